@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"time"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// dataExportLinkExpiry is how long the emailed export download link stays valid
+const dataExportLinkExpiry = 72 * time.Hour
+
+type DeleteAccountRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+// ACCOUNT HANDLERS
+
+// DeleteAccountHandler godoc
+// @Summary Delete current user account
+// @Description Deactivate and delete the authenticated user's account. Personal data is anonymized once the deletion grace period ends.
+// @ID deleteAccount
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body DeleteAccountRequest true "Current password"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /users/me [delete]
+func DeleteAccountHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req DeleteAccountRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		user, err := repos.Users.FindByID(c.UserContext(), uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		if !utils.CheckPasswordHash(req.Password, user.PasswordHash) {
+			return utils.UnauthorizedResponse(c, "Password is incorrect")
+		}
+
+		if err := services.NewAccountService().DeleteAccount(c.UserContext(), uid); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to delete account")
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		if err := services.NewSessionService(&cfg.JWT).RevokeAll(c.UserContext(), uid); err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to revoke sessions of deleted account", zap.Error(err))
+		}
+		graceDays := int(cfg.Limits.AccountDeletionGrace.Hours() / 24)
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Account deleted. Your personal data will be permanently removed after the grace period.",
+			"data": fiber.Map{
+				"grace_period_days": graceDays,
+			},
+		})
+	}
+}
+
+// ExportAccountHandler godoc
+// @Summary Export current user data
+// @Description Generate an export of the authenticated user's profile, orders and tickets and email a download link
+// @ID exportAccount
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param format query string false "Export format" Enums(json, csv) default(json)
+// @Success 202 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 503 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /users/me/export [get]
+func ExportAccountHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		format := c.Query("format", services.ExportFormatJSON)
+		if format != services.ExportFormatJSON && format != services.ExportFormatCSV {
+			return utils.BadRequestResponse(c, "Format must be json or csv")
+		}
+
+		if !storage.Enabled() {
+			return utils.ErrorResponse(c, fiber.StatusServiceUnavailable, utils.CodeServiceUnavailable, "Data export is not available", nil)
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+		ctx := c.UserContext()
+
+		user, err := repos.Users.FindByID(ctx, uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		export, err := services.NewAccountService().ExportAccount(ctx, uid, format)
+		if err != nil {
+			logger.WithContext(ctx).Error("Failed to build data export", zap.Error(err))
+			return utils.InternalServerErrorResponse(c, "Failed to build data export")
+		}
+
+		key := storage.ObjectKey("exports/"+uid.String(), "export."+export.Extension)
+		if err := storage.Upload(ctx, key, export.ContentType, bytes.NewReader(export.Data), int64(len(export.Data))); err != nil {
+			logger.WithContext(ctx).Error("Failed to upload data export", zap.Error(err))
+			return utils.InternalServerErrorResponse(c, "Failed to store data export")
+		}
+
+		link, err := storage.PresignGet(ctx, key, dataExportLinkExpiry)
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to create download link")
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		if err := services.NewEmailService(&cfg.Email).SendDataExportEmail(
+			ctx,
+			user.ID,
+			user.Email,
+			user.FirstName,
+			link,
+			time.Now().Add(dataExportLinkExpiry),
+		); err != nil {
+			logger.WithContext(ctx).Error("Failed to send data export email", zap.Error(err))
+			return utils.InternalServerErrorResponse(c, "Failed to email data export")
+		}
+
+		return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+			"success": true,
+			"message": "Your data export is ready. A download link has been sent to your email.",
+		})
+	}
+}
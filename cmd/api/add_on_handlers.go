@@ -0,0 +1,248 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateAddOnRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description,omitempty"`
+	Price       int64  `json:"price" validate:"min=0"`                        // minor units
+	Quantity    int    `json:"quantity,omitempty" validate:"omitempty,min=0"` // 0 or omitted means unlimited
+}
+
+type UpdateAddOnRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitnil,min=1"`
+	Description *string `json:"description,omitempty"`
+	Price       *int64  `json:"price,omitempty" validate:"omitnil,min=0"`
+	Quantity    *int    `json:"quantity,omitempty" validate:"omitnil,min=0"`
+}
+
+type AddOnResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Price       int64     `json:"price"`
+	Currency    string    `json:"currency"`
+	Quantity    int       `json:"quantity"`
+	Remaining   *int      `json:"remaining,omitempty"` // omitted when unlimited
+}
+
+func toAddOnResponse(addOn *models.AddOn) AddOnResponse {
+	response := AddOnResponse{
+		ID:          addOn.ID,
+		Name:        addOn.Name,
+		Description: addOn.Description,
+		Price:       addOn.Price,
+		Currency:    addOn.Currency,
+		Quantity:    addOn.Quantity,
+	}
+	if remaining := addOn.Remaining(); remaining >= 0 {
+		response.Remaining = &remaining
+	}
+	return response
+}
+
+// ADD-ON HANDLERS
+
+// ListAddOnsHandler godoc
+// @Summary List an event's add-ons
+// @Description List the extras, such as parking or merchandise, that can be added to an order for the event's tickets
+// @ID listAddOns
+// @Tags Events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]AddOnResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/add-ons [get]
+func ListAddOnsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	addOns, err := services.NewAddOnService().List(c.UserContext(), eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch add-ons")
+	}
+
+	responses := make([]AddOnResponse, len(addOns))
+	for i := range addOns {
+		responses[i] = toAddOnResponse(&addOns[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// CreateAddOnHandler godoc
+// @Summary Add an add-on
+// @Description Sell an extra, such as parking or merchandise, alongside the event's tickets. It is priced in the event's currency (Organizer/Admin only)
+// @ID createAddOn
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param addOn body CreateAddOnRequest true "Add-on details"
+// @Success 201 {object} object{success=bool,message=string,data=AddOnResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/add-ons [post]
+func CreateAddOnHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req CreateAddOnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	addOn, err := services.NewAddOnService().Create(c.UserContext(), eventID, services.AddOnInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Quantity:    req.Quantity,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditAddOnCreated, models.AuditTargetAddOn, addOn.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Add-on created successfully",
+		"data":    toAddOnResponse(addOn),
+	})
+}
+
+// UpdateAddOnHandler godoc
+// @Summary Update an add-on
+// @Description Update an add-on's name, description, price or quantity. Orders keep the price they were placed at, and the quantity cannot drop below the number sold (Organizer/Admin only)
+// @ID updateAddOn
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Add-on ID"
+// @Param addOn body UpdateAddOnRequest true "Fields to update"
+// @Success 200 {object} object{success=bool,message=string,data=AddOnResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /add-ons/{id} [put]
+func UpdateAddOnHandler(c *fiber.Ctx) error {
+	addOnID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid add-on ID")
+	}
+
+	var req UpdateAddOnRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	addOnService := services.NewAddOnService()
+
+	existing, err := addOnService.GetAddOn(c.UserContext(), addOnID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Add-on not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditAddOnUpdated, models.AuditTargetAddOn, addOnID)
+	addOn, err := addOnService.Update(c.UserContext(), addOnID, services.AddOnUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Quantity:    req.Quantity,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Add-on updated successfully",
+		"data":    toAddOnResponse(addOn),
+	})
+}
+
+// DeleteAddOnHandler godoc
+// @Summary Delete an add-on
+// @Description Take an add-on off sale. Orders that already include it are unaffected (Organizer/Admin only)
+// @ID deleteAddOn
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Add-on ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /add-ons/{id} [delete]
+func DeleteAddOnHandler(c *fiber.Ctx) error {
+	addOnID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid add-on ID")
+	}
+
+	addOnService := services.NewAddOnService()
+
+	addOn, err := addOnService.GetAddOn(c.UserContext(), addOnID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Add-on not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(addOn.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditAddOnDeleted, models.AuditTargetAddOn, addOnID)
+	if err := addOnService.Delete(c.UserContext(), addOnID); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Add-on deleted successfully",
+	})
+}
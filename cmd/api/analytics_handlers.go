@@ -0,0 +1,49 @@
+package main
+
+import (
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// ANALYTICS HANDLERS
+
+// GetEventAnalyticsHandler godoc
+// @Summary Get event analytics
+// @Description Get tickets sold per tier, daily revenue, refund rate and reservation-to-purchase conversion for an event (Organizer/Admin only)
+// @ID getEventAnalytics
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=services.EventAnalytics}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/events/{id}/analytics [get]
+func GetEventAnalyticsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	analytics, err := services.NewAnalyticsService().GetEventAnalytics(eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch event analytics")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    analytics,
+	})
+}
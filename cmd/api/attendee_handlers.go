@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+	"eventix-api/pkg/xlsx"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+// ATTENDEE HANDLERS
+
+// ExportAttendeesHandler godoc
+// @Summary Export event attendees
+// @Description Download an event's ticket holders with their tier, order, check-in status and registration form answers as CSV or XLSX. The file is streamed as it is generated (Organizer/Admin only)
+// @ID exportAttendees
+// @Tags Organizer
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param format query string false "Export format" Enums(csv, xlsx) default(csv)
+// @Success 200 {file} file
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/events/{id}/attendees/export [get]
+func ExportAttendeesHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	format := c.Query("format", services.ExportFormatCSV)
+	contentType := "text/csv; charset=utf-8"
+	switch format {
+	case services.ExportFormatCSV:
+	case services.ExportFormatXLSX:
+		contentType = xlsx.ContentType
+	default:
+		return utils.BadRequestResponse(c, "Format must be csv or xlsx")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	// The stream is written after the handler returns, so keep nothing from c
+	ctx := c.UserContext()
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="attendees-%s.%s"`, eventID, format))
+
+	c.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(w *bufio.Writer) {
+		// Headers are already sent, so a failure can only cut the file short
+		if err := services.NewAttendeeService().Export(ctx, eventID, format, w); err != nil {
+			logger.WithContext(ctx).Error("Failed to export attendees",
+				zap.String("event_id", eventID.String()),
+				zap.Error(err),
+			)
+		}
+		w.Flush()
+	}))
+
+	return nil
+}
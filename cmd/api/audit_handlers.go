@@ -0,0 +1,179 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type AuditLogResponse struct {
+	ID         uuid.UUID              `json:"id"`
+	ActorID    uuid.UUID              `json:"actor_id"`
+	ActorRole  models.UserRole        `json:"actor_role"`
+	Action     models.AuditAction     `json:"action"`
+	TargetType models.AuditTargetType `json:"target_type"`
+	TargetID   uuid.UUID              `json:"target_id"`
+	Before     json.RawMessage        `json:"before" swaggertype:"object"`
+	After      json.RawMessage        `json:"after" swaggertype:"object"`
+	IPAddress  string                 `json:"ip_address,omitempty"`
+	RequestID  string                 `json:"request_id,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// pendingAudit is an audited mutation whose before snapshot has been taken
+type pendingAudit struct {
+	action     models.AuditAction
+	targetType models.AuditTargetType
+	targetID   uuid.UUID
+	before     interface{}
+}
+
+// beginAudit snapshots the target of an admin or organizer mutation. Call
+// record on the result once the mutation has succeeded.
+func beginAudit(c *fiber.Ctx, action models.AuditAction, targetType models.AuditTargetType, targetID uuid.UUID) *pendingAudit {
+	return &pendingAudit{
+		action:     action,
+		targetType: targetType,
+		targetID:   targetID,
+		before:     services.NewAuditService().Snapshot(c.UserContext(), targetType, targetID),
+	}
+}
+
+// recordCreated audits the creation of a target, which has no before snapshot
+func recordCreated(c *fiber.Ctx, action models.AuditAction, targetType models.AuditTargetType, targetID uuid.UUID) {
+	audit := &pendingAudit{action: action, targetType: targetType, targetID: targetID}
+	audit.record(c)
+}
+
+// record snapshots the target again and writes the audit entry for the acting user
+func (a *pendingAudit) record(c *fiber.Ctx) {
+	auditService := services.NewAuditService()
+	actorID, _ := uuid.Parse(c.Locals("user_id").(string))
+	role, _ := c.Locals("role").(string)
+
+	auditService.Record(c.UserContext(), services.AuditEntry{
+		ActorID:    actorID,
+		ActorRole:  models.UserRole(role),
+		Action:     a.action,
+		TargetType: a.targetType,
+		TargetID:   a.targetID,
+		Before:     a.before,
+		After:      auditService.Snapshot(c.UserContext(), a.targetType, a.targetID),
+		IPAddress:  c.IP(),
+	})
+}
+
+// AUDIT HANDLERS
+
+// ListAuditLogsHandler godoc
+// @Summary List audit logs
+// @Description List recorded admin and organizer mutations, newest first, with before/after snapshots of their targets (Admin only)
+// @ID listAuditLogs
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param actor_id query string false "Filter by acting user"
+// @Param action query string false "Filter by action, e.g. event.approved"
+// @Param target_type query string false "Filter by target type" Enums(event, tier, order, user, organizer, payout)
+// @Param target_id query string false "Filter by target"
+// @Param from query string false "Only entries at or after this RFC 3339 time"
+// @Param to query string false "Only entries before this RFC 3339 time"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} object{success=bool,data=[]AuditLogResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/audit-logs [get]
+func ListAuditLogsHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	filter := services.AuditLogFilter{
+		Action:     models.AuditAction(c.Query("action")),
+		TargetType: models.AuditTargetType(c.Query("target_type")),
+		Page:       page,
+		Limit:      limit,
+	}
+
+	if raw := c.Query("actor_id"); raw != "" {
+		actorID, err := uuid.Parse(raw)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid actor ID")
+		}
+		filter.ActorID = &actorID
+	}
+	if raw := c.Query("target_id"); raw != "" {
+		targetID, err := uuid.Parse(raw)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid target ID")
+		}
+		filter.TargetID = &targetID
+	}
+	if raw := c.Query("from"); raw != "" {
+		from, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid from time, expected RFC 3339")
+		}
+		filter.From = &from
+	}
+	if raw := c.Query("to"); raw != "" {
+		to, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid to time, expected RFC 3339")
+		}
+		filter.To = &to
+	}
+
+	logs, total, err := services.NewAuditService().List(c.UserContext(), filter)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch audit logs")
+	}
+
+	responses := make([]AuditLogResponse, len(logs))
+	for i, log := range logs {
+		responses[i] = AuditLogResponse{
+			ID:         log.ID,
+			ActorID:    log.ActorID,
+			ActorRole:  log.ActorRole,
+			Action:     log.Action,
+			TargetType: log.TargetType,
+			TargetID:   log.TargetID,
+			Before:     auditSnapshotJSON(log.Before),
+			After:      auditSnapshotJSON(log.After),
+			IPAddress:  log.IPAddress,
+			RequestID:  log.RequestID,
+			CreatedAt:  log.CreatedAt,
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+func auditSnapshotJSON(snapshot string) json.RawMessage {
+	if snapshot == "" {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(snapshot)
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateBadgePrinterRequest struct {
+	Driver       models.BadgeDriver `json:"driver" validate:"required,oneof=webhook"`
+	URL          string             `json:"url" validate:"required,url,startswith=https://,max=500"`
+	AutoPrint    bool               `json:"auto_print"`
+	RotateSecret bool               `json:"rotate_secret,omitempty"`
+}
+
+type BadgePrinterResponse struct {
+	Driver    models.BadgeDriver `json:"driver"`
+	URL       string             `json:"url"`
+	AutoPrint bool               `json:"auto_print"`
+	Secret    string             `json:"secret,omitempty"` // only returned when generated
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+func toBadgePrinterResponse(printer *models.BadgePrinter) BadgePrinterResponse {
+	return BadgePrinterResponse{
+		Driver:    printer.Driver,
+		URL:       printer.URL,
+		AutoPrint: printer.AutoPrint,
+		UpdatedAt: printer.UpdatedAt,
+	}
+}
+
+// BADGE HANDLERS
+
+// ListBadgesHandler godoc
+// @Summary List attendee badges
+// @Description Printable badge data (name, tier and QR code) of attendees checked in to an event, in check-in order. Check-in desks poll with since set to the last badge's checked_in_at (Organizer/Admin only, or a scanner token for the event)
+// @ID listBadges
+// @Tags Check-in
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param since query string false "Only badges checked in after this RFC 3339 time"
+// @Param limit query int false "Badges to return (1-100)" default(50)
+// @Success 200 {object} object{success=bool,data=[]services.Badge}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /checkin/events/{id}/badges [get]
+func ListBadgesHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var since time.Time
+	if raw := c.Query("since"); raw != "" {
+		if since, err = time.Parse(time.RFC3339, raw); err != nil {
+			return utils.BadRequestResponse(c, "since must be an RFC 3339 time")
+		}
+	}
+	limit, _ := strconv.Atoi(c.Query("limit", "50"))
+	if limit < 1 || limit > 100 {
+		limit = 50
+	}
+
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	badges, err := services.NewBadgeService().List(c.UserContext(), eventID, since, limit)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    badges,
+	})
+}
+
+// PrintBadgeHandler godoc
+// @Summary Print an attendee badge
+// @Description Send a checked-in attendee's badge to the event's badge printer, e.g. to reprint one (Organizer/Admin only, or a scanner token for the event)
+// @ID printBadge
+// @Tags Check-in
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Ticket ID"
+// @Success 200 {object} object{success=bool,message=string,data=services.Badge}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 502 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /checkin/badges/{id}/print [post]
+func PrintBadgeHandler(c *fiber.Ctx) error {
+	ticketID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid ticket ID")
+	}
+
+	badgeService := services.NewBadgeService()
+	badge, err := badgeService.Get(c.UserContext(), ticketID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeCheckin(c, badge.EventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	if err := badgeService.Print(c.UserContext(), badge); err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Badge sent to printer",
+		"data":    badge,
+	})
+}
+
+// GetBadgePrinterHandler godoc
+// @Summary Get an event's badge printer
+// @Description Where the event's badges are sent for printing (Organizer/Admin only)
+// @ID getBadgePrinter
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=BadgePrinterResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/badge-printer [get]
+func GetBadgePrinterHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	printer, err := services.NewBadgeService().Printer(c.UserContext(), eventID)
+	if err != nil {
+		return err
+	}
+	if printer == nil {
+		return utils.NotFoundResponse(c, "Event has no badge printer")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    toBadgePrinterResponse(printer),
+	})
+}
+
+// UpdateBadgePrinterHandler godoc
+// @Summary Set an event's badge printer
+// @Description Send the event's badges to a print server. The webhook driver POSTs {"type":"badge.print","badge":{...}} to the URL, signed with a hex HMAC-SHA256 of the body in X-Eventix-Signature. The signing secret is returned when first generated or rotated. With auto_print, every event check-in prints a badge (Organizer/Admin only)
+// @ID updateBadgePrinter
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body UpdateBadgePrinterRequest true "Badge printer"
+// @Success 200 {object} object{success=bool,message=string,data=BadgePrinterResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/badge-printer [put]
+func UpdateBadgePrinterHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req UpdateBadgePrinterRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditEventBadgePrinterUpdated, models.AuditTargetEvent, eventID)
+	printer, secret, err := services.NewBadgeService().SetPrinter(c.UserContext(), eventID, services.BadgePrinterInput{
+		Driver:       req.Driver,
+		URL:          req.URL,
+		AutoPrint:    req.AutoPrint,
+		RotateSecret: req.RotateSecret,
+	}, uid)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	response := toBadgePrinterResponse(printer)
+	response.Secret = secret
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Badge printer updated successfully",
+		"data":    response,
+	})
+}
+
+// RemoveBadgePrinterHandler godoc
+// @Summary Remove an event's badge printer
+// @Description Stop sending the event's badges for printing (Organizer/Admin only)
+// @ID removeBadgePrinter
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/badge-printer [delete]
+func RemoveBadgePrinterHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditEventBadgePrinterRemoved, models.AuditTargetEvent, eventID)
+	if err := services.NewBadgeService().RemovePrinter(c.UserContext(), eventID); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Badge printer removed successfully",
+	})
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// CALENDAR HANDLERS
+
+// GetTicketCalendarHandler godoc
+// @Summary Get a calendar invite for a ticket
+// @Description Download an iCalendar (.ics) file with the event time, venue and ticket reference for a ticket owned by the authenticated user
+// @ID getTicketCalendar
+// @Tags Tickets
+// @Produce text/calendar
+// @Security BearerAuth
+// @Param id path string true "Ticket ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tickets/{id}/calendar.ics [get]
+func GetTicketCalendarHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ticketID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid ticket ID")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		ticket, err := repos.Tickets.FindForOwner(c.UserContext(), ticketID, uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Ticket not found")
+		}
+
+		invite, event, err := services.NewCalendarService().TicketInvite(c.UserContext(), ticket.ID)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		c.Set(fiber.HeaderContentType, services.CalendarContentType)
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, services.CalendarFilename(event)))
+		return c.Send(invite)
+	}
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateCategoryRequest struct {
+	Name string `json:"name" validate:"required,max=100"`
+	Slug string `json:"slug,omitempty" validate:"omitempty,max=50"` // derived from the name when omitted
+	Icon string `json:"icon,omitempty"`
+}
+
+type UpdateCategoryRequest struct {
+	Name     *string `json:"name,omitempty" validate:"omitnil,min=1,max=100"`
+	Icon     *string `json:"icon,omitempty"`
+	IsActive *bool   `json:"is_active,omitempty"`
+}
+
+type CategoryResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Slug     string    `json:"slug"`
+	Icon     string    `json:"icon,omitempty"`
+	IsActive bool      `json:"is_active"`
+}
+
+func toCategoryResponse(category *models.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:       category.ID,
+		Name:     category.Name,
+		Slug:     category.Slug,
+		Icon:     category.Icon,
+		IsActive: category.IsActive,
+	}
+}
+
+func toCategoryResponses(categories []models.Category) []CategoryResponse {
+	responses := make([]CategoryResponse, len(categories))
+	for i := range categories {
+		responses[i] = toCategoryResponse(&categories[i])
+	}
+	return responses
+}
+
+// CATEGORY HANDLERS
+
+// ListCategoriesHandler godoc
+// @Summary List event categories
+// @Description List the active categories events can be filed under. Use the slug as an event's category and to filter GET /events
+// @ID listCategories
+// @Tags Events
+// @Produce json
+// @Success 200 {object} object{success=bool,data=[]CategoryResponse}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /categories [get]
+func ListCategoriesHandler(c *fiber.Ctx) error {
+	categories, err := services.NewCategoryService().List(c.UserContext(), false)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch categories")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    toCategoryResponses(categories),
+	})
+}
+
+// AdminListCategoriesHandler godoc
+// @Summary List all event categories
+// @Description List every category, including inactive ones (Admin only)
+// @ID adminListCategories
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]CategoryResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/categories [get]
+func AdminListCategoriesHandler(c *fiber.Ctx) error {
+	categories, err := services.NewCategoryService().List(c.UserContext(), true)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch categories")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    toCategoryResponses(categories),
+	})
+}
+
+// CreateCategoryHandler godoc
+// @Summary Create an event category
+// @Description Add a category events can be filed under (Admin only)
+// @ID createCategory
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateCategoryRequest true "Category"
+// @Success 201 {object} object{success=bool,message=string,data=CategoryResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/categories [post]
+func CreateCategoryHandler(c *fiber.Ctx) error {
+	var req CreateCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	category, err := services.NewCategoryService().Create(c.UserContext(), services.CategoryInput{
+		Name: req.Name,
+		Slug: req.Slug,
+		Icon: req.Icon,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditCategoryCreated, models.AuditTargetCategory, category.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Category created successfully",
+		"data":    toCategoryResponse(category),
+	})
+}
+
+// UpdateCategoryHandler godoc
+// @Summary Update an event category
+// @Description Rename a category, change its icon or deactivate it. Inactive categories are hidden and cannot be chosen for new events; existing events keep them (Admin only)
+// @ID updateCategory
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Param request body UpdateCategoryRequest true "Fields to update"
+// @Success 200 {object} object{success=bool,message=string,data=CategoryResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/categories/{id} [put]
+func UpdateCategoryHandler(c *fiber.Ctx) error {
+	categoryID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid category ID")
+	}
+
+	var req UpdateCategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	audit := beginAudit(c, models.AuditCategoryUpdated, models.AuditTargetCategory, categoryID)
+	category, err := services.NewCategoryService().Update(c.UserContext(), categoryID, services.CategoryUpdate{
+		Name:     req.Name,
+		Icon:     req.Icon,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Category updated successfully",
+		"data":    toCategoryResponse(category),
+	})
+}
+
+// DeleteCategoryHandler godoc
+// @Summary Delete an event category
+// @Description Delete a category no event has been filed under. Deactivate categories that are in use instead (Admin only)
+// @ID deleteCategory
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/categories/{id} [delete]
+func DeleteCategoryHandler(c *fiber.Ctx) error {
+	categoryID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid category ID")
+	}
+
+	audit := beginAudit(c, models.AuditCategoryDeleted, models.AuditTargetCategory, categoryID)
+	if err := services.NewCategoryService().Delete(c.UserContext(), categoryID); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Category deleted successfully",
+	})
+}
@@ -0,0 +1,462 @@
+package main
+
+import (
+	"strings"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ManualCheckinRequest struct {
+	EventID   string `json:"event_id" validate:"required,uuid"`
+	TicketID  string `json:"ticket_id" validate:"required,uuid"`
+	SessionID string `json:"session_id,omitempty" validate:"omitempty,uuid"` // check in to one session of a multi-session event
+	ZoneID    string `json:"zone_id,omitempty" validate:"omitempty,uuid"`    // checking in at the entrance of a zone
+	Device    string `json:"device,omitempty" validate:"max=100"`            // shown when the ticket is scanned again
+}
+
+type UndoCheckinRequest struct {
+	CheckinID string `json:"checkin_id" validate:"required,uuid"`
+}
+
+type CheckoutRequest struct {
+	QRCode  string `json:"qr_code" validate:"required"`
+	EventID string `json:"event_id" validate:"required,uuid"`
+	ZoneID  string `json:"zone_id,omitempty" validate:"omitempty,uuid"` // leaving a zone rather than the event
+	Device  string `json:"device,omitempty" validate:"max=100"`
+}
+
+type UpdateReentryRequest struct {
+	Allowed *bool `json:"allowed" validate:"required"`
+}
+
+type UpdateOccupancyLimitRequest struct {
+	MaxOccupancy int `json:"max_occupancy" validate:"min=0"` // 0 removes the limit
+}
+
+// checkinDevice names the device scanning a ticket: the one the client sent,
+// or for door staff the label of their scanner token
+func checkinDevice(c *fiber.Ctx, device string) string {
+	if device != "" {
+		return device
+	}
+	if _, ok := c.Locals("scanner_event_id").(string); !ok {
+		return ""
+	}
+
+	tokenID, err := uuid.Parse(c.Locals("session_id").(string))
+	if err != nil {
+		return ""
+	}
+	token, err := services.NewScannerTokenService().Get(c.UserContext(), tokenID)
+	if err != nil {
+		return ""
+	}
+	return token.Label
+}
+
+// MANUAL CHECK-IN HANDLERS
+
+// SearchCheckinAttendeesHandler godoc
+// @Summary Search attendees for manual check-in
+// @Description Find an event's tickets by the owner's name or email, for attendees who arrive without their ticket. Returns at most 20 active or checked-in tickets (Organizer/Admin only, or a scanner token for the event)
+// @ID searchCheckinAttendees
+// @Tags Check-in
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param q query string true "Name or email, at least 2 characters"
+// @Success 200 {object} object{success=bool,data=[]services.AttendeeMatch}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /checkin/events/{id}/search [get]
+func SearchCheckinAttendeesHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	query := strings.TrimSpace(c.Query("q"))
+	if len(query) < 2 {
+		return utils.BadRequestResponse(c, "Search query must be at least 2 characters")
+	}
+
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	matches, err := services.NewCheckinService().SearchAttendees(c.UserContext(), eventID, query)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    matches,
+	})
+}
+
+// ManualCheckinHandler godoc
+// @Summary Check in a ticket manually
+// @Description Check in a ticket found with the attendee search, for the event or one of its sessions when session_id is given, at the entrance of a zone when zone_id is given. The check-in is recorded with method "manual" (Organizer/Admin only, or a scanner token for the event)
+// @ID manualCheckin
+// @Tags Check-in
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ManualCheckinRequest true "Ticket to check in"
+// @Success 200 {object} object{success=bool,message=string,data=object}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /checkin/manual [post]
+func ManualCheckinHandler(c *fiber.Ctx) error {
+	var req ManualCheckinRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	eventID, _ := uuid.Parse(req.EventID)
+	ticketID, _ := uuid.Parse(req.TicketID)
+
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+	validatorID, _ := uuid.Parse(c.Locals("user_id").(string))
+	device := checkinDevice(c, req.Device)
+
+	var zoneID *uuid.UUID
+	if req.ZoneID != "" {
+		id, _ := uuid.Parse(req.ZoneID)
+		zoneID = &id
+	}
+
+	ticket, err := services.NewTicketService().ValidateTicketForManualCheckin(ticketID, eventID, req.SessionID != "" || zoneID != nil)
+	if err != nil {
+		return err
+	}
+
+	if req.SessionID != "" {
+		sessionID, _ := uuid.Parse(req.SessionID)
+		return checkInSession(c, ticket, sessionID, zoneID, validatorID, models.CheckinManual, device)
+	}
+	if zoneID != nil {
+		return checkInZone(c, ticket, eventID, *zoneID, validatorID, models.CheckinManual, device)
+	}
+	return checkInEvent(c, ticket, eventID, nil, validatorID, models.CheckinManual, device)
+}
+
+// UndoCheckinHandler godoc
+// @Summary Undo a check-in
+// @Description Reverse a mistaken check-in within 5 minutes of the scan. Undoing an event check-in makes the ticket valid for entry again (Organizer/Admin only)
+// @ID undoCheckin
+// @Tags Check-in
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UndoCheckinRequest true "Check-in to undo"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /checkin/undo [post]
+func UndoCheckinHandler(c *fiber.Ctx) error {
+	var req UndoCheckinRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	checkinID, _ := uuid.Parse(req.CheckinID)
+
+	checkinService := services.NewCheckinService()
+	checkin, err := checkinService.Get(c.UserContext(), checkinID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeCheckin(c, checkin.EventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditCheckinUndone, models.AuditTargetCheckin, checkinID)
+	if err := checkinService.Undo(c.UserContext(), checkin); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Check-in undone successfully",
+	})
+}
+
+// RE-ENTRY HANDLERS
+
+// CheckoutHandler godoc
+// @Summary Check a ticket out
+// @Description Scan a checked-in ticket on its way out of an event that allows re-entry, so it can be scanned back in with /checkin/validate. With zone_id the ticket only leaves that zone, which frees a place in its occupancy (Organizer/Admin only, or a scanner token for the event)
+// @ID checkout
+// @Tags Check-in
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CheckoutRequest true "Ticket to check out"
+// @Success 200 {object} object{success=bool,message=string,data=object}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string} "Already scanned out"
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /checkin/checkout [post]
+func CheckoutHandler(c *fiber.Ctx) error {
+	var req CheckoutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	eventID, _ := uuid.Parse(req.EventID)
+
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+	validatorID, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	ticketService := services.NewTicketService()
+	ticket, err := ticketService.ValidateTicketForCheckout(req.QRCode, eventID)
+	if err != nil {
+		return err
+	}
+
+	if req.ZoneID != "" {
+		zoneID, _ := uuid.Parse(req.ZoneID)
+		zone, err := services.NewEventZoneService().Authorize(c.UserContext(), ticket, zoneID)
+		if err != nil {
+			return err
+		}
+		services.NewOccupancyService().LeaveZone(c.UserContext(), zone.ID, ticket.ID)
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Zone check-out successful",
+			"data": fiber.Map{
+				"ticket_id": ticket.ID,
+				"zone_id":   zone.ID,
+				"zone":      zone.Name,
+			},
+		})
+	}
+
+	checkin, err := ticketService.CheckOutTicket(c.UserContext(), ticket, validatorID, eventID, models.CheckinQR, checkinDevice(c, req.Device))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Check-out successful",
+		"data": fiber.Map{
+			"checkin_id": checkin.ID,
+			"ticket_id":  ticket.ID,
+			"direction":  checkin.Direction,
+			"scanned_at": checkin.ScannedAt,
+		},
+	})
+}
+
+// GetTicketScansHandler godoc
+// @Summary Get a ticket's scan history
+// @Description Every entry, exit and session scan of a ticket, oldest first, and whether it is inside the event (Organizer/Admin only, or a scanner token for the event)
+// @ID getTicketScans
+// @Tags Check-in
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Ticket ID"
+// @Success 200 {object} object{success=bool,data=services.ScanHistory}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /checkin/tickets/{id}/scans [get]
+func GetTicketScansHandler(c *fiber.Ctx) error {
+	ticketID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid ticket ID")
+	}
+
+	history, err := services.NewCheckinService().History(c.UserContext(), ticketID)
+	if err != nil {
+		return err
+	}
+
+	if err := authorizeCheckin(c, history.EventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    history,
+	})
+}
+
+// UpdateEventReentryHandler godoc
+// @Summary Allow or disallow re-entry
+// @Description When allowed, checked-in tickets can be scanned out with /checkin/checkout and back in, and every scan is kept in the ticket's history. Otherwise a ticket gets in once (Organizer/Admin only)
+// @ID updateEventReentry
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body UpdateReentryRequest true "Re-entry setting"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/reentry [put]
+func UpdateEventReentryHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req UpdateReentryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	eventService := services.NewEventService()
+	if err := eventService.AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditEventReentryUpdated, models.AuditTargetEvent, eventID)
+	if err := eventService.SetReentry(c.UserContext(), eventID, *req.Allowed); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	message := "Re-entry disallowed"
+	if *req.Allowed {
+		message = "Re-entry allowed"
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": message,
+	})
+}
+
+// OCCUPANCY HANDLERS
+
+// GetEventOccupancyHandler godoc
+// @Summary Get live occupancy
+// @Description How many attendees are inside an event and each of its zones right now: entries minus check-outs, next to any occupancy limits (Organizer/Admin only, or a scanner token for the event)
+// @ID getEventOccupancy
+// @Tags Check-in
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=services.EventOccupancy}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 503 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /checkin/events/{id}/occupancy [get]
+func GetEventOccupancyHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	occupancy, err := services.NewOccupancyService().Get(c.UserContext(), eventID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    occupancy,
+	})
+}
+
+// UpdateEventOccupancyLimitHandler godoc
+// @Summary Set an event's occupancy limit
+// @Description Refuse entry scans while this many attendees are inside the event; 0 removes the limit. Zones have their own limits (Organizer/Admin only)
+// @ID updateEventOccupancyLimit
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body UpdateOccupancyLimitRequest true "Occupancy limit"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/occupancy-limit [put]
+func UpdateEventOccupancyLimitHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req UpdateOccupancyLimitRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	eventService := services.NewEventService()
+	if err := eventService.AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditEventOccupancyUpdated, models.AuditTargetEvent, eventID)
+	if err := eventService.SetOccupancyLimit(c.UserContext(), eventID, req.MaxOccupancy); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Occupancy limit updated successfully",
+	})
+}
@@ -0,0 +1,234 @@
+//go:build integration
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/testutil"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// TestCheckoutFlow buys two tickets through the API, from the reservation
+// to the paid order's webhook, and checks one of them in at the door
+func TestCheckoutFlow(t *testing.T) {
+	app := newTestApp(t)
+
+	organizer := testutil.CreateOrganizer(t)
+	event := testutil.CreateEvent(t, organizer, 5000, 10)
+	tier := event.TicketTiers[0]
+	buyer := testutil.CreateUser(t, models.RoleAttendee)
+	buyerToken := testutil.AccessToken(t, buyer)
+
+	// Reserving holds the tickets
+	resp := call(t, app, newRequest(t, http.MethodPost, "/api/v1/tickets/reserve", buyerToken, fiber.Map{
+		"tier_id":  tier.ID,
+		"quantity": 2,
+	}))
+	resp.expect(t, fiber.StatusOK)
+	var reserved struct {
+		Data struct {
+			ReservationID string `json:"reservation_id"`
+		} `json:"data"`
+	}
+	resp.decode(t, &reserved)
+	if reserved.Data.ReservationID == "" {
+		t.Fatalf("reservation has no ID\n%s", resp.body)
+	}
+	expectAvailable(t, tier.ID, 8)
+
+	// The order keeps the held tickets while it awaits payment
+	resp = call(t, app, newRequest(t, http.MethodPost, "/api/v1/orders", buyerToken, fiber.Map{
+		"reservation_id": reserved.Data.ReservationID,
+		"provider":       "paystack",
+	}))
+	resp.expect(t, fiber.StatusCreated)
+	var created struct {
+		Data OrderResponse `json:"data"`
+	}
+	resp.decode(t, &created)
+	if created.Data.Status != models.OrderPending {
+		t.Errorf("created order status = %s, want %s", created.Data.Status, models.OrderPending)
+	}
+	if created.Data.TotalAmount != 10000 {
+		t.Errorf("created order total = %d, want 10000", created.Data.TotalAmount)
+	}
+	expectAvailable(t, tier.ID, 8)
+	order := loadOrder(t, created.Data.ID)
+	if order.Status != models.OrderPending {
+		t.Errorf("order status = %s, want %s", order.Status, models.OrderPending)
+	}
+	expectTickets(t, order.ID, 0, "")
+
+	// Initializing the payment calls Paystack, so its pending payment is
+	// recorded directly; the signed webhook then confirms it
+	payment := testutil.CreatePayment(t, order)
+	webhook, err := json.Marshal(fiber.Map{
+		"event": "charge.success",
+		"data": fiber.Map{
+			"reference": payment.TransactionID,
+			"amount":    payment.Amount,
+			"status":    "success",
+		},
+	})
+	if err != nil {
+		t.Fatalf("encode webhook: %v", err)
+	}
+	req := newRequest(t, http.MethodPost, "/api/v1/webhooks/payments", "", webhook)
+	req.Header.Set("x-paystack-signature", paystackSignature(webhook))
+	call(t, app, req).expect(t, fiber.StatusOK)
+
+	expectAvailable(t, tier.ID, 8)
+	order = loadOrder(t, order.ID)
+	if order.Status != models.OrderPaid {
+		t.Errorf("order status = %s, want %s", order.Status, models.OrderPaid)
+	}
+	if stored := loadPayment(t, payment.ID); stored.Status != models.PaymentCompleted {
+		t.Errorf("payment status = %s, want %s", stored.Status, models.PaymentCompleted)
+	}
+	tickets := expectTickets(t, order.ID, 2, models.TicketActive)
+
+	// A replayed webhook changes nothing
+	req = newRequest(t, http.MethodPost, "/api/v1/webhooks/payments", "", webhook)
+	req.Header.Set("x-paystack-signature", paystackSignature(webhook))
+	call(t, app, req).expect(t, fiber.StatusOK)
+	expectTickets(t, order.ID, 2, models.TicketActive)
+
+	// Checking in uses up the scanned ticket only
+	resp = call(t, app, newRequest(t, http.MethodPost, "/api/v1/checkin/validate", testutil.AccessToken(t, &organizer.User), fiber.Map{
+		"qr_code":  tickets[0].QRCode,
+		"event_id": event.ID,
+	}))
+	resp.expect(t, fiber.StatusOK)
+
+	expectAvailable(t, tier.ID, 8)
+	if order = loadOrder(t, order.ID); order.Status != models.OrderPaid {
+		t.Errorf("order status after check-in = %s, want %s", order.Status, models.OrderPaid)
+	}
+	if status := loadTicket(t, tickets[0].ID).Status; status != models.TicketUsed {
+		t.Errorf("checked in ticket status = %s, want %s", status, models.TicketUsed)
+	}
+	if status := loadTicket(t, tickets[1].ID).Status; status != models.TicketActive {
+		t.Errorf("other ticket status = %s, want %s", status, models.TicketActive)
+	}
+
+	// The same ticket cannot get in twice
+	resp = call(t, app, newRequest(t, http.MethodPost, "/api/v1/checkin/validate", testutil.AccessToken(t, &organizer.User), fiber.Map{
+		"qr_code":  tickets[0].QRCode,
+		"event_id": event.ID,
+	}))
+	if resp.status == fiber.StatusOK {
+		t.Errorf("second check-in succeeded\n%s", resp.body)
+	}
+}
+
+// TestCheckoutRejectsForgedWebhook leaves the order unpaid when the webhook
+// signature does not match the body
+func TestCheckoutRejectsForgedWebhook(t *testing.T) {
+	app := newTestApp(t)
+
+	organizer := testutil.CreateOrganizer(t)
+	event := testutil.CreateEvent(t, organizer, 5000, 10)
+	buyer := testutil.CreateUser(t, models.RoleAttendee)
+	order := testutil.CreateOrder(t, buyer, &event.TicketTiers[0], 1)
+	payment := testutil.CreatePayment(t, order)
+
+	signed, _ := json.Marshal(fiber.Map{
+		"event": "charge.success",
+		"data":  fiber.Map{"reference": payment.TransactionID, "amount": 1},
+	})
+	forged, _ := json.Marshal(fiber.Map{
+		"event": "charge.success",
+		"data":  fiber.Map{"reference": payment.TransactionID, "amount": payment.Amount},
+	})
+	req := newRequest(t, http.MethodPost, "/api/v1/webhooks/payments", "", forged)
+	req.Header.Set("x-paystack-signature", paystackSignature(signed))
+	call(t, app, req).expect(t, fiber.StatusUnauthorized)
+
+	if status := loadOrder(t, order.ID).Status; status != models.OrderPending {
+		t.Errorf("order status = %s, want %s", status, models.OrderPending)
+	}
+	if status := loadPayment(t, payment.ID).Status; status != models.PaymentPending {
+		t.Errorf("payment status = %s, want %s", status, models.PaymentPending)
+	}
+	expectAvailable(t, event.TicketTiers[0].ID, 9)
+	expectTickets(t, order.ID, 0, "")
+}
+
+// paystackSignature signs a webhook body the way Paystack does
+func paystackSignature(body []byte) string {
+	mac := hmac.New(sha512.New, []byte(testutil.WebhookSecret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// expectAvailable fails the test unless tier has available tickets left
+func expectAvailable(t *testing.T, tierID uuid.UUID, available int) {
+	t.Helper()
+
+	var tier models.TicketTier
+	if err := env.DB.First(&tier, tierID).Error; err != nil {
+		t.Fatalf("load tier: %v", err)
+	}
+	if tier.AvailableQuantity != available {
+		t.Errorf("available quantity = %d, want %d", tier.AvailableQuantity, available)
+	}
+}
+
+// expectTickets fails the test unless order was issued count tickets, all
+// with status, and returns them
+func expectTickets(t *testing.T, orderID uuid.UUID, count int, status models.TicketStatus) []models.Ticket {
+	t.Helper()
+
+	var tickets []models.Ticket
+	if err := env.DB.Where("order_id = ?", orderID).Order("created_at ASC").Find(&tickets).Error; err != nil {
+		t.Fatalf("load tickets: %v", err)
+	}
+	if len(tickets) != count {
+		t.Fatalf("order has %d tickets, want %d", len(tickets), count)
+	}
+	for _, ticket := range tickets {
+		if ticket.Status != status {
+			t.Errorf("ticket %s status = %s, want %s", ticket.ID, ticket.Status, status)
+		}
+	}
+	return tickets
+}
+
+func loadOrder(t *testing.T, id uuid.UUID) *models.Order {
+	t.Helper()
+
+	var order models.Order
+	if err := env.DB.First(&order, id).Error; err != nil {
+		t.Fatalf("load order: %v", err)
+	}
+	return &order
+}
+
+func loadPayment(t *testing.T, id uuid.UUID) *models.Payment {
+	t.Helper()
+
+	var payment models.Payment
+	if err := env.DB.First(&payment, id).Error; err != nil {
+		t.Fatalf("load payment: %v", err)
+	}
+	return &payment
+}
+
+func loadTicket(t *testing.T, id uuid.UUID) *models.Ticket {
+	t.Helper()
+
+	var ticket models.Ticket
+	if err := env.DB.First(&ticket, id).Error; err != nil {
+		t.Fatalf("load ticket: %v", err)
+	}
+	return &ticket
+}
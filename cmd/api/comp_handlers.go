@@ -0,0 +1,103 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CompRecipientRequest struct {
+	Email     string `json:"email" validate:"required,email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Quantity  int    `json:"quantity,omitempty" validate:"omitempty,min=1,max=10"` // defaults to 1
+}
+
+type IssueCompTicketsRequest struct {
+	TierID     string                 `json:"tier_id" validate:"required,uuid"`
+	Recipients []CompRecipientRequest `json:"recipients" validate:"required,min=1,max=100,dive"`
+}
+
+type CompOrderResponse struct {
+	OrderID  uuid.UUID `json:"order_id"`
+	Email    string    `json:"email"`
+	Quantity int       `json:"quantity"`
+}
+
+// COMP TICKET HANDLERS
+
+// IssueCompTicketsHandler godoc
+// @Summary Issue comp tickets
+// @Description Give free tickets of one tier to a list of people. Each recipient gets a zero-priced order and their tickets by email; people without an account can claim one from it. Comps use up tier stock and are reported separately from sales (Organizer/Admin only)
+// @ID issueCompTickets
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body IssueCompTicketsRequest true "Tier and recipients"
+// @Success 201 {object} object{success=bool,message=string,data=[]CompOrderResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/events/{id}/comp-tickets [post]
+func IssueCompTicketsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req IssueCompTicketsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	recipients := make([]services.CompRecipient, len(req.Recipients))
+	for i, r := range req.Recipients {
+		recipients[i] = services.CompRecipient{
+			Email:     r.Email,
+			FirstName: r.FirstName,
+			LastName:  r.LastName,
+			Quantity:  r.Quantity,
+		}
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	tierID, _ := uuid.Parse(req.TierID)
+
+	orders, err := services.NewCompService(cfg).Issue(c.UserContext(), eventID, tierID, recipients)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	responses := make([]CompOrderResponse, len(orders))
+	for i, order := range orders {
+		recordCreated(c, models.AuditOrderComped, models.AuditTargetOrder, order.ID)
+		responses[i] = CompOrderResponse{
+			OrderID:  order.ID,
+			Email:    recipients[i].Email,
+			Quantity: order.Quantity,
+		}
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Comp tickets issued successfully",
+		"data":    responses,
+	})
+}
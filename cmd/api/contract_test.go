@@ -0,0 +1,197 @@
+package main
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"eventix-api/docs"
+	"eventix-api/internal/repositories/repotest"
+	"eventix-api/pkg/openapi"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// contractSpec is the generated Swagger document test responses are checked
+// against
+var contractSpec = sync.OnceValues(func() (*openapi.Spec, error) {
+	return openapi.Load([]byte(docs.SwaggerInfo.ReadDoc()))
+})
+
+// specParam matches Swagger path parameters such as {id}
+var specParam = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// observed records which documented statuses tests have seen for each
+// route, keyed by method and Swagger path
+var observed = struct {
+	sync.Mutex
+	statuses map[string]map[int]bool
+}{statuses: make(map[string]map[int]bool)}
+
+func observe(method, path string, status int) {
+	observed.Lock()
+	defer observed.Unlock()
+
+	key := method + " " + path
+	if observed.statuses[key] == nil {
+		observed.statuses[key] = make(map[int]bool)
+	}
+	observed.statuses[key][status] = true
+}
+
+// unobserved lists the documented statuses of every route that no test has
+// seen, as "METHOD /path status"
+func unobserved(spec *openapi.Spec) []string {
+	observed.Lock()
+	defer observed.Unlock()
+
+	var missing []string
+	for _, route := range spec.Routes() {
+		seen := observed.statuses[route.Method+" "+route.Path]
+		for code := range route.Responses {
+			status, err := strconv.Atoi(code)
+			if err == nil && !seen[status] {
+				missing = append(missing, route.Method+" "+route.Path+" "+code)
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// specRoute is a documented route with a pattern matching its request paths
+type specRoute struct {
+	openapi.Route
+	pattern *regexp.Regexp
+	params  int
+}
+
+// matchRoute finds the documented route serving a request path relative to
+// the base path. Literal segments win over parameters, so /events/featured
+// is not taken for /events/{id}.
+func matchRoute(routes []specRoute, method, path string) *specRoute {
+	var match *specRoute
+	for i := range routes {
+		route := &routes[i]
+		if route.Method != method || !route.pattern.MatchString(path) {
+			continue
+		}
+		if match == nil || route.params < match.params {
+			match = route
+		}
+	}
+	return match
+}
+
+// checkContract is a test version of middleware.ContractCheck: it fails t
+// for every response of an API route whose status is not documented or
+// whose JSON body departs from the documented schema. Unlike the middleware
+// it renders handler errors itself and finds the route from the request
+// path, so responses from errors and from group middleware are checked too.
+func checkContract(t *testing.T) fiber.Handler {
+	t.Helper()
+
+	spec, err := contractSpec()
+	if err != nil {
+		t.Fatalf("load Swagger docs: %v", err)
+	}
+	basePath := strings.TrimSuffix(spec.BasePath, "/")
+
+	var routes []specRoute
+	for _, route := range spec.Routes() {
+		segments := strings.Split(route.Path, "/")
+		params := 0
+		for i, segment := range segments {
+			if specParam.MatchString(segment) {
+				segments[i] = "[^/]+"
+				params++
+			} else {
+				segments[i] = regexp.QuoteMeta(segment)
+			}
+		}
+		routes = append(routes, specRoute{
+			Route:   route,
+			pattern: regexp.MustCompile("^" + strings.Join(segments, "/") + "/?$"),
+			params:  params,
+		})
+	}
+
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			if err := c.App().ErrorHandler(c, err); err != nil {
+				return err
+			}
+		}
+
+		path, ok := strings.CutPrefix(c.Path(), basePath)
+		if !ok {
+			return nil
+		}
+		route := matchRoute(routes, c.Method(), path)
+		if route == nil {
+			t.Errorf("%s %s is not documented", c.Method(), path)
+			return nil
+		}
+
+		status := c.Response().StatusCode()
+		observe(route.Method, route.Path, status)
+		if _, ok := route.Responses[strconv.Itoa(status)]; !ok {
+			t.Errorf("%s %s: status %d is not documented\n%s", route.Method, route.Path, status, c.Response().Body())
+			return nil
+		}
+		if !strings.HasPrefix(string(c.Response().Header.ContentType()), fiber.MIMEApplicationJSON) {
+			return nil
+		}
+		for _, violation := range spec.ValidateResponse(route.Operation, status, c.Response().Body()) {
+			t.Errorf("%s %s %d: %s\n%s", route.Method, route.Path, status, violation, c.Response().Body())
+		}
+		return nil
+	}
+}
+
+// expandPath fills in the parameters of a Swagger path from params, and any
+// others with fallback
+func expandPath(path string, params map[string]string, fallback string) string {
+	return specParam.ReplaceAllStringFunc(path, func(match string) string {
+		if value, ok := params[match[1:len(match)-1]]; ok {
+			return value
+		}
+		return fallback
+	})
+}
+
+// flaggedRoutes read a feature flag, from Redis and the database, before
+// they authenticate the caller
+var flaggedRoutes = map[string]bool{
+	"POST /events/{id}/queue":        true,
+	"GET /events/{id}/queue/{token}": true,
+}
+
+// TestContractRequiresAuth calls every secured route that documents a 401
+// without credentials. Each must turn the call away with that 401. Routes
+// such as /graphql, where credentials are optional, document no 401.
+func TestContractRequiresAuth(t *testing.T) {
+	spec, err := contractSpec()
+	if err != nil {
+		t.Fatalf("load Swagger docs: %v", err)
+	}
+	app := newRepoTestApp(t, repotest.New())
+
+	for _, route := range spec.Routes() {
+		if _, ok := route.Responses["401"]; !ok || len(route.Security) == 0 || flaggedRoutes[route.Method+" "+route.Path] {
+			continue
+		}
+		t.Run(route.Method+" "+route.Path, func(t *testing.T) {
+			path := "/api/v1" + expandPath(route.Path, nil, uuid.NewString())
+			resp := call(t, app, newRequest(t, route.Method, path, "", nil))
+			if resp.status != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d\n%s", resp.status, http.StatusUnauthorized, resp.body)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/middleware"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,omitempty"` // bearer mode; cookie mode sends the refresh cookie
+}
+
+// cookieMode reports whether a client asked for its refresh token in a
+// cookie, and cookie sessions are enabled
+func cookieMode(c *fiber.Ctx) bool {
+	cfg, _ := c.Locals("config").(*config.Config)
+	return cfg.Cookie.Enabled && c.Get(middleware.AuthModeHeader) == "cookie"
+}
+
+// tokenResponse shapes a new token pair for the client. With useCookie the
+// refresh token is set in the httpOnly refresh cookie rather than returned,
+// along with a new CSRF token.
+func tokenResponse(c *fiber.Ctx, pair *jwt.TokenPair, useCookie bool) (TokenResponse, error) {
+	response := TokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    pair.ExpiresAt - time.Now().Unix(),
+	}
+	if !useCookie {
+		return response, nil
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	csrfToken, err := setCSRFCookie(c, &cfg.Cookie, cfg.JWT.RefreshTokenExpiry)
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	c.Cookie(authCookie(&cfg.Cookie, middleware.RefreshCookie, pair.RefreshToken, cfg.JWT.RefreshTokenExpiry))
+
+	response.RefreshToken = ""
+	response.CSRFToken = csrfToken
+	return response, nil
+}
+
+// authCookie builds a session cookie. The refresh cookie is httpOnly and only
+// sent to the API; the CSRF cookie must be readable by the web app.
+func authCookie(cfg *config.CookieAuthConfig, name, value string, maxAge time.Duration) *fiber.Cookie {
+	cookie := &fiber.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Domain:   cfg.Domain,
+		Secure:   cfg.Secure,
+		SameSite: cfg.SameSite,
+		Expires:  time.Now().Add(maxAge),
+	}
+	if name == middleware.RefreshCookie {
+		cookie.Path = "/api"
+		cookie.HTTPOnly = true
+	}
+	if maxAge <= 0 {
+		cookie.Value = ""
+		cookie.Expires = time.Unix(0, 0)
+		cookie.MaxAge = -1
+	}
+	return cookie
+}
+
+func setCSRFCookie(c *fiber.Ctx, cfg *config.CookieAuthConfig, maxAge time.Duration) (string, error) {
+	token, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return "", err
+	}
+	c.Cookie(authCookie(cfg, middleware.CSRFCookie, token, maxAge))
+	return token, nil
+}
+
+func clearAuthCookies(c *fiber.Ctx, cfg *config.CookieAuthConfig) {
+	c.Cookie(authCookie(cfg, middleware.RefreshCookie, "", 0))
+	c.Cookie(authCookie(cfg, middleware.CSRFCookie, "", 0))
+}
+
+// COOKIE AUTH HANDLERS
+
+// GetCSRFTokenHandler godoc
+// @Summary Get a CSRF token
+// @Description Issue a double-submit CSRF token for cookie sessions, set in a readable cookie and returned. Send it in the X-CSRF-Token header on requests that carry the refresh cookie. Login and refresh in cookie mode issue one too.
+// @ID getCSRFToken
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} object{success=bool,data=object{csrf_token=string}}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /auth/csrf [get]
+func GetCSRFTokenHandler(c *fiber.Ctx) error {
+	cfg, _ := c.Locals("config").(*config.Config)
+	if !cfg.Cookie.Enabled {
+		return utils.NotFoundResponse(c, "Cookie sessions are not enabled")
+	}
+
+	token, err := setCSRFCookie(c, &cfg.Cookie, cfg.JWT.RefreshTokenExpiry)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to generate CSRF token")
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    fiber.Map{"csrf_token": token},
+	})
+}
+
+// LogoutHandler godoc
+// @Summary Log out
+// @Description End the session of a refresh token, from the request body or the refresh cookie, and clear the session cookies. Cookie sessions need the X-CSRF-Token header.
+// @ID logout
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body LogoutRequest false "Refresh token, in bearer mode"
+// @Param X-CSRF-Token header string false "CSRF token, in cookie mode"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /auth/logout [post]
+func LogoutHandler(c *fiber.Ctx) error {
+	var req LogoutRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	refreshToken := req.RefreshToken
+	if refreshToken == "" && cfg.Cookie.Enabled {
+		refreshToken = c.Cookies(middleware.RefreshCookie)
+	}
+	if cfg.Cookie.Enabled {
+		clearAuthCookies(c, &cfg.Cookie)
+	}
+
+	// Logging out twice, or with an expired token, still succeeds
+	if claims, err := jwt.ValidateRefreshToken(refreshToken); err == nil {
+		userID, _ := uuid.Parse(claims.UserID)
+		sessionID, _ := uuid.Parse(claims.SessionID)
+		services.NewSessionService(&cfg.JWT).Revoke(c.UserContext(), userID, sessionID)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Logged out",
+	})
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/currency"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CurrencyResponse struct {
+	Code      string                   `json:"code"`
+	Name      string                   `json:"name"`
+	Symbol    string                   `json:"symbol"`
+	Exponent  int                      `json:"exponent"`
+	Providers []models.PaymentProvider `json:"providers"`
+}
+
+// CURRENCY HANDLERS
+
+// ListCurrenciesHandler godoc
+// @Summary List supported currencies
+// @Description List the currencies events can be priced in and the payment providers that accept each one
+// @ID listCurrencies
+// @Tags Payments
+// @Produce json
+// @Success 200 {object} object{success=bool,data=[]CurrencyResponse}
+// @Router /currencies [get]
+func ListCurrenciesHandler(c *fiber.Ctx) error {
+	supported := currency.Supported()
+
+	currencies := make([]CurrencyResponse, len(supported))
+	for i, cur := range supported {
+		currencies[i] = CurrencyResponse{
+			Code:      cur.Code,
+			Name:      cur.Name,
+			Symbol:    cur.Symbol,
+			Exponent:  cur.Exponent,
+			Providers: services.ProvidersForCurrency(cur.Code),
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    currencies,
+	})
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const maxEvidenceSize = 10 * 1024 * 1024 // 10MB
+
+var evidenceContentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+type ResolveDisputeRequest struct {
+	Outcome    models.DisputeStatus `json:"outcome" validate:"required,oneof=won lost"`
+	Resolution string               `json:"resolution,omitempty" validate:"max=1000"`
+}
+
+type DisputeResponse struct {
+	ID                uuid.UUID              `json:"id"`
+	OrderID           uuid.UUID              `json:"order_id"`
+	PaymentID         uuid.UUID              `json:"payment_id"`
+	Provider          models.PaymentProvider `json:"provider"`
+	ProviderDisputeID string                 `json:"provider_dispute_id"`
+	Amount            int64                  `json:"amount"`
+	Currency          string                 `json:"currency"`
+	Reason            string                 `json:"reason,omitempty"`
+	Status            models.DisputeStatus   `json:"status"`
+	EvidenceDueBy     *time.Time             `json:"evidence_due_by,omitempty"`
+	Resolution        string                 `json:"resolution,omitempty"`
+	ResolvedBy        *uuid.UUID             `json:"resolved_by,omitempty"`
+	ResolvedAt        *time.Time             `json:"resolved_at,omitempty"`
+	Evidence          []EvidenceResponse     `json:"evidence,omitempty"`
+	CreatedAt         time.Time              `json:"created_at"`
+}
+
+type EvidenceResponse struct {
+	ID          uuid.UUID `json:"id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Note        string    `json:"note,omitempty"`
+	URL         string    `json:"url,omitempty"` // expires after 15 minutes
+	UploadedBy  uuid.UUID `json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func toDisputeResponse(dispute *models.Dispute) DisputeResponse {
+	return DisputeResponse{
+		ID:                dispute.ID,
+		OrderID:           dispute.OrderID,
+		PaymentID:         dispute.PaymentID,
+		Provider:          dispute.Provider,
+		ProviderDisputeID: dispute.ProviderDisputeID,
+		Amount:            dispute.Amount,
+		Currency:          dispute.Currency,
+		Reason:            dispute.Reason,
+		Status:            dispute.Status,
+		EvidenceDueBy:     dispute.EvidenceDueBy,
+		Resolution:        dispute.Resolution,
+		ResolvedBy:        dispute.ResolvedBy,
+		ResolvedAt:        dispute.ResolvedAt,
+		CreatedAt:         dispute.CreatedAt,
+	}
+}
+
+func toEvidenceResponse(ctx context.Context, evidence *models.DisputeEvidence) EvidenceResponse {
+	url, _ := storage.PresignGet(ctx, evidence.Key, 15*time.Minute)
+	return EvidenceResponse{
+		ID:          evidence.ID,
+		FileName:    evidence.FileName,
+		ContentType: evidence.ContentType,
+		Size:        evidence.Size,
+		Note:        evidence.Note,
+		URL:         url,
+		UploadedBy:  evidence.UploadedBy,
+		CreatedAt:   evidence.CreatedAt,
+	}
+}
+
+// DISPUTE HANDLERS
+
+// ListDisputesHandler godoc
+// @Summary List payment disputes
+// @Description List chargebacks raised against payments, newest first, by default those still open (Admin only)
+// @ID listDisputes
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Dispute status (open, won, lost)" default(open)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]DisputeResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/disputes [get]
+func ListDisputesHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit := queryLimit(c)
+	status := models.DisputeStatus(c.Query("status", string(models.DisputeOpen)))
+
+	if page < 1 {
+		page = 1
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	disputes, total, err := services.NewDisputeService(cfg).List(c.UserContext(), services.DisputeFilter{
+		Status: status,
+		Offset: (page - 1) * limit,
+		Limit:  limit,
+	})
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch disputes")
+	}
+
+	responses := make([]DisputeResponse, len(disputes))
+	for i := range disputes {
+		responses[i] = toDisputeResponse(&disputes[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// GetDisputeHandler godoc
+// @Summary Get a payment dispute
+// @Description Get a dispute with its evidence files and short-lived download links (Admin only)
+// @ID getDispute
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dispute ID"
+// @Success 200 {object} object{success=bool,data=DisputeResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/disputes/{id} [get]
+func GetDisputeHandler(c *fiber.Ctx) error {
+	disputeID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid dispute ID")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	dispute, err := services.NewDisputeService(cfg).Get(c.UserContext(), disputeID)
+	if err != nil {
+		return err
+	}
+
+	response := toDisputeResponse(dispute)
+	response.Evidence = make([]EvidenceResponse, len(dispute.Evidence))
+	for i := range dispute.Evidence {
+		response.Evidence[i] = toEvidenceResponse(c.Context(), &dispute.Evidence[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// UploadDisputeEvidenceHandler godoc
+// @Summary Upload dispute evidence
+// @Description Attach a receipt, check-in record or correspondence (PDF, JPEG or PNG, max 10MB) to an open dispute. Files are stored privately (Admin only)
+// @ID uploadDisputeEvidence
+// @Tags Admin
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dispute ID"
+// @Param file formData file true "Evidence file"
+// @Param note formData string false "What the file shows"
+// @Success 201 {object} object{success=bool,message=string,data=EvidenceResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/disputes/{id}/evidence [post]
+func UploadDisputeEvidenceHandler(c *fiber.Ctx) error {
+	disputeID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid dispute ID")
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return utils.BadRequestResponse(c, "Evidence file is required")
+	}
+
+	contentType, err := storage.DetectContentType(file, evidenceContentTypes, maxEvidenceSize)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return utils.BadRequestResponse(c, "Failed to read file")
+	}
+	defer f.Close()
+
+	key := storage.ObjectKey(fmt.Sprintf("disputes/%s/evidence", disputeID), file.Filename)
+	if err := storage.Upload(c.Context(), key, contentType, f, file.Size); err != nil {
+		logger.WithContext(c.UserContext()).Error("Failed to upload dispute evidence", zap.Error(err))
+		return utils.InternalServerErrorResponse(c, "Failed to upload evidence")
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditDisputeEvidenceAdded, models.AuditTargetDispute, disputeID)
+	evidence, err := services.NewDisputeService(cfg).AddEvidence(c.UserContext(), disputeID, adminID, services.DisputeEvidenceInput{
+		Key:         key,
+		FileName:    file.Filename,
+		ContentType: contentType,
+		Size:        file.Size,
+		Note:        c.FormValue("note"),
+	})
+	if err != nil {
+		_ = storage.Delete(context.Background(), key)
+		return err
+	}
+	audit.record(c)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Evidence uploaded successfully",
+		"data":    toEvidenceResponse(c.Context(), evidence),
+	})
+}
+
+// ResolveDisputeHandler godoc
+// @Summary Resolve a payment dispute
+// @Description Record the outcome of an open dispute. Won disputes unfreeze the order's tickets; lost ones refund the order, debit the organizer and put the tickets back on sale (Admin only)
+// @ID resolveDispute
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Dispute ID"
+// @Param request body ResolveDisputeRequest true "Outcome"
+// @Success 200 {object} object{success=bool,message=string,data=DisputeResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/disputes/{id}/resolve [post]
+func ResolveDisputeHandler(c *fiber.Ctx) error {
+	disputeID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid dispute ID")
+	}
+
+	var req ResolveDisputeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditDisputeResolved, models.AuditTargetDispute, disputeID)
+	dispute, err := services.NewDisputeService(cfg).Resolve(c.UserContext(), disputeID, adminID, req.Outcome, req.Resolution)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Dispute resolved successfully",
+		"data":    toDisputeResponse(dispute),
+	})
+}
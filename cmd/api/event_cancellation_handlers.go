@@ -0,0 +1,86 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CancelEventRequest struct {
+	Reason string `json:"reason,omitempty" validate:"omitempty,max=500"` // shown to ticket holders
+}
+
+type EventCancellationResponse struct {
+	Event            EventStatusResponse `json:"event"`
+	TicketsCancelled int64               `json:"tickets_cancelled"`
+	OrdersCancelled  int                 `json:"orders_cancelled"` // pending orders that can no longer be paid
+	RefundsQueued    int                 `json:"refunds_queued"`
+	HoldersNotified  int                 `json:"holders_notified"`
+}
+
+// EVENT CANCELLATION HANDLERS
+
+// CancelEventHandler godoc
+// @Summary Cancel an event
+// @Description Cancel an event: its tickets stop being valid, pending orders are cancelled, every paid order is queued for a full refund and ticket holders are emailed in the background. Repeating the call on a cancelled event re-queues any refunds that have not completed (Organizer/Admin only)
+// @ID cancelEvent
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body CancelEventRequest false "Cancellation reason"
+// @Success 200 {object} object{success=bool,message=string,data=EventCancellationResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/cancel [post]
+func CancelEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req CancelEventRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditEventCancelled, models.AuditTargetEvent, eventID)
+	result, err := services.NewEventCancellationService(cfg).Cancel(c.UserContext(), eventID, uid, req.Reason)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event cancelled",
+		"data": EventCancellationResponse{
+			Event:            toEventStatusResponse(result.Event),
+			TicketsCancelled: result.TicketsCancelled,
+			OrdersCancelled:  result.OrdersCancelled,
+			RefundsQueued:    result.RefundsQueued,
+			HoldersNotified:  result.HoldersNotified,
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RescheduleEventRequest struct {
+	StartTime        time.Time `json:"start_time" validate:"required"`
+	EndTime          time.Time `json:"end_time" validate:"required"`
+	Reason           string    `json:"reason,omitempty" validate:"omitempty,max=500"`                  // shown to ticket holders
+	RefundWindowDays int       `json:"refund_window_days,omitempty" validate:"omitempty,min=1,max=90"` // defaults to RESCHEDULE_REFUND_WINDOW
+}
+
+type EventRescheduleResponse struct {
+	ID                uuid.UUID `json:"id"`
+	EventID           uuid.UUID `json:"event_id"`
+	PreviousStartTime time.Time `json:"previous_start_time"`
+	PreviousEndTime   time.Time `json:"previous_end_time"`
+	StartTime         time.Time `json:"start_time"`
+	EndTime           time.Time `json:"end_time"`
+	Reason            string    `json:"reason,omitempty"`
+	RefundDeadline    time.Time `json:"refund_deadline"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+func toEventRescheduleResponse(reschedule *models.EventReschedule) EventRescheduleResponse {
+	return EventRescheduleResponse{
+		ID:                reschedule.ID,
+		EventID:           reschedule.EventID,
+		PreviousStartTime: reschedule.PreviousStartTime,
+		PreviousEndTime:   reschedule.PreviousEndTime,
+		StartTime:         reschedule.StartTime,
+		EndTime:           reschedule.EndTime,
+		Reason:            reschedule.Reason,
+		RefundDeadline:    reschedule.RefundDeadline,
+		CreatedAt:         reschedule.CreatedAt,
+	}
+}
+
+// EVENT RESCHEDULE HANDLERS
+
+// RescheduleEventHandler godoc
+// @Summary Reschedule an event
+// @Description Move an event and its sessions to new dates. Ticket holders are emailed, and buyers of existing orders can request a refund until the refund window closes, even if the refund policy would otherwise refuse it. The window never extends past the new start (Organizer/Admin only)
+// @ID rescheduleEvent
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body RescheduleEventRequest true "New dates"
+// @Success 200 {object} object{success=bool,message=string,data=EventRescheduleResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/reschedule [put]
+func RescheduleEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req RescheduleEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditEventRescheduled, models.AuditTargetEvent, eventID)
+	_, reschedule, err := services.NewEventRescheduleService(cfg).Reschedule(c.UserContext(), eventID, uid, services.RescheduleInput{
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Reason:       req.Reason,
+		RefundWindow: time.Duration(req.RefundWindowDays) * 24 * time.Hour,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event rescheduled",
+		"data":    toEventRescheduleResponse(reschedule),
+	})
+}
+
+// ListEventReschedulesHandler godoc
+// @Summary List an event's date changes
+// @Description List the times an event was rescheduled, newest first, with each change's refund deadline (Organizer/Admin only)
+// @ID listEventReschedules
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]EventRescheduleResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/reschedules [get]
+func ListEventReschedulesHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	reschedules, err := services.NewEventRescheduleService(cfg).History(c.UserContext(), eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch reschedules")
+	}
+
+	responses := make([]EventRescheduleResponse, len(reschedules))
+	for i := range reschedules {
+		responses[i] = toEventRescheduleResponse(&reschedules[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RejectEventRequest struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+type EventStatusResponse struct {
+	ID     uuid.UUID          `json:"id"`
+	Title  string             `json:"title"`
+	Status models.EventStatus `json:"status"`
+}
+
+// EVENT REVIEW HANDLERS
+
+// SubmitEventHandler godoc
+// @Summary Submit an event for review
+// @Description Move a draft event into the admin review queue (Organizer/Admin only)
+// @ID submitEvent
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,message=string,data=EventStatusResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/submit [post]
+func SubmitEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	audit := beginAudit(c, models.AuditEventSubmitted, models.AuditTargetEvent, eventID)
+	event, err := services.NewEventService().SubmitForReview(eventID, uid)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event submitted for review",
+		"data":    toEventStatusResponse(event),
+	})
+}
+
+// ApproveEventHandler godoc
+// @Summary Approve an event
+// @Description Publish an event that is under review (Admin only)
+// @ID approveEvent
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,message=string,data=EventStatusResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/events/{id}/approve [post]
+func ApproveEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	audit := beginAudit(c, models.AuditEventApproved, models.AuditTargetEvent, eventID)
+	event, err := services.NewEventService().Approve(eventID, adminID)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event approved and published",
+		"data":    toEventStatusResponse(event),
+	})
+}
+
+// RejectEventHandler godoc
+// @Summary Reject an event
+// @Description Return an event under review to draft with a reason (Admin only)
+// @ID rejectEvent
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body RejectEventRequest true "Rejection reason"
+// @Success 200 {object} object{success=bool,message=string,data=EventStatusResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/events/{id}/reject [post]
+func RejectEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req RejectEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	audit := beginAudit(c, models.AuditEventRejected, models.AuditTargetEvent, eventID)
+	event, err := services.NewEventService().Reject(eventID, adminID, req.Reason)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event rejected",
+		"data":    toEventStatusResponse(event),
+	})
+}
+
+func toEventStatusResponse(event *models.Event) EventStatusResponse {
+	return EventStatusResponse{
+		ID:     event.ID,
+		Title:  event.Title,
+		Status: event.Status,
+	}
+}
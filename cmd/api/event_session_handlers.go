@@ -0,0 +1,300 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateEventSessionRequest struct {
+	Title     string    `json:"title" validate:"required"`
+	StartTime time.Time `json:"start_time" validate:"required"`
+	EndTime   time.Time `json:"end_time" validate:"required"`
+	Capacity  int       `json:"capacity" validate:"min=0"` // 0 means no limit
+}
+
+type UpdateEventSessionRequest struct {
+	Title     *string    `json:"title,omitempty" validate:"omitnil,min=1"`
+	StartTime *time.Time `json:"start_time,omitempty"`
+	EndTime   *time.Time `json:"end_time,omitempty"`
+	Capacity  *int       `json:"capacity,omitempty" validate:"omitnil,min=0"`
+}
+
+type SetTierSessionsRequest struct {
+	SessionIDs []uuid.UUID `json:"session_ids"` // empty admits the tier to every session
+}
+
+type EventSessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Title     string    `json:"title"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Capacity  int       `json:"capacity"`
+}
+
+func toEventSessionResponse(session *models.EventSession) EventSessionResponse {
+	return EventSessionResponse{
+		ID:        session.ID,
+		Title:     session.Title,
+		StartTime: session.StartTime,
+		EndTime:   session.EndTime,
+		Capacity:  session.Capacity,
+	}
+}
+
+// EVENT SESSION HANDLERS
+
+// ListEventSessionsHandler godoc
+// @Summary List event sessions
+// @Description List the sessions of a multi-session event in schedule order
+// @ID listEventSessions
+// @Tags Events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]EventSessionResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/sessions [get]
+func ListEventSessionsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	sessions, err := services.NewEventSessionService().List(c.UserContext(), eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch sessions")
+	}
+
+	responses := make([]EventSessionResponse, len(sessions))
+	for i := range sessions {
+		responses[i] = toEventSessionResponse(&sessions[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// CreateEventSessionHandler godoc
+// @Summary Add an event session
+// @Description Add a session, such as a talk or festival day, that attendees check in to separately (Organizer/Admin only)
+// @ID createEventSession
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param session body CreateEventSessionRequest true "Session details"
+// @Success 201 {object} object{success=bool,message=string,data=EventSessionResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/sessions [post]
+func CreateEventSessionHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req CreateEventSessionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	session, err := services.NewEventSessionService().Create(c.UserContext(), eventID, services.EventSessionInput{
+		Title:     req.Title,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Capacity:  req.Capacity,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditSessionCreated, models.AuditTargetSession, session.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Session created successfully",
+		"data":    toEventSessionResponse(session),
+	})
+}
+
+// UpdateEventSessionHandler godoc
+// @Summary Update an event session
+// @Description Update a session's title, times or capacity (Organizer/Admin only)
+// @ID updateEventSession
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Param session body UpdateEventSessionRequest true "Fields to update"
+// @Success 200 {object} object{success=bool,message=string,data=EventSessionResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /event-sessions/{id} [put]
+func UpdateEventSessionHandler(c *fiber.Ctx) error {
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid session ID")
+	}
+
+	var req UpdateEventSessionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	sessionService := services.NewEventSessionService()
+
+	existing, err := sessionService.GetSession(c.UserContext(), sessionID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Session not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditSessionUpdated, models.AuditTargetSession, sessionID)
+	session, err := sessionService.Update(c.UserContext(), sessionID, services.EventSessionUpdate{
+		Title:     req.Title,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Capacity:  req.Capacity,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Session updated successfully",
+		"data":    toEventSessionResponse(session),
+	})
+}
+
+// DeleteEventSessionHandler godoc
+// @Summary Delete an event session
+// @Description Delete a session that no ticket tier is limited to (Organizer/Admin only)
+// @ID deleteEventSession
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /event-sessions/{id} [delete]
+func DeleteEventSessionHandler(c *fiber.Ctx) error {
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid session ID")
+	}
+
+	sessionService := services.NewEventSessionService()
+
+	session, err := sessionService.GetSession(c.UserContext(), sessionID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Session not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(session.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditSessionDeleted, models.AuditTargetSession, sessionID)
+	if err := sessionService.Delete(c.UserContext(), sessionID); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Session deleted successfully",
+	})
+}
+
+// SetTierSessionsHandler godoc
+// @Summary Set the sessions a ticket tier admits to
+// @Description Limit a tier's tickets to some sessions of its event. An empty list makes them valid for every session (Organizer/Admin only)
+// @ID setTierSessions
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tier ID"
+// @Param request body SetTierSessionsRequest true "Session IDs"
+// @Success 200 {object} object{success=bool,message=string,data=TicketTierResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tiers/{id}/sessions [put]
+func SetTierSessionsHandler(c *fiber.Ctx) error {
+	tierID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid tier ID")
+	}
+
+	var req SetTierSessionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	existing, err := services.NewTierService().GetTier(c.UserContext(), tierID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Ticket tier not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditTierUpdated, models.AuditTargetTier, tierID)
+	tier, err := services.NewEventSessionService().SetTierSessions(c.UserContext(), tierID, req.SessionIDs)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Ticket tier sessions updated successfully",
+		"data":    toTicketTierResponse(tier),
+	})
+}
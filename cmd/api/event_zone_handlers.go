@@ -0,0 +1,281 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type EventZoneRequest struct {
+	Name         string `json:"name" validate:"required,max=100"` // e.g. "VIP lounge"
+	MaxOccupancy int    `json:"max_occupancy" validate:"min=0"`   // 0 means no limit
+}
+
+type SetTierZonesRequest struct {
+	ZoneIDs []uuid.UUID `json:"zone_ids"` // empty limits the tier to the main gate
+}
+
+type EventZoneResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	MaxOccupancy int       `json:"max_occupancy"`
+}
+
+func toEventZoneResponse(zone *models.EventZone) EventZoneResponse {
+	return EventZoneResponse{
+		ID:           zone.ID,
+		Name:         zone.Name,
+		MaxOccupancy: zone.MaxOccupancy,
+	}
+}
+
+// EVENT ZONE HANDLERS
+
+// ListEventZonesHandler godoc
+// @Summary List event zones
+// @Description List the restricted areas of an event's venue, such as a VIP lounge or backstage
+// @ID listEventZones
+// @Tags Events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]EventZoneResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/zones [get]
+func ListEventZonesHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	zones, err := services.NewEventZoneService().List(c.UserContext(), eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch zones")
+	}
+
+	responses := make([]EventZoneResponse, len(zones))
+	for i := range zones {
+		responses[i] = toEventZoneResponse(&zones[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// CreateEventZoneHandler godoc
+// @Summary Add an event zone
+// @Description Add a restricted area with its own entrance. Only tickets whose tier grants the zone are let in there, and no more than max_occupancy at once when set (Organizer/Admin only)
+// @ID createEventZone
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param zone body EventZoneRequest true "Zone details"
+// @Success 201 {object} object{success=bool,message=string,data=EventZoneResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/zones [post]
+func CreateEventZoneHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req EventZoneRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	zone, err := services.NewEventZoneService().Create(c.UserContext(), eventID, services.EventZoneInput{
+		Name:         req.Name,
+		MaxOccupancy: req.MaxOccupancy,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditZoneCreated, models.AuditTargetZone, zone.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Zone created successfully",
+		"data":    toEventZoneResponse(zone),
+	})
+}
+
+// UpdateEventZoneHandler godoc
+// @Summary Update an event zone
+// @Description Change the name or occupancy limit of a zone (Organizer/Admin only)
+// @ID updateEventZone
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Zone ID"
+// @Param zone body EventZoneRequest true "Zone details"
+// @Success 200 {object} object{success=bool,message=string,data=EventZoneResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /event-zones/{id} [put]
+func UpdateEventZoneHandler(c *fiber.Ctx) error {
+	zoneID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid zone ID")
+	}
+
+	var req EventZoneRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	zoneService := services.NewEventZoneService()
+
+	existing, err := zoneService.GetZone(c.UserContext(), zoneID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Zone not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditZoneUpdated, models.AuditTargetZone, zoneID)
+	zone, err := zoneService.Update(c.UserContext(), zoneID, services.EventZoneInput{
+		Name:         req.Name,
+		MaxOccupancy: req.MaxOccupancy,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Zone updated successfully",
+		"data":    toEventZoneResponse(zone),
+	})
+}
+
+// DeleteEventZoneHandler godoc
+// @Summary Delete an event zone
+// @Description Delete a zone that no ticket tier grants (Organizer/Admin only)
+// @ID deleteEventZone
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Zone ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /event-zones/{id} [delete]
+func DeleteEventZoneHandler(c *fiber.Ctx) error {
+	zoneID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid zone ID")
+	}
+
+	zoneService := services.NewEventZoneService()
+
+	zone, err := zoneService.GetZone(c.UserContext(), zoneID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Zone not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(zone.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditZoneDeleted, models.AuditTargetZone, zoneID)
+	if err := zoneService.Delete(c.UserContext(), zoneID); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Zone deleted successfully",
+	})
+}
+
+// SetTierZonesHandler godoc
+// @Summary Set the zones a ticket tier admits to
+// @Description Let a tier's tickets into some zones of its event besides the main gate. An empty list limits them to the main gate (Organizer/Admin only)
+// @ID setTierZones
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tier ID"
+// @Param request body SetTierZonesRequest true "Zone IDs"
+// @Success 200 {object} object{success=bool,message=string,data=TicketTierResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tiers/{id}/zones [put]
+func SetTierZonesHandler(c *fiber.Ctx) error {
+	tierID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid tier ID")
+	}
+
+	var req SetTierZonesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	existing, err := services.NewTierService().GetTier(c.UserContext(), tierID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Ticket tier not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditTierUpdated, models.AuditTargetTier, tierID)
+	tier, err := services.NewEventZoneService().SetTierZones(c.UserContext(), tierID, req.ZoneIDs)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Ticket tier zones updated successfully",
+		"data":    toTicketTierResponse(tier),
+	})
+}
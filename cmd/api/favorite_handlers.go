@@ -0,0 +1,128 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type FavoriteResponse struct {
+	Event   EventResponse `json:"event"`
+	SavedAt time.Time     `json:"saved_at"`
+}
+
+// FAVORITE HANDLERS
+
+// FavoriteEventHandler godoc
+// @Summary Save an event
+// @Description Add an event to the authenticated user's saved events. The user is emailed when a saved event is published and when its tickets go on sale. Saving an event twice has no effect
+// @ID favoriteEvent
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/favorite [post]
+func FavoriteEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	if _, err := services.NewFavoriteService().Add(c.UserContext(), uid, eventID); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event saved",
+	})
+}
+
+// UnfavoriteEventHandler godoc
+// @Summary Remove a saved event
+// @Description Remove an event from the authenticated user's saved events
+// @ID unfavoriteEvent
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/favorite [delete]
+func UnfavoriteEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	if err := services.NewFavoriteService().Remove(c.UserContext(), uid, eventID); err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to remove saved event")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event removed from saved events",
+	})
+}
+
+// GetMyFavoritesHandler godoc
+// @Summary List saved events
+// @Description List the authenticated user's saved events, most recently saved first
+// @ID getMyFavorites
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} object{success=bool,data=[]FavoriteResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /users/me/favorites [get]
+func GetMyFavoritesHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "20"))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	favorites, total, err := services.NewFavoriteService().List(c.UserContext(), uid, page, limit)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch saved events")
+	}
+
+	responses := make([]FavoriteResponse, len(favorites))
+	for i := range favorites {
+		responses[i] = FavoriteResponse{
+			Event:   toEventResponse(&favorites[i].Event),
+			SavedAt: favorites[i].CreatedAt,
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    favoriteMapper.all(c, responses),
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateFeatureFlagRequest struct {
+	Enabled     *bool  `json:"enabled" validate:"required"`
+	Description string `json:"description,omitempty" validate:"max=255"`
+}
+
+// requireFeature answers 404 while a feature flag is off, as if the route
+// did not exist
+func requireFeature(key string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !services.NewFeatureFlagService().IsEnabled(c.UserContext(), key) {
+			return utils.NotFoundResponse(c, "This feature is not available")
+		}
+		return c.Next()
+	}
+}
+
+// FEATURE FLAG HANDLERS
+
+// ListFeatureFlagsHandler godoc
+// @Summary List feature flags
+// @Description List every feature flag with its state, including known flags still at their default (Admin only)
+// @ID listFeatureFlags
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]models.FeatureFlag}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/feature-flags [get]
+func ListFeatureFlagsHandler(c *fiber.Ctx) error {
+	flags, err := services.NewFeatureFlagService().List(c.UserContext())
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch feature flags")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    flags,
+	})
+}
+
+// UpdateFeatureFlagHandler godoc
+// @Summary Update a feature flag
+// @Description Turn a feature flag on or off, creating it if needed. Every replica sees the change within a minute at most (Admin only)
+// @ID updateFeatureFlag
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param key path string true "Flag key, e.g. guest_checkout"
+// @Param request body UpdateFeatureFlagRequest true "Flag state"
+// @Success 200 {object} object{success=bool,message=string,data=models.FeatureFlag}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/feature-flags/{key} [put]
+func UpdateFeatureFlagHandler(c *fiber.Ctx) error {
+	key := c.Params("key")
+	if !isFeatureFlagKey(key) {
+		return utils.BadRequestResponse(c, "Flag keys are up to 100 lowercase letters, digits and underscores")
+	}
+
+	var req UpdateFeatureFlagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	flagService := services.NewFeatureFlagService()
+
+	existing, err := flagService.Find(c.UserContext(), key)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch feature flag")
+	}
+	var audit *pendingAudit
+	if existing != nil {
+		audit = beginAudit(c, models.AuditFeatureFlagUpdated, models.AuditTargetFeatureFlag, existing.ID)
+	}
+
+	flag, err := flagService.Set(c.UserContext(), key, *req.Enabled, req.Description, adminID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to update feature flag")
+	}
+	if audit != nil {
+		audit.record(c)
+	} else {
+		recordCreated(c, models.AuditFeatureFlagUpdated, models.AuditTargetFeatureFlag, flag.ID)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Feature flag updated successfully",
+		"data":    flag,
+	})
+}
+
+func isFeatureFlagKey(key string) bool {
+	if key == "" || len(key) > 100 {
+		return false
+	}
+	for _, r := range key {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateEventFeaturedRequest struct {
+	Featured bool `json:"featured"`
+}
+
+type EventFeaturedResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Title      string    `json:"title"`
+	IsFeatured bool      `json:"is_featured"`
+}
+
+// FEATURED HANDLERS
+
+// GetFeaturedEventsHandler godoc
+// @Summary Featured events
+// @Description Upcoming published events picked by the Eventix team, soonest first
+// @ID getFeaturedEvents
+// @Tags Events
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]EventResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/featured [get]
+func GetFeaturedEventsHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		page, _ := strconv.Atoi(c.Query("page", "1"))
+		limit := queryLimit(c)
+
+		if page < 1 {
+			page = 1
+		}
+
+		filter := repositories.EventFilter{
+			Status:   string(models.EventPublished),
+			Featured: true,
+			Offset:   (page - 1) * limit,
+			Limit:    limit,
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		key := cacheKey(c, fmt.Sprintf("featured:%d:%d", page, limit))
+		body, err := services.NewEventCache(&cfg.Limits).Fetch(c.UserContext(), key, func() ([]byte, error) {
+			events, total, err := repos.Events.List(c.UserContext(), filter)
+			if err != nil {
+				return nil, err
+			}
+
+			return json.Marshal(fiber.Map{
+				"success": true,
+				"data":    eventMapper.all(c, toEventResponses(events)),
+				"pagination": fiber.Map{
+					"page":  page,
+					"limit": limit,
+					"total": total,
+				},
+			})
+		})
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to fetch featured events")
+		}
+
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Send(body)
+	}
+}
+
+// GetTrendingEventsHandler godoc
+// @Summary Trending events
+// @Description Upcoming published events ranked by tickets sold over the last day, with recent sales weighing most
+// @ID getTrendingEvents
+// @Tags Events
+// @Produce json
+// @Param limit query int false "Number of events" default(10)
+// @Success 200 {object} object{success=bool,data=[]EventResponse}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/trending [get]
+func GetTrendingEventsHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		limit := queryLimit(c)
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		key := cacheKey(c, fmt.Sprintf("trending:%d", limit))
+		body, err := services.NewEventCache(&cfg.Limits).Fetch(c.UserContext(), key, func() ([]byte, error) {
+			// Ranked events may have started or been unpublished since they
+			// sold, so more are fetched than are shown
+			ids, err := services.TrendingEventIDs(c.UserContext(), limit*3)
+			if err != nil {
+				return nil, err
+			}
+
+			events, err := repos.Events.ListUpcoming(c.UserContext(), ids)
+			if err != nil {
+				return nil, err
+			}
+			if len(events) > limit {
+				events = events[:limit]
+			}
+
+			return json.Marshal(fiber.Map{
+				"success": true,
+				"data":    eventMapper.all(c, toEventResponses(events)),
+			})
+		})
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to fetch trending events")
+		}
+
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Send(body)
+	}
+}
+
+// UpdateEventFeaturedHandler godoc
+// @Summary Feature an event
+// @Description Feature a published event on GET /events/featured or stop featuring it (Admin only)
+// @ID updateEventFeatured
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body UpdateEventFeaturedRequest true "Featured flag"
+// @Success 200 {object} object{success=bool,message=string,data=EventFeaturedResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/events/{id}/featured [put]
+func UpdateEventFeaturedHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req UpdateEventFeaturedRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	audit := beginAudit(c, models.AuditEventFeaturedUpdated, models.AuditTargetEvent, eventID)
+	event, err := services.NewEventService().SetFeatured(c.UserContext(), eventID, req.Featured)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	message := "Event is no longer featured"
+	if event.IsFeatured {
+		message = "Event featured"
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": message,
+		"data": EventFeaturedResponse{
+			ID:         event.ID,
+			Title:      event.Title,
+			IsFeatured: event.IsFeatured,
+		},
+	})
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateFormFieldRequest struct {
+	Label    string   `json:"label" validate:"required"`
+	Type     string   `json:"type" validate:"required,oneof=text number select checkbox"`
+	Options  []string `json:"options,omitempty"` // choices of a select field
+	Required bool     `json:"required"`
+	Position int      `json:"position"`
+}
+
+type UpdateFormFieldRequest struct {
+	Label    *string  `json:"label,omitempty" validate:"omitnil,min=1"`
+	Type     *string  `json:"type,omitempty" validate:"omitnil,oneof=text number select checkbox"`
+	Options  []string `json:"options,omitempty"`
+	Required *bool    `json:"required,omitempty"`
+	Position *int     `json:"position,omitempty"`
+}
+
+type FormFieldResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Label    string    `json:"label"`
+	Type     string    `json:"type"`
+	Options  []string  `json:"options,omitempty"`
+	Required bool      `json:"required"`
+	Position int       `json:"position"`
+}
+
+func toFormFieldResponse(field *models.FormField) FormFieldResponse {
+	return FormFieldResponse{
+		ID:       field.ID,
+		Label:    field.Label,
+		Type:     string(field.Type),
+		Options:  field.Options,
+		Required: field.Required,
+		Position: field.Position,
+	}
+}
+
+// FORM FIELD HANDLERS
+
+// ListFormFieldsHandler godoc
+// @Summary List an event's registration questions
+// @Description List the custom questions buyers answer for each ticket when ordering, in display order
+// @ID listFormFields
+// @Tags Events
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]FormFieldResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/form-fields [get]
+func ListFormFieldsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	fields, err := services.NewFormService().List(c.UserContext(), eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch form fields")
+	}
+
+	responses := make([]FormFieldResponse, len(fields))
+	for i := range fields {
+		responses[i] = toFormFieldResponse(&fields[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// CreateFormFieldHandler godoc
+// @Summary Add a registration question
+// @Description Add a custom question, such as a t-shirt size or dietary needs, that buyers answer for each ticket (Organizer/Admin only)
+// @ID createFormField
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param field body CreateFormFieldRequest true "Question details"
+// @Success 201 {object} object{success=bool,message=string,data=FormFieldResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/form-fields [post]
+func CreateFormFieldHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req CreateFormFieldRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	field, err := services.NewFormService().Create(c.UserContext(), eventID, services.FormFieldInput{
+		Label:    req.Label,
+		Type:     models.FormFieldType(req.Type),
+		Options:  req.Options,
+		Required: req.Required,
+		Position: req.Position,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditFormFieldCreated, models.AuditTargetFormField, field.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Form field created successfully",
+		"data":    toFormFieldResponse(field),
+	})
+}
+
+// UpdateFormFieldHandler godoc
+// @Summary Update a registration question
+// @Description Update a question's label, type, options, order or whether it is required. Answers already given are kept (Organizer/Admin only)
+// @ID updateFormField
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Form field ID"
+// @Param field body UpdateFormFieldRequest true "Fields to update"
+// @Success 200 {object} object{success=bool,message=string,data=FormFieldResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /form-fields/{id} [put]
+func UpdateFormFieldHandler(c *fiber.Ctx) error {
+	fieldID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid form field ID")
+	}
+
+	var req UpdateFormFieldRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	formService := services.NewFormService()
+
+	existing, err := formService.GetField(c.UserContext(), fieldID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Form field not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	update := services.FormFieldUpdate{
+		Label:    req.Label,
+		Options:  req.Options,
+		Required: req.Required,
+		Position: req.Position,
+	}
+	if req.Type != nil {
+		fieldType := models.FormFieldType(*req.Type)
+		update.Type = &fieldType
+	}
+
+	audit := beginAudit(c, models.AuditFormFieldUpdated, models.AuditTargetFormField, fieldID)
+	field, err := formService.Update(c.UserContext(), fieldID, update)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Form field updated successfully",
+		"data":    toFormFieldResponse(field),
+	})
+}
+
+// DeleteFormFieldHandler godoc
+// @Summary Delete a registration question
+// @Description Stop asking a question. It also drops out of the attendee export (Organizer/Admin only)
+// @ID deleteFormField
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Form field ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /form-fields/{id} [delete]
+func DeleteFormFieldHandler(c *fiber.Ctx) error {
+	fieldID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid form field ID")
+	}
+
+	formService := services.NewFormService()
+
+	field, err := formService.GetField(c.UserContext(), fieldID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Form field not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(field.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditFormFieldDeleted, models.AuditTargetFormField, fieldID)
+	if err := formService.Delete(c.UserContext(), fieldID); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Form field deleted successfully",
+	})
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"net/http"
+
+	"eventix-api/internal/graph"
+	"eventix-api/pkg/database"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/google/uuid"
+)
+
+// graphqlResponseWriter writes a net/http handler's response to fiber
+type graphqlResponseWriter struct {
+	c           *fiber.Ctx
+	header      http.Header
+	wroteHeader bool
+}
+
+func (w *graphqlResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *graphqlResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	for key, values := range w.header {
+		for _, value := range values {
+			w.c.Response().Header.Add(key, value)
+		}
+	}
+	w.c.Status(status)
+}
+
+func (w *graphqlResponseWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	w.c.Response().AppendBody(data)
+	return len(data), nil
+}
+
+// GRAPHQL HANDLERS
+
+// GraphQLHandler godoc
+// @Summary Query the GraphQL API
+// @Description Run a GraphQL query over events, ticket tiers, organizers and, when authenticated, the caller's tickets and orders. Relationships are batched, so an event with its tiers and organizer is one round trip. See the schema at internal/graph/schema.graphqls or introspect it
+// @Tags GraphQL
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param query query string false "Query, for GET requests"
+// @Success 200 {object} object{data=object,errors=[]object{message=string,path=[]string,extensions=object{code=string}}}
+// @Failure 400 {object} object{data=object,errors=[]object{message=string}}
+// @Failure 422 {object} object{data=object,errors=[]object{message=string,extensions=object{code=string}}}
+// @Router /graphql [get]
+// @Router /graphql [post]
+func GraphQLHandler() fiber.Handler {
+	server := graph.NewHandler(database.DB)
+
+	return func(c *fiber.Ctx) error {
+		req, err := adaptor.ConvertRequest(c, true)
+		if err != nil {
+			return err
+		}
+
+		// Loaders cache for the life of one request only
+		ctx := graph.WithLoaders(c.UserContext(), graph.NewLoaders(database.DB))
+		if userID, ok := c.Locals("user_id").(string); ok {
+			uid, _ := uuid.Parse(userID)
+			role, _ := c.Locals("role").(string)
+			ctx = graph.WithViewer(ctx, graph.Viewer{UserID: uid, Role: role})
+		}
+
+		server.ServeHTTP(&graphqlResponseWriter{c: c, header: http.Header{}}, req.WithContext(ctx))
+		return nil
+	}
+}
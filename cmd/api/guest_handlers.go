@@ -0,0 +1,178 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type GuestCheckoutRequest struct {
+	Email     string `json:"email" validate:"required,email"`
+	FirstName string `json:"first_name" validate:"required"`
+	LastName  string `json:"last_name" validate:"required"`
+	Phone     string `json:"phone,omitempty" validate:"omitempty,e164"`
+}
+
+type ClaimAccountRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+// GUEST HANDLERS
+
+// GuestCheckoutHandler godoc
+// @Summary Start a guest checkout
+// @Description Get tokens to reserve and pay for tickets with just an email. Guest tokens are only accepted by the reservation, order, payment and waiting room endpoints; tickets are emailed with a link to claim the account.
+// @ID guestCheckout
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body GuestCheckoutRequest true "Buyer details"
+// @Success 200 {object} object{success=bool,data=TokenResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /auth/guest [post]
+func GuestCheckoutHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req GuestCheckoutRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+
+		req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+
+		// Returning guests keep buying on the same account; the details they
+		// gave first are kept, since anyone can start a checkout for an email
+		user, err := repos.Users.FindByEmail(c.UserContext(), req.Email)
+		if err == nil {
+			if user.Role != models.RoleGuest {
+				return utils.ConflictResponse(c, "An account with this email already exists. Please log in to check out.")
+			}
+			if !user.IsActive {
+				return utils.UnauthorizedResponse(c, "Account is deactivated")
+			}
+		} else {
+			user = &models.User{
+				Email:     req.Email,
+				FirstName: req.FirstName,
+				LastName:  req.LastName,
+				Phone:     req.Phone,
+				Role:      models.RoleGuest,
+				IsActive:  true,
+			}
+			if err := repos.Users.Create(c.UserContext(), user); err != nil {
+				return utils.InternalServerErrorResponse(c, "Failed to start checkout")
+			}
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		tokenPair, err := services.NewSessionService(&cfg.JWT).Start(c.UserContext(), user, c.Get(fiber.HeaderUserAgent), c.IP())
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+		response, err := tokenResponse(c, tokenPair, cookieMode(c))
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    response,
+		})
+	}
+}
+
+// ClaimAccountHandler godoc
+// @Summary Claim a guest account
+// @Description Upgrade a guest to a full account by setting a password, using the claim link from an order confirmation email. Tickets bought as a guest stay with the account.
+// @ID claimAccount
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body ClaimAccountRequest true "Claim token and new password"
+// @Success 200 {object} object{success=bool,message=string,data=TokenResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /auth/claim-account [post]
+func ClaimAccountHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req ClaimAccountRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+
+		if !utils.IsValidPassword(req.Password) {
+			return utils.BadRequestResponse(c, "Password must be at least 8 characters with uppercase, lowercase, and number")
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+
+		userID, err := services.NewEmailService(&cfg.Email).VerifyAccountClaimToken(req.Token)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		user, err := repos.Users.FindByID(c.UserContext(), userID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+		if user.Role != models.RoleGuest {
+			return utils.BadRequestResponse(c, "Account has already been claimed")
+		}
+
+		hashedPassword, err := utils.HashPassword(req.Password)
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to claim account")
+		}
+
+		// The claim link was emailed, so following it verifies the address
+		changedAt := time.Now().Truncate(time.Second)
+		user.PasswordHash = hashedPassword
+		user.PasswordChangedAt = &changedAt
+		user.Role = models.RoleAttendee
+		user.EmailVerified = true
+		if err := repos.Users.Update(c.UserContext(), user); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to claim account")
+		}
+
+		// Guest checkout tokens carry the guest role, so sign them all out
+		sessionService := services.NewSessionService(&cfg.JWT)
+		if err := sessionService.RevokeAll(c.UserContext(), user.ID); err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to revoke guest sessions", zap.Error(err))
+		}
+
+		tokenPair, err := sessionService.Start(c.UserContext(), user, c.Get(fiber.HeaderUserAgent), c.IP())
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+		response, err := tokenResponse(c, tokenPair, cookieMode(c))
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Account claimed successfully",
+			"data":    response,
+		})
+	}
+}
@@ -1,22 +1,29 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
 	"eventix-api/internal/services"
+	"eventix-api/pkg/cache"
 	"eventix-api/pkg/config"
+	"eventix-api/pkg/currency"
 	"eventix-api/pkg/database"
 	"eventix-api/pkg/jwt"
 	"eventix-api/pkg/logger"
+	"eventix-api/pkg/middleware"
+	"eventix-api/pkg/ticketqr"
 	"eventix-api/pkg/utils"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"gorm.io/gorm"
 )
 
 // REQUEST/RESPONSE DTOs
@@ -26,7 +33,18 @@ type RegisterRequest struct {
 	Password  string `json:"password" validate:"required,min=8"`
 	FirstName string `json:"first_name" validate:"required"`
 	LastName  string `json:"last_name" validate:"required"`
-	Phone     string `json:"phone,omitempty"`
+	Phone     string `json:"phone,omitempty" validate:"omitempty,e164"`
+}
+
+type UpdateProfileRequest struct {
+	FirstName *string `json:"first_name,omitempty" validate:"omitnil,min=1,max=100"`
+	LastName  *string `json:"last_name,omitempty" validate:"omitnil,min=1,max=100"`
+	Phone     *string `json:"phone,omitempty" validate:"omitnil,e164|eq="`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=8"`
 }
 
 type LoginRequest struct {
@@ -42,6 +60,15 @@ type VerifyEmailRequest struct {
 	Token string `json:"token" validate:"required"`
 }
 
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
 type CreateEventRequest struct {
 	Title        string          `json:"title" validate:"required"`
 	Description  string          `json:"description" validate:"required"`
@@ -49,29 +76,51 @@ type CreateEventRequest struct {
 	Location     string          `json:"location" validate:"required"`
 	StartTime    time.Time       `json:"start_time" validate:"required"`
 	EndTime      time.Time       `json:"end_time" validate:"required"`
-	MaxAttendees int             `json:"max_attendees" validate:"required"`
-	TicketTiers  []TicketTierReq `json:"ticket_tiers" validate:"required,min=1"`
+	MaxAttendees int             `json:"max_attendees" validate:"omitempty,min=1"`
+	Currency     string          `json:"currency,omitempty" validate:"omitempty,currency"`
+	TicketTiers  []TicketTierReq `json:"ticket_tiers" validate:"required,min=1,dive"`
+}
+
+type DuplicateEventRequest struct {
+	Title     string     `json:"title,omitempty"`
+	StartTime time.Time  `json:"start_time" validate:"required"`
+	EndTime   *time.Time `json:"end_time,omitempty"` // defaults to the original event's duration
 }
 
 type TicketTierReq struct {
-	Name        string  `json:"name" validate:"required"`
-	Description string  `json:"description"`
-	Price       float64 `json:"price" validate:"required,min=0"`
-	Quantity    int     `json:"quantity" validate:"required,min=1"`
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description"`
+	Price       int64  `json:"price" validate:"min=0"` // minor units, e.g. cents
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
 }
 
 type ReserveTicketRequest struct {
-	TierID   string `json:"tier_id" validate:"required"`
-	Quantity int    `json:"quantity" validate:"required,min=1,max=10"`
+	TierID     string `json:"tier_id" validate:"required"`
+	Quantity   int    `json:"quantity" validate:"required,min=1,max=10"`
+	QueueToken string `json:"queue_token,omitempty"` // required when the event has a waiting room
 }
 
 type CreateOrderRequest struct {
 	ReservationID string `json:"reservation_id" validate:"required"`
+	Provider      string `json:"provider,omitempty" validate:"omitempty,oneof=paystack stripe"`
+	// Registration form answers keyed by form field ID, once per ticket or
+	// once for the whole order
+	Answers []models.AnswerSet `json:"answers,omitempty"`
+	// Extras such as parking or merchandise (see GET /events/{id}/add-ons)
+	AddOns []OrderAddOnRequest `json:"add_ons,omitempty" validate:"omitempty,max=20,dive"`
+}
+
+type OrderAddOnRequest struct {
+	AddOnID  string `json:"add_on_id" validate:"required,uuid"`
+	Quantity int    `json:"quantity" validate:"required,min=1,max=20"`
 }
 
 type ValidateQRRequest struct {
-	QRCode  string `json:"qr_code" validate:"required"`
-	EventID string `json:"event_id" validate:"required"`
+	QRCode    string `json:"qr_code" validate:"required"`
+	EventID   string `json:"event_id" validate:"required"`
+	SessionID string `json:"session_id,omitempty"`                // check in to one session of a multi-session event
+	ZoneID    string `json:"zone_id,omitempty"`                   // scanning at the entrance of a zone, e.g. the VIP area
+	Device    string `json:"device,omitempty" validate:"max=100"` // shown when the ticket is scanned again
 }
 
 type UserResponse struct {
@@ -87,35 +136,62 @@ type UserResponse struct {
 
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	RefreshToken string `json:"refresh_token,omitempty"` // omitted in cookie mode, where it is set in the refresh cookie
+	CSRFToken    string `json:"csrf_token,omitempty"`    // cookie mode only
 	TokenType    string `json:"token_type"`
 	ExpiresIn    int64  `json:"expires_in"`
 }
 
 type EventResponse struct {
-	ID           uuid.UUID            `json:"id"`
-	Title        string               `json:"title"`
-	Description  string               `json:"description"`
-	Category     models.EventCategory `json:"category"`
-	Location     string               `json:"location"`
-	StartTime    time.Time            `json:"start_time"`
-	EndTime      time.Time            `json:"end_time"`
-	Status       models.EventStatus   `json:"status"`
-	MaxAttendees int                  `json:"max_attendees"`
-	OrganizerID  uuid.UUID            `json:"organizer_id"`
-	TicketsSold  int                  `json:"tickets_sold"`
-	TicketTiers  []TicketTierResponse `json:"ticket_tiers,omitempty"`
-	CreatedAt    time.Time            `json:"created_at"`
+	ID           uuid.UUID              `json:"id"`
+	Title        string                 `json:"title"`
+	Slug         string                 `json:"slug"`
+	Description  string                 `json:"description"`
+	Category     string                 `json:"category"`
+	Location     string                 `json:"location"`
+	StartTime    time.Time              `json:"start_time"`
+	EndTime      time.Time              `json:"end_time"`
+	BannerURL    string                 `json:"banner_url,omitempty"`
+	Status       models.EventStatus     `json:"status"`
+	Currency     string                 `json:"currency"`
+	IsFeatured   bool                   `json:"is_featured"`
+	WaitingRoom  bool                   `json:"waiting_room"`
+	AllowReentry bool                   `json:"allow_reentry"`
+	MaxOccupancy int                    `json:"max_occupancy"`
+	MaxAttendees int                    `json:"max_attendees"`
+	OrganizerID  uuid.UUID              `json:"organizer_id"`
+	TicketsSold  int                    `json:"tickets_sold"`
+	RefundPolicy RefundPolicyResponse   `json:"refund_policy"`
+	TicketTiers  []TicketTierResponse   `json:"ticket_tiers,omitempty"`
+	Sessions     []EventSessionResponse `json:"sessions,omitempty"`
+	Zones        []EventZoneResponse    `json:"zones,omitempty"`
+	CreatedAt    time.Time              `json:"created_at"`
 }
 
 type TicketTierResponse struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	Price       float64   `json:"price"`
-	Quantity    int       `json:"quantity"`
-	Sold        int       `json:"sold"`
-	Available   int       `json:"available"`
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Price       int64       `json:"price"`
+	Currency    string      `json:"currency"`
+	Quantity    int         `json:"quantity"`
+	Sold        int         `json:"sold"`
+	Available   int         `json:"available"`
+	SessionIDs  []uuid.UUID `json:"session_ids,omitempty"` // sessions the tier admits to; empty admits to all
+	ZoneIDs     []uuid.UUID `json:"zone_ids,omitempty"`    // zones the tier admits to besides the main gate
+	// Price a reservation made now would hold, and the phase it comes from
+	CurrentPrice int64                `json:"current_price"`
+	PricePhase   string               `json:"price_phase,omitempty"`
+	PricePhases  []PricePhaseResponse `json:"price_phases,omitempty"`
+}
+
+type PricePhaseResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	Name          string     `json:"name"`
+	Price         int64      `json:"price"`
+	StartTime     *time.Time `json:"start_time,omitempty"`
+	EndTime       *time.Time `json:"end_time,omitempty"`
+	QuantityLimit int        `json:"quantity_limit,omitempty"`
 }
 
 type TicketResponse struct {
@@ -128,263 +204,525 @@ type TicketResponse struct {
 	CreatedAt  time.Time           `json:"created_at"`
 }
 
+type TicketAnswerResponse struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+type TicketDetailResponse struct {
+	ID             uuid.UUID              `json:"id"`
+	OrderID        uuid.UUID              `json:"order_id"`
+	EventID        uuid.UUID              `json:"event_id"`
+	EventTitle     string                 `json:"event_title"`
+	EventStartTime time.Time              `json:"event_start_time"`
+	EventEndTime   time.Time              `json:"event_end_time"`
+	Venue          string                 `json:"venue,omitempty"`
+	Location       string                 `json:"location"`
+	TierName       string                 `json:"tier_name"`
+	QRCode         string                 `json:"qr_code"`
+	Status         models.TicketStatus    `json:"status"`
+	CheckedInAt    *time.Time             `json:"checked_in_at,omitempty"`
+	Answers        []TicketAnswerResponse `json:"answers,omitempty"` // registration form answers
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
 type OrderResponse struct {
 	ID          uuid.UUID          `json:"id"`
-	TotalAmount float64            `json:"total_amount"`
+	TotalAmount int64              `json:"total_amount"`
+	TaxAmount   int64              `json:"tax_amount"` // included in the total
+	Currency    string             `json:"currency"`
 	Status      models.OrderStatus `json:"status"`
 	TicketCount int                `json:"ticket_count"`
 	CreatedAt   time.Time          `json:"created_at"`
 }
 
+type OrderItemResponse struct {
+	Type      models.OrderItemType `json:"type"`
+	Name      string               `json:"name"`
+	Quantity  int                  `json:"quantity"`
+	UnitPrice int64                `json:"unit_price"`
+	Amount    int64                `json:"amount"`
+	TaxAmount int64                `json:"tax_amount"`
+}
+
+type OrderDetailResponse struct {
+	ID             uuid.UUID           `json:"id"`
+	TotalAmount    int64               `json:"total_amount"`
+	TaxAmount      int64               `json:"tax_amount"` // included in the total
+	TaxName        string              `json:"tax_name,omitempty"`
+	TaxBasisPoints int                 `json:"tax_basis_points,omitempty"`
+	TaxInclusive   bool                `json:"tax_inclusive"`
+	Currency       string              `json:"currency"`
+	Status         models.OrderStatus  `json:"status"`
+	IsComp         bool                `json:"is_comp"`
+	ExpiresAt      *time.Time          `json:"expires_at,omitempty"` // payment deadline of a pending order
+	Items          []OrderItemResponse `json:"items"`
+	Tickets        []TicketResponse    `json:"tickets"`
+	Payments       []PaymentResponse   `json:"payments"`
+	Refunds        []RefundResponse    `json:"refunds"`
+	CreatedAt      time.Time           `json:"created_at"`
+}
+
 // AUTH HANDLERS
 
 // RegisterHandler godoc
 // @Summary Register a new user
 // @Description Create a new user account
+// @ID register
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param request body RegisterRequest true "Registration details"
 // @Success 201 {object} object{success=bool,message=string,data=UserResponse}
-// @Failure 400 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 409 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /auth/register [post]
-func RegisterHandler(c *fiber.Ctx) error {
-	var req RegisterRequest
+func RegisterHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req RegisterRequest
 
-	if err := c.BodyParser(&req); err != nil {
-		return utils.BadRequestResponse(c, "Invalid request body")
-	}
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
 
-	if !utils.IsValidEmail(req.Email) {
-		return utils.BadRequestResponse(c, "Invalid email format")
-	}
+		if !utils.IsValidEmail(req.Email) {
+			return utils.BadRequestResponse(c, "Invalid email format")
+		}
 
-	if !utils.IsValidPassword(req.Password) {
-		return utils.BadRequestResponse(c, "Password must be at least 8 characters with uppercase, lowercase, and number")
-	}
+		if !utils.IsValidPassword(req.Password) {
+			return utils.BadRequestResponse(c, "Password must be at least 8 characters with uppercase, lowercase, and number")
+		}
 
-	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+		req.Email = strings.ToLower(strings.TrimSpace(req.Email))
 
-	var existingUser models.User
-	result := database.DB.Where("email = ?", req.Email).First(&existingUser)
-	if result.Error == nil {
-		return utils.ConflictResponse(c, "Email already registered")
-	}
+		if existing, err := repos.Users.FindByEmail(c.UserContext(), req.Email); err == nil {
+			if existing.Role == models.RoleGuest {
+				return utils.ConflictResponse(c, "Tickets were bought with this email as a guest. Use the claim link in your order email or reset your password.")
+			}
+			return utils.ConflictResponse(c, "Email already registered")
+		}
 
-	hashedPassword, err := utils.HashPassword(req.Password)
-	if err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to process registration")
-	}
+		hashedPassword, err := utils.HashPassword(req.Password)
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to process registration")
+		}
 
-	user := models.User{
-		Email:         req.Email,
-		PasswordHash:  hashedPassword,
-		FirstName:     req.FirstName,
-		LastName:      req.LastName,
-		Phone:         req.Phone,
-		Role:          models.RoleAttendee,
-		EmailVerified: false,
-		IsActive:      true,
-	}
+		user := models.User{
+			Email:         req.Email,
+			PasswordHash:  hashedPassword,
+			FirstName:     req.FirstName,
+			LastName:      req.LastName,
+			Phone:         req.Phone,
+			Role:          models.RoleAttendee,
+			EmailVerified: false,
+			IsActive:      true,
+		}
 
-	if err := database.DB.Create(&user).Error; err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to create user")
-	}
+		if err := repos.Users.Create(c.UserContext(), &user); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to create user")
+		}
 
-	// Send verification email
-	cfg, _ := c.Locals("config").(*config.Config)
-	emailService := services.NewEmailService(&cfg.Email)
-	frontendURL := cfg.Server.FrontendURL
+		// Send verification email
+		cfg, _ := c.Locals("config").(*config.Config)
+		emailService := services.NewEmailService(&cfg.Email)
+		frontendURL := cfg.Server.FrontendURL
 
-	if err := emailService.SendVerificationEmail(user.ID, user.Email, user.FirstName, frontendURL); err != nil {
-		// Log error but don't fail registration
-		logger.Error("Failed to send verification email", zap.Error(err))
-	}
+		if err := emailService.SendVerificationEmail(c.UserContext(), user.ID, user.Email, user.FirstName, frontendURL); err != nil {
+			// Log error but don't fail registration
+			logger.WithContext(c.UserContext()).Error("Failed to send verification email", zap.Error(err))
+		}
 
-	userResponse := UserResponse{
-		ID:            user.ID,
-		Email:         user.Email,
-		FirstName:     user.FirstName,
-		LastName:      user.LastName,
-		Phone:         user.Phone,
-		Role:          string(user.Role),
-		EmailVerified: user.EmailVerified,
-		CreatedAt:     user.CreatedAt,
-	}
+		userResponse := UserResponse{
+			ID:            user.ID,
+			Email:         user.Email,
+			FirstName:     user.FirstName,
+			LastName:      user.LastName,
+			Phone:         user.Phone,
+			Role:          string(user.Role),
+			EmailVerified: user.EmailVerified,
+			CreatedAt:     user.CreatedAt,
+		}
 
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"success": true,
-		"message": "User registered successfully. Please check your email to verify your account.",
-		"data":    userResponse,
-	})
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+			"success": true,
+			"message": "User registered successfully. Please check your email to verify your account.",
+			"data":    userResponse,
+		})
+	}
 }
 
 // LoginHandler godoc
 // @Summary User login
-// @Description Authenticate user and return JWT tokens
+// @Description Authenticate user and return JWT tokens. Browsers may send X-Auth-Mode: cookie, when cookie sessions are enabled, to get the refresh token in an httpOnly cookie with a CSRF token instead.
+// @ID login
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param credentials body LoginRequest true "Login credentials"
+// @Param X-Auth-Mode header string false "cookie for a cookie session"
 // @Success 200 {object} object{success=bool,data=TokenResponse}
-// @Failure 400 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 429 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /auth/login [post]
-func LoginHandler(c *fiber.Ctx) error {
-	var req LoginRequest
+func LoginHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req LoginRequest
 
-	if err := c.BodyParser(&req); err != nil {
-		return utils.BadRequestResponse(c, "Invalid request body")
-	}
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
 
-	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+		req.Email = strings.ToLower(strings.TrimSpace(req.Email))
 
-	var user models.User
-	if err := database.DB.Where("email = ?", req.Email).First(&user).Error; err != nil {
-		return utils.UnauthorizedResponse(c, "Invalid email or password")
-	}
+		cfg, _ := c.Locals("config").(*config.Config)
+		guard := services.NewLoginGuard(&cfg.Limits)
 
-	if !user.IsActive {
-		return utils.UnauthorizedResponse(c, "Account is deactivated")
-	}
+		lockedFor, err := guard.LockedFor(c.UserContext(), req.Email)
+		if err != nil {
+			logger.WithContext(c.UserContext()).Warn("Failed to check account lock", zap.Error(err))
+		}
+		if lockedFor > 0 {
+			return accountLockedResponse(c, lockedFor)
+		}
+
+		user, err := repos.Users.FindByEmail(c.UserContext(), req.Email)
+		if err != nil {
+			return loginFailedResponse(c, guard, req.Email)
+		}
+
+		if !user.IsActive {
+			return utils.UnauthorizedResponse(c, "Account is deactivated")
+		}
+
+		if !utils.CheckPasswordHash(req.Password, user.PasswordHash) {
+			return loginFailedResponse(c, guard, req.Email)
+		}
+
+		if err := guard.Reset(c.UserContext(), req.Email); err != nil {
+			logger.WithContext(c.UserContext()).Warn("Failed to reset login attempts", zap.Error(err))
+		}
+
+		tokenPair, err := services.NewSessionService(&cfg.JWT).Start(c.UserContext(), user, c.Get(fiber.HeaderUserAgent), c.IP())
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
 
-	if !utils.CheckPasswordHash(req.Password, user.PasswordHash) {
-		return utils.UnauthorizedResponse(c, "Invalid email or password")
+		now := time.Now()
+		user.LastLoginAt = &now
+		repos.Users.Update(c.UserContext(), user)
+
+		response, err := tokenResponse(c, tokenPair, cookieMode(c))
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    response,
+		})
 	}
+}
 
-	tokenPair, err := jwt.GenerateTokenPair(
-		user.ID.String(),
-		user.Email,
-		string(user.Role),
-	)
+// loginFailedResponse records a failed login and reports it, locking the account once the limit is hit
+func loginFailedResponse(c *fiber.Ctx, guard *services.LoginGuard, email string) error {
+	locked, err := guard.RecordFailure(c.UserContext(), email)
 	if err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		logger.WithContext(c.UserContext()).Warn("Failed to record login failure", zap.Error(err))
 	}
-
-	now := time.Now()
-	user.LastLoginAt = &now
-	database.DB.Save(&user)
-
-	response := TokenResponse{
-		AccessToken:  tokenPair.AccessToken,
-		RefreshToken: tokenPair.RefreshToken,
-		TokenType:    "Bearer",
-		ExpiresIn:    tokenPair.ExpiresAt - time.Now().Unix(),
+	if locked {
+		cfg, _ := c.Locals("config").(*config.Config)
+		return accountLockedResponse(c, cfg.Limits.LoginLockoutDuration)
 	}
+	return utils.UnauthorizedResponse(c, "Invalid email or password")
+}
 
-	return c.JSON(fiber.Map{
-		"success": true,
-		"data":    response,
-	})
+func accountLockedResponse(c *fiber.Ctx, lockedFor time.Duration) error {
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(lockedFor.Seconds())))
+	return utils.ErrorResponse(c, fiber.StatusTooManyRequests, "ACCOUNT_LOCKED",
+		"Too many failed login attempts. Try again later or reset your password.", nil)
 }
 
 // RefreshTokenHandler godoc
 // @Summary Refresh access token
-// @Description Get a new access token using refresh token
+// @Description Get a new token pair using a refresh token. Cookie sessions may leave the body empty to use the refresh cookie, and must send the X-CSRF-Token header; the new refresh token is set in the cookie.
+// @ID refreshToken
 // @Tags Auth
 // @Accept json
 // @Produce json
-// @Param request body RefreshTokenRequest true "Refresh token"
-// @Success 200 {object} object{success=bool,data=object{access_token=string,token_type=string,expires_in=int}}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
+// @Param request body RefreshTokenRequest false "Refresh token, required in bearer mode"
+// @Param X-CSRF-Token header string false "CSRF token, in cookie mode"
+// @Success 200 {object} object{success=bool,data=TokenResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
 // @Router /auth/refresh [post]
-func RefreshTokenHandler(c *fiber.Ctx) error {
-	var req RefreshTokenRequest
+func RefreshTokenHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req RefreshTokenRequest
 
-	if err := c.BodyParser(&req); err != nil {
-		return utils.BadRequestResponse(c, "Invalid request body")
-	}
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&req); err != nil {
+				return utils.BadRequestResponse(c, "Invalid request body")
+			}
+		}
 
-	claims, err := jwt.ValidateToken(req.RefreshToken)
-	if err != nil {
-		return utils.UnauthorizedResponse(c, "Invalid or expired refresh token")
-	}
+		// Cookie sessions send the refresh cookie instead, checked for CSRF by
+		// the route
+		cfg, _ := c.Locals("config").(*config.Config)
+		fromCookie := req.RefreshToken == "" && cfg.Cookie.Enabled && c.Cookies(middleware.RefreshCookie) != ""
+		if fromCookie {
+			req.RefreshToken = c.Cookies(middleware.RefreshCookie)
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
 
-	var user models.User
-	userID, err := uuid.Parse(claims.UserID)
-	if err != nil {
-		return utils.UnauthorizedResponse(c, "Invalid token")
-	}
+		claims, err := jwt.ValidateRefreshToken(req.RefreshToken)
+		if err != nil {
+			if fromCookie {
+				clearAuthCookies(c, &cfg.Cookie)
+			}
+			return utils.UnauthorizedResponse(c, "Invalid or expired refresh token")
+		}
 
-	if err := database.DB.First(&user, userID).Error; err != nil {
-		return utils.UnauthorizedResponse(c, "User not found")
-	}
+		userID, err := uuid.Parse(claims.UserID)
+		if err != nil {
+			return utils.UnauthorizedResponse(c, "Invalid token")
+		}
 
-	if !user.IsActive {
-		return utils.UnauthorizedResponse(c, "Account is deactivated")
-	}
+		user, err := repos.Users.FindByID(c.UserContext(), userID)
+		if err != nil {
+			return utils.UnauthorizedResponse(c, "User not found")
+		}
 
-	tokenPair, err := jwt.GenerateTokenPair(
-		user.ID.String(),
-		user.Email,
-		string(user.Role),
-	)
-	if err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
-	}
+		if !user.IsActive {
+			return utils.UnauthorizedResponse(c, "Account is deactivated")
+		}
 
-	return c.JSON(fiber.Map{
-		"success": true,
-		"data": fiber.Map{
-			"access_token":  tokenPair.AccessToken,
-			"refresh_token": tokenPair.RefreshToken,
-			"token_type":    "Bearer",
-			"expires_in":    tokenPair.ExpiresAt - time.Now().Unix(),
-		},
-	})
+		// Changing the password revokes every refresh token issued before it
+		if user.PasswordChangedAt != nil && claims.IssuedAt != nil &&
+			claims.IssuedAt.Time.Before(*user.PasswordChangedAt) {
+			return utils.UnauthorizedResponse(c, "Session expired, please log in again")
+		}
+
+		tokenPair, err := services.NewSessionService(&cfg.JWT).Refresh(c.UserContext(), claims, user, c.Get(fiber.HeaderUserAgent), c.IP())
+		if err != nil {
+			if fromCookie {
+				clearAuthCookies(c, &cfg.Cookie)
+			}
+			return utils.UnauthorizedResponse(c, "Session expired, please log in again")
+		}
+
+		response, err := tokenResponse(c, tokenPair, fromCookie)
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    response,
+		})
+	}
 }
 
 // VerifyEmailHandler godoc
 // @Summary Verify email address
 // @Description Verify a user's email address using verification token
+// @ID verifyEmail
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param request body VerifyEmailRequest true "Verification token"
 // @Success 200 {object} object{success=bool,message=string}
-// @Failure 400 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
 // @Router /auth/verify-email [post]
-func VerifyEmailHandler(c *fiber.Ctx) error {
-	var req VerifyEmailRequest
+func VerifyEmailHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req VerifyEmailRequest
 
-	if err := c.BodyParser(&req); err != nil {
-		return utils.BadRequestResponse(c, "Invalid request body")
-	}
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
 
-	cfg, _ := c.Locals("config").(*config.Config)
-	emailService := services.NewEmailService(&cfg.Email)
+		cfg, _ := c.Locals("config").(*config.Config)
+		emailService := services.NewEmailService(&cfg.Email)
 
-	// Verify token and get user ID
-	userID, err := emailService.VerifyEmailToken(req.Token)
-	if err != nil {
-		return utils.BadRequestResponse(c, err.Error())
-	}
+		// Verify token and get user ID
+		userID, err := emailService.VerifyEmailToken(req.Token)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
 
-	// Update user's email_verified status
-	var user models.User
-	if err := database.DB.First(&user, userID).Error; err != nil {
-		return utils.NotFoundResponse(c, "User not found")
-	}
+		// Update user's email_verified status
+		user, err := repos.Users.FindByID(c.UserContext(), userID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
 
-	if user.EmailVerified {
-		return utils.BadRequestResponse(c, "Email already verified")
-	}
+		if user.EmailVerified {
+			return utils.BadRequestResponse(c, "Email already verified")
+		}
 
-	user.EmailVerified = true
-	if err := database.DB.Save(&user).Error; err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to update user")
+		user.EmailVerified = true
+		if err := repos.Users.Update(c.UserContext(), user); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to update user")
+		}
+
+		// Send welcome email
+		if err := emailService.SendWelcomeEmail(c.UserContext(), user.ID, user.Email, user.FirstName); err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to send welcome email", zap.Error(err))
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Email verified successfully!  Welcome to Eventix!",
+		})
 	}
+}
+
+// ForgotPasswordHandler godoc
+// @Summary Request password reset
+// @Description Send a password reset link to the account email if it exists
+// @ID forgotPassword
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body ForgotPasswordRequest true "Account email"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /auth/forgot-password [post]
+func ForgotPasswordHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req ForgotPasswordRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+
+		req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+		if !utils.IsValidEmail(req.Email) {
+			return utils.BadRequestResponse(c, "Invalid email format")
+		}
+
+		// Always respond the same way so the endpoint can't be used to enumerate accounts
+		response := fiber.Map{
+			"success": true,
+			"message": "If an account exists for this email, a password reset link has been sent.",
+		}
+
+		user, err := repos.Users.FindByEmail(c.UserContext(), req.Email)
+		if err != nil {
+			return c.JSON(response)
+		}
 
-	// Send welcome email
-	if err := emailService.SendWelcomeEmail(user.Email, user.FirstName); err != nil {
-		logger.Error("Failed to send welcome email", zap.Error(err))
+		if !user.IsActive {
+			return c.JSON(response)
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		emailService := services.NewEmailService(&cfg.Email)
+
+		if err := emailService.SendPasswordResetEmail(c.UserContext(), user.ID, user.Email, user.FirstName, cfg.Server.FrontendURL); err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to send password reset email", zap.Error(err))
+		}
+
+		return c.JSON(response)
 	}
+}
 
-	return c.JSON(fiber.Map{
-		"success": true,
-		"message": "Email verified successfully!  Welcome to Eventix!",
-	})
+// ResetPasswordHandler godoc
+// @Summary Reset password
+// @Description Set a new password using a password reset token
+// @ID resetPassword
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /auth/reset-password [post]
+func ResetPasswordHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req ResetPasswordRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+
+		if !utils.IsValidPassword(req.Password) {
+			return utils.BadRequestResponse(c, "Password must be at least 8 characters with uppercase, lowercase, and number")
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		emailService := services.NewEmailService(&cfg.Email)
+
+		userID, err := emailService.VerifyPasswordResetToken(req.Token)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		user, err := repos.Users.FindByID(c.UserContext(), userID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		hashedPassword, err := utils.HashPassword(req.Password)
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to process password reset")
+		}
+
+		// Token issue times have second precision; truncate so tokens issued
+		// right after the change are not treated as older than it
+		changedAt := time.Now().Truncate(time.Second)
+		user.PasswordHash = hashedPassword
+		user.PasswordChangedAt = &changedAt
+
+		// The reset link proves a guest owns the email, which is all claiming takes
+		if user.Role == models.RoleGuest {
+			user.Role = models.RoleAttendee
+			user.EmailVerified = true
+		}
+
+		if err := repos.Users.Update(c.UserContext(), user); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to update password")
+		}
+
+		// A successful reset proves ownership, so lift any login lockout
+		if err := services.NewLoginGuard(&cfg.Limits).Reset(c.UserContext(), user.Email); err != nil {
+			logger.WithContext(c.UserContext()).Warn("Failed to reset login attempts", zap.Error(err))
+		}
+
+		// Whoever knew the old password is signed out everywhere
+		if err := services.NewSessionService(&cfg.JWT).RevokeAll(c.UserContext(), user.ID); err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to revoke sessions after password reset", zap.Error(err))
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Password reset successfully. You can now log in with your new password.",
+		})
+	}
 }
 
 // USER HANDLERS
@@ -392,218 +730,382 @@ func VerifyEmailHandler(c *fiber.Ctx) error {
 // GetCurrentUserHandler godoc
 // @Summary Get current user profile
 // @Description Get authenticated user's profile information
+// @ID getCurrentUser
 // @Tags Users
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
+// @Security BearerAuth
 // @Success 200 {object} object{success=bool,data=UserResponse}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /users/me [get]
-func GetCurrentUserHandler(c *fiber.Ctx) error {
-	userID := c.Locals("user_id").(string)
+func GetCurrentUserHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID := c.Locals("user_id").(string)
 
-	id, err := uuid.Parse(userID)
-	if err != nil {
-		return utils.UnauthorizedResponse(c, "Invalid user ID")
-	}
+		id, err := uuid.Parse(userID)
+		if err != nil {
+			return utils.UnauthorizedResponse(c, "Invalid user ID")
+		}
 
-	var user models.User
-	if err := database.DB.First(&user, id).Error; err != nil {
-		return utils.NotFoundResponse(c, "User not found")
+		user, err := repos.Users.FindByID(c.UserContext(), id)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		userResponse := UserResponse{
+			ID:            user.ID,
+			Email:         user.Email,
+			FirstName:     user.FirstName,
+			LastName:      user.LastName,
+			Phone:         user.Phone,
+			Role:          string(user.Role),
+			EmailVerified: user.EmailVerified,
+			CreatedAt:     user.CreatedAt,
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    userResponse,
+		})
 	}
+}
+
+// UpdateProfileHandler godoc
+// @Summary Update current user profile
+// @Description Update the authenticated user's name and phone number. Omitted fields are unchanged; an empty phone removes it.
+// @ID updateProfile
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateProfileRequest true "Profile fields to change"
+// @Success 200 {object} object{success=bool,message=string,data=UserResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /users/me [put]
+func UpdateProfileHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req UpdateProfileRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		user, err := repos.Users.FindByID(c.UserContext(), uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		if req.FirstName != nil {
+			user.FirstName = strings.TrimSpace(*req.FirstName)
+		}
+		if req.LastName != nil {
+			user.LastName = strings.TrimSpace(*req.LastName)
+		}
+		if req.Phone != nil {
+			user.Phone = *req.Phone
+		}
 
-	userResponse := UserResponse{
-		ID:            user.ID,
-		Email:         user.Email,
-		FirstName:     user.FirstName,
-		LastName:      user.LastName,
-		Phone:         user.Phone,
-		Role:          string(user.Role),
-		EmailVerified: user.EmailVerified,
-		CreatedAt:     user.CreatedAt,
+		if err := repos.Users.Update(c.UserContext(), user); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to update profile")
+		}
+
+		userResponse := UserResponse{
+			ID:            user.ID,
+			Email:         user.Email,
+			FirstName:     user.FirstName,
+			LastName:      user.LastName,
+			Phone:         user.Phone,
+			Role:          string(user.Role),
+			EmailVerified: user.EmailVerified,
+			CreatedAt:     user.CreatedAt,
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Profile updated successfully",
+			"data":    userResponse,
+		})
 	}
+}
 
-	return c.JSON(fiber.Map{
-		"success": true,
-		"data":    userResponse,
-	})
+// ChangePasswordHandler godoc
+// @Summary Change password
+// @Description Change the authenticated user's password. Refresh tokens issued before the change stop working; a new token pair is returned.
+// @ID changePassword
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} object{success=bool,message=string,data=TokenResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /users/me/change-password [post]
+func ChangePasswordHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req ChangePasswordRequest
+
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+
+		if !utils.IsValidPassword(req.NewPassword) {
+			return utils.BadRequestResponse(c, "Password must be at least 8 characters with uppercase, lowercase, and number")
+		}
+		if req.NewPassword == req.CurrentPassword {
+			return utils.BadRequestResponse(c, "New password must be different from the current password")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		user, err := repos.Users.FindByID(c.UserContext(), uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		if !utils.CheckPasswordHash(req.CurrentPassword, user.PasswordHash) {
+			return utils.UnauthorizedResponse(c, "Current password is incorrect")
+		}
+
+		hashedPassword, err := utils.HashPassword(req.NewPassword)
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to process password change")
+		}
+
+		// Token issue times have second precision; truncate so tokens issued
+		// right after the change are not treated as older than it
+		changedAt := time.Now().Truncate(time.Second)
+		user.PasswordHash = hashedPassword
+		user.PasswordChangedAt = &changedAt
+		if err := repos.Users.Update(c.UserContext(), user); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to update password")
+		}
+
+		// Sign out every device, then start a fresh session for this one
+		cfg, _ := c.Locals("config").(*config.Config)
+		sessionService := services.NewSessionService(&cfg.JWT)
+		if err := sessionService.RevokeAll(c.UserContext(), user.ID); err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to revoke sessions after password change", zap.Error(err))
+		}
+
+		tokenPair, err := sessionService.Start(c.UserContext(), user, c.Get(fiber.HeaderUserAgent), c.IP())
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+
+		response, err := tokenResponse(c, tokenPair, cookieMode(c))
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate tokens")
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Password changed successfully",
+			"data":    response,
+		})
+	}
 }
 
 // EVENT HANDLERS
 
 // ListEventsHandler godoc
 // @Summary List all events
-// @Description Get a list of all published events
+// @Description Get a list of all published events. Pass cursor (empty for the first page, then pagination.next_cursor) for keyset pagination instead of page numbers.
+// @ID listEvents
 // @Tags Events
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
+// @Param cursor query string false "Cursor from the previous page"
 // @Param category query string false "Filter by category"
 // @Param status query string false "Filter by status"
-// @Success 200 {object} object{success=bool,data=[]EventResponse,pagination=object{page=int,limit=int,total=int}}
+// @Success 200 {object} object{success=bool,data=[]EventResponse,pagination=object{page=int,limit=int,total=int,next_cursor=string,has_more=bool}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /events [get]
-func ListEventsHandler(c *fiber.Ctx) error {
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	limit, _ := strconv.Atoi(c.Query("limit", "10"))
-	category := c.Query("category")
-	status := c.Query("status", string(models.EventPublished))
-
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
-	}
-
-	offset := (page - 1) * limit
+func ListEventsHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		page, _ := strconv.Atoi(c.Query("page", "1"))
+		limit := queryLimit(c)
+		category := c.Query("category")
+		status := c.Query("status", string(models.EventPublished))
+
+		if page < 1 {
+			page = 1
+		}
 
-	query := database.DB.Model(&models.Event{}).Preload("TicketTiers")
+		useCursor, after, err := cursorQuery(c)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid cursor")
+		}
 
-	// Apply filters
-	if category != "" {
-		query = query.Where("category = ?", category)
-	}
-	if status != "" {
-		query = query.Where("status = ?", status)
-	}
+		filter := repositories.EventFilter{
+			Category: category,
+			Status:   status,
+			Offset:   (page - 1) * limit,
+			Limit:    limit,
+		}
 
-	var total int64
-	query.Count(&total)
+		key := cacheKey(c, fmt.Sprintf("list:%s:%s:%d:%d", status, category, page, limit))
+		if useCursor {
+			key = cacheKey(c, fmt.Sprintf("cursor:%s:%s:%s:%d", status, category, c.Query("cursor"), limit))
+		}
 
-	var events []models.Event
-	if err := query.Offset(offset).Limit(limit).Order("start_time ASC").Find(&events).Error; err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to fetch events")
-	}
+		cfg, _ := c.Locals("config").(*config.Config)
+		body, err := services.NewEventCache(&cfg.Limits).Fetch(c.UserContext(), key, func() ([]byte, error) {
+			if useCursor {
+				// Fetch one extra row to learn whether another page follows
+				filter.Limit = limit + 1
+				events, err := repos.Events.ListAfter(c.UserContext(), filter, after)
+				if err != nil {
+					return nil, err
+				}
+
+				next := ""
+				if len(events) > limit {
+					events = events[:limit]
+					last := events[limit-1]
+					next = utils.CursorEncode(last.StartTime, last.ID)
+				}
+
+				return json.Marshal(fiber.Map{
+					"success":    true,
+					"data":       eventMapper.all(c, toEventResponses(events)),
+					"pagination": cursorPagination(limit, next),
+				})
+			}
 
-	eventResponses := make([]EventResponse, len(events))
-	for i, event := range events {
-		tierResponses := make([]TicketTierResponse, len(event.TicketTiers))
-		for j, tier := range event.TicketTiers {
-			tierResponses[j] = TicketTierResponse{
-				ID:          tier.ID,
-				Name:        tier.TierName,
-				Description: tier.Description,
-				Price:       tier.Price,
-				Quantity:    tier.TotalQuantity,
-				Sold:        tier.TotalQuantity - tier.AvailableQuantity,
-				Available:   tier.AvailableQuantity,
+			events, total, err := repos.Events.List(c.UserContext(), filter)
+			if err != nil {
+				return nil, err
 			}
-		}
 
-		eventResponses[i] = EventResponse{
-			ID:           event.ID,
-			Title:        event.Title,
-			Description:  event.Description,
-			Category:     event.Category,
-			Location:     event.Location,
-			StartTime:    event.StartTime,
-			EndTime:      event.EndTime,
-			Status:       event.Status,
-			MaxAttendees: 0,
-			OrganizerID:  event.OrganizerID,
-			// TicketsSold not in model
-			TicketTiers: tierResponses,
-			CreatedAt:   event.CreatedAt,
+			return json.Marshal(fiber.Map{
+				"success": true,
+				"data":    eventMapper.all(c, toEventResponses(events)),
+				"pagination": fiber.Map{
+					"page":  page,
+					"limit": limit,
+					"total": total,
+				},
+			})
+		})
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to fetch events")
 		}
-	}
 
-	return c.JSON(fiber.Map{
-		"success": true,
-		"data":    eventResponses,
-		"pagination": fiber.Map{
-			"page":  page,
-			"limit": limit,
-			"total": total,
-		},
-	})
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Send(body)
+	}
 }
 
 // GetEventHandler godoc
 // @Summary Get event by ID
 // @Description Get detailed information about a specific event
+// @ID getEvent
 // @Tags Events
 // @Accept json
 // @Produce json
 // @Param id path string true "Event ID"
 // @Success 200 {object} object{success=bool,data=EventResponse}
-// @Failure 404 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /events/{id} [get]
-func GetEventHandler(c *fiber.Ctx) error {
-	eventID, err := uuid.Parse(c.Params("id"))
-	if err != nil {
-		return utils.BadRequestResponse(c, "Invalid event ID")
-	}
+func GetEventHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		eventID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid event ID")
+		}
 
-	var event models.Event
-	if err := database.DB.Preload("TicketTiers").First(&event, eventID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return utils.NotFoundResponse(c, "Event not found")
+		cfg, _ := c.Locals("config").(*config.Config)
+		body, err := services.NewEventCache(&cfg.Limits).Fetch(c.UserContext(), cacheKey(c, "event:"+eventID.String()), func() ([]byte, error) {
+			event, err := repos.Events.FindByID(c.UserContext(), eventID)
+			if err != nil {
+				return nil, err
+			}
+
+			return json.Marshal(fiber.Map{
+				"success": true,
+				"data":    eventMapper.one(c, toEventResponse(event)),
+			})
+		})
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return utils.NotFoundResponse(c, "Event not found")
+			}
+			return utils.InternalServerErrorResponse(c, "Failed to fetch event")
 		}
-		return utils.InternalServerErrorResponse(c, "Failed to fetch event")
+
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Send(body)
 	}
+}
 
-	tierResponses := make([]TicketTierResponse, len(event.TicketTiers))
-	for i, tier := range event.TicketTiers {
-		tierResponses[i] = TicketTierResponse{
-			ID:          tier.ID,
-			Name:        tier.TierName,
-			Description: tier.Description,
-			Price:       tier.Price,
-			Quantity:    tier.TotalQuantity,
-			Sold:        tier.TotalQuantity - tier.AvailableQuantity,
-			Available:   tier.AvailableQuantity,
+// GetEventBySlugHandler godoc
+// @Summary Get event by slug
+// @Description Get detailed information about an event using its URL slug
+// @ID getEventBySlug
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Param slug path string true "Event slug"
+// @Success 200 {object} object{success=bool,data=EventResponse}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/slug/{slug} [get]
+func GetEventBySlugHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		event, err := repos.Events.FindBySlug(c.UserContext(), c.Params("slug"))
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return utils.NotFoundResponse(c, "Event not found")
+			}
+			return utils.InternalServerErrorResponse(c, "Failed to fetch event")
 		}
-	}
 
-	eventResponse := EventResponse{
-		ID:           event.ID,
-		Title:        event.Title,
-		Description:  event.Description,
-		Category:     event.Category,
-		Location:     event.Location,
-		StartTime:    event.StartTime,
-		EndTime:      event.EndTime,
-		Status:       event.Status,
-		MaxAttendees: 0,
-		OrganizerID:  event.OrganizerID,
-		// TicketsSold not in model
-		TicketTiers: tierResponses,
-		CreatedAt:   event.CreatedAt,
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    eventMapper.one(c, toEventResponse(event)),
+		})
 	}
-
-	return c.JSON(fiber.Map{
-		"success": true,
-		"data":    eventResponse,
-	})
 }
 
 // CreateEventHandler godoc
 // @Summary Create a new event
-// @Description Create a new event (Organizer/Admin only)
+// @Description Create a new event. Requires the events:create permission, held by organizers and admins unless an admin changes the role mapping
+// @ID createEvent
 // @Tags Events
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
+// @Security BearerAuth
 // @Param event body CreateEventRequest true "Event details"
 // @Success 201 {object} object{success=bool,message=string,data=EventResponse}
-// @Failure 400 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 403 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /events [post]
 func CreateEventHandler(c *fiber.Ctx) error {
 	userID := c.Locals("user_id").(string)
-	role := c.Locals("role").(string)
-
-	// Check if user has organizer role
-	if role != string(models.RoleOrganizer) && role != string(models.RoleAdmin) {
-		return utils.ForbiddenResponse(c, "Only organizers and admins can create events")
-	}
 
 	var req CreateEventRequest
 	if err := c.BodyParser(&req); err != nil {
 		return utils.BadRequestResponse(c, "Invalid request body")
 	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
 
 	// Validation
 	if req.EndTime.Before(req.StartTime) {
@@ -615,6 +1117,9 @@ func CreateEventHandler(c *fiber.Ctx) error {
 	if len(req.TicketTiers) == 0 {
 		return utils.BadRequestResponse(c, "At least one ticket tier is required")
 	}
+	if err := services.NewCategoryService().Validate(c.UserContext(), req.Category); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
 
 	// Get user's organizer profile
 	var organizer models.Organizer
@@ -631,15 +1136,27 @@ func CreateEventHandler(c *fiber.Ctx) error {
 		}
 	}
 
+	eventCurrency := currency.Default
+	if req.Currency != "" {
+		eventCurrency = currency.Normalize(req.Currency)
+	}
+
+	slug, err := services.NewEventService().UniqueSlug(c.UserContext(), req.Title)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to create event")
+	}
+
 	// Create event
 	event := models.Event{
 		Title:       req.Title,
+		Slug:        slug,
 		Description: req.Description,
-		Category:    models.EventCategory(req.Category),
+		Category:    req.Category,
 		Location:    req.Location,
 		StartTime:   req.StartTime,
 		EndTime:     req.EndTime,
 		Status:      models.EventDraft,
+		Currency:    eventCurrency,
 		// MaxAttendees not in model
 		OrganizerID: organizer.ID,
 		// TicketsSold not in model
@@ -659,6 +1176,7 @@ func CreateEventHandler(c *fiber.Ctx) error {
 			TierName:      tierReq.Name,
 			Description:   tierReq.Description,
 			Price:         tierReq.Price,
+			Currency:      event.Currency,
 			TotalQuantity: tierReq.Quantity, AvailableQuantity: tierReq.Quantity,
 			// Sold calculated from TotalQuantity - AvailableQuantity
 		}
@@ -672,41 +1190,168 @@ func CreateEventHandler(c *fiber.Ctx) error {
 
 	// Reload event with tiers
 	database.DB.Preload("TicketTiers").First(&event, event.ID)
+	recordCreated(c, models.AuditEventCreated, models.AuditTargetEvent, event.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Event created successfully",
+		"data":    eventMapper.one(c, toEventResponse(&event)),
+	})
+}
+
+// DuplicateEventHandler godoc
+// @Summary Duplicate an event
+// @Description Copy an event's details, ticket tiers, sessions and registration questions into a new draft with new dates. Sale windows, price phases and sessions move with the event. (Organizer/Admin only)
+// @ID duplicateEvent
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body DuplicateEventRequest true "Dates and optional title of the copy"
+// @Success 201 {object} object{success=bool,message=string,data=EventResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/duplicate [post]
+func DuplicateEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req DuplicateEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	if req.StartTime.Before(time.Now()) {
+		return utils.BadRequestResponse(c, "Event start time cannot be in the past")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	eventService := services.NewEventService()
+	if err := eventService.AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	event, err := eventService.Duplicate(c.UserContext(), eventID, services.DuplicateOptions{
+		Title:     strings.TrimSpace(req.Title),
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditEventDuplicated, models.AuditTargetEvent, event.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Event duplicated successfully",
+		"data":    eventMapper.one(c, toEventResponse(event)),
+	})
+}
+
+func toEventResponses(events []models.Event) []EventResponse {
+	responses := make([]EventResponse, len(events))
+	for i := range events {
+		responses[i] = toEventResponse(&events[i])
+	}
+	return responses
+}
 
+func toEventResponse(event *models.Event) EventResponse {
 	tierResponses := make([]TicketTierResponse, len(event.TicketTiers))
-	for i, tier := range event.TicketTiers {
-		tierResponses[i] = TicketTierResponse{
-			ID:          tier.ID,
-			Name:        tier.TierName,
-			Description: tier.Description,
-			Price:       tier.Price,
-			Quantity:    tier.TotalQuantity,
-			Sold:        tier.TotalQuantity - tier.AvailableQuantity,
-			Available:   tier.TotalQuantity - tier.TotalQuantity - tier.AvailableQuantity,
-		}
+	for i := range event.TicketTiers {
+		tierResponses[i] = toTicketTierResponse(&event.TicketTiers[i])
+	}
+
+	var sessionResponses []EventSessionResponse
+	for i := range event.Sessions {
+		sessionResponses = append(sessionResponses, toEventSessionResponse(&event.Sessions[i]))
+	}
+
+	var zoneResponses []EventZoneResponse
+	for i := range event.Zones {
+		zoneResponses = append(zoneResponses, toEventZoneResponse(&event.Zones[i]))
 	}
 
-	eventResponse := EventResponse{
+	return EventResponse{
 		ID:           event.ID,
 		Title:        event.Title,
+		Slug:         event.Slug,
 		Description:  event.Description,
 		Category:     event.Category,
 		Location:     event.Location,
 		StartTime:    event.StartTime,
 		EndTime:      event.EndTime,
+		BannerURL:    event.BannerURL,
 		Status:       event.Status,
+		Currency:     event.Currency,
+		IsFeatured:   event.IsFeatured,
+		WaitingRoom:  event.WaitingRoom,
+		AllowReentry: event.AllowReentry,
+		MaxOccupancy: event.MaxOccupancy,
 		MaxAttendees: 0,
 		OrganizerID:  event.OrganizerID,
 		// TicketsSold not in model
-		TicketTiers: tierResponses,
-		CreatedAt:   event.CreatedAt,
+		RefundPolicy: toRefundPolicyResponse(event),
+		TicketTiers:  tierResponses,
+		Sessions:     sessionResponses,
+		Zones:        zoneResponses,
+		CreatedAt:    event.CreatedAt,
 	}
+}
 
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"success": true,
-		"message": "Event created successfully",
-		"data":    eventResponse,
-	})
+func toTicketTierResponse(tier *models.TicketTier) TicketTierResponse {
+	var sessionIDs []uuid.UUID
+	for _, session := range tier.Sessions {
+		sessionIDs = append(sessionIDs, session.ID)
+	}
+
+	var zoneIDs []uuid.UUID
+	for _, zone := range tier.Zones {
+		zoneIDs = append(zoneIDs, zone.ID)
+	}
+
+	var phases []PricePhaseResponse
+	for _, phase := range tier.PricePhases {
+		phases = append(phases, PricePhaseResponse{
+			ID:            phase.ID,
+			Name:          phase.Name,
+			Price:         phase.Price,
+			StartTime:     phase.StartTime,
+			EndTime:       phase.EndTime,
+			QuantityLimit: phase.QuantityLimit,
+		})
+	}
+
+	response := TicketTierResponse{
+		ID:          tier.ID,
+		Name:        tier.TierName,
+		Description: tier.Description,
+		Price:       tier.Price,
+		Currency:    tier.Currency,
+		Quantity:    tier.TotalQuantity,
+		Sold:        tier.TotalQuantity - tier.AvailableQuantity,
+		Available:   tier.AvailableQuantity,
+		SessionIDs:  sessionIDs,
+		ZoneIDs:     zoneIDs,
+		PricePhases: phases,
+	}
+
+	var phase *models.PricePhase
+	response.CurrentPrice, phase = tier.CurrentPrice(time.Now())
+	if phase != nil {
+		response.PricePhase = phase.Name
+	}
+	return response
 }
 
 // TICKET HANDLERS
@@ -714,14 +1359,17 @@ func CreateEventHandler(c *fiber.Ctx) error {
 // ReserveTicketHandler godoc
 // @Summary Reserve a ticket
 // @Description Reserve a ticket for an event (15-minute hold)
+// @ID reserveTicket
 // @Tags Tickets
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
+// @Security BearerAuth
 // @Param request body ReserveTicketRequest true "Ticket reservation details"
-// @Success 200 {object} object{success=bool,message=string,data=object{reservation_id=string,expires_at=string}}
-// @Failure 400 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
+// @Success 200 {object} object{success=bool,message=string,data=object{reservation_id=string,tier_id=string,event_id=string,quantity=int,unit_price=int,price_phase=string,total_price=int,expires_at=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /tickets/reserve [post]
 func ReserveTicketHandler(c *fiber.Ctx) error {
 	userID := c.Locals("user_id").(string)
@@ -730,6 +1378,9 @@ func ReserveTicketHandler(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		return utils.BadRequestResponse(c, "Invalid request body")
 	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
 
 	tierID, err := uuid.Parse(req.TierID)
 	if err != nil {
@@ -738,6 +1389,11 @@ func ReserveTicketHandler(c *fiber.Ctx) error {
 
 	uid, _ := uuid.Parse(userID)
 
+	cfg, _ := c.Locals("config").(*config.Config)
+	if err := services.NewWaitingRoomService(&cfg.Limits).CheckAdmission(c.UserContext(), tierID, uid, req.QueueToken); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
 	// Create reservation using service
 	ticketService := services.NewTicketService()
 	reservation, err := ticketService.CreateReservation(uid, tierID, req.Quantity)
@@ -754,6 +1410,7 @@ func ReserveTicketHandler(c *fiber.Ctx) error {
 			"event_id":       reservation.EventID.String(),
 			"quantity":       reservation.Quantity,
 			"unit_price":     reservation.UnitPrice,
+			"price_phase":    reservation.PricePhase,
 			"total_price":    reservation.TotalPrice,
 			"expires_at":     reservation.ExpiresAt.Format(time.RFC3339),
 		},
@@ -762,59 +1419,186 @@ func ReserveTicketHandler(c *fiber.Ctx) error {
 
 // GetMyTicketsHandler godoc
 // @Summary Get user's tickets
-// @Description Get all tickets owned by the authenticated user
+// @Description Get all tickets owned by the authenticated user, newest first. Pass cursor (empty for the first page, then pagination.next_cursor) to page through them.
+// @ID getMyTickets
 // @Tags Tickets
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
-// @Success 200 {object} object{success=bool,data=[]TicketResponse}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
+// @Security BearerAuth
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Items per page when paginating" default(10)
+// @Success 200 {object} object{success=bool,data=[]TicketResponse,pagination=object{limit=int,next_cursor=string,has_more=bool}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /tickets/my-tickets [get]
-func GetMyTicketsHandler(c *fiber.Ctx) error {
-	userID := c.Locals("user_id").(string)
+func GetMyTicketsHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID := c.Locals("user_id").(string)
 
-	uid, _ := uuid.Parse(userID)
-	var tickets []models.Ticket
-	if err := database.DB.Where("owner_id = ?", uid).
-		Preload("Tier").
-		Preload("Tier.Event").
-		Order("created_at DESC").
-		Find(&tickets).Error; err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to fetch tickets")
+		uid, _ := uuid.Parse(userID)
+
+		useCursor, after, err := cursorQuery(c)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid cursor")
+		}
+
+		var tickets []models.Ticket
+		var pagination fiber.Map
+		if useCursor {
+			limit := queryLimit(c)
+			// Fetch one extra row to learn whether another page follows
+			tickets, err = repos.Tickets.ListByOwnerAfter(c.UserContext(), uid, after, limit+1)
+			if err == nil {
+				next := ""
+				if len(tickets) > limit {
+					tickets = tickets[:limit]
+					last := tickets[limit-1]
+					next = utils.CursorEncode(last.CreatedAt, last.ID)
+				}
+				pagination = cursorPagination(limit, next)
+			}
+		} else {
+			tickets, err = repos.Tickets.ListByOwner(c.UserContext(), uid)
+		}
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to fetch tickets")
+		}
+
+		ticketResponses := make([]TicketResponse, len(tickets))
+		for i, ticket := range tickets {
+			ticketResponses[i] = TicketResponse{
+				ID:         ticket.ID,
+				EventID:    ticket.Tier.EventID,
+				EventTitle: ticket.Tier.Event.Title,
+				TierName:   ticket.Tier.TierName,
+				QRCode:     ticket.QRCode,
+				Status:     ticket.Status,
+				CreatedAt:  ticket.CreatedAt,
+			}
+		}
+
+		response := fiber.Map{
+			"success": true,
+			"data":    ticketResponses,
+		}
+		if pagination != nil {
+			response["pagination"] = pagination
+		}
+		return c.JSON(response)
 	}
+}
+
+// GetTicketHandler godoc
+// @Summary Get a ticket
+// @Description Get a ticket owned by the authenticated user with its event, QR code, check-in status and registration answers
+// @ID getTicket
+// @Tags Tickets
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Ticket ID"
+// @Success 200 {object} object{success=bool,data=TicketDetailResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tickets/{id} [get]
+func GetTicketHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ticketID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid ticket ID")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
 
-	ticketResponses := make([]TicketResponse, len(tickets))
-	for i, ticket := range tickets {
-		ticketResponses[i] = TicketResponse{
-			ID:         ticket.ID,
-			EventID:    ticket.Tier.EventID,
-			EventTitle: ticket.Tier.Event.Title,
-			TierName:   ticket.Tier.TierName,
-			QRCode:     ticket.QRCode,
-			Status:     ticket.Status,
-			CreatedAt:  ticket.CreatedAt,
+		ticket, err := repos.Tickets.FindDetailForOwner(c.UserContext(), ticketID, uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Ticket not found")
 		}
+
+		event := ticket.Tier.Event
+		response := TicketDetailResponse{
+			ID:             ticket.ID,
+			OrderID:        ticket.OrderID,
+			EventID:        event.ID,
+			EventTitle:     event.Title,
+			EventStartTime: event.StartTime,
+			EventEndTime:   event.EndTime,
+			Venue:          event.Venue,
+			Location:       event.Location,
+			TierName:       ticket.Tier.TierName,
+			QRCode:         ticket.QRCode,
+			Status:         ticket.Status,
+			CheckedInAt:    ticket.CheckedInAt,
+			CreatedAt:      ticket.CreatedAt,
+		}
+		for _, answer := range ticket.Answers {
+			response.Answers = append(response.Answers, TicketAnswerResponse{
+				Label: answer.FormField.Label,
+				Value: answer.Value,
+			})
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    response,
+		})
 	}
+}
 
-	return c.JSON(fiber.Map{
-		"success": true,
-		"data":    ticketResponses,
-	})
+// GetTicketQRCodeHandler godoc
+// @Summary Get ticket QR code
+// @Description Render the signed QR code of a ticket owned by the authenticated user as a PNG image
+// @ID getTicketQRCode
+// @Tags Tickets
+// @Produce png
+// @Security BearerAuth
+// @Param id path string true "Ticket ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tickets/{id}/qr [get]
+func GetTicketQRCodeHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ticketID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid ticket ID")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		ticket, err := repos.Tickets.FindForOwner(c.UserContext(), ticketID, uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Ticket not found")
+		}
+
+		png, err := ticketqr.PNG(ticket.QRCode)
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to generate QR code")
+		}
+
+		c.Set(fiber.HeaderContentType, "image/png")
+		c.Set(fiber.HeaderCacheControl, "private, max-age=3600")
+		return c.Send(png)
+	}
 }
 
 // ORDER HANDLERS
 
 // CreateOrderHandler godoc
 // @Summary Create an order
-// @Description Create an order for reserved tickets
+// @Description Create an order for reserved tickets, answering the event's registration form questions (see GET /events/{id}/form-fields) and optionally adding extras such as parking or merchandise (see GET /events/{id}/add-ons)
+// @ID createOrder
 // @Tags Orders
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
+// @Security BearerAuth
 // @Param order body CreateOrderRequest true "Order details"
+// @Param Idempotency-Key header string false "Unique key that makes retries of this request safe"
 // @Success 201 {object} object{success=bool,message=string,data=OrderResponse}
-// @Failure 400 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /orders [post]
 func CreateOrderHandler(c *fiber.Ctx) error {
 	userID := c.Locals("user_id").(string)
@@ -823,178 +1607,502 @@ func CreateOrderHandler(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil {
 		return utils.BadRequestResponse(c, "Invalid request body")
 	}
-
-	uid, _ := uuid.Parse(userID)
-	ticketService := services.NewTicketService()
-
-	// Get and validate reservation
-	reservation, err := ticketService.GetReservation(req.ReservationID)
-	if err != nil {
-		return utils.BadRequestResponse(c, "Reservation not found or expired")
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
 	}
 
-	// Verify ownership
-	if reservation.UserID != uid {
-		return utils.ForbiddenResponse(c, "This reservation belongs to another user")
+	var provider models.PaymentProvider
+	if req.Provider != "" {
+		provider = models.PaymentProvider(strings.ToLower(req.Provider))
+		if !services.IsSupportedProvider(provider) {
+			return utils.BadRequestResponse(c, "Unsupported payment provider")
+		}
 	}
 
-	// Create order
-	order := models.Order{
-		UserID:      uid,
-		TotalAmount: reservation.TotalPrice,
-		Currency:    "USD",
-		Status:      models.OrderPending,
+	selections := make([]services.AddOnSelection, len(req.AddOns))
+	for i, a := range req.AddOns {
+		addOnID, _ := uuid.Parse(a.AddOnID)
+		selections[i] = services.AddOnSelection{AddOnID: addOnID, Quantity: a.Quantity}
 	}
 
-	// Start transaction
-	tx := database.DB.Begin()
-	if err := tx.Create(&order).Error; err != nil {
-		tx.Rollback()
-		return utils.InternalServerErrorResponse(c, "Failed to create order")
-	}
+	uid, _ := uuid.Parse(userID)
+	cfg, _ := c.Locals("config").(*config.Config)
 
-	// Create tickets
-	tickets, err := ticketService.CreateTicketsFromOrder(
-		order.ID,
-		reservation.TierID,
-		uid,
-		reservation.Quantity,
-	)
+	orderService := services.NewOrderService(services.NewTicketService(), services.NewOrderStore())
+	order, tickets, err := orderService.CreateOrder(c.UserContext(), cfg, services.CreateOrderInput{
+		UserID:        uid,
+		ReservationID: req.ReservationID,
+		Provider:      provider,
+		AddOns:        selections,
+		Answers:       req.Answers,
+	})
 	if err != nil {
-		tx.Rollback()
-		return utils.InternalServerErrorResponse(c, err.Error())
-	}
-
-	// Process payment (simplified - mark as paid immediately)
-	if err := ticketService.ProcessOrderPayment(order.ID); err != nil {
-		tx.Rollback()
-		return utils.InternalServerErrorResponse(c, "Payment processing failed")
+		return err
 	}
 
-	tx.Commit()
-
-	// Delete reservation from Redis
-	ticketService.DeleteReservation(req.ReservationID)
-
-	// Prepare response
-	orderResponse := OrderResponse{
+	response := OrderResponse{
 		ID:          order.ID,
 		TotalAmount: order.TotalAmount,
-		Status:      models.OrderPaid,
-		TicketCount: len(tickets),
+		TaxAmount:   order.TaxAmount,
+		Currency:    order.Currency,
+		Status:      order.Status,
+		TicketCount: order.Quantity,
 		CreatedAt:   order.CreatedAt,
 	}
 
+	// Free orders need no payment and are fulfilled immediately
+	message := "Order created successfully. Initialize payment to complete your purchase."
+	if order.Status == models.OrderPaid {
+		message = "Order created successfully"
+		response.TicketCount = len(tickets)
+	}
+
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
 		"success": true,
-		"message": "Order created and paid successfully",
-		"data":    orderResponse,
+		"message": message,
+		"data":    response,
 	})
 }
 
 // GetMyOrdersHandler godoc
 // @Summary Get user's orders
-// @Description Get all orders placed by the authenticated user
+// @Description Get all orders placed by the authenticated user, newest first. Pass cursor (empty for the first page, then pagination.next_cursor) to page through them.
+// @ID getMyOrders
 // @Tags Orders
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
-// @Success 200 {object} object{success=bool,data=[]OrderResponse}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
+// @Security BearerAuth
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Items per page when paginating" default(10)
+// @Success 200 {object} object{success=bool,data=[]OrderResponse,pagination=object{limit=int,next_cursor=string,has_more=bool}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /orders/my-orders [get]
-func GetMyOrdersHandler(c *fiber.Ctx) error {
-	userID := c.Locals("user_id").(string)
+func GetMyOrdersHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID := c.Locals("user_id").(string)
 
-	uid, _ := uuid.Parse(userID)
-	var orders []models.Order
-	if err := database.DB.Where("user_id = ?", uid).
-		Preload("Tickets").
-		Order("created_at DESC").
-		Find(&orders).Error; err != nil {
-		return utils.InternalServerErrorResponse(c, "Failed to fetch orders")
-	}
+		uid, _ := uuid.Parse(userID)
+
+		useCursor, after, err := cursorQuery(c)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid cursor")
+		}
+
+		var orders []models.Order
+		var pagination fiber.Map
+		if useCursor {
+			limit := queryLimit(c)
+			// Fetch one extra row to learn whether another page follows
+			orders, err = repos.Orders.ListByUserAfter(c.UserContext(), uid, after, limit+1)
+			if err == nil {
+				next := ""
+				if len(orders) > limit {
+					orders = orders[:limit]
+					last := orders[limit-1]
+					next = utils.CursorEncode(last.CreatedAt, last.ID)
+				}
+				pagination = cursorPagination(limit, next)
+			}
+		} else {
+			orders, err = repos.Orders.ListByUser(c.UserContext(), uid)
+		}
+		if err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to fetch orders")
+		}
+
+		orderResponses := make([]OrderResponse, len(orders))
+		for i, order := range orders {
+			orderResponses[i] = OrderResponse{
+				ID:          order.ID,
+				TotalAmount: order.TotalAmount,
+				TaxAmount:   order.TaxAmount,
+				Currency:    order.Currency,
+				Status:      order.Status,
+				TicketCount: len(order.Tickets),
+				CreatedAt:   order.CreatedAt,
+			}
+		}
 
-	orderResponses := make([]OrderResponse, len(orders))
-	for i, order := range orders {
-		orderResponses[i] = OrderResponse{
-			ID:          order.ID,
-			TotalAmount: order.TotalAmount,
-			Status:      order.Status,
-			TicketCount: len(order.Tickets),
-			CreatedAt:   order.CreatedAt,
+		response := fiber.Map{
+			"success": true,
+			"data":    orderResponses,
 		}
+		if pagination != nil {
+			response["pagination"] = pagination
+		}
+		return c.JSON(response)
 	}
+}
 
-	return c.JSON(fiber.Map{
-		"success": true,
-		"data":    orderResponses,
-	})
+// GetOrderHandler godoc
+// @Summary Get an order
+// @Description Get an order owned by the authenticated user with its line items, tickets, payments and refunds. QR codes are included for tickets the user still holds.
+// @ID getOrder
+// @Tags Orders
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} object{success=bool,data=OrderDetailResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /orders/{id} [get]
+func GetOrderHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orderID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid order ID")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		order, err := repos.Orders.FindDetail(c.UserContext(), orderID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Order not found")
+		}
+
+		if order.UserID != uid {
+			return utils.ForbiddenResponse(c, "This order belongs to another user")
+		}
+
+		response := OrderDetailResponse{
+			ID:             order.ID,
+			TotalAmount:    order.TotalAmount,
+			TaxAmount:      order.TaxAmount,
+			TaxName:        order.TaxName,
+			TaxBasisPoints: order.TaxBasisPoints,
+			TaxInclusive:   order.TaxInclusive,
+			Currency:       order.Currency,
+			Status:         order.Status,
+			IsComp:         order.IsComp,
+			ExpiresAt:      order.ExpiresAt,
+			Items:          make([]OrderItemResponse, len(order.Items)),
+			Tickets:        make([]TicketResponse, len(order.Tickets)),
+			Payments:       make([]PaymentResponse, len(order.Payments)),
+			Refunds:        make([]RefundResponse, len(order.Refunds)),
+			CreatedAt:      order.CreatedAt,
+		}
+		if order.Status != models.OrderPending {
+			response.ExpiresAt = nil
+		}
+
+		for i, item := range order.Items {
+			response.Items[i] = OrderItemResponse{
+				Type:      item.Type,
+				Name:      item.Name,
+				Quantity:  item.Quantity,
+				UnitPrice: item.UnitPrice,
+				Amount:    item.Amount,
+				TaxAmount: item.TaxAmount,
+			}
+		}
+		for i, ticket := range order.Tickets {
+			response.Tickets[i] = TicketResponse{
+				ID:         ticket.ID,
+				EventID:    ticket.Tier.EventID,
+				EventTitle: ticket.Tier.Event.Title,
+				TierName:   ticket.Tier.TierName,
+				Status:     ticket.Status,
+				CreatedAt:  ticket.CreatedAt,
+			}
+			// A ticket passed on to someone else is theirs to scan
+			if ticket.OwnerID == uid {
+				response.Tickets[i].QRCode = ticket.QRCode
+			}
+		}
+		for i := range order.Payments {
+			response.Payments[i] = toPaymentResponse(&order.Payments[i])
+		}
+		for i := range order.Refunds {
+			response.Refunds[i] = toRefundResponse(&order.Refunds[i])
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data":    response,
+		})
+	}
 }
 
 // CHECKIN HANDLERS
 
 // ValidateQRCodeHandler godoc
 // @Summary Validate QR code
-// @Description Validate a ticket QR code for event check-in (Organizer/Admin only)
+// @Description Validate a ticket QR code for event check-in, or for one of its sessions when session_id is given. With zone_id the scan is at a zone's entrance and only tickets whose tier grants the zone get in; tickets already checked in at the main gate are let through without a new check-in (Organizer/Admin only, or a scanner token for the event)
+// @ID validateQRCode
 // @Tags Check-in
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
+// @Security BearerAuth
 // @Param request body ValidateQRRequest true "QR validation details"
 // @Success 200 {object} object{success=bool,message=string,data=object}
-// @Failure 400 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 403 {object} object{success=bool,error=object{code=string,message=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string} "Already scanned, with when and by which device"
 // @Router /checkin/validate [post]
 func ValidateQRCodeHandler(c *fiber.Ctx) error {
 	var req ValidateQRRequest
 	if err := c.BodyParser(&req); err != nil {
 		return utils.BadRequestResponse(c, "Invalid request body")
 	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
 
 	eventID, err := uuid.Parse(req.EventID)
 	if err != nil {
 		return utils.BadRequestResponse(c, "Invalid event ID")
 	}
 
+	// Team members and door staff may only scan tickets for their events
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
 	validatorID, _ := uuid.Parse(c.Locals("user_id").(string))
+	device := checkinDevice(c, req.Device)
+
+	var zoneID *uuid.UUID
+	if req.ZoneID != "" {
+		id, err := uuid.Parse(req.ZoneID)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid zone ID")
+		}
+		zoneID = &id
+	}
+
 	ticketService := services.NewTicketService()
 
+	if req.SessionID != "" {
+		sessionID, err := uuid.Parse(req.SessionID)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid session ID")
+		}
+		ticket, err := ticketService.ValidateTicketForSession(req.QRCode, eventID)
+		if err != nil {
+			return err
+		}
+		return checkInSession(c, ticket, sessionID, zoneID, validatorID, models.CheckinQR, device)
+	}
+
+	if zoneID != nil {
+		ticket, err := ticketService.ValidateTicketForZone(req.QRCode, eventID)
+		if err != nil {
+			return err
+		}
+		return checkInZone(c, ticket, eventID, *zoneID, validatorID, models.CheckinQR, device)
+	}
+
 	// Validate ticket
 	ticket, err := ticketService.ValidateTicketForCheckin(req.QRCode, eventID)
 	if err != nil {
-		return utils.BadRequestResponse(c, err.Error())
+		return err
 	}
 
 	// Check in ticket
-	checkin, err := ticketService.CheckInTicket(ticket, validatorID, eventID)
+	return checkInEvent(c, ticket, eventID, nil, validatorID, models.CheckinQR, device)
+}
+
+// checkInZone admits a validated ticket through the entrance of a zone. A
+// ticket not yet checked in is checked in to the event there; one already
+// inside the event is only let through.
+func checkInZone(c *fiber.Ctx, ticket *models.Ticket, eventID, zoneID, validatorID uuid.UUID, method models.CheckinMethod, device string) error {
+	zone, err := services.NewEventZoneService().Authorize(c.UserContext(), ticket, zoneID)
+	if err != nil {
+		return err
+	}
+
+	// A checked-in ticket gets into zones while it is inside the event; one
+	// that checked out at a re-entry event comes back through the entrance
+	if ticket.Status == models.TicketUsed {
+		inside, err := services.NewTicketService().IsInside(c.UserContext(), ticket.ID)
+		if err != nil {
+			return err
+		}
+		if !inside {
+			return utils.BadRequestError("ticket has checked out of the event; check it in again first")
+		}
+	}
+
+	occupancy := services.NewOccupancyService()
+	if err := occupancy.EnterZone(c.UserContext(), zone, ticket.ID); err != nil {
+		return err
+	}
+
+	if ticket.Status != models.TicketUsed {
+		if err := checkInEvent(c, ticket, eventID, &zoneID, validatorID, method, device); err != nil {
+			occupancy.LeaveZone(c.UserContext(), zoneID, ticket.ID)
+			return err
+		}
+		return nil
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Zone access granted",
+		"data": fiber.Map{
+			"ticket_id": ticket.ID,
+			"zone_id":   zone.ID,
+			"zone":      zone.Name,
+			"status":    ticket.Status,
+		},
+	})
+}
+
+// checkInEvent admits a validated ticket to its event, at the entrance of
+// zoneID when set
+func checkInEvent(c *fiber.Ctx, ticket *models.Ticket, eventID uuid.UUID, zoneID *uuid.UUID, validatorID uuid.UUID, method models.CheckinMethod, device string) error {
+	checkin, err := services.NewTicketService().CheckInTicket(c.UserContext(), ticket, validatorID, eventID, zoneID, method, device)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Check-in successful",
+		"data": fiber.Map{
+			"checkin_id": checkin.ID,
+			"ticket_id":  ticket.ID,
+			"zone_id":    checkin.ZoneID,
+			"status":     ticket.Status,
+			"method":     checkin.Method,
+			"direction":  checkin.Direction,
+			"scanned_at": checkin.ScannedAt,
+		},
+	})
+}
+
+// checkInSession admits a validated ticket to one session of an event. A
+// session held in a zone also needs the ticket to grant that zone.
+func checkInSession(c *fiber.Ctx, ticket *models.Ticket, sessionID uuid.UUID, zoneID *uuid.UUID, validatorID uuid.UUID, method models.CheckinMethod, device string) error {
+	if zoneID != nil {
+		if _, err := services.NewEventZoneService().Authorize(c.UserContext(), ticket, *zoneID); err != nil {
+			return err
+		}
+	}
+
+	checkin, err := services.NewEventSessionService().CheckIn(c.UserContext(), ticket, sessionID, validatorID, zoneID, method, device)
 	if err != nil {
-		return utils.InternalServerErrorResponse(c, err.Error())
+		return err
 	}
 
 	return c.JSON(fiber.Map{
 		"success": true,
 		"message": "Check-in successful",
 		"data": fiber.Map{
+			"checkin_id": checkin.ID,
 			"ticket_id":  ticket.ID,
+			"session_id": sessionID,
+			"zone_id":    checkin.ZoneID,
 			"status":     ticket.Status,
+			"method":     checkin.Method,
 			"scanned_at": checkin.ScannedAt,
 		},
 	})
 }
 
+// GetCheckinStatsHandler godoc
+// @Summary Get check-in statistics
+// @Description Get live attendance for an event: totals, per-tier check-ins and check-in rate over time (Organizer/Admin only, or a scanner token for the event)
+// @ID getCheckinStats
+// @Tags Check-in
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param interval query int false "Rate bucket size in minutes (1-60)" default(5)
+// @Success 200 {object} object{success=bool,data=services.CheckinStats}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /checkin/events/{id}/stats [get]
+func GetCheckinStatsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	interval, _ := strconv.Atoi(c.Query("interval", "5"))
+	if interval < 1 || interval > 60 {
+		interval = 5
+	}
+
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	stats, err := services.NewCheckinService().GetEventStats(eventID, interval)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch check-in statistics")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    stats,
+	})
+}
+
 // ADMIN HANDLERS
 
+// UnlockUserHandler godoc
+// @Summary Unlock a user account
+// @Description Clear failed login attempts and any login lockout on a user account (Admin only)
+// @ID unlockUser
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/users/{id}/unlock [post]
+func UnlockUserHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid user ID")
+		}
+
+		user, err := repos.Users.FindByID(c.UserContext(), userID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		audit := beginAudit(c, models.AuditUserUnlocked, models.AuditTargetUser, userID)
+		cfg, _ := c.Locals("config").(*config.Config)
+		if err := services.NewLoginGuard(&cfg.Limits).Reset(c.UserContext(), user.Email); err != nil {
+			return utils.InternalServerErrorResponse(c, "Failed to unlock account")
+		}
+		audit.record(c)
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Account unlocked",
+		})
+	}
+}
+
 // GetAdminStatsHandler godoc
 // @Summary Get admin statistics
-// @Description Get platform statistics and metrics (Admin only)
+// @Description Get platform totals and a dashboard of orders, tickets sold, revenue and refunds per currency and new user signups per day, week or month, with the top events and organizers by tickets sold. The range defaults to the last 30 days; the dashboard is refreshed every 5 minutes (Admin only)
+// @ID getAdminStats
 // @Tags Admin
 // @Accept json
 // @Produce json
-// @Security OAuth2Password
-// @Success 200 {object} object{success=bool,data=object}
-// @Failure 401 {object} object{success=bool,error=object{code=string,message=string}}
-// @Failure 403 {object} object{success=bool,error=object{code=string,message=string}}
+// @Security BearerAuth
+// @Param from query string false "First day of the range (YYYY-MM-DD)"
+// @Param to query string false "Last day of the range, inclusive (YYYY-MM-DD)"
+// @Param interval query string false "Bucket size of the time series" Enums(day, week, month) default(day)
+// @Success 200 {object} object{success=bool,data=object{dashboard=services.PlatformDashboard}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
 // @Router /admin/stats [get]
 func GetAdminStatsHandler(c *fiber.Ctx) error {
 	role := c.Locals("role").(string)
@@ -1002,10 +2110,34 @@ func GetAdminStatsHandler(c *fiber.Ctx) error {
 		return utils.ForbiddenResponse(c, "Admin access required")
 	}
 
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	to := today.AddDate(0, 0, 1)
+	if raw := c.Query("to"); raw != "" {
+		day, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid to date, expected YYYY-MM-DD")
+		}
+		to = day.AddDate(0, 0, 1)
+	}
+	from := to.AddDate(0, 0, -30)
+	if raw := c.Query("from"); raw != "" {
+		day, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid from date, expected YYYY-MM-DD")
+		}
+		from = day
+	}
+	interval := services.DashboardInterval(c.Query("interval", string(services.DashboardDaily)))
+
+	dashboard, err := services.NewAnalyticsService().GetPlatformDashboard(c.UserContext(), from, to, interval)
+	if err != nil {
+		return err
+	}
+
 	var totalUsers int64
 	var totalEvents int64
 	var totalTickets int64
-	var totalRevenue float64
+	var totalRevenue int64
 
 	database.DB.Model(&models.User{}).Count(&totalUsers)
 	database.DB.Model(&models.Event{}).Count(&totalEvents)
@@ -1013,13 +2145,17 @@ func GetAdminStatsHandler(c *fiber.Ctx) error {
 	database.DB.Model(&models.Order{}).Where("status = ?", models.OrderPaid).
 		Select("COALESCE(SUM(total_amount), 0)").Scan(&totalRevenue)
 
+	reservationsReleased, _ := cache.Client.Get(c.Context(), utils.ReservationsReleasedMetricKey).Int64()
+
 	return c.JSON(fiber.Map{
 		"success": true,
 		"data": fiber.Map{
-			"total_users":        totalUsers,
-			"total_events":       totalEvents,
-			"total_tickets_sold": totalTickets,
-			"total_revenue":      totalRevenue,
+			"total_users":           totalUsers,
+			"total_events":          totalEvents,
+			"total_tickets_sold":    totalTickets,
+			"total_revenue":         totalRevenue,
+			"dashboard":             dashboard,
+			"reservations_released": reservationsReleased,
 		},
 	})
 }
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories/repotest"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/jwt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// newRepoTestApp mounts the API routes the way main does, on the in-memory
+// repositories of store instead of the database. Only handlers whose data
+// access goes through the repositories can be called on it.
+func newRepoTestApp(t *testing.T, store *repotest.Store) *fiber.App {
+	t.Helper()
+
+	cfg := &config.Config{
+		App: config.AppConfig{
+			Name:        "Eventix",
+			Environment: "test",
+			Version:     "v1",
+			Versions:    []string{"v1", "v2"},
+		},
+		JWT: config.JWTConfig{
+			Algorithm:          "HS256",
+			Secret:             "unit-test-secret",
+			Expiry:             15 * time.Minute,
+			RefreshTokenExpiry: time.Hour,
+			Issuer:             "eventix-test",
+		},
+		Limits: config.LimitsConfig{
+			IdempotencyTTL:     time.Hour,
+			RequestTimeout:     10 * time.Second,
+			LongRequestTimeout: time.Minute,
+			MaxBodySize:        1024 * 1024,
+		},
+	}
+	if err := jwt.Init(&cfg.JWT); err != nil {
+		t.Fatalf("init JWT: %v", err)
+	}
+
+	app := fiber.New(fiber.Config{ErrorHandler: newErrorHandler(false)})
+	if err := mountAPI(app, config.NewLive(cfg, ""), store.Repositories(), checkContract(t)); err != nil {
+		t.Fatalf("mount API: %v", err)
+	}
+	app.Use(notFoundHandler)
+	return app
+}
+
+// tokenFor signs an access token for user
+func tokenFor(t *testing.T, user *models.User) string {
+	t.Helper()
+
+	token, _, err := jwt.GenerateToken(user.ID.String(), user.Email, string(user.Role), time.Minute)
+	if err != nil {
+		t.Fatalf("generate token: %v", err)
+	}
+	return token
+}
+
+// addAttendee stores an attendee account
+func addAttendee(store *repotest.Store, firstName string) *models.User {
+	user := &models.User{
+		Email:         firstName + "@example.com",
+		FirstName:     firstName,
+		LastName:      "Attendee",
+		Role:          models.RoleAttendee,
+		EmailVerified: true,
+		IsActive:      true,
+	}
+	store.AddUser(user)
+	return user
+}
+
+// addEvent stores a published event starting tomorrow with one tier
+func addEvent(store *repotest.Store, slug string) *models.Event {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	event := &models.Event{
+		OrganizerID: uuid.New(),
+		Title:       "Rooftop Jazz",
+		Slug:        slug,
+		Category:    "music",
+		Location:    "Lagos",
+		Venue:       "The Roof",
+		Currency:    "NGN",
+		StartTime:   start,
+		EndTime:     start.Add(3 * time.Hour),
+		Status:      models.EventPublished,
+		TicketTiers: []models.TicketTier{{
+			TierName:          "General Admission",
+			Price:             500000,
+			Currency:          "NGN",
+			TotalQuantity:     100,
+			AvailableQuantity: 98,
+		}},
+	}
+	store.AddEvent(event)
+	return event
+}
+
+// addPaidOrder stores a paid order of user for quantity tickets of tier,
+// created at createdAt, with its tickets
+func addPaidOrder(store *repotest.Store, user *models.User, tier *models.TicketTier, quantity int, createdAt time.Time) *models.Order {
+	order := &models.Order{
+		UserID:          user.ID,
+		TierID:          tier.ID,
+		Quantity:        quantity,
+		UnitPrice:       tier.Price,
+		TotalAmount:     tier.Price * int64(quantity),
+		Currency:        tier.Currency,
+		PaymentProvider: models.ProviderPaystack,
+		Status:          models.OrderPaid,
+		CreatedAt:       createdAt,
+	}
+	store.AddOrder(order)
+
+	for i := 0; i < quantity; i++ {
+		store.AddTicket(&models.Ticket{
+			OrderID:   order.ID,
+			TierID:    tier.ID,
+			OwnerID:   user.ID,
+			QRCode:    fmt.Sprintf("qr-%s-%d", order.ID, i),
+			Status:    models.TicketActive,
+			CreatedAt: createdAt.Add(time.Duration(i) * time.Second),
+		})
+	}
+	return order
+}
+
+func TestGetEventBySlugHandler(t *testing.T) {
+	store := repotest.New()
+	event := addEvent(store, "rooftop-jazz")
+	app := newRepoTestApp(t, store)
+
+	resp := call(t, app, newRequest(t, http.MethodGet, "/api/v1/events/slug/rooftop-jazz", "", nil))
+	resp.expect(t, fiber.StatusOK)
+	var body struct {
+		Data EventResponse `json:"data"`
+	}
+	resp.decode(t, &body)
+	if body.Data.ID != event.ID {
+		t.Errorf("event ID = %s, want %s", body.Data.ID, event.ID)
+	}
+	if len(body.Data.TicketTiers) != 1 || body.Data.TicketTiers[0].Available != 98 {
+		t.Errorf("ticket tiers = %+v, want the event's one tier with 98 left", body.Data.TicketTiers)
+	}
+
+	call(t, app, newRequest(t, http.MethodGet, "/api/v1/events/slug/no-such-event", "", nil)).expect(t, fiber.StatusNotFound)
+}
+
+func TestGetCurrentUserHandler(t *testing.T) {
+	store := repotest.New()
+	user := addAttendee(store, "ada")
+	app := newRepoTestApp(t, store)
+
+	resp := call(t, app, newRequest(t, http.MethodGet, "/api/v1/users/me", tokenFor(t, user), nil))
+	resp.expect(t, fiber.StatusOK)
+	var body struct {
+		Data UserResponse `json:"data"`
+	}
+	resp.decode(t, &body)
+	if body.Data.ID != user.ID || body.Data.Email != user.Email {
+		t.Errorf("user = %+v, want %s <%s>", body.Data, user.ID, user.Email)
+	}
+
+	call(t, app, newRequest(t, http.MethodGet, "/api/v1/users/me", "", nil)).expect(t, fiber.StatusUnauthorized)
+
+	// A token for an account that is gone
+	gone := &models.User{ID: uuid.New(), Email: "gone@example.com", Role: models.RoleAttendee}
+	call(t, app, newRequest(t, http.MethodGet, "/api/v1/users/me", tokenFor(t, gone), nil)).expect(t, fiber.StatusNotFound)
+}
+
+func TestUpdateProfileHandler(t *testing.T) {
+	store := repotest.New()
+	user := addAttendee(store, "ada")
+	app := newRepoTestApp(t, store)
+
+	resp := call(t, app, newRequest(t, http.MethodPut, "/api/v1/users/me", tokenFor(t, user), fiber.Map{
+		"first_name": "  Grace ",
+		"phone":      "+2348012345678",
+	}))
+	resp.expect(t, fiber.StatusOK)
+
+	// The change went through the user repository
+	stored, err := store.Repositories().Users.FindByID(t.Context(), user.ID)
+	if err != nil {
+		t.Fatalf("find user: %v", err)
+	}
+	if stored.FirstName != "Grace" || stored.LastName != "Attendee" || stored.Phone != "+2348012345678" {
+		t.Errorf("stored user = %s %s %s, want Grace Attendee +2348012345678", stored.FirstName, stored.LastName, stored.Phone)
+	}
+
+	resp = call(t, app, newRequest(t, http.MethodPut, "/api/v1/users/me", tokenFor(t, user), fiber.Map{"phone": "not a phone"}))
+	resp.expect(t, fiber.StatusUnprocessableEntity)
+}
+
+func TestGetOrderHandler(t *testing.T) {
+	store := repotest.New()
+	event := addEvent(store, "rooftop-jazz")
+	buyer := addAttendee(store, "ada")
+	other := addAttendee(store, "grace")
+	order := addPaidOrder(store, buyer, &event.TicketTiers[0], 2, time.Now().Add(-time.Hour))
+	app := newRepoTestApp(t, store)
+
+	path := "/api/v1/orders/" + order.ID.String()
+	resp := call(t, app, newRequest(t, http.MethodGet, path, tokenFor(t, buyer), nil))
+	resp.expect(t, fiber.StatusOK)
+	var body struct {
+		Data OrderDetailResponse `json:"data"`
+	}
+	resp.decode(t, &body)
+	if body.Data.ID != order.ID || body.Data.Status != models.OrderPaid {
+		t.Errorf("order = %s %s, want %s %s", body.Data.ID, body.Data.Status, order.ID, models.OrderPaid)
+	}
+	if len(body.Data.Tickets) != 2 {
+		t.Fatalf("order has %d tickets, want 2", len(body.Data.Tickets))
+	}
+	for _, ticket := range body.Data.Tickets {
+		if ticket.EventID != event.ID || ticket.EventTitle != event.Title {
+			t.Errorf("ticket event = %s %q, want %s %q", ticket.EventID, ticket.EventTitle, event.ID, event.Title)
+		}
+	}
+
+	call(t, app, newRequest(t, http.MethodGet, path, tokenFor(t, other), nil)).expect(t, fiber.StatusForbidden)
+	call(t, app, newRequest(t, http.MethodGet, "/api/v1/orders/"+uuid.NewString(), tokenFor(t, buyer), nil)).expect(t, fiber.StatusNotFound)
+	call(t, app, newRequest(t, http.MethodGet, "/api/v1/orders/not-an-id", tokenFor(t, buyer), nil)).expect(t, fiber.StatusBadRequest)
+	call(t, app, newRequest(t, http.MethodGet, path, "", nil)).expect(t, fiber.StatusUnauthorized)
+}
+
+func TestGetMyOrdersHandlerPages(t *testing.T) {
+	store := repotest.New()
+	event := addEvent(store, "rooftop-jazz")
+	buyer := addAttendee(store, "ada")
+	other := addAttendee(store, "grace")
+	tier := &event.TicketTiers[0]
+
+	now := time.Now()
+	oldest := addPaidOrder(store, buyer, tier, 1, now.Add(-3*time.Hour))
+	middle := addPaidOrder(store, buyer, tier, 2, now.Add(-2*time.Hour))
+	newest := addPaidOrder(store, buyer, tier, 1, now.Add(-time.Hour))
+	addPaidOrder(store, other, tier, 1, now)
+	app := newRepoTestApp(t, store)
+	token := tokenFor(t, buyer)
+
+	type page struct {
+		Data       []OrderResponse `json:"data"`
+		Pagination struct {
+			NextCursor string `json:"next_cursor"`
+			HasMore    bool   `json:"has_more"`
+		} `json:"pagination"`
+	}
+	ids := func(orders []OrderResponse) []uuid.UUID {
+		ids := make([]uuid.UUID, len(orders))
+		for i, order := range orders {
+			ids[i] = order.ID
+		}
+		return ids
+	}
+
+	resp := call(t, app, newRequest(t, http.MethodGet, "/api/v1/orders/my-orders?cursor=&limit=2", token, nil))
+	resp.expect(t, fiber.StatusOK)
+	var first page
+	resp.decode(t, &first)
+	if got := ids(first.Data); len(got) != 2 || got[0] != newest.ID || got[1] != middle.ID {
+		t.Fatalf("first page = %v, want [%s %s]", got, newest.ID, middle.ID)
+	}
+	if !first.Pagination.HasMore || first.Pagination.NextCursor == "" {
+		t.Fatalf("first page pagination = %+v, want a next cursor", first.Pagination)
+	}
+	if first.Data[1].TicketCount != 2 {
+		t.Errorf("ticket count = %d, want 2", first.Data[1].TicketCount)
+	}
+
+	resp = call(t, app, newRequest(t, http.MethodGet, "/api/v1/orders/my-orders?limit=2&cursor="+first.Pagination.NextCursor, token, nil))
+	resp.expect(t, fiber.StatusOK)
+	var second page
+	resp.decode(t, &second)
+	if got := ids(second.Data); len(got) != 1 || got[0] != oldest.ID {
+		t.Fatalf("second page = %v, want [%s]", got, oldest.ID)
+	}
+	if second.Pagination.HasMore {
+		t.Errorf("second page has more")
+	}
+
+	// Without a cursor every order comes back at once
+	resp = call(t, app, newRequest(t, http.MethodGet, "/api/v1/orders/my-orders", token, nil))
+	resp.expect(t, fiber.StatusOK)
+	var all page
+	resp.decode(t, &all)
+	if len(all.Data) != 3 {
+		t.Errorf("got %d orders, want the buyer's 3", len(all.Data))
+	}
+
+	call(t, app, newRequest(t, http.MethodGet, "/api/v1/orders/my-orders?cursor=garbage", token, nil)).expect(t, fiber.StatusBadRequest)
+}
+
+func TestGetTicketHandler(t *testing.T) {
+	store := repotest.New()
+	event := addEvent(store, "rooftop-jazz")
+	buyer := addAttendee(store, "ada")
+	other := addAttendee(store, "grace")
+	order := addPaidOrder(store, buyer, &event.TicketTiers[0], 1, time.Now())
+	tickets, err := store.Repositories().Tickets.ListByOwner(t.Context(), buyer.ID)
+	if err != nil || len(tickets) != 1 {
+		t.Fatalf("list tickets = %d, %v", len(tickets), err)
+	}
+	ticket := tickets[0]
+	app := newRepoTestApp(t, store)
+
+	path := "/api/v1/tickets/" + ticket.ID.String()
+	resp := call(t, app, newRequest(t, http.MethodGet, path, tokenFor(t, buyer), nil))
+	resp.expect(t, fiber.StatusOK)
+	var body struct {
+		Data TicketDetailResponse `json:"data"`
+	}
+	resp.decode(t, &body)
+	if body.Data.OrderID != order.ID || body.Data.EventID != event.ID || body.Data.QRCode != ticket.QRCode {
+		t.Errorf("ticket = %+v, want order %s, event %s and its QR code", body.Data, order.ID, event.ID)
+	}
+
+	// Someone else's ticket is not found rather than forbidden
+	call(t, app, newRequest(t, http.MethodGet, path, tokenFor(t, other), nil)).expect(t, fiber.StatusNotFound)
+}
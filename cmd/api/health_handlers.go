@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/events"
+	"eventix-api/pkg/queue"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// healthCheckTimeout bounds each dependency check so a hung dependency
+// fails readiness instead of the probe timing out
+const healthCheckTimeout = 2 * time.Second
+
+// Dependency states reported by the readiness probe
+const (
+	dependencyUp       = "up"
+	dependencyDown     = "down"
+	dependencyDisabled = "disabled"
+)
+
+type DependencyHealth struct {
+	Status    string  `json:"status"`
+	Critical  bool    `json:"critical"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// dependencyCheck probes one dependency. Requests cannot be served without
+// a critical one; the others only degrade the service.
+type dependencyCheck struct {
+	name     string
+	critical bool
+	enabled  func() bool
+	check    func(ctx context.Context) error
+}
+
+var dependencyChecks = []dependencyCheck{
+	{name: "database", critical: true, check: database.Health},
+	{name: "redis", critical: true, check: cache.Health},
+	{name: "rabbitmq", enabled: queue.Enabled, check: func(context.Context) error { return queue.Health() }},
+	{name: "kafka", enabled: events.Enabled, check: events.Health},
+}
+
+// livenessHandler reports that the process is up and serving. It checks no
+// dependencies so an outage elsewhere never gets the pod restarted.
+func livenessHandler(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"status":  "alive",
+		"service": "eventix-api",
+	})
+}
+
+// readinessHandler checks every dependency concurrently. It answers 503
+// while a critical dependency is down, and 200 with degraded set while only
+// optional ones are.
+func readinessHandler(c *fiber.Ctx) error {
+	results := make(map[string]DependencyHealth, len(dependencyChecks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, dep := range dependencyChecks {
+		if dep.enabled != nil && !dep.enabled() {
+			results[dep.name] = DependencyHealth{Status: dependencyDisabled, Critical: dep.critical}
+			continue
+		}
+
+		wg.Add(1)
+		go func(dep dependencyCheck) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := dep.check(ctx)
+			health := DependencyHealth{
+				Status:    dependencyUp,
+				Critical:  dep.critical,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				health.Status = dependencyDown
+				health.Error = err.Error()
+			}
+
+			mu.Lock()
+			results[dep.name] = health
+			mu.Unlock()
+		}(dep)
+	}
+	wg.Wait()
+
+	status, degraded := "ready", false
+	code := fiber.StatusOK
+	for _, health := range results {
+		if health.Status != dependencyDown {
+			continue
+		}
+		if health.Critical {
+			status, code = "unavailable", fiber.StatusServiceUnavailable
+		} else {
+			degraded = true
+		}
+	}
+	if degraded && code == fiber.StatusOK {
+		status = "degraded"
+	}
+
+	return c.Status(code).JSON(fiber.Map{
+		"status":       status,
+		"degraded":     degraded,
+		"service":      "eventix-api",
+		"dependencies": results,
+	})
+}
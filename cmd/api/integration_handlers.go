@@ -0,0 +1,576 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/currency"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateIntegrationKeyRequest struct {
+	Name string `json:"name" validate:"required,max=100"` // e.g. "Zapier"
+}
+
+type IntegrationKeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Key        string     `json:"key,omitempty"` // only returned when created
+	CreatedBy  uuid.UUID  `json:"created_by"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type IntegrationCompTicketsRequest struct {
+	EventID   string `json:"event_id" validate:"required,uuid"`
+	TierID    string `json:"tier_id" validate:"required,uuid"`
+	Email     string `json:"email" validate:"required,email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Quantity  int    `json:"quantity,omitempty" validate:"omitempty,min=1,max=10"` // defaults to 1
+}
+
+type IntegrationAccountResponse struct {
+	OrganizerID      uuid.UUID `json:"organizer_id"`
+	OrganizationName string    `json:"organization_name"`
+	KeyName          string    `json:"key_name"`
+}
+
+type IntegrationEventResponse struct {
+	ID        uuid.UUID          `json:"id"`
+	Title     string             `json:"title"`
+	Slug      string             `json:"slug"`
+	Status    models.EventStatus `json:"status"`
+	StartTime time.Time          `json:"start_time"`
+	EndTime   time.Time          `json:"end_time"`
+}
+
+type IntegrationTierResponse struct {
+	ID        uuid.UUID `json:"id"`
+	EventID   uuid.UUID `json:"event_id"`
+	Name      string    `json:"name"`
+	Price     string    `json:"price"` // decimal, e.g. "25.00"
+	Currency  string    `json:"currency"`
+	Available int       `json:"available"`
+}
+
+// Integration records are flat, with decimal amounts, so no-code tools can
+// map their fields straight onto spreadsheet columns and mailing list fields
+
+type IntegrationAttendeeResponse struct {
+	ID          uuid.UUID           `json:"id"` // ticket ID
+	OrderID     uuid.UUID           `json:"order_id"`
+	EventID     uuid.UUID           `json:"event_id"`
+	EventTitle  string              `json:"event_title"`
+	TierID      uuid.UUID           `json:"tier_id"`
+	TierName    string              `json:"tier_name"`
+	FirstName   string              `json:"first_name"`
+	LastName    string              `json:"last_name"`
+	Email       string              `json:"email"`
+	Status      models.TicketStatus `json:"status"`
+	CheckedInAt *time.Time          `json:"checked_in_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	Cursor      string              `json:"cursor"` // pass as since to fetch newer records
+}
+
+type IntegrationOrderResponse struct {
+	ID         uuid.UUID          `json:"id"`
+	EventID    uuid.UUID          `json:"event_id"`
+	EventTitle string             `json:"event_title"`
+	TierID     uuid.UUID          `json:"tier_id"`
+	TierName   string             `json:"tier_name"`
+	Quantity   int                `json:"quantity"`
+	Total      string             `json:"total"` // decimal, e.g. "50.00"
+	Currency   string             `json:"currency"`
+	Status     models.OrderStatus `json:"status"`
+	IsComp     bool               `json:"is_comp"`
+	FirstName  string             `json:"first_name"`
+	LastName   string             `json:"last_name"`
+	Email      string             `json:"email"`
+	CreatedAt  time.Time          `json:"created_at"` // when the tickets were issued
+	Cursor     string             `json:"cursor"`     // pass as since to fetch newer records
+}
+
+type IntegrationCompResponse struct {
+	OrderID  uuid.UUID `json:"id"`
+	EventID  uuid.UUID `json:"event_id"`
+	TierID   uuid.UUID `json:"tier_id"`
+	Email    string    `json:"email"`
+	Quantity int       `json:"quantity"`
+}
+
+func toIntegrationKeyResponse(key *models.IntegrationKey) IntegrationKeyResponse {
+	return IntegrationKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		CreatedBy:  key.CreatedBy,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func toIntegrationAttendeeResponse(attendee *services.IntegrationAttendee) IntegrationAttendeeResponse {
+	return IntegrationAttendeeResponse{
+		ID:          attendee.ID,
+		OrderID:     attendee.OrderID,
+		EventID:     attendee.EventID,
+		EventTitle:  attendee.EventTitle,
+		TierID:      attendee.TierID,
+		TierName:    attendee.TierName,
+		FirstName:   attendee.FirstName,
+		LastName:    attendee.LastName,
+		Email:       attendee.Email,
+		Status:      attendee.Status,
+		CheckedInAt: attendee.CheckedInAt,
+		CreatedAt:   attendee.CreatedAt,
+		Cursor:      utils.CursorEncode(attendee.CreatedAt, attendee.ID),
+	}
+}
+
+func toIntegrationOrderResponse(order *services.IntegrationOrder) IntegrationOrderResponse {
+	return IntegrationOrderResponse{
+		ID:         order.ID,
+		EventID:    order.EventID,
+		EventTitle: order.EventTitle,
+		TierID:     order.TierID,
+		TierName:   order.TierName,
+		Quantity:   order.Quantity,
+		Total:      currency.Decimal(order.TotalAmount, order.Currency),
+		Currency:   order.Currency,
+		Status:     order.Status,
+		IsComp:     order.IsComp,
+		FirstName:  order.FirstName,
+		LastName:   order.LastName,
+		Email:      order.Email,
+		CreatedAt:  order.SoldAt,
+		Cursor:     utils.CursorEncode(order.SoldAt, order.ID),
+	}
+}
+
+// integrationOrganizerID returns the organizer of the request's API key
+func integrationOrganizerID(c *fiber.Ctx) uuid.UUID {
+	organizerID, _ := uuid.Parse(c.Locals("organizer_id").(string))
+	return organizerID
+}
+
+// integrationFilter reads the event_id, since and limit query parameters of a
+// polling trigger
+func integrationFilter(c *fiber.Ctx) (services.IntegrationFilter, error) {
+	filter := services.IntegrationFilter{Limit: queryLimit(c)}
+	if raw := c.Query("event_id"); raw != "" {
+		eventID, err := uuid.Parse(raw)
+		if err != nil {
+			return filter, utils.BadRequestError("invalid event ID")
+		}
+		filter.EventID = &eventID
+	}
+	if raw := c.Query("since"); raw != "" {
+		since, err := utils.CursorDecode(raw)
+		if err != nil {
+			return filter, utils.BadRequestError("invalid since cursor")
+		}
+		filter.Since = since
+	}
+	return filter, nil
+}
+
+// INTEGRATION KEY HANDLERS
+
+// ListIntegrationKeysHandler godoc
+// @Summary List my API keys
+// @Description List the API keys that connect Zapier, Make and similar tools to the organizer account, including revoked ones
+// @ID listIntegrationKeys
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]IntegrationKeyResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/api-keys [get]
+func ListIntegrationKeysHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	keys, err := services.NewIntegrationKeyService().List(c.UserContext(), organizer.ID)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]IntegrationKeyResponse, len(keys))
+	for i := range keys {
+		responses[i] = toIntegrationKeyResponse(&keys[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// CreateIntegrationKeyHandler godoc
+// @Summary Create an API key
+// @Description Create a key for the /integrations routes, sent in the X-API-Key header. The key reads the organizer's orders and attendees and can issue comp tickets, acting as the signed-in user. It is only returned here
+// @ID createIntegrationKey
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateIntegrationKeyRequest true "Key name"
+// @Success 201 {object} object{success=bool,message=string,data=IntegrationKeyResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/api-keys [post]
+func CreateIntegrationKeyHandler(c *fiber.Ctx) error {
+	var req CreateIntegrationKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	key, plaintext, err := services.NewIntegrationKeyService().Create(c.UserContext(), organizer.ID, req.Name, uid)
+	if err != nil {
+		return err
+	}
+	recordCreated(c, models.AuditAPIKeyCreated, models.AuditTargetAPIKey, key.ID)
+
+	response := toIntegrationKeyResponse(key)
+	response.Key = plaintext
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "API key created successfully",
+		"data":    response,
+	})
+}
+
+// RevokeIntegrationKeyHandler godoc
+// @Summary Revoke an API key
+// @Description Stop an API key from working. Integrations using it get 401 responses
+// @ID revokeIntegrationKey
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "API key ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/api-keys/{id} [delete]
+func RevokeIntegrationKeyHandler(c *fiber.Ctx) error {
+	keyID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid API key ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	audit := beginAudit(c, models.AuditAPIKeyRevoked, models.AuditTargetAPIKey, keyID)
+	if err := services.NewIntegrationKeyService().Revoke(c.UserContext(), organizer.ID, keyID); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "API key revoked successfully",
+	})
+}
+
+// INTEGRATION HANDLERS
+//
+// The /integrations routes are shaped for Zapier and Make: they authenticate
+// with an X-API-Key header and answer with bare JSON objects and arrays. Errors
+// keep the usual envelope.
+
+// GetIntegrationAccountHandler godoc
+// @Summary Test an API key
+// @Description Return the organizer an API key belongs to. Integrations call it to test a connection and label it
+// @ID getIntegrationAccount
+// @Tags Integrations
+// @Produce json
+// @Security APIKeyAuth
+// @Success 200 {object} IntegrationAccountResponse
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /integrations/me [get]
+func GetIntegrationAccountHandler(c *fiber.Ctx) error {
+	organizer, err := services.NewOrganizerService().GetByID(integrationOrganizerID(c))
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	keyName, _ := c.Locals("api_key_name").(string)
+	return c.JSON(IntegrationAccountResponse{
+		OrganizerID:      organizer.ID,
+		OrganizationName: organizer.OrganizationName,
+		KeyName:          keyName,
+	})
+}
+
+// ListIntegrationEventsHandler godoc
+// @Summary List events for an integration
+// @Description List the organizer's events, latest first, for event pickers in integration setup
+// @ID listIntegrationEvents
+// @Tags Integrations
+// @Produce json
+// @Security APIKeyAuth
+// @Success 200 {array} IntegrationEventResponse
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /integrations/events [get]
+func ListIntegrationEventsHandler(c *fiber.Ctx) error {
+	events, err := services.NewIntegrationService().Events(c.UserContext(), integrationOrganizerID(c))
+	if err != nil {
+		return err
+	}
+
+	responses := make([]IntegrationEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = IntegrationEventResponse{
+			ID:        event.ID,
+			Title:     event.Title,
+			Slug:      event.Slug,
+			Status:    event.Status,
+			StartTime: event.StartTime,
+			EndTime:   event.EndTime,
+		}
+	}
+	return c.JSON(responses)
+}
+
+// ListIntegrationTiersHandler godoc
+// @Summary List ticket tiers for an integration
+// @Description List an event's ticket tiers, for tier pickers in integration setup
+// @ID listIntegrationTiers
+// @Tags Integrations
+// @Produce json
+// @Security APIKeyAuth
+// @Param id path string true "Event ID"
+// @Success 200 {array} IntegrationTierResponse
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /integrations/events/{id}/tiers [get]
+func ListIntegrationTiersHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	tiers, err := services.NewIntegrationService().Tiers(c.UserContext(), integrationOrganizerID(c), eventID)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]IntegrationTierResponse, len(tiers))
+	for i, tier := range tiers {
+		responses[i] = IntegrationTierResponse{
+			ID:        tier.ID,
+			EventID:   tier.EventID,
+			Name:      tier.TierName,
+			Price:     currency.Decimal(tier.Price, tier.Currency),
+			Currency:  tier.Currency,
+			Available: tier.AvailableQuantity,
+		}
+	}
+	return c.JSON(responses)
+}
+
+// NewAttendeesTriggerHandler godoc
+// @Summary Poll for new attendees
+// @Description Polling trigger for issued tickets with their holder's name and email, newest first. Zapier deduplicates by id; other pollers pass the newest record's cursor as since to get only the records issued after it
+// @ID newAttendeesTrigger
+// @Tags Integrations
+// @Produce json
+// @Security APIKeyAuth
+// @Param event_id query string false "Only this event"
+// @Param since query string false "Cursor of the newest record already seen"
+// @Param limit query int false "Maximum records" default(10)
+// @Success 200 {array} IntegrationAttendeeResponse
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /integrations/triggers/attendees [get]
+func NewAttendeesTriggerHandler(c *fiber.Ctx) error {
+	filter, err := integrationFilter(c)
+	if err != nil {
+		return err
+	}
+
+	attendees, err := services.NewIntegrationService().NewAttendees(c.UserContext(), integrationOrganizerID(c), filter)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]IntegrationAttendeeResponse, len(attendees))
+	for i := range attendees {
+		responses[i] = toIntegrationAttendeeResponse(&attendees[i])
+	}
+	return c.JSON(responses)
+}
+
+// NewOrdersTriggerHandler godoc
+// @Summary Poll for new orders
+// @Description Polling trigger for sold orders, comps included, with the buyer's name and email, newest first by when their tickets were issued. Refunded orders keep their place with status refunded. Paged by since like the attendees trigger
+// @ID newOrdersTrigger
+// @Tags Integrations
+// @Produce json
+// @Security APIKeyAuth
+// @Param event_id query string false "Only this event"
+// @Param since query string false "Cursor of the newest record already seen"
+// @Param limit query int false "Maximum records" default(10)
+// @Success 200 {array} IntegrationOrderResponse
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /integrations/triggers/orders [get]
+func NewOrdersTriggerHandler(c *fiber.Ctx) error {
+	filter, err := integrationFilter(c)
+	if err != nil {
+		return err
+	}
+
+	orders, err := services.NewIntegrationService().NewOrders(c.UserContext(), integrationOrganizerID(c), filter)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]IntegrationOrderResponse, len(orders))
+	for i := range orders {
+		responses[i] = toIntegrationOrderResponse(&orders[i])
+	}
+	return c.JSON(responses)
+}
+
+// FindAttendeeSearchHandler godoc
+// @Summary Find an attendee
+// @Description Search action returning the issued tickets held by an email address, newest first. An empty array means no match
+// @ID findAttendeeSearch
+// @Tags Integrations
+// @Produce json
+// @Security APIKeyAuth
+// @Param email query string true "Attendee email"
+// @Param event_id query string false "Only this event"
+// @Param limit query int false "Maximum records" default(10)
+// @Success 200 {array} IntegrationAttendeeResponse
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /integrations/searches/attendees [get]
+func FindAttendeeSearchHandler(c *fiber.Ctx) error {
+	email := c.Query("email")
+	if email == "" {
+		return utils.BadRequestResponse(c, "email is required")
+	}
+
+	filter, err := integrationFilter(c)
+	if err != nil {
+		return err
+	}
+
+	attendees, err := services.NewIntegrationService().FindAttendees(c.UserContext(), integrationOrganizerID(c), filter.EventID, email, filter.Limit)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]IntegrationAttendeeResponse, len(attendees))
+	for i := range attendees {
+		responses[i] = toIntegrationAttendeeResponse(&attendees[i])
+	}
+	return c.JSON(responses)
+}
+
+// IssueCompTicketsActionHandler godoc
+// @Summary Issue comp tickets from an integration
+// @Description Action giving one person free tickets of a tier, for example everyone added to a sponsor sheet. They get a zero-priced order and their tickets by email, like comps issued from the dashboard
+// @ID issueCompTicketsAction
+// @Tags Integrations
+// @Accept json
+// @Produce json
+// @Security APIKeyAuth
+// @Param request body IntegrationCompTicketsRequest true "Event, tier and recipient"
+// @Success 201 {object} IntegrationCompResponse
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /integrations/actions/comp-tickets [post]
+func IssueCompTicketsActionHandler(c *fiber.Ctx) error {
+	var req IntegrationCompTicketsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	eventID, _ := uuid.Parse(req.EventID)
+	tierID, _ := uuid.Parse(req.TierID)
+
+	// Tiers checks the event belongs to the key's organizer
+	tiers, err := services.NewIntegrationService().Tiers(c.UserContext(), integrationOrganizerID(c), eventID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, tier := range tiers {
+		found = found || tier.ID == tierID
+	}
+	if !found {
+		return utils.NotFoundResponse(c, "Ticket tier not found")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	orders, err := services.NewCompService(cfg).Issue(c.UserContext(), eventID, tierID, []services.CompRecipient{{
+		Email:     req.Email,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Quantity:  req.Quantity,
+	}})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	order := orders[0]
+	recordCreated(c, models.AuditOrderComped, models.AuditTargetOrder, order.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(IntegrationCompResponse{
+		OrderID:  order.ID,
+		EventID:  eventID,
+		TierID:   tierID,
+		Email:    req.Email,
+		Quantity: order.Quantity,
+	})
+}
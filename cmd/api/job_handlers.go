@@ -0,0 +1,226 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type JobResponse struct {
+	ID          uuid.UUID        `json:"id"`
+	Type        string           `json:"type"`
+	Payload     json.RawMessage  `json:"payload" swaggertype:"object"`
+	Status      models.JobStatus `json:"status"`
+	Attempts    int              `json:"attempts"`
+	MaxAttempts int              `json:"max_attempts"`
+	RunAt       time.Time        `json:"run_at"`
+	LockedUntil *time.Time       `json:"locked_until,omitempty"`
+	Error       string           `json:"error,omitempty"`
+	Schedule    string           `json:"schedule,omitempty"`
+	RequestID   string           `json:"request_id,omitempty"`
+	FinishedAt  *time.Time       `json:"finished_at,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+func toJobResponse(job *models.Job) JobResponse {
+	return JobResponse{
+		ID:          job.ID,
+		Type:        job.Type,
+		Payload:     json.RawMessage(job.Payload),
+		Status:      job.Status,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LockedUntil: job.LockedUntil,
+		Error:       job.Error,
+		Schedule:    job.Schedule,
+		RequestID:   job.RequestID,
+		FinishedAt:  job.FinishedAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+}
+
+// BACKGROUND JOB HANDLERS
+
+// ListJobsHandler godoc
+// @Summary List background jobs
+// @Description List jobs in the background queue, most recently updated first, by default the dead letters that failed every attempt (Admin only)
+// @ID listJobs
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Job status (pending, running, succeeded, dead)" default(dead)
+// @Param type query string false "Job type, such as emails.event_cancelled"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]JobResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/jobs [get]
+func ListJobsHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit := queryLimit(c)
+	status := models.JobStatus(c.Query("status", string(models.JobDead)))
+
+	if page < 1 {
+		page = 1
+	}
+
+	jobs, total, err := services.NewJobService().List(c.UserContext(), services.JobFilter{
+		Status: status,
+		Type:   c.Query("type"),
+		Offset: (page - 1) * limit,
+		Limit:  limit,
+	})
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch jobs")
+	}
+
+	responses := make([]JobResponse, len(jobs))
+	for i := range jobs {
+		responses[i] = toJobResponse(&jobs[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// ListJobSchedulesHandler godoc
+// @Summary List background job schedules
+// @Description List the cron schedules that enqueue periodic jobs, with when each last ran and runs next (Admin only)
+// @ID listJobSchedules
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]models.JobSchedule}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/jobs/schedules [get]
+func ListJobSchedulesHandler(c *fiber.Ctx) error {
+	schedules, err := services.NewJobService().Schedules(c.UserContext())
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch job schedules")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    schedules,
+	})
+}
+
+// GetJobHandler godoc
+// @Summary Get a background job
+// @Description Get a job with its payload and the error of its latest failed attempt (Admin only)
+// @ID getJob
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} object{success=bool,data=JobResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/jobs/{id} [get]
+func GetJobHandler(c *fiber.Ctx) error {
+	jobID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid job ID")
+	}
+
+	job, err := services.NewJobService().Get(c.UserContext(), jobID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    toJobResponse(job),
+	})
+}
+
+// RetryJobHandler godoc
+// @Summary Retry a dead background job
+// @Description Return a dead job to the queue with its attempts reset, once whatever made it fail is fixed (Admin only)
+// @ID retryJob
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} object{success=bool,message=string,data=JobResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/jobs/{id}/retry [post]
+func RetryJobHandler(c *fiber.Ctx) error {
+	jobID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid job ID")
+	}
+
+	audit := beginAudit(c, models.AuditJobRetried, models.AuditTargetJob, jobID)
+	job, err := services.NewJobService().Retry(c.UserContext(), jobID)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Job queued for retry",
+		"data":    toJobResponse(job),
+	})
+}
+
+// DiscardJobHandler godoc
+// @Summary Discard a background job
+// @Description Delete a dead or pending job so it never runs. Running jobs cannot be discarded (Admin only)
+// @ID discardJob
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/jobs/{id} [delete]
+func DiscardJobHandler(c *fiber.Ctx) error {
+	jobID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid job ID")
+	}
+
+	audit := beginAudit(c, models.AuditJobDiscarded, models.AuditTargetJob, jobID)
+	if err := services.NewJobService().Discard(c.UserContext(), jobID); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Job discarded",
+	})
+}
@@ -1,25 +1,40 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"eventix-api/internal/jobs"
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/internal/workers"
 	"eventix-api/pkg/cache"
 	"eventix-api/pkg/config"
 	"eventix-api/pkg/database"
+	"eventix-api/pkg/events"
 	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/lifecycle"
 	"eventix-api/pkg/logger"
 	"eventix-api/pkg/middleware"
+	"eventix-api/pkg/openapi"
+	"eventix-api/pkg/queue"
+	"eventix-api/pkg/realtime"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/ticketqr"
+	"eventix-api/pkg/utils"
 
 	"github.com/gofiber/fiber/v2"
 	swagger "github.com/gofiber/swagger"
 	"go.uber.org/zap"
 
-	// Import swagger docs - auto-generated
-	_ "eventix-api/docs"
+	// Swagger docs, generated by cmd/gendocs
+	"eventix-api/docs"
 )
 
 // @title Eventix Ticket Booking API
@@ -33,20 +48,35 @@ import (
 // @license.name MIT
 // @license.url https://opensource.org/licenses/MIT
 
-// @host localhost:8080
 // @BasePath /api/v1
 
-// @securityDefinitions.oauth2.password OAuth2Password
-// @tokenUrl /api/v1/auth/login
-// @scope.write Grants write access
-// @scope.read Grants read access
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token from /auth/login, /auth/refresh or /auth/guest, as "Bearer <token>"
+
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
+// @description Organizer API key for the /integrations routes, from /organizer/api-keys
+
+//go:generate go run ../gendocs -root ../..
 
 func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or JSON config file")
+	validateConfig := flag.Bool("validate-config", false, "check the configuration and exit")
+	flag.Parse()
+
 	// Load configuration
-	cfg, err := config.Load()
+	cfg, err := config.LoadFile(*configFile)
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
+	if *validateConfig {
+		fmt.Println("Configuration is valid")
+		return
+	}
+	live := config.NewLive(cfg, *configFile)
 
 	// Initialize logger
 	if err := logger.Init(cfg.App.LogLevel, cfg.App.LogFormat); err != nil {
@@ -60,7 +90,12 @@ func main() {
 	)
 
 	// Initialize JWT
-	jwt.Init(&cfg.JWT)
+	if err := jwt.Init(&cfg.JWT); err != nil {
+		logger.Fatal("Failed to initialize JWT signing", zap.Error(err))
+	}
+
+	// Initialize ticket QR signing
+	ticketqr.Init(&cfg.Ticket)
 
 	// Connect to database
 	if err := database.Connect(&cfg.Database); err != nil {
@@ -74,66 +109,158 @@ func main() {
 	}
 	defer cache.Close()
 
+	// Invalidate cached event responses on event and tier writes
+	if err := services.RegisterEventCacheInvalidation(database.DB); err != nil {
+		logger.Fatal("Failed to register event cache invalidation", zap.Error(err))
+	}
+
+	// Connect to RabbitMQ for asynchronous jobs
+	if err := queue.Connect(&cfg.RabbitMQ); err != nil {
+		logger.Fatal("Failed to connect to RabbitMQ", zap.Error(err))
+	}
+	defer queue.Close()
+
+	// Configure domain event publishing
+	events.Init(&cfg.Kafka)
+	defer events.Close()
+
+	// Configure file storage
+	if err := storage.Connect(&cfg.S3); err != nil {
+		logger.Fatal("Failed to configure file storage", zap.Error(err))
+	}
+
 	// Create Fiber app
 	app := fiber.New(fiber.Config{
 		AppName:      cfg.App.Name,
-		ErrorHandler: customErrorHandler,
+		ErrorHandler: newErrorHandler(cfg.App.Environment == "production"),
 		BodyLimit:    10 * 1024 * 1024, // 10MB
 	})
 
 	// Global middleware
 	app.Use(middleware.Recover())
-	app.Use(middleware.Logger())
-	app.Use(middleware.CORS(&cfg.CORS))
-	app.Use(middleware.RateLimiter(&cfg.Limits))
+	app.Use(middleware.SecurityHeaders(&cfg.Server))
+	app.Use(middleware.Logger(cfg.Limits.SlowRequestThreshold))
+	var originResolver middleware.OriginResolver
+	if cfg.CORS.OrganizerOrigins {
+		originResolver = services.NewWidgetService()
+	}
+	app.Use(middleware.CORS(&cfg.CORS, originResolver))
+	app.Use(middleware.RateLimiter(func() *config.LimitsConfig { return &live.Get().Limits }))
+	app.Use(middleware.SanitizeRequests())
 
 	// API info endpoint at root
 	app.Get("/", func(c *fiber.Ctx) error {
+		links := fiber.Map{
+			"health":   fmt.Sprintf("http://%s/health/ready", c.Hostname()),
+			"api_base": fmt.Sprintf("http://%s/api/%s", c.Hostname(), cfg.App.Version),
+		}
+		if cfg.Server.SwaggerEnabled {
+			links["documentation"] = fmt.Sprintf("http://%s/swagger/index.html", c.Hostname())
+		}
+
 		return c.JSON(fiber.Map{
 			"name":        cfg.App.Name,
 			"version":     cfg.App.Version,
 			"environment": cfg.App.Environment,
 			"description": "Production-grade ticket booking system API for events, organizers, and attendees",
-			"links": fiber.Map{
-				"health":        fmt.Sprintf("http://%s/health", c.Hostname()),
-				"documentation": fmt.Sprintf("http://%s/swagger/index.html", c.Hostname()),
-				"api_base":      fmt.Sprintf("http://%s/api/%s", c.Hostname(), cfg.App.Version),
-			},
-			"status": "running",
+			"links":       links,
+			"status":      "running",
 		})
 	})
 
-	// Swagger documentation endpoint
-	app.Get("/swagger/*", swagger.HandlerDefault)
+	// Swagger documentation, served for the linked API version on whichever
+	// host the page was loaded from so try-it-out calls this server
+	docs.SwaggerInfo.Host = ""
+	docs.SwaggerInfo.BasePath = "/api/" + cfg.App.Version
+	docs.SwaggerInfo.Version = cfg.App.Version
+	if cfg.Server.SwaggerEnabled {
+		app.Get("/swagger/*", swagger.HandlerDefault)
+	}
 
-	// Health check endpoint
-	app.Get("/health", healthCheckHandler)
+	// Responses checked against the same docs, when enabled
+	var contractCheck fiber.Handler
+	if cfg.Server.ContractCheckEnabled {
+		spec, err := openapi.Load([]byte(docs.SwaggerInfo.ReadDoc()))
+		if err != nil {
+			logger.Fatal("Failed to load Swagger docs", zap.Error(err))
+		}
+		contractCheck = middleware.ContractCheck(spec)
+	}
 
-	// API routes
-	api := app.Group(fmt.Sprintf("/api/%s", cfg.App.Version))
+	// Health probes: liveness never touches dependencies, readiness does
+	app.Get("/health/live", livenessHandler)
+	app.Get("/health/ready", readinessHandler)
 
-	// Inject config into context so handlers can access it
-	api.Use(func(c *fiber.Ctx) error {
-		c.Locals("config", cfg)
-		return c.Next()
-	})
+	// Public keys for services verifying our access tokens
+	app.Get("/.well-known/jwks.json", jwksHandler)
 
-	setupRoutes(api, cfg)
+	// API routes, with data access for handlers
+	if err := mountAPI(app, live, repositories.New(database.DB), contractCheck); err != nil {
+		logger.Fatal("Invalid API versions", zap.Error(err))
+	}
 
 	// 404 handler
 	app.Use(notFoundHandler)
 
-	// Graceful shutdown
+	// Background jobs: emails, refunds and the periodic sweeps
+	runner := jobs.NewRunner(cfg.Limits.JobPollInterval, cfg.Limits.JobConcurrency)
+	services.RegisterJobs(runner, cfg)
+	if err := workers.RegisterJobs(runner, cfg); err != nil {
+		logger.Fatal("Invalid job schedule", zap.Error(err))
+	}
+
+	// Background workers, drained on shutdown
+	lc := lifecycle.New()
+	lc.Go("jobs", runner.Start)
+	lc.Go("payment reconciliation", workers.NewPaymentReconciliationWorker(cfg).Start)
+	lc.Go("reconciliation report", workers.NewReconciliationReportWorker(&cfg.Payment).Start)
+	lc.Go("warehouse export", workers.NewWarehouseExportWorker(&cfg.Export).Start)
+	lc.Go("account anonymization", workers.NewAccountAnonymizationWorker(cfg.Limits.AccountPurgeInterval, cfg.Limits.AccountDeletionGrace).Start)
+	lc.Go("webhook delivery", workers.NewWebhookDeliveryWorker(cfg.Limits.WebhookDeliveryInterval, cfg.Limits.WebhookMaxAttempts).Start)
+	lc.Go("outbox relay", workers.NewOutboxRelayWorker(cfg.Limits.OutboxRelayInterval, cfg.Limits.OutboxRetention).Start)
+
+	lc.Go("waiting room", workers.NewWaitingRoomWorker(&cfg.Limits).Start)
+
+	// Relay realtime updates published by any replica to local WebSocket clients
+	lc.Go("realtime relay", realtime.Run)
+
+	// Reload non-critical settings such as rate limits on SIGHUP
+	go func() {
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		for range hup {
+			reloaded, err := live.Reload()
+			if err != nil {
+				logger.Error("Failed to reload configuration, keeping the current one", zap.Error(err))
+				continue
+			}
+			logger.Info("Configuration reloaded",
+				zap.Int("rate_limit_requests", reloaded.Limits.RateLimitRequests),
+				zap.Duration("rate_limit_window", reloaded.Limits.RateLimitWindow),
+			)
+		}
+	}()
+
+	// Graceful shutdown: stop taking requests, let in-flight requests and
+	// worker jobs finish, then return so the deferred closes run
+	stopped := make(chan struct{})
 	go func() {
+		defer close(stopped)
+
 		sigChan := make(chan os.Signal, 1)
 		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 		<-sigChan
 
-		logger.Info("Shutting down server...")
+		logger.Info("Shutting down server...", zap.Duration("timeout", cfg.Server.ShutdownTimeout))
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
 
-		if err := app.Shutdown(); err != nil {
+		if err := app.ShutdownWithContext(ctx); err != nil {
 			logger.Error("Server shutdown error", zap.Error(err))
 		}
+		if err := lc.Shutdown(ctx); err != nil {
+			logger.Warn("Workers did not drain in time", zap.Error(err))
+		}
 	}()
 
 	// Start server
@@ -143,72 +270,333 @@ func main() {
 	if err := app.Listen(addr); err != nil {
 		logger.Fatal("Server failed to start", zap.Error(err))
 	}
+
+	<-stopped
+	logger.Info("Server stopped")
 }
 
-func setupRoutes(api fiber.Router, cfg *config.Config) {
+// mountAPI serves the API routes once per version under /api. Handlers
+// shape responses for their version with the mappers in versioning.go and
+// are given repos for their data access. contractCheck, when not nil,
+// checks every API response.
+func mountAPI(app *fiber.App, live *config.Live, repos *repositories.Repositories, contractCheck fiber.Handler) error {
+	cfg := live.Get()
+	versions, err := middleware.APIVersions(&cfg.App)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		api := app.Group("/api/"+version.Name, middleware.Versioned(version))
+
+		// Inject config into context so handlers can access it
+		api.Use(func(c *fiber.Ctx) error {
+			c.Locals("config", live.Get())
+			return c.Next()
+		})
+
+		// Deadlines and body limits for API requests; see setupRoutes for the
+		// routes given LongRequestTimeout
+		api.Use(middleware.Timeout(cfg.Limits.RequestTimeout))
+		api.Use(middleware.BodyLimit(cfg.Limits.MaxBodySize))
+		if contractCheck != nil {
+			api.Use(contractCheck)
+		}
+
+		setupRoutes(api, cfg, repos)
+	}
+	return nil
+}
+
+func setupRoutes(api fiber.Router, cfg *config.Config, repos *repositories.Repositories) {
+	// Exports, uploads and event cancellation may outlast the default timeout
+	long := middleware.Timeout(cfg.Limits.LongRequestTimeout)
+
 	// Auth routes
 	auth := api.Group("/auth")
-	auth.Post("/register", RegisterHandler)
-	auth.Post("/verify-email", VerifyEmailHandler)
-	auth.Post("/login", LoginHandler)
-	auth.Post("/refresh", RefreshTokenHandler)
+	auth.Post("/register", middleware.StrictRateLimiter(), RegisterHandler(repos))
+	auth.Post("/verify-email", VerifyEmailHandler(repos))
+	auth.Post("/login", middleware.StrictRateLimiter(), LoginHandler(repos))
+	auth.Post("/refresh", middleware.CSRF(), RefreshTokenHandler(repos))
+	auth.Post("/logout", middleware.CSRF(), LogoutHandler)
+	auth.Get("/csrf", GetCSRFTokenHandler)
+	auth.Post("/forgot-password", middleware.StrictRateLimiter(), ForgotPasswordHandler(repos))
+	auth.Post("/reset-password", ResetPasswordHandler(repos))
+	auth.Post("/guest", requireFeature(services.FeatureGuestCheckout), middleware.StrictRateLimiter(), GuestCheckoutHandler(repos))
+	auth.Post("/claim-account", ClaimAccountHandler(repos))
+
+	// Webhook routes (authenticated by provider signatures)
+	webhooks := api.Group("/webhooks")
+	webhooks.Post("/payments", PaymentWebhookHandler)
+
+	// Event routes (public). Registered before the protected group because its
+	// auth middleware applies to every route added under the API prefix after it.
+	events := api.Group("/events")
+	events.Get("/", ListEventsHandler(repos))
+	events.Get("/slug/:slug", GetEventBySlugHandler(repos))
+	events.Get("/recommended", middleware.OptionalAuthMiddleware(), GetRecommendedEventsHandler(repos))
+	events.Get("/featured", GetFeaturedEventsHandler(repos))
+	events.Get("/trending", GetTrendingEventsHandler(repos))
+	events.Get("/:id", GetEventHandler(repos))
+	events.Get("/:id/sessions", ListEventSessionsHandler)
+	events.Get("/:id/zones", ListEventZonesHandler)
+	events.Get("/:id/form-fields", ListFormFieldsHandler)
+	events.Get("/:id/add-ons", ListAddOnsHandler)
+
+	// Currency routes (public)
+	api.Get("/currencies", ListCurrenciesHandler)
+
+	// Category routes (public)
+	api.Get("/categories", ListCategoriesHandler)
+
+	// GraphQL (public, with the caller's tickets and orders when authenticated)
+	graphql := GraphQLHandler()
+	api.Get("/graphql", middleware.OptionalAuthMiddleware(), graphql)
+	api.Post("/graphql", middleware.OptionalAuthMiddleware(), graphql)
+
+	// Zapier and Make integrations (authenticated by organizer API keys)
+	integrations := api.Group("/integrations", middleware.APIKeyMiddleware(services.NewIntegrationKeyService()))
+	integrations.Get("/me", GetIntegrationAccountHandler)
+	integrations.Get("/events", ListIntegrationEventsHandler)
+	integrations.Get("/events/:id/tiers", ListIntegrationTiersHandler)
+	integrations.Get("/triggers/attendees", NewAttendeesTriggerHandler)
+	integrations.Get("/triggers/orders", NewOrdersTriggerHandler)
+	integrations.Get("/searches/attendees", FindAttendeeSearchHandler)
+	integrations.Post("/actions/comp-tickets", IssueCompTicketsActionHandler)
+
+	// Embeddable checkout widget, answering cross-origin requests from the
+	// sites each event's organizer allowed. Registered as routes so the
+	// origin check sees the event ID, and before the checkout routes below
+	// so it runs ahead of their auth.
+	widgetCORS := middleware.WidgetCORS(services.NewWidgetService())
+	widget := api.Group("/widget")
+	widget.Options("/events/:id", widgetCORS)
+	widget.Options("/events/:id/*", widgetCORS)
+	widget.Get("/events/:id", widgetCORS, GetWidgetEventHandler(repos))
+	widget.Post("/events/:id/guest", widgetCORS, requireFeature(services.FeatureGuestCheckout), middleware.StrictRateLimiter(), WidgetGuestCheckoutHandler(repos))
+	widget.Post("/events/:id/reserve", widgetCORS, middleware.GuestCheckoutMiddleware(), WidgetReserveTicketHandler(repos))
+	widget.Post("/events/:id/orders", widgetCORS, middleware.GuestCheckoutMiddleware(), middleware.Idempotency(cfg.Limits.IdempotencyTTL), WidgetCreateOrderHandler(repos))
+	widget.Post("/events/:id/payments/initialize", widgetCORS, middleware.GuestCheckoutMiddleware(), middleware.Idempotency(cfg.Limits.IdempotencyTTL), WidgetInitializePaymentHandler(repos))
+
+	// Live availability (public WebSocket)
+	api.Get("/ws", RequireWebSocketUpgrade, AvailabilitySocketHandler(repos))
+
+	// Checkout routes, which also accept guest checkout tokens. Registered as
+	// routes rather than groups so their middleware stays off other paths.
+	guestCheckout := middleware.GuestCheckoutMiddleware()
+	waitingRoom := requireFeature(services.FeatureWaitingRoom)
+	api.Post("/events/:id/queue", waitingRoom, guestCheckout, JoinWaitingRoomHandler)
+	api.Get("/events/:id/queue/:token", waitingRoom, guestCheckout, GetWaitingRoomPositionHandler)
+	api.Post("/tickets/reserve", guestCheckout, ReserveTicketHandler)
+	api.Post("/orders", guestCheckout, middleware.Idempotency(cfg.Limits.IdempotencyTTL), CreateOrderHandler)
+	api.Post("/payments/initialize", guestCheckout, middleware.Idempotency(cfg.Limits.IdempotencyTTL), InitializePaymentHandler)
+	api.Post("/orders/:id/retry-payment", guestCheckout, middleware.Idempotency(cfg.Limits.IdempotencyTTL), RetryPaymentHandler)
+	api.Get("/payments/verify/:reference", guestCheckout, VerifyPaymentHandler)
+
+	// Organizer routes check permissions rather than roles, so organizers can
+	// delegate some of them to their team
+	permissions := services.NewPermissionService()
+	can := func(permission models.Permission) fiber.Handler {
+		return middleware.PermissionMiddleware(permissions, string(permission))
+	}
+
+	// Check-in routes (checkin:scan), which also accept door staff scanner
+	// tokens. Registered before the protected group, which rejects them.
+	checkinAuth := middleware.CheckinAuthMiddleware()
+	api.Post("/checkin/validate", checkinAuth, can(models.PermCheckinScan), ValidateQRCodeHandler)
+	api.Get("/checkin/events/:id/stats", checkinAuth, can(models.PermCheckinScan), GetCheckinStatsHandler)
+	api.Get("/checkin/events/:id/stream", checkinAuth, can(models.PermCheckinScan), StreamCheckinsHandler)
+	api.Get("/checkin/events/:id/search", checkinAuth, can(models.PermCheckinScan), SearchCheckinAttendeesHandler)
+	api.Post("/checkin/manual", checkinAuth, can(models.PermCheckinScan), ManualCheckinHandler)
+	api.Post("/checkin/checkout", checkinAuth, can(models.PermCheckinScan), CheckoutHandler)
+	api.Get("/checkin/tickets/:id/scans", checkinAuth, can(models.PermCheckinScan), GetTicketScansHandler)
+	api.Get("/checkin/events/:id/occupancy", checkinAuth, can(models.PermCheckinScan), GetEventOccupancyHandler)
+	api.Get("/checkin/events/:id/badges", checkinAuth, can(models.PermCheckinScan), ListBadgesHandler)
+	api.Post("/checkin/badges/:id/print", checkinAuth, can(models.PermCheckinScan), PrintBadgeHandler)
 
 	// Protected routes
 	protected := api.Group("", middleware.AuthMiddleware())
 
+	// Undoing a check-in is left to the organizer's team, not door staff
+	protected.Post("/checkin/undo", can(models.PermCheckinScan), UndoCheckinHandler)
+
 	// User routes
 	users := protected.Group("/users")
-	users.Get("/me", GetCurrentUserHandler)
-
-	// Event routes (public)
-	events := api.Group("/events")
-	events.Get("/", ListEventsHandler)
-	events.Get("/:id", GetEventHandler)
-
-	// Event routes (protected - organizer/admin only)
-	organizerEvents := protected.Group("/events", middleware.RoleMiddleware("organizer", "admin"))
-	organizerEvents.Post("/", CreateEventHandler)
+	users.Get("/me", GetCurrentUserHandler(repos))
+	users.Put("/me", UpdateProfileHandler(repos))
+	users.Delete("/me", DeleteAccountHandler(repos))
+	users.Get("/me/export", long, ExportAccountHandler(repos))
+	users.Post("/me/change-password", ChangePasswordHandler(repos))
+	users.Get("/me/sessions", GetMySessionsHandler)
+	users.Delete("/me/sessions/:id", RevokeMySessionHandler)
+	users.Get("/me/favorites", GetMyFavoritesHandler)
+	users.Get("/me/notifications", GetMyNotificationsHandler)
+	users.Get("/me/notification-preferences", GetNotificationPreferencesHandler)
+	users.Put("/me/notification-preferences", UpdateNotificationPreferencesHandler)
+
+	// Saved event and report routes, registered before the organizer-only events group
+	protected.Post("/events/:id/favorite", FavoriteEventHandler)
+	protected.Delete("/events/:id/favorite", UnfavoriteEventHandler)
+	protected.Post("/events/:id/report", ReportEventHandler)
+
+	// Notification routes
+	notifications := protected.Group("/notifications")
+	notifications.Post("/:id/read", MarkNotificationReadHandler)
+
+	// Event routes (protected - events:create and events:manage)
+	protected.Post("/events", can(models.PermEventsCreate), CreateEventHandler)
+	organizerEvents := protected.Group("/events", can(models.PermEventsManage))
+	organizerEvents.Post("/:id/submit", SubmitEventHandler)
+	organizerEvents.Post("/:id/cancel", long, CancelEventHandler)
+	organizerEvents.Put("/:id/reschedule", RescheduleEventHandler)
+	organizerEvents.Get("/:id/reschedules", ListEventReschedulesHandler)
+	organizerEvents.Get("/:id/status-history", GetEventStatusHistoryHandler)
+	organizerEvents.Post("/:id/duplicate", DuplicateEventHandler)
+	organizerEvents.Post("/:id/banner", long, UploadEventBannerHandler)
+	organizerEvents.Post("/:id/tiers", CreateTicketTierHandler)
+	organizerEvents.Post("/:id/sessions", CreateEventSessionHandler)
+	organizerEvents.Post("/:id/zones", CreateEventZoneHandler)
+	organizerEvents.Post("/:id/form-fields", CreateFormFieldHandler)
+	organizerEvents.Post("/:id/add-ons", CreateAddOnHandler)
+	organizerEvents.Put("/:id/waiting-room", UpdateWaitingRoomHandler)
+	organizerEvents.Put("/:id/reentry", UpdateEventReentryHandler)
+	organizerEvents.Put("/:id/occupancy-limit", UpdateEventOccupancyLimitHandler)
+	organizerEvents.Get("/:id/badge-printer", GetBadgePrinterHandler)
+	organizerEvents.Put("/:id/badge-printer", UpdateBadgePrinterHandler)
+	organizerEvents.Delete("/:id/badge-printer", RemoveBadgePrinterHandler)
+	organizerEvents.Put("/:id/tax", UpdateEventTaxHandler)
+	organizerEvents.Put("/:id/refund-policy", UpdateEventRefundPolicyHandler)
+
+	// Ticket tier routes (events:manage)
+	tiers := protected.Group("/tiers", can(models.PermEventsManage))
+	tiers.Put("/:id", UpdateTicketTierHandler)
+	tiers.Delete("/:id", DeleteTicketTierHandler)
+	tiers.Put("/:id/sessions", SetTierSessionsHandler)
+	tiers.Put("/:id/zones", SetTierZonesHandler)
+	tiers.Put("/:id/price-phases", SetPricePhasesHandler)
+
+	// Event session routes (events:manage)
+	eventSessions := protected.Group("/event-sessions", can(models.PermEventsManage))
+	eventSessions.Put("/:id", UpdateEventSessionHandler)
+	eventSessions.Delete("/:id", DeleteEventSessionHandler)
+
+	// Event zone routes (events:manage)
+	eventZones := protected.Group("/event-zones", can(models.PermEventsManage))
+	eventZones.Put("/:id", UpdateEventZoneHandler)
+	eventZones.Delete("/:id", DeleteEventZoneHandler)
+
+	// Registration form field routes (events:manage)
+	formFields := protected.Group("/form-fields", can(models.PermEventsManage))
+	formFields.Put("/:id", UpdateFormFieldHandler)
+	formFields.Delete("/:id", DeleteFormFieldHandler)
+
+	// Add-on routes (events:manage)
+	addOns := protected.Group("/add-ons", can(models.PermEventsManage))
+	addOns.Put("/:id", UpdateAddOnHandler)
+	addOns.Delete("/:id", DeleteAddOnHandler)
 
 	// Ticket routes
 	tickets := protected.Group("/tickets")
-	tickets.Post("/reserve", ReserveTicketHandler)
-	tickets.Get("/my-tickets", GetMyTicketsHandler)
+	tickets.Get("/my-tickets", GetMyTicketsHandler(repos))
+	tickets.Get("/:id", GetTicketHandler(repos))
+	tickets.Get("/:id/qr", GetTicketQRCodeHandler(repos))
+	tickets.Get("/:id/pdf", GetTicketPDFHandler(repos))
+	tickets.Get("/:id/wallet-pass", GetTicketWalletPassHandler(repos))
+	tickets.Get("/:id/calendar.ics", GetTicketCalendarHandler(repos))
 
 	// Order routes
 	orders := protected.Group("/orders")
-	orders.Post("/", CreateOrderHandler)
-	orders.Get("/my-orders", GetMyOrdersHandler)
-
-	// Check-in routes
-	checkin := protected.Group("/checkin", middleware.RoleMiddleware("organizer", "admin"))
-	checkin.Post("/validate", ValidateQRCodeHandler)
+	orders.Get("/my-orders", GetMyOrdersHandler(repos))
+	orders.Get("/:id", GetOrderHandler(repos))
+	orders.Get("/:id/receipt", GetOrderReceiptHandler(repos))
+	orders.Post("/:id/refund", RequestRefundHandler(repos))
+
+	// Organizer routes (applying and joining a team are open to any authenticated user)
+	protected.Post("/organizer/apply", ApplyOrganizerHandler)
+	protected.Post("/team-invitations/:token/accept", AcceptTeamInvitationHandler(repos))
+	organizer := protected.Group("/organizer")
+	organizer.Get("/events/:id/analytics", can(models.PermAttendeesView), GetEventAnalyticsHandler)
+	organizer.Get("/events/:id/attendees/export", can(models.PermAttendeesView), long, ExportAttendeesHandler)
+	organizer.Post("/events/:id/comp-tickets", can(models.PermTicketsComp), IssueCompTicketsHandler)
+	organizer.Get("/events/:id/scanner-tokens", can(models.PermCheckinScan), ListScannerTokensHandler)
+	organizer.Post("/events/:id/scanner-tokens", can(models.PermCheckinScan), IssueScannerTokenHandler)
+	organizer.Delete("/scanner-tokens/:id", can(models.PermCheckinScan), RevokeScannerTokenHandler)
+	organizer.Post("/logo", can(models.PermOrganizerManage), long, UploadOrganizerLogoHandler)
+	organizer.Put("/tax", can(models.PermOrganizerManage), UpdateOrganizerTaxHandler)
+	organizer.Get("/payouts", can(models.PermPayoutsView), GetMyPayoutsHandler)
+	organizer.Get("/team", can(models.PermOrganizerManage), ListTeamMembersHandler)
+	organizer.Post("/team", can(models.PermOrganizerManage), InviteTeamMemberHandler)
+	organizer.Put("/team/:id", can(models.PermOrganizerManage), UpdateTeamMemberHandler)
+	organizer.Delete("/team/:id", can(models.PermOrganizerManage), RemoveTeamMemberHandler)
+	organizer.Get("/api-keys", can(models.PermOrganizerManage), ListIntegrationKeysHandler)
+	organizer.Post("/api-keys", can(models.PermOrganizerManage), CreateIntegrationKeyHandler)
+	organizer.Delete("/api-keys/:id", can(models.PermOrganizerManage), RevokeIntegrationKeyHandler)
+	organizer.Get("/widget-origins", can(models.PermOrganizerManage), ListWidgetOriginsHandler)
+	organizer.Post("/widget-origins", can(models.PermOrganizerManage), AddWidgetOriginHandler)
+	organizer.Delete("/widget-origins/:id", can(models.PermOrganizerManage), RemoveWidgetOriginHandler)
+	organizer.Get("/webhooks", can(models.PermOrganizerManage), ListWebhookEndpointsHandler)
+	organizer.Post("/webhooks", can(models.PermOrganizerManage), CreateWebhookEndpointHandler)
+	organizer.Put("/webhooks/:id", can(models.PermOrganizerManage), UpdateWebhookEndpointHandler)
+	organizer.Delete("/webhooks/:id", can(models.PermOrganizerManage), DeleteWebhookEndpointHandler)
+	organizer.Get("/webhooks/:id/deliveries", can(models.PermOrganizerManage), ListWebhookDeliveriesHandler)
+	organizer.Post("/webhook-deliveries/:id/redeliver", can(models.PermOrganizerManage), RedeliverWebhookHandler)
 
 	// Admin routes
 	admin := protected.Group("/admin", middleware.RoleMiddleware("admin"))
 	admin.Get("/stats", GetAdminStatsHandler)
+	admin.Get("/audit-logs", ListAuditLogsHandler)
+	admin.Post("/users/:id/unlock", UnlockUserHandler(repos))
+	admin.Post("/orders/:id/refund", AdminRefundOrderHandler)
+	admin.Get("/orders/review", ListReviewOrdersHandler)
+	admin.Post("/orders/:id/approve", ApproveReviewOrderHandler)
+	admin.Post("/orders/:id/reject", RejectReviewOrderHandler)
+	admin.Get("/orders/:id/saga", GetOrderSagaHandler)
+	admin.Post("/orders/:id/saga/resume", ResumeOrderSagaHandler)
+	admin.Post("/orders/:id/saga/compensate", CompensateOrderSagaHandler)
+	admin.Get("/jobs", ListJobsHandler)
+	admin.Get("/jobs/schedules", ListJobSchedulesHandler)
+	admin.Get("/jobs/:id", GetJobHandler)
+	admin.Post("/jobs/:id/retry", RetryJobHandler)
+	admin.Delete("/jobs/:id", DiscardJobHandler)
+	admin.Get("/disputes", ListDisputesHandler)
+	admin.Get("/disputes/:id", GetDisputeHandler)
+	admin.Post("/disputes/:id/evidence", long, UploadDisputeEvidenceHandler)
+	admin.Post("/disputes/:id/resolve", ResolveDisputeHandler)
+	admin.Get("/reconciliation", GetReconciliationReportHandler)
+	admin.Get("/exports", ListWarehouseExportsHandler)
+	admin.Post("/exports", long, CreateWarehouseExportHandler)
+	admin.Get("/exports/:id", GetWarehouseExportHandler)
+	admin.Get("/feature-flags", ListFeatureFlagsHandler)
+	admin.Put("/feature-flags/:key", UpdateFeatureFlagHandler)
+	admin.Get("/permissions", ListPermissionsHandler)
+	admin.Put("/permissions/:role", UpdateRolePermissionsHandler)
+	admin.Get("/events", ListModerationQueueHandler)
+	admin.Get("/events/:id/reports", ListEventReportsHandler)
+	admin.Post("/events/:id/reports/dismiss", DismissEventReportsHandler)
+	admin.Post("/events/:id/unpublish", UnpublishEventHandler)
+	admin.Post("/events/:id/ban", BanEventHandler)
+	admin.Post("/events/:id/approve", ApproveEventHandler)
+	admin.Post("/events/:id/reject", RejectEventHandler)
+	admin.Put("/events/:id/featured", UpdateEventFeaturedHandler)
+	admin.Get("/categories", AdminListCategoriesHandler)
+	admin.Post("/categories", CreateCategoryHandler)
+	admin.Put("/categories/:id", UpdateCategoryHandler)
+	admin.Delete("/categories/:id", DeleteCategoryHandler)
+	admin.Get("/organizers", ListOrganizersHandler)
+	admin.Post("/organizers/:id/approve", ApproveOrganizerHandler)
+	admin.Post("/organizers/:id/reject", RejectOrganizerHandler)
+	admin.Put("/organizers/:id/fees", UpdateOrganizerFeesHandler)
+	admin.Post("/organizers/:id/payouts", InitiatePayoutHandler)
+	admin.Post("/payouts/:id/complete", CompletePayoutHandler)
+	admin.Post("/payouts/:id/fail", FailPayoutHandler)
 
 	logger.Info("Routes registered successfully")
 }
 
-func healthCheckHandler(c *fiber.Ctx) error {
-	// Check database health
-	dbHealth := "healthy"
-	if err := database.Health(); err != nil {
-		dbHealth = "unhealthy"
-	}
-
-	// Check cache health
-	cacheHealth := "healthy"
-	if err := cache.Health(); err != nil {
-		cacheHealth = "unhealthy"
-	}
-
-	return c.JSON(fiber.Map{
-		"status":   "ok",
-		"service":  "eventix-api",
-		"database": dbHealth,
-		"cache":    cacheHealth,
-	})
+// jwksHandler serves the JSON Web Key Set for RS256/ES256 access tokens.
+// The set is empty when tokens are signed with a shared HS256 secret.
+func jwksHandler(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(jwt.JWKS())
 }
 
 func notFoundHandler(c *fiber.Ctx) error {
@@ -221,24 +609,24 @@ func notFoundHandler(c *fiber.Ctx) error {
 	})
 }
 
-func customErrorHandler(c *fiber.Ctx, err error) error {
-	code := fiber.StatusInternalServerError
+// newErrorHandler answers errors returned by handlers using the error
+// catalog. In production, internal errors are logged but not shown.
+func newErrorHandler(production bool) fiber.ErrorHandler {
+	return func(c *fiber.Ctx, err error) error {
+		appErr := utils.AsAppError(err, production)
+
+		fields := []zap.Field{
+			zap.Int("status_code", appErr.Status),
+			zap.String("code", appErr.Code),
+			zap.String("path", c.Path()),
+			zap.Error(err),
+		}
+		if appErr.Status >= fiber.StatusInternalServerError {
+			logger.WithContext(c.UserContext()).Error("Request error", fields...)
+		} else {
+			logger.WithContext(c.UserContext()).Debug("Request error", fields...)
+		}
 
-	if e, ok := err.(*fiber.Error); ok {
-		code = e.Code
+		return utils.ErrorResponse(c, appErr.Status, appErr.Code, appErr.Message, appErr.Details)
 	}
-
-	logger.Error("Request error",
-		zap.Int("status_code", code),
-		zap.String("path", c.Path()),
-		zap.Error(err),
-	)
-
-	return c.Status(code).JSON(fiber.Map{
-		"success": false,
-		"error": fiber.Map{
-			"code":    "INTERNAL_ERROR",
-			"message": err.Error(),
-		},
-	})
 }
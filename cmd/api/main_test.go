@@ -0,0 +1,52 @@
+//go:build integration
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/testutil"
+	"eventix-api/pkg/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var env *testutil.Env
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	var err error
+	env, err = testutil.Start(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start test environment: %v\n", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	if err := env.Close(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to stop test environment: %v\n", err)
+	}
+	os.Exit(code)
+}
+
+// newTestApp resets the environment and builds the API routes the way main
+// does, on the test database
+func newTestApp(t *testing.T) *fiber.App {
+	t.Helper()
+
+	if err := env.Reset(context.Background()); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	app := fiber.New(fiber.Config{ErrorHandler: newErrorHandler(false)})
+	if err := mountAPI(app, config.NewLive(env.Config, ""), repositories.New(env.DB), checkContract(t)); err != nil {
+		t.Fatalf("mount API: %v", err)
+	}
+	app.Use(notFoundHandler)
+	return app
+}
@@ -0,0 +1,399 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ReportEventRequest struct {
+	Reason  string `json:"reason" validate:"required,oneof=spam fraud misleading offensive other"`
+	Details string `json:"details,omitempty" validate:"omitempty,max=2000"`
+}
+
+type ModerateEventRequest struct {
+	Reason string `json:"reason" validate:"required,max=2000"` // shown to the organizer
+}
+
+type EventReportResponse struct {
+	ID         uuid.UUID           `json:"id"`
+	EventID    uuid.UUID           `json:"event_id"`
+	Reason     models.ReportReason `json:"reason"`
+	Details    string              `json:"details,omitempty"`
+	Status     models.ReportStatus `json:"status"`
+	ResolvedAt *time.Time          `json:"resolved_at,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+}
+
+type ModerationEventResponse struct {
+	ID               uuid.UUID          `json:"id"`
+	Title            string             `json:"title"`
+	Slug             string             `json:"slug"`
+	Status           models.EventStatus `json:"status"`
+	OrganizerID      uuid.UUID          `json:"organizer_id"`
+	OrganizationName string             `json:"organization_name"`
+	StartTime        time.Time          `json:"start_time"`
+	OpenReports      int64              `json:"open_reports"`
+	UpdatedAt        time.Time          `json:"updated_at"`
+}
+
+type EventStatusChangeResponse struct {
+	FromStatus models.EventStatus `json:"from_status"`
+	ToStatus   models.EventStatus `json:"to_status"`
+	Reason     string             `json:"reason,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+func toEventReportResponse(report *models.EventReport) EventReportResponse {
+	return EventReportResponse{
+		ID:         report.ID,
+		EventID:    report.EventID,
+		Reason:     report.Reason,
+		Details:    report.Details,
+		Status:     report.Status,
+		ResolvedAt: report.ResolvedAt,
+		CreatedAt:  report.CreatedAt,
+	}
+}
+
+// MODERATION HANDLERS
+
+// ReportEventHandler godoc
+// @Summary Report an event
+// @Description Flag a published event as spam, fraud, misleading, offensive or other for the Eventix team to review. Each user can report an event once
+// @ID reportEvent
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body ReportEventRequest true "Report"
+// @Success 201 {object} object{success=bool,message=string,data=EventReportResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/report [post]
+func ReportEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req ReportEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	report, err := services.NewModerationService(cfg).Report(c.UserContext(), eventID, uid, models.ReportReason(req.Reason), req.Details)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Thanks, our team will review this event",
+		"data":    toEventReportResponse(report),
+	})
+}
+
+// GetEventStatusHistoryHandler godoc
+// @Summary Event status history
+// @Description List an event's status changes, newest first, with the reasons given when it was rejected, unpublished, banned or cancelled (Organizer/Admin only)
+// @ID getEventStatusHistory
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]EventStatusChangeResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/status-history [get]
+func GetEventStatusHistoryHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	changes, err := services.NewModerationService(cfg).History(c.UserContext(), eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch status history")
+	}
+
+	responses := make([]EventStatusChangeResponse, len(changes))
+	for i, change := range changes {
+		responses[i] = EventStatusChangeResponse{
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			Reason:     change.Reason,
+			CreatedAt:  change.CreatedAt,
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// ListModerationQueueHandler godoc
+// @Summary Event moderation queue
+// @Description List events by status, by default those awaiting review, oldest first. With reported=true, list events with open user reports, most reported first (Admin only)
+// @ID listModerationQueue
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Event status; defaults to under_review, or any status when reported=true"
+// @Param reported query bool false "Only events with open reports"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]ModerationEventResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/events [get]
+func ListModerationQueueHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit := queryLimit(c)
+	reported := c.QueryBool("reported")
+
+	defaultStatus := string(models.EventUnderReview)
+	if reported {
+		defaultStatus = ""
+	}
+	status := models.EventStatus(c.Query("status", defaultStatus))
+
+	if page < 1 {
+		page = 1
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	items, total, err := services.NewModerationService(cfg).Queue(c.UserContext(), services.ModerationFilter{
+		Status:   status,
+		Reported: reported,
+		Offset:   (page - 1) * limit,
+		Limit:    limit,
+	})
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch events")
+	}
+
+	responses := make([]ModerationEventResponse, len(items))
+	for i, item := range items {
+		responses[i] = ModerationEventResponse{
+			ID:               item.Event.ID,
+			Title:            item.Event.Title,
+			Slug:             item.Event.Slug,
+			Status:           item.Event.Status,
+			OrganizerID:      item.Event.OrganizerID,
+			OrganizationName: item.Event.Organizer.OrganizationName,
+			StartTime:        item.Event.StartTime,
+			OpenReports:      item.OpenReports,
+			UpdatedAt:        item.Event.UpdatedAt,
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// ListEventReportsHandler godoc
+// @Summary List an event's reports
+// @Description List every user report on an event, newest first (Admin only)
+// @ID listEventReports
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]EventReportResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/events/{id}/reports [get]
+func ListEventReportsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	reports, err := services.NewModerationService(cfg).Reports(c.UserContext(), eventID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch reports")
+	}
+
+	responses := make([]EventReportResponse, len(reports))
+	for i := range reports {
+		responses[i] = toEventReportResponse(&reports[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// DismissEventReportsHandler godoc
+// @Summary Dismiss an event's reports
+// @Description Close an event's open reports without taking the event down (Admin only)
+// @ID dismissEventReports
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,message=string,data=object{dismissed=int}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/events/{id}/reports/dismiss [post]
+func DismissEventReportsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	audit := beginAudit(c, models.AuditEventReportsDismissed, models.AuditTargetEvent, eventID)
+	dismissed, err := services.NewModerationService(cfg).DismissReports(c.UserContext(), eventID, adminID)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Reports dismissed",
+		"data":    fiber.Map{"dismissed": dismissed},
+	})
+}
+
+// UnpublishEventHandler godoc
+// @Summary Unpublish an event
+// @Description Take a published event off sale and email the organizer the reason. The organizer can edit the event and submit it for review again. Open reports on the event are closed (Admin only)
+// @ID unpublishEvent
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body ModerateEventRequest true "Reason"
+// @Success 200 {object} object{success=bool,message=string,data=EventStatusResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/events/{id}/unpublish [post]
+func UnpublishEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req ModerateEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	audit := beginAudit(c, models.AuditEventUnpublished, models.AuditTargetEvent, eventID)
+	event, err := services.NewModerationService(cfg).Unpublish(c.UserContext(), eventID, adminID, req.Reason)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event unpublished",
+		"data":    toEventStatusResponse(event),
+	})
+}
+
+// BanEventHandler godoc
+// @Summary Ban an event
+// @Description Remove an event for good and email the organizer the reason. Banned events cannot be published or sold again. Open reports on the event are closed (Admin only)
+// @ID banEvent
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body ModerateEventRequest true "Reason"
+// @Success 200 {object} object{success=bool,message=string,data=EventStatusResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/events/{id}/ban [post]
+func BanEventHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req ModerateEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	audit := beginAudit(c, models.AuditEventBanned, models.AuditTargetEvent, eventID)
+	event, err := services.NewModerationService(cfg).Ban(c.UserContext(), eventID, adminID, req.Reason)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event banned",
+		"data":    toEventStatusResponse(event),
+	})
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type NotificationResponse struct {
+	ID        uuid.UUID                  `json:"id"`
+	Channel   models.NotificationChannel `json:"channel"`
+	Subject   string                     `json:"subject"`
+	Message   string                     `json:"message"`
+	IsRead    bool                       `json:"is_read"`
+	SentAt    *time.Time                 `json:"sent_at,omitempty"`
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+type NotificationPreferencesRequest struct {
+	EmailEnabled   *bool `json:"email_enabled,omitempty"`
+	SMSEnabled     *bool `json:"sms_enabled,omitempty"`
+	PushEnabled    *bool `json:"push_enabled,omitempty"`
+	OrderUpdates   *bool `json:"order_updates,omitempty"`
+	EventReminders *bool `json:"event_reminders,omitempty"`
+	Marketing      *bool `json:"marketing,omitempty"`
+}
+
+type NotificationPreferencesResponse struct {
+	EmailEnabled   bool `json:"email_enabled"`
+	SMSEnabled     bool `json:"sms_enabled"`
+	PushEnabled    bool `json:"push_enabled"`
+	OrderUpdates   bool `json:"order_updates"`
+	EventReminders bool `json:"event_reminders"`
+	Marketing      bool `json:"marketing"`
+}
+
+// NOTIFICATION HANDLERS
+
+// GetMyNotificationsHandler godoc
+// @Summary Get user's notifications
+// @Description Get notifications sent to the authenticated user, newest first
+// @ID getMyNotifications
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param unread query bool false "Only return unread notifications"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} object{success=bool,data=[]NotificationResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /users/me/notifications [get]
+func GetMyNotificationsHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "20"))
+	unreadOnly := c.QueryBool("unread", false)
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	notifications, total, err := services.NewNotificationService().ListForUser(uid, unreadOnly, page, limit)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch notifications")
+	}
+
+	responses := make([]NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		responses[i] = NotificationResponse{
+			ID:        n.ID,
+			Channel:   n.Channel,
+			Subject:   n.Subject,
+			Message:   n.Message,
+			IsRead:    n.IsRead,
+			SentAt:    n.SentAt,
+			CreatedAt: n.CreatedAt,
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// MarkNotificationReadHandler godoc
+// @Summary Mark a notification as read
+// @Description Mark a notification belonging to the authenticated user as read
+// @ID markNotificationRead
+// @Tags Notifications
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /notifications/{id}/read [post]
+func MarkNotificationReadHandler(c *fiber.Ctx) error {
+	notificationID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid notification ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	if err := services.NewNotificationService().MarkRead(notificationID, uid); err != nil {
+		return utils.NotFoundResponse(c, "Notification not found")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Notification marked as read",
+	})
+}
+
+// GetNotificationPreferencesHandler godoc
+// @Summary Get notification preferences
+// @Description Get the channels and topics the authenticated user receives notifications for
+// @ID getNotificationPreferences
+// @Tags Notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=NotificationPreferencesResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /users/me/notification-preferences [get]
+func GetNotificationPreferencesHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	pref, err := services.NewNotificationService().GetPreferences(uid)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch notification preferences")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    toNotificationPreferencesResponse(pref),
+	})
+}
+
+// UpdateNotificationPreferencesHandler godoc
+// @Summary Update notification preferences
+// @Description Choose which channels (email, SMS, push) and topics (orders, reminders, marketing) the authenticated user receives. Omitted fields are unchanged; account and security emails are always sent.
+// @ID updateNotificationPreferences
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body NotificationPreferencesRequest true "Preferences to change"
+// @Success 200 {object} object{success=bool,message=string,data=NotificationPreferencesResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /users/me/notification-preferences [put]
+func UpdateNotificationPreferencesHandler(c *fiber.Ctx) error {
+	var req NotificationPreferencesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	pref, err := services.NewNotificationService().UpdatePreferences(uid, services.NotificationPreferenceUpdate{
+		EmailEnabled:   req.EmailEnabled,
+		SMSEnabled:     req.SMSEnabled,
+		PushEnabled:    req.PushEnabled,
+		OrderUpdates:   req.OrderUpdates,
+		EventReminders: req.EventReminders,
+		Marketing:      req.Marketing,
+	})
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to update notification preferences")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Notification preferences updated",
+		"data":    toNotificationPreferencesResponse(pref),
+	})
+}
+
+func toNotificationPreferencesResponse(pref *models.NotificationPreference) NotificationPreferencesResponse {
+	return NotificationPreferencesResponse{
+		EmailEnabled:   pref.EmailEnabled,
+		SMSEnabled:     pref.SMSEnabled,
+		PushEnabled:    pref.PushEnabled,
+		OrderUpdates:   pref.OrderUpdates,
+		EventReminders: pref.EventReminders,
+		Marketing:      pref.Marketing,
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RejectOrderRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+type ReviewOrderResponse struct {
+	ID             uuid.UUID `json:"id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Email          string    `json:"email"`
+	TierID         uuid.UUID `json:"tier_id"`
+	Quantity       int       `json:"quantity"`
+	TotalAmount    int64     `json:"total_amount"`
+	Currency       string    `json:"currency"`
+	BillingCountry string    `json:"billing_country,omitempty"`
+	ClientIP       string    `json:"client_ip,omitempty"`
+	IPCountry      string    `json:"ip_country,omitempty"`
+	RiskScore      int       `json:"risk_score"`
+	RiskReasons    []string  `json:"risk_reasons"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ORDER REVIEW HANDLERS
+
+// ListReviewOrdersHandler godoc
+// @Summary Orders held for review
+// @Description List paid orders the risk rules held for manual review, oldest first, with their score and the rules that fired (Admin only)
+// @ID listReviewOrders
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]ReviewOrderResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/orders/review [get]
+func ListReviewOrdersHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit := queryLimit(c)
+
+	if page < 1 {
+		page = 1
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	orders, total, err := services.NewPaymentService(cfg).ReviewQueue(c.UserContext(), (page-1)*limit, limit)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch orders")
+	}
+
+	responses := make([]ReviewOrderResponse, len(orders))
+	for i, order := range orders {
+		responses[i] = ReviewOrderResponse{
+			ID:             order.ID,
+			UserID:         order.UserID,
+			Email:          order.User.Email,
+			TierID:         order.TierID,
+			Quantity:       order.Quantity,
+			TotalAmount:    order.TotalAmount,
+			Currency:       order.Currency,
+			BillingCountry: order.BillingCountry,
+			ClientIP:       order.ClientIP,
+			IPCountry:      order.IPCountry,
+			RiskScore:      order.RiskScore,
+			RiskReasons:    order.RiskReasons,
+			CreatedAt:      order.CreatedAt,
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// ApproveReviewOrderHandler godoc
+// @Summary Approve an order held for review
+// @Description Confirm a held order: its tickets are issued and the buyer is sent the confirmation (Admin only)
+// @ID approveReviewOrder
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} object{success=bool,message=string,data=OrderResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/orders/{id}/approve [post]
+func ApproveReviewOrderHandler(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditOrderApproved, models.AuditTargetOrder, orderID)
+	order, err := services.NewPaymentService(cfg).ApproveReview(c.UserContext(), orderID)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Order approved successfully",
+		"data": OrderResponse{
+			ID:          order.ID,
+			TotalAmount: order.TotalAmount,
+			TaxAmount:   order.TaxAmount,
+			Currency:    order.Currency,
+			Status:      order.Status,
+			TicketCount: order.Quantity,
+			CreatedAt:   order.CreatedAt,
+		},
+	})
+}
+
+// RejectReviewOrderHandler godoc
+// @Summary Reject an order held for review
+// @Description Refund a held order in full and put its tickets back on sale (Admin only)
+// @ID rejectReviewOrder
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body RejectOrderRequest true "Rejection reason, recorded on the refund"
+// @Success 200 {object} object{success=bool,message=string,data=RefundResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/orders/{id}/reject [post]
+func RejectReviewOrderHandler(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	var req RejectOrderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditOrderRejected, models.AuditTargetOrder, orderID)
+	refund, err := services.NewPaymentService(cfg).RejectReview(orderID, adminID, req.Reason)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Order rejected and refunded",
+		"data":    toRefundResponse(refund),
+	})
+}
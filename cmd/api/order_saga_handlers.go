@@ -0,0 +1,136 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CompensateOrderSagaRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// ORDER SAGA HANDLERS
+
+// GetOrderSagaHandler godoc
+// @Summary Get an order's saga
+// @Description Show which of the reserve, order, pay and fulfill steps an order has completed, the step it is stuck at and every execution and compensation attempt (Admin only)
+// @ID getOrderSaga
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} object{success=bool,data=models.OrderSaga}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/orders/{id}/saga [get]
+func GetOrderSagaHandler(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	saga, err := services.NewOrderSagaService(services.NewPaymentService(cfg)).Get(c.UserContext(), orderID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    saga,
+	})
+}
+
+// ResumeOrderSagaHandler godoc
+// @Summary Resume a stuck order saga
+// @Description Retry the step an order's saga stopped at: its open payment is verified with the provider, or its paid order is fulfilled. A saga being compensated carries on undoing its steps (Admin only)
+// @ID resumeOrderSaga
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {object} object{success=bool,message=string,data=models.OrderSaga}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/orders/{id}/saga/resume [post]
+func ResumeOrderSagaHandler(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditOrderSagaResumed, models.AuditTargetOrder, orderID)
+	saga, err := services.NewOrderSagaService(services.NewPaymentService(cfg)).Resume(c.UserContext(), orderID, adminID)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Order saga resumed",
+		"data":    saga,
+	})
+}
+
+// CompensateOrderSagaHandler godoc
+// @Summary Compensate an order saga
+// @Description Undo an order's completed steps, latest first: its tickets are revoked, its payment voided, the order cancelled and its tickets put back on sale. Orders with a checked-in ticket cannot be compensated (Admin only)
+// @ID compensateOrderSaga
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body CompensateOrderSagaRequest true "Why the order is undone, recorded on the saga and the refund"
+// @Success 200 {object} object{success=bool,message=string,data=models.OrderSaga}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/orders/{id}/saga/compensate [post]
+func CompensateOrderSagaHandler(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	var req CompensateOrderSagaRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditOrderSagaCompensated, models.AuditTargetOrder, orderID)
+	saga, err := services.NewOrderSagaService(services.NewPaymentService(cfg)).Compensate(c.UserContext(), orderID, adminID, req.Reason)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Order saga compensated",
+		"data":    saga,
+	})
+}
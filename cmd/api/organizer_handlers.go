@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const maxVerificationDocumentSize = 5 * 1024 * 1024 // 5MB
+
+var verificationDocumentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+type RejectOrganizerRequest struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+type OrganizerResponse struct {
+	ID                 uuid.UUID                 `json:"id"`
+	UserID             uuid.UUID                 `json:"user_id"`
+	Email              string                    `json:"email,omitempty"`
+	OrganizationName   string                    `json:"organization_name"`
+	Description        string                    `json:"description,omitempty"`
+	Website            string                    `json:"website,omitempty"`
+	VerificationStatus models.VerificationStatus `json:"verification_status"`
+	RejectionReason    string                    `json:"rejection_reason,omitempty"`
+	DocumentURL        string                    `json:"document_url,omitempty"`
+	AppliedAt          *time.Time                `json:"applied_at,omitempty"`
+	VerifiedAt         *time.Time                `json:"verified_at,omitempty"`
+}
+
+// ORGANIZER VERIFICATION HANDLERS
+
+// ApplyOrganizerHandler godoc
+// @Summary Apply to become an organizer
+// @Description Submit an organizer application with a verification document (PDF, JPEG or PNG, max 5MB)
+// @ID applyOrganizer
+// @Tags Organizer
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param organization_name formData string true "Organization name"
+// @Param description formData string false "Organization description"
+// @Param website formData string false "Organization website"
+// @Param document formData file true "Verification document"
+// @Success 201 {object} object{success=bool,message=string,data=OrganizerResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/apply [post]
+func ApplyOrganizerHandler(c *fiber.Ctx) error {
+	organizationName := c.FormValue("organization_name")
+	if organizationName == "" {
+		return utils.BadRequestResponse(c, "Organization name is required")
+	}
+
+	file, err := c.FormFile("document")
+	if err != nil {
+		return utils.BadRequestResponse(c, "Verification document is required")
+	}
+
+	contentType, err := storage.DetectContentType(file, verificationDocumentTypes, maxVerificationDocumentSize)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	f, err := file.Open()
+	if err != nil {
+		return utils.BadRequestResponse(c, "Failed to read document")
+	}
+	defer f.Close()
+
+	key := storage.ObjectKey(fmt.Sprintf("organizer-documents/%s", uid), file.Filename)
+	if err := storage.Upload(c.Context(), key, contentType, f, file.Size); err != nil {
+		logger.WithContext(c.UserContext()).Error("Failed to upload verification document", zap.Error(err))
+		return utils.InternalServerErrorResponse(c, "Failed to upload document")
+	}
+
+	organizer, err := services.NewOrganizerService().Apply(uid, services.OrganizerApplication{
+		OrganizationName: organizationName,
+		Description:      c.FormValue("description"),
+		Website:          c.FormValue("website"),
+		DocumentKey:      key,
+	})
+	if err != nil {
+		_ = storage.Delete(context.Background(), key)
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Application submitted for review",
+		"data":    toOrganizerResponse(organizer, ""),
+	})
+}
+
+// ListOrganizersHandler godoc
+// @Summary List organizers by verification status
+// @Description List organizer applications, by default those awaiting verification (Admin only)
+// @ID listOrganizers
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Verification status (pending, approved, rejected)" default(pending)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]OrganizerResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/organizers [get]
+func ListOrganizersHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	status := models.VerificationStatus(c.Query("status", string(models.VerificationPending)))
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	organizers, total, err := services.NewOrganizerService().ListByStatus(status, page, limit)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch organizers")
+	}
+
+	responses := make([]OrganizerResponse, len(organizers))
+	for i := range organizers {
+		documentURL := ""
+		if organizers[i].VerificationDocKey != "" {
+			documentURL, _ = storage.PresignGet(c.Context(), organizers[i].VerificationDocKey, 15*time.Minute)
+		}
+		responses[i] = toOrganizerResponse(&organizers[i], documentURL)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// ApproveOrganizerHandler godoc
+// @Summary Approve an organizer
+// @Description Verify an organizer application and notify the applicant (Admin only)
+// @ID approveOrganizer
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organizer ID"
+// @Success 200 {object} object{success=bool,message=string,data=OrganizerResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/organizers/{id}/approve [post]
+func ApproveOrganizerHandler(c *fiber.Ctx) error {
+	organizerID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid organizer ID")
+	}
+
+	audit := beginAudit(c, models.AuditOrganizerApproved, models.AuditTargetOrganizer, organizerID)
+	organizer, err := services.NewOrganizerService().Approve(organizerID)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	notifyOrganizerVerification(c, organizer, true)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Organizer approved",
+		"data":    toOrganizerResponse(organizer, ""),
+	})
+}
+
+// RejectOrganizerHandler godoc
+// @Summary Reject an organizer
+// @Description Decline an organizer application with a reason and notify the applicant (Admin only)
+// @ID rejectOrganizer
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organizer ID"
+// @Param request body RejectOrganizerRequest true "Rejection reason"
+// @Success 200 {object} object{success=bool,message=string,data=OrganizerResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/organizers/{id}/reject [post]
+func RejectOrganizerHandler(c *fiber.Ctx) error {
+	organizerID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid organizer ID")
+	}
+
+	var req RejectOrganizerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	audit := beginAudit(c, models.AuditOrganizerRejected, models.AuditTargetOrganizer, organizerID)
+	organizer, err := services.NewOrganizerService().Reject(organizerID, req.Reason)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	notifyOrganizerVerification(c, organizer, false)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Organizer rejected",
+		"data":    toOrganizerResponse(organizer, ""),
+	})
+}
+
+// notifyOrganizerVerification emails the applicant; failures are logged, not returned
+func notifyOrganizerVerification(c *fiber.Ctx, organizer *models.Organizer, approved bool) {
+	cfg, _ := c.Locals("config").(*config.Config)
+	emailService := services.NewEmailService(&cfg.Email)
+
+	if err := emailService.SendOrganizerVerificationEmail(
+		c.UserContext(),
+		organizer.UserID,
+		organizer.User.Email,
+		organizer.User.FirstName,
+		organizer.OrganizationName,
+		approved,
+		organizer.RejectionReason,
+		cfg.Server.FrontendURL,
+	); err != nil {
+		logger.WithContext(c.UserContext()).Error("Failed to send organizer verification email",
+			zap.String("organizer_id", organizer.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+func toOrganizerResponse(organizer *models.Organizer, documentURL string) OrganizerResponse {
+	return OrganizerResponse{
+		ID:                 organizer.ID,
+		UserID:             organizer.UserID,
+		Email:              organizer.User.Email,
+		OrganizationName:   organizer.OrganizationName,
+		Description:        organizer.Description,
+		Website:            organizer.Website,
+		VerificationStatus: organizer.VerificationStatus,
+		RejectionReason:    organizer.RejectionReason,
+		DocumentURL:        documentURL,
+		AppliedAt:          organizer.AppliedAt,
+		VerifiedAt:         organizer.VerifiedAt,
+	}
+}
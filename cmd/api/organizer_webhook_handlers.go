@@ -0,0 +1,382 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateWebhookEndpointRequest struct {
+	URL         string                    `json:"url" validate:"required,url,startswith=https://,max=500"`
+	Description string                    `json:"description,omitempty" validate:"max=200"`
+	Events      []models.WebhookEventType `json:"events,omitempty" validate:"dive,required"` // empty subscribes to every event
+}
+
+type UpdateWebhookEndpointRequest struct {
+	URL          string                    `json:"url" validate:"required,url,startswith=https://,max=500"`
+	Description  string                    `json:"description,omitempty" validate:"max=200"`
+	Events       []models.WebhookEventType `json:"events,omitempty" validate:"dive,required"` // empty subscribes to every event
+	Active       bool                      `json:"active"`
+	RotateSecret bool                      `json:"rotate_secret,omitempty"`
+}
+
+type WebhookEndpointResponse struct {
+	ID          uuid.UUID                 `json:"id"`
+	URL         string                    `json:"url"`
+	Description string                    `json:"description,omitempty"`
+	Events      []models.WebhookEventType `json:"events"`
+	Active      bool                      `json:"active"`
+	Secret      string                    `json:"secret,omitempty"` // only returned when generated
+	CreatedAt   time.Time                 `json:"created_at"`
+	UpdatedAt   time.Time                 `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID                    `json:"id"`
+	EndpointID     uuid.UUID                    `json:"endpoint_id"`
+	EventType      models.WebhookEventType      `json:"event_type"`
+	Payload        string                       `json:"payload"`
+	Status         models.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                          `json:"attempts"`
+	NextAttemptAt  *time.Time                   `json:"next_attempt_at,omitempty"`
+	ResponseStatus int                          `json:"response_status,omitempty"`
+	ResponseBody   string                       `json:"response_body,omitempty"` // first 1KB
+	Error          string                       `json:"error,omitempty"`
+	DeliveredAt    *time.Time                   `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time                    `json:"created_at"`
+}
+
+func toWebhookEndpointResponse(endpoint *models.WebhookEndpoint) WebhookEndpointResponse {
+	events := endpoint.Events
+	if events == nil {
+		events = []models.WebhookEventType{}
+	}
+	return WebhookEndpointResponse{
+		ID:          endpoint.ID,
+		URL:         endpoint.URL,
+		Description: endpoint.Description,
+		Events:      events,
+		Active:      endpoint.Active,
+		CreatedAt:   endpoint.CreatedAt,
+		UpdatedAt:   endpoint.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery *models.WebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		EndpointID:     delivery.EndpointID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+// ORGANIZER WEBHOOK HANDLERS
+
+// ListWebhookEndpointsHandler godoc
+// @Summary List my webhook endpoints
+// @Description List the URLs the organizer receives event notifications at
+// @ID listWebhookEndpoints
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]WebhookEndpointResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/webhooks [get]
+func ListWebhookEndpointsHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	endpoints, err := services.NewOrganizerWebhookService().List(c.UserContext(), organizer.ID)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]WebhookEndpointResponse, len(endpoints))
+	for i := range endpoints {
+		responses[i] = toWebhookEndpointResponse(&endpoints[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// CreateWebhookEndpointHandler godoc
+// @Summary Register a webhook endpoint
+// @Description Receive ticket.sold, attendee.checked_in and order.refunded events at an HTTPS URL. Each event is POSTed as {"id","type","created_at","data"} with X-Eventix-Event, X-Eventix-Delivery and X-Eventix-Timestamp headers, signed with a hex HMAC-SHA256 of "<timestamp>.<body>" in X-Eventix-Signature. Any 2xx answer accepts a delivery; others are retried with exponential backoff. The signing secret is only returned here and when rotated
+// @ID createWebhookEndpoint
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateWebhookEndpointRequest true "Endpoint"
+// @Success 201 {object} object{success=bool,message=string,data=WebhookEndpointResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/webhooks [post]
+func CreateWebhookEndpointHandler(c *fiber.Ctx) error {
+	var req CreateWebhookEndpointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	endpoint, secret, err := services.NewOrganizerWebhookService().Create(c.UserContext(), organizer.ID, services.WebhookEndpointInput{
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      req.Events,
+	}, uid)
+	if err != nil {
+		return err
+	}
+	recordCreated(c, models.AuditWebhookCreated, models.AuditTargetWebhook, endpoint.ID)
+
+	response := toWebhookEndpointResponse(endpoint)
+	response.Secret = secret
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Webhook endpoint created successfully",
+		"data":    response,
+	})
+}
+
+// UpdateWebhookEndpointHandler godoc
+// @Summary Update a webhook endpoint
+// @Description Change an endpoint's URL, description or events, pause it with active=false, or rotate its signing secret. A rotated secret is returned once
+// @ID updateWebhookEndpoint
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Param request body UpdateWebhookEndpointRequest true "Endpoint"
+// @Success 200 {object} object{success=bool,message=string,data=WebhookEndpointResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/webhooks/{id} [put]
+func UpdateWebhookEndpointHandler(c *fiber.Ctx) error {
+	endpointID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid webhook endpoint ID")
+	}
+
+	var req UpdateWebhookEndpointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	audit := beginAudit(c, models.AuditWebhookUpdated, models.AuditTargetWebhook, endpointID)
+	endpoint, secret, err := services.NewOrganizerWebhookService().Update(c.UserContext(), organizer.ID, endpointID, services.WebhookEndpointInput{
+		URL:          req.URL,
+		Description:  req.Description,
+		Events:       req.Events,
+		Active:       req.Active,
+		RotateSecret: req.RotateSecret,
+	})
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	response := toWebhookEndpointResponse(endpoint)
+	response.Secret = secret
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Webhook endpoint updated successfully",
+		"data":    response,
+	})
+}
+
+// DeleteWebhookEndpointHandler godoc
+// @Summary Delete a webhook endpoint
+// @Description Stop sending events to an endpoint and drop its delivery log, including deliveries still waiting to be retried
+// @ID deleteWebhookEndpoint
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/webhooks/{id} [delete]
+func DeleteWebhookEndpointHandler(c *fiber.Ctx) error {
+	endpointID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid webhook endpoint ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	audit := beginAudit(c, models.AuditWebhookDeleted, models.AuditTargetWebhook, endpointID)
+	if err := services.NewOrganizerWebhookService().Delete(c.UserContext(), organizer.ID, endpointID); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Webhook endpoint deleted successfully",
+	})
+}
+
+// ListWebhookDeliveriesHandler godoc
+// @Summary List webhook deliveries
+// @Description List an endpoint's deliveries newest first, with the payload sent, the number of attempts, the endpoint's last response and when the next retry is due
+// @ID listWebhookDeliveries
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook endpoint ID"
+// @Param status query string false "Filter by status" Enums(pending, succeeded, failed)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]WebhookDeliveryResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/webhooks/{id}/deliveries [get]
+func ListWebhookDeliveriesHandler(c *fiber.Ctx) error {
+	endpointID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid webhook endpoint ID")
+	}
+
+	status := models.WebhookDeliveryStatus(c.Query("status"))
+	switch status {
+	case "", models.WebhookDeliveryPending, models.WebhookDeliverySucceeded, models.WebhookDeliveryFailed:
+	default:
+		return utils.BadRequestResponse(c, "Invalid status")
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit := queryLimit(c)
+
+	if page < 1 {
+		page = 1
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	deliveries, total, err := services.NewOrganizerWebhookService().Deliveries(c.UserContext(), organizer.ID, endpointID, status, (page-1)*limit, limit)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]WebhookDeliveryResponse, len(deliveries))
+	for i := range deliveries {
+		responses[i] = toWebhookDeliveryResponse(&deliveries[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// RedeliverWebhookHandler godoc
+// @Summary Redeliver a webhook
+// @Description Queue a logged delivery's payload to be sent again on the next delivery sweep, as a new delivery. The payload keeps its event id so receivers can recognise it
+// @ID redeliverWebhook
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook delivery ID"
+// @Success 202 {object} object{success=bool,message=string,data=WebhookDeliveryResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/webhook-deliveries/{id}/redeliver [post]
+func RedeliverWebhookHandler(c *fiber.Ctx) error {
+	deliveryID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid webhook delivery ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	delivery, err := services.NewOrganizerWebhookService().Redeliver(c.UserContext(), organizer.ID, deliveryID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"success": true,
+		"message": "Webhook delivery queued",
+		"data":    toWebhookDeliveryResponse(delivery),
+	})
+}
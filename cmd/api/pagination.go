@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strconv"
+
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// cursorQuery reports whether the request asked for cursor pagination with
+// ?cursor= and decodes the cursor. An empty cursor starts from the first page.
+func cursorQuery(c *fiber.Ctx) (bool, *utils.Cursor, error) {
+	if !c.Context().QueryArgs().Has("cursor") {
+		return false, nil, nil
+	}
+
+	raw := c.Query("cursor")
+	if raw == "" {
+		return true, nil, nil
+	}
+
+	after, err := utils.CursorDecode(raw)
+	if err != nil {
+		return true, nil, err
+	}
+	return true, after, nil
+}
+
+// queryLimit returns the ?limit= page size, falling back to 10 when missing or out of range
+func queryLimit(c *fiber.Ctx) int {
+	limit, _ := strconv.Atoi(c.Query("limit", "10"))
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+	return limit
+}
+
+// cursorPagination describes a cursor page. next is empty on the last page.
+func cursorPagination(limit int, next string) fiber.Map {
+	pagination := fiber.Map{
+		"limit":    limit,
+		"has_more": next != "",
+	}
+	if next != "" {
+		pagination["next_cursor"] = next
+	}
+	return pagination
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type InitializePaymentRequest struct {
+	OrderID     string `json:"order_id" validate:"required"`
+	CallbackURL string `json:"callback_url,omitempty"`
+	// ISO 3166-1 alpha-2 billing country, checked against the card and IP
+	BillingCountry string `json:"billing_country,omitempty" validate:"omitempty,len=2,alpha"`
+}
+
+type RetryPaymentRequest struct {
+	CallbackURL    string `json:"callback_url,omitempty"`
+	BillingCountry string `json:"billing_country,omitempty" validate:"omitempty,len=2,alpha"`
+}
+
+type PaymentResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	OrderID   uuid.UUID  `json:"order_id"`
+	Provider  string     `json:"provider"`
+	Reference string     `json:"reference"`
+	Amount    int64      `json:"amount"`
+	Currency  string     `json:"currency"`
+	Status    string     `json:"status"`
+	PaidAt    *time.Time `json:"paid_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func toPaymentResponse(payment *models.Payment) PaymentResponse {
+	return PaymentResponse{
+		ID:        payment.ID,
+		OrderID:   payment.OrderID,
+		Provider:  string(payment.Provider),
+		Reference: payment.TransactionID,
+		Amount:    payment.Amount,
+		Currency:  payment.Currency,
+		Status:    string(payment.Status),
+		PaidAt:    payment.PaidAt,
+		CreatedAt: payment.CreatedAt,
+	}
+}
+
+// PAYMENT HANDLERS
+
+// InitializePaymentHandler godoc
+// @Summary Initialize payment
+// @Description Initialize a transaction with the order's payment provider (Paystack or Stripe) and return the checkout URL
+// @ID initializePayment
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body InitializePaymentRequest true "Payment details"
+// @Param Idempotency-Key header string false "Unique key that makes retries of this request safe"
+// @Success 200 {object} object{success=bool,message=string,data=services.PaymentInitResult}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /payments/initialize [post]
+func InitializePaymentHandler(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(string)
+	email := c.Locals("email").(string)
+
+	var req InitializePaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	orderID, err := uuid.Parse(req.OrderID)
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	callbackURL := req.CallbackURL
+	if callbackURL == "" {
+		callbackURL = cfg.Server.FrontendURL + "/payments/callback"
+	}
+
+	uid, _ := uuid.Parse(userID)
+	paymentService := services.NewPaymentService(cfg)
+
+	client := services.PaymentClient{BillingCountry: req.BillingCountry, IP: c.IP()}
+	if cfg.Payment.RiskIPCountryHeader != "" {
+		client.IPCountry = c.Get(cfg.Payment.RiskIPCountryHeader)
+	}
+
+	result, err := paymentService.InitializePayment(orderID, uid, email, callbackURL, client)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Payment initialized successfully",
+		"data":    result,
+	})
+}
+
+// RetryPaymentHandler godoc
+// @Summary Retry a failed payment
+// @Description Start a new payment for an order whose last payment failed, while its tickets are still reserved
+// @ID retryPayment
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body RetryPaymentRequest false "Payment details"
+// @Param Idempotency-Key header string false "Unique key that makes retries of this request safe"
+// @Success 200 {object} object{success=bool,message=string,data=services.PaymentInitResult}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /orders/{id}/retry-payment [post]
+func RetryPaymentHandler(c *fiber.Ctx) error {
+	userID := c.Locals("user_id").(string)
+	email := c.Locals("email").(string)
+
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	var req RetryPaymentRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	callbackURL := req.CallbackURL
+	if callbackURL == "" {
+		callbackURL = cfg.Server.FrontendURL + "/payments/callback"
+	}
+
+	uid, _ := uuid.Parse(userID)
+
+	client := services.PaymentClient{BillingCountry: req.BillingCountry, IP: c.IP()}
+	if cfg.Payment.RiskIPCountryHeader != "" {
+		client.IPCountry = c.Get(cfg.Payment.RiskIPCountryHeader)
+	}
+
+	result, err := services.NewPaymentService(cfg).RetryPayment(orderID, uid, email, callbackURL, client)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Payment initialized successfully",
+		"data":    result,
+	})
+}
+
+// VerifyPaymentHandler godoc
+// @Summary Verify payment
+// @Description Verify a transaction with the payment provider and complete the order on success
+// @ID verifyPayment
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param reference path string true "Payment reference"
+// @Success 200 {object} object{success=bool,data=PaymentResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /payments/verify/{reference} [get]
+func VerifyPaymentHandler(c *fiber.Ctx) error {
+	reference := c.Params("reference")
+	if reference == "" {
+		return utils.BadRequestResponse(c, "Payment reference is required")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	paymentService := services.NewPaymentService(cfg)
+
+	existing, err := paymentService.GetPaymentByReference(reference)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Payment not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	if existing.Order.UserID != uid {
+		return utils.ForbiddenResponse(c, "This payment belongs to another user")
+	}
+
+	payment, err := paymentService.VerifyPayment(c.UserContext(), reference)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    toPaymentResponse(payment),
+	})
+}
+
+// PaymentWebhookHandler godoc
+// @Summary Payment provider webhook
+// @Description Receive signed Paystack (x-paystack-signature) or Stripe (Stripe-Signature) callbacks and settle the referenced payment
+// @ID paymentWebhook
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /webhooks/payments [post]
+func PaymentWebhookHandler(c *fiber.Ctx) error {
+	cfg, _ := c.Locals("config").(*config.Config)
+	if cfg.Payment.WebhookSecret == "" {
+		return utils.InternalServerErrorResponse(c, "Payment webhooks are not configured")
+	}
+
+	webhookService := services.NewWebhookService(
+		cfg.Payment.WebhookSecret,
+		services.NewPaymentService(cfg),
+		services.NewDisputeService(cfg),
+	)
+
+	var event *services.WebhookEvent
+	var err error
+
+	switch {
+	case c.Get("x-paystack-signature") != "":
+		event, err = webhookService.ParsePaystackEvent(c.Body(), c.Get("x-paystack-signature"))
+	case c.Get("Stripe-Signature") != "":
+		event, err = webhookService.ParseStripeEvent(c.Body(), c.Get("Stripe-Signature"))
+	default:
+		return utils.BadRequestResponse(c, "Missing webhook signature")
+	}
+
+	if err != nil {
+		logger.WithContext(c.UserContext()).Warn("Rejected payment webhook", zap.Error(err))
+		return utils.UnauthorizedResponse(c, "Invalid webhook signature")
+	}
+
+	if err := webhookService.Apply(c.UserContext(), event); err != nil {
+		logger.WithContext(c.UserContext()).Error("Failed to apply payment webhook",
+			zap.String("provider", string(event.Provider)),
+			zap.String("event", event.Type),
+			zap.String("reference", event.Reference),
+			zap.Error(err),
+		)
+		return utils.InternalServerErrorResponse(c, "Failed to process webhook")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Webhook processed",
+	})
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type InitiatePayoutRequest struct {
+	Currency string `json:"currency" validate:"required,currency"`
+	Amount   int64  `json:"amount,omitempty" validate:"omitempty,min=1"` // minor units; omit to pay out the full balance
+}
+
+type CompletePayoutRequest struct {
+	Reference string `json:"reference" validate:"required"`
+}
+
+type FailPayoutRequest struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+type UpdateOrganizerFeesRequest struct {
+	FeeBasisPoints    *int   `json:"fee_basis_points" validate:"omitnil,min=0,max=10000"`
+	FeeFixedPerTicket *int64 `json:"fee_fixed_per_ticket" validate:"omitnil,min=0"`
+}
+
+type OrganizerFeesResponse struct {
+	OrganizerID       uuid.UUID `json:"organizer_id"`
+	FeeBasisPoints    *int      `json:"fee_basis_points"`
+	FeeFixedPerTicket *int64    `json:"fee_fixed_per_ticket"`
+}
+
+type BalanceResponse struct {
+	Currency string `json:"currency"`
+	Balance  int64  `json:"balance"`
+}
+
+type PayoutResponse struct {
+	ID          uuid.UUID           `json:"id"`
+	OrganizerID uuid.UUID           `json:"organizer_id"`
+	Amount      int64               `json:"amount"`
+	Currency    string              `json:"currency"`
+	Status      models.PayoutStatus `json:"status"`
+	Reference   string              `json:"reference,omitempty"`
+	Reason      string              `json:"reason,omitempty"`
+	CompletedAt *time.Time          `json:"completed_at,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+// PAYOUT HANDLERS
+
+// GetMyPayoutsHandler godoc
+// @Summary Get organizer balances and payouts
+// @Description Get the authenticated organizer's balance per currency and payout history (Organizer/Admin only)
+// @ID getMyPayouts
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} object{success=bool,data=object{balances=[]BalanceResponse,payouts=[]PayoutResponse},pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/payouts [get]
+func GetMyPayoutsHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit, _ := strconv.Atoi(c.Query("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	// Team members with payouts:view see the payouts of the organizer they work for
+	organizer, err := services.NewPermissionService().ActingOrganizer(c.UserContext(), uid)
+	if err != nil {
+		return err
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	payoutService := services.NewPayoutService(&cfg.Payment)
+
+	balances, err := payoutService.GetBalances(c.UserContext(), organizer.ID)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch balances")
+	}
+
+	payouts, total, err := payoutService.ListPayouts(c.UserContext(), organizer.ID, page, limit)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch payouts")
+	}
+
+	balanceResponses := make([]BalanceResponse, len(balances))
+	for i, balance := range balances {
+		balanceResponses[i] = BalanceResponse{Currency: balance.Currency, Balance: balance.Balance}
+	}
+
+	payoutResponses := make([]PayoutResponse, len(payouts))
+	for i := range payouts {
+		payoutResponses[i] = toPayoutResponse(&payouts[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": fiber.Map{
+			"balances": balanceResponses,
+			"payouts":  payoutResponses,
+		},
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// InitiatePayoutHandler godoc
+// @Summary Initiate an organizer payout
+// @Description Debit an organizer's balance and create a pending payout (Admin only)
+// @ID initiatePayout
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organizer ID"
+// @Param request body InitiatePayoutRequest true "Payout details"
+// @Success 201 {object} object{success=bool,message=string,data=PayoutResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/organizers/{id}/payouts [post]
+func InitiatePayoutHandler(c *fiber.Ctx) error {
+	organizerID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid organizer ID")
+	}
+
+	var req InitiatePayoutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	payout, err := services.NewPayoutService(&cfg.Payment).InitiatePayout(c.UserContext(), organizerID, req.Currency, req.Amount, adminID)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditPayoutInitiated, models.AuditTargetPayout, payout.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Payout initiated",
+		"data":    toPayoutResponse(payout),
+	})
+}
+
+// CompletePayoutHandler godoc
+// @Summary Complete a payout
+// @Description Mark a pending payout as transferred with the transfer reference (Admin only)
+// @ID completePayout
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Payout ID"
+// @Param request body CompletePayoutRequest true "Transfer reference"
+// @Success 200 {object} object{success=bool,message=string,data=PayoutResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/payouts/{id}/complete [post]
+func CompletePayoutHandler(c *fiber.Ctx) error {
+	payoutID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid payout ID")
+	}
+
+	var req CompletePayoutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditPayoutCompleted, models.AuditTargetPayout, payoutID)
+	payout, err := services.NewPayoutService(&cfg.Payment).CompletePayout(c.UserContext(), payoutID, req.Reference)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Payout completed",
+		"data":    toPayoutResponse(payout),
+	})
+}
+
+// FailPayoutHandler godoc
+// @Summary Fail a payout
+// @Description Mark a pending payout as failed and return its amount to the organizer balance (Admin only)
+// @ID failPayout
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Payout ID"
+// @Param request body FailPayoutRequest true "Failure reason"
+// @Success 200 {object} object{success=bool,message=string,data=PayoutResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/payouts/{id}/fail [post]
+func FailPayoutHandler(c *fiber.Ctx) error {
+	payoutID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid payout ID")
+	}
+
+	var req FailPayoutRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditPayoutFailed, models.AuditTargetPayout, payoutID)
+	payout, err := services.NewPayoutService(&cfg.Payment).FailPayout(c.UserContext(), payoutID, req.Reason)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Payout marked as failed",
+		"data":    toPayoutResponse(payout),
+	})
+}
+
+// UpdateOrganizerFeesHandler godoc
+// @Summary Set organizer fees
+// @Description Override the platform fee for an organizer. Omitted fields fall back to the platform defaults (Admin only)
+// @ID updateOrganizerFees
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Organizer ID"
+// @Param request body UpdateOrganizerFeesRequest true "Fee overrides"
+// @Success 200 {object} object{success=bool,message=string,data=OrganizerFeesResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /admin/organizers/{id}/fees [put]
+func UpdateOrganizerFeesHandler(c *fiber.Ctx) error {
+	organizerID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid organizer ID")
+	}
+
+	var req UpdateOrganizerFeesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	audit := beginAudit(c, models.AuditOrganizerFeesUpdated, models.AuditTargetOrganizer, organizerID)
+	organizer, err := services.NewPayoutService(&cfg.Payment).SetOrganizerFees(c.UserContext(), organizerID, req.FeeBasisPoints, req.FeeFixedPerTicket)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Organizer fees updated",
+		"data": OrganizerFeesResponse{
+			OrganizerID:       organizer.ID,
+			FeeBasisPoints:    organizer.FeeBasisPoints,
+			FeeFixedPerTicket: organizer.FeeFixedPerTicket,
+		},
+	})
+}
+
+func toPayoutResponse(payout *models.Payout) PayoutResponse {
+	return PayoutResponse{
+		ID:          payout.ID,
+		OrganizerID: payout.OrganizerID,
+		Amount:      payout.Amount,
+		Currency:    payout.Currency,
+		Status:      payout.Status,
+		Reference:   payout.Reference,
+		Reason:      payout.Reason,
+		CompletedAt: payout.CompletedAt,
+		CreatedAt:   payout.CreatedAt,
+	}
+}
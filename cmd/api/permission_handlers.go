@@ -0,0 +1,107 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateRolePermissionsRequest struct {
+	Permissions []models.Permission `json:"permissions" validate:"required,dive,required"`
+}
+
+type PermissionsResponse struct {
+	Permissions []services.PermissionInfo               `json:"permissions"`
+	Roles       map[models.UserRole][]models.Permission `json:"roles"`
+}
+
+// PERMISSION HANDLERS
+
+// ListPermissionsHandler godoc
+// @Summary List permissions and role mappings
+// @Description List every permission, whether organizers may delegate it to their team, and the permissions each role holds (Admin only)
+// @ID listPermissions
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=PermissionsResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/permissions [get]
+func ListPermissionsHandler(c *fiber.Ctx) error {
+	permissionService := services.NewPermissionService()
+
+	roles, err := permissionService.ListRoles(c.UserContext())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data": PermissionsResponse{
+			Permissions: permissionService.Catalog(),
+			Roles:       roles,
+		},
+	})
+}
+
+// UpdateRolePermissionsHandler godoc
+// @Summary Update a role's permissions
+// @Description Replace the permissions every attendee or organizer holds. Admins always hold every permission. Every replica sees the change within a minute at most (Admin only)
+// @ID updateRolePermissions
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role path string true "Role (attendee, organizer)"
+// @Param request body UpdateRolePermissionsRequest true "Permissions"
+// @Success 200 {object} object{success=bool,message=string,data=models.RolePermissions}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/permissions/{role} [put]
+func UpdateRolePermissionsHandler(c *fiber.Ctx) error {
+	role := models.UserRole(c.Params("role"))
+
+	var req UpdateRolePermissionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+	permissionService := services.NewPermissionService()
+
+	existing, err := permissionService.FindRole(c.UserContext(), role)
+	if err != nil {
+		return err
+	}
+	var audit *pendingAudit
+	if existing != nil {
+		audit = beginAudit(c, models.AuditRolePermissionsUpdated, models.AuditTargetRole, existing.ID)
+	}
+
+	stored, err := permissionService.SetRolePermissions(c.UserContext(), role, req.Permissions, adminID)
+	if err != nil {
+		return err
+	}
+	if audit != nil {
+		audit.record(c)
+	} else {
+		recordCreated(c, models.AuditRolePermissionsUpdated, models.AuditTargetRole, stored.ID)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Role permissions updated successfully",
+		"data":    stored,
+	})
+}
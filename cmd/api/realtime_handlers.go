@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/realtime"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// maxWatchedEvents caps how many events one connection may follow
+	maxWatchedEvents = 20
+
+	socketPingInterval = 30 * time.Second
+	socketPongTimeout  = 60 * time.Second
+	socketWriteTimeout = 10 * time.Second
+
+	// streamHeartbeatInterval keeps idle SSE connections open through proxies
+	streamHeartbeatInterval = 15 * time.Second
+)
+
+// AvailabilitySocketRequest is sent by clients to follow or stop following an event
+type AvailabilitySocketRequest struct {
+	Action  string `json:"action"` // subscribe or unsubscribe
+	EventID string `json:"event_id"`
+}
+
+// AvailabilitySocketMessage is pushed to clients
+type AvailabilitySocketMessage struct {
+	Type    string      `json:"type"` // subscribed, unsubscribed, availability or error
+	EventID *uuid.UUID  `json:"event_id,omitempty"`
+	Message string      `json:"message,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// REALTIME HANDLERS
+
+// RequireWebSocketUpgrade rejects plain HTTP requests to WebSocket endpoints
+func RequireWebSocketUpgrade(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return utils.ErrorResponse(c, fiber.StatusUpgradeRequired, "UPGRADE_REQUIRED", "This endpoint requires a WebSocket connection", nil)
+	}
+	return c.Next()
+}
+
+// AvailabilitySocketHandler godoc
+// @Summary Live ticket availability
+// @Description WebSocket endpoint. Send {"action":"subscribe","event_id":"..."} (or pass ?event_id=) to receive the current availability of every tier of an event, then an update after every reservation, release, sale and tier change. Send {"action":"unsubscribe","event_id":"..."} to stop.
+// @ID availabilitySocket
+// @Tags Events
+// @Param event_id query string false "Event to subscribe to on connect"
+// @Success 101 {object} AvailabilitySocketMessage
+// @Failure 426 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /ws [get]
+func AvailabilitySocketHandler(repos *repositories.Repositories) fiber.Handler {
+	return websocket.New(func(conn *websocket.Conn) {
+		serveAvailabilitySocket(conn, repos)
+	})
+}
+
+func serveAvailabilitySocket(conn *websocket.Conn, repos *repositories.Repositories) {
+	sub := realtime.NewSubscriber()
+	defer sub.Close()
+
+	replies := make(chan AvailabilitySocketMessage, 4)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	defer close(stop)
+
+	conn.SetReadDeadline(time.Now().Add(socketPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(socketPongTimeout))
+	})
+
+	// Reader: the only goroutine reading from conn
+	go func() {
+		defer close(done)
+
+		if eventID := conn.Query("event_id"); eventID != "" {
+			select {
+			case replies <- watchEvent(repos, sub, eventID):
+			case <-stop:
+				return
+			}
+		}
+
+		for {
+			var req AvailabilitySocketRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+
+			var reply AvailabilitySocketMessage
+			switch req.Action {
+			case "subscribe":
+				reply = watchEvent(repos, sub, req.EventID)
+			case "unsubscribe":
+				reply = unwatchEvent(sub, req.EventID)
+			default:
+				reply = AvailabilitySocketMessage{Type: "error", Message: "action must be subscribe or unsubscribe"}
+			}
+
+			select {
+			case replies <- reply:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(socketPingInterval)
+	defer ping.Stop()
+
+	// Writer: the only goroutine writing to conn
+	for {
+		var err error
+		select {
+		case <-done:
+			return
+		case reply := <-replies:
+			err = writeSocketJSON(conn, reply)
+		case payload := <-sub.C:
+			err = writeSocketJSON(conn, AvailabilitySocketMessage{Type: "availability", Data: json.RawMessage(payload)})
+		case <-ping.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(socketWriteTimeout))
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// StreamCheckinsHandler godoc
+// @Summary Live check-in feed
+// @Description Server-sent events stream pushing each successful check-in for an event as a "checkin" event (Organizer/Admin only, or a scanner token for the event)
+// @ID streamCheckins
+// @Tags Check-in
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} services.CheckinFeedEntry
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /checkin/events/{id}/stream [get]
+func StreamCheckinsHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	if err := authorizeCheckin(c, eventID); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	sub := realtime.NewSubscriber()
+	sub.Subscribe(services.CheckinFeedTopic(eventID))
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+
+		heartbeat := time.NewTicker(streamHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		fmt.Fprintf(w, "retry: 5000\nevent: ready\ndata: {\"event_id\":%q}\n\n", eventID)
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		// A failed flush means the dashboard disconnected
+		for {
+			select {
+			case payload := <-sub.C:
+				fmt.Fprintf(w, "event: checkin\ndata: %s\n\n", payload)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}))
+
+	return nil
+}
+
+// watchEvent subscribes to an event and returns its current availability
+func watchEvent(repos *repositories.Repositories, sub *realtime.Subscriber, rawID string) AvailabilitySocketMessage {
+	eventID, err := uuid.Parse(rawID)
+	if err != nil {
+		return AvailabilitySocketMessage{Type: "error", Message: "Invalid event ID"}
+	}
+
+	ctx := context.Background()
+	if _, err := repos.Events.FindByID(ctx, eventID); err != nil {
+		return AvailabilitySocketMessage{Type: "error", EventID: &eventID, Message: "Event not found"}
+	}
+
+	if sub.Topics() >= maxWatchedEvents {
+		return AvailabilitySocketMessage{Type: "error", EventID: &eventID, Message: "Too many subscriptions on this connection"}
+	}
+
+	// Subscribe before reading the snapshot so no update in between is missed
+	sub.Subscribe(services.AvailabilityTopic(eventID))
+
+	snapshot, err := services.EventAvailability(ctx, eventID, services.AvailabilitySnapshot)
+	if err != nil {
+		return AvailabilitySocketMessage{Type: "error", EventID: &eventID, Message: "Failed to load availability"}
+	}
+
+	return AvailabilitySocketMessage{Type: "subscribed", EventID: &eventID, Data: snapshot}
+}
+
+func unwatchEvent(sub *realtime.Subscriber, rawID string) AvailabilitySocketMessage {
+	eventID, err := uuid.Parse(rawID)
+	if err != nil {
+		return AvailabilitySocketMessage{Type: "error", Message: "Invalid event ID"}
+	}
+
+	sub.Unsubscribe(services.AvailabilityTopic(eventID))
+	return AvailabilitySocketMessage{Type: "unsubscribed", EventID: &eventID}
+}
+
+func writeSocketJSON(conn *websocket.Conn, message AvailabilitySocketMessage) error {
+	conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+	return conn.WriteJSON(message)
+}
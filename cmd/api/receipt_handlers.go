@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RECEIPT HANDLERS
+
+// GetOrderReceiptHandler godoc
+// @Summary Download an order receipt
+// @Description Render the PDF receipt of a paid or refunded order owned by the authenticated user
+// @ID getOrderReceipt
+// @Tags Orders
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Success 200 {file} file
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /orders/{id}/receipt [get]
+func GetOrderReceiptHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orderID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid order ID")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		order, err := repos.Orders.FindByID(c.UserContext(), orderID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Order not found")
+		}
+
+		if order.UserID != uid {
+			return utils.ForbiddenResponse(c, "This order belongs to another user")
+		}
+
+		receipt, err := services.NewReceiptService().Generate(c.UserContext(), order.ID)
+		if err != nil {
+			logger.WithContext(c.UserContext()).Warn("Failed to generate receipt", zap.String("order_id", order.ID.String()), zap.Error(err))
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		c.Set(fiber.HeaderContentType, services.ReceiptContentType)
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, services.ReceiptFilename(order.ID)))
+		return c.Send(receipt)
+	}
+}
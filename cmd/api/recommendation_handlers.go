@@ -0,0 +1,64 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// RECOMMENDATION HANDLERS
+
+// GetRecommendedEventsHandler godoc
+// @Summary Recommended events
+// @Description Upcoming events ranked for the authenticated user by the organizers, categories and locations of their past purchases, topped up with featured and best-selling events. Anonymous users and users without purchases get featured and best-selling events only
+// @ID getRecommendedEvents
+// @Tags Events
+// @Produce json
+// @Param limit query int false "Number of events" default(10)
+// @Success 200 {object} object{success=bool,data=[]EventResponse,personalized=bool}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/recommended [get]
+func GetRecommendedEventsHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		limit := queryLimit(c)
+
+		var uid uuid.UUID
+		if userID, ok := c.Locals("user_id").(string); ok {
+			uid, _ = uuid.Parse(userID)
+		}
+
+		eventRepo := repos.Events
+
+		var events []models.Event
+		if uid != uuid.Nil {
+			var err error
+			events, err = eventRepo.Recommended(c.UserContext(), uid, limit)
+			if err != nil {
+				return utils.InternalServerErrorResponse(c, "Failed to fetch recommendations")
+			}
+		}
+		personalized := len(events) > 0
+
+		if len(events) < limit {
+			exclude := make([]uuid.UUID, len(events))
+			for i := range events {
+				exclude[i] = events[i].ID
+			}
+
+			popular, err := eventRepo.Popular(c.UserContext(), uid, limit-len(events), exclude)
+			if err != nil {
+				return utils.InternalServerErrorResponse(c, "Failed to fetch recommendations")
+			}
+			events = append(events, popular...)
+		}
+
+		return c.JSON(fiber.Map{
+			"success":      true,
+			"data":         eventMapper.all(c, toEventResponses(events)),
+			"personalized": personalized,
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ReconciliationReportResponse struct {
+	ID           uuid.UUID                    `json:"id"`
+	Provider     models.PaymentProvider       `json:"provider"`
+	Date         string                       `json:"date"`
+	Transactions int                          `json:"transactions"`
+	Matched      int                          `json:"matched"`
+	Issues       []models.ReconciliationIssue `json:"issues"`
+	CreatedAt    time.Time                    `json:"created_at"`
+}
+
+func toReconciliationReportResponse(report *models.ReconciliationReport) ReconciliationReportResponse {
+	issues := report.Issues
+	if issues == nil {
+		issues = []models.ReconciliationIssue{}
+	}
+	return ReconciliationReportResponse{
+		ID:           report.ID,
+		Provider:     report.Provider,
+		Date:         report.Date.Format("2006-01-02"),
+		Transactions: report.Transactions,
+		Matched:      report.Matched,
+		Issues:       issues,
+		CreatedAt:    report.CreatedAt,
+	}
+}
+
+// RECONCILIATION HANDLERS
+
+// GetReconciliationReportHandler godoc
+// @Summary Get payment reconciliation reports
+// @Description Compare a day of Paystack and Stripe settlements with our payments: mismatched amounts, transactions unknown here (orphaned) and payments whose webhook never arrived. Defaults to the latest reconciled day (Admin only)
+// @ID getReconciliationReport
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param date query string false "UTC day (YYYY-MM-DD)"
+// @Param provider query string false "Payment provider (paystack, stripe)"
+// @Success 200 {object} object{success=bool,data=[]ReconciliationReportResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/reconciliation [get]
+func GetReconciliationReportHandler(c *fiber.Ctx) error {
+	var day *time.Time
+	if raw := c.Query("date"); raw != "" {
+		parsed, err := time.Parse("2006-01-02", raw)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid date, expected YYYY-MM-DD")
+		}
+		day = &parsed
+	}
+
+	provider := models.PaymentProvider(c.Query("provider"))
+	if provider != "" && !services.IsSupportedProvider(provider) {
+		return utils.BadRequestResponse(c, "Unsupported payment provider")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	reports, err := services.NewReconciliationService(&cfg.Payment).Reports(c.UserContext(), day, provider)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch reconciliation reports")
+	}
+
+	responses := make([]ReconciliationReportResponse, len(reports))
+	for i := range reports {
+		responses[i] = toReconciliationReportResponse(&reports[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RefundRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type UpdateRefundPolicyRequest struct {
+	Policy         models.RefundPolicy `json:"policy" validate:"required,oneof=flexible deadline none"`
+	DaysBefore     int                 `json:"days_before,omitempty" validate:"min=0,max=365"`        // deadline policy only
+	FeeBasisPoints int                 `json:"fee_basis_points,omitempty" validate:"min=0,max=10000"` // 500 keeps 5% of each refund
+}
+
+type RefundPolicyResponse struct {
+	Policy         models.RefundPolicy `json:"policy"`
+	DaysBefore     int                 `json:"days_before,omitempty"`
+	FeeBasisPoints int                 `json:"fee_basis_points,omitempty"`
+	// When attendee refunds close; omitted when the event offers none
+	RefundableUntil *time.Time `json:"refundable_until,omitempty"`
+}
+
+type RefundResponse struct {
+	ID        uuid.UUID           `json:"id"`
+	OrderID   uuid.UUID           `json:"order_id"`
+	Amount    int64               `json:"amount"`
+	Fee       int64               `json:"fee,omitempty"` // kept under the event's refund policy
+	Currency  string              `json:"currency"`
+	Status    models.RefundStatus `json:"status"`
+	Reason    string              `json:"reason,omitempty"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+func toRefundPolicyResponse(event *models.Event) RefundPolicyResponse {
+	response := RefundPolicyResponse{
+		Policy:         event.RefundPolicy,
+		DaysBefore:     event.RefundDaysBefore,
+		FeeBasisPoints: event.RefundFeeBasisPoints,
+	}
+	if response.Policy == "" {
+		response.Policy = models.RefundPolicyFlexible
+	}
+	if deadline, ok := event.RefundDeadline(); ok {
+		response.RefundableUntil = &deadline
+	}
+	return response
+}
+
+// REFUND HANDLERS
+
+// RequestRefundHandler godoc
+// @Summary Request a refund
+// @Description Refund a paid order owned by the authenticated user, subject to the event refund policy
+// @ID requestRefund
+// @Tags Orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body RefundRequest false "Refund reason"
+// @Success 200 {object} object{success=bool,message=string,data=RefundResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /orders/{id}/refund [post]
+func RequestRefundHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orderID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid order ID")
+		}
+
+		var req RefundRequest
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&req); err != nil {
+				return utils.BadRequestResponse(c, "Invalid request body")
+			}
+			if errs := utils.ValidateStruct(req); errs != nil {
+				return utils.ValidationErrorResponse(c, errs)
+			}
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		order, err := repos.Orders.FindByID(c.UserContext(), orderID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Order not found")
+		}
+
+		if order.UserID != uid {
+			return utils.ForbiddenResponse(c, "This order belongs to another user")
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		paymentService := services.NewPaymentService(cfg)
+
+		feeBasisPoints, err := paymentService.ValidateRefundPolicy(order)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		refund, err := paymentService.RefundOrderWithFee(order.ID, uid, req.Reason, feeBasisPoints)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "Order refunded successfully",
+			"data":    toRefundResponse(refund),
+		})
+	}
+}
+
+// AdminRefundOrderHandler godoc
+// @Summary Refund an order (admin)
+// @Description Refund any paid order regardless of the event refund policy (Admin only)
+// @ID adminRefundOrder
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Order ID"
+// @Param request body RefundRequest false "Refund reason"
+// @Success 200 {object} object{success=bool,message=string,data=RefundResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/orders/{id}/refund [post]
+func AdminRefundOrderHandler(c *fiber.Ctx) error {
+	orderID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid order ID")
+	}
+
+	var req RefundRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if errs := utils.ValidateStruct(req); errs != nil {
+			return utils.ValidationErrorResponse(c, errs)
+		}
+	}
+
+	adminID, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	paymentService := services.NewPaymentService(cfg)
+
+	audit := beginAudit(c, models.AuditOrderRefunded, models.AuditTargetOrder, orderID)
+	refund, err := paymentService.RefundOrder(orderID, adminID, req.Reason)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Order refunded successfully",
+		"data":    toRefundResponse(refund),
+	})
+}
+
+func toRefundResponse(refund *models.Refund) RefundResponse {
+	return RefundResponse{
+		ID:        refund.ID,
+		OrderID:   refund.OrderID,
+		Amount:    refund.Amount,
+		Fee:       refund.Fee,
+		Currency:  refund.Currency,
+		Status:    refund.Status,
+		Reason:    refund.Reason,
+		CreatedAt: refund.CreatedAt,
+	}
+}
+
+// UpdateEventRefundPolicyHandler godoc
+// @Summary Set an event's refund policy
+// @Description Set when attendees may refund their own orders: until the event starts (flexible), until days_before days before it (deadline) or never (none), and the fee in basis points kept from each refund. Refunds offered after a reschedule are always in full (Organizer/Admin only)
+// @ID updateEventRefundPolicy
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body UpdateRefundPolicyRequest true "Refund policy"
+// @Success 200 {object} object{success=bool,message=string,data=RefundPolicyResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/refund-policy [put]
+func UpdateEventRefundPolicyHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req UpdateRefundPolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	eventService := services.NewEventService()
+	if err := eventService.AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditEventRefundsUpdated, models.AuditTargetEvent, eventID)
+	event, err := eventService.SetRefundPolicy(c.UserContext(), eventID, services.RefundPolicySettings{
+		Policy:         req.Policy,
+		DaysBefore:     req.DaysBefore,
+		FeeBasisPoints: req.FeeBasisPoints,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Refund policy updated",
+		"data":    toRefundPolicyResponse(event),
+	})
+}
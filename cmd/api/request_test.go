@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// apiResponse is a response of the test app
+type apiResponse struct {
+	status int
+	body   []byte
+}
+
+// decode reads the JSON body into v
+func (r *apiResponse) decode(t *testing.T, v interface{}) {
+	t.Helper()
+	if err := json.Unmarshal(r.body, v); err != nil {
+		t.Fatalf("decode response: %v\n%s", err, r.body)
+	}
+}
+
+// expect fails the test unless the response has status
+func (r *apiResponse) expect(t *testing.T, status int) {
+	t.Helper()
+	if r.status != status {
+		t.Fatalf("status = %d, want %d\n%s", r.status, status, r.body)
+	}
+}
+
+// newRequest builds a request to the API, with body encoded as JSON unless
+// it is already []byte and token sent as a bearer token when not empty
+func newRequest(t *testing.T, method, path, token string, body interface{}) *http.Request {
+	t.Helper()
+
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		reader = bytes.NewReader(b)
+	default:
+		data, err := json.Marshal(b)
+		if err != nil {
+			t.Fatalf("encode request: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	if reader != nil {
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	if token != "" {
+		req.Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+	}
+	return req
+}
+
+// call sends req to app and reads the whole response
+func call(t *testing.T, app *fiber.App, req *http.Request) *apiResponse {
+	t.Helper()
+
+	resp, err := send(app, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+// send is call for goroutines other than the test's, which cannot fail it
+func send(app *fiber.App, req *http.Request) (*apiResponse, error) {
+	resp, err := app.Test(req, -1)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	return &apiResponse{status: resp.StatusCode, body: body}, nil
+}
@@ -0,0 +1,155 @@
+//go:build integration
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/internal/testutil"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// reserveConcurrently has one buyer per entry of quantities reserve that
+// many tickets of tier at once, and returns how many tickets the successful
+// reservations got. Responses other than a reservation or a sold-out 400
+// fail the test.
+func reserveConcurrently(t *testing.T, app *fiber.App, tier *models.TicketTier, quantities []int) (reserved, succeeded int) {
+	t.Helper()
+
+	requests := make([]*http.Request, len(quantities))
+	for i, quantity := range quantities {
+		buyer := testutil.CreateUser(t, models.RoleAttendee)
+		requests[i] = newRequest(t, http.MethodPost, "/api/v1/tickets/reserve", testutil.AccessToken(t, buyer), fiber.Map{
+			"tier_id":  tier.ID,
+			"quantity": quantity,
+		})
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := range requests {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			resp, err := send(app, requests[i])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			switch resp.status {
+			case fiber.StatusOK:
+				var body struct {
+					Data struct {
+						Quantity int `json:"quantity"`
+					} `json:"data"`
+				}
+				if err := json.Unmarshal(resp.body, &body); err != nil {
+					t.Errorf("decode reservation: %v", err)
+					return
+				}
+				if body.Data.Quantity != quantities[i] {
+					t.Errorf("reserved %d tickets, asked for %d", body.Data.Quantity, quantities[i])
+				}
+				mu.Lock()
+				reserved += body.Data.Quantity
+				succeeded++
+				mu.Unlock()
+			case fiber.StatusBadRequest:
+				// Sold out
+			default:
+				t.Errorf("reserve status = %d\n%s", resp.status, resp.body)
+			}
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+	return reserved, succeeded
+}
+
+// expectHolds fails the test unless Redis tracks count reservation holds
+// of tickets tickets in total, so each successful reservation is backed by
+// the inventory it took
+func expectHolds(t *testing.T, count, tickets int) {
+	t.Helper()
+
+	holds, err := env.Redis.HGetAll(context.Background(), utils.ReservationHoldsKey).Result()
+	if err != nil {
+		t.Fatalf("load reservation holds: %v", err)
+	}
+	if len(holds) != count {
+		t.Errorf("%d reservation holds, want %d", len(holds), count)
+	}
+
+	held := 0
+	for _, data := range holds {
+		var hold services.ReservationData
+		if err := json.Unmarshal([]byte(data), &hold); err != nil {
+			t.Fatalf("decode reservation hold: %v", err)
+		}
+		held += hold.Quantity
+	}
+	if held != tickets {
+		t.Errorf("holds cover %d tickets, want %d", held, tickets)
+	}
+}
+
+// TestConcurrentReservationsDoNotOversell races more buyers than there are
+// tickets for a small tier, one ticket each, so exactly the tier's capacity
+// is reserved
+func TestConcurrentReservationsDoNotOversell(t *testing.T) {
+	app := newTestApp(t)
+
+	const capacity = 10
+	event := testutil.CreateEvent(t, testutil.CreateOrganizer(t), 2500, capacity)
+	tier := &event.TicketTiers[0]
+
+	quantities := make([]int, 4*capacity)
+	for i := range quantities {
+		quantities[i] = 1
+	}
+
+	reserved, succeeded := reserveConcurrently(t, app, tier, quantities)
+	if reserved != capacity {
+		t.Errorf("reserved %d tickets, want the tier's %d", reserved, capacity)
+	}
+	expectAvailable(t, tier.ID, capacity-reserved)
+	expectHolds(t, succeeded, reserved)
+}
+
+// TestConcurrentReservationsOfSeveralTickets races buyers asking for one to
+// three tickets each. However the race goes, the tier never goes below zero
+// and what is left is the capacity less what was reserved.
+func TestConcurrentReservationsOfSeveralTickets(t *testing.T) {
+	app := newTestApp(t)
+
+	const capacity = 7
+	event := testutil.CreateEvent(t, testutil.CreateOrganizer(t), 2500, capacity)
+	tier := &event.TicketTiers[0]
+
+	quantities := make([]int, 24)
+	for i := range quantities {
+		quantities[i] = 1 + i%3
+	}
+
+	reserved, succeeded := reserveConcurrently(t, app, tier, quantities)
+	if reserved > capacity {
+		t.Errorf("reserved %d tickets of a tier of %d", reserved, capacity)
+	}
+	if reserved == 0 {
+		t.Error("no reservation succeeded")
+	}
+	expectAvailable(t, tier.ID, capacity-reserved)
+	expectHolds(t, succeeded, reserved)
+}
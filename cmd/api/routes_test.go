@@ -0,0 +1,193 @@
+//go:build integration
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/internal/testutil"
+	"eventix-api/pkg/middleware"
+	"eventix-api/pkg/openapi"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// contractWorld is what the route sweep calls each route against: an
+// organizer's published event, a buyer's paid order for two of its tickets,
+// an admin and an attendee with no stake in any of it
+type contractWorld struct {
+	admin     *models.User
+	organizer *models.Organizer
+	buyer     *models.User
+	stranger  *models.User
+	event     *models.Event
+	order     *models.Order
+	ticket    models.Ticket
+	reference string
+	apiKey    string // the organizer's integration key
+}
+
+func newContractWorld(t *testing.T) *contractWorld {
+	t.Helper()
+
+	w := &contractWorld{
+		admin:     testutil.CreateUser(t, models.RoleAdmin),
+		organizer: testutil.CreateOrganizer(t),
+		buyer:     testutil.CreateUser(t, models.RoleAttendee),
+		stranger:  testutil.CreateUser(t, models.RoleAttendee),
+	}
+	w.event = testutil.CreateEvent(t, w.organizer, 5000, 10)
+	w.order = testutil.CreateOrder(t, w.buyer, &w.event.TicketTiers[0], 2)
+	tickets := testutil.PayOrder(t, services.NewPaymentService(env.Config), w.order)
+	if len(tickets) == 0 {
+		t.Fatal("paid order has no tickets")
+	}
+	w.ticket = tickets[0]
+	var payment models.Payment
+	if err := env.DB.Where("order_id = ?", w.order.ID).First(&payment).Error; err != nil {
+		t.Fatalf("load payment: %v", err)
+	}
+	w.reference = payment.TransactionID
+
+	_, key, err := services.NewIntegrationKeyService().Create(context.Background(), w.organizer.ID, "contract tests", w.organizer.UserID)
+	if err != nil {
+		t.Fatalf("create integration key: %v", err)
+	}
+	w.apiKey = key
+	return w
+}
+
+// params gives the world's record for each parameter of a route path.
+// Parameters it has no record for are left out.
+func (w *contractWorld) params(path string) map[string]string {
+	params := map[string]string{
+		"slug":      w.event.Slug,
+		"reference": w.reference,
+	}
+	switch {
+	case strings.Contains(path, "/events/{id}"):
+		params["id"] = w.event.ID.String()
+	case strings.HasPrefix(path, "/orders/{id}"), strings.HasPrefix(path, "/admin/orders/{id}"):
+		params["id"] = w.order.ID.String()
+	case strings.Contains(path, "/tickets/{id}"):
+		params["id"] = w.ticket.ID.String()
+	case strings.HasPrefix(path, "/tiers/{id}"):
+		params["id"] = w.event.TicketTiers[0].ID.String()
+	case strings.HasPrefix(path, "/admin/organizers/{id}"):
+		params["id"] = w.organizer.ID.String()
+	case strings.HasPrefix(path, "/admin/users/{id}"):
+		params["id"] = w.buyer.ID.String()
+	}
+	return params
+}
+
+// contractCaller is who a probe calls a route as; the zero value is
+// anonymous
+type contractCaller struct {
+	token  string
+	apiKey string
+}
+
+// owner is the caller a route's records in the world belong to: the admin
+// for admin routes, the organizer for routes managing the event and the
+// buyer for everything else
+func (w *contractWorld) owner(t *testing.T, path string) contractCaller {
+	t.Helper()
+
+	switch {
+	case strings.HasPrefix(path, "/integrations/"):
+		return contractCaller{apiKey: w.apiKey}
+	case strings.HasPrefix(path, "/admin/"):
+		return contractCaller{token: testutil.AccessToken(t, w.admin)}
+	case strings.HasSuffix(path, "/favorite"), strings.HasPrefix(path, "/events/{id}/queue"), strings.HasSuffix(path, "/report"):
+		return contractCaller{token: testutil.AccessToken(t, w.buyer)}
+	}
+	for _, prefix := range []string{"/organizer/", "/checkin/", "/events", "/tiers/", "/add-ons/", "/event-sessions/", "/event-zones/", "/form-fields/"} {
+		if strings.HasPrefix(path, prefix) {
+			return contractCaller{token: testutil.AccessToken(t, &w.organizer.User)}
+		}
+	}
+	return contractCaller{token: testutil.AccessToken(t, w.buyer)}
+}
+
+// ownerReadSkips are reads the sweep does not make as the owner: the
+// check-in feed streams until the client goes away, and verifying a payment
+// asks the provider
+var ownerReadSkips = map[string]bool{
+	"GET /checkin/events/{id}/stream":  true,
+	"GET /payments/verify/{reference}": true,
+}
+
+// probe calls route as caller with body; checkContract checks the response
+func probe(t *testing.T, app *fiber.App, route openapi.Route, path string, caller contractCaller, body interface{}) {
+	t.Helper()
+
+	req := newRequest(t, route.Method, "/api/v1"+path, caller.token, body)
+	if caller.apiKey != "" {
+		req.Header.Set(middleware.APIKeyHeader, caller.apiKey)
+	}
+	call(t, app, req)
+}
+
+// TestContractRoutes calls every documented route anonymously, with
+// malformed and unknown IDs, as someone with no stake in its records, with
+// malformed and empty bodies and, for reads, as the records' owner. Every
+// response must have a documented status and match its documented schema.
+// Owners only call writes with malformed or empty bodies; the API tests of
+// each flow cover their successes.
+func TestContractRoutes(t *testing.T) {
+	spec, err := contractSpec()
+	if err != nil {
+		t.Fatalf("load Swagger docs: %v", err)
+	}
+
+	for _, route := range spec.Routes() {
+		t.Run(route.Method+" "+route.Path, func(t *testing.T) {
+			app := newTestApp(t)
+			w := newContractWorld(t)
+
+			owner := w.owner(t, route.Path)
+			stranger := contractCaller{token: testutil.AccessToken(t, w.stranger)}
+			fixtures := expandPath(route.Path, w.params(route.Path), uuid.NewString())
+			hasParams := specParam.MatchString(route.Path)
+			hasBody := false
+			for _, param := range route.Parameters {
+				hasBody = hasBody || param.In == "body"
+			}
+			var empty interface{}
+			if hasBody {
+				empty = fiber.Map{}
+			}
+
+			probe(t, app, route, fixtures, contractCaller{}, nil)
+			if hasParams {
+				probe(t, app, route, expandPath(route.Path, nil, "not-an-id"), owner, empty)
+				probe(t, app, route, expandPath(route.Path, nil, uuid.NewString()), owner, empty)
+			}
+			probe(t, app, route, fixtures, stranger, empty)
+			if hasBody {
+				probe(t, app, route, fixtures, owner, []byte("{"))
+				probe(t, app, route, fixtures, owner, fiber.Map{})
+			}
+			if route.Method == http.MethodGet && !ownerReadSkips[route.Method+" "+route.Path] {
+				probe(t, app, route, fixtures, owner, nil)
+			}
+		})
+	}
+
+	// Statuses only a flow's own test can reach, such as conflicts, are left
+	// to those tests; list the ones nothing reached
+	missing := unobserved(spec)
+	t.Logf("%d documented statuses were not observed", len(missing))
+	if testing.Verbose() {
+		for _, status := range missing {
+			t.Log(status)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type IssueScannerTokenRequest struct {
+	Label            string `json:"label,omitempty" validate:"max=100"`
+	ExpiresInMinutes int    `json:"expires_in_minutes,omitempty" validate:"omitempty,min=15,max=1440"` // defaults to 12 hours
+}
+
+type ScannerTokenResponse struct {
+	ID        uuid.UUID `json:"id"`
+	EventID   uuid.UUID `json:"event_id"`
+	Label     string    `json:"label,omitempty"`
+	Token     string    `json:"token,omitempty"` // only returned when issued
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toScannerTokenResponse(token *models.ScannerToken) ScannerTokenResponse {
+	return ScannerTokenResponse{
+		ID:        token.ID,
+		EventID:   token.EventID,
+		Label:     token.Label,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	}
+}
+
+// authorizeCheckin checks that the caller may check in tickets of an event:
+// door staff only for the event of their scanner token, users for the events
+// they manage
+func authorizeCheckin(c *fiber.Ctx, eventID uuid.UUID) error {
+	if scannerEventID, ok := c.Locals("scanner_event_id").(string); ok {
+		if scannerEventID != eventID.String() {
+			return errors.New("this scanner token is for another event")
+		}
+		return nil
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+	return services.NewEventService().AuthorizeEventAccess(eventID, uid, role)
+}
+
+// SCANNER TOKEN HANDLERS
+
+// IssueScannerTokenHandler godoc
+// @Summary Issue a door staff scanner token
+// @Description Issue a short-lived token for temporary gate staff. It only reaches the check-in routes of this event, so staff never need organizer credentials. The token is returned once; check-ins made with it are recorded against the issuer
+// @ID issueScannerToken
+// @Tags Check-in
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body IssueScannerTokenRequest false "Label and lifetime"
+// @Success 201 {object} object{success=bool,message=string,data=ScannerTokenResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/events/{id}/scanner-tokens [post]
+func IssueScannerTokenHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req IssueScannerTokenRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	expiry := time.Duration(req.ExpiresInMinutes) * time.Minute
+	token, signed, err := services.NewScannerTokenService().Issue(c.UserContext(), eventID, uid, req.Label, expiry)
+	if err != nil {
+		return err
+	}
+	recordCreated(c, models.AuditScannerTokenIssued, models.AuditTargetScannerToken, token.ID)
+
+	response := toScannerTokenResponse(token)
+	response.Token = signed
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Scanner token issued successfully",
+		"data":    response,
+	})
+}
+
+// ListScannerTokensHandler godoc
+// @Summary List scanner tokens
+// @Description List the unexpired, unrevoked scanner tokens of an event. Token values are not returned
+// @ID listScannerTokens
+// @Tags Check-in
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=[]ScannerTokenResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/events/{id}/scanner-tokens [get]
+func ListScannerTokensHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	tokens, err := services.NewScannerTokenService().List(c.UserContext(), eventID)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]ScannerTokenResponse, len(tokens))
+	for i := range tokens {
+		responses[i] = toScannerTokenResponse(&tokens[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// RevokeScannerTokenHandler godoc
+// @Summary Revoke a scanner token
+// @Description Stop a scanner token from checking in tickets before it expires
+// @ID revokeScannerToken
+// @Tags Check-in
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Scanner token ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/scanner-tokens/{id} [delete]
+func RevokeScannerTokenHandler(c *fiber.Ctx) error {
+	tokenID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid scanner token ID")
+	}
+
+	scannerTokens := services.NewScannerTokenService()
+	token, err := scannerTokens.Get(c.UserContext(), tokenID)
+	if err != nil {
+		return err
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(token.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditScannerTokenRevoked, models.AuditTargetScannerToken, tokenID)
+	if err := scannerTokens.Revoke(c.UserContext(), token); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Scanner token revoked successfully",
+	})
+}
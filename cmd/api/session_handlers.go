@@ -0,0 +1,98 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	Current    bool      `json:"current"`
+}
+
+// SESSION HANDLERS
+
+// GetMySessionsHandler godoc
+// @Summary List my sessions
+// @Description List the devices the authenticated user is signed in on, most recently used first
+// @ID getMySessions
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]SessionResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /users/me/sessions [get]
+func GetMySessionsHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	currentID, _ := c.Locals("session_id").(string)
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	sessions, err := services.NewSessionService(&cfg.JWT).List(c.UserContext(), uid)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch sessions")
+	}
+
+	responses := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			CreatedAt:  session.CreatedAt,
+			Current:    session.ID.String() == currentID,
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// RevokeMySessionHandler godoc
+// @Summary Revoke a session
+// @Description Sign the authenticated user out of one device. Its refresh token stops working and its access tokens are rejected.
+// @ID revokeMySession
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Session ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /users/me/sessions/{id} [delete]
+func RevokeMySessionHandler(c *fiber.Ctx) error {
+	sessionID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid session ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	if err := services.NewSessionService(&cfg.JWT).Revoke(c.UserContext(), uid, sessionID); err != nil {
+		if err.Error() == "session not found" {
+			return utils.NotFoundResponse(c, "Session not found")
+		}
+		return utils.InternalServerErrorResponse(c, "Failed to revoke session")
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Session revoked",
+	})
+}
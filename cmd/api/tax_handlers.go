@@ -0,0 +1,137 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateTaxRequest struct {
+	Name        string `json:"name,omitempty" validate:"omitempty,max=50"` // e.g. "VAT" or "Sales tax"
+	BasisPoints *int   `json:"basis_points" validate:"omitnil,min=0,max=10000"`
+	Inclusive   bool   `json:"inclusive"` // prices already include the tax
+}
+
+type TaxResponse struct {
+	Name        string `json:"name,omitempty"`
+	BasisPoints *int   `json:"basis_points"`
+	Inclusive   bool   `json:"inclusive"`
+}
+
+// TAX HANDLERS
+
+// UpdateEventTaxHandler godoc
+// @Summary Set an event's tax
+// @Description Set the sales tax or VAT charged on the event's orders, in basis points (750 is 7.5%). Inclusive tax is part of the ticket and add-on prices; exclusive tax is added at checkout. A null basis_points falls back to the organizer's tax settings (Organizer/Admin only)
+// @ID updateEventTax
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body UpdateTaxRequest true "Tax settings"
+// @Success 200 {object} object{success=bool,message=string,data=TaxResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/tax [put]
+func UpdateEventTaxHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req UpdateTaxRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditEventTaxUpdated, models.AuditTargetEvent, eventID)
+	event, err := services.NewTaxService().SetEventTax(c.UserContext(), eventID, services.TaxSettings{
+		Name:        req.Name,
+		BasisPoints: req.BasisPoints,
+		Inclusive:   req.Inclusive,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Event tax updated",
+		"data": TaxResponse{
+			Name:        event.TaxName,
+			BasisPoints: event.TaxBasisPoints,
+			Inclusive:   event.TaxInclusive,
+		},
+	})
+}
+
+// UpdateOrganizerTaxHandler godoc
+// @Summary Set the organizer's default tax
+// @Description Set the sales tax or VAT charged on orders for the organizer's events that have no tax settings of their own, in basis points (750 is 7.5%). A null basis_points charges no tax (Organizer only)
+// @ID updateOrganizerTax
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateTaxRequest true "Tax settings"
+// @Success 200 {object} object{success=bool,message=string,data=TaxResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/tax [put]
+func UpdateOrganizerTaxHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	var req UpdateTaxRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	var existing models.Organizer
+	if err := database.DB.Where("user_id = ?", uid).First(&existing).Error; err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	audit := beginAudit(c, models.AuditOrganizerTaxUpdated, models.AuditTargetOrganizer, existing.ID)
+	organizer, err := services.NewTaxService().SetOrganizerTax(c.UserContext(), existing.ID, services.TaxSettings{
+		Name:        req.Name,
+		BasisPoints: req.BasisPoints,
+		Inclusive:   req.Inclusive,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Organizer tax updated",
+		"data": TaxResponse{
+			Name:        organizer.TaxName,
+			BasisPoints: organizer.TaxBasisPoints,
+			Inclusive:   organizer.TaxInclusive,
+		},
+	})
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type InviteTeamMemberRequest struct {
+	Email       string              `json:"email" validate:"required,email"`
+	Role        models.StaffRole    `json:"role" validate:"required,oneof=scanner finance editor custom"`
+	Permissions []models.Permission `json:"permissions,omitempty" validate:"dive,required"` // custom role only
+}
+
+type UpdateTeamMemberRequest struct {
+	Role        models.StaffRole    `json:"role" validate:"required,oneof=scanner finance editor custom"`
+	Permissions []models.Permission `json:"permissions,omitempty" validate:"dive,required"` // custom role only
+}
+
+type TeamMemberResponse struct {
+	ID              uuid.UUID           `json:"id"`
+	Email           string              `json:"email"`
+	UserID          *uuid.UUID          `json:"user_id,omitempty"`
+	FirstName       string              `json:"first_name,omitempty"`
+	LastName        string              `json:"last_name,omitempty"`
+	Role            models.StaffRole    `json:"role"`
+	Permissions     []models.Permission `json:"permissions"`
+	Status          models.MemberStatus `json:"status"`
+	InviteExpiresAt *time.Time          `json:"invite_expires_at,omitempty"`
+	AcceptedAt      *time.Time          `json:"accepted_at,omitempty"`
+	CreatedAt       time.Time           `json:"created_at"`
+}
+
+func toTeamMemberResponse(member *models.OrganizerMember) TeamMemberResponse {
+	response := TeamMemberResponse{
+		ID:              member.ID,
+		Email:           member.Email,
+		UserID:          member.UserID,
+		Role:            member.Role,
+		Permissions:     member.Permissions,
+		Status:          member.Status,
+		InviteExpiresAt: member.InviteExpiresAt,
+		AcceptedAt:      member.AcceptedAt,
+		CreatedAt:       member.CreatedAt,
+	}
+	if member.User != nil {
+		response.FirstName = member.User.FirstName
+		response.LastName = member.User.LastName
+	}
+	return response
+}
+
+// TEAM HANDLERS
+
+// ListTeamMembersHandler godoc
+// @Summary List my team
+// @Description List the organizer's team members and pending invitations
+// @ID listTeamMembers
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]TeamMemberResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/team [get]
+func ListTeamMembersHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	members, err := services.NewTeamService(cfg).List(c.UserContext(), organizer.ID)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]TeamMemberResponse, len(members))
+	for i := range members {
+		responses[i] = toTeamMemberResponse(&members[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// InviteTeamMemberHandler godoc
+// @Summary Invite a team member
+// @Description Email an invitation to join the organizer's team as a scanner (check-in only), finance (analytics and payouts), editor (event editing and comps) or with custom permissions. Members only act on this organizer's events. Inviting a pending address again sends a fresh link
+// @ID inviteTeamMember
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body InviteTeamMemberRequest true "Invitee and role"
+// @Success 201 {object} object{success=bool,message=string,data=TeamMemberResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/team [post]
+func InviteTeamMemberHandler(c *fiber.Ctx) error {
+	var req InviteTeamMemberRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	member, err := services.NewTeamService(cfg).Invite(c.UserContext(), organizer, services.TeamInvite{
+		Email:       req.Email,
+		Role:        req.Role,
+		Permissions: req.Permissions,
+	}, uid)
+	if err != nil {
+		return err
+	}
+	recordCreated(c, models.AuditMemberInvited, models.AuditTargetMember, member.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Invitation sent successfully",
+		"data":    toTeamMemberResponse(member),
+	})
+}
+
+// UpdateTeamMemberHandler godoc
+// @Summary Change a team member's role
+// @Description Change the role or custom permissions of a team member or pending invitee
+// @ID updateTeamMember
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Team member ID"
+// @Param request body UpdateTeamMemberRequest true "Role"
+// @Success 200 {object} object{success=bool,message=string,data=TeamMemberResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/team/{id} [put]
+func UpdateTeamMemberHandler(c *fiber.Ctx) error {
+	memberID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid team member ID")
+	}
+
+	var req UpdateTeamMemberRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	audit := beginAudit(c, models.AuditMemberUpdated, models.AuditTargetMember, memberID)
+	member, err := services.NewTeamService(cfg).Update(c.UserContext(), organizer.ID, memberID, req.Role, req.Permissions)
+	if err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Team member updated successfully",
+		"data":    toTeamMemberResponse(member),
+	})
+}
+
+// RemoveTeamMemberHandler godoc
+// @Summary Remove a team member
+// @Description Take a member off the team, or withdraw a pending invitation
+// @ID removeTeamMember
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Team member ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/team/{id} [delete]
+func RemoveTeamMemberHandler(c *fiber.Ctx) error {
+	memberID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid team member ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	audit := beginAudit(c, models.AuditMemberRemoved, models.AuditTargetMember, memberID)
+	if err := services.NewTeamService(cfg).Remove(c.UserContext(), organizer.ID, memberID); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Team member removed successfully",
+	})
+}
+
+// AcceptTeamInvitationHandler godoc
+// @Summary Accept a team invitation
+// @Description Join the organizer team that invited the signed-in user's email address. Only attendee accounts can join, and only one team at a time
+// @ID acceptTeamInvitation
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Param token path string true "Invitation token from the email link"
+// @Success 200 {object} object{success=bool,message=string,data=TeamMemberResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /team-invitations/{token}/accept [post]
+func AcceptTeamInvitationHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		uid, err := uuid.Parse(c.Locals("user_id").(string))
+		if err != nil {
+			return utils.UnauthorizedResponse(c, "Invalid user ID")
+		}
+
+		user, err := repos.Users.FindByID(c.UserContext(), uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "User not found")
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		member, err := services.NewTeamService(cfg).Accept(c.UserContext(), c.Params("token"), user)
+		if err != nil {
+			return err
+		}
+		recordCreated(c, models.AuditMemberJoined, models.AuditTargetMember, member.ID)
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"message": "You have joined the team",
+			"data":    toTeamMemberResponse(member),
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// TICKET PDF HANDLERS
+
+// GetTicketPDFHandler godoc
+// @Summary Download a printable ticket
+// @Description Download a PDF of a ticket owned by the authenticated user with its QR code, event details and terms of entry
+// @ID getTicketPDF
+// @Tags Tickets
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param id path string true "Ticket ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tickets/{id}/pdf [get]
+func GetTicketPDFHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ticketID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid ticket ID")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		ticket, err := repos.Tickets.FindForOwner(c.UserContext(), ticketID, uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Ticket not found")
+		}
+
+		pdf, err := services.NewTicketPDFService().Render(c.UserContext(), ticket.ID)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		c.Set(fiber.HeaderContentType, services.TicketPDFContentType)
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, services.TicketPDFFilename(ticket.ID)))
+		return c.Send(pdf)
+	}
+}
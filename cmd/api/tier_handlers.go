@@ -0,0 +1,268 @@
+package main
+
+import (
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateTicketTierRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitnil,min=1"`
+	Description *string `json:"description,omitempty"`
+	Price       *int64  `json:"price,omitempty" validate:"omitnil,min=0"`
+	Quantity    *int    `json:"quantity,omitempty" validate:"omitnil,min=1"`
+}
+
+type PricePhaseRequest struct {
+	Name          string     `json:"name" validate:"required"`
+	Price         int64      `json:"price" validate:"min=0"` // minor units
+	StartTime     *time.Time `json:"start_time,omitempty"`
+	EndTime       *time.Time `json:"end_time,omitempty"`
+	QuantityLimit int        `json:"quantity_limit,omitempty" validate:"min=0"` // phase ends once the tier has sold this many
+}
+
+type SetPricePhasesRequest struct {
+	Phases []PricePhaseRequest `json:"phases" validate:"max=10,dive"` // applied in order; empty sells at the regular price
+}
+
+// TICKET TIER HANDLERS
+
+// CreateTicketTierHandler godoc
+// @Summary Add a ticket tier
+// @Description Add a ticket tier to an existing event (Organizer/Admin only)
+// @ID createTicketTier
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param tier body TicketTierReq true "Tier details"
+// @Success 201 {object} object{success=bool,message=string,data=TicketTierResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /events/{id}/tiers [post]
+func CreateTicketTierHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req TicketTierReq
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	tier, err := services.NewTierService().CreateTier(c.UserContext(), eventID, services.TierInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Quantity:    req.Quantity,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	recordCreated(c, models.AuditTierCreated, models.AuditTargetTier, tier.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Ticket tier created successfully",
+		"data":    toTicketTierResponse(tier),
+	})
+}
+
+// UpdateTicketTierHandler godoc
+// @Summary Update a ticket tier
+// @Description Update a ticket tier. Quantity cannot drop below tickets sold and price is locked once sales begin (Organizer/Admin only)
+// @ID updateTicketTier
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tier ID"
+// @Param tier body UpdateTicketTierRequest true "Fields to update"
+// @Success 200 {object} object{success=bool,message=string,data=TicketTierResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /tiers/{id} [put]
+func UpdateTicketTierHandler(c *fiber.Ctx) error {
+	tierID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid tier ID")
+	}
+
+	var req UpdateTicketTierRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	tierService := services.NewTierService()
+
+	existing, err := tierService.GetTier(c.UserContext(), tierID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Ticket tier not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditTierUpdated, models.AuditTargetTier, tierID)
+	tier, err := tierService.UpdateTier(c.UserContext(), tierID, services.TierUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       req.Price,
+		Quantity:    req.Quantity,
+	})
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Ticket tier updated successfully",
+		"data":    toTicketTierResponse(tier),
+	})
+}
+
+// DeleteTicketTierHandler godoc
+// @Summary Delete a ticket tier
+// @Description Delete a ticket tier that has no sold or reserved tickets (Organizer/Admin only)
+// @ID deleteTicketTier
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tier ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tiers/{id} [delete]
+func DeleteTicketTierHandler(c *fiber.Ctx) error {
+	tierID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid tier ID")
+	}
+
+	tierService := services.NewTierService()
+
+	tier, err := tierService.GetTier(c.UserContext(), tierID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Ticket tier not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(tier.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	audit := beginAudit(c, models.AuditTierDeleted, models.AuditTargetTier, tierID)
+	if err := tierService.DeleteTier(c.UserContext(), tierID); err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Ticket tier deleted successfully",
+	})
+}
+
+// SetPricePhasesHandler godoc
+// @Summary Set a ticket tier's price phases
+// @Description Replace a tier's price phases, such as an early-bird price that ends at a date or after a number of sales. The first phase that applies sets the price; the tier's regular price applies after them all. Reservations keep the price they were held at (Organizer/Admin only)
+// @ID setPricePhases
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Tier ID"
+// @Param request body SetPricePhasesRequest true "Price phases in order"
+// @Success 200 {object} object{success=bool,message=string,data=TicketTierResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /tiers/{id}/price-phases [put]
+func SetPricePhasesHandler(c *fiber.Ctx) error {
+	tierID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid tier ID")
+	}
+
+	var req SetPricePhasesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	tierService := services.NewTierService()
+
+	existing, err := tierService.GetTier(c.UserContext(), tierID)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Ticket tier not found")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(existing.EventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	phases := make([]services.PricePhaseInput, len(req.Phases))
+	for i, phase := range req.Phases {
+		phases[i] = services.PricePhaseInput{
+			Name:          phase.Name,
+			Price:         phase.Price,
+			StartTime:     phase.StartTime,
+			EndTime:       phase.EndTime,
+			QuantityLimit: phase.QuantityLimit,
+		}
+	}
+
+	audit := beginAudit(c, models.AuditTierUpdated, models.AuditTargetTier, tierID)
+	tier, err := tierService.SetPricePhases(c.UserContext(), tierID, phases)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Ticket tier price phases updated successfully",
+		"data":    toTicketTierResponse(tier),
+	})
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"mime/multipart"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const maxImageSize = 5 * 1024 * 1024 // 5MB
+
+var imageContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+// UPLOAD HANDLERS
+
+// UploadEventBannerHandler godoc
+// @Summary Upload an event banner
+// @Description Upload a banner image for an event (JPEG, PNG or WebP, max 5MB) (Organizer/Admin only)
+// @ID uploadEventBanner
+// @Tags Events
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param file formData file true "Banner image"
+// @Success 200 {object} object{success=bool,message=string,data=object{banner_url=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/banner [post]
+func UploadEventBannerHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	var event models.Event
+	if err := database.DB.Select("id", "banner_url").First(&event, eventID).Error; err != nil {
+		return utils.NotFoundResponse(c, "Event not found")
+	}
+
+	file, contentType, err := readImage(c)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	url, err := storeImage(c, file, contentType, fmt.Sprintf("events/%s/banners", eventID))
+	if err != nil {
+		logger.WithContext(c.UserContext()).Error("Failed to upload event banner", zap.Error(err))
+		return utils.InternalServerErrorResponse(c, "Failed to upload banner")
+	}
+
+	audit := beginAudit(c, models.AuditEventBannerUpdated, models.AuditTargetEvent, eventID)
+	if err := database.DB.Model(&event).Update("banner_url", url).Error; err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to save banner")
+	}
+	audit.record(c)
+
+	deleteReplacedImage(c.UserContext(), event.BannerURL)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Banner uploaded successfully",
+		"data": fiber.Map{
+			"banner_url": url,
+		},
+	})
+}
+
+// UploadOrganizerLogoHandler godoc
+// @Summary Upload an organizer logo
+// @Description Upload a logo for the authenticated user's organizer profile (JPEG, PNG or WebP, max 5MB)
+// @ID uploadOrganizerLogo
+// @Tags Organizer
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Logo image"
+// @Success 200 {object} object{success=bool,message=string,data=object{logo_url=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/logo [post]
+func UploadOrganizerLogoHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	var organizer models.Organizer
+	if err := database.DB.Where("user_id = ?", uid).First(&organizer).Error; err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	file, contentType, err := readImage(c)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	url, err := storeImage(c, file, contentType, fmt.Sprintf("organizers/%s/logos", organizer.ID))
+	if err != nil {
+		logger.WithContext(c.UserContext()).Error("Failed to upload organizer logo", zap.Error(err))
+		return utils.InternalServerErrorResponse(c, "Failed to upload logo")
+	}
+
+	previous := organizer.Logo
+	audit := beginAudit(c, models.AuditOrganizerLogoUpdated, models.AuditTargetOrganizer, organizer.ID)
+	if err := database.DB.Model(&organizer).Update("logo", url).Error; err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to save logo")
+	}
+	audit.record(c)
+
+	deleteReplacedImage(c.UserContext(), previous)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Logo uploaded successfully",
+		"data": fiber.Map{
+			"logo_url": url,
+		},
+	})
+}
+
+// readImage returns the "file" form field once it has been validated as an image
+func readImage(c *fiber.Ctx) (*multipart.FileHeader, string, error) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return nil, "", fmt.Errorf("image file is required")
+	}
+
+	contentType, err := storage.DetectContentType(file, imageContentTypes, maxImageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return file, contentType, nil
+}
+
+// storeImage uploads an image under prefix and returns its public URL
+func storeImage(c *fiber.Ctx, file *multipart.FileHeader, contentType, prefix string) (string, error) {
+	f, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to read image: %w", err)
+	}
+	defer f.Close()
+
+	key := storage.ObjectKey(prefix, file.Filename)
+	if err := storage.Upload(c.Context(), key, contentType, f, file.Size); err != nil {
+		return "", err
+	}
+
+	return storage.PublicURL(key), nil
+}
+
+// deleteReplacedImage removes a previously uploaded image that is no longer referenced
+func deleteReplacedImage(ctx context.Context, url string) {
+	key, ok := storage.KeyFromPublicURL(url)
+	if !ok {
+		return
+	}
+	if err := storage.Delete(ctx, key); err != nil {
+		logger.WithContext(ctx).Warn("Failed to delete replaced image", zap.String("key", key), zap.Error(err))
+	}
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"slices"
+	"time"
+
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// responseMapper converts a response DTO into its shape in later API
+// versions. It is keyed by the version that introduced each shape; a request
+// gets the shape of the newest version at or before its own, and the v1 DTO
+// unchanged when there is none.
+type responseMapper[T any] map[string]func(T) interface{}
+
+// forVersion returns the conversion for the request's API version, or nil
+// for the v1 shape
+func (m responseMapper[T]) forVersion(c *fiber.Ctx) func(T) interface{} {
+	cfg, _ := c.Locals("config").(*config.Config)
+	for i := slices.Index(cfg.App.Versions, middleware.GetAPIVersion(c)); i >= 0; i-- {
+		if convert, ok := m[cfg.App.Versions[i]]; ok {
+			return convert
+		}
+	}
+	return nil
+}
+
+// one shapes a single response for the request's API version
+func (m responseMapper[T]) one(c *fiber.Ctx, response T) interface{} {
+	convert := m.forVersion(c)
+	if convert == nil {
+		return response
+	}
+	return convert(response)
+}
+
+// all shapes a list of responses for the request's API version
+func (m responseMapper[T]) all(c *fiber.Ctx, responses []T) interface{} {
+	convert := m.forVersion(c)
+	if convert == nil {
+		return responses
+	}
+	shaped := make([]interface{}, len(responses))
+	for i, response := range responses {
+		shaped[i] = convert(response)
+	}
+	return shaped
+}
+
+// cacheKey scopes a cached response to the request's API version, since
+// versions render the same data differently
+func cacheKey(c *fiber.Ctx, key string) string {
+	return middleware.GetAPIVersion(c) + ":" + key
+}
+
+// V2 RESPONSES
+
+// Money is an amount in the minor unit of its currency
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// EventResponseV2 is EventResponse with tier prices as Money
+type EventResponseV2 struct {
+	EventResponse
+	TicketTiers []TicketTierResponseV2 `json:"ticket_tiers,omitempty"`
+}
+
+// TicketTierResponseV2 is TicketTierResponse with prices as Money
+type TicketTierResponseV2 struct {
+	TicketTierResponse
+	Price        Money                  `json:"price"`
+	CurrentPrice Money                  `json:"current_price"`
+	PricePhases  []PricePhaseResponseV2 `json:"price_phases,omitempty"`
+}
+
+type PricePhaseResponseV2 struct {
+	PricePhaseResponse
+	Price Money `json:"price"`
+}
+
+type FavoriteResponseV2 struct {
+	Event   EventResponseV2 `json:"event"`
+	SavedAt time.Time       `json:"saved_at"`
+}
+
+var eventMapper = responseMapper[EventResponse]{
+	"v2": func(event EventResponse) interface{} { return toEventResponseV2(event) },
+}
+
+var favoriteMapper = responseMapper[FavoriteResponse]{
+	"v2": func(favorite FavoriteResponse) interface{} {
+		return FavoriteResponseV2{Event: toEventResponseV2(favorite.Event), SavedAt: favorite.SavedAt}
+	},
+}
+
+func toEventResponseV2(event EventResponse) EventResponseV2 {
+	response := EventResponseV2{EventResponse: event}
+	if event.TicketTiers == nil {
+		return response
+	}
+
+	response.TicketTiers = make([]TicketTierResponseV2, len(event.TicketTiers))
+	for i, tier := range event.TicketTiers {
+		response.TicketTiers[i] = TicketTierResponseV2{
+			TicketTierResponse: tier,
+			Price:              Money{Amount: tier.Price, Currency: tier.Currency},
+			CurrentPrice:       Money{Amount: tier.CurrentPrice, Currency: tier.Currency},
+		}
+		if tier.PricePhases != nil {
+			phases := make([]PricePhaseResponseV2, len(tier.PricePhases))
+			for j, phase := range tier.PricePhases {
+				phases[j] = PricePhaseResponseV2{
+					PricePhaseResponse: phase,
+					Price:              Money{Amount: phase.Price, Currency: tier.Currency},
+				}
+			}
+			response.TicketTiers[i].PricePhases = phases
+		}
+	}
+	return response
+}
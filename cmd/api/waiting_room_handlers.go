@@ -0,0 +1,138 @@
+package main
+
+import (
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateWaitingRoomRequest struct {
+	Enabled *bool `json:"enabled" validate:"required"`
+}
+
+// WAITING ROOM HANDLERS
+
+// JoinWaitingRoomHandler godoc
+// @Summary Join an event's waiting room
+// @Description Get a queue token for a high-demand event. Poll its status until admitted, then pass it as queue_token to /tickets/reserve.
+// @ID joinWaitingRoom
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=services.WaitingRoomPosition}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/queue [post]
+func JoinWaitingRoomHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	position, err := services.NewWaitingRoomService(&cfg.Limits).Join(c.UserContext(), eventID, uid)
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    position,
+	})
+}
+
+// GetWaitingRoomPositionHandler godoc
+// @Summary Get waiting room status
+// @Description Get the position of a queue token, or when it was admitted until
+// @ID getWaitingRoomPosition
+// @Tags Events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param token path string true "Queue token"
+// @Success 200 {object} object{success=bool,data=services.WaitingRoomPosition}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/queue/{token} [get]
+func GetWaitingRoomPositionHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	position, err := services.NewWaitingRoomService(&cfg.Limits).Position(c.UserContext(), eventID, uid, c.Params("token"))
+	if err != nil {
+		return utils.BadRequestResponse(c, err.Error())
+	}
+
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    position,
+	})
+}
+
+// UpdateWaitingRoomHandler godoc
+// @Summary Enable or disable an event's waiting room
+// @Description When enabled, buyers must be admitted from the virtual queue before reserving tickets. Disabling it discards the queue. (Organizer/Admin only)
+// @ID updateWaitingRoom
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body UpdateWaitingRoomRequest true "Waiting room setting"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /events/{id}/waiting-room [put]
+func UpdateWaitingRoomHandler(c *fiber.Ctx) error {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid event ID")
+	}
+
+	var req UpdateWaitingRoomRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	role := c.Locals("role").(string)
+
+	if err := services.NewEventService().AuthorizeEventAccess(eventID, uid, role); err != nil {
+		return utils.ForbiddenResponse(c, err.Error())
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	audit := beginAudit(c, models.AuditEventWaitingRoomUpdated, models.AuditTargetEvent, eventID)
+	if err := services.NewWaitingRoomService(&cfg.Limits).SetEnabled(c.UserContext(), eventID, *req.Enabled); err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to update waiting room")
+	}
+	audit.record(c)
+
+	message := "Waiting room disabled"
+	if *req.Enabled {
+		message = "Waiting room enabled"
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": message,
+	})
+}
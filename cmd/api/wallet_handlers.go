@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GoogleWalletPassResponse struct {
+	SaveURL string `json:"save_url"`
+}
+
+// WALLET HANDLERS
+
+// GetTicketWalletPassHandler godoc
+// @Summary Get a wallet pass for a ticket
+// @Description Download a signed Apple Wallet pass (.pkpass) for an active ticket owned by the authenticated user, or get an "Add to Google Wallet" link with platform=google
+// @ID getTicketWalletPass
+// @Tags Tickets
+// @Produce application/vnd.apple.pkpass
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Ticket ID"
+// @Param platform query string false "Wallet platform (apple, google)" default(apple)
+// @Success 200 {file} binary
+// @Success 200 {object} object{success=bool,data=GoogleWalletPassResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 503 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /tickets/{id}/wallet-pass [get]
+func GetTicketWalletPassHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ticketID, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid ticket ID")
+		}
+
+		platform := c.Query("platform", "apple")
+		if platform != "apple" && platform != "google" {
+			return utils.BadRequestResponse(c, "Platform must be apple or google")
+		}
+
+		uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+		ticket, err := repos.Tickets.FindForOwner(c.UserContext(), ticketID, uid)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Ticket not found")
+		}
+
+		cfg, _ := c.Locals("config").(*config.Config)
+		walletService := services.NewWalletService(&cfg.Wallet, cfg.Server.FrontendURL)
+
+		if platform == "google" {
+			if !walletService.GoogleEnabled() {
+				return utils.ErrorResponse(c, fiber.StatusServiceUnavailable, utils.CodeServiceUnavailable, "Google Wallet passes are not available", nil)
+			}
+
+			saveURL, err := walletService.GoogleSaveURL(c.UserContext(), ticket.ID)
+			if err != nil {
+				return utils.BadRequestResponse(c, err.Error())
+			}
+
+			return c.JSON(fiber.Map{
+				"success": true,
+				"data":    GoogleWalletPassResponse{SaveURL: saveURL},
+			})
+		}
+
+		if !walletService.AppleEnabled() {
+			return utils.ErrorResponse(c, fiber.StatusServiceUnavailable, utils.CodeServiceUnavailable, "Apple Wallet passes are not available", nil)
+		}
+
+		pass, err := walletService.ApplePass(c.UserContext(), ticket.ID)
+		if err != nil {
+			return utils.BadRequestResponse(c, err.Error())
+		}
+
+		c.Set(fiber.HeaderContentType, services.PassContentType)
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, services.PassFilename(ticket.ID)))
+		c.Set(fiber.HeaderCacheControl, "private, no-store")
+		return c.Send(pass)
+	}
+}
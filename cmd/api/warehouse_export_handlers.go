@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateWarehouseExportRequest struct {
+	Date string `json:"date,omitempty"` // UTC day (YYYY-MM-DD), yesterday by default
+}
+
+type WarehouseExportResponse struct {
+	ID          uuid.UUID                     `json:"id"`
+	Date        string                        `json:"date"`
+	Status      models.WarehouseExportStatus  `json:"status"`
+	Error       string                        `json:"error,omitempty"`
+	RequestedBy *uuid.UUID                    `json:"requested_by,omitempty"`
+	Files       []WarehouseExportFileResponse `json:"files"`
+	CreatedAt   time.Time                     `json:"created_at"`
+	CompletedAt *time.Time                    `json:"completed_at,omitempty"`
+}
+
+type WarehouseExportFileResponse struct {
+	Dataset string `json:"dataset"`
+	Key     string `json:"key"`
+	Rows    int64  `json:"rows"`
+	Size    int64  `json:"size"`
+	URL     string `json:"url,omitempty"` // expires after 15 minutes
+}
+
+func toWarehouseExportResponse(export *models.WarehouseExport) WarehouseExportResponse {
+	files := make([]WarehouseExportFileResponse, len(export.Files))
+	for i, file := range export.Files {
+		files[i] = WarehouseExportFileResponse{
+			Dataset: file.Dataset,
+			Key:     file.Key,
+			Rows:    file.Rows,
+			Size:    file.Size,
+		}
+	}
+	return WarehouseExportResponse{
+		ID:          export.ID,
+		Date:        export.Date.Format("2006-01-02"),
+		Status:      export.Status,
+		Error:       export.Error,
+		RequestedBy: export.RequestedBy,
+		Files:       files,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+	}
+}
+
+// withDownloadLinks adds short-lived download links to an export's files
+func withDownloadLinks(ctx context.Context, response WarehouseExportResponse) WarehouseExportResponse {
+	for i := range response.Files {
+		response.Files[i].URL, _ = storage.PresignGet(ctx, response.Files[i].Key, 15*time.Minute)
+	}
+	return response
+}
+
+// WAREHOUSE EXPORT HANDLERS
+
+// CreateWarehouseExportHandler godoc
+// @Summary Export a day to the data warehouse
+// @Description Write the orders, tickets, payments and check-ins created or changed on a UTC day to S3 as gzipped CSV, one date-partitioned file per dataset, replacing any earlier export of that day. The nightly job does the same for yesterday (Admin only)
+// @ID createWarehouseExport
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateWarehouseExportRequest false "Day to export"
+// @Success 201 {object} object{success=bool,message=string,data=WarehouseExportResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 503 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/exports [post]
+func CreateWarehouseExportHandler(c *fiber.Ctx) error {
+	var req CreateWarehouseExportRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+	}
+
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	if req.Date != "" {
+		parsed, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return utils.BadRequestResponse(c, "Invalid date, expected YYYY-MM-DD")
+		}
+		day = parsed
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+	cfg, _ := c.Locals("config").(*config.Config)
+
+	export, err := services.NewWarehouseExportService(&cfg.Export).Export(c.UserContext(), day, &uid)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Export completed",
+		"data":    withDownloadLinks(c.UserContext(), toWarehouseExportResponse(export)),
+	})
+}
+
+// ListWarehouseExportsHandler godoc
+// @Summary List data warehouse exports
+// @Description List nightly and on-demand warehouse exports with their files, newest first (Admin only)
+// @ID listWarehouseExports
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{success=bool,data=[]WarehouseExportResponse,pagination=object{page=int,limit=int,total=int}}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 500 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/exports [get]
+func ListWarehouseExportsHandler(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	limit := queryLimit(c)
+
+	if page < 1 {
+		page = 1
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	exports, total, err := services.NewWarehouseExportService(&cfg.Export).List(c.UserContext(), (page-1)*limit, limit)
+	if err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to fetch exports")
+	}
+
+	responses := make([]WarehouseExportResponse, len(exports))
+	for i := range exports {
+		responses[i] = toWarehouseExportResponse(&exports[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+		},
+	})
+}
+
+// GetWarehouseExportHandler godoc
+// @Summary Get a data warehouse export
+// @Description Get an export with short-lived download links to its files (Admin only)
+// @ID getWarehouseExport
+// @Tags Admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Export ID"
+// @Success 200 {object} object{success=bool,data=WarehouseExportResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /admin/exports/{id} [get]
+func GetWarehouseExportHandler(c *fiber.Ctx) error {
+	exportID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid export ID")
+	}
+
+	cfg, _ := c.Locals("config").(*config.Config)
+	export, err := services.NewWarehouseExportService(&cfg.Export).Get(c.UserContext(), exportID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    withDownloadLinks(c.UserContext(), toWarehouseExportResponse(export)),
+	})
+}
@@ -0,0 +1,407 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type AddWidgetOriginRequest struct {
+	// Site the widget is embedded on, e.g. "https://tickets.example.com"
+	Origin string `json:"origin" validate:"required,max=255"`
+}
+
+type WidgetOriginResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Origin    string    `json:"origin"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type WidgetEventResponse struct {
+	ID            uuid.UUID            `json:"id"`
+	Title         string               `json:"title"`
+	Slug          string               `json:"slug"`
+	Description   string               `json:"description"`
+	Location      string               `json:"location"`
+	StartTime     time.Time            `json:"start_time"`
+	EndTime       time.Time            `json:"end_time"`
+	BannerURL     string               `json:"banner_url,omitempty"`
+	Currency      string               `json:"currency"`
+	WaitingRoom   bool                 `json:"waiting_room"`
+	OrganizerName string               `json:"organizer_name"`
+	GuestCheckout bool                 `json:"guest_checkout"` // whether buyers can check out without an account
+	TicketTiers   []TicketTierResponse `json:"ticket_tiers"`
+	// Sites the organizer embeds the widget on, for the frame's
+	// Content-Security-Policy frame-ancestors
+	AllowedOrigins []string `json:"allowed_origins"`
+}
+
+func toWidgetOriginResponse(origin *models.WidgetOrigin) WidgetOriginResponse {
+	return WidgetOriginResponse{
+		ID:        origin.ID,
+		Origin:    origin.Origin,
+		CreatedBy: origin.CreatedBy,
+		CreatedAt: origin.CreatedAt,
+	}
+}
+
+// widgetEvent loads the published event of a widget route
+func widgetEvent(c *fiber.Ctx, events repositories.EventRepository) (*models.Event, error) {
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return nil, utils.BadRequestError("Invalid event ID")
+	}
+
+	event, err := events.FindByID(c.UserContext(), eventID)
+	if errors.Is(err, repositories.ErrNotFound) || (err == nil && event.Status != models.EventPublished) {
+		return nil, utils.NotFoundError("Event not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func eventHasTier(event *models.Event, tierID uuid.UUID) bool {
+	for _, tier := range event.TicketTiers {
+		if tier.ID == tierID {
+			return true
+		}
+	}
+	return false
+}
+
+// WIDGET ORIGIN HANDLERS
+
+// ListWidgetOriginsHandler godoc
+// @Summary List widget origins
+// @Description List the sites the organizer's checkout widget can be embedded on
+// @ID listWidgetOrigins
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{success=bool,data=[]WidgetOriginResponse}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/widget-origins [get]
+func ListWidgetOriginsHandler(c *fiber.Ctx) error {
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	origins, err := services.NewWidgetService().ListOrigins(c.UserContext(), organizer.ID)
+	if err != nil {
+		return err
+	}
+
+	responses := make([]WidgetOriginResponse, len(origins))
+	for i := range origins {
+		responses[i] = toWidgetOriginResponse(&origins[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    responses,
+	})
+}
+
+// AddWidgetOriginHandler godoc
+// @Summary Allow a widget origin
+// @Description Allow the checkout widget of the organizer's events on a site. The widget routes answer cross-origin requests from it; the origin must use https, except for localhost
+// @ID addWidgetOrigin
+// @Tags Organizer
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AddWidgetOriginRequest true "Site origin"
+// @Success 201 {object} object{success=bool,message=string,data=WidgetOriginResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /organizer/widget-origins [post]
+func AddWidgetOriginHandler(c *fiber.Ctx) error {
+	var req AddWidgetOriginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+	if errs := utils.ValidateStruct(req); errs != nil {
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	origin, err := services.NewWidgetService().AddOrigin(c.UserContext(), organizer.ID, req.Origin, uid)
+	if err != nil {
+		return err
+	}
+	recordCreated(c, models.AuditWidgetOriginAdded, models.AuditTargetWidgetOrigin, origin.ID)
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Widget origin added successfully",
+		"data":    toWidgetOriginResponse(origin),
+	})
+}
+
+// RemoveWidgetOriginHandler godoc
+// @Summary Remove a widget origin
+// @Description Stop the checkout widget from answering requests from a site
+// @ID removeWidgetOrigin
+// @Tags Organizer
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Widget origin ID"
+// @Success 200 {object} object{success=bool,message=string}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /organizer/widget-origins/{id} [delete]
+func RemoveWidgetOriginHandler(c *fiber.Ctx) error {
+	originID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid widget origin ID")
+	}
+
+	uid, _ := uuid.Parse(c.Locals("user_id").(string))
+
+	organizer, err := services.NewOrganizerService().GetByUserID(uid)
+	if err != nil {
+		return utils.NotFoundResponse(c, "Organizer profile not found")
+	}
+
+	audit := beginAudit(c, models.AuditWidgetOriginRemoved, models.AuditTargetWidgetOrigin, originID)
+	if err := services.NewWidgetService().RemoveOrigin(c.UserContext(), organizer.ID, originID); err != nil {
+		return err
+	}
+	audit.record(c)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Widget origin removed successfully",
+	})
+}
+
+// WIDGET HANDLERS
+//
+// The /widget routes back the embeddable checkout. Browsers may call them from
+// the sites the event's organizer allowed (see /organizer/widget-origins);
+// requests from other sites get 403 responses. Checkout uses guest tokens from
+// POST /widget/events/{id}/guest, or a buyer's own access token.
+
+// GetWidgetEventHandler godoc
+// @Summary Get an event for the widget
+// @Description Get a published event with its ticket tiers, live availability and the sites its checkout widget is embedded on
+// @ID getWidgetEvent
+// @Tags Widget
+// @Produce json
+// @Param id path string true "Event ID"
+// @Success 200 {object} object{success=bool,data=WidgetEventResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Router /widget/events/{id} [get]
+func GetWidgetEventHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		event, err := widgetEvent(c, repos.Events)
+		if err != nil {
+			return err
+		}
+
+		organizer, err := services.NewOrganizerService().GetByID(event.OrganizerID)
+		if err != nil {
+			return utils.NotFoundResponse(c, "Event not found")
+		}
+
+		origins, err := services.NewWidgetService().EventOrigins(c.UserContext(), event.ID)
+		if err != nil {
+			return err
+		}
+
+		tiers := make([]TicketTierResponse, len(event.TicketTiers))
+		for i := range event.TicketTiers {
+			tiers[i] = toTicketTierResponse(&event.TicketTiers[i])
+		}
+
+		return c.JSON(fiber.Map{
+			"success": true,
+			"data": WidgetEventResponse{
+				ID:             event.ID,
+				Title:          event.Title,
+				Slug:           event.Slug,
+				Description:    event.Description,
+				Location:       event.Location,
+				StartTime:      event.StartTime,
+				EndTime:        event.EndTime,
+				BannerURL:      event.BannerURL,
+				Currency:       event.Currency,
+				WaitingRoom:    event.WaitingRoom,
+				OrganizerName:  organizer.OrganizationName,
+				GuestCheckout:  services.NewFeatureFlagService().IsEnabled(c.UserContext(), services.FeatureGuestCheckout),
+				TicketTiers:    tiers,
+				AllowedOrigins: origins,
+			},
+		})
+	}
+}
+
+// WidgetGuestCheckoutHandler godoc
+// @Summary Start a widget guest checkout
+// @Description Get guest tokens for the widget's reservation, order and payment routes, as with POST /auth/guest
+// @ID widgetGuestCheckout
+// @Tags Widget
+// @Accept json
+// @Produce json
+// @Param id path string true "Event ID"
+// @Param request body GuestCheckoutRequest true "Buyer details"
+// @Success 200 {object} object{success=bool,data=TokenResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 409 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /widget/events/{id}/guest [post]
+func WidgetGuestCheckoutHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if _, err := widgetEvent(c, repos.Events); err != nil {
+			return err
+		}
+		return GuestCheckoutHandler(repos)(c)
+	}
+}
+
+// WidgetReserveTicketHandler godoc
+// @Summary Reserve tickets from the widget
+// @Description Reserve tickets of one of the event's tiers (15-minute hold), as with POST /tickets/reserve
+// @ID widgetReserveTicket
+// @Tags Widget
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body ReserveTicketRequest true "Ticket reservation details"
+// @Success 200 {object} object{success=bool,message=string,data=object{reservation_id=string,tier_id=string,event_id=string,quantity=int,unit_price=int,price_phase=string,total_price=int,expires_at=string}}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /widget/events/{id}/reserve [post]
+func WidgetReserveTicketHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		event, err := widgetEvent(c, repos.Events)
+		if err != nil {
+			return err
+		}
+
+		var req ReserveTicketRequest
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if tierID, err := uuid.Parse(req.TierID); err == nil && !eventHasTier(event, tierID) {
+			return utils.BadRequestResponse(c, "Ticket tier does not belong to this event")
+		}
+
+		return ReserveTicketHandler(c)
+	}
+}
+
+// WidgetCreateOrderHandler godoc
+// @Summary Create an order from the widget
+// @Description Create an order for tickets reserved through the widget, as with POST /orders
+// @ID widgetCreateOrder
+// @Tags Widget
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param order body CreateOrderRequest true "Order details"
+// @Param Idempotency-Key header string false "Unique key that makes retries of this request safe"
+// @Success 201 {object} object{success=bool,message=string,data=OrderResponse}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /widget/events/{id}/orders [post]
+func WidgetCreateOrderHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		event, err := widgetEvent(c, repos.Events)
+		if err != nil {
+			return err
+		}
+
+		var req CreateOrderRequest
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if req.ReservationID != "" {
+			reservation, err := services.NewTicketService().GetReservation(req.ReservationID)
+			if err == nil && reservation.EventID != event.ID {
+				return utils.BadRequestResponse(c, "Reservation does not belong to this event")
+			}
+		}
+
+		return CreateOrderHandler(c)
+	}
+}
+
+// WidgetInitializePaymentHandler godoc
+// @Summary Pay for a widget order
+// @Description Initialize the payment of an order for the event and return the checkout URL, as with POST /payments/initialize
+// @ID widgetInitializePayment
+// @Tags Widget
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Event ID"
+// @Param request body InitializePaymentRequest true "Payment details"
+// @Param Idempotency-Key header string false "Unique key that makes retries of this request safe"
+// @Success 200 {object} object{success=bool,message=string,data=services.PaymentInitResult}
+// @Failure 400 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 401 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 403 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 404 {object} object{success=bool,error=object{code=string,message=string},timestamp=string}
+// @Failure 422 {object} object{success=bool,error=object{code=string,message=string,details=[]utils.FieldError},timestamp=string}
+// @Router /widget/events/{id}/payments/initialize [post]
+func WidgetInitializePaymentHandler(repos *repositories.Repositories) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		event, err := widgetEvent(c, repos.Events)
+		if err != nil {
+			return err
+		}
+
+		var req InitializePaymentRequest
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+		if orderID, err := uuid.Parse(req.OrderID); err == nil {
+			order, err := repos.Orders.FindByID(c.UserContext(), orderID)
+			if err == nil && !eventHasTier(event, order.TierID) {
+				return utils.BadRequestResponse(c, "Order does not belong to this event")
+			}
+		}
+
+		return InitializePaymentHandler(c)
+	}
+}
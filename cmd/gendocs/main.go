@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/swaggo/swag"
+	"github.com/swaggo/swag/gen"
+)
+
+// gendocs regenerates the Swagger docs from the annotations on the API
+// handlers. Run it from the repository root, or with -root pointing there.
+func main() {
+	root := flag.String("root", ".", "repository root")
+	output := flag.String("output", "docs", "output directory, relative to the root")
+	quiet := flag.Bool("quiet", false, "suppress parser output")
+	flag.Parse()
+
+	if err := os.Chdir(*root); err != nil {
+		log.Fatalf("Failed to enter repository root: %v", err)
+	}
+
+	logger := log.New(os.Stdout, "", log.LstdFlags)
+	if *quiet {
+		logger = log.New(io.Discard, "", log.LstdFlags)
+	}
+
+	err := gen.New().Build(&gen.Config{
+		SearchDir:          "./",
+		MainAPIFile:        filepath.Join("cmd", "api", "main.go"),
+		PropNamingStrategy: swag.CamelCase,
+		OutputDir:          *output,
+		OutputTypes:        []string{"go", "json", "yaml"},
+		ParseInternal:      true,
+		ParseDepth:         100,
+		ParseGoList:        true,
+		OverridesFile:      gen.DefaultOverridesFile,
+		LeftTemplateDelim:  "{{",
+		RightTemplateDelim: "}}",
+		CollectionFormat:   "csv",
+		Debugger:           logger,
+	})
+	if err != nil {
+		log.Fatalf("Failed to generate docs: %v", err)
+	}
+}
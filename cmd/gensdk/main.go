@@ -0,0 +1,511 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"eventix-api/pkg/openapi"
+)
+
+// gensdk generates the Go and TypeScript client SDKs from the Swagger spec
+// that cmd/gendocs writes. Run it from the repository root, or with -root
+// pointing there, after regenerating the docs.
+func main() {
+	root := flag.String("root", ".", "repository root")
+	specFile := flag.String("spec", filepath.Join("docs", "swagger.json"), "Swagger spec, relative to the root")
+	output := flag.String("output", "sdk", "output directory, relative to the root")
+	version := flag.String("version", "", "SDK version; defaults to the API version in the spec")
+	flag.Parse()
+
+	if err := os.Chdir(*root); err != nil {
+		log.Fatalf("Failed to enter repository root: %v", err)
+	}
+
+	data, err := os.ReadFile(*specFile)
+	if err != nil {
+		log.Fatalf("Failed to read spec: %v", err)
+	}
+	spec, err := openapi.Load(data)
+	if err != nil {
+		log.Fatalf("Failed to load spec: %v", err)
+	}
+
+	m, err := buildModel(spec)
+	if err != nil {
+		log.Fatalf("Failed to build SDK model: %v", err)
+	}
+	m.Version = *version
+	if m.Version == "" {
+		m.Version = semver(spec.Info.Version)
+	}
+
+	if err := writeGo(m, filepath.Join(*output, "go")); err != nil {
+		log.Fatalf("Failed to generate Go SDK: %v", err)
+	}
+	if err := writeTypeScript(m, filepath.Join(*output, "typescript")); err != nil {
+		log.Fatalf("Failed to generate TypeScript SDK: %v", err)
+	}
+	fmt.Printf("Generated SDK %s with %d operations and %d types\n", m.Version, len(m.Operations), len(m.Types))
+}
+
+// typeKind is the shape of a type reference
+type typeKind int
+
+const (
+	kindAny typeKind = iota
+	kindString
+	kindInt
+	kindInt64
+	kindNumber
+	kindBool
+	kindArray
+	kindMap
+	kindNamed
+)
+
+// typeRef is a language-neutral reference to a type
+type typeRef struct {
+	Kind typeKind
+	Name string   // kindNamed
+	Elem *typeRef // kindArray and kindMap
+}
+
+// declKind is the shape of a named type
+type declKind int
+
+const (
+	declStruct declKind = iota
+	declEnum
+	declMap
+	declAlias
+)
+
+type namedType struct {
+	Name        string
+	Description string
+	Kind        declKind
+	Fields      []field     // declStruct
+	Base        *typeRef    // base of declEnum and declAlias, values of declMap
+	Enum        []enumValue // declEnum
+	Request     bool        // sent in a request body, so optional fields must be omittable
+}
+
+type field struct {
+	Name        string // as sent on the wire
+	Type        *typeRef
+	Required    bool
+	Description string
+}
+
+type enumValue struct {
+	Name  string // constant name from x-enum-varnames, if any
+	Value any
+}
+
+// resultKind is how an operation's successful response is read
+type resultKind int
+
+const (
+	resultNone resultKind = iota
+	resultJSON
+	resultBinary
+	resultStream
+)
+
+type param struct {
+	Name        string // as sent on the wire
+	In          string // path, query, header or formData
+	Type        *typeRef
+	File        bool
+	Required    bool
+	Description string
+}
+
+type operation struct {
+	Name         string // exported name, e.g. GetCurrentUser
+	ID           string // method name in TypeScript, e.g. getCurrentUser
+	Method       string
+	Path         string
+	Summary      string
+	Description  string
+	PathParams   []param
+	Params       []param // query and header parameters
+	Form         []param
+	Body         *typeRef
+	BodyRequired bool
+	Result       resultKind
+	ResultType   *typeRef
+	Types        []*namedType // inline types introduced by the operation
+}
+
+type model struct {
+	Version    string
+	BasePath   string
+	Types      []*namedType // named definitions, sorted
+	Operations []*operation
+	byName     map[string]*namedType
+	spec       *openapi.Spec
+}
+
+func buildModel(spec *openapi.Spec) (*model, error) {
+	m := &model{
+		BasePath: spec.BasePath,
+		byName:   map[string]*namedType{},
+		spec:     spec,
+	}
+
+	requestDefs := map[string]bool{}
+	for _, route := range spec.Routes() {
+		for _, p := range route.Parameters {
+			if p.In == "body" {
+				m.markRequest(p.Schema, requestDefs)
+			}
+		}
+	}
+
+	defNames := make([]string, 0, len(spec.Definitions))
+	for name := range spec.Definitions {
+		defNames = append(defNames, name)
+	}
+	sort.Strings(defNames)
+	for _, name := range defNames {
+		if err := m.claim(typeName(name)); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range defNames {
+		var pending []*namedType
+		t := m.definition(typeName(name), spec.Definitions[name], requestDefs[name], &pending)
+		m.Types = append(m.Types, t)
+		m.Types = append(m.Types, pending...)
+	}
+
+	for _, route := range spec.Routes() {
+		op, err := m.operation(route)
+		if err != nil {
+			return nil, err
+		}
+		if op != nil {
+			m.Operations = append(m.Operations, op)
+		}
+	}
+	return m, nil
+}
+
+// markRequest records every definition reachable from a request body
+func (m *model) markRequest(schema *openapi.Schema, seen map[string]bool) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		name := openapi.RefName(schema.Ref)
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		m.markRequest(m.spec.Definitions[name], seen)
+		return
+	}
+	for _, prop := range schema.Properties {
+		m.markRequest(prop, seen)
+	}
+	for _, part := range schema.AllOf {
+		m.markRequest(part, seen)
+	}
+	m.markRequest(schema.Items, seen)
+	m.markRequest(schema.AdditionalProperties, seen)
+}
+
+func (m *model) claim(name string) error {
+	if _, taken := m.byName[name]; taken {
+		return fmt.Errorf("type name %s is generated twice", name)
+	}
+	m.byName[name] = nil
+	return nil
+}
+
+func (m *model) definition(name string, schema *openapi.Schema, request bool, pending *[]*namedType) *namedType {
+	t := &namedType{Name: name, Description: schema.Description, Request: request}
+	switch {
+	case schema.Type == "object" && len(schema.Properties) > 0:
+		t.Kind = declStruct
+		t.Fields = m.fields(name, schema, request, pending)
+	case schema.Type == "object" && schema.AdditionalProperties != nil:
+		t.Kind = declMap
+		t.Base = m.ref(schema.AdditionalProperties, name+"Value", request, pending)
+	case len(schema.Enum) > 0 && (schema.Type == "string" || schema.Type == "integer"):
+		t.Kind = declEnum
+		t.Base = m.ref(&openapi.Schema{Type: schema.Type, Format: schema.Format}, name, request, pending)
+		for i, value := range schema.Enum {
+			v := enumValue{Value: value}
+			if i < len(schema.EnumVarNames) {
+				v.Name = schema.EnumVarNames[i]
+			}
+			t.Enum = append(t.Enum, v)
+		}
+	default:
+		t.Kind = declAlias
+		t.Base = m.ref(schema, name, request, pending)
+	}
+	m.byName[name] = t
+	return t
+}
+
+func (m *model) fields(parent string, schema *openapi.Schema, request bool, pending *[]*namedType) []field {
+	required := map[string]bool{}
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]field, 0, len(names))
+	for _, name := range names {
+		prop := schema.Properties[name]
+		fields = append(fields, field{
+			Name:        name,
+			Type:        m.ref(prop, parent+pascal(name), request, pending),
+			Required:    required[name],
+			Description: prop.Description,
+		})
+	}
+	return fields
+}
+
+// ref converts a schema to a type reference, declaring a named type for
+// every inline object with properties. Inline types are named after where
+// they appear.
+func (m *model) ref(schema *openapi.Schema, context string, request bool, pending *[]*namedType) *typeRef {
+	switch {
+	case schema == nil:
+		return &typeRef{Kind: kindAny}
+	case schema.Ref != "":
+		return &typeRef{Kind: kindNamed, Name: typeName(openapi.RefName(schema.Ref))}
+	case len(schema.AllOf) == 1:
+		return m.ref(schema.AllOf[0], context, request, pending)
+	}
+
+	switch schema.Type {
+	case "object":
+		if len(schema.Properties) > 0 {
+			t := &namedType{Name: context, Kind: declStruct, Request: request}
+			if _, taken := m.byName[context]; taken {
+				t.Name = context + "Object"
+			}
+			m.byName[t.Name] = t
+			t.Fields = m.fields(t.Name, schema, request, pending)
+			*pending = append(*pending, t)
+			return &typeRef{Kind: kindNamed, Name: t.Name}
+		}
+		if schema.AdditionalProperties != nil {
+			return &typeRef{Kind: kindMap, Elem: m.ref(schema.AdditionalProperties, context+"Value", request, pending)}
+		}
+		return &typeRef{Kind: kindAny}
+	case "array":
+		return &typeRef{Kind: kindArray, Elem: m.ref(schema.Items, context, request, pending)}
+	case "string":
+		return &typeRef{Kind: kindString}
+	case "integer":
+		if schema.Format == "int64" {
+			return &typeRef{Kind: kindInt64}
+		}
+		return &typeRef{Kind: kindInt}
+	case "number":
+		return &typeRef{Kind: kindNumber}
+	case "boolean":
+		return &typeRef{Kind: kindBool}
+	}
+	return &typeRef{Kind: kindAny}
+}
+
+func (m *model) operation(route openapi.Route) (*operation, error) {
+	// WebSocket upgrades can't be made through an HTTP client
+	if _, ok := route.Responses["101"]; ok {
+		return nil, nil
+	}
+
+	op := &operation{
+		ID:          route.ID,
+		Method:      route.Method,
+		Path:        route.Path,
+		Summary:     route.Summary,
+		Description: route.Description,
+	}
+	if op.ID == "" {
+		op.ID = strings.ToLower(route.Method) + pascal(pathWords(route.Path))
+	}
+	op.Name = upperFirst(op.ID)
+
+	for _, p := range route.Parameters {
+		switch p.In {
+		case "body":
+			op.Body = m.ref(p.Schema, op.Name+"Request", true, &op.Types)
+			op.BodyRequired = p.Required
+		case "path", "query", "header", "formData":
+			sp := param{
+				Name:        p.Name,
+				In:          p.In,
+				Type:        m.ref(&openapi.Schema{Type: p.Type, Format: p.Format, Items: p.Items}, op.Name+pascal(p.Name), true, &op.Types),
+				File:        p.Type == "file",
+				Required:    p.Required,
+				Description: p.Description,
+			}
+			switch p.In {
+			case "path":
+				op.PathParams = append(op.PathParams, sp)
+			case "formData":
+				op.Form = append(op.Form, sp)
+			default:
+				op.Params = append(op.Params, sp)
+			}
+		}
+	}
+	if len(op.Params) > 0 {
+		if err := m.claim(op.Name + "Params"); err != nil {
+			return nil, err
+		}
+	}
+	if len(op.Form) > 0 {
+		if err := m.claim(op.Name + "Form"); err != nil {
+			return nil, err
+		}
+	}
+
+	status := ""
+	for code := range route.Responses {
+		if strings.HasPrefix(code, "2") && (status == "" || code < status) {
+			status = code
+		}
+	}
+	resp, ok := route.Responses[status]
+	switch {
+	case !ok || resp.Schema == nil:
+		op.Result = resultNone
+	case slices.Contains(route.Produces, "text/event-stream"):
+		op.Result = resultStream
+	case len(route.Produces) > 0 && !slices.Contains(route.Produces, "application/json") || resp.Schema.Type == "file":
+		op.Result = resultBinary
+	case len(route.Produces) > 1:
+		// Documents served in several formats are returned as they are
+		op.Result = resultBinary
+	default:
+		op.Result = resultJSON
+		if _, taken := m.byName[op.Name+"Response"]; taken {
+			return nil, fmt.Errorf("type name %sResponse is generated twice", op.Name)
+		}
+		op.ResultType = m.ref(resp.Schema, op.Name+"Response", false, &op.Types)
+	}
+	return op, nil
+}
+
+// pathParam matches {param} placeholders in documented paths
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// pathSegments splits a documented path into literal text and parameter
+// names, alternating and starting with literal text
+func pathSegments(path string) []string {
+	var segments []string
+	last := 0
+	for _, loc := range pathParam.FindAllStringSubmatchIndex(path, -1) {
+		segments = append(segments, path[last:loc[0]], path[loc[2]:loc[3]])
+		last = loc[1]
+	}
+	return append(segments, path[last:])
+}
+
+// pathWords joins the literal segments of a path, for naming operations
+// that were documented without an @ID
+func pathWords(path string) string {
+	return pathParam.ReplaceAllString(path, "")
+}
+
+func typeName(definition string) string {
+	if i := strings.LastIndex(definition, "."); i >= 0 {
+		return definition[i+1:]
+	}
+	return definition
+}
+
+// initialisms are kept upper case in generated names
+var initialisms = map[string]bool{
+	"api": true, "csrf": true, "http": true, "id": true, "ip": true, "json": true,
+	"pdf": true, "qr": true, "sms": true, "uri": true, "url": true, "uuid": true,
+}
+
+// pascal converts a wire name such as ticket_id or Idempotency-Key to
+// TicketID or IdempotencyKey
+func pascal(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	var b strings.Builder
+	for _, word := range words {
+		if initialisms[strings.ToLower(word)] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		b.WriteString(upperFirst(word))
+	}
+	name := b.String()
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "N" + name
+	}
+	return name
+}
+
+// camel converts a wire name to lower camel case, e.g. idempotencyKey
+func camel(s string) string {
+	name := pascal(s)
+	for word := range initialisms {
+		if strings.HasPrefix(name, strings.ToUpper(word)) && (len(name) == len(word) || name[len(word)] >= 'A' && name[len(word)] <= 'Z') {
+			return word + name[len(word):]
+		}
+	}
+	return lowerFirst(name)
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// semver pads an API version such as 1.0 to a full semantic version
+func semver(version string) string {
+	version = strings.TrimPrefix(version, "v")
+	if version == "" {
+		return "0.1.0"
+	}
+	for strings.Count(version, ".") < 2 {
+		version += ".0"
+	}
+	return version
+}
+
+// sentence turns a summary into a doc comment sentence
+func sentence(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasSuffix(s, ".") {
+		return s
+	}
+	return s + "."
+}
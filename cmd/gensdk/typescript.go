@@ -0,0 +1,434 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// tsPackage is the npm package the TypeScript SDK is published as
+const tsPackage = "@eventix/api-client"
+
+// tsIdentifier matches property names that need no quotes
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func writeTypeScript(m *model, dir string) error {
+	if err := os.MkdirAll(filepath.Join(dir, "src"), 0o755); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by cmd/gensdk. DO NOT EDIT.\n\n")
+	b.WriteString(strings.Replace(tsRuntime, "{{BASE_PATH}}", strconv.Quote(m.BasePath), 1))
+
+	for _, t := range m.Types {
+		writeTSType(&b, t)
+	}
+	for _, op := range m.Operations {
+		for _, t := range op.Types {
+			writeTSType(&b, t)
+		}
+		writeTSParams(&b, op)
+	}
+
+	b.WriteString("/** Client for the Eventix API */\n")
+	b.WriteString("export class EventixClient extends BaseClient {\n")
+	for i, op := range m.Operations {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		writeTSOperation(&b, op)
+	}
+	b.WriteString("}\n")
+
+	pkg, err := json.MarshalIndent(map[string]any{
+		"name":        tsPackage,
+		"version":     m.Version,
+		"description": "Client for the Eventix ticket booking API, generated from its Swagger spec",
+		"license":     "MIT",
+		"main":        "dist/index.js",
+		"types":       "dist/index.d.ts",
+		"files":       []string{"dist"},
+		"scripts": map[string]string{
+			"build":          "tsc",
+			"prepublishOnly": "npm run build",
+		},
+		"devDependencies": map[string]string{
+			"typescript": "^5.4.0",
+		},
+		"engines": map[string]string{
+			"node": ">=18",
+		},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	files := map[string]string{
+		filepath.Join("src", "index.ts"): b.String(),
+		"package.json":                   string(pkg) + "\n",
+		"tsconfig.json":                  tsConfig,
+		"README.md":                      strings.ReplaceAll(tsReadme, "{{PACKAGE}}", tsPackage),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeTSComment(b *strings.Builder, indent, text string) {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) == 1 {
+		fmt.Fprintf(b, "%s/** %s */\n", indent, strings.ReplaceAll(lines[0], "*/", "* /"))
+		return
+	}
+	fmt.Fprintf(b, "%s/**\n", indent)
+	for _, line := range lines {
+		line = strings.ReplaceAll(strings.TrimSpace(line), "*/", "* /")
+		if line == "" {
+			fmt.Fprintf(b, "%s *\n", indent)
+			continue
+		}
+		fmt.Fprintf(b, "%s * %s\n", indent, line)
+	}
+	fmt.Fprintf(b, "%s */\n", indent)
+}
+
+func writeTSType(b *strings.Builder, t *namedType) {
+	if t.Description != "" {
+		writeTSComment(b, "", t.Description)
+	}
+	switch t.Kind {
+	case declStruct:
+		fmt.Fprintf(b, "export interface %s {\n", t.Name)
+		for _, f := range t.Fields {
+			if f.Description != "" {
+				writeTSComment(b, "  ", f.Description)
+			}
+			optional := "?"
+			if f.Required {
+				optional = ""
+			}
+			fmt.Fprintf(b, "  %s%s: %s;\n", tsProperty(f.Name), optional, tsType(f.Type))
+		}
+		b.WriteString("}\n\n")
+	case declMap:
+		fmt.Fprintf(b, "export type %s = Record<string, %s>;\n\n", t.Name, tsType(t.Base))
+	case declEnum:
+		values := make([]string, 0, len(t.Enum))
+		for _, v := range t.Enum {
+			values = append(values, tsLiteral(v.Value))
+		}
+		fmt.Fprintf(b, "export type %s = %s;\n\n", t.Name, strings.Join(values, " | "))
+	case declAlias:
+		fmt.Fprintf(b, "export type %s = %s;\n\n", t.Name, tsType(t.Base))
+	}
+}
+
+func writeTSParams(b *strings.Builder, op *operation) {
+	write := func(name string, params []param) {
+		fmt.Fprintf(b, "export interface %s {\n", name)
+		for _, p := range params {
+			if p.Description != "" {
+				writeTSComment(b, "  ", p.Description)
+			}
+			optional := "?"
+			if p.Required {
+				optional = ""
+			}
+			typ := tsType(p.Type)
+			if p.File {
+				typ = "Blob"
+			}
+			fmt.Fprintf(b, "  %s%s: %s;\n", camel(p.Name), optional, typ)
+		}
+		b.WriteString("}\n\n")
+	}
+	if len(op.Params) > 0 {
+		write(op.Name+"Params", op.Params)
+	}
+	if len(op.Form) > 0 {
+		write(op.Name+"Form", op.Form)
+	}
+}
+
+// tsRequired reports whether any of the parameters must be given, in which
+// case the argument holding them is not optional
+func tsRequired(params []param) bool {
+	for _, p := range params {
+		if p.Required {
+			return true
+		}
+	}
+	return false
+}
+
+func writeTSOperation(b *strings.Builder, op *operation) {
+	doc := op.Method + " " + op.Path
+	if op.Summary != "" {
+		doc = sentence(op.Summary) + "\n\n" + doc
+	}
+	if op.Description != "" && op.Description != op.Summary {
+		doc += "\n\n" + sentence(op.Description)
+	}
+	writeTSComment(b, "  ", doc)
+
+	var args []string
+	for _, p := range op.PathParams {
+		args = append(args, camel(p.Name)+": "+tsType(p.Type))
+	}
+	if op.Body != nil {
+		if op.BodyRequired {
+			args = append(args, "body: "+tsType(op.Body))
+		} else {
+			args = append(args, "body?: "+tsType(op.Body))
+		}
+	}
+	if len(op.Form) > 0 {
+		args = append(args, "form: "+op.Name+"Form")
+	}
+	if len(op.Params) > 0 {
+		if tsRequired(op.Params) {
+			args = append(args, "params: "+op.Name+"Params")
+		} else {
+			args = append(args, "params: "+op.Name+"Params = {}")
+		}
+	}
+
+	var result, as string
+	switch op.Result {
+	case resultJSON:
+		result, as = tsType(op.ResultType), "json"
+	case resultBinary:
+		result, as = "Blob", "blob"
+	case resultStream:
+		result, as = "Response", "response"
+	default:
+		result, as = "void", "none"
+	}
+	fmt.Fprintf(b, "  %s(%s): Promise<%s> {\n", op.ID, strings.Join(args, ", "), result)
+
+	segments := pathSegments(op.Path)
+	var path strings.Builder
+	for i, segment := range segments {
+		if i%2 == 1 {
+			fmt.Fprintf(&path, "${encodeURIComponent(%s)}", camel(segment))
+		} else {
+			path.WriteString(strings.ReplaceAll(segment, "`", "\\`"))
+		}
+	}
+
+	fmt.Fprintf(b, "    return this.request<%s>({\n", result)
+	fmt.Fprintf(b, "      method: %q,\n", op.Method)
+	fmt.Fprintf(b, "      path: `%s`,\n", path.String())
+	var query, headers []string
+	for _, p := range op.Params {
+		entry := fmt.Sprintf("%s: params.%s", tsProperty(p.Name), camel(p.Name))
+		if p.In == "header" {
+			headers = append(headers, entry)
+		} else {
+			query = append(query, entry)
+		}
+	}
+	if len(query) > 0 {
+		fmt.Fprintf(b, "      query: { %s },\n", strings.Join(query, ", "))
+	}
+	if len(headers) > 0 {
+		fmt.Fprintf(b, "      headers: { %s },\n", strings.Join(headers, ", "))
+	}
+	if op.Body != nil {
+		b.WriteString("      body,\n")
+	}
+	if len(op.Form) > 0 {
+		fields := make([]string, 0, len(op.Form))
+		for _, p := range op.Form {
+			fields = append(fields, fmt.Sprintf("%s: form.%s", tsProperty(p.Name), camel(p.Name)))
+		}
+		fmt.Fprintf(b, "      form: { %s },\n", strings.Join(fields, ", "))
+	}
+	fmt.Fprintf(b, "      as: %q,\n", as)
+	b.WriteString("    });\n  }\n")
+}
+
+func tsType(ref *typeRef) string {
+	switch ref.Kind {
+	case kindString:
+		return "string"
+	case kindInt, kindInt64, kindNumber:
+		return "number"
+	case kindBool:
+		return "boolean"
+	case kindArray:
+		elem := tsType(ref.Elem)
+		if strings.Contains(elem, " ") {
+			elem = "(" + elem + ")"
+		}
+		return elem + "[]"
+	case kindMap:
+		return "Record<string, " + tsType(ref.Elem) + ">"
+	case kindNamed:
+		return ref.Name
+	}
+	return "unknown"
+}
+
+func tsProperty(name string) string {
+	if tsIdentifier.MatchString(name) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+func tsLiteral(value any) string {
+	if s, ok := value.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(value)
+}
+
+const tsRuntime = `/** Where the API version this SDK was generated for is served */
+export const BASE_PATH = {{BASE_PATH}};
+
+export interface ClientOptions {
+  /** Server to call, such as https://api.example.com; BASE_PATH is added to it */
+  serverURL: string;
+  /** Access token sent as a Bearer token */
+  token?: string;
+  /** Organizer API key, as the /integrations routes expect */
+  apiKey?: string;
+  /** fetch implementation; defaults to the global one */
+  fetch?: typeof fetch;
+  /** Sent with every request, e.g. credentials: "include" for cookie sessions */
+  init?: RequestInit;
+}
+
+/** Thrown for responses with a non-2xx status */
+export class APIError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly code: string,
+    message: string,
+    public readonly details?: unknown,
+  ) {
+    super(message || ` + "`HTTP ${status}`" + `);
+    this.name = "APIError";
+  }
+}
+
+/** A request as the generated methods describe it */
+export interface RequestOptions {
+  method: string;
+  path: string;
+  query?: Record<string, string | number | boolean | string[] | undefined>;
+  headers?: Record<string, string | undefined>;
+  body?: unknown;
+  form?: Record<string, string | Blob | undefined>;
+  as: "json" | "blob" | "response" | "none";
+}
+
+/** Sends requests for EventixClient */
+export class BaseClient {
+  private readonly baseURL: string;
+  private readonly fetchImpl: typeof fetch;
+  private token?: string;
+  private readonly apiKey?: string;
+  private readonly init?: RequestInit;
+
+  constructor(options: ClientOptions) {
+    this.baseURL = options.serverURL.replace(/\/+$/, "") + BASE_PATH;
+    this.fetchImpl = options.fetch ?? fetch.bind(globalThis);
+    this.token = options.token;
+    this.apiKey = options.apiKey;
+    this.init = options.init;
+  }
+
+  /** Replaces the access token, e.g. after a login or refresh */
+  setToken(token?: string): void {
+    this.token = token;
+  }
+
+  protected async request<T>(options: RequestOptions): Promise<T> {
+    const url = new URL(this.baseURL + options.path);
+    for (const [name, value] of Object.entries(options.query ?? {})) {
+      if (value === undefined) continue;
+      for (const item of Array.isArray(value) ? value : [value]) {
+        url.searchParams.append(name, String(item));
+      }
+    }
+
+    const headers = new Headers(this.init?.headers);
+    for (const [name, value] of Object.entries(options.headers ?? {})) {
+      if (value !== undefined) headers.set(name, value);
+    }
+    if (this.token) headers.set("Authorization", ` + "`Bearer ${this.token}`" + `);
+    if (this.apiKey) headers.set("X-API-Key", this.apiKey);
+
+    let body: BodyInit | undefined;
+    if (options.form) {
+      const form = new FormData();
+      for (const [name, value] of Object.entries(options.form)) {
+        if (value !== undefined) form.append(name, value);
+      }
+      body = form;
+    } else if (options.body !== undefined) {
+      headers.set("Content-Type", "application/json");
+      body = JSON.stringify(options.body);
+    }
+
+    const response = await this.fetchImpl(url, { ...this.init, method: options.method, headers, body });
+    if (!response.ok) {
+      let error: { code?: string; message?: string; details?: unknown } = {};
+      try {
+        error = ((await response.json()) as { error?: typeof error }).error ?? {};
+      } catch {
+        // Not a JSON error envelope
+      }
+      throw new APIError(response.status, error.code ?? "", error.message ?? "", error.details);
+    }
+
+    switch (options.as) {
+      case "json":
+        return (await response.json()) as T;
+      case "blob":
+        return (await response.blob()) as T;
+      case "response":
+        return response as T;
+      default:
+        await response.body?.cancel();
+        return undefined as T;
+    }
+  }
+}
+
+`
+
+const tsConfig = `{
+  "compilerOptions": {
+    "target": "ES2020",
+    "module": "CommonJS",
+    "lib": ["ES2020", "DOM"],
+    "declaration": true,
+    "outDir": "dist",
+    "rootDir": "src",
+    "strict": true,
+    "skipLibCheck": true
+  },
+  "include": ["src"]
+}
+`
+
+const tsReadme = "# Eventix TypeScript SDK\n\n" +
+	"Generated by `cmd/gensdk` from the API's Swagger spec. Do not edit; run `make sdk` instead.\n\n" +
+	"```ts\n" +
+	"import { EventixClient, APIError } from \"{{PACKAGE}}\";\n\n" +
+	"const client = new EventixClient({ serverURL: \"https://api.example.com\" });\n" +
+	"const login = await client.login({ email, password });\n" +
+	"client.setToken(login.data?.access_token);\n" +
+	"```\n\n" +
+	"Errors from the API are thrown as `APIError` with the status, error code and message.\n"
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/rpc"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/events"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/ticketqr"
+
+	"go.uber.org/zap"
+)
+
+// The gRPC server exposes tickets, orders and check-in to internal services
+func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or JSON config file")
+	validateConfig := flag.Bool("validate-config", false, "check the configuration and exit")
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.LoadFile(*configFile)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if *validateConfig {
+		fmt.Println("Configuration is valid")
+		return
+	}
+
+	// Initialize logger
+	if err := logger.Init(cfg.App.LogLevel, cfg.App.LogFormat); err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	defer logger.Sync()
+
+	// Check-ins verify signed QR codes
+	ticketqr.Init(&cfg.Ticket)
+
+	// Connect to database
+	if err := database.Connect(&cfg.Database); err != nil {
+		logger.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Connect to Redis (live availability, occupancy and check-in feeds)
+	if err := cache.Connect(&cfg.Redis); err != nil {
+		logger.Fatal("Failed to connect to Redis", zap.Error(err))
+	}
+	defer cache.Close()
+
+	// Cancelled orders release tickets, which must refresh cached events
+	if err := services.RegisterEventCacheInvalidation(database.DB); err != nil {
+		logger.Fatal("Failed to register event cache invalidation", zap.Error(err))
+	}
+
+	// Configure domain event publishing
+	events.Init(&cfg.Kafka)
+	defer events.Close()
+
+	srv, err := rpc.NewServer(&cfg.GRPC, repositories.New(database.DB))
+	if err != nil {
+		logger.Fatal("Failed to create gRPC server", zap.Error(err))
+	}
+
+	addr := fmt.Sprintf(":%d", cfg.GRPC.Port)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		logger.Fatal("Failed to listen", zap.String("address", addr), zap.Error(err))
+	}
+
+	// Graceful shutdown: finish in-flight calls, then stop outright once the
+	// shutdown timeout passes
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+		<-sigChan
+
+		logger.Info("Shutting down gRPC server...", zap.Duration("timeout", cfg.Server.ShutdownTimeout))
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+
+		done := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			logger.Warn("gRPC calls did not drain in time")
+			srv.Stop()
+		}
+	}()
+
+	logger.Info("gRPC server starting",
+		zap.String("address", addr),
+		zap.String("version", cfg.App.Version),
+		zap.String("environment", cfg.App.Environment),
+	)
+	if err := srv.Serve(lis); err != nil {
+		logger.Fatal("gRPC server failed", zap.Error(err))
+	}
+
+	<-stopped
+	logger.Info("gRPC server stopped")
+}
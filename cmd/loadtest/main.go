@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/jwt"
+)
+
+// result is the outcome of one reservation attempt
+type result struct {
+	status        int // 0 when the request failed before a response
+	latency       time.Duration
+	reservationID string
+	quantity      int
+}
+
+// loadtest simulates an on-sale spike: thousands of buyers reserving from
+// one tier at once through a running API. It seeds the event, tier and
+// buyers directly in the database the API uses, so run it with the API's
+// environment. The API's RATE_LIMIT_REQUESTS must allow the whole burst from
+// one address, or most attempts are rejected before they reach the tier.
+//
+// The run fails when more tickets were reserved than the tier holds, when
+// the tier's remaining inventory disagrees with the reservations made, or
+// when the p99 latency exceeds -max-p99.
+func main() {
+	baseURL := flag.String("url", "http://localhost:8080/api/v1", "API base URL")
+	buyers := flag.Int("buyers", 5000, "buyers, each making one reservation attempt")
+	concurrency := flag.Int("concurrency", 1000, "requests in flight at once")
+	capacity := flag.Int("capacity", 500, "tickets in the tier")
+	quantity := flag.Int("quantity", 1, "tickets per reservation (1-10)")
+	maxP99 := flag.Duration("max-p99", 500*time.Millisecond, "highest acceptable p99 latency")
+	timeout := flag.Duration("timeout", 30*time.Second, "per-request timeout")
+	keep := flag.Bool("keep", false, "keep the seeded data and reservations for inspection")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := database.Connect(&cfg.Database); err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.Close()
+	if err := cache.Connect(&cfg.Redis); err != nil {
+		log.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer cache.Close()
+	if err := jwt.Init(&cfg.JWT); err != nil {
+		log.Fatalf("Failed to initialize JWT: %v", err)
+	}
+
+	log.Printf("Seeding %d buyers and a tier of %d tickets...", *buyers, *capacity)
+	s, err := seed(*capacity, *buyers)
+	if err != nil {
+		log.Fatalf("Failed to seed scenario: %v", err)
+	}
+
+	log.Printf("Reserving %d ticket(s) per buyer, %d requests at a time...", *quantity, *concurrency)
+	client := &http.Client{
+		Timeout:   *timeout,
+		Transport: &http.Transport{MaxIdleConns: *concurrency, MaxIdleConnsPerHost: *concurrency},
+	}
+	started := time.Now()
+	results := run(client, strings.TrimSuffix(*baseURL, "/")+"/tickets/reserve", s, *quantity, *concurrency)
+	elapsed := time.Since(started)
+
+	available, err := s.available()
+	if err != nil {
+		log.Fatalf("Failed to read remaining inventory: %v", err)
+	}
+
+	failures := report(results, elapsed, *capacity, available, *maxP99)
+
+	if *keep {
+		log.Printf("Keeping event %s and tier %s", s.event.ID, s.tier.ID)
+	} else {
+		var reservationIDs []string
+		for _, r := range results {
+			if r.reservationID != "" {
+				reservationIDs = append(reservationIDs, r.reservationID)
+			}
+		}
+		if err := s.cleanup(reservationIDs); err != nil {
+			log.Printf("Cleanup failed: %v", err)
+		}
+	}
+
+	if len(failures) > 0 {
+		for _, failure := range failures {
+			log.Printf("FAIL: %s", failure)
+		}
+		os.Exit(1)
+	}
+	log.Println("✅ No oversell and latency within budget")
+}
+
+// run makes one reservation attempt per buyer. Workers start together once
+// all are ready, so the tier sees the burst an on-sale opening produces.
+func run(client *http.Client, url string, s *scenario, quantity, concurrency int) []result {
+	results := make([]result, len(s.tokens))
+	next := make(chan int)
+	start := make(chan struct{})
+
+	var ready, done sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		ready.Add(1)
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			ready.Done()
+			<-start
+			for i := range next {
+				results[i] = reserve(client, url, s.tokens[i], s.tier.ID.String(), quantity)
+			}
+		}()
+	}
+	ready.Wait()
+	close(start)
+
+	for i := range s.tokens {
+		next <- i
+	}
+	close(next)
+	done.Wait()
+
+	return results
+}
+
+// reserve makes one reservation request
+func reserve(client *http.Client, url, token, tierID string, quantity int) result {
+	body, _ := json.Marshal(map[string]any{"tier_id": tierID, "quantity": quantity})
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return result{}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	started := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return result{latency: time.Since(started)}
+	}
+	defer resp.Body.Close()
+
+	r := result{status: resp.StatusCode}
+	var payload struct {
+		Data struct {
+			ReservationID string `json:"reservation_id"`
+			Quantity      int    `json:"quantity"`
+		} `json:"data"`
+	}
+	data, _ := io.ReadAll(resp.Body)
+	r.latency = time.Since(started)
+	if resp.StatusCode == http.StatusOK && json.Unmarshal(data, &payload) == nil {
+		r.reservationID = payload.Data.ReservationID
+		r.quantity = payload.Data.Quantity
+	}
+	return r
+}
+
+// report prints the run's outcome and returns the assertions it failed
+func report(results []result, elapsed time.Duration, capacity, available int, maxP99 time.Duration) []string {
+	statuses := map[int]int{}
+	latencies := make([]time.Duration, 0, len(results))
+	reserved := 0
+	for _, r := range results {
+		statuses[r.status]++
+		if r.status != 0 {
+			latencies = append(latencies, r.latency)
+		}
+		reserved += r.quantity
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	log.Printf("%d attempts in %s (%.0f req/s)", len(results), elapsed.Round(time.Millisecond), float64(len(results))/elapsed.Seconds())
+	codes := make([]int, 0, len(statuses))
+	for code := range statuses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		label := http.StatusText(code)
+		if code == 0 {
+			label = "no response"
+		}
+		log.Printf("  %d %s: %d", code, label, statuses[code])
+	}
+	log.Printf("Latency p50 %s, p95 %s, p99 %s, max %s",
+		percentile(latencies, 0.50), percentile(latencies, 0.95), percentile(latencies, 0.99), percentile(latencies, 1))
+	log.Printf("Reserved %d of %d tickets; %d left in the tier", reserved, capacity, available)
+
+	var failures []string
+	if oversold := reserved - capacity; oversold > 0 {
+		failures = append(failures, fmt.Sprintf("oversold %d tickets", oversold))
+	}
+	if available < 0 {
+		failures = append(failures, fmt.Sprintf("tier inventory went negative (%d)", available))
+	}
+	if held := capacity - available; held != reserved {
+		failure := fmt.Sprintf("tier holds %d tickets but %d were reserved", held, reserved)
+		if statuses[0] > 0 {
+			failure += "; requests without a response may have reserved"
+		}
+		failures = append(failures, failure)
+	}
+	if p99 := percentile(latencies, 0.99); p99 > maxP99 {
+		failures = append(failures, fmt.Sprintf("p99 latency %s exceeds %s", p99, maxP99))
+	}
+	if statuses[http.StatusTooManyRequests] > 0 {
+		log.Printf("⚠️  %d attempts were rate limited; raise RATE_LIMIT_REQUESTS for a meaningful run", statuses[http.StatusTooManyRequests])
+	}
+	return failures
+}
+
+// percentile returns the latency at or below which fraction p of sorted fall
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i].Round(time.Millisecond)
+}
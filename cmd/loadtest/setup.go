@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// scenario is the event, tier and buyers one run seeds
+type scenario struct {
+	runID     string
+	organizer models.Organizer
+	event     models.Event
+	tier      models.TicketTier
+	buyers    []models.User
+	tokens    []string
+}
+
+// seed creates a published event whose single tier has capacity tickets,
+// and buyers users with an access token each. The users cannot log in;
+// their password hash is not a bcrypt hash.
+func seed(capacity, buyers int) (*scenario, error) {
+	s := &scenario{runID: uuid.NewString()[:8]}
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		owner := models.User{
+			Email:        fmt.Sprintf("loadtest-%s-organizer@example.com", s.runID),
+			PasswordHash: "loadtest",
+			FirstName:    "Load",
+			LastName:     "Test",
+			Role:         models.RoleOrganizer,
+			IsActive:     true,
+		}
+		if err := tx.Create(&owner).Error; err != nil {
+			return fmt.Errorf("failed to create organizer user: %w", err)
+		}
+
+		s.organizer = models.Organizer{
+			UserID:             owner.ID,
+			OrganizationName:   "Load Test " + s.runID,
+			VerificationStatus: models.VerificationApproved,
+		}
+		if err := tx.Create(&s.organizer).Error; err != nil {
+			return fmt.Errorf("failed to create organizer: %w", err)
+		}
+
+		start := time.Now().Add(7 * 24 * time.Hour).Truncate(time.Hour)
+		s.event = models.Event{
+			OrganizerID: s.organizer.ID,
+			Title:       "Load Test " + s.runID,
+			Slug:        "load-test-" + s.runID,
+			Category:    "other",
+			Location:    "Load Test",
+			Currency:    "USD",
+			StartTime:   start,
+			EndTime:     start.Add(3 * time.Hour),
+			Status:      models.EventPublished,
+		}
+		if err := tx.Create(&s.event).Error; err != nil {
+			return fmt.Errorf("failed to create event: %w", err)
+		}
+
+		s.tier = models.TicketTier{
+			EventID:           s.event.ID,
+			TierName:          "On-sale",
+			Price:             5000,
+			Currency:          "USD",
+			TotalQuantity:     capacity,
+			AvailableQuantity: capacity,
+		}
+		if err := tx.Create(&s.tier).Error; err != nil {
+			return fmt.Errorf("failed to create tier: %w", err)
+		}
+
+		s.buyers = make([]models.User, buyers)
+		for i := range s.buyers {
+			s.buyers[i] = models.User{
+				Email:        fmt.Sprintf("loadtest-%s-%d@example.com", s.runID, i),
+				PasswordHash: "loadtest",
+				FirstName:    "Buyer",
+				LastName:     fmt.Sprint(i),
+				Role:         models.RoleAttendee,
+				IsActive:     true,
+			}
+		}
+		if err := tx.CreateInBatches(&s.buyers, 500).Error; err != nil {
+			return fmt.Errorf("failed to create buyers: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.tokens = make([]string, len(s.buyers))
+	for i, buyer := range s.buyers {
+		token, _, err := jwt.GenerateToken(buyer.ID.String(), buyer.Email, string(buyer.Role), time.Hour)
+		if err != nil {
+			s.cleanup(nil)
+			return nil, fmt.Errorf("failed to sign token: %w", err)
+		}
+		s.tokens[i] = token
+	}
+
+	return s, nil
+}
+
+// available reads the tier's remaining inventory
+func (s *scenario) available() (int, error) {
+	var tier models.TicketTier
+	if err := database.DB.Select("available_quantity").First(&tier, s.tier.ID).Error; err != nil {
+		return 0, fmt.Errorf("failed to read tier: %w", err)
+	}
+	return tier.AvailableQuantity, nil
+}
+
+// cleanup releases the reservations made during the run and deletes
+// everything seed created
+func (s *scenario) cleanup(reservationIDs []string) error {
+	ticketService := services.NewTicketService()
+	for _, id := range reservationIDs {
+		ticketService.DeleteReservation(id)
+	}
+	cache.Delete(context.Background(), utils.EventReservationsMetricKey(s.event.ID))
+
+	return database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Delete(&models.TicketTier{}, "event_id = ?", s.event.ID).Error; err != nil {
+			return fmt.Errorf("failed to delete tier: %w", err)
+		}
+		if err := tx.Unscoped().Delete(&models.Event{}, "id = ?", s.event.ID).Error; err != nil {
+			return fmt.Errorf("failed to delete event: %w", err)
+		}
+		if err := tx.Delete(&models.Organizer{}, "id = ?", s.organizer.ID).Error; err != nil {
+			return fmt.Errorf("failed to delete organizer: %w", err)
+		}
+		if err := tx.Unscoped().Delete(&models.User{}, "email LIKE ?", "loadtest-"+s.runID+"-%").Error; err != nil {
+			return fmt.Errorf("failed to delete users: %w", err)
+		}
+		return nil
+	})
+}
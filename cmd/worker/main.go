@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"eventix-api/internal/workers"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/lifecycle"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/queue"
+
+	"go.uber.org/zap"
+)
+
+// The worker consumes asynchronous jobs (notifications and refunds) from RabbitMQ
+func main() {
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or JSON config file")
+	validateConfig := flag.Bool("validate-config", false, "check the configuration and exit")
+	flag.Parse()
+
+	// Load configuration
+	cfg, err := config.LoadFile(*configFile)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if *validateConfig {
+		fmt.Println("Configuration is valid")
+		return
+	}
+
+	// Initialize logger
+	if err := logger.Init(cfg.App.LogLevel, cfg.App.LogFormat); err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	defer logger.Sync()
+
+	if !cfg.RabbitMQ.Enabled {
+		logger.Fatal("RabbitMQ must be enabled to run the worker")
+	}
+
+	// Connect to database (notification delivery status and refunds are recorded there)
+	if err := database.Connect(&cfg.Database); err != nil {
+		logger.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer database.Close()
+
+	// Connect to RabbitMQ
+	if err := queue.Connect(&cfg.RabbitMQ); err != nil {
+		logger.Fatal("Failed to connect to RabbitMQ", zap.Error(err))
+	}
+	defer queue.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger.Info("Starting Eventix worker",
+		zap.String("version", cfg.App.Version),
+		zap.String("environment", cfg.App.Environment),
+	)
+
+	lc := lifecycle.New()
+	lc.Go("payment consumer", func(ctx context.Context) {
+		if err := workers.NewPaymentConsumer(cfg).Start(ctx); err != nil {
+			logger.Fatal("Payment consumer stopped", zap.Error(err))
+		}
+	})
+	lc.Go("notification consumer", func(ctx context.Context) {
+		if err := workers.NewNotificationConsumer(&cfg.Email, &cfg.SMS).Start(ctx); err != nil {
+			logger.Fatal("Notification consumer stopped", zap.Error(err))
+		}
+	})
+
+	<-ctx.Done()
+	logger.Info("Stopping worker...", zap.Duration("timeout", cfg.Server.ShutdownTimeout))
+
+	// Let jobs already taken off the queue finish before the connections close
+	drainCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := lc.Shutdown(drainCtx); err != nil {
+		logger.Warn("Consumers did not drain in time", zap.Error(err))
+	}
+
+	logger.Info("Worker stopped")
+}
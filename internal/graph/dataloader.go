@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// loaderWait is how long a loader collects keys before fetching them. gqlgen
+// resolves the fields of a list's items concurrently, so lookups made for
+// one level of a query arrive within it.
+const loaderWait = 2 * time.Millisecond
+
+// loader batches lookups of the same kind into a single fetch and caches
+// the results for the rest of the request
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending *loaderBatch[K, V]
+	batches map[K]*loaderBatch[K, V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+	err    error
+	done   chan struct{}
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{fetch: fetch, batches: map[K]*loaderBatch[K, V]{}}
+}
+
+// Load returns the value for key, or the zero value when the fetch found
+// none. It waits for the batch the key joined.
+func (l *loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	batch, ok := l.batches[key]
+	if !ok {
+		if l.pending == nil {
+			l.pending = &loaderBatch[K, V]{done: make(chan struct{})}
+			go l.dispatch(ctx, l.pending)
+		}
+		batch = l.pending
+		batch.keys = append(batch.keys, key)
+		l.batches[key] = batch
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-batch.done:
+		return batch.values[key], batch.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+func (l *loader[K, V]) dispatch(ctx context.Context, batch *loaderBatch[K, V]) {
+	time.Sleep(loaderWait)
+
+	l.mu.Lock()
+	l.pending = nil
+	keys := batch.keys
+	l.mu.Unlock()
+
+	batch.values, batch.err = l.fetch(ctx, keys)
+	close(batch.done)
+}
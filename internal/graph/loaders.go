@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+type contextKey string
+
+const (
+	loadersKey contextKey = "graph_loaders"
+	viewerKey  contextKey = "graph_viewer"
+)
+
+// Viewer is the authenticated caller of a GraphQL request
+type Viewer struct {
+	UserID uuid.UUID
+	Role   string
+}
+
+// WithViewer attaches the authenticated caller to a request context
+func WithViewer(ctx context.Context, viewer Viewer) context.Context {
+	return context.WithValue(ctx, viewerKey, viewer)
+}
+
+// viewerFrom returns the authenticated caller, or false for anonymous requests
+func viewerFrom(ctx context.Context) (Viewer, bool) {
+	viewer, ok := ctx.Value(viewerKey).(Viewer)
+	return viewer, ok
+}
+
+// Loaders batch the relationship lookups of one GraphQL request
+type Loaders struct {
+	events         *loader[uuid.UUID, *models.Event]
+	organizers     *loader[uuid.UUID, *models.Organizer]
+	tiers          *loader[uuid.UUID, *models.TicketTier]
+	tiersByEvent   *loader[uuid.UUID, []*models.TicketTier]
+	orders         *loader[uuid.UUID, *models.Order]
+	ticketsByOrder *loader[uuid.UUID, []*models.Ticket]
+}
+
+// NewLoaders creates the loaders of one request, reading from db's replicas
+func NewLoaders(db *gorm.DB) *Loaders {
+	reader := database.Reader(db)
+
+	return &Loaders{
+		events: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.Event, error) {
+			var events []*models.Event
+			if err := reader.WithContext(ctx).Where("id IN ?", ids).Find(&events).Error; err != nil {
+				return nil, err
+			}
+			return byID(events, func(e *models.Event) uuid.UUID { return e.ID }), nil
+		}),
+		organizers: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.Organizer, error) {
+			var organizers []*models.Organizer
+			if err := reader.WithContext(ctx).Where("id IN ?", ids).Find(&organizers).Error; err != nil {
+				return nil, err
+			}
+			return byID(organizers, func(o *models.Organizer) uuid.UUID { return o.ID }), nil
+		}),
+		tiers: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.TicketTier, error) {
+			var tiers []*models.TicketTier
+			if err := withPricePhases(reader.WithContext(ctx)).Where("id IN ?", ids).Find(&tiers).Error; err != nil {
+				return nil, err
+			}
+			return byID(tiers, func(t *models.TicketTier) uuid.UUID { return t.ID }), nil
+		}),
+		tiersByEvent: newLoader(func(ctx context.Context, eventIDs []uuid.UUID) (map[uuid.UUID][]*models.TicketTier, error) {
+			var tiers []*models.TicketTier
+			if err := withPricePhases(reader.WithContext(ctx)).
+				Where("event_id IN ?", eventIDs).
+				Order("created_at ASC").
+				Find(&tiers).Error; err != nil {
+				return nil, err
+			}
+			return groupBy(tiers, func(t *models.TicketTier) uuid.UUID { return t.EventID }), nil
+		}),
+		orders: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*models.Order, error) {
+			var orders []*models.Order
+			if err := reader.WithContext(ctx).Where("id IN ?", ids).Find(&orders).Error; err != nil {
+				return nil, err
+			}
+			return byID(orders, func(o *models.Order) uuid.UUID { return o.ID }), nil
+		}),
+		ticketsByOrder: newLoader(func(ctx context.Context, orderIDs []uuid.UUID) (map[uuid.UUID][]*models.Ticket, error) {
+			var tickets []*models.Ticket
+			if err := reader.WithContext(ctx).
+				Where("order_id IN ?", orderIDs).
+				Order("created_at ASC").
+				Find(&tickets).Error; err != nil {
+				return nil, err
+			}
+			return groupBy(tickets, func(t *models.Ticket) uuid.UUID { return t.OrderID }), nil
+		}),
+	}
+}
+
+// WithLoaders attaches a request's loaders to its context
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey, loaders)
+}
+
+func loadersFrom(ctx context.Context) *Loaders {
+	return ctx.Value(loadersKey).(*Loaders)
+}
+
+// withPricePhases loads a tier's price phases in order, for its current price
+func withPricePhases(db *gorm.DB) *gorm.DB {
+	return db.Preload("PricePhases", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	})
+}
+
+func byID[V any](values []*V, id func(*V) uuid.UUID) map[uuid.UUID]*V {
+	result := make(map[uuid.UUID]*V, len(values))
+	for _, value := range values {
+		result[id(value)] = value
+	}
+	return result
+}
+
+func groupBy[V any](values []*V, key func(*V) uuid.UUID) map[uuid.UUID][]*V {
+	result := map[uuid.UUID][]*V{}
+	for _, value := range values {
+		result[key(value)] = append(result[key(value)], value)
+	}
+	return result
+}
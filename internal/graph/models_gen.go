@@ -0,0 +1,26 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"eventix-api/internal/models"
+)
+
+type EventConnection struct {
+	Nodes []*models.Event `json:"nodes"`
+	// Pass as after for the next page; null on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+type OrderConnection struct {
+	Nodes      []*models.Order `json:"nodes"`
+	NextCursor *string         `json:"nextCursor,omitempty"`
+}
+
+type Query struct {
+}
+
+type TicketConnection struct {
+	Nodes      []*models.Ticket `json:"nodes"`
+	NextCursor *string          `json:"nextCursor,omitempty"`
+}
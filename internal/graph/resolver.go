@@ -0,0 +1,57 @@
+// Package graph serves the GraphQL API. Schema types are bound to the GORM
+// models, and relationships are fetched through per-request loaders that
+// batch the lookups made while resolving one level of a query.
+package graph
+
+//go:generate go tool gqlgen generate --config gqlgen.yml
+
+import (
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"eventix-api/pkg/utils"
+)
+
+// Resolver is the root resolver. It holds the dependencies shared by every
+// request; per-request state lives in the context.
+type Resolver struct {
+	db *gorm.DB
+}
+
+// NewResolver creates a resolver reading from db
+func NewResolver(db *gorm.DB) *Resolver {
+	return &Resolver{db: db}
+}
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+var errUnauthenticated = utils.NewAppError(http.StatusUnauthorized, utils.CodeUnauthorized, "authentication required")
+
+// pageSize clamps a connection's first argument
+func pageSize(first *int) int {
+	if first == nil || *first < 1 {
+		return defaultPageSize
+	}
+	if *first > maxPageSize {
+		return maxPageSize
+	}
+	return *first
+}
+
+// newestFirst pages query by (created_at, id) descending, starting after the
+// after cursor. It fetches one extra row to tell whether a next page exists.
+func newestFirst(query *gorm.DB, first *int, after *string) (*gorm.DB, int, error) {
+	limit := pageSize(first)
+	if after != nil && *after != "" {
+		cursor, err := utils.CursorDecode(*after)
+		if err != nil {
+			return nil, 0, utils.BadRequestError("invalid cursor")
+		}
+		query = query.Where("(created_at, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+	return query.Order("created_at DESC, id DESC").Limit(limit + 1), limit, nil
+}
@@ -0,0 +1,350 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.86
+
+import (
+	"context"
+	"errors"
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Status is the resolver for the status field.
+func (r *eventResolver) Status(ctx context.Context, obj *models.Event) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Organizer is the resolver for the organizer field.
+func (r *eventResolver) Organizer(ctx context.Context, obj *models.Event) (*models.Organizer, error) {
+	organizer, err := loadersFrom(ctx).organizers.Load(ctx, obj.OrganizerID)
+	if err != nil {
+		return nil, err
+	}
+	if organizer == nil {
+		return nil, fmt.Errorf("organizer of event %s not found", obj.ID)
+	}
+	return organizer, nil
+}
+
+// Tiers is the resolver for the tiers field.
+func (r *eventResolver) Tiers(ctx context.Context, obj *models.Event) ([]*models.TicketTier, error) {
+	tiers, err := loadersFrom(ctx).tiersByEvent.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if tiers == nil {
+		tiers = []*models.TicketTier{}
+	}
+	return tiers, nil
+}
+
+// Status is the resolver for the status field.
+func (r *orderResolver) Status(ctx context.Context, obj *models.Order) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Tier is the resolver for the tier field.
+func (r *orderResolver) Tier(ctx context.Context, obj *models.Order) (*models.TicketTier, error) {
+	if obj.TierID == uuid.Nil {
+		return nil, nil
+	}
+	return loadersFrom(ctx).tiers.Load(ctx, obj.TierID)
+}
+
+// Tickets is the resolver for the tickets field.
+func (r *orderResolver) Tickets(ctx context.Context, obj *models.Order) ([]*models.Ticket, error) {
+	tickets, err := loadersFrom(ctx).ticketsByOrder.Load(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tickets transferred to someone else are theirs to show
+	viewer, _ := viewerFrom(ctx)
+	owned := []*models.Ticket{}
+	for _, ticket := range tickets {
+		if ticket.OwnerID == viewer.UserID {
+			owned = append(owned, ticket)
+		}
+	}
+	return owned, nil
+}
+
+// VerificationStatus is the resolver for the verificationStatus field.
+func (r *organizerResolver) VerificationStatus(ctx context.Context, obj *models.Organizer) (string, error) {
+	return string(obj.VerificationStatus), nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*models.User, error) {
+	viewer, ok := viewerFrom(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	var user models.User
+	err := r.db.WithContext(ctx).First(&user, viewer.UserID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Event is the resolver for the event field.
+func (r *queryResolver) Event(ctx context.Context, id *uuid.UUID, slug *string) (*models.Event, error) {
+	query := database.Reader(r.db).WithContext(ctx)
+	switch {
+	case id != nil:
+		query = query.Where("id = ?", *id)
+	case slug != nil:
+		query = query.Where("slug = ?", *slug)
+	default:
+		return nil, utils.BadRequestError("id or slug is required")
+	}
+
+	var event models.Event
+	err := query.First(&event).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// Events is the resolver for the events field.
+func (r *queryResolver) Events(ctx context.Context, status *string, category *string, first *int, after *string) (*EventConnection, error) {
+	limit := pageSize(first)
+	query := database.Reader(r.db).WithContext(ctx)
+	if status != nil && *status != "" {
+		query = query.Where("status = ?", *status)
+	}
+	if category != nil && *category != "" {
+		query = query.Where("category = ?", *category)
+	}
+	if after != nil && *after != "" {
+		cursor, err := utils.CursorDecode(*after)
+		if err != nil {
+			return nil, utils.BadRequestError("invalid cursor")
+		}
+		query = query.Where("(start_time, id) > (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	// One extra row tells whether there is a next page
+	var events []*models.Event
+	if err := query.Order("start_time ASC, id ASC").Limit(limit + 1).Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	connection := &EventConnection{Nodes: events}
+	if len(events) > limit {
+		connection.Nodes = events[:limit]
+		last := connection.Nodes[limit-1]
+		next := utils.CursorEncode(last.StartTime, last.ID)
+		connection.NextCursor = &next
+	}
+	return connection, nil
+}
+
+// Ticket is the resolver for the ticket field.
+func (r *queryResolver) Ticket(ctx context.Context, id uuid.UUID) (*models.Ticket, error) {
+	viewer, ok := viewerFrom(ctx)
+	if !ok {
+		return nil, errUnauthenticated
+	}
+
+	var ticket models.Ticket
+	err := r.db.WithContext(ctx).Where("id = ? AND owner_id = ?", id, viewer.UserID).First(&ticket).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// Order is the resolver for the order field.
+func (r *queryResolver) Order(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	viewer, ok := viewerFrom(ctx)
+	if !ok {
+		return nil, errUnauthenticated
+	}
+
+	var order models.Order
+	err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, viewer.UserID).First(&order).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// Status is the resolver for the status field.
+func (r *ticketResolver) Status(ctx context.Context, obj *models.Ticket) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Tier is the resolver for the tier field.
+func (r *ticketResolver) Tier(ctx context.Context, obj *models.Ticket) (*models.TicketTier, error) {
+	tier, err := loadersFrom(ctx).tiers.Load(ctx, obj.TierID)
+	if err != nil {
+		return nil, err
+	}
+	if tier == nil {
+		return nil, fmt.Errorf("tier of ticket %s not found", obj.ID)
+	}
+	return tier, nil
+}
+
+// Event is the resolver for the event field.
+func (r *ticketResolver) Event(ctx context.Context, obj *models.Ticket) (*models.Event, error) {
+	tier, err := r.Ticket().Tier(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return r.TicketTier().Event(ctx, tier)
+}
+
+// Order is the resolver for the order field.
+func (r *ticketResolver) Order(ctx context.Context, obj *models.Ticket) (*models.Order, error) {
+	order, err := loadersFrom(ctx).orders.Load(ctx, obj.OrderID)
+	if err != nil || order == nil {
+		return nil, err
+	}
+
+	// A transferred ticket's order belongs to whoever bought it
+	if viewer, _ := viewerFrom(ctx); order.UserID != viewer.UserID {
+		return nil, nil
+	}
+	return order, nil
+}
+
+// CurrentPrice is the resolver for the currentPrice field.
+func (r *ticketTierResolver) CurrentPrice(ctx context.Context, obj *models.TicketTier) (int, error) {
+	price, _ := obj.CurrentPrice(time.Now())
+	return int(price), nil
+}
+
+// Event is the resolver for the event field.
+func (r *ticketTierResolver) Event(ctx context.Context, obj *models.TicketTier) (*models.Event, error) {
+	event, err := loadersFrom(ctx).events.Load(ctx, obj.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event of tier %s not found", obj.ID)
+	}
+	return event, nil
+}
+
+// Role is the resolver for the role field.
+func (r *userResolver) Role(ctx context.Context, obj *models.User) (string, error) {
+	return string(obj.Role), nil
+}
+
+// Organizer is the resolver for the organizer field.
+func (r *userResolver) Organizer(ctx context.Context, obj *models.User) (*models.Organizer, error) {
+	if obj.Role != models.RoleOrganizer {
+		return nil, nil
+	}
+
+	var organizer models.Organizer
+	err := database.Reader(r.db).WithContext(ctx).Where("user_id = ?", obj.ID).First(&organizer).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &organizer, nil
+}
+
+// Tickets is the resolver for the tickets field.
+func (r *userResolver) Tickets(ctx context.Context, obj *models.User, first *int, after *string) (*TicketConnection, error) {
+	query := r.db.WithContext(ctx).Where("owner_id = ?", obj.ID)
+	query, limit, err := newestFirst(query, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	var tickets []*models.Ticket
+	if err := query.Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	connection := &TicketConnection{Nodes: tickets}
+	if len(tickets) > limit {
+		connection.Nodes = tickets[:limit]
+		last := connection.Nodes[limit-1]
+		next := utils.CursorEncode(last.CreatedAt, last.ID)
+		connection.NextCursor = &next
+	}
+	return connection, nil
+}
+
+// Orders is the resolver for the orders field.
+func (r *userResolver) Orders(ctx context.Context, obj *models.User, first *int, after *string) (*OrderConnection, error) {
+	query := r.db.WithContext(ctx).Where("user_id = ?", obj.ID)
+	query, limit, err := newestFirst(query, first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []*models.Order
+	if err := query.Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	connection := &OrderConnection{Nodes: orders}
+	if len(orders) > limit {
+		connection.Nodes = orders[:limit]
+		last := connection.Nodes[limit-1]
+		next := utils.CursorEncode(last.CreatedAt, last.ID)
+		connection.NextCursor = &next
+	}
+	return connection, nil
+}
+
+// Event returns EventResolver implementation.
+func (r *Resolver) Event() EventResolver { return &eventResolver{r} }
+
+// Order returns OrderResolver implementation.
+func (r *Resolver) Order() OrderResolver { return &orderResolver{r} }
+
+// Organizer returns OrganizerResolver implementation.
+func (r *Resolver) Organizer() OrganizerResolver { return &organizerResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Ticket returns TicketResolver implementation.
+func (r *Resolver) Ticket() TicketResolver { return &ticketResolver{r} }
+
+// TicketTier returns TicketTierResolver implementation.
+func (r *Resolver) TicketTier() TicketTierResolver { return &ticketTierResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type eventResolver struct{ *Resolver }
+type orderResolver struct{ *Resolver }
+type organizerResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type ticketResolver struct{ *Resolver }
+type ticketTierResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// maxComplexity bounds how many fields one query may resolve, so deeply
+// nested connections cannot fan out into thousands of lookups
+const maxComplexity = 200
+
+// NewHandler serves GraphQL queries over GET and POST. Callers attach the
+// per-request Viewer and Loaders to the request context.
+func NewHandler(db *gorm.DB) http.Handler {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: NewResolver(db)}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
+	srv.SetErrorPresenter(presentError)
+	srv.SetRecoverFunc(func(ctx context.Context, p interface{}) error {
+		logger.Error("GraphQL resolver panicked", zap.Any("panic", p))
+		return fmt.Errorf("panic: %v", p)
+	})
+	return srv
+}
+
+// presentError shows AppErrors with their code and hides every other
+// resolver failure behind a generic message, as the REST error handler does
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+
+	var appErr *utils.AppError
+	if errors.As(err, &appErr) {
+		presented.Message = appErr.Message
+		presented.Extensions = map[string]interface{}{"code": appErr.Code}
+		return presented
+	}
+
+	// Parse and validation errors are about the query itself
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) && gqlErr.Err == nil {
+		return presented
+	}
+
+	logger.Error("GraphQL resolver failed", zap.String("path", presented.Path.String()), zap.Error(err))
+	presented.Message = "An internal server error occurred"
+	presented.Extensions = map[string]interface{}{"code": utils.CodeInternal}
+	return presented
+}
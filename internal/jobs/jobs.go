@@ -0,0 +1,121 @@
+// Package jobs is a table-backed background job queue. Jobs are rows in the
+// jobs table, so they are enqueued in the transaction of the change that
+// needs them and survive restarts. A Runner claims due jobs with SKIP LOCKED,
+// retries failures with exponential backoff and moves jobs that fail
+// MaxAttempts times to the dead letter state, from which an admin can retry
+// them. Schedules enqueue jobs on cron expressions.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// DefaultMaxAttempts is how often a job is attempted before it is dead
+// lettered, unless enqueued with MaxAttempts
+const DefaultMaxAttempts = 5
+
+// enqueueBatchSize bounds how many jobs EnqueueMany inserts per statement
+const enqueueBatchSize = 500
+
+// Type is a kind of job whose payload is a T. Declare each type once, with
+// NewType, and give it a handler with Handle.
+type Type[T any] struct {
+	name string
+}
+
+// NewType declares a job type. Names are stored with each job, so a type
+// must keep its name once jobs of it have been enqueued.
+func NewType[T any](name string) Type[T] {
+	return Type[T]{name: name}
+}
+
+// Name returns the name jobs of the type are stored under
+func (t Type[T]) Name() string {
+	return t.name
+}
+
+// Option adjusts a job as it is enqueued
+type Option func(*models.Job)
+
+// At runs the job no earlier than at
+func At(at time.Time) Option {
+	return func(job *models.Job) { job.RunAt = at }
+}
+
+// In runs the job no earlier than delay from now
+func In(delay time.Duration) Option {
+	return func(job *models.Job) { job.RunAt = time.Now().Add(delay) }
+}
+
+// MaxAttempts dead letters the job after n failed attempts
+func MaxAttempts(n int) Option {
+	return func(job *models.Job) { job.MaxAttempts = n }
+}
+
+// Enqueue adds a job with payload to the queue
+func (t Type[T]) Enqueue(ctx context.Context, payload T, opts ...Option) (*models.Job, error) {
+	return t.EnqueueTx(database.DB.WithContext(ctx), payload, opts...)
+}
+
+// EnqueueTx adds a job with payload to the queue within tx, so it only runs
+// if tx commits
+func (t Type[T]) EnqueueTx(tx *gorm.DB, payload T, opts ...Option) (*models.Job, error) {
+	job, err := t.job(tx, payload, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Create(job).Error; err != nil {
+		return nil, fmt.Errorf("failed to enqueue %s job: %w", t.name, err)
+	}
+	return job, nil
+}
+
+// EnqueueMany adds a job for each payload to the queue, in batches, so a
+// fan-out to many recipients retries each of them on its own
+func (t Type[T]) EnqueueMany(ctx context.Context, payloads []T, opts ...Option) error {
+	tx := database.DB.WithContext(ctx)
+	batch := make([]*models.Job, 0, len(payloads))
+	for _, payload := range payloads {
+		job, err := t.job(tx, payload, opts)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, job)
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	if err := tx.CreateInBatches(batch, enqueueBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to enqueue %s jobs: %w", t.name, err)
+	}
+	return nil
+}
+
+func (t Type[T]) job(tx *gorm.DB, payload T, opts []Option) (*models.Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s job: %w", t.name, err)
+	}
+
+	job := &models.Job{
+		Type:        t.name,
+		Payload:     string(data),
+		Status:      models.JobPending,
+		MaxAttempts: DefaultMaxAttempts,
+		RunAt:       time.Now(),
+		RequestID:   logger.RequestIDFromContext(tx.Statement.Context),
+	}
+	for _, opt := range opts {
+		opt(job)
+	}
+	return job, nil
+}
@@ -0,0 +1,274 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+const (
+	// DefaultTimeout bounds a job's run unless its type is handled with
+	// Timeout. A runner that has not finished a job by then is presumed
+	// gone, and the job is claimed again.
+	DefaultTimeout = 5 * time.Minute
+
+	// retryBase is the wait before a failed job's first retry; each further
+	// retry waits twice as long as the one before, up to retryMax
+	retryBase = 10 * time.Second
+	retryMax  = time.Hour
+)
+
+// handler runs jobs of one type
+type handler struct {
+	timeout time.Duration
+	run     func(ctx context.Context, payload []byte) error
+}
+
+// HandleOption adjusts how a runner executes jobs of a type
+type HandleOption func(*handler)
+
+// Timeout bounds each run of the type's jobs
+func Timeout(d time.Duration) HandleOption {
+	return func(h *handler) { h.timeout = d }
+}
+
+// Runner executes due jobs of the types it handles and enqueues the jobs of
+// its schedules. Runners on several replicas share the queue; each job runs
+// on one of them at a time.
+type Runner struct {
+	interval    time.Duration
+	concurrency int
+	handlers    map[string]*handler
+	schedules   []*schedule
+}
+
+// NewRunner creates a runner that polls for due jobs every interval and runs
+// up to concurrency of them at once
+func NewRunner(interval time.Duration, concurrency int) *Runner {
+	return &Runner{
+		interval:    interval,
+		concurrency: max(concurrency, 1),
+		handlers:    make(map[string]*handler),
+	}
+}
+
+// Handle registers fn to run jobs of type t. A job whose fn returns an error
+// or panics is retried until it has failed its MaxAttempts.
+func Handle[T any](r *Runner, t Type[T], fn func(ctx context.Context, payload T) error, opts ...HandleOption) {
+	h := &handler{
+		timeout: DefaultTimeout,
+		run: func(ctx context.Context, data []byte) error {
+			var payload T
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return fmt.Errorf("failed to decode payload: %w", err)
+			}
+			return fn(ctx, payload)
+		},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	r.handlers[t.name] = h
+}
+
+// Start runs the poll loop until ctx is cancelled, then waits for the jobs
+// it is running to finish
+func (r *Runner) Start(ctx context.Context) {
+	if err := r.syncSchedules(ctx); err != nil {
+		logger.Error("Failed to register job schedules", zap.Error(err))
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	logger.Info("Job runner started",
+		zap.Duration("interval", r.interval),
+		zap.Int("concurrency", r.concurrency),
+		zap.Int("types", len(r.handlers)),
+		zap.Int("schedules", len(r.schedules)),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Job runner stopped")
+			return
+		case <-ticker.C:
+			r.sweep(ctx)
+		}
+	}
+}
+
+// sweep enqueues due scheduled jobs, then runs due jobs in batches until
+// none are left or ctx is cancelled
+func (r *Runner) sweep(ctx context.Context) {
+	work := context.WithoutCancel(ctx)
+	r.enqueueScheduled(work)
+
+	for ctx.Err() == nil {
+		jobs, err := r.claim(work, r.concurrency)
+		if err != nil {
+			logger.Error("Failed to claim jobs", zap.Error(err))
+			return
+		}
+
+		var wg sync.WaitGroup
+		for i := range jobs {
+			wg.Add(1)
+			go func(job *models.Job) {
+				defer wg.Done()
+				r.execute(work, job)
+			}(&jobs[i])
+		}
+		wg.Wait()
+
+		if len(jobs) < r.concurrency {
+			return
+		}
+	}
+}
+
+// claim takes up to limit due jobs of the handled types, oldest first. Jobs
+// whose runner's lease expired are claimed again.
+func (r *Runner) claim(ctx context.Context, limit int) ([]models.Job, error) {
+	if len(r.handlers) == 0 {
+		return nil, nil
+	}
+	types := make([]string, 0, len(r.handlers))
+	for name := range r.handlers {
+		types = append(types, name)
+	}
+
+	now := time.Now()
+	var jobs []models.Job
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("type IN ?", types).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)",
+				models.JobPending, now, models.JobRunning, now).
+			Order("run_at ASC").
+			Limit(limit).
+			Find(&jobs).Error; err != nil {
+			return fmt.Errorf("failed to claim jobs: %w", err)
+		}
+
+		for i := range jobs {
+			job := &jobs[i]
+			lease := now.Add(r.handlers[job.Type].timeout)
+			job.Status, job.LockedUntil = models.JobRunning, &lease
+			job.Attempts++
+			if err := tx.Model(job).
+				Select("status", "locked_until", "attempts").
+				Updates(job).Error; err != nil {
+				return fmt.Errorf("failed to lease job: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// execute runs a claimed job once and records the outcome: succeeded,
+// pending a retry, or dead once it has used its attempts
+func (r *Runner) execute(ctx context.Context, job *models.Job) {
+	h := r.handlers[job.Type]
+
+	ctx = logger.ContextWithRequestID(ctx, job.RequestID)
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	started := time.Now()
+	err := run(ctx, h, job)
+
+	now := time.Now()
+	job.LockedUntil = nil
+	switch {
+	case err == nil:
+		job.Status, job.Error, job.FinishedAt = models.JobSucceeded, "", &now
+	case job.Attempts >= job.MaxAttempts:
+		job.Status, job.Error, job.FinishedAt = models.JobDead, err.Error(), &now
+		logger.WithContext(ctx).Error("Job dead lettered",
+			zap.String("job_id", job.ID.String()),
+			zap.String("type", job.Type),
+			zap.Int("attempts", job.Attempts),
+			zap.Error(err),
+		)
+	default:
+		job.Status, job.Error, job.RunAt = models.JobPending, err.Error(), now.Add(retryDelay(job.Attempts))
+		logger.WithContext(ctx).Warn("Job failed, retrying",
+			zap.String("job_id", job.ID.String()),
+			zap.String("type", job.Type),
+			zap.Int("attempts", job.Attempts),
+			zap.Time("retry_at", job.RunAt),
+			zap.Error(err),
+		)
+	}
+
+	if err := database.DB.WithContext(context.WithoutCancel(ctx)).Model(job).
+		Select("status", "error", "run_at", "locked_until", "finished_at").
+		Updates(job).Error; err != nil {
+		logger.WithContext(ctx).Error("Failed to record job outcome",
+			zap.String("job_id", job.ID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	if job.Status == models.JobSucceeded {
+		logger.WithContext(ctx).Debug("Job succeeded",
+			zap.String("job_id", job.ID.String()),
+			zap.String("type", job.Type),
+			zap.Duration("duration", time.Since(started)),
+		)
+	}
+}
+
+// run calls a job's handler, turning a panic into an error
+func run(ctx context.Context, h *handler, job *models.Job) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+			logger.WithContext(ctx).Error("Job panicked",
+				zap.String("job_id", job.ID.String()),
+				zap.String("type", job.Type),
+				zap.String("stack", string(debug.Stack())),
+			)
+		}
+	}()
+	return h.run(ctx, []byte(job.Payload))
+}
+
+// Prune deletes jobs that succeeded before cutoff and returns how many it removed
+func Prune(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := database.DB.WithContext(ctx).
+		Where("status = ? AND finished_at < ?", models.JobSucceeded, cutoff).
+		Delete(&models.Job{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to prune jobs: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// retryDelay returns how long to wait after a job's attempts-th failure
+func retryDelay(attempts int) time.Duration {
+	delay := retryBase
+	for i := 1; i < attempts && delay < retryMax; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMax)
+}
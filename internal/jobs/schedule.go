@@ -0,0 +1,138 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// schedule enqueues a job of one type each time its cron spec is due
+type schedule struct {
+	name    string
+	spec    string
+	cron    cron.Schedule
+	jobType string
+	payload []byte
+}
+
+// Every returns the spec of a schedule due every interval
+func Every(interval time.Duration) string {
+	return "@every " + interval.String()
+}
+
+// Schedule registers a schedule that enqueues a job of type t with payload
+// whenever spec is due. Spec is a standard five-field cron expression, such
+// as "0 6 * * *" for 06:00 daily, or a descriptor such as "@hourly" or
+// "@every 30s". A run is skipped while the job the schedule last enqueued is
+// still pending or running, so slow jobs do not pile up.
+func Schedule[T any](r *Runner, name, spec string, t Type[T], payload T) error {
+	parsed, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q for %s: %w", spec, name, err)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s payload: %w", name, err)
+	}
+
+	r.schedules = append(r.schedules, &schedule{
+		name:    name,
+		spec:    spec,
+		cron:    parsed,
+		jobType: t.name,
+		payload: data,
+	})
+	return nil
+}
+
+// syncSchedules records the runner's schedules, rescheduling those whose
+// spec changed since they were last recorded
+func (r *Runner) syncSchedules(ctx context.Context) error {
+	now := time.Now()
+	for _, s := range r.schedules {
+		row := models.JobSchedule{Name: s.name, Spec: s.spec, JobType: s.jobType, NextRunAt: s.cron.Next(now)}
+		if err := database.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&row).Error; err != nil {
+			return fmt.Errorf("failed to record schedule %s: %w", s.name, err)
+		}
+		if err := database.DB.WithContext(ctx).Model(&models.JobSchedule{}).
+			Where("name = ? AND (spec <> ? OR job_type <> ?)", s.name, s.spec, s.jobType).
+			Updates(map[string]interface{}{"spec": s.spec, "job_type": s.jobType, "next_run_at": s.cron.Next(now)}).Error; err != nil {
+			return fmt.Errorf("failed to update schedule %s: %w", s.name, err)
+		}
+	}
+	return nil
+}
+
+// enqueueScheduled enqueues the jobs of the runner's due schedules
+func (r *Runner) enqueueScheduled(ctx context.Context) {
+	for _, s := range r.schedules {
+		if err := r.enqueueDue(ctx, s); err != nil {
+			logger.Error("Failed to enqueue scheduled job",
+				zap.String("schedule", s.name),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// enqueueDue enqueues a schedule's job if it is due. The schedule's row is
+// locked with SKIP LOCKED, so only one replica enqueues each run.
+func (r *Runner) enqueueDue(ctx context.Context, s *schedule) error {
+	now := time.Now()
+	return database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		var row models.JobSchedule
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("name = ? AND next_run_at <= ?", s.name, now).
+			First(&row).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch schedule: %w", err)
+		}
+
+		updates := map[string]interface{}{"next_run_at": s.cron.Next(now)}
+
+		busy := false
+		if row.LastJobID != nil {
+			var unfinished int64
+			if err := tx.Model(&models.Job{}).
+				Where("id = ? AND status IN ?", *row.LastJobID, []models.JobStatus{models.JobPending, models.JobRunning}).
+				Count(&unfinished).Error; err != nil {
+				return fmt.Errorf("failed to check previous run: %w", err)
+			}
+			busy = unfinished > 0
+		}
+
+		if !busy {
+			job := models.Job{
+				Type:        s.jobType,
+				Payload:     string(s.payload),
+				Status:      models.JobPending,
+				MaxAttempts: 1, // the next run stands in for a retry
+				RunAt:       now,
+				Schedule:    s.name,
+			}
+			if err := tx.Create(&job).Error; err != nil {
+				return fmt.Errorf("failed to enqueue job: %w", err)
+			}
+			updates["last_run_at"] = now
+			updates["last_job_id"] = job.ID
+		}
+
+		return tx.Model(&row).Updates(updates).Error
+	})
+}
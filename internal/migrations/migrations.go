@@ -0,0 +1,200 @@
+package migrations
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/currency"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultCategories are the event categories a new database starts with
+var defaultCategories = []models.Category{
+	{Name: "Music", Slug: "music"},
+	{Name: "Sports", Slug: "sports"},
+	{Name: "Arts", Slug: "arts"},
+	{Name: "Technology", Slug: "technology"},
+	{Name: "Business", Slug: "business"},
+	{Name: "Education", Slug: "education"},
+	{Name: "Other", Slug: "other"},
+}
+
+// moneyColumns are the amounts stored as integer minor units
+var moneyColumns = []struct {
+	Table  string
+	Column string
+}{
+	{"ticket_tiers", "price"},
+	{"orders", "total_amount"},
+	{"payments", "amount"},
+	{"refunds", "amount"},
+}
+
+// Run brings the schema up to date: it converts legacy money columns, drops
+// replaced indexes, auto-migrates every model, backfills order items and
+// seeds the default categories. Every step is safe to repeat.
+func Run(db *gorm.DB) error {
+	// Convert decimal amounts before AutoMigrate would truncate them to bigint
+	if err := convertMoneyToMinorUnits(db); err != nil {
+		return fmt.Errorf("money conversion failed: %w", err)
+	}
+
+	// Check-ins became unique per ticket and session rather than per ticket
+	if err := db.Exec("DROP INDEX IF EXISTS idx_checkins_ticket_id").Error; err != nil {
+		return fmt.Errorf("failed to drop replaced check-in index: %w", err)
+	}
+	// and then one row per scan, so events allowing re-entry record every entry and exit
+	if err := db.Exec("DROP INDEX IF EXISTS idx_checkins_ticket_event").Error; err != nil {
+		return fmt.Errorf("failed to drop replaced check-in index: %w", err)
+	}
+
+	if err := db.AutoMigrate(Models()...); err != nil {
+		return fmt.Errorf("migration failed: %w", err)
+	}
+
+	if err := backfillOrderItems(db); err != nil {
+		return fmt.Errorf("order item backfill failed: %w", err)
+	}
+
+	if err := seedCategories(db); err != nil {
+		return fmt.Errorf("category seeding failed: %w", err)
+	}
+	return nil
+}
+
+// Models lists every persisted model in the order tables are created
+func Models() []any {
+	return []any{
+		&models.User{},
+		&models.Session{},
+		&models.Organizer{},
+		&models.Category{},
+		&models.Event{},
+		&models.EventStatusChange{},
+		&models.EventReschedule{},
+		&models.Favorite{},
+		&models.EventReport{},
+		&models.EventSession{},
+		&models.EventZone{},
+		&models.FormField{},
+		&models.TicketTier{},
+		&models.PricePhase{},
+		&models.Ticket{},
+		&models.TicketAnswer{},
+		&models.Order{},
+		&models.OrderItem{},
+		&models.AddOn{},
+		&models.Payment{},
+		&models.Refund{},
+		&models.Dispute{},
+		&models.DisputeEvidence{},
+		&models.ReconciliationReport{},
+		&models.ReconciliationIssue{},
+		&models.WarehouseExport{},
+		&models.WarehouseExportFile{},
+		&models.WebhookEndpoint{},
+		&models.WebhookDelivery{},
+		&models.IntegrationKey{},
+		&models.WidgetOrigin{},
+		&models.FeatureFlag{},
+		&models.RolePermissions{},
+		&models.OrganizerMember{},
+		&models.ScannerToken{},
+		&models.OrganizerBalance{},
+		&models.LedgerEntry{},
+		&models.Payout{},
+		&models.Checkin{},
+		&models.ZonePresence{},
+		&models.BadgePrinter{},
+		&models.Notification{},
+		&models.NotificationPreference{},
+		&models.AuditLog{},
+		&models.OutboxMessage{},
+		&models.OrderSaga{},
+		&models.OrderSagaEntry{},
+		&models.Job{},
+		&models.JobSchedule{},
+	}
+}
+
+// convertMoneyToMinorUnits rewrites floating point amount columns as bigint
+// minor units, scaling each row by its currency's exponent. Columns that are
+// already integers are left untouched, so the conversion runs at most once.
+func convertMoneyToMinorUnits(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, mc := range moneyColumns {
+			var dataType string
+			if err := tx.Raw(
+				"SELECT data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ? AND column_name = ?",
+				mc.Table, mc.Column,
+			).Scan(&dataType).Error; err != nil {
+				return fmt.Errorf("failed to inspect %s.%s: %w", mc.Table, mc.Column, err)
+			}
+
+			if dataType != "double precision" && dataType != "real" && dataType != "numeric" {
+				continue
+			}
+
+			logger.Info("Converting money column to minor units", zap.String("table", mc.Table), zap.String("column", mc.Column))
+
+			stmt := fmt.Sprintf(
+				"ALTER TABLE %s ALTER COLUMN %s TYPE bigint USING ROUND(%s * %s)::bigint",
+				mc.Table, mc.Column, mc.Column, minorUnitScale(),
+			)
+			if err := tx.Exec(stmt).Error; err != nil {
+				return fmt.Errorf("failed to convert %s.%s: %w", mc.Table, mc.Column, err)
+			}
+		}
+		return nil
+	})
+}
+
+// backfillOrderItems gives orders placed before line items existed a ticket
+// line for their tier. Orders that already have items are skipped, so it is
+// safe to run repeatedly.
+func backfillOrderItems(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO order_items (id, order_id, type, tier_id, name, quantity, unit_price, amount, created_at)
+		SELECT gen_random_uuid(), o.id, ?, o.tier_id, COALESCE(t.tier_name, 'Ticket'), o.quantity,
+			CASE WHEN o.unit_price > 0 OR o.quantity = 0 THEN o.unit_price ELSE o.total_amount / o.quantity END,
+			o.total_amount, o.created_at
+		FROM orders o
+		LEFT JOIN ticket_tiers t ON t.id = o.tier_id
+		WHERE NOT EXISTS (SELECT 1 FROM order_items i WHERE i.order_id = o.id)`,
+		models.OrderItemTicket,
+	).Error
+}
+
+// seedCategories adds the default categories, plus any category events
+// already use, so existing events stay valid. Categories that exist are left
+// as they are, so admins' changes survive repeated runs.
+func seedCategories(db *gorm.DB) error {
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&defaultCategories).Error; err != nil {
+		return fmt.Errorf("failed to seed categories: %w", err)
+	}
+	return db.Exec(`
+		INSERT INTO categories (id, name, slug, is_active, created_at, updated_at)
+		SELECT gen_random_uuid(), INITCAP(category), category, true, NOW(), NOW()
+		FROM (SELECT DISTINCT category FROM events) used
+		ON CONFLICT (slug) DO NOTHING`,
+	).Error
+}
+
+// minorUnitScale builds a SQL expression giving 10^exponent for a row's currency
+func minorUnitScale() string {
+	var cases strings.Builder
+	cases.WriteString("CASE UPPER(currency)")
+	for _, c := range currency.Supported() {
+		if c.Exponent != 2 {
+			fmt.Fprintf(&cases, " WHEN '%s' THEN %d", c.Code, int64(math.Pow10(c.Exponent)))
+		}
+	}
+	cases.WriteString(" ELSE 100 END")
+	return cases.String()
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AuditAction names an audited admin or organizer mutation
+type AuditAction string
+
+const (
+	AuditEventCreated             AuditAction = "event.created"
+	AuditEventDuplicated          AuditAction = "event.duplicated"
+	AuditEventSubmitted           AuditAction = "event.submitted"
+	AuditEventApproved            AuditAction = "event.approved"
+	AuditEventRejected            AuditAction = "event.rejected"
+	AuditEventBannerUpdated       AuditAction = "event.banner_updated"
+	AuditEventWaitingRoomUpdated  AuditAction = "event.waiting_room_updated"
+	AuditEventReentryUpdated      AuditAction = "event.reentry_updated"
+	AuditEventOccupancyUpdated    AuditAction = "event.occupancy_limit_updated"
+	AuditEventTaxUpdated          AuditAction = "event.tax_updated"
+	AuditEventRefundsUpdated      AuditAction = "event.refunds_updated"
+	AuditEventCancelled           AuditAction = "event.cancelled" // also refunds every paid order
+	AuditEventRescheduled         AuditAction = "event.rescheduled"
+	AuditEventFeaturedUpdated     AuditAction = "event.featured_updated"
+	AuditEventUnpublished         AuditAction = "event.unpublished"
+	AuditEventBanned              AuditAction = "event.banned"
+	AuditEventReportsDismissed    AuditAction = "event.reports_dismissed"
+	AuditEventBadgePrinterUpdated AuditAction = "event.badge_printer_updated"
+	AuditEventBadgePrinterRemoved AuditAction = "event.badge_printer_removed"
+	AuditTierCreated              AuditAction = "tier.created"
+	AuditTierUpdated              AuditAction = "tier.updated"
+	AuditTierDeleted              AuditAction = "tier.deleted"
+	AuditSessionCreated           AuditAction = "session.created"
+	AuditSessionUpdated           AuditAction = "session.updated"
+	AuditSessionDeleted           AuditAction = "session.deleted"
+	AuditZoneCreated              AuditAction = "zone.created"
+	AuditZoneUpdated              AuditAction = "zone.updated"
+	AuditZoneDeleted              AuditAction = "zone.deleted"
+	AuditFormFieldCreated         AuditAction = "form_field.created"
+	AuditFormFieldUpdated         AuditAction = "form_field.updated"
+	AuditFormFieldDeleted         AuditAction = "form_field.deleted"
+	AuditAddOnCreated             AuditAction = "add_on.created"
+	AuditAddOnUpdated             AuditAction = "add_on.updated"
+	AuditAddOnDeleted             AuditAction = "add_on.deleted"
+	AuditCategoryCreated          AuditAction = "category.created"
+	AuditCategoryUpdated          AuditAction = "category.updated"
+	AuditCategoryDeleted          AuditAction = "category.deleted"
+	AuditOrderComped              AuditAction = "order.comped"
+	AuditOrderRefunded            AuditAction = "order.refunded"
+	AuditOrderApproved            AuditAction = "order.approved" // releases an order held for review
+	AuditOrderRejected            AuditAction = "order.rejected" // refunds an order held for review
+	AuditOrderSagaResumed         AuditAction = "order.saga_resumed"
+	AuditOrderSagaCompensated     AuditAction = "order.saga_compensated" // voids the payment and releases the tickets
+	AuditUserUnlocked             AuditAction = "user.unlocked"
+	AuditOrganizerApproved        AuditAction = "organizer.approved" // also promotes the user to organizer
+	AuditOrganizerRejected        AuditAction = "organizer.rejected"
+	AuditOrganizerFeesUpdated     AuditAction = "organizer.fees_updated"
+	AuditOrganizerLogoUpdated     AuditAction = "organizer.logo_updated"
+	AuditOrganizerTaxUpdated      AuditAction = "organizer.tax_updated"
+	AuditPayoutInitiated          AuditAction = "payout.initiated"
+	AuditPayoutCompleted          AuditAction = "payout.completed"
+	AuditPayoutFailed             AuditAction = "payout.failed"
+	AuditDisputeEvidenceAdded     AuditAction = "dispute.evidence_added"
+	AuditDisputeResolved          AuditAction = "dispute.resolved"
+	AuditFeatureFlagUpdated       AuditAction = "feature_flag.updated"
+	AuditRolePermissionsUpdated   AuditAction = "role.permissions_updated"
+	AuditMemberInvited            AuditAction = "team_member.invited"
+	AuditMemberJoined             AuditAction = "team_member.joined"
+	AuditMemberUpdated            AuditAction = "team_member.updated"
+	AuditMemberRemoved            AuditAction = "team_member.removed"
+	AuditScannerTokenIssued       AuditAction = "scanner_token.issued"
+	AuditScannerTokenRevoked      AuditAction = "scanner_token.revoked"
+	AuditCheckinUndone            AuditAction = "checkin.undone"
+	AuditWebhookCreated           AuditAction = "webhook.created"
+	AuditWebhookUpdated           AuditAction = "webhook.updated"
+	AuditWebhookDeleted           AuditAction = "webhook.deleted"
+	AuditAPIKeyCreated            AuditAction = "api_key.created"
+	AuditAPIKeyRevoked            AuditAction = "api_key.revoked"
+	AuditWidgetOriginAdded        AuditAction = "widget_origin.added"
+	AuditWidgetOriginRemoved      AuditAction = "widget_origin.removed"
+	AuditJobRetried               AuditAction = "job.retried"
+	AuditJobDiscarded             AuditAction = "job.discarded"
+)
+
+// AuditTargetType is the kind of record an audited action changed
+type AuditTargetType string
+
+const (
+	AuditTargetEvent        AuditTargetType = "event"
+	AuditTargetTier         AuditTargetType = "tier"
+	AuditTargetSession      AuditTargetType = "session"
+	AuditTargetZone         AuditTargetType = "zone"
+	AuditTargetFormField    AuditTargetType = "form_field"
+	AuditTargetAddOn        AuditTargetType = "add_on"
+	AuditTargetCategory     AuditTargetType = "category"
+	AuditTargetOrder        AuditTargetType = "order"
+	AuditTargetUser         AuditTargetType = "user"
+	AuditTargetOrganizer    AuditTargetType = "organizer"
+	AuditTargetPayout       AuditTargetType = "payout"
+	AuditTargetDispute      AuditTargetType = "dispute"
+	AuditTargetFeatureFlag  AuditTargetType = "feature_flag"
+	AuditTargetRole         AuditTargetType = "role"
+	AuditTargetMember       AuditTargetType = "team_member"
+	AuditTargetScannerToken AuditTargetType = "scanner_token"
+	AuditTargetCheckin      AuditTargetType = "checkin"
+	AuditTargetWebhook      AuditTargetType = "webhook"
+	AuditTargetAPIKey       AuditTargetType = "api_key"
+	AuditTargetWidgetOrigin AuditTargetType = "widget_origin"
+	AuditTargetJob          AuditTargetType = "job"
+)
+
+// AuditLog records who changed what through an admin or organizer action,
+// with JSON snapshots of the target before and after the change
+type AuditLog struct {
+	ID         uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ActorID    uuid.UUID       `gorm:"type:uuid;not null;index" json:"actor_id"`
+	ActorRole  UserRole        `gorm:"type:varchar(20);not null" json:"actor_role"`
+	Action     AuditAction     `gorm:"type:varchar(50);not null;index" json:"action"`
+	TargetType AuditTargetType `gorm:"type:varchar(20);not null;index:idx_audit_logs_target" json:"target_type"`
+	TargetID   uuid.UUID       `gorm:"type:uuid;not null;index:idx_audit_logs_target" json:"target_id"`
+	Before     string          `gorm:"type:jsonb" json:"before"` // null when the target did not exist
+	After      string          `gorm:"type:jsonb" json:"after"`  // null when the target was deleted
+	IPAddress  string          `gorm:"type:varchar(45)" json:"ip_address,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+	CreatedAt  time.Time       `gorm:"index" json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (a *AuditLog) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BadgeDriver names the integration that sends badges to an event's printers
+type BadgeDriver string
+
+const (
+	BadgeDriverWebhook BadgeDriver = "webhook" // POSTs each badge to the organizer's print server
+)
+
+// BadgePrinter is where an event's attendee badges are sent for printing at
+// the check-in desk. With AutoPrint, a badge is sent on every event check-in.
+type BadgePrinter struct {
+	ID        uuid.UUID   `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID   uuid.UUID   `gorm:"type:uuid;not null;uniqueIndex" json:"event_id"`
+	Driver    BadgeDriver `gorm:"type:varchar(20);not null" json:"driver"`
+	URL       string      `gorm:"type:varchar(500);not null" json:"url"`
+	Secret    string      `gorm:"type:varchar(64);not null" json:"-"` // signs webhook requests
+	AutoPrint bool        `gorm:"default:false" json:"auto_print"`
+	UpdatedBy uuid.UUID   `gorm:"type:uuid;not null" json:"updated_by"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (p *BadgePrinter) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
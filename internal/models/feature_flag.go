@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// FeatureFlag switches a feature on or off for every replica without a deploy
+type FeatureFlag struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Key         string     `gorm:"type:varchar(100);uniqueIndex;not null" json:"key"`
+	Enabled     bool       `gorm:"not null" json:"enabled"`
+	Description string     `json:"description,omitempty"`
+	UpdatedBy   *uuid.UUID `gorm:"type:uuid" json:"updated_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (f *FeatureFlag) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+	return nil
+}
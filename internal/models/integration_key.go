@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// IntegrationKey is an API key an organizer gives to no-code tools such as
+// Zapier or Make. It reaches the integration routes of its organizer only, and
+// acts as the user who created it. Only the key's hash is stored.
+type IntegrationKey struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID uuid.UUID  `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	Name        string     `gorm:"type:varchar(100);not null" json:"name"`  // e.g. "Zapier"
+	Prefix      string     `gorm:"type:varchar(12);not null" json:"prefix"` // first characters of the key, to tell keys apart
+	KeyHash     string     `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	CreatedBy   uuid.UUID  `gorm:"type:uuid;not null" json:"created_by"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (k *IntegrationKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == uuid.Nil {
+		k.ID = uuid.New()
+	}
+	return nil
+}
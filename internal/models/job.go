@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JobStatus represents the state of a background job
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"   // waiting for RunAt, including retries
+	JobRunning   JobStatus = "running"   // claimed by a runner until LockedUntil
+	JobSucceeded JobStatus = "succeeded" // deleted after JOB_RETENTION
+	JobDead      JobStatus = "dead"      // failed MaxAttempts times; retried from /admin/jobs
+)
+
+// Job is a unit of background work in the table-backed job queue. Its
+// payload is the JSON of the job type's payload struct.
+type Job struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Type        string     `gorm:"type:varchar(100);not null;index" json:"type"`
+	Payload     string     `gorm:"type:jsonb;not null" json:"payload"`
+	Status      JobStatus  `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	MaxAttempts int        `gorm:"not null" json:"max_attempts"`
+	RunAt       time.Time  `gorm:"not null;index" json:"run_at"`                // next attempt, once pending
+	LockedUntil *time.Time `json:"locked_until,omitempty"`                      // lease of the runner executing it
+	Error       string     `gorm:"type:text" json:"error,omitempty"`            // from the latest failed attempt
+	Schedule    string     `gorm:"type:varchar(100)" json:"schedule,omitempty"` // schedule that enqueued it
+	RequestID   string     `gorm:"type:varchar(100)" json:"request_id,omitempty"`
+	FinishedAt  *time.Time `gorm:"index" json:"finished_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (j *Job) BeforeCreate(tx *gorm.DB) error {
+	if j.ID == uuid.Nil {
+		j.ID = uuid.New()
+	}
+	return nil
+}
+
+// JobSchedule is a cron schedule that enqueues a job each time it is due.
+// Replicas take turns through the row lock, so each run is enqueued once.
+type JobSchedule struct {
+	Name      string     `gorm:"type:varchar(100);primary_key" json:"name"`
+	Spec      string     `gorm:"type:varchar(100);not null" json:"spec"` // cron expression or @every duration
+	JobType   string     `gorm:"type:varchar(100);not null" json:"job_type"`
+	NextRunAt time.Time  `gorm:"not null;index" json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	LastJobID *uuid.UUID `gorm:"type:uuid" json:"last_job_id,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StaffRole is a preset of permissions an organizer grants a team member
+type StaffRole string
+
+const (
+	StaffScanner StaffRole = "scanner" // check-in only
+	StaffFinance StaffRole = "finance" // analytics and payouts
+	StaffEditor  StaffRole = "editor"  // event editing and comp tickets
+	StaffCustom  StaffRole = "custom"  // permissions picked one by one
+)
+
+// MemberStatus tracks a team member from invitation to acceptance
+type MemberStatus string
+
+const (
+	MemberInvited MemberStatus = "invited"
+	MemberActive  MemberStatus = "active"
+)
+
+// OrganizerMember is someone an organizer invited by email to work on their
+// events with some of the organizer's permissions. The invitee's account is
+// linked when they accept; a user can be an active member of one organizer.
+type OrganizerMember struct {
+	ID              uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID     uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_organizer_members_email" json:"organizer_id"`
+	Email           string       `gorm:"type:varchar(255);not null;uniqueIndex:idx_organizer_members_email" json:"email"`
+	UserID          *uuid.UUID   `gorm:"type:uuid;uniqueIndex" json:"user_id,omitempty"` // set on acceptance
+	Role            StaffRole    `gorm:"type:varchar(20);not null" json:"role"`
+	Permissions     []Permission `gorm:"type:jsonb;serializer:json" json:"permissions"`
+	Status          MemberStatus `gorm:"type:varchar(20);not null;default:'invited';index" json:"status"`
+	InviteTokenHash string       `gorm:"type:varchar(64);index" json:"-"` // SHA-256 of the token in the invitation link
+	InviteExpiresAt *time.Time   `json:"invite_expires_at,omitempty"`
+	InvitedBy       uuid.UUID    `gorm:"type:uuid;not null" json:"invited_by"`
+	AcceptedAt      *time.Time   `json:"accepted_at,omitempty"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+
+	// Relationships
+	Organizer Organizer `gorm:"foreignKey:OrganizerID" json:"-"`
+	User      *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+// BeforeCreate sets the ID before creating
+func (m *OrganizerMember) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OutboxMessage is a domain event written in the same transaction as the
+// change it describes, and published to the message bus by the outbox relay.
+// Its ID is the event's envelope ID, so consumers can drop redeliveries.
+type OutboxMessage struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventType     string     `gorm:"type:varchar(50);not null" json:"event_type"`
+	Key           string     `gorm:"type:varchar(100);not null" json:"key"` // partition key
+	Payload       string     `gorm:"type:jsonb;not null" json:"payload"`    // the event's data
+	RequestID     string     `gorm:"type:varchar(100)" json:"request_id,omitempty"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt *time.Time `gorm:"index" json:"next_attempt_at,omitempty"` // nil once published
+	Error         string     `gorm:"type:text" json:"error,omitempty"`       // from the latest failed attempt
+	PublishedAt   *time.Time `gorm:"index" json:"published_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (m *OutboxMessage) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
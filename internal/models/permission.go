@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Permission is an action a user may be allowed to take, named
+// resource:action
+type Permission string
+
+const (
+	PermEventsCreate    Permission = "events:create"
+	PermEventsManage    Permission = "events:manage"
+	PermCheckinScan     Permission = "checkin:scan"
+	PermAttendeesView   Permission = "attendees:view"
+	PermTicketsComp     Permission = "tickets:comp"
+	PermPayoutsView     Permission = "payouts:view"
+	PermOrganizerManage Permission = "organizer:manage"
+)
+
+// RolePermissions is the set of permissions granted to every user with a
+// role. Roles without a row keep their built-in permissions.
+type RolePermissions struct {
+	ID          uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Role        UserRole     `gorm:"type:varchar(20);uniqueIndex;not null" json:"role"`
+	Permissions []Permission `gorm:"type:jsonb;serializer:json" json:"permissions"`
+	UpdatedBy   *uuid.UUID   `gorm:"type:uuid" json:"updated_by,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (r *RolePermissions) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SagaStep is one step of the order saga
+type SagaStep string
+
+const (
+	SagaReserve SagaStep = "reserve" // tickets and add-ons held for the order
+	SagaOrder   SagaStep = "order"   // order placed, awaiting payment
+	SagaPay     SagaStep = "pay"     // payment captured
+	SagaFulfill SagaStep = "fulfill" // tickets issued
+)
+
+// SagaSteps are the order saga's steps in the order they run; they are
+// compensated in reverse
+var SagaSteps = []SagaStep{SagaReserve, SagaOrder, SagaPay, SagaFulfill}
+
+// SagaStatus represents the state of an order saga
+type SagaStatus string
+
+const (
+	SagaRunning      SagaStatus = "running"      // waiting on its next step
+	SagaStuck        SagaStatus = "stuck"        // a step failed; resume to retry it
+	SagaCompleted    SagaStatus = "completed"    // every step ran
+	SagaCompensating SagaStatus = "compensating" // being undone; resume to continue
+	SagaCompensated  SagaStatus = "compensated"  // every completed step was undone
+)
+
+// SagaAction is what a saga log entry did to its step
+type SagaAction string
+
+const (
+	SagaExecute    SagaAction = "execute"
+	SagaCompensate SagaAction = "compensate"
+)
+
+// OrderSaga tracks an order through reserve → order → pay → fulfill so a
+// flow interrupted between steps can be inspected, resumed or undone.
+// Completed holds the steps that ran and have not been compensated.
+type OrderSaga struct {
+	ID         uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrderID    uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex" json:"order_id"`
+	Status     SagaStatus       `gorm:"type:varchar(20);not null;default:'running';index" json:"status"`
+	Completed  []SagaStep       `gorm:"type:jsonb;serializer:json" json:"completed"`
+	FailedStep SagaStep         `gorm:"type:varchar(20)" json:"failed_step,omitempty"`
+	Error      string           `gorm:"type:text" json:"error,omitempty"`  // from the step that last failed
+	Reason     string           `gorm:"type:text" json:"reason,omitempty"` // why it was compensated
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+	Log        []OrderSagaEntry `gorm:"foreignKey:SagaID" json:"log,omitempty"`
+}
+
+// Done reports whether step ran and has not been compensated
+func (s *OrderSaga) Done(step SagaStep) bool {
+	for _, completed := range s.Completed {
+		if completed == step {
+			return true
+		}
+	}
+	return false
+}
+
+// BeforeCreate sets the ID before creating
+func (s *OrderSaga) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// OrderSagaEntry records one attempt to execute or compensate a saga step
+type OrderSagaEntry struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	SagaID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"-"`
+	Step      SagaStep   `gorm:"type:varchar(20);not null" json:"step"`
+	Action    SagaAction `gorm:"type:varchar(20);not null" json:"action"`
+	Error     string     `gorm:"type:text" json:"error,omitempty"` // empty when the attempt succeeded
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (e *OrderSagaEntry) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ScannerToken is a short-lived credential for temporary door staff. It only
+// reaches the check-in routes of one event; its ID is the token's session ID,
+// so revoking it works like signing out a session.
+type ScannerToken struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID   uuid.UUID  `gorm:"type:uuid;not null;index" json:"event_id"`
+	Label     string     `gorm:"type:varchar(100)" json:"label,omitempty"` // e.g. "North gate"
+	IssuedBy  uuid.UUID  `gorm:"type:uuid;not null" json:"issued_by"`      // check-ins are recorded against them
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (t *ScannerToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the token can still be used
+func (t *ScannerToken) IsActive() bool {
+	return t.RevokedAt == nil && time.Now().Before(t.ExpiresAt)
+}
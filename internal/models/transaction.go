@@ -16,6 +16,7 @@ const (
 	TicketUsed      TicketStatus = "used"
 	TicketCancelled TicketStatus = "cancelled"
 	TicketRefunded  TicketStatus = "refunded"
+	TicketFrozen    TicketStatus = "frozen" // unusable while the payment is disputed
 )
 
 // Ticket represents a ticket
@@ -32,10 +33,11 @@ type Ticket struct {
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Relationships
-	Tier    TicketTier `gorm:"foreignKey:TierID" json:"tier,omitempty"`
-	Order   Order      `gorm:"foreignKey:OrderID" json:"order,omitempty"`
-	Owner   User       `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
-	Checkin *Checkin   `gorm:"foreignKey:TicketID" json:"checkin,omitempty"`
+	Tier    TicketTier     `gorm:"foreignKey:TierID" json:"tier,omitempty"`
+	Order   Order          `gorm:"foreignKey:OrderID" json:"order,omitempty"`
+	Owner   User           `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
+	Checkin *Checkin       `gorm:"foreignKey:TicketID" json:"checkin,omitempty"`
+	Answers []TicketAnswer `gorm:"foreignKey:TicketID" json:"answers,omitempty"`
 }
 
 // BeforeCreate sets the ID before creating
@@ -60,23 +62,43 @@ const (
 	OrderFailed    OrderStatus = "failed"
 	OrderCancelled OrderStatus = "cancelled"
 	OrderRefunded  OrderStatus = "refunded"
+	OrderReview    OrderStatus = "review" // paid, but held for an admin after scoring as risky
 )
 
 // Order represents an order
 type Order struct {
-	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	UserID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"user_id"`
-	TotalAmount float64        `gorm:"not null" json:"total_amount"`
-	Currency    string         `gorm:"default:'USD'" json:"currency"`
-	Status      OrderStatus    `gorm:"type:varchar(20);default:'pending';index" json:"status"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	ID              uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID          uuid.UUID       `gorm:"type:uuid;not null;index" json:"user_id"`
+	TierID          uuid.UUID       `gorm:"type:uuid;index" json:"tier_id"`
+	Quantity        int             `gorm:"not null;default:0" json:"quantity"`
+	UnitPrice       int64           `gorm:"not null;default:0" json:"unit_price"`   // minor units, as held at reservation time
+	TotalAmount     int64           `gorm:"not null" json:"total_amount"`           // minor units
+	PlatformFee     int64           `gorm:"not null;default:0" json:"platform_fee"` // minor units, deducted from organizer proceeds
+	TaxName         string          `json:"tax_name,omitempty"`
+	TaxBasisPoints  int             `gorm:"not null;default:0" json:"tax_basis_points"`
+	TaxInclusive    bool            `gorm:"default:false" json:"tax_inclusive"`
+	TaxAmount       int64           `gorm:"not null;default:0" json:"tax_amount"` // minor units, part of the total
+	Currency        string          `gorm:"default:'USD'" json:"currency"`
+	PaymentProvider PaymentProvider `gorm:"type:varchar(20);default:'paystack'" json:"payment_provider"`
+	Status          OrderStatus     `gorm:"type:varchar(20);default:'pending';index" json:"status"`
+	IsComp          bool            `gorm:"default:false;index" json:"is_comp"` // complimentary tickets issued by the organizer
+	ExpiresAt       *time.Time      `gorm:"index" json:"expires_at,omitempty"`
+	BillingCountry  string          `gorm:"type:varchar(2)" json:"billing_country,omitempty"` // ISO 3166-1 alpha-2, given at checkout
+	ClientIP        string          `json:"-"`
+	IPCountry       string          `gorm:"type:varchar(2)" json:"-"`
+	RiskScore       int             `gorm:"not null;default:0" json:"-"`
+	RiskReasons     []string        `gorm:"type:jsonb;serializer:json" json:"-"` // why the order was held for review
+	FormAnswers     []AnswerSet     `gorm:"type:jsonb;serializer:json" json:"-"` // one per ticket, copied to the tickets when they are issued
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt  `gorm:"index" json:"-"`
 
 	// Relationships
-	User     User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Tickets  []Ticket  `gorm:"foreignKey:OrderID" json:"tickets,omitempty"`
-	Payments []Payment `gorm:"foreignKey:OrderID" json:"payments,omitempty"`
+	User     User        `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Items    []OrderItem `gorm:"foreignKey:OrderID" json:"items,omitempty"`
+	Tickets  []Ticket    `gorm:"foreignKey:OrderID" json:"tickets,omitempty"`
+	Payments []Payment   `gorm:"foreignKey:OrderID" json:"payments,omitempty"`
+	Refunds  []Refund    `gorm:"foreignKey:OrderID" json:"refunds,omitempty"`
 }
 
 // BeforeCreate sets the ID before creating
@@ -87,6 +109,61 @@ func (o *Order) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// OrderItemType is the kind of thing an order line buys
+type OrderItemType string
+
+const (
+	OrderItemTicket OrderItemType = "ticket"
+	OrderItemAddOn  OrderItemType = "add_on"
+)
+
+// OrderItem is one line of an order: its tickets, or an add-on bought with them.
+// Names and prices are copied onto the line so receipts survive later edits.
+type OrderItem struct {
+	ID        uuid.UUID     `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrderID   uuid.UUID     `gorm:"type:uuid;not null;index" json:"order_id"`
+	Type      OrderItemType `gorm:"type:varchar(20);not null" json:"type"`
+	TierID    *uuid.UUID    `gorm:"type:uuid" json:"tier_id,omitempty"`
+	AddOnID   *uuid.UUID    `gorm:"type:uuid;index" json:"add_on_id,omitempty"`
+	Name      string        `gorm:"not null" json:"name"`
+	Quantity  int           `gorm:"not null" json:"quantity"`
+	UnitPrice int64         `gorm:"not null" json:"unit_price"`           // minor units
+	Amount    int64         `gorm:"not null" json:"amount"`               // minor units
+	TaxAmount int64         `gorm:"not null;default:0" json:"tax_amount"` // minor units, included in or added to the amount
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (i *OrderItem) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// AnswerSet holds one ticket's registration form answers, keyed by form field
+type AnswerSet map[uuid.UUID]string
+
+// TicketAnswer is a ticket holder's answer to one of the event's form fields
+type TicketAnswer struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TicketID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_ticket_answers_ticket_field" json:"ticket_id"`
+	FormFieldID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_ticket_answers_ticket_field" json:"form_field_id"`
+	Value       string    `gorm:"type:text;not null" json:"value"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Relationships
+	FormField FormField `gorm:"foreignKey:FormFieldID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (a *TicketAnswer) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
 // PaymentStatus represents payment status
 type PaymentStatus string
 
@@ -98,6 +175,8 @@ const (
 	PaymentFailed     PaymentStatus = "failed"
 	PaymentRefunding  PaymentStatus = "refunding"
 	PaymentRefunded   PaymentStatus = "refunded"
+	PaymentDisputed   PaymentStatus = "disputed"
+	PaymentChargeback PaymentStatus = "charged_back" // dispute lost, funds returned to the cardholder
 )
 
 // PaymentProvider represents payment providers
@@ -113,7 +192,7 @@ type Payment struct {
 	ID              uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 	OrderID         uuid.UUID       `gorm:"type:uuid;not null;index" json:"order_id"`
 	Provider        PaymentProvider `gorm:"type:varchar(20);not null" json:"provider"`
-	Amount          float64         `gorm:"not null" json:"amount"`
+	Amount          int64           `gorm:"not null" json:"amount"` // minor units
 	Currency        string          `gorm:"default:'USD'" json:"currency"`
 	TransactionID   string          `gorm:"uniqueIndex" json:"transaction_id"`
 	PaymentIntentID string          `json:"payment_intent_id,omitempty"`
@@ -135,20 +214,198 @@ func (p *Payment) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// RefundStatus represents refund status
+type RefundStatus string
+
+const (
+	RefundPending   RefundStatus = "pending"
+	RefundCompleted RefundStatus = "completed"
+	RefundFailed    RefundStatus = "failed"
+)
+
+// Refund represents a refund issued against a payment
+type Refund struct {
+	ID               uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrderID          uuid.UUID    `gorm:"type:uuid;not null;index" json:"order_id"`
+	PaymentID        *uuid.UUID   `gorm:"type:uuid;index" json:"payment_id,omitempty"`
+	Amount           int64        `gorm:"not null" json:"amount"`                  // minor units
+	Fee              int64        `gorm:"not null;default:0" json:"fee,omitempty"` // kept under the event's refund policy, minor units
+	Currency         string       `gorm:"default:'USD'" json:"currency"`
+	ProviderRefundID string       `json:"provider_refund_id,omitempty"`
+	Reason           string       `gorm:"type:text" json:"reason,omitempty"`
+	Status           RefundStatus `gorm:"type:varchar(20);default:'pending';index" json:"status"`
+	RequestedBy      uuid.UUID    `gorm:"type:uuid;not null" json:"requested_by"`
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+
+	// Relationships
+	Order   Order    `gorm:"foreignKey:OrderID" json:"-"`
+	Payment *Payment `gorm:"foreignKey:PaymentID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (r *Refund) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// DisputeStatus represents dispute status
+type DisputeStatus string
+
+const (
+	DisputeOpen DisputeStatus = "open"
+	DisputeWon  DisputeStatus = "won"
+	DisputeLost DisputeStatus = "lost"
+)
+
+// Dispute is a chargeback raised by a cardholder against a payment. The
+// order's tickets are frozen until it is resolved.
+type Dispute struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PaymentID         uuid.UUID       `gorm:"type:uuid;not null;index" json:"payment_id"`
+	OrderID           uuid.UUID       `gorm:"type:uuid;not null;index" json:"order_id"`
+	Provider          PaymentProvider `gorm:"type:varchar(20);not null" json:"provider"`
+	ProviderDisputeID string          `gorm:"type:varchar(100);uniqueIndex" json:"provider_dispute_id"`
+	Amount            int64           `gorm:"not null" json:"amount"` // minor units
+	Currency          string          `gorm:"type:varchar(3)" json:"currency"`
+	Reason            string          `json:"reason,omitempty"` // as reported by the provider
+	Status            DisputeStatus   `gorm:"type:varchar(20);default:'open';index" json:"status"`
+	EvidenceDueBy     *time.Time      `json:"evidence_due_by,omitempty"`
+	Resolution        string          `gorm:"type:text" json:"resolution,omitempty"`
+	ResolvedBy        *uuid.UUID      `gorm:"type:uuid" json:"resolved_by,omitempty"` // nil when resolved by the provider
+	ResolvedAt        *time.Time      `json:"resolved_at,omitempty"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+
+	// Relationships
+	Order    Order             `gorm:"foreignKey:OrderID" json:"-"`
+	Evidence []DisputeEvidence `gorm:"foreignKey:DisputeID" json:"evidence,omitempty"`
+}
+
+// BeforeCreate sets the ID before creating
+func (d *Dispute) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// DisputeEvidence is a file uploaded to private storage to contest a dispute
+type DisputeEvidence struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	DisputeID   uuid.UUID `gorm:"type:uuid;not null;index" json:"dispute_id"`
+	Key         string    `gorm:"not null" json:"-"` // storage object key
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Note        string    `gorm:"type:text" json:"note,omitempty"`
+	UploadedBy  uuid.UUID `gorm:"type:uuid;not null" json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (e *DisputeEvidence) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// ReconciliationIssueType classifies a difference between a provider's
+// records and our payments
+type ReconciliationIssueType string
+
+const (
+	ReconciliationAmountMismatch ReconciliationIssueType = "amount_mismatch"
+	ReconciliationOrphaned       ReconciliationIssueType = "orphaned"        // paid at the provider, unknown here
+	ReconciliationMissingWebhook ReconciliationIssueType = "missing_webhook" // paid at the provider, not completed here
+)
+
+// ReconciliationReport compares one day of a provider's successful
+// transactions with our payment records
+type ReconciliationReport struct {
+	ID           uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Provider     PaymentProvider `gorm:"type:varchar(20);not null;uniqueIndex:idx_reconciliation_provider_date" json:"provider"`
+	Date         time.Time       `gorm:"type:date;not null;uniqueIndex:idx_reconciliation_provider_date" json:"date"`
+	Transactions int             `json:"transactions"` // successful at the provider
+	Matched      int             `json:"matched"`
+	CreatedAt    time.Time       `json:"created_at"`
+
+	// Relationships
+	Issues []ReconciliationIssue `gorm:"foreignKey:ReportID" json:"issues,omitempty"`
+}
+
+// BeforeCreate sets the ID before creating
+func (r *ReconciliationReport) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// ReconciliationIssue is a provider transaction that does not match our records
+type ReconciliationIssue struct {
+	ID               uuid.UUID               `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ReportID         uuid.UUID               `gorm:"type:uuid;not null;index" json:"report_id"`
+	Type             ReconciliationIssueType `gorm:"type:varchar(30);not null" json:"type"`
+	Reference        string                  `gorm:"not null" json:"reference"`
+	PaymentID        *uuid.UUID              `gorm:"type:uuid" json:"payment_id,omitempty"`
+	PaymentStatus    PaymentStatus           `gorm:"type:varchar(20)" json:"payment_status,omitempty"`
+	Amount           int64                   `json:"amount"` // ours, minor units
+	Currency         string                  `gorm:"type:varchar(3)" json:"currency,omitempty"`
+	ProviderAmount   int64                   `json:"provider_amount"` // minor units
+	ProviderCurrency string                  `gorm:"type:varchar(3)" json:"provider_currency"`
+	CreatedAt        time.Time               `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (i *ReconciliationIssue) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// CheckinMethod is how door staff admitted a ticket
+type CheckinMethod string
+
+const (
+	CheckinQR     CheckinMethod = "qr"
+	CheckinManual CheckinMethod = "manual" // found by name or email for attendees without their ticket
+)
+
+// CheckinDirection tells entries from exits at events that allow re-entry
+type CheckinDirection string
+
+const (
+	CheckinIn  CheckinDirection = "in"
+	CheckinOut CheckinDirection = "out"
+)
+
 // Checkin represents a ticket check-in
+// A ticket is checked in to its event once, unless the event allows re-entry,
+// in which case every entry and exit scan is recorded. It is checked in to
+// each session at most once.
 type Checkin struct {
-	ID         uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	TicketID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"ticket_id"`
-	EventID    uuid.UUID `gorm:"type:uuid;not null;index" json:"event_id"`
-	ScannedBy  uuid.UUID `gorm:"type:uuid;not null" json:"scanned_by"`
-	ScannedAt  time.Time `gorm:"not null;index" json:"scanned_at"`
-	Location   string    `json:"location,omitempty"`
-	DeviceInfo string    `json:"device_info,omitempty"`
+	ID         uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TicketID   uuid.UUID        `gorm:"type:uuid;not null;uniqueIndex:idx_checkins_ticket_session" json:"ticket_id"`
+	EventID    uuid.UUID        `gorm:"type:uuid;not null;index" json:"event_id"`
+	SessionID  *uuid.UUID       `gorm:"type:uuid;uniqueIndex:idx_checkins_ticket_session;index" json:"session_id,omitempty"` // nil for event entry
+	ZoneID     *uuid.UUID       `gorm:"type:uuid" json:"zone_id,omitempty"`                                                  // entrance the ticket was scanned at; nil for the main gate
+	ScannedBy  uuid.UUID        `gorm:"type:uuid;not null" json:"scanned_by"`
+	ScannedAt  time.Time        `gorm:"not null;index" json:"scanned_at"`
+	Location   string           `json:"location,omitempty"`
+	DeviceInfo string           `json:"device_info,omitempty"`
+	Method     CheckinMethod    `gorm:"type:varchar(20);not null;default:'qr'" json:"method"`
+	Direction  CheckinDirection `gorm:"type:varchar(3);not null;default:'in'" json:"direction"`
 
 	// Relationships
-	Ticket  Ticket `gorm:"foreignKey:TicketID" json:"ticket,omitempty"`
-	Event   Event  `gorm:"foreignKey:EventID" json:"event,omitempty"`
-	Scanner User   `gorm:"foreignKey:ScannedBy" json:"scanner,omitempty"`
+	Ticket  Ticket        `gorm:"foreignKey:TicketID" json:"ticket,omitempty"`
+	Event   Event         `gorm:"foreignKey:EventID" json:"event,omitempty"`
+	Session *EventSession `gorm:"foreignKey:SessionID" json:"session,omitempty"`
+	Scanner User          `gorm:"foreignKey:ScannedBy" json:"scanner,omitempty"`
 }
 
 // BeforeCreate sets the ID before creating
@@ -159,6 +416,17 @@ func (c *Checkin) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// ZonePresence records a ticket inside an event zone, from its first pass
+// through the zone's entrance until it is checked out of the zone or the
+// event. Zone occupancy counters are rebuilt from it, and repeat passes of a
+// ticket already inside are not counted again.
+type ZonePresence struct {
+	TicketID  uuid.UUID `gorm:"type:uuid;primary_key" json:"ticket_id"`
+	ZoneID    uuid.UUID `gorm:"type:uuid;primary_key;index" json:"zone_id"`
+	EventID   uuid.UUID `gorm:"type:uuid;not null;index" json:"event_id"`
+	EnteredAt time.Time `gorm:"not null" json:"entered_at"`
+}
+
 // NotificationType represents notification types
 type NotificationType string
 
@@ -201,3 +469,164 @@ func (n *Notification) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// NotificationTopic groups notifications for preference purposes
+type NotificationTopic string
+
+const (
+	TopicAccount   NotificationTopic = "account" // security and account mail, always delivered
+	TopicOrders    NotificationTopic = "orders"
+	TopicReminders NotificationTopic = "reminders"
+	TopicMarketing NotificationTopic = "marketing"
+)
+
+// NotificationPreference records which channels and topics a user receives.
+// Rows are always created from DefaultNotificationPreference, so the flags
+// carry no column defaults (GORM would replace a false value with them).
+type NotificationPreference struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"user_id"`
+	EmailEnabled   bool      `gorm:"not null" json:"email_enabled"`
+	SMSEnabled     bool      `gorm:"not null" json:"sms_enabled"`
+	PushEnabled    bool      `gorm:"not null" json:"push_enabled"`
+	OrderUpdates   bool      `gorm:"not null" json:"order_updates"`
+	EventReminders bool      `gorm:"not null" json:"event_reminders"`
+	Marketing      bool      `gorm:"not null" json:"marketing"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (p *NotificationPreference) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// DefaultNotificationPreference returns the preferences of a user who has not chosen any
+func DefaultNotificationPreference(userID uuid.UUID) NotificationPreference {
+	return NotificationPreference{
+		UserID:         userID,
+		EmailEnabled:   true,
+		SMSEnabled:     true,
+		PushEnabled:    true,
+		OrderUpdates:   true,
+		EventReminders: true,
+	}
+}
+
+// Allows reports whether a notification on channel about topic may be sent
+func (p *NotificationPreference) Allows(channel NotificationChannel, topic NotificationTopic) bool {
+	// Account notifications (verification, password reset) cannot be opted out of
+	if topic == TopicAccount {
+		return true
+	}
+
+	switch channel {
+	case ChannelEmail:
+		if !p.EmailEnabled {
+			return false
+		}
+	case ChannelSMS:
+		if !p.SMSEnabled {
+			return false
+		}
+	case ChannelPush:
+		if !p.PushEnabled {
+			return false
+		}
+	}
+
+	switch topic {
+	case TopicOrders:
+		return p.OrderUpdates
+	case TopicReminders:
+		return p.EventReminders
+	case TopicMarketing:
+		return p.Marketing
+	}
+
+	return true
+}
+
+// LedgerEntryType represents the reason an organizer balance changed
+type LedgerEntryType string
+
+const (
+	LedgerSale           LedgerEntryType = "sale"
+	LedgerRefund         LedgerEntryType = "refund"
+	LedgerPayout         LedgerEntryType = "payout"
+	LedgerPayoutReversal LedgerEntryType = "payout_reversal"
+	LedgerChargeback     LedgerEntryType = "chargeback"
+)
+
+// OrganizerBalance is the amount owed to an organizer in one currency
+type OrganizerBalance struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_organizer_balance_currency" json:"organizer_id"`
+	Currency    string    `gorm:"type:varchar(3);not null;uniqueIndex:idx_organizer_balance_currency" json:"currency"`
+	Balance     int64     `gorm:"not null;default:0" json:"balance"` // minor units
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (b *OrganizerBalance) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// LedgerEntry records a single change to an organizer balance
+type LedgerEntry struct {
+	ID          uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID uuid.UUID       `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	Type        LedgerEntryType `gorm:"type:varchar(20);not null" json:"type"`
+	Amount      int64           `gorm:"not null" json:"amount"` // signed, minor units
+	Currency    string          `gorm:"type:varchar(3);not null" json:"currency"`
+	OrderID     *uuid.UUID      `gorm:"type:uuid;index" json:"order_id,omitempty"`
+	PayoutID    *uuid.UUID      `gorm:"type:uuid;index" json:"payout_id,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (e *LedgerEntry) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// PayoutStatus represents payout status
+type PayoutStatus string
+
+const (
+	PayoutPending   PayoutStatus = "pending"
+	PayoutCompleted PayoutStatus = "completed"
+	PayoutFailed    PayoutStatus = "failed"
+)
+
+// Payout is a transfer of an organizer's balance to the organizer
+type Payout struct {
+	ID          uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID uuid.UUID    `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	Amount      int64        `gorm:"not null" json:"amount"` // minor units
+	Currency    string       `gorm:"type:varchar(3);not null" json:"currency"`
+	Status      PayoutStatus `gorm:"type:varchar(20);default:'pending';index" json:"status"`
+	Reference   string       `json:"reference,omitempty"`
+	Reason      string       `gorm:"type:text" json:"reason,omitempty"`
+	InitiatedBy uuid.UUID    `gorm:"type:uuid;not null" json:"initiated_by"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (p *Payout) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
@@ -14,23 +14,33 @@ const (
 	RoleAttendee  UserRole = "attendee"
 	RoleOrganizer UserRole = "organizer"
 	RoleAdmin     UserRole = "admin"
+
+	// RoleGuest is a buyer who checked out with just an email. Guests can
+	// only reserve and pay until they claim their account.
+	RoleGuest UserRole = "guest"
+
+	// RoleScanner is carried by door staff scanner tokens rather than user
+	// accounts. Scanner tokens only reach the check-in routes of one event.
+	RoleScanner UserRole = "scanner"
 )
 
 // User represents a user in the system
 type User struct {
-	ID            uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	Email         string         `gorm:"uniqueIndex;not null" json:"email"`
-	PasswordHash  string         `gorm:"not null" json:"-"`
-	FirstName     string         `gorm:"not null" json:"first_name"`
-	LastName      string         `gorm:"not null" json:"last_name"`
-	Phone         string         `json:"phone,omitempty"`
-	Role          UserRole       `gorm:"type:varchar(20);not null;default:'attendee'" json:"role"`
-	EmailVerified bool           `gorm:"default:false" json:"email_verified"`
-	IsActive      bool           `gorm:"default:true" json:"is_active"`
-	LastLoginAt   *time.Time     `json:"last_login_at,omitempty"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Email             string         `gorm:"uniqueIndex;not null" json:"email"`
+	PasswordHash      string         `gorm:"not null" json:"-"`
+	FirstName         string         `gorm:"not null" json:"first_name"`
+	LastName          string         `gorm:"not null" json:"last_name"`
+	Phone             string         `json:"phone,omitempty"`
+	Role              UserRole       `gorm:"type:varchar(20);not null;default:'attendee'" json:"role"`
+	EmailVerified     bool           `gorm:"default:false" json:"email_verified"`
+	IsActive          bool           `gorm:"default:true" json:"is_active"`
+	LastLoginAt       *time.Time     `json:"last_login_at,omitempty"`
+	PasswordChangedAt *time.Time     `json:"-"`
+	AnonymizedAt      *time.Time     `json:"-"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// OAuth fields
 	OAuthProvider string `json:"oauth_provider,omitempty"`
@@ -55,6 +65,32 @@ func (u *User) FullName() string {
 	return u.FirstName + " " + u.LastName
 }
 
+// Session is a signed-in device. Each refresh rotates its refresh token;
+// only the latest one is accepted.
+type Session struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	RefreshTokenID string     `gorm:"not null" json:"-"` // jti of the current refresh token
+	UserAgent      string     `gorm:"type:text" json:"user_agent"`
+	IPAddress      string     `gorm:"type:varchar(45)" json:"ip_address"`
+	LastUsedAt     time.Time  `json:"last_used_at"`
+	ExpiresAt      time.Time  `gorm:"index" json:"expires_at"`
+	RevokedAt      *time.Time `gorm:"index" json:"revoked_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+
+	// Relationships
+	User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (s *Session) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
 // VerificationStatus represents organizer verification status
 type VerificationStatus string
 
@@ -72,7 +108,15 @@ type Organizer struct {
 	Description        string             `gorm:"type:text" json:"description"`
 	Website            string             `json:"website,omitempty"`
 	Logo               string             `json:"logo,omitempty"`
-	VerificationStatus VerificationStatus `gorm:"type:varchar(20);default:'pending'" json:"verification_status"`
+	VerificationStatus VerificationStatus `gorm:"type:varchar(20);default:'pending';index" json:"verification_status"`
+	VerificationDocKey string             `json:"-"`
+	RejectionReason    string             `gorm:"type:text" json:"rejection_reason,omitempty"`
+	FeeBasisPoints     *int               `json:"fee_basis_points,omitempty"`         // overrides the platform percentage fee
+	FeeFixedPerTicket  *int64             `json:"fee_fixed_per_ticket,omitempty"`     // overrides the platform fixed fee
+	TaxName            string             `json:"tax_name,omitempty"`                 // e.g. "VAT", shown on receipts
+	TaxBasisPoints     *int               `json:"tax_basis_points,omitempty"`         // default tax rate of the organizer's events; nil means none
+	TaxInclusive       bool               `gorm:"default:false" json:"tax_inclusive"` // prices already include the tax
+	AppliedAt          *time.Time         `json:"applied_at,omitempty"`
 	VerifiedAt         *time.Time         `json:"verified_at,omitempty"`
 	CreatedAt          time.Time          `json:"created_at"`
 	UpdatedAt          time.Time          `json:"updated_at"`
@@ -100,44 +144,77 @@ const (
 	EventActive      EventStatus = "active"
 	EventCompleted   EventStatus = "completed"
 	EventCancelled   EventStatus = "cancelled"
+	EventUnpublished EventStatus = "unpublished" // taken down by an admin; the organizer may resubmit it
+	EventBanned      EventStatus = "banned"      // taken down by an admin for good
 )
 
-// EventCategory represents event categories
-type EventCategory string
+// Category groups events for browsing. Events refer to a category by slug;
+// admins manage the list, and inactive categories are hidden and cannot be
+// chosen for new events.
+type Category struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Name      string    `gorm:"not null" json:"name"`
+	Slug      string    `gorm:"type:varchar(50);uniqueIndex;not null" json:"slug"`
+	Icon      string    `json:"icon,omitempty"` // icon name or URL understood by the frontend
+	IsActive  bool      `gorm:"default:true" json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (c *Category) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// RefundPolicy controls when attendees may refund their own orders
+type RefundPolicy string
 
 const (
-	CategoryMusic      EventCategory = "music"
-	CategorySports     EventCategory = "sports"
-	CategoryArts       EventCategory = "arts"
-	CategoryTechnology EventCategory = "technology"
-	CategoryBusiness   EventCategory = "business"
-	CategoryEducation  EventCategory = "education"
-	CategoryOther      EventCategory = "other"
+	RefundPolicyFlexible RefundPolicy = "flexible" // until the event starts
+	RefundPolicyDeadline RefundPolicy = "deadline" // until RefundDaysBefore days before the event starts
+	RefundPolicyNone     RefundPolicy = "none"
 )
 
 // Event represents an event
 type Event struct {
-	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	OrganizerID uuid.UUID      `gorm:"type:uuid;not null;index" json:"organizer_id"`
-	Title       string         `gorm:"not null" json:"title"`
-	Slug        string         `gorm:"uniqueIndex;not null" json:"slug"`
-	Description string         `gorm:"type:text" json:"description"`
-	Category    EventCategory  `gorm:"type:varchar(50);not null" json:"category"`
-	Location    string         `gorm:"not null" json:"location"`
-	Venue       string         `json:"venue"`
-	StartTime   time.Time      `gorm:"not null;index" json:"start_time"`
-	EndTime     time.Time      `gorm:"not null" json:"end_time"`
-	BannerURL   string         `json:"banner_url"`
-	Status      EventStatus    `gorm:"type:varchar(20);default:'draft';index" json:"status"`
-	IsFeatured  bool           `gorm:"default:false" json:"is_featured"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                   uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID          uuid.UUID      `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	Title                string         `gorm:"not null" json:"title"`
+	Slug                 string         `gorm:"uniqueIndex;not null" json:"slug"`
+	Description          string         `gorm:"type:text" json:"description"`
+	Category             string         `gorm:"type:varchar(50);not null;index" json:"category"` // category slug
+	Location             string         `gorm:"not null" json:"location"`
+	Venue                string         `json:"venue"`
+	Currency             string         `gorm:"type:varchar(3);default:'USD'" json:"currency"`
+	StartTime            time.Time      `gorm:"not null;index" json:"start_time"`
+	EndTime              time.Time      `gorm:"not null" json:"end_time"`
+	BannerURL            string         `json:"banner_url"`
+	Status               EventStatus    `gorm:"type:varchar(20);default:'draft';index" json:"status"`
+	IsFeatured           bool           `gorm:"default:false" json:"is_featured"`
+	WaitingRoom          bool           `gorm:"default:false" json:"waiting_room"`  // purchases go through the virtual queue
+	AllowReentry         bool           `gorm:"default:false" json:"allow_reentry"` // tickets can be scanned out and back in
+	MaxOccupancy         int            `gorm:"default:0" json:"max_occupancy"`     // attendees allowed inside at once; 0 means no limit
+	TaxName              string         `json:"tax_name,omitempty"`
+	TaxBasisPoints       *int           `json:"tax_basis_points,omitempty"` // overrides the organizer's tax settings when set
+	TaxInclusive         bool           `gorm:"default:false" json:"tax_inclusive"`
+	RefundPolicy         RefundPolicy   `gorm:"type:varchar(20);default:'flexible'" json:"refund_policy"`
+	RefundDaysBefore     int            `gorm:"default:0" json:"refund_days_before,omitempty"`
+	RefundFeeBasisPoints int            `gorm:"default:0" json:"refund_fee_basis_points,omitempty"` // kept from attendee refunds
+	CreatedAt            time.Time      `json:"created_at"`
+	UpdatedAt            time.Time      `json:"updated_at"`
+	DeletedAt            gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Relationships
-	Organizer   Organizer    `gorm:"foreignKey:OrganizerID" json:"organizer,omitempty"`
-	TicketTiers []TicketTier `gorm:"foreignKey:EventID" json:"ticket_tiers,omitempty"`
-	Checkins    []Checkin    `gorm:"foreignKey:EventID" json:"-"`
+	Organizer   Organizer      `gorm:"foreignKey:OrganizerID" json:"organizer,omitempty"`
+	TicketTiers []TicketTier   `gorm:"foreignKey:EventID" json:"ticket_tiers,omitempty"`
+	Sessions    []EventSession `gorm:"foreignKey:EventID" json:"sessions,omitempty"`
+	Zones       []EventZone    `gorm:"foreignKey:EventID" json:"zones,omitempty"`
+	FormFields  []FormField    `gorm:"foreignKey:EventID" json:"form_fields,omitempty"`
+	AddOns      []AddOn        `gorm:"foreignKey:EventID" json:"add_ons,omitempty"`
+	Checkins    []Checkin      `gorm:"foreignKey:EventID" json:"-"`
 }
 
 // BeforeCreate sets the ID before creating
@@ -154,13 +231,255 @@ func (e *Event) IsActive() bool {
 	return e.Status == EventActive && now.After(e.StartTime) && now.Before(e.EndTime)
 }
 
+// RefundDeadline returns when attendee refunds close under the event's
+// refund policy, or false when the event offers none
+func (e *Event) RefundDeadline() (time.Time, bool) {
+	switch e.RefundPolicy {
+	case RefundPolicyNone:
+		return time.Time{}, false
+	case RefundPolicyDeadline:
+		return e.StartTime.AddDate(0, 0, -e.RefundDaysBefore), true
+	default:
+		return e.StartTime, true
+	}
+}
+
+// EventStatusChange records a transition in an event's lifecycle
+type EventStatusChange struct {
+	ID         uuid.UUID   `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID    uuid.UUID   `gorm:"type:uuid;not null;index" json:"event_id"`
+	FromStatus EventStatus `gorm:"type:varchar(20);not null" json:"from_status"`
+	ToStatus   EventStatus `gorm:"type:varchar(20);not null" json:"to_status"`
+	ActorID    uuid.UUID   `gorm:"type:uuid;not null" json:"actor_id"`
+	Reason     string      `gorm:"type:text" json:"reason,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+	Actor User  `gorm:"foreignKey:ActorID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (e *EventStatusChange) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// EventReschedule records a change to an event's dates. Holders of orders
+// placed before the change may ask for a refund until RefundDeadline.
+type EventReschedule struct {
+	ID                uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID           uuid.UUID `gorm:"type:uuid;not null;index" json:"event_id"`
+	PreviousStartTime time.Time `gorm:"not null" json:"previous_start_time"`
+	PreviousEndTime   time.Time `gorm:"not null" json:"previous_end_time"`
+	StartTime         time.Time `gorm:"not null" json:"start_time"`
+	EndTime           time.Time `gorm:"not null" json:"end_time"`
+	Reason            string    `gorm:"type:text" json:"reason,omitempty"`
+	RefundDeadline    time.Time `gorm:"not null" json:"refund_deadline"`
+	ActorID           uuid.UUID `gorm:"type:uuid;not null" json:"actor_id"`
+	CreatedAt         time.Time `json:"created_at"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+	Actor User  `gorm:"foreignKey:ActorID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (e *EventReschedule) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// Favorite is an event a user saved. The notified timestamps record which
+// alerts the user has had, so each is sent once.
+type Favorite struct {
+	UserID            uuid.UUID  `gorm:"type:uuid;primaryKey" json:"user_id"`
+	EventID           uuid.UUID  `gorm:"type:uuid;primaryKey;index" json:"event_id"`
+	PublishNotifiedAt *time.Time `json:"-"` // also set when the event was already published
+	OnSaleNotifiedAt  *time.Time `json:"-"`
+	CreatedAt         time.Time  `json:"created_at"`
+
+	// Relationships
+	User  User  `gorm:"foreignKey:UserID" json:"-"`
+	Event Event `gorm:"foreignKey:EventID" json:"event,omitempty"`
+}
+
+// ReportReason is why a user flagged an event
+type ReportReason string
+
+const (
+	ReportSpam       ReportReason = "spam"
+	ReportFraud      ReportReason = "fraud"
+	ReportMisleading ReportReason = "misleading"
+	ReportOffensive  ReportReason = "offensive"
+	ReportOther      ReportReason = "other"
+)
+
+// ReportStatus tracks an event report through moderation
+type ReportStatus string
+
+const (
+	ReportOpen      ReportStatus = "open"
+	ReportActioned  ReportStatus = "actioned" // the event was unpublished or banned
+	ReportDismissed ReportStatus = "dismissed"
+)
+
+// EventReport is a user's flag on an event for admins to review. A user
+// can report an event once.
+type EventReport struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID    uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_event_reports_event_reporter" json:"event_id"`
+	ReporterID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_event_reports_event_reporter" json:"reporter_id"`
+	Reason     ReportReason `gorm:"type:varchar(20);not null" json:"reason"`
+	Details    string       `gorm:"type:text" json:"details,omitempty"`
+	Status     ReportStatus `gorm:"type:varchar(20);default:'open';index" json:"status"`
+	ResolvedBy *uuid.UUID   `gorm:"type:uuid" json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time   `json:"resolved_at,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+
+	// Relationships
+	Event    Event `gorm:"foreignKey:EventID" json:"-"`
+	Reporter User  `gorm:"foreignKey:ReporterID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (r *EventReport) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// EventSession is one part of a multi-session event, such as a conference
+// talk or a festival day, that attendees check in to separately
+type EventSession struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"event_id"`
+	Title     string         `gorm:"not null" json:"title"`
+	StartTime time.Time      `gorm:"not null" json:"start_time"`
+	EndTime   time.Time      `gorm:"not null" json:"end_time"`
+	Capacity  int            `gorm:"not null;default:0" json:"capacity"` // 0 means no limit
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (s *EventSession) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// EventZone is an area of an event's venue, such as a VIP lounge or
+// backstage, with its own entrance. Only tiers that grant the zone get in.
+type EventZone struct {
+	ID           uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID      uuid.UUID      `gorm:"type:uuid;not null;index" json:"event_id"`
+	Name         string         `gorm:"type:varchar(100);not null" json:"name"`
+	MaxOccupancy int            `gorm:"default:0" json:"max_occupancy"` // attendees allowed inside at once; 0 means no limit
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (z *EventZone) BeforeCreate(tx *gorm.DB) error {
+	if z.ID == uuid.Nil {
+		z.ID = uuid.New()
+	}
+	return nil
+}
+
+// FormFieldType is the kind of answer a registration form field takes
+type FormFieldType string
+
+const (
+	FormFieldText     FormFieldType = "text"
+	FormFieldNumber   FormFieldType = "number"
+	FormFieldSelect   FormFieldType = "select"
+	FormFieldCheckbox FormFieldType = "checkbox"
+)
+
+// FormField is a custom question, such as a t-shirt size or dietary needs,
+// that buyers answer for each ticket when ordering for an event
+type FormField struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID   uuid.UUID      `gorm:"type:uuid;not null;index" json:"event_id"`
+	Label     string         `gorm:"not null" json:"label"`
+	Type      FormFieldType  `gorm:"type:varchar(20);not null;default:'text'" json:"type"`
+	Options   []string       `gorm:"type:jsonb;serializer:json" json:"options,omitempty"` // choices of a select field
+	Required  bool           `gorm:"default:false" json:"required"`
+	Position  int            `gorm:"not null;default:0" json:"position"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (f *FormField) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+	return nil
+}
+
+// AddOn is an extra, such as parking or merchandise, that buyers can add to
+// an order for an event's tickets
+type AddOn struct {
+	ID          uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EventID     uuid.UUID      `gorm:"type:uuid;not null;index" json:"event_id"`
+	Name        string         `gorm:"not null" json:"name"`
+	Description string         `gorm:"type:text" json:"description"`
+	Price       int64          `gorm:"not null" json:"price"` // minor units
+	Currency    string         `gorm:"default:'USD'" json:"currency"`
+	Quantity    int            `gorm:"not null;default:0" json:"quantity"` // 0 means unlimited
+	Sold        int            `gorm:"not null;default:0" json:"sold"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// Relationships
+	Event Event `gorm:"foreignKey:EventID" json:"-"`
+}
+
+// BeforeCreate sets the ID before creating
+func (a *AddOn) BeforeCreate(tx *gorm.DB) error {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// Remaining returns how many more of the add-on can be sold, or -1 when it is unlimited
+func (a *AddOn) Remaining() int {
+	if a.Quantity == 0 {
+		return -1
+	}
+	return a.Quantity - a.Sold
+}
+
 // TicketTier represents a ticket tier for an event
 type TicketTier struct {
 	ID                uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 	EventID           uuid.UUID      `gorm:"type:uuid;not null;index" json:"event_id"`
 	TierName          string         `gorm:"not null" json:"tier_name"`
 	Description       string         `gorm:"type:text" json:"description"`
-	Price             float64        `gorm:"not null" json:"price"`
+	Price             int64          `gorm:"not null" json:"price"` // minor units
 	Currency          string         `gorm:"default:'USD'" json:"currency"`
 	TotalQuantity     int            `gorm:"not null" json:"total_quantity"`
 	AvailableQuantity int            `gorm:"not null" json:"available_quantity"`
@@ -171,8 +490,11 @@ type TicketTier struct {
 	DeletedAt         gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// Relationships
-	Event   Event    `gorm:"foreignKey:EventID" json:"event,omitempty"`
-	Tickets []Ticket `gorm:"foreignKey:TierID" json:"-"`
+	Event       Event          `gorm:"foreignKey:EventID" json:"event,omitempty"`
+	Tickets     []Ticket       `gorm:"foreignKey:TierID" json:"-"`
+	Sessions    []EventSession `gorm:"many2many:ticket_tier_sessions" json:"sessions,omitempty"` // none grants every session
+	Zones       []EventZone    `gorm:"many2many:ticket_tier_zones" json:"zones,omitempty"`       // none grants the main gate only
+	PricePhases []PricePhase   `gorm:"foreignKey:TierID" json:"price_phases,omitempty"`
 }
 
 // BeforeCreate sets the ID before creating
@@ -206,3 +528,75 @@ func (t *TicketTier) IsAvailable() bool {
 func (TicketTier) TableName() string {
 	return "ticket_tiers"
 }
+
+// GrantsSession reports whether the tier's tickets admit to a session
+func (t *TicketTier) GrantsSession(sessionID uuid.UUID) bool {
+	if len(t.Sessions) == 0 {
+		return true
+	}
+	for _, session := range t.Sessions {
+		if session.ID == sessionID {
+			return true
+		}
+	}
+	return false
+}
+
+// GrantsZone reports whether the tier's tickets admit to a zone
+func (t *TicketTier) GrantsZone(zoneID uuid.UUID) bool {
+	for _, zone := range t.Zones {
+		if zone.ID == zoneID {
+			return true
+		}
+	}
+	return false
+}
+
+// CurrentPrice returns the price the tier sells at, at time now, and the
+// phase it comes from, or nil for the regular price. PricePhases must be
+// loaded in order.
+func (t *TicketTier) CurrentPrice(now time.Time) (int64, *PricePhase) {
+	sold := t.TotalQuantity - t.AvailableQuantity
+	for i := range t.PricePhases {
+		if t.PricePhases[i].Applies(now, sold) {
+			return t.PricePhases[i].Price, &t.PricePhases[i]
+		}
+	}
+	return t.Price, nil
+}
+
+// PricePhase is a limited-time or limited-quantity price for a ticket tier,
+// such as an early-bird price. A tier sells at its first applicable phase,
+// and at its regular price once none applies.
+type PricePhase struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	TierID        uuid.UUID  `gorm:"type:uuid;not null;index" json:"tier_id"`
+	Name          string     `gorm:"not null" json:"name"`
+	Price         int64      `gorm:"not null" json:"price"` // minor units
+	StartTime     *time.Time `json:"start_time,omitempty"`
+	EndTime       *time.Time `json:"end_time,omitempty"`
+	QuantityLimit int        `gorm:"not null;default:0" json:"quantity_limit"` // ends once the tier has sold this many; 0 means no limit
+	Position      int        `gorm:"not null;default:0" json:"position"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (p *PricePhase) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// Applies reports whether the phase is in effect at time now for a tier
+// that has sold sold tickets
+func (p *PricePhase) Applies(now time.Time, sold int) bool {
+	if p.StartTime != nil && now.Before(*p.StartTime) {
+		return false
+	}
+	if p.EndTime != nil && !now.Before(*p.EndTime) {
+		return false
+	}
+	return p.QuantityLimit == 0 || sold < p.QuantityLimit
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WarehouseExportStatus tracks a data warehouse export run
+type WarehouseExportStatus string
+
+const (
+	WarehouseExportRunning   WarehouseExportStatus = "running"
+	WarehouseExportCompleted WarehouseExportStatus = "completed"
+	WarehouseExportFailed    WarehouseExportStatus = "failed"
+)
+
+// WarehouseExport is one run exporting a UTC day of orders, tickets,
+// payments and check-ins to S3 for BI tooling
+type WarehouseExport struct {
+	ID          uuid.UUID             `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Date        time.Time             `gorm:"type:date;not null;index" json:"date"`
+	Status      WarehouseExportStatus `gorm:"type:varchar(20);not null;default:'running';index" json:"status"`
+	Error       string                `gorm:"type:text" json:"error,omitempty"`
+	RequestedBy *uuid.UUID            `gorm:"type:uuid" json:"requested_by,omitempty"` // nil for the nightly run
+	CreatedAt   time.Time             `json:"created_at"`
+	CompletedAt *time.Time            `json:"completed_at,omitempty"`
+
+	// Relationships
+	Files []WarehouseExportFile `gorm:"foreignKey:ExportID" json:"files,omitempty"`
+}
+
+// BeforeCreate sets the ID before creating
+func (e *WarehouseExport) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// WarehouseExportFile is the partition of one dataset written by an export
+type WarehouseExportFile struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ExportID  uuid.UUID `gorm:"type:uuid;not null;index" json:"export_id"`
+	Dataset   string    `gorm:"type:varchar(30);not null" json:"dataset"`
+	Key       string    `gorm:"not null" json:"key"`
+	Rows      int64     `json:"rows"`
+	Size      int64     `json:"size"` // bytes, compressed
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (f *WarehouseExportFile) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WebhookEventType names an event organizers can receive at their webhook endpoints
+type WebhookEventType string
+
+const (
+	WebhookTicketSold        WebhookEventType = "ticket.sold"         // an order was paid and its tickets issued
+	WebhookAttendeeCheckedIn WebhookEventType = "attendee.checked_in" // a ticket's first entry to its event
+	WebhookOrderRefunded     WebhookEventType = "order.refunded"      // a refund completed
+)
+
+// WebhookEventTypes lists every event type an endpoint may subscribe to
+var WebhookEventTypes = []WebhookEventType{WebhookTicketSold, WebhookAttendeeCheckedIn, WebhookOrderRefunded}
+
+// WebhookEndpoint is a URL an organizer receives signed event notifications at.
+// An endpoint with no events subscribes to all of them.
+type WebhookEndpoint struct {
+	ID          uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID uuid.UUID          `gorm:"type:uuid;not null;index" json:"organizer_id"`
+	URL         string             `gorm:"type:varchar(500);not null" json:"url"`
+	Description string             `gorm:"type:varchar(200)" json:"description,omitempty"`
+	Secret      string             `gorm:"type:varchar(64);not null" json:"-"` // signs deliveries
+	Events      []WebhookEventType `gorm:"type:jsonb;serializer:json" json:"events"`
+	Active      bool               `gorm:"default:true" json:"active"`
+	CreatedBy   uuid.UUID          `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (e *WebhookEndpoint) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// Subscribes reports whether the endpoint receives events of eventType
+func (e *WebhookEndpoint) Subscribes(eventType WebhookEventType) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, subscribed := range e.Events {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus tracks a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending" // waiting for its next attempt
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed" // gave up after the last attempt
+)
+
+// WebhookDelivery is one event sent to one endpoint, with the outcome of its
+// latest attempt kept for debugging
+type WebhookDelivery struct {
+	ID             uuid.UUID             `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EndpointID     uuid.UUID             `gorm:"type:uuid;not null;index" json:"endpoint_id"`
+	EventType      WebhookEventType      `gorm:"type:varchar(50);not null" json:"event_type"`
+	Payload        string                `gorm:"type:jsonb;not null" json:"payload"` // the exact body sent
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;default:'pending';index:idx_webhook_deliveries_due" json:"status"`
+	Attempts       int                   `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  *time.Time            `gorm:"index:idx_webhook_deliveries_due" json:"next_attempt_at,omitempty"`
+	ResponseStatus int                   `json:"response_status,omitempty"`
+	ResponseBody   string                `gorm:"type:text" json:"response_body,omitempty"` // truncated
+	Error          string                `gorm:"type:text" json:"error,omitempty"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time             `gorm:"index" json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (d *WebhookDelivery) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
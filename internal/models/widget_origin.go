@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WidgetOrigin is a site an organizer embeds the checkout widget on, such as
+// "https://tickets.example.com". Widget routes for the organizer's events
+// answer cross-origin requests from it.
+type WidgetOrigin struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizerID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_widget_origins_organizer_origin" json:"organizer_id"`
+	Origin      string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_widget_origins_organizer_origin;index" json:"origin"` // scheme, host and port, without a path
+	CreatedBy   uuid.UUID `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// BeforeCreate sets the ID before creating
+func (o *WidgetOrigin) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	return nil
+}
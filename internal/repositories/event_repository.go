@@ -0,0 +1,240 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// EventFilter narrows an event listing
+type EventFilter struct {
+	Category string
+	Status   string
+	Featured bool // only featured events that have not started
+	Offset   int
+	Limit    int
+}
+
+// EventRepository stores events and their ticket tiers
+type EventRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Event, error)
+	FindBySlug(ctx context.Context, slug string) (*models.Event, error)
+	List(ctx context.Context, filter EventFilter) ([]models.Event, int64, error)
+	ListAfter(ctx context.Context, filter EventFilter, after *utils.Cursor) ([]models.Event, error)
+	Recommended(ctx context.Context, userID uuid.UUID, limit int) ([]models.Event, error)
+	Popular(ctx context.Context, userID uuid.UUID, limit int, exclude []uuid.UUID) ([]models.Event, error)
+	ListUpcoming(ctx context.Context, ids []uuid.UUID) ([]models.Event, error)
+}
+
+type gormEventRepository struct {
+	db *gorm.DB
+}
+
+// NewEventRepository creates a GORM-backed event repository
+func NewEventRepository(db *gorm.DB) EventRepository {
+	return &gormEventRepository{db: db}
+}
+
+// preloadSchedule loads an event's ticket tiers, sessions and zones, along
+// with the sessions and zones each tier admits to and its price phases
+func preloadSchedule(query *gorm.DB) *gorm.DB {
+	return query.Preload("TicketTiers").
+		Preload("TicketTiers.Sessions").
+		Preload("TicketTiers.Zones").
+		Preload("TicketTiers.PricePhases", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Sessions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_time ASC")
+		}).
+		Preload("Zones", func(db *gorm.DB) *gorm.DB {
+			return db.Order("name ASC")
+		})
+}
+
+// FindByID returns an event with its ticket tiers and sessions
+func (r *gormEventRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Event, error) {
+	var event models.Event
+	if err := preloadSchedule(r.db.WithContext(ctx)).First(&event, id).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &event, nil
+}
+
+// FindBySlug returns the event published under slug with its ticket tiers and sessions
+func (r *gormEventRepository) FindBySlug(ctx context.Context, slug string) (*models.Event, error) {
+	var event models.Event
+	if err := preloadSchedule(r.db.WithContext(ctx)).Where("slug = ?", slug).First(&event).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &event, nil
+}
+
+// List returns a page of events with their ticket tiers, soonest first, and the total match count
+func (r *gormEventRepository) List(ctx context.Context, filter EventFilter) ([]models.Event, int64, error) {
+	query := r.filtered(ctx, filter)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var events []models.Event
+	if err := preloadSchedule(query).
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Order("start_time ASC").
+		Find(&events).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// ListAfter returns up to filter.Limit events sorted after the after cursor,
+// or from the start when it is nil, soonest first. Offset is ignored.
+func (r *gormEventRepository) ListAfter(ctx context.Context, filter EventFilter, after *utils.Cursor) ([]models.Event, error) {
+	query := r.filtered(ctx, filter)
+	if after != nil {
+		query = query.Where("(start_time, id) > (?, ?)", after.Time, after.ID)
+	}
+
+	var events []models.Event
+	if err := preloadSchedule(query).
+		Limit(filter.Limit).
+		Order("start_time ASC, id ASC").
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// recommendationQuery scores each upcoming published event by how many of
+// the user's paid orders were for the same organizer (weight 3), category (2)
+// or location (1). Events the user already bought for are left out.
+const recommendationQuery = `
+WITH purchased AS (
+	SELECT events.id, events.organizer_id, events.category, LOWER(events.location) AS location
+	FROM orders
+	JOIN ticket_tiers ON ticket_tiers.id = orders.tier_id
+	JOIN events ON events.id = ticket_tiers.event_id
+	WHERE orders.user_id = @user AND orders.status = @paid
+),
+organizers AS (SELECT organizer_id, COUNT(*) AS n FROM purchased GROUP BY organizer_id),
+categories AS (SELECT category, COUNT(*) AS n FROM purchased GROUP BY category),
+locations AS (SELECT location, COUNT(*) AS n FROM purchased GROUP BY location),
+scored AS (
+	SELECT events.id, events.is_featured, events.start_time,
+		3 * COALESCE(organizers.n, 0) + 2 * COALESCE(categories.n, 0) + COALESCE(locations.n, 0) AS score
+	FROM events
+	LEFT JOIN organizers ON organizers.organizer_id = events.organizer_id
+	LEFT JOIN categories ON categories.category = events.category
+	LEFT JOIN locations ON locations.location = LOWER(events.location)
+	WHERE events.status = @published AND events.start_time > @now AND events.deleted_at IS NULL
+		AND events.id NOT IN (SELECT id FROM purchased)
+)
+SELECT id FROM scored
+WHERE score > 0
+ORDER BY score DESC, is_featured DESC, start_time ASC
+LIMIT @limit`
+
+// Recommended returns up to limit upcoming events ranked for userID by its
+// past purchases, best match first. Users without purchases get none.
+func (r *gormEventRepository) Recommended(ctx context.Context, userID uuid.UUID, limit int) ([]models.Event, error) {
+	var ids []uuid.UUID
+	if err := database.Reader(r.db).WithContext(ctx).Raw(recommendationQuery, map[string]interface{}{
+		"user":      userID,
+		"paid":      models.OrderPaid,
+		"published": models.EventPublished,
+		"now":       time.Now(),
+		"limit":     limit,
+	}).Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+	return r.findOrdered(database.Reader(r.db).WithContext(ctx), ids)
+}
+
+// Popular returns up to limit upcoming published events, featured events
+// first and then by tickets sold. Events in exclude and events userID
+// already bought for are skipped.
+func (r *gormEventRepository) Popular(ctx context.Context, userID uuid.UUID, limit int, exclude []uuid.UUID) ([]models.Event, error) {
+	db := database.Reader(r.db).WithContext(ctx)
+	query := db.Model(&models.Event{}).
+		Select("events.id").
+		Joins("LEFT JOIN ticket_tiers ON ticket_tiers.event_id = events.id AND ticket_tiers.deleted_at IS NULL").
+		Where("events.status = ? AND events.start_time > ?", models.EventPublished, time.Now())
+	if len(exclude) > 0 {
+		query = query.Where("events.id NOT IN ?", exclude)
+	}
+	if userID != uuid.Nil {
+		query = query.Where("events.id NOT IN (?)", db.Table("orders").
+			Select("ticket_tiers.event_id").
+			Joins("JOIN ticket_tiers ON ticket_tiers.id = orders.tier_id").
+			Where("orders.user_id = ? AND orders.status = ?", userID, models.OrderPaid))
+	}
+
+	var ids []uuid.UUID
+	if err := query.
+		Group("events.id").
+		Order("events.is_featured DESC").
+		Order("COALESCE(SUM(ticket_tiers.total_quantity - ticket_tiers.available_quantity), 0) DESC").
+		Order("events.start_time ASC").
+		Limit(limit).
+		Pluck("events.id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return r.findOrdered(db, ids)
+}
+
+// ListUpcoming returns the events in ids that are published and have not
+// started, in the order of ids
+func (r *gormEventRepository) ListUpcoming(ctx context.Context, ids []uuid.UUID) ([]models.Event, error) {
+	query := database.Reader(r.db).WithContext(ctx).
+		Where("status = ? AND start_time > ?", models.EventPublished, time.Now())
+	return r.findOrdered(query, ids)
+}
+
+// findOrdered loads the events in ids matching query with their schedules,
+// in the order of ids
+func (r *gormEventRepository) findOrdered(query *gorm.DB, ids []uuid.UUID) ([]models.Event, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var found []models.Event
+	if err := preloadSchedule(query).Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]models.Event, len(found))
+	for _, event := range found {
+		byID[event.ID] = event
+	}
+	events := make([]models.Event, 0, len(found))
+	for _, id := range ids {
+		if event, ok := byID[id]; ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (r *gormEventRepository) filtered(ctx context.Context, filter EventFilter) *gorm.DB {
+	query := database.Reader(r.db).WithContext(ctx).Model(&models.Event{})
+	if filter.Category != "" {
+		query = query.Where("category = ?", filter.Category)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Featured {
+		query = query.Where("is_featured = ? AND start_time > ?", true, time.Now())
+	}
+	return query
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/utils"
+)
+
+// OrderRepository stores orders
+type OrderRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	FindDetail(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]models.Order, error)
+	ListByUserAfter(ctx context.Context, userID uuid.UUID, after *utils.Cursor, limit int) ([]models.Order, error)
+	Create(ctx context.Context, order *models.Order) error
+}
+
+type gormOrderRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderRepository creates a GORM-backed order repository
+func NewOrderRepository(db *gorm.DB) OrderRepository {
+	return &gormOrderRepository{db: db}
+}
+
+func (r *gormOrderRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	var order models.Order
+	if err := r.db.WithContext(ctx).First(&order, id).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &order, nil
+}
+
+// FindDetail returns an order with its line items, its tickets with their
+// tier and event, and its payment and refund history, oldest first
+func (r *gormOrderRepository) FindDetail(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	oldestFirst := func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }
+
+	var order models.Order
+	if err := r.db.WithContext(ctx).
+		Preload("Items", oldestFirst).
+		Preload("Tickets", oldestFirst).
+		Preload("Tickets.Tier.Event").
+		Preload("Payments", oldestFirst).
+		Preload("Refunds", oldestFirst).
+		First(&order, id).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &order, nil
+}
+
+// ListByUser returns a user's orders with their tickets, newest first
+func (r *gormOrderRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.Order, error) {
+	var orders []models.Order
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Preload("Tickets").
+		Order("created_at DESC").
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// ListByUserAfter returns up to limit of a user's orders sorted after the
+// after cursor, or from the newest when it is nil
+func (r *gormOrderRepository) ListByUserAfter(ctx context.Context, userID uuid.UUID, after *utils.Cursor, limit int) ([]models.Order, error) {
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.Time, after.ID)
+	}
+
+	var orders []models.Order
+	if err := query.Preload("Tickets").
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *gormOrderRepository) Create(ctx context.Context, order *models.Order) error {
+	return r.db.WithContext(ctx).Create(order).Error
+}
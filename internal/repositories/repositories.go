@@ -0,0 +1,38 @@
+// Package repositories provides data access behind interfaces so handlers and
+// services do not depend on the global database connection.
+package repositories
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ErrNotFound is returned when a lookup matches no record
+var ErrNotFound = errors.New("record not found")
+
+// Repositories bundles the repositories handed to handlers
+type Repositories struct {
+	Users   UserRepository
+	Events  EventRepository
+	Tickets TicketRepository
+	Orders  OrderRepository
+}
+
+// New creates GORM-backed repositories on db
+func New(db *gorm.DB) *Repositories {
+	return &Repositories{
+		Users:   NewUserRepository(db),
+		Events:  NewEventRepository(db),
+		Tickets: NewTicketRepository(db),
+		Orders:  NewOrderRepository(db),
+	}
+}
+
+// translate maps GORM's not-found error to ErrNotFound
+func translate(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,460 @@
+// Package repotest provides in-memory repositories, so handlers and services
+// that take their data access as repositories can be tested without a
+// database. Fill a Store with the records a test needs and hand its
+// Repositories to the code under test.
+package repotest
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/pkg/utils"
+)
+
+// Store holds the records the in-memory repositories serve. They are copied
+// in and out, so code under test only changes the store through the
+// repositories. Relationships the GORM repositories preload are filled in
+// from the other records of the store.
+type Store struct {
+	mu      sync.Mutex
+	users   map[uuid.UUID]models.User
+	events  map[uuid.UUID]models.Event
+	tickets map[uuid.UUID]models.Ticket
+	orders  map[uuid.UUID]models.Order
+}
+
+// New creates an empty store
+func New() *Store {
+	return &Store{
+		users:   make(map[uuid.UUID]models.User),
+		events:  make(map[uuid.UUID]models.Event),
+		tickets: make(map[uuid.UUID]models.Ticket),
+		orders:  make(map[uuid.UUID]models.Order),
+	}
+}
+
+// Repositories returns repositories backed by the store
+func (s *Store) Repositories() *repositories.Repositories {
+	return &repositories.Repositories{
+		Users:   &userRepository{s},
+		Events:  &eventRepository{s},
+		Tickets: &ticketRepository{s},
+		Orders:  &orderRepository{s},
+	}
+}
+
+// AddUser stores user, giving it an ID and creation time when it has none
+func (s *Store) AddUser(user *models.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stamp(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+	s.users[user.ID] = *user
+}
+
+// AddEvent stores event with its ticket tiers, giving them IDs and creation
+// times when they have none
+func (s *Store) AddEvent(event *models.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stamp(&event.ID, &event.CreatedAt, &event.UpdatedAt)
+	for i := range event.TicketTiers {
+		tier := &event.TicketTiers[i]
+		stamp(&tier.ID, &tier.CreatedAt, &tier.UpdatedAt)
+		tier.EventID = event.ID
+	}
+	s.events[event.ID] = *event
+}
+
+// AddTicket stores ticket, giving it an ID and creation time when it has none
+func (s *Store) AddTicket(ticket *models.Ticket) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stamp(&ticket.ID, &ticket.CreatedAt, &ticket.UpdatedAt)
+	s.tickets[ticket.ID] = *ticket
+}
+
+// AddOrder stores order, giving it an ID and creation time when it has none.
+// Its tickets are served from the tickets added for it.
+func (s *Store) AddOrder(order *models.Order) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stamp(&order.ID, &order.CreatedAt, &order.UpdatedAt)
+	s.orders[order.ID] = *order
+}
+
+// stamp sets an ID and creation time the way the database defaults do
+func stamp(id *uuid.UUID, createdAt, updatedAt *time.Time) {
+	if *id == uuid.Nil {
+		*id = uuid.New()
+	}
+	if createdAt.IsZero() {
+		*createdAt = time.Now()
+	}
+	if updatedAt.IsZero() {
+		*updatedAt = *createdAt
+	}
+}
+
+// tier finds a stored ticket tier with its event. The caller holds s.mu.
+func (s *Store) tier(id uuid.UUID) (models.TicketTier, bool) {
+	for _, event := range s.events {
+		for _, tier := range event.TicketTiers {
+			if tier.ID == id {
+				event.TicketTiers = nil
+				tier.Event = event
+				return tier, true
+			}
+		}
+	}
+	return models.TicketTier{}, false
+}
+
+// withTier fills in a ticket's tier and event. The caller holds s.mu.
+func (s *Store) withTier(ticket models.Ticket) models.Ticket {
+	if tier, ok := s.tier(ticket.TierID); ok {
+		ticket.Tier = tier
+	}
+	return ticket
+}
+
+// newestFirst sorts records by creation time and then ID, descending, the
+// order the keyset paginated listings use
+func newestFirst[T any](records []T, key func(T) (time.Time, uuid.UUID)) {
+	sort.Slice(records, func(i, j int) bool {
+		ti, idi := key(records[i])
+		tj, idj := key(records[j])
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return compareIDs(idi, idj) > 0
+	})
+}
+
+// before reports whether a record sorts after the cursor in a newest first
+// listing
+func before(after *utils.Cursor, t time.Time, id uuid.UUID) bool {
+	if after == nil {
+		return true
+	}
+	if !t.Equal(after.Time) {
+		return t.Before(after.Time)
+	}
+	return compareIDs(id, after.ID) < 0
+}
+
+// compareIDs orders UUIDs the way Postgres does, byte by byte
+func compareIDs(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// page returns at most limit records
+func page[T any](records []T, limit int) []T {
+	if limit >= 0 && len(records) > limit {
+		return records[:limit]
+	}
+	return records
+}
+
+type userRepository struct {
+	s *Store
+}
+
+func (r *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	user, ok := r.s.users[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	return &user, nil
+}
+
+func (r *userRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, user := range r.s.users {
+		if user.Email == email {
+			return &user, nil
+		}
+	}
+	return nil, repositories.ErrNotFound
+}
+
+func (r *userRepository) Create(ctx context.Context, user *models.User) error {
+	r.s.AddUser(user)
+	return nil
+}
+
+func (r *userRepository) Update(ctx context.Context, user *models.User) error {
+	user.UpdatedAt = time.Now()
+	r.s.AddUser(user)
+	return nil
+}
+
+type eventRepository struct {
+	s *Store
+}
+
+func (r *eventRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Event, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	event, ok := r.s.events[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	return &event, nil
+}
+
+func (r *eventRepository) FindBySlug(ctx context.Context, slug string) (*models.Event, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, event := range r.s.events {
+		if event.Slug == slug {
+			return &event, nil
+		}
+	}
+	return nil, repositories.ErrNotFound
+}
+
+// filtered returns the events matching filter, soonest first
+func (r *eventRepository) filtered(filter repositories.EventFilter) []models.Event {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := time.Now()
+	var events []models.Event
+	for _, event := range r.s.events {
+		if filter.Category != "" && event.Category != filter.Category {
+			continue
+		}
+		if filter.Status != "" && string(event.Status) != filter.Status {
+			continue
+		}
+		if filter.Featured && (!event.IsFeatured || !event.StartTime.After(now)) {
+			continue
+		}
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].StartTime.Equal(events[j].StartTime) {
+			return events[i].StartTime.Before(events[j].StartTime)
+		}
+		return compareIDs(events[i].ID, events[j].ID) < 0
+	})
+	return events
+}
+
+func (r *eventRepository) List(ctx context.Context, filter repositories.EventFilter) ([]models.Event, int64, error) {
+	events := r.filtered(filter)
+	total := int64(len(events))
+	if filter.Offset >= len(events) {
+		return nil, total, nil
+	}
+	return page(events[filter.Offset:], filter.Limit), total, nil
+}
+
+func (r *eventRepository) ListAfter(ctx context.Context, filter repositories.EventFilter, after *utils.Cursor) ([]models.Event, error) {
+	var events []models.Event
+	for _, event := range r.filtered(filter) {
+		if after != nil && (event.StartTime.Before(after.Time) ||
+			event.StartTime.Equal(after.Time) && compareIDs(event.ID, after.ID) <= 0) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return page(events, filter.Limit), nil
+}
+
+// Recommended ranks events by the user's past purchases, which the store
+// does not score, so it returns none, as for a user without purchases
+func (r *eventRepository) Recommended(ctx context.Context, userID uuid.UUID, limit int) ([]models.Event, error) {
+	return nil, nil
+}
+
+// Popular returns upcoming published events, featured first and then by
+// tickets sold, leaving out exclude and the events userID bought for
+func (r *eventRepository) Popular(ctx context.Context, userID uuid.UUID, limit int, exclude []uuid.UUID) ([]models.Event, error) {
+	skip := make(map[uuid.UUID]bool, len(exclude))
+	for _, id := range exclude {
+		skip[id] = true
+	}
+	r.s.mu.Lock()
+	for _, order := range r.s.orders {
+		if userID != uuid.Nil && order.UserID == userID && order.Status == models.OrderPaid {
+			if tier, ok := r.s.tier(order.TierID); ok {
+				skip[tier.EventID] = true
+			}
+		}
+	}
+	r.s.mu.Unlock()
+
+	now := time.Now()
+	var events []models.Event
+	for _, event := range r.filtered(repositories.EventFilter{Status: string(models.EventPublished)}) {
+		if event.StartTime.After(now) && !skip[event.ID] {
+			events = append(events, event)
+		}
+	}
+
+	sold := func(event models.Event) int {
+		n := 0
+		for _, tier := range event.TicketTiers {
+			n += tier.TotalQuantity - tier.AvailableQuantity
+		}
+		return n
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].IsFeatured != events[j].IsFeatured {
+			return events[i].IsFeatured
+		}
+		return sold(events[i]) > sold(events[j])
+	})
+	return page(events, limit), nil
+}
+
+func (r *eventRepository) ListUpcoming(ctx context.Context, ids []uuid.UUID) ([]models.Event, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	now := time.Now()
+	var events []models.Event
+	for _, id := range ids {
+		event, ok := r.s.events[id]
+		if ok && event.Status == models.EventPublished && event.StartTime.After(now) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+type ticketRepository struct {
+	s *Store
+}
+
+func (r *ticketRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Ticket, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	ticket, ok := r.s.tickets[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	ticket = r.s.withTier(ticket)
+	return &ticket, nil
+}
+
+func (r *ticketRepository) FindForOwner(ctx context.Context, id, ownerID uuid.UUID) (*models.Ticket, error) {
+	ticket, err := r.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.OwnerID != ownerID {
+		return nil, repositories.ErrNotFound
+	}
+	return ticket, nil
+}
+
+func (r *ticketRepository) FindDetailForOwner(ctx context.Context, id, ownerID uuid.UUID) (*models.Ticket, error) {
+	return r.FindForOwner(ctx, id, ownerID)
+}
+
+// list returns the tickets match selects, newest first, after the cursor
+func (r *ticketRepository) list(match func(models.Ticket) bool, after *utils.Cursor) []models.Ticket {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var tickets []models.Ticket
+	for _, ticket := range r.s.tickets {
+		if match(ticket) && before(after, ticket.CreatedAt, ticket.ID) {
+			tickets = append(tickets, r.s.withTier(ticket))
+		}
+	}
+	newestFirst(tickets, func(ticket models.Ticket) (time.Time, uuid.UUID) { return ticket.CreatedAt, ticket.ID })
+	return tickets
+}
+
+func (r *ticketRepository) ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]models.Ticket, error) {
+	return r.list(func(ticket models.Ticket) bool { return ticket.OwnerID == ownerID }, nil), nil
+}
+
+func (r *ticketRepository) ListByOwnerAfter(ctx context.Context, ownerID uuid.UUID, after *utils.Cursor, limit int) ([]models.Ticket, error) {
+	return page(r.list(func(ticket models.Ticket) bool { return ticket.OwnerID == ownerID }, after), limit), nil
+}
+
+func (r *ticketRepository) ListByOrderAfter(ctx context.Context, orderID uuid.UUID, after *utils.Cursor, limit int) ([]models.Ticket, error) {
+	return page(r.list(func(ticket models.Ticket) bool { return ticket.OrderID == orderID }, after), limit), nil
+}
+
+type orderRepository struct {
+	s *Store
+}
+
+// withTickets fills in an order's tickets, oldest first. The caller holds s.mu.
+func (r *orderRepository) withTickets(order models.Order) models.Order {
+	order.Tickets = nil
+	for _, ticket := range r.s.tickets {
+		if ticket.OrderID == order.ID {
+			order.Tickets = append(order.Tickets, r.s.withTier(ticket))
+		}
+	}
+	sort.Slice(order.Tickets, func(i, j int) bool {
+		return order.Tickets[i].CreatedAt.Before(order.Tickets[j].CreatedAt)
+	})
+	return order
+}
+
+func (r *orderRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	order, ok := r.s.orders[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	order.Tickets = nil
+	return &order, nil
+}
+
+func (r *orderRepository) FindDetail(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	order, ok := r.s.orders[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	order = r.withTickets(order)
+	return &order, nil
+}
+
+// list returns a user's orders with their tickets, newest first, after the cursor
+func (r *orderRepository) list(userID uuid.UUID, after *utils.Cursor) []models.Order {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var orders []models.Order
+	for _, order := range r.s.orders {
+		if order.UserID == userID && before(after, order.CreatedAt, order.ID) {
+			orders = append(orders, r.withTickets(order))
+		}
+	}
+	newestFirst(orders, func(order models.Order) (time.Time, uuid.UUID) { return order.CreatedAt, order.ID })
+	return orders
+}
+
+func (r *orderRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.Order, error) {
+	return r.list(userID, nil), nil
+}
+
+func (r *orderRepository) ListByUserAfter(ctx context.Context, userID uuid.UUID, after *utils.Cursor, limit int) ([]models.Order, error) {
+	return page(r.list(userID, after), limit), nil
+}
+
+func (r *orderRepository) Create(ctx context.Context, order *models.Order) error {
+	r.s.AddOrder(order)
+	return nil
+}
@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/utils"
+)
+
+// TicketRepository stores issued tickets
+type TicketRepository interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Ticket, error)
+	FindForOwner(ctx context.Context, id, ownerID uuid.UUID) (*models.Ticket, error)
+	FindDetailForOwner(ctx context.Context, id, ownerID uuid.UUID) (*models.Ticket, error)
+	ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]models.Ticket, error)
+	ListByOwnerAfter(ctx context.Context, ownerID uuid.UUID, after *utils.Cursor, limit int) ([]models.Ticket, error)
+	ListByOrderAfter(ctx context.Context, orderID uuid.UUID, after *utils.Cursor, limit int) ([]models.Ticket, error)
+}
+
+type gormTicketRepository struct {
+	db *gorm.DB
+}
+
+// NewTicketRepository creates a GORM-backed ticket repository
+func NewTicketRepository(db *gorm.DB) TicketRepository {
+	return &gormTicketRepository{db: db}
+}
+
+// FindByID returns a ticket with its tier, whoever owns it
+func (r *gormTicketRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Ticket, error) {
+	var ticket models.Ticket
+	if err := r.db.WithContext(ctx).Preload("Tier").First(&ticket, id).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &ticket, nil
+}
+
+// FindForOwner returns a ticket only if it belongs to ownerID
+func (r *gormTicketRepository) FindForOwner(ctx context.Context, id, ownerID uuid.UUID) (*models.Ticket, error) {
+	var ticket models.Ticket
+	if err := r.db.WithContext(ctx).Where("id = ? AND owner_id = ?", id, ownerID).First(&ticket).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &ticket, nil
+}
+
+// FindDetailForOwner returns a ticket with its tier, event and registration
+// answers only if it belongs to ownerID
+func (r *gormTicketRepository) FindDetailForOwner(ctx context.Context, id, ownerID uuid.UUID) (*models.Ticket, error) {
+	var ticket models.Ticket
+	if err := r.db.WithContext(ctx).Where("id = ? AND owner_id = ?", id, ownerID).
+		Preload("Tier").
+		Preload("Tier.Event").
+		Preload("Answers.FormField").
+		First(&ticket).Error; err != nil {
+		return nil, translate(err)
+	}
+	return &ticket, nil
+}
+
+// ListByOwner returns a user's tickets with their tier and event, newest first
+func (r *gormTicketRepository) ListByOwner(ctx context.Context, ownerID uuid.UUID) ([]models.Ticket, error) {
+	var tickets []models.Ticket
+	if err := r.db.WithContext(ctx).Where("owner_id = ?", ownerID).
+		Preload("Tier").
+		Preload("Tier.Event").
+		Order("created_at DESC").
+		Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// ListByOwnerAfter returns up to limit of a user's tickets sorted after the
+// after cursor, or from the newest when it is nil
+func (r *gormTicketRepository) ListByOwnerAfter(ctx context.Context, ownerID uuid.UUID, after *utils.Cursor, limit int) ([]models.Ticket, error) {
+	query := r.db.WithContext(ctx).Where("owner_id = ?", ownerID)
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.Time, after.ID)
+	}
+
+	var tickets []models.Ticket
+	if err := query.Preload("Tier").
+		Preload("Tier.Event").
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// ListByOrderAfter returns up to limit of an order's tickets with their tier
+// sorted after the after cursor, or from the newest when it is nil
+func (r *gormTicketRepository) ListByOrderAfter(ctx context.Context, orderID uuid.UUID, after *utils.Cursor, limit int) ([]models.Ticket, error) {
+	query := r.db.WithContext(ctx).Where("order_id = ?", orderID)
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.Time, after.ID)
+	}
+
+	var tickets []models.Ticket
+	if err := query.Preload("Tier").
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type contextKey string
+
+const callerKey contextKey = "rpc_caller"
+
+// apiKey authenticates one internal service
+type apiKey struct {
+	name string
+	key  []byte
+}
+
+// parseAPIKeys reads name:key pairs such as payments:s3cret
+func parseAPIKeys(pairs []string) ([]apiKey, error) {
+	keys := make([]apiKey, 0, len(pairs))
+	for i, pair := range pairs {
+		name, key, ok := strings.Cut(pair, ":")
+		if !ok || name == "" || key == "" {
+			return nil, fmt.Errorf("gRPC API key %d is not name:key", i+1)
+		}
+		keys = append(keys, apiKey{name: name, key: []byte(key)})
+	}
+	return keys, nil
+}
+
+// authInterceptor admits calls whose authorization metadata holds a bearer
+// API key and records which service made them. Health checks are open so
+// load balancers and orchestrators can probe the server.
+func authInterceptor(keys []apiKey) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isHealthCheck(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing API key")
+		}
+		token, ok := strings.CutPrefix(values[0], "Bearer ")
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "authorization must be a Bearer API key")
+		}
+
+		// Compare against every key so timing does not reveal which matched
+		caller := ""
+		for _, key := range keys {
+			if subtle.ConstantTimeCompare([]byte(token), key.key) == 1 {
+				caller = key.name
+			}
+		}
+		if caller == "" {
+			return nil, status.Error(codes.Unauthenticated, "invalid API key")
+		}
+
+		return handler(context.WithValue(ctx, callerKey, caller), req)
+	}
+}
+
+// callerFrom returns the name of the service that made a call
+func callerFrom(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey).(string)
+	return caller
+}
+
+func isHealthCheck(method string) bool {
+	return strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
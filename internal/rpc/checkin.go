@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/rpc/eventixv1"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+)
+
+type checkinServer struct {
+	eventixv1.UnimplementedCheckinServiceServer
+}
+
+func (s *checkinServer) CheckIn(ctx context.Context, req *eventixv1.CheckInRequest) (*eventixv1.Checkin, error) {
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, utils.BadRequestError("invalid event ID")
+	}
+	scannedBy, err := uuid.Parse(req.GetScannedBy())
+	if err != nil {
+		return nil, utils.BadRequestError("invalid scanned_by user ID")
+	}
+	if req.GetQrCode() == "" {
+		return nil, utils.BadRequestError("qr_code is required")
+	}
+
+	// Record which service made the scan when the caller names no device
+	device := req.GetDevice()
+	if device == "" {
+		device = "grpc:" + callerFrom(ctx)
+	}
+
+	tickets := services.NewTicketService()
+	ticket, err := tickets.ValidateTicketForCheckin(req.GetQrCode(), eventID)
+	if err != nil {
+		return nil, err
+	}
+	checkin, err := tickets.CheckInTicket(ctx, ticket, scannedBy, eventID, nil, models.CheckinQR, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventixv1.Checkin{
+		Id:           checkin.ID.String(),
+		TicketId:     ticket.ID.String(),
+		EventId:      eventID.String(),
+		TicketStatus: string(ticket.Status),
+		Method:       string(checkin.Method),
+		Direction:    string(checkin.Direction),
+		ScannedAt:    timestamppb.New(checkin.ScannedAt),
+	}, nil
+}
+
+func (s *checkinServer) GetEventStats(ctx context.Context, req *eventixv1.GetEventStatsRequest) (*eventixv1.EventStats, error) {
+	eventID, err := uuid.Parse(req.GetEventId())
+	if err != nil {
+		return nil, utils.BadRequestError("invalid event ID")
+	}
+	bucket := int(req.GetBucketMinutes())
+	if bucket < 1 || bucket > 60 {
+		bucket = 5
+	}
+
+	stats, err := services.NewCheckinService().GetEventStats(eventID, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &eventixv1.EventStats{
+		EventId:       stats.EventID.String(),
+		TotalTickets:  stats.TotalTickets,
+		CheckedIn:     stats.CheckedIn,
+		Remaining:     stats.Remaining,
+		BucketMinutes: int32(stats.BucketMinutes),
+		GeneratedAt:   timestamppb.New(stats.GeneratedAt),
+	}
+	for _, tier := range stats.Tiers {
+		resp.Tiers = append(resp.Tiers, &eventixv1.TierStats{
+			TierId:    tier.TierID.String(),
+			Name:      tier.TierName,
+			Total:     tier.Total,
+			CheckedIn: tier.CheckedIn,
+		})
+	}
+	for _, bucket := range stats.Rate {
+		resp.Rate = append(resp.Rate, &eventixv1.RateBucket{
+			BucketStart: timestamppb.New(bucket.BucketStart),
+			Count:       bucket.Count,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// statusCodes maps the HTTP statuses of AppErrors onto gRPC codes
+var statusCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.FailedPrecondition,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+}
+
+// toStatus turns a service error into a gRPC status. AppErrors keep their
+// message; anything else is logged and reported as an internal error.
+func toStatus(ctx context.Context, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, repositories.ErrNotFound) {
+		return status.Error(codes.NotFound, "not found")
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+
+	var appErr *utils.AppError
+	if errors.As(err, &appErr) {
+		if code, ok := statusCodes[appErr.Status]; ok {
+			return status.Error(code, appErr.Message)
+		}
+	}
+
+	logger.Error("gRPC call failed",
+		zap.String("method", method),
+		zap.String("caller", callerFrom(ctx)),
+		zap.Error(err),
+	)
+	return status.Error(codes.Internal, "an internal error occurred")
+}
+
+// logInterceptor logs each call but health checks with its outcome and
+// converts service errors into gRPC statuses
+func logInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if isHealthCheck(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	if err != nil {
+		err = toStatus(ctx, info.FullMethod, err)
+	}
+
+	logger.Info("gRPC call",
+		zap.String("method", info.FullMethod),
+		zap.String("caller", callerFrom(ctx)),
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return resp, err
+}
+
+// recoverInterceptor turns a panicking handler into an internal error
+func recoverInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error("gRPC handler panicked", zap.String("method", info.FullMethod), zap.Any("panic", p))
+			err = status.Error(codes.Internal, "an internal error occurred")
+		}
+	}()
+	return handler(ctx, req)
+}
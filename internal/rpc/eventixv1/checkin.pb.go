@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: eventix/v1/checkin.proto
+
+package eventixv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QrCode        string                 `protobuf:"bytes,1,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ScannedBy     string                 `protobuf:"bytes,3,opt,name=scanned_by,json=scannedBy,proto3" json:"scanned_by,omitempty"` // ID of the user the scan is recorded against
+	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_eventix_v1_checkin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventix_v1_checkin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_eventix_v1_checkin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CheckInRequest) GetQrCode() string {
+	if x != nil {
+		return x.QrCode
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetScannedBy() string {
+	if x != nil {
+		return x.ScannedBy
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type Checkin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId      string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketStatus  string                 `protobuf:"bytes,4,opt,name=ticket_status,json=ticketStatus,proto3" json:"ticket_status,omitempty"`
+	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Direction     string                 `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Checkin) Reset() {
+	*x = Checkin{}
+	mi := &file_eventix_v1_checkin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Checkin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checkin) ProtoMessage() {}
+
+func (x *Checkin) ProtoReflect() protoreflect.Message {
+	mi := &file_eventix_v1_checkin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checkin.ProtoReflect.Descriptor instead.
+func (*Checkin) Descriptor() ([]byte, []int) {
+	return file_eventix_v1_checkin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Checkin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Checkin) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Checkin) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Checkin) GetTicketStatus() string {
+	if x != nil {
+		return x.TicketStatus
+	}
+	return ""
+}
+
+func (x *Checkin) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Checkin) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Checkin) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+type GetEventStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	BucketMinutes int32                  `protobuf:"varint,2,opt,name=bucket_minutes,json=bucketMinutes,proto3" json:"bucket_minutes,omitempty"` // size of the rate buckets, 1-60, 5 by default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventStatsRequest) Reset() {
+	*x = GetEventStatsRequest{}
+	mi := &file_eventix_v1_checkin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventStatsRequest) ProtoMessage() {}
+
+func (x *GetEventStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventix_v1_checkin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventStatsRequest) Descriptor() ([]byte, []int) {
+	return file_eventix_v1_checkin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetEventStatsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetEventStatsRequest) GetBucketMinutes() int32 {
+	if x != nil {
+		return x.BucketMinutes
+	}
+	return 0
+}
+
+type EventStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TotalTickets  int64                  `protobuf:"varint,2,opt,name=total_tickets,json=totalTickets,proto3" json:"total_tickets,omitempty"`
+	CheckedIn     int64                  `protobuf:"varint,3,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	Remaining     int64                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Tiers         []*TierStats           `protobuf:"bytes,5,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	BucketMinutes int32                  `protobuf:"varint,6,opt,name=bucket_minutes,json=bucketMinutes,proto3" json:"bucket_minutes,omitempty"`
+	Rate          []*RateBucket          `protobuf:"bytes,7,rep,name=rate,proto3" json:"rate,omitempty"` // check-ins per bucket, oldest first
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventStats) Reset() {
+	*x = EventStats{}
+	mi := &file_eventix_v1_checkin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStats) ProtoMessage() {}
+
+func (x *EventStats) ProtoReflect() protoreflect.Message {
+	mi := &file_eventix_v1_checkin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStats.ProtoReflect.Descriptor instead.
+func (*EventStats) Descriptor() ([]byte, []int) {
+	return file_eventix_v1_checkin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventStats) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventStats) GetTotalTickets() int64 {
+	if x != nil {
+		return x.TotalTickets
+	}
+	return 0
+}
+
+func (x *EventStats) GetCheckedIn() int64 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+func (x *EventStats) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *EventStats) GetTiers() []*TierStats {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *EventStats) GetBucketMinutes() int32 {
+	if x != nil {
+		return x.BucketMinutes
+	}
+	return 0
+}
+
+func (x *EventStats) GetRate() []*RateBucket {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *EventStats) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type TierStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TierId        string                 `protobuf:"bytes,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	CheckedIn     int64                  `protobuf:"varint,4,opt,name=checked_in,json=checkedIn,proto3" json:"checked_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TierStats) Reset() {
+	*x = TierStats{}
+	mi := &file_eventix_v1_checkin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TierStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TierStats) ProtoMessage() {}
+
+func (x *TierStats) ProtoReflect() protoreflect.Message {
+	mi := &file_eventix_v1_checkin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TierStats.ProtoReflect.Descriptor instead.
+func (*TierStats) Descriptor() ([]byte, []int) {
+	return file_eventix_v1_checkin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TierStats) GetTierId() string {
+	if x != nil {
+		return x.TierId
+	}
+	return ""
+}
+
+func (x *TierStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TierStats) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TierStats) GetCheckedIn() int64 {
+	if x != nil {
+		return x.CheckedIn
+	}
+	return 0
+}
+
+type RateBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateBucket) Reset() {
+	*x = RateBucket{}
+	mi := &file_eventix_v1_checkin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateBucket) ProtoMessage() {}
+
+func (x *RateBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_eventix_v1_checkin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateBucket.ProtoReflect.Descriptor instead.
+func (*RateBucket) Descriptor() ([]byte, []int) {
+	return file_eventix_v1_checkin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RateBucket) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *RateBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_eventix_v1_checkin_proto protoreflect.FileDescriptor
+
+const file_eventix_v1_checkin_proto_rawDesc = "" +
+	"\n" +
+	"\x18eventix/v1/checkin.proto\x12\n" +
+	"eventix.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"{\n" +
+	"\x0eCheckInRequest\x12\x17\n" +
+	"\aqr_code\x18\x01 \x01(\tR\x06qrCode\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"scanned_by\x18\x03 \x01(\tR\tscannedBy\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\"\xe7\x01\n" +
+	"\aCheckin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12#\n" +
+	"\rticket_status\x18\x04 \x01(\tR\fticketStatus\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x1c\n" +
+	"\tdirection\x18\x06 \x01(\tR\tdirection\x129\n" +
+	"\n" +
+	"scanned_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"X\n" +
+	"\x14GetEventStatsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0ebucket_minutes\x18\x02 \x01(\x05R\rbucketMinutes\"\xc8\x02\n" +
+	"\n" +
+	"EventStats\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12#\n" +
+	"\rtotal_tickets\x18\x02 \x01(\x03R\ftotalTickets\x12\x1d\n" +
+	"\n" +
+	"checked_in\x18\x03 \x01(\x03R\tcheckedIn\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x03R\tremaining\x12+\n" +
+	"\x05tiers\x18\x05 \x03(\v2\x15.eventix.v1.TierStatsR\x05tiers\x12%\n" +
+	"\x0ebucket_minutes\x18\x06 \x01(\x05R\rbucketMinutes\x12*\n" +
+	"\x04rate\x18\a \x03(\v2\x16.eventix.v1.RateBucketR\x04rate\x12=\n" +
+	"\fgenerated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"m\n" +
+	"\tTierStats\x12\x17\n" +
+	"\atier_id\x18\x01 \x01(\tR\x06tierId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x1d\n" +
+	"\n" +
+	"checked_in\x18\x04 \x01(\x03R\tcheckedIn\"a\n" +
+	"\n" +
+	"RateBucket\x12=\n" +
+	"\fbucket_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vbucketStart\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count2\x97\x01\n" +
+	"\x0eCheckinService\x12:\n" +
+	"\aCheckIn\x12\x1a.eventix.v1.CheckInRequest\x1a\x13.eventix.v1.Checkin\x12I\n" +
+	"\rGetEventStats\x12 .eventix.v1.GetEventStatsRequest\x1a\x16.eventix.v1.EventStatsB.Z,eventix-api/internal/rpc/eventixv1;eventixv1b\x06proto3"
+
+var (
+	file_eventix_v1_checkin_proto_rawDescOnce sync.Once
+	file_eventix_v1_checkin_proto_rawDescData []byte
+)
+
+func file_eventix_v1_checkin_proto_rawDescGZIP() []byte {
+	file_eventix_v1_checkin_proto_rawDescOnce.Do(func() {
+		file_eventix_v1_checkin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_eventix_v1_checkin_proto_rawDesc), len(file_eventix_v1_checkin_proto_rawDesc)))
+	})
+	return file_eventix_v1_checkin_proto_rawDescData
+}
+
+var file_eventix_v1_checkin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_eventix_v1_checkin_proto_goTypes = []any{
+	(*CheckInRequest)(nil),        // 0: eventix.v1.CheckInRequest
+	(*Checkin)(nil),               // 1: eventix.v1.Checkin
+	(*GetEventStatsRequest)(nil),  // 2: eventix.v1.GetEventStatsRequest
+	(*EventStats)(nil),            // 3: eventix.v1.EventStats
+	(*TierStats)(nil),             // 4: eventix.v1.TierStats
+	(*RateBucket)(nil),            // 5: eventix.v1.RateBucket
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_eventix_v1_checkin_proto_depIdxs = []int32{
+	6, // 0: eventix.v1.Checkin.scanned_at:type_name -> google.protobuf.Timestamp
+	4, // 1: eventix.v1.EventStats.tiers:type_name -> eventix.v1.TierStats
+	5, // 2: eventix.v1.EventStats.rate:type_name -> eventix.v1.RateBucket
+	6, // 3: eventix.v1.EventStats.generated_at:type_name -> google.protobuf.Timestamp
+	6, // 4: eventix.v1.RateBucket.bucket_start:type_name -> google.protobuf.Timestamp
+	0, // 5: eventix.v1.CheckinService.CheckIn:input_type -> eventix.v1.CheckInRequest
+	2, // 6: eventix.v1.CheckinService.GetEventStats:input_type -> eventix.v1.GetEventStatsRequest
+	1, // 7: eventix.v1.CheckinService.CheckIn:output_type -> eventix.v1.Checkin
+	3, // 8: eventix.v1.CheckinService.GetEventStats:output_type -> eventix.v1.EventStats
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_eventix_v1_checkin_proto_init() }
+func file_eventix_v1_checkin_proto_init() {
+	if File_eventix_v1_checkin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eventix_v1_checkin_proto_rawDesc), len(file_eventix_v1_checkin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_eventix_v1_checkin_proto_goTypes,
+		DependencyIndexes: file_eventix_v1_checkin_proto_depIdxs,
+		MessageInfos:      file_eventix_v1_checkin_proto_msgTypes,
+	}.Build()
+	File_eventix_v1_checkin_proto = out.File
+	file_eventix_v1_checkin_proto_goTypes = nil
+	file_eventix_v1_checkin_proto_depIdxs = nil
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: eventix/v1/checkin.proto
+
+package eventixv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CheckinService_CheckIn_FullMethodName       = "/eventix.v1.CheckinService/CheckIn"
+	CheckinService_GetEventStats_FullMethodName = "/eventix.v1.CheckinService/GetEventStats"
+)
+
+// CheckinServiceClient is the client API for CheckinService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CheckinService admits tickets to events and reports attendance
+type CheckinServiceClient interface {
+	// CheckIn validates a ticket's QR code and checks it in at the main gate
+	// of its event, as a scan at the door would
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Checkin, error)
+	// GetEventStats returns an event's live attendance
+	GetEventStats(ctx context.Context, in *GetEventStatsRequest, opts ...grpc.CallOption) (*EventStats, error)
+}
+
+type checkinServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCheckinServiceClient(cc grpc.ClientConnInterface) CheckinServiceClient {
+	return &checkinServiceClient{cc}
+}
+
+func (c *checkinServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*Checkin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Checkin)
+	err := c.cc.Invoke(ctx, CheckinService_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkinServiceClient) GetEventStats(ctx context.Context, in *GetEventStatsRequest, opts ...grpc.CallOption) (*EventStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventStats)
+	err := c.cc.Invoke(ctx, CheckinService_GetEventStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CheckinServiceServer is the server API for CheckinService service.
+// All implementations must embed UnimplementedCheckinServiceServer
+// for forward compatibility.
+//
+// CheckinService admits tickets to events and reports attendance
+type CheckinServiceServer interface {
+	// CheckIn validates a ticket's QR code and checks it in at the main gate
+	// of its event, as a scan at the door would
+	CheckIn(context.Context, *CheckInRequest) (*Checkin, error)
+	// GetEventStats returns an event's live attendance
+	GetEventStats(context.Context, *GetEventStatsRequest) (*EventStats, error)
+	mustEmbedUnimplementedCheckinServiceServer()
+}
+
+// UnimplementedCheckinServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCheckinServiceServer struct{}
+
+func (UnimplementedCheckinServiceServer) CheckIn(context.Context, *CheckInRequest) (*Checkin, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedCheckinServiceServer) GetEventStats(context.Context, *GetEventStatsRequest) (*EventStats, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEventStats not implemented")
+}
+func (UnimplementedCheckinServiceServer) mustEmbedUnimplementedCheckinServiceServer() {}
+func (UnimplementedCheckinServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCheckinServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CheckinServiceServer will
+// result in compilation errors.
+type UnsafeCheckinServiceServer interface {
+	mustEmbedUnimplementedCheckinServiceServer()
+}
+
+func RegisterCheckinServiceServer(s grpc.ServiceRegistrar, srv CheckinServiceServer) {
+	// If the following call panics, it indicates UnimplementedCheckinServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CheckinService_ServiceDesc, srv)
+}
+
+func _CheckinService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckinServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckinService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckinServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckinService_GetEventStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckinServiceServer).GetEventStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckinService_GetEventStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckinServiceServer).GetEventStats(ctx, req.(*GetEventStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CheckinService_ServiceDesc is the grpc.ServiceDesc for CheckinService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CheckinService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eventix.v1.CheckinService",
+	HandlerType: (*CheckinServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIn",
+			Handler:    _CheckinService_CheckIn_Handler,
+		},
+		{
+			MethodName: "GetEventStats",
+			Handler:    _CheckinService_GetEventStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eventix/v1/checkin.proto",
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/rpc/eventixv1"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/utils"
+)
+
+type orderServer struct {
+	eventixv1.UnimplementedOrderServiceServer
+	repos *repositories.Repositories
+}
+
+func toOrder(order *models.Order) *eventixv1.Order {
+	message := &eventixv1.Order{
+		Id:              order.ID.String(),
+		UserId:          order.UserID.String(),
+		Quantity:        int32(order.Quantity),
+		UnitPrice:       order.UnitPrice,
+		TotalAmount:     order.TotalAmount,
+		TaxAmount:       order.TaxAmount,
+		PlatformFee:     order.PlatformFee,
+		Currency:        order.Currency,
+		Status:          string(order.Status),
+		PaymentProvider: string(order.PaymentProvider),
+		IsComp:          order.IsComp,
+		TicketIds:       make([]string, len(order.Tickets)),
+		CreatedAt:       timestamppb.New(order.CreatedAt),
+		UpdatedAt:       timestamppb.New(order.UpdatedAt),
+	}
+	if order.TierID != uuid.Nil {
+		message.TierId = order.TierID.String()
+	}
+	for i, ticket := range order.Tickets {
+		message.TicketIds[i] = ticket.ID.String()
+	}
+	return message
+}
+
+func (s *orderServer) GetOrder(ctx context.Context, req *eventixv1.GetOrderRequest) (*eventixv1.Order, error) {
+	orderID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, utils.BadRequestError("invalid order ID")
+	}
+
+	order, err := s.repos.Orders.FindDetail(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return toOrder(order), nil
+}
+
+func (s *orderServer) ListOrders(ctx context.Context, req *eventixv1.ListOrdersRequest) (*eventixv1.ListOrdersResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, utils.BadRequestError("invalid user ID")
+	}
+	after, err := pageAfter(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	limit := pageSize(req.GetPageSize())
+
+	// Fetch one extra row to learn whether another page follows
+	orders, err := s.repos.Orders.ListByUserAfter(ctx, userID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &eventixv1.ListOrdersResponse{}
+	if len(orders) > limit {
+		orders = orders[:limit]
+		last := orders[limit-1]
+		resp.NextPageToken = utils.CursorEncode(last.CreatedAt, last.ID)
+	}
+	for i := range orders {
+		resp.Orders = append(resp.Orders, toOrder(&orders[i]))
+	}
+	return resp, nil
+}
+
+func (s *orderServer) CancelOrder(ctx context.Context, req *eventixv1.CancelOrderRequest) (*eventixv1.Order, error) {
+	orderID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, utils.BadRequestError("invalid order ID")
+	}
+
+	if _, err := s.repos.Orders.FindByID(ctx, orderID); err != nil {
+		return nil, err
+	}
+	if _, err := services.NewOrderService(services.NewTicketService(), services.NewOrderStore()).CancelOrder(orderID); err != nil {
+		return nil, err
+	}
+
+	order, err := s.repos.Orders.FindDetail(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return toOrder(order), nil
+}
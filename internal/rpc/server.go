@@ -0,0 +1,71 @@
+// Package rpc serves the internal gRPC API that other Eventix services use
+// to read tickets and orders and to check tickets in, without going through
+// the public HTTP API. The protobuf definitions live in proto/eventix/v1 and
+// the generated code in eventixv1.
+package rpc
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/rpc/eventixv1"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// NewServer creates a gRPC server with the ticket, order and check-in
+// services and the standard health service. Every call except health checks
+// must carry one of the configured API keys.
+func NewServer(cfg *config.GRPCConfig, repos *repositories.Repositories) (*grpc.Server, error) {
+	keys, err := parseAPIKeys(cfg.APIKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no gRPC API keys configured")
+	}
+
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		recoverInterceptor,
+		authInterceptor(keys),
+		logInterceptor,
+	))
+	eventixv1.RegisterTicketServiceServer(srv, &ticketServer{repos: repos})
+	eventixv1.RegisterOrderServiceServer(srv, &orderServer{repos: repos})
+	eventixv1.RegisterCheckinServiceServer(srv, &checkinServer{})
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	return srv, nil
+}
+
+// pageSize clamps a requested page size
+func pageSize(size int32) int {
+	if size < 1 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
+
+// pageAfter decodes a page token, which is a keyset cursor as used by the
+// HTTP API. An empty token starts from the first page.
+func pageAfter(token string) (*utils.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	cursor, err := utils.CursorDecode(token)
+	if err != nil {
+		return nil, utils.BadRequestError("invalid page token")
+	}
+	return cursor, nil
+}
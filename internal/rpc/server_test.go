@@ -0,0 +1,205 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories/repotest"
+	"eventix-api/internal/rpc/eventixv1"
+	"eventix-api/pkg/config"
+)
+
+const testAPIKey = "s3cret"
+
+// dial serves NewServer on the in-memory repositories of store and returns
+// a connection to it
+func dial(t *testing.T, store *repotest.Store) *grpc.ClientConn {
+	t.Helper()
+
+	srv, err := NewServer(&config.GRPCConfig{APIKeys: []string{"tests:" + testAPIKey}}, store.Repositories())
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+	listener := bufconn.Listen(1024 * 1024)
+	go srv.Serve(listener)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// authorized returns a context carrying key as the caller's API key
+func authorized(key string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+key)
+}
+
+func expectCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != code {
+		t.Errorf("code = %s (%v), want %s", got, err, code)
+	}
+}
+
+// addOrder stores a paid order of quantity tickets of a new event's tier,
+// owned by userID and created at createdAt
+func addOrder(store *repotest.Store, userID uuid.UUID, quantity int, createdAt time.Time) *models.Order {
+	event := &models.Event{
+		Title:     "Rooftop Jazz",
+		Slug:      "rooftop-jazz-" + uuid.NewString(),
+		Currency:  "NGN",
+		StartTime: createdAt.Add(24 * time.Hour),
+		EndTime:   createdAt.Add(27 * time.Hour),
+		Status:    models.EventPublished,
+		TicketTiers: []models.TicketTier{{
+			TierName:      "General Admission",
+			Price:         500000,
+			Currency:      "NGN",
+			TotalQuantity: 100,
+		}},
+	}
+	store.AddEvent(event)
+	tier := &event.TicketTiers[0]
+
+	order := &models.Order{
+		UserID:          userID,
+		TierID:          tier.ID,
+		Quantity:        quantity,
+		UnitPrice:       tier.Price,
+		TotalAmount:     tier.Price * int64(quantity),
+		Currency:        tier.Currency,
+		PaymentProvider: models.ProviderPaystack,
+		Status:          models.OrderPaid,
+		CreatedAt:       createdAt,
+	}
+	store.AddOrder(order)
+	for i := 0; i < quantity; i++ {
+		store.AddTicket(&models.Ticket{
+			OrderID:   order.ID,
+			TierID:    tier.ID,
+			OwnerID:   userID,
+			QRCode:    fmt.Sprintf("qr-%s-%d", order.ID, i),
+			Status:    models.TicketActive,
+			CreatedAt: createdAt.Add(time.Duration(i) * time.Second),
+		})
+	}
+	return order
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	orders := eventixv1.NewOrderServiceClient(dial(t, repotest.New()))
+	req := &eventixv1.GetOrderRequest{Id: uuid.NewString()}
+
+	_, err := orders.GetOrder(context.Background(), req)
+	expectCode(t, err, codes.Unauthenticated)
+
+	_, err = orders.GetOrder(authorized("wrong"), req)
+	expectCode(t, err, codes.Unauthenticated)
+
+	// A known key gets through to the service
+	_, err = orders.GetOrder(authorized(testAPIKey), req)
+	expectCode(t, err, codes.NotFound)
+}
+
+func TestGetOrder(t *testing.T) {
+	store := repotest.New()
+	order := addOrder(store, uuid.New(), 2, time.Now())
+	orders := eventixv1.NewOrderServiceClient(dial(t, store))
+	ctx := authorized(testAPIKey)
+
+	got, err := orders.GetOrder(ctx, &eventixv1.GetOrderRequest{Id: order.ID.String()})
+	if err != nil {
+		t.Fatalf("get order: %v", err)
+	}
+	if got.GetId() != order.ID.String() || got.GetStatus() != string(models.OrderPaid) {
+		t.Errorf("order = %s %s, want %s %s", got.GetId(), got.GetStatus(), order.ID, models.OrderPaid)
+	}
+	if len(got.GetTicketIds()) != 2 {
+		t.Errorf("order has %d tickets, want 2", len(got.GetTicketIds()))
+	}
+
+	_, err = orders.GetOrder(ctx, &eventixv1.GetOrderRequest{Id: uuid.NewString()})
+	expectCode(t, err, codes.NotFound)
+
+	_, err = orders.GetOrder(ctx, &eventixv1.GetOrderRequest{Id: "not-an-id"})
+	expectCode(t, err, codes.InvalidArgument)
+}
+
+func TestListTicketsPages(t *testing.T) {
+	store := repotest.New()
+	owner := uuid.New()
+	now := time.Now()
+	addOrder(store, owner, 3, now.Add(-time.Hour))
+	addOrder(store, owner, 2, now)
+	addOrder(store, uuid.New(), 4, now)
+	tickets := eventixv1.NewTicketServiceClient(dial(t, store))
+	ctx := authorized(testAPIKey)
+
+	// Walk the owner's tickets two at a time, newest first
+	var seen []*eventixv1.Ticket
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages == 5 {
+			t.Fatal("paging does not end")
+		}
+		resp, err := tickets.ListTickets(ctx, &eventixv1.ListTicketsRequest{
+			OwnerId:   owner.String(),
+			PageSize:  2,
+			PageToken: token,
+		})
+		if err != nil {
+			t.Fatalf("list tickets: %v", err)
+		}
+		if len(resp.GetTickets()) > 2 {
+			t.Fatalf("page of %d tickets, want at most 2", len(resp.GetTickets()))
+		}
+		seen = append(seen, resp.GetTickets()...)
+		if token = resp.GetNextPageToken(); token == "" {
+			break
+		}
+	}
+
+	if len(seen) != 5 {
+		t.Fatalf("listed %d tickets, want the owner's 5", len(seen))
+	}
+	ids := make(map[string]bool)
+	for i, ticket := range seen {
+		if ticket.GetOwnerId() != owner.String() {
+			t.Errorf("ticket %s belongs to %s", ticket.GetId(), ticket.GetOwnerId())
+		}
+		if ids[ticket.GetId()] {
+			t.Errorf("ticket %s listed twice", ticket.GetId())
+		}
+		ids[ticket.GetId()] = true
+		if i > 0 && ticket.GetCreatedAt().AsTime().After(seen[i-1].GetCreatedAt().AsTime()) {
+			t.Errorf("ticket %d is newer than the one before it", i)
+		}
+	}
+
+	_, err := tickets.ListTickets(ctx, &eventixv1.ListTicketsRequest{OwnerId: owner.String(), PageToken: "garbage"})
+	expectCode(t, err, codes.InvalidArgument)
+
+	_, err = tickets.ListTickets(ctx, &eventixv1.ListTicketsRequest{})
+	expectCode(t, err, codes.InvalidArgument)
+}
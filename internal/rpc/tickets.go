@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/repositories"
+	"eventix-api/internal/rpc/eventixv1"
+	"eventix-api/pkg/utils"
+)
+
+type ticketServer struct {
+	eventixv1.UnimplementedTicketServiceServer
+	repos *repositories.Repositories
+}
+
+func toTicket(ticket *models.Ticket) *eventixv1.Ticket {
+	message := &eventixv1.Ticket{
+		Id:        ticket.ID.String(),
+		OrderId:   ticket.OrderID.String(),
+		TierId:    ticket.TierID.String(),
+		EventId:   ticket.Tier.EventID.String(),
+		OwnerId:   ticket.OwnerID.String(),
+		Status:    string(ticket.Status),
+		CreatedAt: timestamppb.New(ticket.CreatedAt),
+	}
+	if ticket.CheckedInAt != nil {
+		message.CheckedInAt = timestamppb.New(*ticket.CheckedInAt)
+	}
+	return message
+}
+
+func (s *ticketServer) GetTicket(ctx context.Context, req *eventixv1.GetTicketRequest) (*eventixv1.Ticket, error) {
+	ticketID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, utils.BadRequestError("invalid ticket ID")
+	}
+
+	ticket, err := s.repos.Tickets.FindByID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return toTicket(ticket), nil
+}
+
+func (s *ticketServer) ListTickets(ctx context.Context, req *eventixv1.ListTicketsRequest) (*eventixv1.ListTicketsResponse, error) {
+	if (req.GetOwnerId() == "") == (req.GetOrderId() == "") {
+		return nil, utils.BadRequestError("exactly one of owner_id and order_id is required")
+	}
+
+	after, err := pageAfter(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	limit := pageSize(req.GetPageSize())
+
+	// Fetch one extra row to learn whether another page follows
+	var tickets []models.Ticket
+	if req.GetOwnerId() != "" {
+		ownerID, err := uuid.Parse(req.GetOwnerId())
+		if err != nil {
+			return nil, utils.BadRequestError("invalid owner ID")
+		}
+		tickets, err = s.repos.Tickets.ListByOwnerAfter(ctx, ownerID, after, limit+1)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		orderID, err := uuid.Parse(req.GetOrderId())
+		if err != nil {
+			return nil, utils.BadRequestError("invalid order ID")
+		}
+		tickets, err = s.repos.Tickets.ListByOrderAfter(ctx, orderID, after, limit+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &eventixv1.ListTicketsResponse{}
+	if len(tickets) > limit {
+		tickets = tickets[:limit]
+		last := tickets[limit-1]
+		resp.NextPageToken = utils.CursorEncode(last.CreatedAt, last.ID)
+	}
+	for i := range tickets {
+		resp.Tickets = append(resp.Tickets, toTicket(&tickets[i]))
+	}
+	return resp, nil
+}
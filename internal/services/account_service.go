@@ -0,0 +1,301 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+// Data export formats
+const (
+	ExportFormatJSON = "json"
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// AccountService handles account deletion and personal data export
+type AccountService struct{}
+
+// NewAccountService creates a new account service
+func NewAccountService() *AccountService {
+	return &AccountService{}
+}
+
+// DeleteAccount deactivates and soft-deletes a user. Personal data is kept
+// until the deletion grace period ends and AnonymizeDeleted runs.
+func (s *AccountService) DeleteAccount(ctx context.Context, userID uuid.UUID) error {
+	return database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		if err := tx.Model(&models.User{}).Where("id = ?", userID).Update("is_active", false).Error; err != nil {
+			return fmt.Errorf("failed to deactivate user: %w", err)
+		}
+
+		result := tx.Delete(&models.User{}, userID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete user: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("user not found")
+		}
+
+		return nil
+	})
+}
+
+// AnonymizeDeleted scrubs personal data from up to limit accounts deleted
+// before cutoff. Orders and tickets are kept for accounting but no longer
+// identify the person.
+func (s *AccountService) AnonymizeDeleted(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	var users []models.User
+	if err := database.DB.WithContext(ctx).Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ? AND anonymized_at IS NULL", cutoff).
+		Limit(limit).
+		Find(&users).Error; err != nil {
+		return 0, fmt.Errorf("failed to fetch deleted accounts: %w", err)
+	}
+
+	anonymized := 0
+	for _, user := range users {
+		err := database.Transaction(func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			now := time.Now()
+
+			user.Email = fmt.Sprintf("deleted-%s@deleted.invalid", user.ID)
+			user.PasswordHash = ""
+			user.FirstName = "Deleted"
+			user.LastName = "User"
+			user.Phone = ""
+			user.OAuthProvider = ""
+			user.OAuthID = ""
+			user.AnonymizedAt = &now
+			if err := tx.Unscoped().Save(&user).Error; err != nil {
+				return fmt.Errorf("failed to anonymize user: %w", err)
+			}
+
+			// Notification bodies can contain names and order details
+			if err := tx.Where("user_id = ?", user.ID).Delete(&models.Notification{}).Error; err != nil {
+				return fmt.Errorf("failed to delete notifications: %w", err)
+			}
+			if err := tx.Where("user_id = ?", user.ID).Delete(&models.NotificationPreference{}).Error; err != nil {
+				return fmt.Errorf("failed to delete notification preferences: %w", err)
+			}
+			if err := tx.Where("user_id = ?", user.ID).Delete(&models.Favorite{}).Error; err != nil {
+				return fmt.Errorf("failed to delete favorites: %w", err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return anonymized, err
+		}
+		anonymized++
+	}
+
+	return anonymized, nil
+}
+
+// AccountExport is everything stored about a user
+type AccountExport struct {
+	GeneratedAt time.Time        `json:"generated_at"`
+	Profile     ExportedProfile  `json:"profile"`
+	Orders      []ExportedOrder  `json:"orders"`
+	Tickets     []ExportedTicket `json:"tickets"`
+}
+
+// ExportedProfile is the profile section of a data export
+type ExportedProfile struct {
+	ID            uuid.UUID  `json:"id"`
+	Email         string     `json:"email"`
+	FirstName     string     `json:"first_name"`
+	LastName      string     `json:"last_name"`
+	Phone         string     `json:"phone"`
+	Role          string     `json:"role"`
+	EmailVerified bool       `json:"email_verified"`
+	LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// ExportedOrder is an order in a data export
+type ExportedOrder struct {
+	ID          uuid.UUID `json:"id"`
+	Quantity    int       `json:"quantity"`
+	TotalAmount int64     `json:"total_amount"`
+	Currency    string    `json:"currency"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ExportedTicket is a ticket in a data export
+type ExportedTicket struct {
+	ID          uuid.UUID  `json:"id"`
+	OrderID     uuid.UUID  `json:"order_id"`
+	EventTitle  string     `json:"event_title"`
+	TierName    string     `json:"tier_name"`
+	Status      string     `json:"status"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ExportFile is a rendered data export
+type ExportFile struct {
+	Data        []byte
+	ContentType string
+	Extension   string
+}
+
+// ExportAccount collects a user's profile, orders and tickets and renders
+// them as JSON, or as a zip of CSV files
+func (s *AccountService) ExportAccount(ctx context.Context, userID uuid.UUID, format string) (*ExportFile, error) {
+	export, err := s.collectExport(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode export: %w", err)
+		}
+		return &ExportFile{Data: data, ContentType: "application/json", Extension: "json"}, nil
+	case ExportFormatCSV:
+		data, err := exportCSVArchive(export)
+		if err != nil {
+			return nil, err
+		}
+		return &ExportFile{Data: data, ContentType: "application/zip", Extension: "zip"}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+func (s *AccountService) collectExport(ctx context.Context, userID uuid.UUID) (*AccountExport, error) {
+	db := database.DB.WithContext(ctx)
+
+	var user models.User
+	if err := db.First(&user, userID).Error; err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	var orders []models.Order
+	if err := db.Where("user_id = ?", userID).Order("created_at ASC").Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch orders: %w", err)
+	}
+
+	var tickets []models.Ticket
+	if err := db.Where("owner_id = ?", userID).
+		Preload("Tier").
+		Preload("Tier.Event").
+		Order("created_at ASC").
+		Find(&tickets).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch tickets: %w", err)
+	}
+
+	export := &AccountExport{
+		GeneratedAt: time.Now().UTC(),
+		Profile: ExportedProfile{
+			ID:            user.ID,
+			Email:         user.Email,
+			FirstName:     user.FirstName,
+			LastName:      user.LastName,
+			Phone:         user.Phone,
+			Role:          string(user.Role),
+			EmailVerified: user.EmailVerified,
+			LastLoginAt:   user.LastLoginAt,
+			CreatedAt:     user.CreatedAt,
+		},
+		Orders:  make([]ExportedOrder, len(orders)),
+		Tickets: make([]ExportedTicket, len(tickets)),
+	}
+
+	for i, order := range orders {
+		export.Orders[i] = ExportedOrder{
+			ID:          order.ID,
+			Quantity:    order.Quantity,
+			TotalAmount: order.TotalAmount,
+			Currency:    order.Currency,
+			Status:      string(order.Status),
+			CreatedAt:   order.CreatedAt,
+		}
+	}
+
+	for i, ticket := range tickets {
+		export.Tickets[i] = ExportedTicket{
+			ID:          ticket.ID,
+			OrderID:     ticket.OrderID,
+			EventTitle:  ticket.Tier.Event.Title,
+			TierName:    ticket.Tier.TierName,
+			Status:      string(ticket.Status),
+			CheckedInAt: ticket.CheckedInAt,
+			CreatedAt:   ticket.CreatedAt,
+		}
+	}
+
+	return export, nil
+}
+
+// exportCSVArchive writes one CSV file per export section into a zip archive
+func exportCSVArchive(export *AccountExport) ([]byte, error) {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	p := export.Profile
+	files := []struct {
+		name string
+		rows [][]string
+	}{
+		{"profile.csv", [][]string{
+			{"id", "email", "first_name", "last_name", "phone", "role", "email_verified", "last_login_at", "created_at"},
+			{p.ID.String(), p.Email, p.FirstName, p.LastName, p.Phone, p.Role,
+				strconv.FormatBool(p.EmailVerified), formatTime(p.LastLoginAt), formatTime(&p.CreatedAt)},
+		}},
+		{"orders.csv", [][]string{{"id", "quantity", "total_amount", "currency", "status", "created_at"}}},
+		{"tickets.csv", [][]string{{"id", "order_id", "event_title", "tier_name", "status", "checked_in_at", "created_at"}}},
+	}
+
+	for _, o := range export.Orders {
+		files[1].rows = append(files[1].rows, []string{
+			o.ID.String(), strconv.Itoa(o.Quantity), strconv.FormatInt(o.TotalAmount, 10),
+			o.Currency, o.Status, formatTime(&o.CreatedAt),
+		})
+	}
+	for _, t := range export.Tickets {
+		files[2].rows = append(files[2].rows, []string{
+			t.ID.String(), t.OrderID.String(), t.EventTitle, t.TierName, t.Status,
+			formatTime(t.CheckedInAt), formatTime(&t.CreatedAt),
+		})
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, file := range files {
+		w, err := archive.Create(file.name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", file.name, err)
+		}
+		if err := csv.NewWriter(w).WriteAll(file.rows); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish export archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
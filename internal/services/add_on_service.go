@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// AddOnInput describes a new add-on
+type AddOnInput struct {
+	Name        string
+	Description string
+	Price       int64
+	Quantity    int // 0 means unlimited
+}
+
+// AddOnUpdate holds the add-on settings to change; nil fields are left as they are
+type AddOnUpdate struct {
+	Name        *string
+	Description *string
+	Price       *int64
+	Quantity    *int
+}
+
+// AddOnSelection is a quantity of one add-on chosen at checkout
+type AddOnSelection struct {
+	AddOnID  uuid.UUID
+	Quantity int
+}
+
+// AddOnService manages the extras sold alongside an event's tickets and
+// turns a checkout into order line items
+type AddOnService struct {
+	tiers *TierService
+}
+
+// NewAddOnService creates a new add-on service
+func NewAddOnService() *AddOnService {
+	return &AddOnService{tiers: NewTierService()}
+}
+
+// GetAddOn returns an add-on by ID
+func (s *AddOnService) GetAddOn(ctx context.Context, addOnID uuid.UUID) (*models.AddOn, error) {
+	var addOn models.AddOn
+	if err := database.DB.WithContext(ctx).First(&addOn, addOnID).Error; err != nil {
+		return nil, fmt.Errorf("add-on not found")
+	}
+	return &addOn, nil
+}
+
+// List returns an event's add-ons, oldest first
+func (s *AddOnService) List(ctx context.Context, eventID uuid.UUID) ([]models.AddOn, error) {
+	var addOns []models.AddOn
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(&addOns).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch add-ons: %w", err)
+	}
+	return addOns, nil
+}
+
+// Create adds an add-on to an event, priced in the event's currency
+func (s *AddOnService) Create(ctx context.Context, eventID uuid.UUID, input AddOnInput) (*models.AddOn, error) {
+	event, err := s.tiers.ensureEditable(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	addOn := models.AddOn{
+		EventID:     eventID,
+		Name:        strings.TrimSpace(input.Name),
+		Description: input.Description,
+		Price:       input.Price,
+		Currency:    event.Currency,
+		Quantity:    input.Quantity,
+	}
+	if err := validateAddOn(&addOn); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.WithContext(ctx).Create(&addOn).Error; err != nil {
+		return nil, fmt.Errorf("failed to create add-on: %w", err)
+	}
+	return &addOn, nil
+}
+
+// Update applies update to an add-on. Orders keep the name and price they
+// were placed with.
+func (s *AddOnService) Update(ctx context.Context, addOnID uuid.UUID, update AddOnUpdate) (*models.AddOn, error) {
+	addOn, err := s.GetAddOn(ctx, addOnID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, addOn.EventID); err != nil {
+		return nil, err
+	}
+
+	if update.Name != nil {
+		addOn.Name = strings.TrimSpace(*update.Name)
+	}
+	if update.Description != nil {
+		addOn.Description = *update.Description
+	}
+	if update.Price != nil {
+		addOn.Price = *update.Price
+	}
+	if update.Quantity != nil {
+		addOn.Quantity = *update.Quantity
+	}
+	if err := validateAddOn(addOn); err != nil {
+		return nil, err
+	}
+
+	// Stock is only changed when no sale has taken it below the new quantity
+	result := database.DB.WithContext(ctx).Model(addOn).
+		Where("? = 0 OR sold <= ?", addOn.Quantity, addOn.Quantity).
+		Select("name", "description", "price", "quantity").
+		Updates(addOn)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update add-on: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("quantity cannot be less than the number already sold")
+	}
+	return addOn, nil
+}
+
+// Delete takes an add-on off sale. Orders that include it are unaffected.
+func (s *AddOnService) Delete(ctx context.Context, addOnID uuid.UUID) error {
+	addOn, err := s.GetAddOn(ctx, addOnID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, addOn.EventID); err != nil {
+		return err
+	}
+
+	if err := database.DB.WithContext(ctx).Delete(addOn).Error; err != nil {
+		return fmt.Errorf("failed to delete add-on: %w", err)
+	}
+	return nil
+}
+
+// OrderItems builds the line items of an order for a ticket reservation:
+// one line for the reserved tickets followed by one per selected add-on.
+// Selections of the same add-on are combined.
+func (s *AddOnService) OrderItems(ctx context.Context, reservation *ReservationData, selections []AddOnSelection) ([]models.OrderItem, error) {
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Select("id", "tier_name").First(&tier, reservation.TierID).Error; err != nil {
+		return nil, fmt.Errorf("ticket tier not found")
+	}
+
+	tierID := reservation.TierID
+	items := []models.OrderItem{{
+		Type:      models.OrderItemTicket,
+		TierID:    &tierID,
+		Name:      tier.TierName,
+		Quantity:  reservation.Quantity,
+		UnitPrice: reservation.UnitPrice,
+		Amount:    reservation.TotalPrice,
+	}}
+	if len(selections) == 0 {
+		return items, nil
+	}
+
+	quantities := make(map[uuid.UUID]int, len(selections))
+	var ids []uuid.UUID
+	for _, selection := range selections {
+		if _, ok := quantities[selection.AddOnID]; !ok {
+			ids = append(ids, selection.AddOnID)
+		}
+		quantities[selection.AddOnID] += selection.Quantity
+	}
+
+	var addOns []models.AddOn
+	if err := database.DB.WithContext(ctx).
+		Where("id IN ? AND event_id = ?", ids, reservation.EventID).
+		Find(&addOns).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch add-ons: %w", err)
+	}
+	found := make(map[uuid.UUID]*models.AddOn, len(addOns))
+	for i := range addOns {
+		found[addOns[i].ID] = &addOns[i]
+	}
+
+	for _, id := range ids {
+		addOn, ok := found[id]
+		if !ok {
+			return nil, fmt.Errorf("add-on %s is not sold for this event", id)
+		}
+		if !strings.EqualFold(addOn.Currency, reservation.Currency) {
+			return nil, fmt.Errorf("%s is priced in %s, not %s", addOn.Name, addOn.Currency, reservation.Currency)
+		}
+
+		quantity := quantities[id]
+		if remaining := addOn.Remaining(); remaining >= 0 && quantity > remaining {
+			return nil, fmt.Errorf("only %d of %s left", remaining, addOn.Name)
+		}
+
+		addOnID := addOn.ID
+		items = append(items, models.OrderItem{
+			Type:      models.OrderItemAddOn,
+			AddOnID:   &addOnID,
+			Name:      addOn.Name,
+			Quantity:  quantity,
+			UnitPrice: addOn.Price,
+			Amount:    addOn.Price * int64(quantity),
+		})
+	}
+	return items, nil
+}
+
+// reserveAddOnItems takes the add-ons in items out of stock within tx. When
+// one has sold out it fails, and rolling tx back returns the others.
+func reserveAddOnItems(tx *gorm.DB, items []models.OrderItem) error {
+	for _, item := range items {
+		if item.AddOnID == nil {
+			continue
+		}
+
+		// Conditional update so concurrent checkouts cannot oversell
+		result := tx.Model(&models.AddOn{}).
+			Where("id = ? AND (quantity = 0 OR sold + ? <= quantity)", *item.AddOnID, item.Quantity).
+			UpdateColumn("sold", gorm.Expr("sold + ?", item.Quantity))
+		if result.Error != nil {
+			return fmt.Errorf("failed to reserve add-ons: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return utils.BadRequestError("%s is sold out", item.Name)
+		}
+	}
+	return nil
+}
+
+// releaseOrderAddOns puts the add-ons bought with an order back in stock
+func releaseOrderAddOns(tx *gorm.DB, orderID uuid.UUID) error {
+	var items []models.OrderItem
+	if err := tx.Where("order_id = ? AND type = ?", orderID, models.OrderItemAddOn).Find(&items).Error; err != nil {
+		return fmt.Errorf("failed to fetch order items: %w", err)
+	}
+	return releaseAddOnItems(tx, items)
+}
+
+func releaseAddOnItems(tx *gorm.DB, items []models.OrderItem) error {
+	for _, item := range items {
+		if item.AddOnID == nil {
+			continue
+		}
+		if err := tx.Model(&models.AddOn{}).
+			Where("id = ?", *item.AddOnID).
+			UpdateColumn("sold", gorm.Expr("GREATEST(sold - ?, 0)", item.Quantity)).Error; err != nil {
+			return fmt.Errorf("failed to release add-ons: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateAddOn(addOn *models.AddOn) error {
+	if addOn.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if addOn.Price < 0 {
+		return fmt.Errorf("price cannot be negative")
+	}
+	if addOn.Quantity < 0 {
+		return fmt.Errorf("quantity cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,479 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// eventAnalyticsTTL bounds how stale organizer analytics may be
+const eventAnalyticsTTL = 5 * time.Minute
+
+// platformDashboardTTL bounds how stale the admin dashboard may be
+const platformDashboardTTL = 5 * time.Minute
+
+// maxDashboardPeriods caps the buckets of a dashboard time series
+const maxDashboardPeriods = 400
+
+// topDashboardEntries is how many top events and organizers the dashboard lists
+const topDashboardEntries = 10
+
+// DashboardInterval is the bucket size of dashboard time series
+type DashboardInterval string
+
+const (
+	DashboardDaily   DashboardInterval = "day"
+	DashboardWeekly  DashboardInterval = "week" // weeks start on Monday
+	DashboardMonthly DashboardInterval = "month"
+)
+
+// TierSalesStats holds sales figures for a single ticket tier
+type TierSalesStats struct {
+	TierID        uuid.UUID `json:"tier_id"`
+	TierName      string    `json:"tier_name"`
+	TotalQuantity int64     `json:"total_quantity"`
+	Sold          int64     `json:"sold"`
+	Comps         int64     `json:"comps"`
+	Revenue       int64     `json:"revenue"`
+}
+
+// DailyRevenue holds sales for a single calendar day (UTC)
+type DailyRevenue struct {
+	Date        time.Time `json:"date"`
+	Orders      int64     `json:"orders"`
+	TicketsSold int64     `json:"tickets_sold"`
+	Revenue     int64     `json:"revenue"`
+}
+
+// EventAnalytics summarizes sales performance for an event
+type EventAnalytics struct {
+	EventID        uuid.UUID        `json:"event_id"`
+	TicketsSold    int64            `json:"tickets_sold"`
+	CompTickets    int64            `json:"comp_tickets"`
+	GrossRevenue   int64            `json:"gross_revenue"`
+	TaxCollected   int64            `json:"tax_collected"` // on orders still paid, part of gross revenue
+	RefundedAmount int64            `json:"refunded_amount"`
+	NetRevenue     int64            `json:"net_revenue"`
+	PaidOrders     int64            `json:"paid_orders"`
+	RefundedOrders int64            `json:"refunded_orders"`
+	RefundRate     float64          `json:"refund_rate"`
+	Reservations   int64            `json:"reservations"`
+	ConversionRate float64          `json:"conversion_rate"`
+	Tiers          []TierSalesStats `json:"tiers"`
+	DailyRevenue   []DailyRevenue   `json:"daily_revenue"`
+	GeneratedAt    time.Time        `json:"generated_at"`
+}
+
+// DashboardPeriod holds platform activity within one bucket of the
+// dashboard. Money is in minor units per currency.
+type DashboardPeriod struct {
+	PeriodStart    time.Time        `json:"period_start"`
+	Orders         int64            `json:"orders"`
+	TicketsSold    int64            `json:"tickets_sold"`
+	Revenue        map[string]int64 `json:"revenue"`
+	Refunds        int64            `json:"refunds"`
+	RefundedAmount map[string]int64 `json:"refunded_amount"`
+	NewUsers       int64            `json:"new_users"`
+}
+
+// DashboardTotals sums the dashboard's series over its whole range
+type DashboardTotals struct {
+	Orders         int64            `json:"orders"`
+	TicketsSold    int64            `json:"tickets_sold"`
+	Revenue        map[string]int64 `json:"revenue"`
+	Refunds        int64            `json:"refunds"`
+	RefundedAmount map[string]int64 `json:"refunded_amount"`
+	RefundRate     float64          `json:"refund_rate"` // share of the range's orders since refunded
+	NewUsers       int64            `json:"new_users"`
+}
+
+// TopEvent is one of the best-selling events of the dashboard range
+type TopEvent struct {
+	EventID     uuid.UUID `json:"event_id"`
+	Title       string    `json:"title"`
+	OrganizerID uuid.UUID `json:"organizer_id"`
+	Currency    string    `json:"currency"`
+	TicketsSold int64     `json:"tickets_sold"`
+	Revenue     int64     `json:"revenue"`
+}
+
+// TopOrganizer is one of the best-selling organizers of the dashboard range
+type TopOrganizer struct {
+	OrganizerID      uuid.UUID        `json:"organizer_id"`
+	OrganizationName string           `json:"organization_name"`
+	Events           int64            `json:"events"`
+	TicketsSold      int64            `json:"tickets_sold"`
+	Revenue          map[string]int64 `json:"revenue"`
+}
+
+// PlatformDashboard summarizes sales, refunds and signups across the
+// platform between From and To
+type PlatformDashboard struct {
+	From          time.Time         `json:"from"`
+	To            time.Time         `json:"to"`
+	Interval      DashboardInterval `json:"interval"`
+	Totals        DashboardTotals   `json:"totals"`
+	Series        []DashboardPeriod `json:"series"`
+	TopEvents     []TopEvent        `json:"top_events"`
+	TopOrganizers []TopOrganizer    `json:"top_organizers"`
+	GeneratedAt   time.Time         `json:"generated_at"`
+}
+
+// AnalyticsService handles organizer and platform reporting
+type AnalyticsService struct{}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService() *AnalyticsService {
+	return &AnalyticsService{}
+}
+
+// GetEventAnalytics returns sales, revenue, refund and conversion figures
+// for an event. Comp tickets are counted apart and left out of every sales
+// figure. Results are cached in Redis.
+func (s *AnalyticsService) GetEventAnalytics(eventID uuid.UUID) (*EventAnalytics, error) {
+	ctx := context.Background()
+	cacheKey := fmt.Sprintf("event_analytics:%s", eventID)
+
+	var analytics EventAnalytics
+	if err := cache.GetValue(ctx, cacheKey, &analytics); err == nil {
+		return &analytics, nil
+	}
+
+	analytics = EventAnalytics{
+		EventID:     eventID,
+		GeneratedAt: time.Now(),
+	}
+
+	// Orders that completed payment, whether or not later refunded
+	purchased := []models.OrderStatus{models.OrderPaid, models.OrderRefunded}
+
+	if err := database.Reader(database.DB).Table("ticket_tiers tt").
+		Select(`tt.id AS tier_id, tt.tier_name, tt.total_quantity,
+			COALESCE(SUM(o.quantity) FILTER (WHERE o.status = ? AND NOT o.is_comp), 0) AS sold,
+			COALESCE(SUM(o.quantity) FILTER (WHERE o.status = ? AND o.is_comp), 0) AS comps,
+			COALESCE(SUM(o.total_amount) FILTER (WHERE o.status = ? AND NOT o.is_comp), 0) AS revenue`,
+			models.OrderPaid, models.OrderPaid, models.OrderPaid).
+		Joins("LEFT JOIN orders o ON o.tier_id = tt.id AND o.deleted_at IS NULL").
+		Where("tt.event_id = ? AND tt.deleted_at IS NULL", eventID).
+		Group("tt.id, tt.tier_name, tt.total_quantity").
+		Order("tt.tier_name ASC").
+		Scan(&analytics.Tiers).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate tier sales: %w", err)
+	}
+
+	for _, tier := range analytics.Tiers {
+		analytics.TicketsSold += tier.Sold
+		analytics.CompTickets += tier.Comps
+	}
+
+	if err := database.Reader(database.DB).Table("orders o").
+		Select(`date_trunc('day', o.created_at AT TIME ZONE 'UTC') AS date,
+			COUNT(*) AS orders,
+			COALESCE(SUM(o.quantity), 0) AS tickets_sold,
+			COALESCE(SUM(o.total_amount), 0) AS revenue`).
+		Joins("JOIN ticket_tiers tt ON tt.id = o.tier_id").
+		Where("tt.event_id = ? AND o.status IN ? AND NOT o.is_comp AND o.deleted_at IS NULL", eventID, purchased).
+		Group("date").
+		Order("date ASC").
+		Scan(&analytics.DailyRevenue).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate daily revenue: %w", err)
+	}
+
+	var orderTotals struct {
+		PaidOrders     int64
+		RefundedOrders int64
+		GrossRevenue   int64
+		TaxCollected   int64
+	}
+	if err := database.Reader(database.DB).Table("orders o").
+		Select(`COUNT(*) FILTER (WHERE o.status = ?) AS paid_orders,
+			COUNT(*) FILTER (WHERE o.status = ?) AS refunded_orders,
+			COALESCE(SUM(o.total_amount), 0) AS gross_revenue,
+			COALESCE(SUM(o.tax_amount) FILTER (WHERE o.status = ?), 0) AS tax_collected`,
+			models.OrderPaid, models.OrderRefunded, models.OrderPaid).
+		Joins("JOIN ticket_tiers tt ON tt.id = o.tier_id").
+		Where("tt.event_id = ? AND o.status IN ? AND NOT o.is_comp AND o.deleted_at IS NULL", eventID, purchased).
+		Scan(&orderTotals).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate orders: %w", err)
+	}
+
+	if err := database.Reader(database.DB).Table("refunds r").
+		Select("COALESCE(SUM(r.amount), 0)").
+		Joins("JOIN orders o ON o.id = r.order_id").
+		Joins("JOIN ticket_tiers tt ON tt.id = o.tier_id").
+		Where("tt.event_id = ? AND r.status = ? AND NOT o.is_comp", eventID, models.RefundCompleted).
+		Scan(&analytics.RefundedAmount).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate refunds: %w", err)
+	}
+
+	analytics.PaidOrders = orderTotals.PaidOrders
+	analytics.RefundedOrders = orderTotals.RefundedOrders
+	analytics.GrossRevenue = orderTotals.GrossRevenue
+	analytics.TaxCollected = orderTotals.TaxCollected
+	analytics.NetRevenue = analytics.GrossRevenue - analytics.RefundedAmount
+
+	purchases := analytics.PaidOrders + analytics.RefundedOrders
+	if purchases > 0 {
+		analytics.RefundRate = float64(analytics.RefundedOrders) / float64(purchases)
+	}
+
+	// Reservations live only in Redis, so they are counted as they are made
+	if count, err := cache.Client.Get(ctx, utils.EventReservationsMetricKey(eventID)).Result(); err == nil {
+		analytics.Reservations, _ = strconv.ParseInt(count, 10, 64)
+	}
+	if analytics.Reservations > 0 {
+		analytics.ConversionRate = float64(purchases) / float64(analytics.Reservations)
+	}
+
+	_ = cache.Set(ctx, cacheKey, analytics, eventAnalyticsTTL)
+
+	return &analytics, nil
+}
+
+// GetPlatformDashboard returns the platform's sales, refunds and signups
+// between from and to, bucketed by interval, along with its top events and
+// organizers by tickets sold. Sales are counted when the order was placed
+// and refunds when they completed; comp tickets are left out. Results are
+// cached in Redis.
+func (s *AnalyticsService) GetPlatformDashboard(ctx context.Context, from, to time.Time, interval DashboardInterval) (*PlatformDashboard, error) {
+	periods, err := dashboardPeriods(from, to, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("platform_dashboard:%d:%d:%s", from.Unix(), to.Unix(), interval)
+	var dashboard PlatformDashboard
+	if err := cache.GetValue(ctx, cacheKey, &dashboard); err == nil {
+		return &dashboard, nil
+	}
+
+	dashboard = PlatformDashboard{
+		From:        from,
+		To:          to,
+		Interval:    interval,
+		Series:      make([]DashboardPeriod, len(periods)),
+		GeneratedAt: time.Now(),
+	}
+	index := make(map[int64]*DashboardPeriod, len(periods))
+	for i, start := range periods {
+		dashboard.Series[i] = DashboardPeriod{
+			PeriodStart:    start,
+			Revenue:        map[string]int64{},
+			RefundedAmount: map[string]int64{},
+		}
+		index[start.Unix()] = &dashboard.Series[i]
+	}
+	bucket := fmt.Sprintf("date_trunc('%s', %%s AT TIME ZONE 'UTC') AS period_start", interval)
+	db := database.Reader(database.DB).WithContext(ctx)
+
+	// Orders that completed payment, whether or not later refunded
+	purchased := []models.OrderStatus{models.OrderPaid, models.OrderRefunded}
+
+	var sales []struct {
+		PeriodStart    time.Time
+		Currency       string
+		Orders         int64
+		RefundedOrders int64
+		TicketsSold    int64
+		Revenue        int64
+	}
+	if err := db.Table("orders o").
+		Select(fmt.Sprintf(bucket, "o.created_at")+`, o.currency,
+			COUNT(*) AS orders,
+			COUNT(*) FILTER (WHERE o.status = ?) AS refunded_orders,
+			COALESCE(SUM(o.quantity), 0) AS tickets_sold,
+			COALESCE(SUM(o.total_amount), 0) AS revenue`, models.OrderRefunded).
+		Where("o.status IN ? AND NOT o.is_comp AND o.deleted_at IS NULL", purchased).
+		Where("o.created_at >= ? AND o.created_at < ?", from, to).
+		Group("period_start, o.currency").
+		Scan(&sales).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate sales: %w", err)
+	}
+	var refundedOrders int64
+	for _, row := range sales {
+		refundedOrders += row.RefundedOrders
+		if period, ok := index[row.PeriodStart.Unix()]; ok {
+			period.Orders += row.Orders
+			period.TicketsSold += row.TicketsSold
+			period.Revenue[row.Currency] += row.Revenue
+		}
+	}
+
+	var refunds []struct {
+		PeriodStart time.Time
+		Currency    string
+		Refunds     int64
+		Amount      int64
+	}
+	if err := db.Table("refunds r").
+		Select(fmt.Sprintf(bucket, "r.updated_at")+`, o.currency,
+			COUNT(*) AS refunds, COALESCE(SUM(r.amount), 0) AS amount`).
+		Joins("JOIN orders o ON o.id = r.order_id").
+		Where("r.status = ? AND NOT o.is_comp", models.RefundCompleted).
+		Where("r.updated_at >= ? AND r.updated_at < ?", from, to).
+		Group("period_start, o.currency").
+		Scan(&refunds).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate refunds: %w", err)
+	}
+	for _, row := range refunds {
+		if period, ok := index[row.PeriodStart.Unix()]; ok {
+			period.Refunds += row.Refunds
+			period.RefundedAmount[row.Currency] += row.Amount
+		}
+	}
+
+	// Deleted accounts still signed up in their period
+	var signups []struct {
+		PeriodStart time.Time
+		NewUsers    int64
+	}
+	if err := db.Table("users u").
+		Select(fmt.Sprintf(bucket, "u.created_at")+", COUNT(*) AS new_users").
+		Where("u.role <> ? AND u.created_at >= ? AND u.created_at < ?", models.RoleGuest, from, to).
+		Group("period_start").
+		Scan(&signups).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate signups: %w", err)
+	}
+	for _, row := range signups {
+		if period, ok := index[row.PeriodStart.Unix()]; ok {
+			period.NewUsers += row.NewUsers
+		}
+	}
+
+	dashboard.Totals = DashboardTotals{Revenue: map[string]int64{}, RefundedAmount: map[string]int64{}}
+	for _, period := range dashboard.Series {
+		dashboard.Totals.Orders += period.Orders
+		dashboard.Totals.TicketsSold += period.TicketsSold
+		dashboard.Totals.Refunds += period.Refunds
+		dashboard.Totals.NewUsers += period.NewUsers
+		for currency, amount := range period.Revenue {
+			dashboard.Totals.Revenue[currency] += amount
+		}
+		for currency, amount := range period.RefundedAmount {
+			dashboard.Totals.RefundedAmount[currency] += amount
+		}
+	}
+
+	if dashboard.Totals.Orders > 0 {
+		dashboard.Totals.RefundRate = float64(refundedOrders) / float64(dashboard.Totals.Orders)
+	}
+
+	dashboard.TopEvents = []TopEvent{}
+	if err := db.Table("orders o").
+		Select(`e.id AS event_id, e.title, e.organizer_id, e.currency,
+			COALESCE(SUM(o.quantity), 0) AS tickets_sold,
+			COALESCE(SUM(o.total_amount), 0) AS revenue`).
+		Joins("JOIN ticket_tiers tt ON tt.id = o.tier_id").
+		Joins("JOIN events e ON e.id = tt.event_id").
+		Where("o.status IN ? AND NOT o.is_comp AND o.deleted_at IS NULL", purchased).
+		Where("o.created_at >= ? AND o.created_at < ?", from, to).
+		Group("e.id, e.title, e.organizer_id, e.currency").
+		Order("tickets_sold DESC, revenue DESC").
+		Limit(topDashboardEntries).
+		Scan(&dashboard.TopEvents).Error; err != nil {
+		return nil, fmt.Errorf("failed to rank events: %w", err)
+	}
+
+	var organizers []struct {
+		OrganizerID      uuid.UUID
+		OrganizationName string
+		Events           int64
+		TicketsSold      int64
+	}
+	if err := db.Table("orders o").
+		Select(`org.id AS organizer_id, org.organization_name,
+			COUNT(DISTINCT e.id) AS events,
+			COALESCE(SUM(o.quantity), 0) AS tickets_sold`).
+		Joins("JOIN ticket_tiers tt ON tt.id = o.tier_id").
+		Joins("JOIN events e ON e.id = tt.event_id").
+		Joins("JOIN organizers org ON org.id = e.organizer_id").
+		Where("o.status IN ? AND NOT o.is_comp AND o.deleted_at IS NULL", purchased).
+		Where("o.created_at >= ? AND o.created_at < ?", from, to).
+		Group("org.id, org.organization_name").
+		Order("tickets_sold DESC").
+		Limit(topDashboardEntries).
+		Scan(&organizers).Error; err != nil {
+		return nil, fmt.Errorf("failed to rank organizers: %w", err)
+	}
+
+	dashboard.TopOrganizers = make([]TopOrganizer, len(organizers))
+	organizerIDs := make([]uuid.UUID, len(organizers))
+	byOrganizer := make(map[uuid.UUID]*TopOrganizer, len(organizers))
+	for i, row := range organizers {
+		dashboard.TopOrganizers[i] = TopOrganizer{
+			OrganizerID:      row.OrganizerID,
+			OrganizationName: row.OrganizationName,
+			Events:           row.Events,
+			TicketsSold:      row.TicketsSold,
+			Revenue:          map[string]int64{},
+		}
+		organizerIDs[i] = row.OrganizerID
+		byOrganizer[row.OrganizerID] = &dashboard.TopOrganizers[i]
+	}
+
+	if len(organizerIDs) > 0 {
+		var revenue []struct {
+			OrganizerID uuid.UUID
+			Currency    string
+			Revenue     int64
+		}
+		if err := db.Table("orders o").
+			Select("e.organizer_id, o.currency, COALESCE(SUM(o.total_amount), 0) AS revenue").
+			Joins("JOIN ticket_tiers tt ON tt.id = o.tier_id").
+			Joins("JOIN events e ON e.id = tt.event_id").
+			Where("o.status IN ? AND NOT o.is_comp AND o.deleted_at IS NULL", purchased).
+			Where("o.created_at >= ? AND o.created_at < ? AND e.organizer_id IN ?", from, to, organizerIDs).
+			Group("e.organizer_id, o.currency").
+			Scan(&revenue).Error; err != nil {
+			return nil, fmt.Errorf("failed to aggregate organizer revenue: %w", err)
+		}
+		for _, row := range revenue {
+			byOrganizer[row.OrganizerID].Revenue[row.Currency] += row.Revenue
+		}
+	}
+
+	_ = cache.Set(ctx, cacheKey, dashboard, platformDashboardTTL)
+
+	return &dashboard, nil
+}
+
+// dashboardPeriods returns the start of every interval bucket from from up
+// to to, in UTC, the way Postgres date_trunc buckets them
+func dashboardPeriods(from, to time.Time, interval DashboardInterval) ([]time.Time, error) {
+	if !to.After(from) {
+		return nil, utils.BadRequestError("from must not be after to")
+	}
+
+	from = from.UTC()
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	var next func(time.Time) time.Time
+	switch interval {
+	case DashboardDaily:
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case DashboardWeekly:
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	case DashboardMonthly:
+		start = time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	default:
+		return nil, utils.BadRequestError("interval must be day, week or month")
+	}
+
+	var periods []time.Time
+	for t := start; t.Before(to); t = next(t) {
+		if len(periods) == maxDashboardPeriods {
+			return nil, utils.BadRequestError("range is too long for %s buckets; use a longer interval", interval)
+		}
+		periods = append(periods, t)
+	}
+	return periods, nil
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/xlsx"
+)
+
+// attendeeExportBatchSize is how many tickets an attendee export reads per query
+const attendeeExportBatchSize = 500
+
+// AttendeeService lists the ticket holders of an event for its organizer
+type AttendeeService struct {
+	forms *FormService
+}
+
+// NewAttendeeService creates a new attendee service
+func NewAttendeeService() *AttendeeService {
+	return &AttendeeService{forms: NewFormService()}
+}
+
+// attendeeRowWriter is the part of a spreadsheet writer an export needs
+type attendeeRowWriter interface {
+	WriteRow(cells []string) error
+	Close() error
+}
+
+type csvRowWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvRowWriter) WriteRow(cells []string) error {
+	// Answers are free text opened in spreadsheet apps, so keep them out of formulas
+	for i, cell := range cells {
+		if cell != "" && strings.ContainsRune("=+-@", rune(cell[0])) {
+			cells[i] = "'" + cell
+		}
+	}
+	return c.w.Write(cells)
+}
+
+func (c *csvRowWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// Export writes an event's issued tickets to w as a CSV or XLSX sheet, with
+// each ticket's tier, order, holder, check-in status and one column per
+// registration form field. Tickets are read in batches, so the export never
+// holds the whole attendee list in memory.
+func (s *AttendeeService) Export(ctx context.Context, eventID uuid.UUID, format string, w io.Writer) error {
+	fields, err := s.forms.List(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	var rows attendeeRowWriter
+	switch format {
+	case ExportFormatCSV:
+		rows = &csvRowWriter{w: csv.NewWriter(w)}
+	case ExportFormatXLSX:
+		sheet, err := xlsx.NewWriter(w, "Attendees")
+		if err != nil {
+			return err
+		}
+		rows = sheet
+	default:
+		return fmt.Errorf("unsupported export format %q", format)
+	}
+
+	header := []string{"ticket_id", "order_id", "order_status", "tier_name", "first_name", "last_name", "email", "ticket_status", "checked_in", "checked_in_at"}
+	for _, field := range fields {
+		header = append(header, field.Label)
+	}
+	if err := rows.WriteRow(header); err != nil {
+		return fmt.Errorf("failed to write attendee export: %w", err)
+	}
+
+	var after *models.Ticket
+	for {
+		query := database.Reader(database.DB).WithContext(ctx).
+			Joins("JOIN ticket_tiers ON ticket_tiers.id = tickets.tier_id").
+			Where("ticket_tiers.event_id = ? AND tickets.status IN ?", eventID,
+				[]models.TicketStatus{models.TicketActive, models.TicketUsed})
+		if after != nil {
+			query = query.Where("(tickets.created_at, tickets.id) > (?, ?)", after.CreatedAt, after.ID)
+		}
+
+		var tickets []models.Ticket
+		if err := query.Preload("Tier").
+			Preload("Order").
+			Preload("Owner").
+			Preload("Answers").
+			Order("tickets.created_at ASC, tickets.id ASC").
+			Limit(attendeeExportBatchSize).
+			Find(&tickets).Error; err != nil {
+			return fmt.Errorf("failed to fetch attendees: %w", err)
+		}
+
+		for i := range tickets {
+			if err := rows.WriteRow(attendeeRow(&tickets[i], fields)); err != nil {
+				return fmt.Errorf("failed to write attendee export: %w", err)
+			}
+		}
+
+		if len(tickets) < attendeeExportBatchSize {
+			break
+		}
+		after = &tickets[len(tickets)-1]
+	}
+
+	return rows.Close()
+}
+
+func attendeeRow(ticket *models.Ticket, fields []models.FormField) []string {
+	checkedInAt := ""
+	if ticket.CheckedInAt != nil {
+		checkedInAt = ticket.CheckedInAt.UTC().Format(time.RFC3339)
+	}
+
+	row := []string{
+		ticket.ID.String(), ticket.OrderID.String(), string(ticket.Order.Status), ticket.Tier.TierName,
+		ticket.Owner.FirstName, ticket.Owner.LastName, ticket.Owner.Email,
+		string(ticket.Status), strconv.FormatBool(ticket.CheckedInAt != nil), checkedInAt,
+	}
+
+	answers := make(map[uuid.UUID]string, len(ticket.Answers))
+	for _, answer := range ticket.Answers {
+		answers[answer.FormFieldID] = answer.Value
+	}
+	for _, field := range fields {
+		row = append(row, answers[field.ID])
+	}
+	return row
+}
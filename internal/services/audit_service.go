@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// AuditEntry describes one audited mutation. Before and After are
+// snapshots of the target and are stored as JSON; nil is stored as null.
+type AuditEntry struct {
+	ActorID    uuid.UUID
+	ActorRole  models.UserRole
+	Action     models.AuditAction
+	TargetType models.AuditTargetType
+	TargetID   uuid.UUID
+	Before     interface{}
+	After      interface{}
+	IPAddress  string
+}
+
+// AuditLogFilter narrows an audit log listing
+type AuditLogFilter struct {
+	ActorID    *uuid.UUID
+	Action     models.AuditAction
+	TargetType models.AuditTargetType
+	TargetID   *uuid.UUID
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	Limit      int
+}
+
+// AuditService records admin and organizer mutations
+type AuditService struct{}
+
+// NewAuditService creates a new audit service
+func NewAuditService() *AuditService {
+	return &AuditService{}
+}
+
+// Snapshot loads the current state of a target for an audit entry. It
+// returns nil when the target does not exist (yet, or any more).
+func (s *AuditService) Snapshot(ctx context.Context, targetType models.AuditTargetType, id uuid.UUID) interface{} {
+	var target interface{}
+	query := database.DB.WithContext(ctx)
+	switch targetType {
+	case models.AuditTargetEvent:
+		target = &models.Event{}
+	case models.AuditTargetTier:
+		target = &models.TicketTier{}
+		query = query.Preload("Sessions").Preload("Zones").Preload("PricePhases", orderPricePhases)
+	case models.AuditTargetSession:
+		target = &models.EventSession{}
+	case models.AuditTargetZone:
+		target = &models.EventZone{}
+	case models.AuditTargetFormField:
+		target = &models.FormField{}
+	case models.AuditTargetAddOn:
+		target = &models.AddOn{}
+	case models.AuditTargetCategory:
+		target = &models.Category{}
+	case models.AuditTargetOrder:
+		target = &models.Order{}
+	case models.AuditTargetUser:
+		target = &models.User{}
+	case models.AuditTargetOrganizer:
+		// The user carries the role an organizer approval grants
+		target = &models.Organizer{}
+		query = query.Preload("User")
+	case models.AuditTargetPayout:
+		target = &models.Payout{}
+	case models.AuditTargetDispute:
+		target = &models.Dispute{}
+		query = query.Preload("Evidence")
+	case models.AuditTargetFeatureFlag:
+		target = &models.FeatureFlag{}
+	case models.AuditTargetRole:
+		target = &models.RolePermissions{}
+	case models.AuditTargetMember:
+		target = &models.OrganizerMember{}
+	case models.AuditTargetScannerToken:
+		target = &models.ScannerToken{}
+	case models.AuditTargetCheckin:
+		target = &models.Checkin{}
+	case models.AuditTargetWebhook:
+		target = &models.WebhookEndpoint{}
+	case models.AuditTargetAPIKey:
+		target = &models.IntegrationKey{}
+	case models.AuditTargetWidgetOrigin:
+		target = &models.WidgetOrigin{}
+	case models.AuditTargetJob:
+		target = &models.Job{}
+	default:
+		return nil
+	}
+
+	if err := query.First(target, id).Error; err != nil {
+		return nil
+	}
+	return target
+}
+
+// Record stores an audit entry. Failures are logged rather than returned:
+// the audited mutation has already happened and must not be reported as failed.
+func (s *AuditService) Record(ctx context.Context, entry AuditEntry) {
+	before, err := json.Marshal(entry.Before)
+	if err != nil {
+		before = []byte("null")
+	}
+	after, err := json.Marshal(entry.After)
+	if err != nil {
+		after = []byte("null")
+	}
+
+	log := models.AuditLog{
+		ActorID:    entry.ActorID,
+		ActorRole:  entry.ActorRole,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Before:     string(before),
+		After:      string(after),
+		IPAddress:  entry.IPAddress,
+		RequestID:  logger.RequestIDFromContext(ctx),
+	}
+	if err := database.DB.WithContext(ctx).Create(&log).Error; err != nil {
+		logger.WithContext(ctx).Error("Failed to record audit log",
+			zap.String("action", string(entry.Action)),
+			zap.String("target_id", entry.TargetID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// List returns a page of audit logs matching filter, newest first, and the total match count
+func (s *AuditService) List(ctx context.Context, filter AuditLogFilter) ([]models.AuditLog, int64, error) {
+	query := database.Reader(database.DB).WithContext(ctx).Model(&models.AuditLog{})
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		query = query.Where("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != nil {
+		query = query.Where("target_id = ?", *filter.TargetID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
+	}
+
+	var logs []models.AuditLog
+	if err := query.Order("created_at DESC").
+		Offset((filter.Page - 1) * filter.Limit).
+		Limit(filter.Limit).
+		Find(&logs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch audit logs: %w", err)
+	}
+
+	return logs, total, nil
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/realtime"
+)
+
+// AvailabilityReason says what changed a tier's availability
+type AvailabilityReason string
+
+const (
+	AvailabilitySnapshot AvailabilityReason = "snapshot"
+	AvailabilityReserved AvailabilityReason = "reservation"
+	AvailabilityReleased AvailabilityReason = "release"
+	AvailabilitySold     AvailabilityReason = "sale"
+	AvailabilityUpdated  AvailabilityReason = "update"
+)
+
+// TierAvailability is the live inventory of one ticket tier
+type TierAvailability struct {
+	TierID    uuid.UUID `json:"tier_id"`
+	TierName  string    `json:"tier_name"`
+	Total     int       `json:"total"`
+	Available int       `json:"available"`
+	Sold      int       `json:"sold"`
+}
+
+// AvailabilityUpdate is pushed to clients watching an event
+type AvailabilityUpdate struct {
+	EventID   uuid.UUID          `json:"event_id"`
+	Reason    AvailabilityReason `json:"reason"`
+	Tiers     []TierAvailability `json:"tiers"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// AvailabilityTopic is the realtime topic carrying an event's availability updates
+func AvailabilityTopic(eventID uuid.UUID) string {
+	return "availability:" + eventID.String()
+}
+
+// EventAvailability returns the current availability of every tier of an event
+func EventAvailability(ctx context.Context, eventID uuid.UUID, reason AvailabilityReason) (*AvailabilityUpdate, error) {
+	var tiers []models.TicketTier
+	if err := database.DB.WithContext(ctx).
+		Select("id", "tier_name", "total_quantity", "available_quantity").
+		Where("event_id = ?", eventID).
+		Order("price ASC").
+		Find(&tiers).Error; err != nil {
+		return nil, fmt.Errorf("failed to load availability: %w", err)
+	}
+
+	update := &AvailabilityUpdate{
+		EventID:   eventID,
+		Reason:    reason,
+		Tiers:     make([]TierAvailability, 0, len(tiers)),
+		UpdatedAt: time.Now(),
+	}
+	for _, tier := range tiers {
+		update.Tiers = append(update.Tiers, TierAvailability{
+			TierID:    tier.ID,
+			TierName:  tier.TierName,
+			Total:     tier.TotalQuantity,
+			Available: tier.AvailableQuantity,
+			Sold:      tier.TotalQuantity - tier.AvailableQuantity,
+		})
+	}
+
+	return update, nil
+}
+
+// publishAvailability pushes the availability of the event owning tierID to
+// its watchers. Failures are logged; live updates never block inventory changes.
+func publishAvailability(ctx context.Context, tierID uuid.UUID, reason AvailabilityReason) {
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Select("id", "event_id").First(&tier, tierID).Error; err != nil {
+		logger.WithContext(ctx).Warn("Failed to resolve tier for availability update", zap.String("tier_id", tierID.String()), zap.Error(err))
+		return
+	}
+
+	publishEventAvailability(ctx, tier.EventID, reason)
+}
+
+// publishEventAvailability pushes the availability of every tier of an event to its watchers
+func publishEventAvailability(ctx context.Context, eventID uuid.UUID, reason AvailabilityReason) {
+	update, err := EventAvailability(ctx, eventID, reason)
+	if err != nil {
+		logger.WithContext(ctx).Warn("Failed to load availability update", zap.String("event_id", eventID.String()), zap.Error(err))
+		return
+	}
+
+	if err := realtime.Publish(ctx, AvailabilityTopic(eventID), update); err != nil {
+		logger.WithContext(ctx).Warn("Failed to publish availability update", zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+}
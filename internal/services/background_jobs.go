@@ -0,0 +1,72 @@
+package services
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/jobs"
+	"eventix-api/pkg/config"
+)
+
+// Jobs the services enqueue in the background job queue. Emails get a job
+// per recipient, so a failed send is retried without repeating the others.
+var (
+	refundJob                = jobs.NewType[RefundJob]("orders.refund")
+	disputeOpenedEmailJob    = jobs.NewType[disputeOpenedEmail]("emails.dispute_opened")
+	eventModeratedEmailJob   = jobs.NewType[eventModeratedEmail]("emails.event_moderated")
+	eventCancelledEmailJob   = jobs.NewType[eventCancelledEmail]("emails.event_cancelled")
+	eventRescheduledEmailJob = jobs.NewType[eventRescheduledEmail]("emails.event_rescheduled")
+	printBadgeJob            = jobs.NewType[printBadge]("badges.print")
+)
+
+// disputeOpenedEmail tells an organizer that a buyer disputed a payment
+type disputeOpenedEmail struct {
+	DisputeID uuid.UUID `json:"dispute_id"`
+}
+
+// eventModeratedEmail tells an organizer why their event was taken down
+type eventModeratedEmail struct {
+	EventID uuid.UUID `json:"event_id"`
+	Reason  string    `json:"reason"`
+}
+
+// eventCancelledEmail tells a ticket holder that their event was cancelled
+type eventCancelledEmail struct {
+	EventID     uuid.UUID `json:"event_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Email       string    `json:"email"`
+	FirstName   string    `json:"first_name"`
+	TicketCount int       `json:"ticket_count"`
+	Reason      string    `json:"reason"`
+}
+
+// eventRescheduledEmail tells a ticket holder their event's new dates
+type eventRescheduledEmail struct {
+	RescheduleID uuid.UUID `json:"reschedule_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	Email        string    `json:"email"`
+	FirstName    string    `json:"first_name"`
+	TicketCount  int       `json:"ticket_count"`
+}
+
+// printBadge prints the badge of a ticket checked in at an event
+type printBadge struct {
+	EventID  uuid.UUID `json:"event_id"`
+	TicketID uuid.UUID `json:"ticket_id"`
+}
+
+// RegisterJobs gives r the handlers of the jobs the services enqueue
+func RegisterJobs(r *jobs.Runner, cfg *config.Config) {
+	cancellations := NewEventCancellationService(cfg)
+	// A refund waits on the payment provider, so it gets longer than an email
+	jobs.Handle(r, refundJob, cancellations.Refund, jobs.Timeout(10*time.Minute))
+	jobs.Handle(r, eventCancelledEmailJob, cancellations.notifyHolder)
+
+	jobs.Handle(r, disputeOpenedEmailJob, NewDisputeService(cfg).notifyOrganizer)
+	jobs.Handle(r, eventModeratedEmailJob, NewModerationService(cfg).notifyOrganizer)
+	jobs.Handle(r, eventRescheduledEmailJob, NewEventRescheduleService(cfg).notifyHolder)
+
+	// Attendees wait at the desk for their badge
+	jobs.Handle(r, printBadgeJob, NewBadgeService().printOnCheckin, jobs.Timeout(time.Minute))
+}
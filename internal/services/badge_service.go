@@ -0,0 +1,271 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/jobs"
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// badgeSignatureHeader carries the hex HMAC-SHA256 of a badge webhook body,
+// keyed with the printer's secret
+const badgeSignatureHeader = "X-Eventix-Signature"
+
+// Badge is the printable badge of a checked-in attendee
+type Badge struct {
+	TicketID     uuid.UUID `json:"ticket_id"`
+	CheckinID    uuid.UUID `json:"checkin_id"`
+	EventID      uuid.UUID `json:"event_id"`
+	EventTitle   string    `json:"event_title"`
+	AttendeeName string    `json:"attendee_name"`
+	TierName     string    `json:"tier_name"`
+	QRCode       string    `json:"qr_code"`
+	CheckedInAt  time.Time `json:"checked_in_at"`
+}
+
+// BadgePrinterInput configures the badge printer of an event
+type BadgePrinterInput struct {
+	Driver       models.BadgeDriver
+	URL          string
+	AutoPrint    bool
+	RotateSecret bool
+}
+
+// BadgeDriver is implemented by every supported badge printer integration
+type BadgeDriver interface {
+	Name() string
+	Print(ctx context.Context, badge *Badge) error
+}
+
+// NewBadgeDriver returns the driver for an event's badge printer
+func NewBadgeDriver(printer *models.BadgePrinter) (BadgeDriver, error) {
+	switch printer.Driver {
+	case models.BadgeDriverWebhook:
+		return &WebhookBadgeDriver{
+			url:        printer.URL,
+			secret:     printer.Secret,
+			httpClient: &http.Client{Timeout: 10 * time.Second},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported badge driver: %s", printer.Driver)
+	}
+}
+
+// WebhookBadgeDriver POSTs each badge as JSON to the organizer's print
+// server, signed so the server can tell it came from us
+type WebhookBadgeDriver struct {
+	url        string
+	secret     string
+	httpClient *http.Client
+}
+
+// Name returns the driver identifier
+func (d *WebhookBadgeDriver) Name() string {
+	return string(models.BadgeDriverWebhook)
+}
+
+// Print sends a badge to the print server
+func (d *WebhookBadgeDriver) Print(ctx context.Context, badge *Badge) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"type":  "badge.print",
+		"badge": badge,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode badge: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	mac := hmac.New(sha256.New, []byte(d.secret))
+	mac.Write(body)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(badgeSignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach badge printer: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("badge printer answered %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// BadgeService builds attendee badges and sends them to an event's printer
+type BadgeService struct{}
+
+// NewBadgeService creates a new badge service
+func NewBadgeService() *BadgeService {
+	return &BadgeService{}
+}
+
+// List returns the badges of attendees checked in to an event after since,
+// in check-in order, so a desk client can poll for new ones
+func (s *BadgeService) List(ctx context.Context, eventID uuid.UUID, since time.Time, limit int) ([]Badge, error) {
+	badges := []Badge{}
+	if err := s.query(ctx).
+		Where("c.event_id = ? AND c.scanned_at > ?", eventID, since).
+		Order("c.scanned_at ASC").
+		Limit(limit).
+		Scan(&badges).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch badges: %w", err)
+	}
+	return badges, nil
+}
+
+// Get returns the badge of a checked-in ticket
+func (s *BadgeService) Get(ctx context.Context, ticketID uuid.UUID) (*Badge, error) {
+	var badges []Badge
+	if err := s.query(ctx).Where("c.ticket_id = ?", ticketID).Limit(1).Scan(&badges).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch badge: %w", err)
+	}
+	if len(badges) == 0 {
+		return nil, utils.NotFoundError("ticket has not been checked in")
+	}
+	return &badges[0], nil
+}
+
+// query selects badges from first event entries; re-entries and session
+// check-ins do not print badges
+func (s *BadgeService) query(ctx context.Context) *gorm.DB {
+	return database.DB.WithContext(ctx).Table("checkins c").
+		Select(`c.ticket_id, c.id AS checkin_id, c.event_id, e.title AS event_title,
+			TRIM(u.first_name || ' ' || u.last_name) AS attendee_name, tt.tier_name,
+			t.qr_code, c.scanned_at AS checked_in_at`).
+		Joins("JOIN tickets t ON t.id = c.ticket_id").
+		Joins("JOIN ticket_tiers tt ON tt.id = t.tier_id").
+		Joins("JOIN users u ON u.id = t.owner_id").
+		Joins("JOIN events e ON e.id = c.event_id").
+		Where("c.session_id IS NULL AND c.direction = ?", models.CheckinIn).
+		Where(`NOT EXISTS (SELECT 1 FROM checkins p
+			WHERE p.ticket_id = c.ticket_id AND p.session_id IS NULL AND p.scanned_at < c.scanned_at)`)
+}
+
+// Printer returns the badge printer of an event, or nil when it has none
+func (s *BadgeService) Printer(ctx context.Context, eventID uuid.UUID) (*models.BadgePrinter, error) {
+	var printer models.BadgePrinter
+	err := database.DB.WithContext(ctx).Where("event_id = ?", eventID).First(&printer).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch badge printer: %w", err)
+	}
+	return &printer, nil
+}
+
+// SetPrinter creates or replaces the badge printer of an event. The signing
+// secret is returned when a new one was generated, and never again.
+func (s *BadgeService) SetPrinter(ctx context.Context, eventID uuid.UUID, input BadgePrinterInput, updatedBy uuid.UUID) (*models.BadgePrinter, string, error) {
+	printer, err := s.Printer(ctx, eventID)
+	if err != nil {
+		return nil, "", err
+	}
+	if printer == nil {
+		printer = &models.BadgePrinter{EventID: eventID}
+	}
+
+	printer.Driver = input.Driver
+	printer.URL = input.URL
+	printer.AutoPrint = input.AutoPrint
+	printer.UpdatedBy = updatedBy
+	if _, err := NewBadgeDriver(printer); err != nil {
+		return nil, "", utils.BadRequestError("%s", err)
+	}
+
+	var secret string
+	if printer.Secret == "" || input.RotateSecret {
+		if secret, err = utils.GenerateRandomString(48); err != nil {
+			return nil, "", fmt.Errorf("failed to generate badge printer secret: %w", err)
+		}
+		printer.Secret = secret
+	}
+
+	if err := database.DB.WithContext(ctx).Save(printer).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to save badge printer: %w", err)
+	}
+	return printer, secret, nil
+}
+
+// RemovePrinter stops sending an event's badges to its printer
+func (s *BadgeService) RemovePrinter(ctx context.Context, eventID uuid.UUID) error {
+	result := database.DB.WithContext(ctx).Where("event_id = ?", eventID).Delete(&models.BadgePrinter{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove badge printer: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.NotFoundError("event has no badge printer")
+	}
+	return nil
+}
+
+// Print sends a badge to its event's printer
+func (s *BadgeService) Print(ctx context.Context, badge *Badge) error {
+	printer, err := s.Printer(ctx, badge.EventID)
+	if err != nil {
+		return err
+	}
+	if printer == nil {
+		return utils.BadRequestError("event has no badge printer")
+	}
+
+	driver, err := NewBadgeDriver(printer)
+	if err != nil {
+		return err
+	}
+	if err := driver.Print(ctx, badge); err != nil {
+		return utils.NewAppError(http.StatusBadGateway, utils.CodeServiceUnavailable, "badge printer could not be reached").Wrap(err)
+	}
+	return nil
+}
+
+// queueCheckinBadge enqueues the badge of a ticket checked in within tx when
+// its event's printer prints automatically, so the job runner prints it once
+// tx commits. A printer that stays unreachable leaves the job dead lettered;
+// the desk can reprint.
+func queueCheckinBadge(tx *gorm.DB, checkin *models.Checkin) error {
+	var printers int64
+	if err := tx.Model(&models.BadgePrinter{}).
+		Where("event_id = ? AND auto_print = ?", checkin.EventID, true).
+		Count(&printers).Error; err != nil {
+		return fmt.Errorf("failed to fetch badge printer: %w", err)
+	}
+	if printers == 0 {
+		return nil
+	}
+	_, err := printBadgeJob.EnqueueTx(tx, printBadge{EventID: checkin.EventID, TicketID: checkin.TicketID}, jobs.MaxAttempts(3))
+	return err
+}
+
+// printOnCheckin prints the badge of a checked-in ticket, unless its event's
+// printer stopped printing automatically since the check-in
+func (s *BadgeService) printOnCheckin(ctx context.Context, job printBadge) error {
+	printer, err := s.Printer(ctx, job.EventID)
+	if err != nil || printer == nil || !printer.AutoPrint {
+		return err
+	}
+
+	badge, err := s.Get(ctx, job.TicketID)
+	if err != nil {
+		return err
+	}
+	return s.Print(ctx, badge)
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+const (
+	// CalendarContentType is the MIME type of iCalendar files
+	CalendarContentType = "text/calendar; charset=utf-8"
+
+	// icsTimeFormat is the iCalendar UTC date-time form (RFC 5545 §3.3.5)
+	icsTimeFormat = "20060102T150405Z"
+)
+
+// CalendarLinks are "add to calendar" links for web calendars
+type CalendarLinks struct {
+	Google  string
+	Outlook string
+}
+
+// CalendarService builds calendar entries for purchased events
+type CalendarService struct{}
+
+// NewCalendarService creates a new calendar service
+func NewCalendarService() *CalendarService {
+	return &CalendarService{}
+}
+
+// CalendarFilename returns the file name calendar invites are downloaded and attached as
+func CalendarFilename(event *models.Event) string {
+	name := event.Slug
+	if name == "" {
+		name = utils.Slugify(event.Title)
+	}
+	if name == "" {
+		name = "event"
+	}
+	return name + ".ics"
+}
+
+// TicketInvite renders an iCalendar file for the event of an active ticket
+func (s *CalendarService) TicketInvite(ctx context.Context, ticketID uuid.UUID) ([]byte, *models.Event, error) {
+	var ticket models.Ticket
+	if err := database.DB.WithContext(ctx).Preload("Tier").Preload("Tier.Event").First(&ticket, ticketID).Error; err != nil {
+		return nil, nil, fmt.Errorf("ticket not found")
+	}
+
+	if ticket.Status != models.TicketActive && ticket.Status != models.TicketUsed {
+		return nil, nil, fmt.Errorf("calendar invites are only available for active tickets")
+	}
+
+	event := ticket.Tier.Event
+	description := fmt.Sprintf("%s ticket\nTicket reference: %s\nOrder: %s", ticket.Tier.TierName, ticket.ID, ticket.OrderID)
+	return renderICS(&event, "ticket-"+ticket.ID.String(), description), &event, nil
+}
+
+// OrderInvite renders an iCalendar file for the event of an order
+func (s *CalendarService) OrderInvite(event *models.Event, orderID uuid.UUID, ticketCount int) []byte {
+	description := fmt.Sprintf("%d ticket(s)\nOrder reference: %s", ticketCount, orderID)
+	return renderICS(event, "order-"+orderID.String(), description)
+}
+
+// Links returns add-to-calendar links for an event
+func (s *CalendarService) Links(event *models.Event, details string) CalendarLinks {
+	google := url.Values{}
+	google.Set("action", "TEMPLATE")
+	google.Set("text", event.Title)
+	google.Set("dates", event.StartTime.UTC().Format(icsTimeFormat)+"/"+event.EndTime.UTC().Format(icsTimeFormat))
+	google.Set("details", details)
+	google.Set("location", eventAddress(event))
+
+	outlook := url.Values{}
+	outlook.Set("path", "/calendar/action/compose")
+	outlook.Set("rru", "addevent")
+	outlook.Set("subject", event.Title)
+	outlook.Set("startdt", event.StartTime.UTC().Format(time.RFC3339))
+	outlook.Set("enddt", event.EndTime.UTC().Format(time.RFC3339))
+	outlook.Set("body", details)
+	outlook.Set("location", eventAddress(event))
+
+	return CalendarLinks{
+		Google:  "https://calendar.google.com/calendar/render?" + google.Encode(),
+		Outlook: "https://outlook.live.com/calendar/0/deeplink/compose?" + outlook.Encode(),
+	}
+}
+
+// renderICS builds a single-event VCALENDAR (RFC 5545). uid must be stable
+// so re-imports update the existing calendar entry instead of duplicating it.
+func renderICS(event *models.Event, uid, description string) []byte {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Eventix//Tickets//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"BEGIN:VEVENT",
+		"UID:" + uid + "@eventix",
+		"DTSTAMP:" + time.Now().UTC().Format(icsTimeFormat),
+		"DTSTART:" + event.StartTime.UTC().Format(icsTimeFormat),
+		"DTEND:" + event.EndTime.UTC().Format(icsTimeFormat),
+		"SUMMARY:" + escapeICSText(event.Title),
+		"LOCATION:" + escapeICSText(eventAddress(event)),
+		"DESCRIPTION:" + escapeICSText(description),
+		"STATUS:" + icsStatus(event.Status),
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"DESCRIPTION:" + escapeICSText(event.Title),
+		"TRIGGER:-PT1H",
+		"END:VALARM",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(foldICSLine(line))
+		b.WriteString("\r\n")
+	}
+	return []byte(b.String())
+}
+
+func eventAddress(event *models.Event) string {
+	if event.Venue != "" {
+		return event.Venue + ", " + event.Location
+	}
+	return event.Location
+}
+
+func icsStatus(status models.EventStatus) string {
+	if status == models.EventCancelled || status == models.EventBanned {
+		return "CANCELLED"
+	}
+	return "CONFIRMED"
+}
+
+// escapeICSText escapes a TEXT property value (RFC 5545 §3.3.11)
+func escapeICSText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
+
+// foldICSLine splits content lines longer than 75 octets (RFC 5545 §3.1)
+// without breaking multi-byte characters
+func foldICSLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
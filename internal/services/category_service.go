@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// CategoryInput describes a new category. An empty Slug is derived from Name.
+type CategoryInput struct {
+	Name string
+	Slug string
+	Icon string
+}
+
+// CategoryUpdate holds the category settings to change; nil fields are left
+// as they are. Slugs cannot change because events refer to them.
+type CategoryUpdate struct {
+	Name     *string
+	Icon     *string
+	IsActive *bool
+}
+
+// CategoryService manages the categories events are filed under
+type CategoryService struct{}
+
+// NewCategoryService creates a new category service
+func NewCategoryService() *CategoryService {
+	return &CategoryService{}
+}
+
+// List returns the categories by name. Inactive categories are only
+// included when includeInactive is set.
+func (s *CategoryService) List(ctx context.Context, includeInactive bool) ([]models.Category, error) {
+	query := database.Reader(database.DB).WithContext(ctx).Order("name ASC")
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	var categories []models.Category
+	if err := query.Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch categories: %w", err)
+	}
+	return categories, nil
+}
+
+// Create adds a category
+func (s *CategoryService) Create(ctx context.Context, input CategoryInput) (*models.Category, error) {
+	category := models.Category{
+		Name:     strings.TrimSpace(input.Name),
+		Slug:     utils.Slugify(input.Slug),
+		Icon:     input.Icon,
+		IsActive: true,
+	}
+	if category.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if category.Slug == "" {
+		category.Slug = utils.Slugify(category.Name)
+	}
+	if category.Slug == "" {
+		return nil, fmt.Errorf("slug must contain letters or digits")
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&models.Category{}).
+		Where("slug = ?", category.Slug).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to check slug: %w", err)
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("category %s already exists", category.Slug)
+	}
+
+	if err := database.DB.WithContext(ctx).Create(&category).Error; err != nil {
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+	return &category, nil
+}
+
+// Update applies update to a category. Deactivating a category hides it
+// from new events; events already filed under it keep it.
+func (s *CategoryService) Update(ctx context.Context, categoryID uuid.UUID, update CategoryUpdate) (*models.Category, error) {
+	var category models.Category
+	if err := database.DB.WithContext(ctx).First(&category, categoryID).Error; err != nil {
+		return nil, fmt.Errorf("category not found")
+	}
+
+	if update.Name != nil {
+		category.Name = strings.TrimSpace(*update.Name)
+	}
+	if update.Icon != nil {
+		category.Icon = *update.Icon
+	}
+	if update.IsActive != nil {
+		category.IsActive = *update.IsActive
+	}
+	if category.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	if err := database.DB.WithContext(ctx).Model(&category).
+		Select("name", "icon", "is_active").
+		Updates(&category).Error; err != nil {
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+	return &category, nil
+}
+
+// Delete removes a category no event has ever been filed under. Categories
+// in use can only be deactivated.
+func (s *CategoryService) Delete(ctx context.Context, categoryID uuid.UUID) error {
+	var category models.Category
+	if err := database.DB.WithContext(ctx).First(&category, categoryID).Error; err != nil {
+		return fmt.Errorf("category not found")
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Unscoped().Model(&models.Event{}).
+		Where("category = ?", category.Slug).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check category events: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("category is used by %d events; deactivate it instead", count)
+	}
+
+	if err := database.DB.WithContext(ctx).Delete(&category).Error; err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+	return nil
+}
+
+// Validate checks that slug names an active category
+func (s *CategoryService) Validate(ctx context.Context, slug string) error {
+	var count int64
+	if err := database.Reader(database.DB).WithContext(ctx).Model(&models.Category{}).
+		Where("slug = ? AND is_active = ?", slug, true).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check category: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("unknown category %q", slug)
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/realtime"
+	"eventix-api/pkg/utils"
+)
+
+// checkinStatsTTL keeps live stats fresh while absorbing dashboard polling
+const checkinStatsTTL = 10 * time.Second
+
+// CheckinUndoWindow is how long after a scan a mistaken check-in can be undone
+const CheckinUndoWindow = 5 * time.Minute
+
+// maxAttendeeMatches caps the results of a manual check-in search
+const maxAttendeeMatches = 20
+
+// TierCheckinStats holds check-in counts for a single ticket tier
+type TierCheckinStats struct {
+	TierID    uuid.UUID `json:"tier_id"`
+	TierName  string    `json:"tier_name"`
+	Total     int64     `json:"total"`
+	CheckedIn int64     `json:"checked_in"`
+}
+
+// CheckinRateBucket holds the number of check-ins within a time bucket
+type CheckinRateBucket struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Count       int64     `json:"count"`
+}
+
+// CheckinStats summarizes attendance for an event
+type CheckinStats struct {
+	EventID       uuid.UUID           `json:"event_id"`
+	TotalTickets  int64               `json:"total_tickets"`
+	CheckedIn     int64               `json:"checked_in"`
+	Remaining     int64               `json:"remaining"`
+	BucketMinutes int                 `json:"bucket_minutes"`
+	Tiers         []TierCheckinStats  `json:"tiers"`
+	Rate          []CheckinRateBucket `json:"rate"`
+	GeneratedAt   time.Time           `json:"generated_at"`
+}
+
+// CheckinFeedEntry is pushed to organizer dashboards for each successful check-in
+type CheckinFeedEntry struct {
+	TicketID     uuid.UUID               `json:"ticket_id"`
+	EventID      uuid.UUID               `json:"event_id"`
+	TierID       uuid.UUID               `json:"tier_id"`
+	TierName     string                  `json:"tier_name"`
+	AttendeeName string                  `json:"attendee_name"`
+	Direction    models.CheckinDirection `json:"direction"`
+	ScannedBy    uuid.UUID               `json:"scanned_by"`
+	ScannedAt    time.Time               `json:"scanned_at"`
+}
+
+// ScanRecord is one scan of a ticket: an entry to or exit from its event, or
+// an entry to one of its sessions
+type ScanRecord struct {
+	ID        uuid.UUID               `json:"id"`
+	SessionID *uuid.UUID              `json:"session_id,omitempty"`
+	ZoneID    *uuid.UUID              `json:"zone_id,omitempty"`
+	Direction models.CheckinDirection `json:"direction"`
+	Method    models.CheckinMethod    `json:"method"`
+	ScannedBy uuid.UUID               `json:"scanned_by"`
+	Device    string                  `json:"device,omitempty"`
+	ScannedAt time.Time               `json:"scanned_at"`
+}
+
+// ScanHistory lists every scan of a ticket, oldest first
+type ScanHistory struct {
+	TicketID uuid.UUID           `json:"ticket_id"`
+	EventID  uuid.UUID           `json:"event_id"`
+	Status   models.TicketStatus `json:"status"`
+	Inside   bool                `json:"inside"` // the last event scan was an entry
+	Scans    []ScanRecord        `json:"scans"`
+}
+
+// AttendeeMatch is a ticket found by its owner's name or email for manual
+// check-in
+type AttendeeMatch struct {
+	TicketID     uuid.UUID           `json:"ticket_id"`
+	TierName     string              `json:"tier_name"`
+	AttendeeName string              `json:"attendee_name"`
+	Email        string              `json:"email"`
+	Status       models.TicketStatus `json:"status"`
+	CheckedInAt  *time.Time          `json:"checked_in_at,omitempty"`
+}
+
+// CheckinFeedTopic is the realtime topic carrying an event's check-ins
+func CheckinFeedTopic(eventID uuid.UUID) string {
+	return "checkins:" + eventID.String()
+}
+
+// CheckinService handles check-in reporting
+type CheckinService struct{}
+
+// NewCheckinService creates a new check-in service
+func NewCheckinService() *CheckinService {
+	return &CheckinService{}
+}
+
+// GetEventStats returns attendance stats for an event with check-ins grouped
+// into buckets of bucketMinutes. Results are cached briefly in Redis.
+func (s *CheckinService) GetEventStats(eventID uuid.UUID, bucketMinutes int) (*CheckinStats, error) {
+	ctx := context.Background()
+	cacheKey := fmt.Sprintf("checkin_stats:%s:%d", eventID, bucketMinutes)
+
+	var stats CheckinStats
+	if err := cache.GetValue(ctx, cacheKey, &stats); err == nil {
+		return &stats, nil
+	}
+
+	stats = CheckinStats{
+		EventID:       eventID,
+		BucketMinutes: bucketMinutes,
+		GeneratedAt:   time.Now(),
+	}
+
+	if err := database.DB.Table("ticket_tiers tt").
+		Select(`tt.id AS tier_id, tt.tier_name,
+			COUNT(t.id) FILTER (WHERE t.status IN ?) AS total,
+			COUNT(t.id) FILTER (WHERE t.status = ?) AS checked_in`,
+			[]models.TicketStatus{models.TicketActive, models.TicketUsed}, models.TicketUsed).
+		Joins("LEFT JOIN tickets t ON t.tier_id = tt.id AND t.deleted_at IS NULL").
+		Where("tt.event_id = ? AND tt.deleted_at IS NULL", eventID).
+		Group("tt.id, tt.tier_name").
+		Order("tt.tier_name ASC").
+		Scan(&stats.Tiers).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate tier check-ins: %w", err)
+	}
+
+	for _, tier := range stats.Tiers {
+		stats.TotalTickets += tier.Total
+		stats.CheckedIn += tier.CheckedIn
+	}
+	stats.Remaining = stats.TotalTickets - stats.CheckedIn
+
+	bucketSeconds := bucketMinutes * 60
+	if err := database.DB.Model(&models.Checkin{}).
+		Select("to_timestamp(floor(extract(epoch FROM scanned_at) / ?) * ?) AS bucket_start, COUNT(*) AS count",
+			bucketSeconds, bucketSeconds).
+		Where("event_id = ? AND direction = ?", eventID, models.CheckinIn).
+		Group("bucket_start").
+		Order("bucket_start ASC").
+		Scan(&stats.Rate).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate check-in rate: %w", err)
+	}
+
+	_ = cache.Set(ctx, cacheKey, stats, checkinStatsTTL)
+
+	return &stats, nil
+}
+
+// SearchAttendees finds the event's active and checked-in tickets whose
+// owner's name or email contains query
+func (s *CheckinService) SearchAttendees(ctx context.Context, eventID uuid.UUID, query string) ([]AttendeeMatch, error) {
+	pattern := "%" + escapeLike(strings.TrimSpace(query)) + "%"
+
+	matches := []AttendeeMatch{}
+	if err := database.DB.WithContext(ctx).Table("tickets t").
+		Select(`t.id AS ticket_id, tt.tier_name, TRIM(u.first_name || ' ' || u.last_name) AS attendee_name,
+			u.email, t.status, t.checked_in_at`).
+		Joins("JOIN ticket_tiers tt ON tt.id = t.tier_id").
+		Joins("JOIN users u ON u.id = t.owner_id").
+		Where("tt.event_id = ? AND t.deleted_at IS NULL AND t.status IN ?",
+			eventID, []models.TicketStatus{models.TicketActive, models.TicketUsed}).
+		Where("(u.first_name || ' ' || u.last_name) ILIKE ? OR u.email ILIKE ?", pattern, pattern).
+		Order("u.last_name ASC, u.first_name ASC").
+		Limit(maxAttendeeMatches).
+		Scan(&matches).Error; err != nil {
+		return nil, fmt.Errorf("failed to search attendees: %w", err)
+	}
+	return matches, nil
+}
+
+// Get returns a check-in
+func (s *CheckinService) Get(ctx context.Context, checkinID uuid.UUID) (*models.Checkin, error) {
+	var checkin models.Checkin
+	if err := database.DB.WithContext(ctx).First(&checkin, checkinID).Error; err != nil {
+		return nil, utils.NotFoundError("check-in not found")
+	}
+	return &checkin, nil
+}
+
+// History returns every scan of a ticket
+func (s *CheckinService) History(ctx context.Context, ticketID uuid.UUID) (*ScanHistory, error) {
+	var ticket models.Ticket
+	if err := database.DB.WithContext(ctx).Preload("Tier").First(&ticket, ticketID).Error; err != nil {
+		return nil, utils.NotFoundError("ticket not found")
+	}
+
+	history := ScanHistory{
+		TicketID: ticket.ID,
+		EventID:  ticket.Tier.EventID,
+		Status:   ticket.Status,
+		Scans:    []ScanRecord{},
+	}
+	if err := database.DB.WithContext(ctx).Model(&models.Checkin{}).
+		Select("id, session_id, zone_id, direction, method, scanned_by, device_info AS device, scanned_at").
+		Where("ticket_id = ?", ticketID).
+		Order("scanned_at ASC").
+		Scan(&history.Scans).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch scans: %w", err)
+	}
+
+	for _, scan := range history.Scans {
+		if scan.SessionID == nil {
+			history.Inside = scan.Direction == models.CheckinIn
+		}
+	}
+	return &history, nil
+}
+
+// Undo reverses a mistaken check-in within CheckinUndoWindow of the scan.
+// Undoing a ticket's only event entry makes it active again; undoing a
+// session check-in frees its seat in the session.
+func (s *CheckinService) Undo(ctx context.Context, checkin *models.Checkin) error {
+	if time.Since(checkin.ScannedAt) > CheckinUndoWindow {
+		return utils.BadRequestError("check-ins can only be undone within %s of the scan", CheckinUndoWindow)
+	}
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		result := tx.WithContext(ctx).Delete(&models.Checkin{}, checkin.ID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to undo check-in: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return utils.ConflictError("check-in was already undone")
+		}
+
+		if checkin.SessionID == nil && checkin.Direction == models.CheckinIn {
+			// At events allowing re-entry the ticket may have other scans
+			var remaining int64
+			if err := tx.WithContext(ctx).Model(&models.Checkin{}).
+				Where("ticket_id = ? AND session_id IS NULL", checkin.TicketID).
+				Count(&remaining).Error; err != nil {
+				return fmt.Errorf("failed to check remaining scans: %w", err)
+			}
+			if remaining > 0 {
+				return nil
+			}
+
+			if err := tx.WithContext(ctx).Model(&models.Ticket{}).
+				Where("id = ? AND status = ?", checkin.TicketID, models.TicketUsed).
+				Updates(map[string]interface{}{"status": models.TicketActive, "checked_in_at": nil}).Error; err != nil {
+				return fmt.Errorf("failed to reactivate ticket: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil || checkin.SessionID != nil {
+		return err
+	}
+
+	// Take the scan back out of the occupancy counts too
+	occupancy := NewOccupancyService()
+	if checkin.Direction == models.CheckinOut {
+		return occupancy.EnterEvent(ctx, checkin.EventID, 0)
+	}
+	occupancy.LeaveEvent(ctx, checkin.EventID)
+	occupancy.LeaveZones(ctx, checkin.EventID, checkin.TicketID)
+	return nil
+}
+
+// alreadyScannedError tells door staff when and where a ticket was checked
+// in, so a duplicate scan can be told apart from a copied ticket
+func alreadyScannedError(ctx context.Context, ticketID uuid.UUID, sessionID *uuid.UUID) error {
+	query := database.DB.WithContext(ctx).Preload("Scanner").Where("ticket_id = ?", ticketID).Order("scanned_at DESC")
+	if sessionID == nil {
+		query = query.Where("session_id IS NULL AND direction = ?", models.CheckinIn)
+	} else {
+		query = query.Where("session_id = ?", *sessionID)
+	}
+
+	var checkin models.Checkin
+	if err := query.First(&checkin).Error; err != nil {
+		return utils.ConflictError("ticket already checked in")
+	}
+
+	at := checkin.ScannedAt.UTC().Format("15:04 MST")
+	by := checkin.DeviceInfo
+	if by == "" {
+		by = strings.TrimSpace(checkin.Scanner.FirstName + " " + checkin.Scanner.LastName)
+	}
+	if by == "" {
+		return utils.ConflictError("ticket already scanned at %s", at)
+	}
+	return utils.ConflictError("ticket already scanned at %s by %s", at, by)
+}
+
+// publishCheckin pushes a check-in to the event's live feed. Failures are
+// logged; the check-in itself has already been recorded.
+func publishCheckin(ctx context.Context, ticket *models.Ticket, checkin *models.Checkin) {
+	entry := CheckinFeedEntry{
+		TicketID:  ticket.ID,
+		EventID:   checkin.EventID,
+		TierID:    ticket.TierID,
+		Direction: checkin.Direction,
+		ScannedBy: checkin.ScannedBy,
+		ScannedAt: checkin.ScannedAt,
+	}
+
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Select("id", "tier_name").First(&tier, ticket.TierID).Error; err == nil {
+		entry.TierName = tier.TierName
+	}
+	var owner models.User
+	if err := database.DB.WithContext(ctx).Select("id", "first_name", "last_name").First(&owner, ticket.OwnerID).Error; err == nil {
+		entry.AttendeeName = strings.TrimSpace(owner.FirstName + " " + owner.LastName)
+	}
+
+	if err := realtime.Publish(ctx, CheckinFeedTopic(checkin.EventID), entry); err != nil {
+		logger.WithContext(ctx).Warn("Failed to publish check-in", zap.String("event_id", checkin.EventID.String()), zap.Error(err))
+	}
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern so user input matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
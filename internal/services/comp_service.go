@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+)
+
+// CompRecipient is someone an organizer gives complimentary tickets to
+type CompRecipient struct {
+	Email     string
+	FirstName string
+	LastName  string
+	Quantity  int
+}
+
+// CompService issues complimentary tickets on behalf of organizers
+type CompService struct {
+	notifier *OrderNotifier
+}
+
+// NewCompService creates a new comp ticket service
+func NewCompService(cfg *config.Config) *CompService {
+	return &CompService{
+		notifier: NewOrderNotifier(&cfg.Email, &cfg.SMS, cfg.Server.FrontendURL),
+	}
+}
+
+// Issue gives each recipient free tickets to one tier of a published event.
+// Every recipient gets a zero-priced paid order flagged as a comp, so comps
+// use up tier stock but stay out of sales figures. Recipients without an
+// account get a guest account they can claim from the confirmation email.
+func (s *CompService) Issue(ctx context.Context, eventID, tierID uuid.UUID, recipients []CompRecipient) ([]models.Order, error) {
+	total := 0
+	seen := make(map[string]bool, len(recipients))
+	for i := range recipients {
+		recipients[i].Email = strings.ToLower(strings.TrimSpace(recipients[i].Email))
+		if seen[recipients[i].Email] {
+			return nil, fmt.Errorf("%s is listed more than once", recipients[i].Email)
+		}
+		seen[recipients[i].Email] = true
+
+		if recipients[i].Quantity < 1 {
+			recipients[i].Quantity = 1
+		}
+		total += recipients[i].Quantity
+	}
+
+	orders := make([]models.Order, 0, len(recipients))
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		// Lock the tier so comps and reservations cannot oversell it together
+		var tier models.TicketTier
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND event_id = ?", tierID, eventID).
+			First(&tier).Error; err != nil {
+			return fmt.Errorf("ticket tier not found")
+		}
+
+		var event models.Event
+		if err := tx.Select("id", "status").First(&event, eventID).Error; err != nil {
+			return fmt.Errorf("event not found")
+		}
+		if event.Status != models.EventPublished {
+			return fmt.Errorf("comp tickets can only be issued for published events")
+		}
+
+		if tier.AvailableQuantity < total {
+			return fmt.Errorf("only %d tickets available", tier.AvailableQuantity)
+		}
+		if err := tx.Model(&models.TicketTier{}).
+			Where("id = ?", tierID).
+			UpdateColumn("available_quantity", gorm.Expr("available_quantity - ?", total)).Error; err != nil {
+			return fmt.Errorf("failed to allocate tickets: %w", err)
+		}
+
+		for _, recipient := range recipients {
+			user, err := compRecipientUser(tx, recipient)
+			if err != nil {
+				return err
+			}
+
+			order := models.Order{
+				UserID:   user.ID,
+				TierID:   tierID,
+				Quantity: recipient.Quantity,
+				Currency: tier.Currency,
+				Status:   models.OrderPaid,
+				IsComp:   true,
+				Items: []models.OrderItem{{
+					Type:     models.OrderItemTicket,
+					TierID:   &tierID,
+					Name:     tier.TierName,
+					Quantity: recipient.Quantity,
+				}},
+			}
+			if err := tx.Create(&order).Error; err != nil {
+				return fmt.Errorf("failed to create order: %w", err)
+			}
+			if _, err := createTicketsForOrder(tx, &order); err != nil {
+				return err
+			}
+			orders = append(orders, order)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range orders {
+		publishOrderPaid(ctx, &orders[i])
+		s.notifier.OrderConfirmed(ctx, &orders[i])
+	}
+
+	return orders, nil
+}
+
+// compRecipientUser returns the account for a comp recipient's email,
+// creating a guest account when there is none
+func compRecipientUser(tx *gorm.DB, recipient CompRecipient) (*models.User, error) {
+	var user models.User
+	err := tx.Where("email = ?", recipient.Email).First(&user).Error
+	if err == nil {
+		if !user.IsActive {
+			return nil, fmt.Errorf("the account for %s is deactivated", recipient.Email)
+		}
+		return &user, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to look up %s: %w", recipient.Email, err)
+	}
+
+	user = models.User{
+		Email:     recipient.Email,
+		FirstName: recipient.FirstName,
+		LastName:  recipient.LastName,
+		Role:      models.RoleGuest,
+		IsActive:  true,
+	}
+	if err := tx.Create(&user).Error; err != nil {
+		return nil, fmt.Errorf("failed to create an account for %s: %w", recipient.Email, err)
+	}
+	return &user, nil
+}
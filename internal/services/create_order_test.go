@@ -0,0 +1,237 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/utils"
+)
+
+// fakeReservations serves reservations from memory and records what is done
+// with them. Once taken, as by the expiry worker, they cannot be consumed.
+type fakeReservations struct {
+	reservations map[string]*services.ReservationData
+	taken        bool
+	consumed     []string
+	released     int
+}
+
+func (r *fakeReservations) GetReservation(reservationID string) (*services.ReservationData, error) {
+	reservation, ok := r.reservations[reservationID]
+	if !ok {
+		return nil, fmt.Errorf("reservation not found or expired")
+	}
+	return reservation, nil
+}
+
+func (r *fakeReservations) ConsumeReservation(reservationID string) error {
+	if _, ok := r.reservations[reservationID]; !ok || r.taken {
+		return fmt.Errorf("reservation not found or expired")
+	}
+	delete(r.reservations, reservationID)
+	r.consumed = append(r.consumed, reservationID)
+	return nil
+}
+
+func (r *fakeReservations) ReleaseTickets(tierID uuid.UUID, quantity int) error {
+	r.released += quantity
+	return nil
+}
+
+// fakeOrderStore prices orders at their tickets' price and keeps them in
+// memory. Place fails with failBeforeClaim before claiming the reservation,
+// as a sold-out add-on does, and with failAfterClaim once it has.
+type fakeOrderStore struct {
+	failBeforeClaim error
+	failAfterClaim  error
+	placed          []*models.Order
+}
+
+func (s *fakeOrderStore) Quote(ctx context.Context, cfg *config.Config, reservation *services.ReservationData, input services.CreateOrderInput) (*models.Order, error) {
+	return &models.Order{TotalAmount: reservation.TotalPrice}, nil
+}
+
+func (s *fakeOrderStore) Place(ctx context.Context, order *models.Order, claim func() error) ([]models.Ticket, error) {
+	if s.failBeforeClaim != nil {
+		return nil, s.failBeforeClaim
+	}
+	if err := claim(); err != nil {
+		return nil, err
+	}
+	if s.failAfterClaim != nil {
+		return nil, s.failAfterClaim
+	}
+	order.ID = uuid.New()
+	s.placed = append(s.placed, order)
+	return nil, nil
+}
+
+// fakeCheckout is a buyer holding a reservation of two tickets in fakes
+type fakeCheckout struct {
+	buyer        uuid.UUID
+	reservation  *services.ReservationData
+	reservations *fakeReservations
+	store        *fakeOrderStore
+}
+
+func newFakeCheckout() *fakeCheckout {
+	reservation := &services.ReservationData{
+		ReservationID: utils.GenerateReservationID(),
+		UserID:        uuid.New(),
+		TierID:        uuid.New(),
+		EventID:       uuid.New(),
+		Quantity:      2,
+		UnitPrice:     5000,
+		TotalPrice:    10000,
+		Currency:      "NGN",
+		ExpiresAt:     time.Now().Add(10 * time.Minute),
+	}
+	return &fakeCheckout{
+		buyer:       reservation.UserID,
+		reservation: reservation,
+		reservations: &fakeReservations{
+			reservations: map[string]*services.ReservationData{reservation.ReservationID: reservation},
+		},
+		store: &fakeOrderStore{},
+	}
+}
+
+func (c *fakeCheckout) createOrder(userID uuid.UUID) (*models.Order, error) {
+	cfg := &config.Config{Limits: config.LimitsConfig{OrderExpiry: 15 * time.Minute}}
+	order, _, err := services.NewOrderService(c.reservations, c.store).CreateOrder(context.Background(), cfg, services.CreateOrderInput{
+		UserID:        userID,
+		ReservationID: c.reservation.ReservationID,
+	})
+	return order, err
+}
+
+// expectStatus fails the test unless err is an AppError with status
+func expectStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("error = %v, want an error with status %d", err, status)
+	}
+	if appErr.Status != status {
+		t.Errorf("status = %d (%s), want %d", appErr.Status, appErr.Message, status)
+	}
+}
+
+func TestCreateOrderPending(t *testing.T) {
+	c := newFakeCheckout()
+
+	order, err := c.createOrder(c.buyer)
+	if err != nil {
+		t.Fatalf("create order: %v", err)
+	}
+	if order.Status != models.OrderPending || order.ExpiresAt == nil {
+		t.Errorf("order is %s expiring at %v, want %s with an expiry", order.Status, order.ExpiresAt, models.OrderPending)
+	}
+	if order.UserID != c.buyer || order.TierID != c.reservation.TierID || order.Quantity != c.reservation.Quantity {
+		t.Errorf("order is for %s of %d of tier %s, want the reservation's", order.UserID, order.Quantity, order.TierID)
+	}
+	if !services.SupportsCurrency(order.PaymentProvider, "NGN") {
+		t.Errorf("provider %s cannot charge NGN", order.PaymentProvider)
+	}
+	if len(c.store.placed) != 1 || len(c.reservations.consumed) != 1 || c.reservations.released != 0 {
+		t.Errorf("placed %d orders, consumed %d reservations and released %d tickets, want 1, 1 and 0",
+			len(c.store.placed), len(c.reservations.consumed), c.reservations.released)
+	}
+}
+
+func TestCreateOrderFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(c *fakeCheckout) uuid.UUID // returns the caller
+		status   int                             // 0 for an error without one
+		consumed bool
+		released int
+	}{
+		{
+			name: "missing reservation",
+			setup: func(c *fakeCheckout) uuid.UUID {
+				delete(c.reservations.reservations, c.reservation.ReservationID)
+				return c.buyer
+			},
+			status: 400,
+		},
+		{
+			name: "expired reservation",
+			setup: func(c *fakeCheckout) uuid.UUID {
+				c.reservation.ExpiresAt = time.Now().Add(-time.Minute)
+				return c.buyer
+			},
+			status: 400,
+		},
+		{
+			name: "other user's reservation",
+			setup: func(c *fakeCheckout) uuid.UUID {
+				return uuid.New()
+			},
+			status: 403,
+		},
+		{
+			// The reservation is left for another attempt without the add-on
+			name: "sold-out add-on",
+			setup: func(c *fakeCheckout) uuid.UUID {
+				c.store.failBeforeClaim = utils.BadRequestError("Parking is sold out")
+				return c.buyer
+			},
+			status: 400,
+		},
+		{
+			// The expiry worker took the reservation between reading and
+			// claiming it, and releases its tickets itself
+			name: "reservation claimed concurrently",
+			setup: func(c *fakeCheckout) uuid.UUID {
+				c.reservations.taken = true
+				return c.buyer
+			},
+			status: 400,
+		},
+		{
+			// The consumed reservation no longer holds the tickets, so they
+			// are released, once
+			name: "failure after consuming the reservation",
+			setup: func(c *fakeCheckout) uuid.UUID {
+				c.store.failAfterClaim = errors.New("failed to start order saga")
+				return c.buyer
+			},
+			consumed: true,
+			released: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeCheckout()
+			caller := tt.setup(c)
+
+			_, err := c.createOrder(caller)
+			if err == nil {
+				t.Fatal("create order succeeded")
+			}
+			if tt.status != 0 {
+				expectStatus(t, err, tt.status)
+			}
+			if len(c.store.placed) != 0 {
+				t.Errorf("placed %d orders, want 0", len(c.store.placed))
+			}
+			if consumed := len(c.reservations.consumed) > 0; consumed != tt.consumed {
+				t.Errorf("reservation consumed = %v, want %v", consumed, tt.consumed)
+			}
+			if c.reservations.released != tt.released {
+				t.Errorf("released %d tickets, want %d", c.reservations.released, tt.released)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/currency"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// DisputeNotice is a dispute update carried by a provider webhook
+type DisputeNotice struct {
+	ID            string
+	PaymentIntent string // Stripe only, used to find the payment when the event has no reference
+	Amount        int64  // minor units
+	Currency      string
+	Reason        string
+	Status        models.DisputeStatus // open until the provider decides
+	DueBy         *time.Time
+}
+
+// DisputeFilter selects disputes for the admin list
+type DisputeFilter struct {
+	Status models.DisputeStatus
+	Offset int
+	Limit  int
+}
+
+// DisputeEvidenceInput describes an evidence file already uploaded to storage
+type DisputeEvidenceInput struct {
+	Key         string
+	FileName    string
+	ContentType string
+	Size        int64
+	Note        string
+}
+
+// DisputeService records payment disputes, freezes the disputed tickets and
+// settles the order once the dispute is decided
+type DisputeService struct {
+	emailCfg    *config.EmailConfig
+	frontendURL string
+}
+
+// NewDisputeService creates a new dispute service
+func NewDisputeService(cfg *config.Config) *DisputeService {
+	return &DisputeService{
+		emailCfg:    &cfg.Email,
+		frontendURL: cfg.Server.FrontendURL,
+	}
+}
+
+// Record opens or updates the dispute described by notice. A new dispute
+// freezes the order's unused tickets and notifies the organizer; a final
+// status from the provider resolves it.
+func (s *DisputeService) Record(ctx context.Context, payment *models.Payment, notice *DisputeNotice) error {
+	if notice.ID == "" {
+		return fmt.Errorf("dispute has no provider ID")
+	}
+
+	var dispute models.Dispute
+	err := database.DB.WithContext(ctx).Where("provider_dispute_id = ?", notice.ID).First(&dispute).Error
+	switch {
+	case err == gorm.ErrRecordNotFound:
+		if err := s.open(ctx, payment, notice, &dispute); err != nil {
+			return err
+		}
+	case err != nil:
+		return fmt.Errorf("failed to fetch dispute: %w", err)
+	case notice.DueBy != nil && dispute.Status == models.DisputeOpen:
+		if err := database.DB.WithContext(ctx).Model(&dispute).Update("evidence_due_by", notice.DueBy).Error; err != nil {
+			return fmt.Errorf("failed to update dispute: %w", err)
+		}
+	}
+
+	if notice.Status == models.DisputeOpen || dispute.Status != models.DisputeOpen {
+		return nil
+	}
+	_, err = s.resolve(ctx, &dispute, notice.Status, "Decided by "+string(payment.Provider), nil)
+	return err
+}
+
+// List returns disputes, newest first
+func (s *DisputeService) List(ctx context.Context, filter DisputeFilter) ([]models.Dispute, int64, error) {
+	query := database.Reader(database.DB).WithContext(ctx).Model(&models.Dispute{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count disputes: %w", err)
+	}
+
+	var disputes []models.Dispute
+	if err := query.Order("created_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&disputes).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch disputes: %w", err)
+	}
+	return disputes, total, nil
+}
+
+// Get returns a dispute with its evidence, oldest first
+func (s *DisputeService) Get(ctx context.Context, disputeID uuid.UUID) (*models.Dispute, error) {
+	var dispute models.Dispute
+	if err := database.DB.WithContext(ctx).
+		Preload("Evidence", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		First(&dispute, disputeID).Error; err != nil {
+		return nil, utils.NotFoundError("dispute not found")
+	}
+	return &dispute, nil
+}
+
+// AddEvidence attaches an uploaded file to an open dispute
+func (s *DisputeService) AddEvidence(ctx context.Context, disputeID, uploadedBy uuid.UUID, input DisputeEvidenceInput) (*models.DisputeEvidence, error) {
+	var dispute models.Dispute
+	if err := database.DB.WithContext(ctx).Select("id", "status").First(&dispute, disputeID).Error; err != nil {
+		return nil, utils.NotFoundError("dispute not found")
+	}
+	if dispute.Status != models.DisputeOpen {
+		return nil, utils.ConflictError("dispute is already %s", dispute.Status)
+	}
+
+	evidence := models.DisputeEvidence{
+		DisputeID:   disputeID,
+		Key:         input.Key,
+		FileName:    input.FileName,
+		ContentType: input.ContentType,
+		Size:        input.Size,
+		Note:        strings.TrimSpace(input.Note),
+		UploadedBy:  uploadedBy,
+	}
+	if err := database.DB.WithContext(ctx).Create(&evidence).Error; err != nil {
+		return nil, fmt.Errorf("failed to record evidence: %w", err)
+	}
+	return &evidence, nil
+}
+
+// Resolve records the outcome of an open dispute. A won dispute unfreezes
+// the tickets; a lost one charges the order back and puts them on sale again.
+func (s *DisputeService) Resolve(ctx context.Context, disputeID, adminID uuid.UUID, outcome models.DisputeStatus, resolution string) (*models.Dispute, error) {
+	if outcome != models.DisputeWon && outcome != models.DisputeLost {
+		return nil, utils.BadRequestError("outcome must be won or lost")
+	}
+
+	var dispute models.Dispute
+	if err := database.DB.WithContext(ctx).First(&dispute, disputeID).Error; err != nil {
+		return nil, utils.NotFoundError("dispute not found")
+	}
+	return s.resolve(ctx, &dispute, outcome, strings.TrimSpace(resolution), &adminID)
+}
+
+func (s *DisputeService) open(ctx context.Context, payment *models.Payment, notice *DisputeNotice, dispute *models.Dispute) error {
+	*dispute = models.Dispute{
+		PaymentID:         payment.ID,
+		OrderID:           payment.OrderID,
+		Provider:          payment.Provider,
+		ProviderDisputeID: notice.ID,
+		Amount:            notice.Amount,
+		Currency:          strings.ToUpper(notice.Currency),
+		Reason:            notice.Reason,
+		Status:            models.DisputeOpen,
+		EvidenceDueBy:     notice.DueBy,
+	}
+	if dispute.Amount == 0 {
+		dispute.Amount = payment.Amount
+	}
+	if dispute.Currency == "" {
+		dispute.Currency = payment.Currency
+	}
+
+	var frozen int64
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		if err := tx.Create(dispute).Error; err != nil {
+			return fmt.Errorf("failed to record dispute: %w", err)
+		}
+
+		// Payments already refunded have nothing left to freeze
+		if err := tx.Model(&models.Payment{}).
+			Where("id = ? AND status = ?", payment.ID, models.PaymentCompleted).
+			Update("status", models.PaymentDisputed).Error; err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		result := tx.Model(&models.Ticket{}).
+			Where("order_id = ? AND status = ?", payment.OrderID, models.TicketActive).
+			Update("status", models.TicketFrozen)
+		if result.Error != nil {
+			return fmt.Errorf("failed to freeze tickets: %w", result.Error)
+		}
+		frozen = result.RowsAffected
+
+		_, err := disputeOpenedEmailJob.EnqueueTx(tx, disputeOpenedEmail{DisputeID: dispute.ID})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.WithContext(ctx).Warn("Payment disputed",
+		zap.String("dispute_id", dispute.ID.String()),
+		zap.String("order_id", dispute.OrderID.String()),
+		zap.Int64("tickets_frozen", frozen),
+	)
+
+	return nil
+}
+
+func (s *DisputeService) resolve(ctx context.Context, dispute *models.Dispute, outcome models.DisputeStatus, resolution string, resolvedBy *uuid.UUID) (*models.Dispute, error) {
+	restocked := false
+	var tierID uuid.UUID
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+		now := time.Now()
+
+		// Conditional update so a dispute is only settled once
+		result := tx.Model(&models.Dispute{}).
+			Where("id = ? AND status = ?", dispute.ID, models.DisputeOpen).
+			Updates(map[string]interface{}{
+				"status":      outcome,
+				"resolution":  resolution,
+				"resolved_by": resolvedBy,
+				"resolved_at": now,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update dispute: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return utils.ConflictError("dispute is already resolved")
+		}
+		dispute.Status, dispute.Resolution = outcome, resolution
+		dispute.ResolvedBy, dispute.ResolvedAt = resolvedBy, &now
+
+		if outcome == models.DisputeWon {
+			if err := tx.Model(&models.Payment{}).
+				Where("id = ? AND status = ?", dispute.PaymentID, models.PaymentDisputed).
+				Update("status", models.PaymentCompleted).Error; err != nil {
+				return fmt.Errorf("failed to update payment: %w", err)
+			}
+			if err := tx.Model(&models.Ticket{}).
+				Where("order_id = ? AND status = ?", dispute.OrderID, models.TicketFrozen).
+				Update("status", models.TicketActive).Error; err != nil {
+				return fmt.Errorf("failed to unfreeze tickets: %w", err)
+			}
+			return nil
+		}
+
+		if err := tx.Model(&models.Payment{}).
+			Where("id = ? AND status = ?", dispute.PaymentID, models.PaymentDisputed).
+			Update("status", models.PaymentChargeback).Error; err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		var order models.Order
+		if err := tx.First(&order, dispute.OrderID).Error; err != nil {
+			return fmt.Errorf("order not found: %w", err)
+		}
+		tierID = order.TierID
+
+		// Orders refunded since the dispute opened were settled already
+		result = tx.Model(&models.Order{}).
+			Where("id = ? AND status = ?", order.ID, models.OrderPaid).
+			Update("status", models.OrderRefunded)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update order: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if err := recordChargeback(tx, &order); err != nil {
+			return err
+		}
+
+		// The cardholder has their money back, so the frozen tickets go back
+		// on sale
+		result = tx.Model(&models.Ticket{}).
+			Where("order_id = ? AND status = ?", order.ID, models.TicketFrozen).
+			Update("status", models.TicketRefunded)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update tickets: %w", result.Error)
+		}
+		if result.RowsAffected > 0 {
+			if err := tx.Model(&models.TicketTier{}).
+				Where("id = ?", order.TierID).
+				UpdateColumn("available_quantity", gorm.Expr("available_quantity + ?", result.RowsAffected)).
+				Error; err != nil {
+				return fmt.Errorf("failed to restore tickets: %w", err)
+			}
+			restocked = true
+		}
+
+		return releaseOrderAddOns(tx, order.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if restocked {
+		publishAvailability(context.Background(), tierID, AvailabilityReleased)
+	}
+
+	return dispute, nil
+}
+
+// notifyOrganizer runs a disputeOpenedEmailJob
+func (s *DisputeService) notifyOrganizer(ctx context.Context, job disputeOpenedEmail) error {
+	var dispute models.Dispute
+	if err := database.DB.WithContext(ctx).First(&dispute, job.DisputeID).Error; err != nil {
+		return fmt.Errorf("failed to fetch dispute: %w", err)
+	}
+
+	var order models.Order
+	if err := database.DB.WithContext(ctx).Select("id", "tier_id").First(&order, dispute.OrderID).Error; err != nil {
+		return fmt.Errorf("failed to fetch disputed order: %w", err)
+	}
+
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Preload("Event.Organizer.User").First(&tier, order.TierID).Error; err != nil {
+		return fmt.Errorf("failed to fetch disputed event: %w", err)
+	}
+
+	organizer := tier.Event.Organizer.User
+	amount := currency.Format(dispute.Amount, dispute.Currency)
+	if err := NewEmailService(s.emailCfg).SendDisputeOpenedEmail(ctx, organizer.ID, organizer.Email, organizer.FirstName, &tier.Event, order.ID, amount, s.frontendURL); err != nil {
+		return fmt.Errorf("failed to send dispute email: %w", err)
+	}
+	return nil
+}
@@ -11,9 +11,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/resend/resend-go/v2"
+	"go.uber.org/zap"
 
+	"eventix-api/internal/models"
 	"eventix-api/pkg/cache"
 	"eventix-api/pkg/config"
+	"eventix-api/pkg/currency"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/queue"
+	"eventix-api/pkg/ticketqr"
 	"eventix-api/pkg/utils"
 )
 
@@ -48,9 +54,16 @@ func (s *EmailService) loadTemplates() {
 	templateDir := "templates/email"
 
 	templates := map[string]string{
-		"verify_email":       "verify_email.html",
-		"welcome":            "welcome.html",
-		"order_confirmation": "order_confirmation.html",
+		"verify_email":           "verify_email.html",
+		"welcome":                "welcome.html",
+		"order_confirmation":     "order_confirmation.html",
+		"password_reset":         "password_reset.html",
+		"order_cancelled":        "order_cancelled.html",
+		"event_cancelled":        "event_cancelled.html",
+		"event_rescheduled":      "event_rescheduled.html",
+		"favorite_event":         "favorite_event.html",
+		"organizer_verification": "organizer_verification.html",
+		"data_export":            "data_export.html",
 	}
 
 	for name, filename := range templates {
@@ -87,14 +100,100 @@ func (s *EmailService) renderTemplate(templateName string, data interface{}) (st
 	return buf.String(), nil
 }
 
+// EmailJob is an email queued for delivery by the notification worker.
+// Templates are rendered at delivery time.
+type EmailJob struct {
+	UserID         uuid.UUID                `json:"user_id"`
+	NotificationID uuid.UUID                `json:"notification_id"`
+	To             string                   `json:"to"`
+	Subject        string                   `json:"subject"`
+	Summary        string                   `json:"summary"`
+	Template       string                   `json:"template"`
+	Topic          models.NotificationTopic `json:"topic"`
+	Data           map[string]interface{}   `json:"data"`
+	Attachments    []EmailAttachment        `json:"attachments,omitempty"`
+}
+
+// EmailAttachment is a file sent along with an email
+type EmailAttachment struct {
+	Filename string `json:"filename"`
+	Content  []byte `json:"content"`
+}
+
+// EmailJobType identifies email jobs on the notifications queue
+const EmailJobType = "email"
+
+// send records the email in the user's notifications and hands it to the
+// notification queue, delivering it inline when queueing is disabled or the
+// broker is unreachable. Emails the user opted out of are dropped.
+func (s *EmailService) send(ctx context.Context, job EmailJob) error {
+	if job.UserID != uuid.Nil {
+		if !NewNotificationService().Allowed(ctx, job.UserID, models.ChannelEmail, job.Topic) {
+			return nil
+		}
+
+		notification, err := NewNotificationService().Create(job.UserID, models.ChannelEmail, job.Subject, job.Summary,
+			map[string]interface{}{"template": job.Template})
+		if err != nil {
+			// Still send the email; the notification feed is secondary
+			logger.WithContext(ctx).Error("Failed to record email notification", zap.String("template", job.Template), zap.Error(err))
+		} else {
+			job.NotificationID = notification.ID
+		}
+	}
+
+	if queue.Enabled() {
+		err := queue.Publish(ctx, queue.NotificationsQueue(), EmailJobType, job)
+		if err == nil {
+			return nil
+		}
+		logger.WithContext(ctx).Warn("Failed to enqueue email, delivering inline",
+			zap.String("template", job.Template),
+			zap.Error(err),
+		)
+	}
+
+	return s.Deliver(ctx, job)
+}
+
+// Deliver renders and sends an email immediately
+func (s *EmailService) Deliver(ctx context.Context, job EmailJob) error {
+	htmlBody, err := s.renderTemplate(job.Template, job.Data)
+	if err != nil {
+		return err
+	}
+
+	params := &resend.SendEmailRequest{
+		From:    fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
+		To:      []string{job.To},
+		Subject: job.Subject,
+		Html:    htmlBody,
+	}
+	for _, attachment := range job.Attachments {
+		params.Attachments = append(params.Attachments, &resend.Attachment{
+			Filename: attachment.Filename,
+			Content:  attachment.Content,
+		})
+	}
+
+	if _, err := s.client.Emails.Send(params); err != nil {
+		return fmt.Errorf("failed to send %s email: %w", job.Template, err)
+	}
+
+	if job.NotificationID != uuid.Nil {
+		NewNotificationService().MarkSent(job.NotificationID)
+	}
+
+	return nil
+}
+
 // SendVerificationEmail sends an email verification link
-func (s *EmailService) SendVerificationEmail(userID uuid.UUID, email, firstName, frontendURL string) error {
+func (s *EmailService) SendVerificationEmail(ctx context.Context, userID uuid.UUID, email, firstName, frontendURL string) error {
 	// Generate verification token
 	token := utils.GenerateReservationID()
 
 	// Store in Redis with 24 hour expiry
 	key := fmt.Sprintf("email_verify:%s", token)
-	ctx := context.Background()
 	if err := cache.Client.Set(ctx, key, userID.String(), 24*time.Hour).Err(); err != nil {
 		return fmt.Errorf("failed to store verification token: %w", err)
 	}
@@ -108,36 +207,121 @@ func (s *EmailService) SendVerificationEmail(userID uuid.UUID, email, firstName,
 		"VerificationLink": verificationLink,
 	}
 
-	// Render template
-	htmlBody, err := s.renderTemplate("verify_email", data)
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  "Verify Your Email - Eventix",
+		Summary:  "Confirm your email address to activate your account.",
+		Template: "verify_email",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
+}
+
+// VerifyEmailToken verifies an email verification token
+func (s *EmailService) VerifyEmailToken(token string) (uuid.UUID, error) {
+	key := fmt.Sprintf("email_verify:%s", token)
+	ctx := context.Background()
+
+	userIDStr, err := cache.Client.Get(ctx, key).Result()
 	if err != nil {
-		return err
+		return uuid.Nil, fmt.Errorf("invalid or expired verification token")
 	}
 
-	// Send email using Resend
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
-		To:      []string{email},
-		Subject: "Verify Your Email - Eventix",
-		Html:    htmlBody,
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid token data")
+	}
+
+	// Delete token after use (one-time use)
+	cache.Client.Del(ctx, key)
+
+	return userID, nil
+}
+
+// SendPasswordResetEmail sends a password reset link
+func (s *EmailService) SendPasswordResetEmail(ctx context.Context, userID uuid.UUID, email, firstName, frontendURL string) error {
+	// Generate reset token
+	token := utils.GenerateReservationID()
+
+	// Store in Redis with 1 hour expiry
+	key := fmt.Sprintf("password_reset:%s", token)
+	if err := cache.Client.Set(ctx, key, userID.String(), time.Hour).Err(); err != nil {
+		return fmt.Errorf("failed to store password reset token: %w", err)
+	}
+
+	// Create reset link
+	resetLink := fmt.Sprintf("%s/reset-password?token=%s", frontendURL, token)
+
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName": firstName,
+		"ResetLink": resetLink,
 	}
 
-	_, err = s.client.Emails.Send(params)
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  "Reset Your Password - Eventix",
+		Summary:  "A password reset was requested for your account.",
+		Template: "password_reset",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
+}
+
+// VerifyPasswordResetToken verifies and consumes a password reset token
+func (s *EmailService) VerifyPasswordResetToken(token string) (uuid.UUID, error) {
+	key := fmt.Sprintf("password_reset:%s", token)
+	ctx := context.Background()
+
+	userIDStr, err := cache.Client.Get(ctx, key).Result()
 	if err != nil {
-		return fmt.Errorf("failed to send verification email: %w", err)
+		return uuid.Nil, fmt.Errorf("invalid or expired password reset token")
 	}
 
-	return nil
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid token data")
+	}
+
+	// Delete token after use (one-time use)
+	cache.Client.Del(ctx, key)
+
+	return userID, nil
 }
 
-// VerifyEmailToken verifies an email verification token
-func (s *EmailService) VerifyEmailToken(token string) (uuid.UUID, error) {
-	key := fmt.Sprintf("email_verify:%s", token)
+// accountClaimExpiry is how long a guest's account claim link stays valid
+const accountClaimExpiry = 30 * 24 * time.Hour
+
+// GuestDelivery is what a guest buyer gets in place of an account dashboard:
+// their tickets as QR code attachments and a link to claim the account
+type GuestDelivery struct {
+	ClaimLink string
+	Tickets   []models.Ticket
+}
+
+// NewAccountClaimLink creates a link that lets a guest set a password and
+// take over the account their tickets were bought with
+func (s *EmailService) NewAccountClaimLink(ctx context.Context, userID uuid.UUID, frontendURL string) (string, error) {
+	token := utils.GenerateReservationID()
+
+	key := fmt.Sprintf("account_claim:%s", token)
+	if err := cache.Client.Set(ctx, key, userID.String(), accountClaimExpiry).Err(); err != nil {
+		return "", fmt.Errorf("failed to store account claim token: %w", err)
+	}
+
+	return fmt.Sprintf("%s/claim-account?token=%s", frontendURL, token), nil
+}
+
+// VerifyAccountClaimToken verifies and consumes an account claim token
+func (s *EmailService) VerifyAccountClaimToken(token string) (uuid.UUID, error) {
+	key := fmt.Sprintf("account_claim:%s", token)
 	ctx := context.Background()
 
 	userIDStr, err := cache.Client.Get(ctx, key).Result()
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("invalid or expired verification token")
+		return uuid.Nil, fmt.Errorf("invalid or expired claim token")
 	}
 
 	userID, err := uuid.Parse(userIDStr)
@@ -151,55 +335,312 @@ func (s *EmailService) VerifyEmailToken(token string) (uuid.UUID, error) {
 	return userID, nil
 }
 
-// SendOrderConfirmationEmail sends order confirmation with tickets
-func (s *EmailService) SendOrderConfirmationEmail(email, firstName string, orderID uuid.UUID, totalAmount float64, ticketCount int) error {
+// SendOrderConfirmationEmail sends order confirmation with tickets. When the
+// event is given it adds add-to-calendar links and an .ics invite; the receipt
+// is attached when one is given. Guest buyers get their ticket QR codes
+// attached and a claim link.
+func (s *EmailService) SendOrderConfirmationEmail(ctx context.Context, userID uuid.UUID, email, firstName string, orderID uuid.UUID, totalAmount int64, currencyCode string, ticketCount int, addOns []models.OrderItem, event *models.Event, receipt []byte, guest *GuestDelivery) error {
 	// Prepare template data
 	data := map[string]interface{}{
 		"FirstName":   firstName,
 		"OrderID":     orderID.String(),
 		"TicketCount": ticketCount,
-		"TotalAmount": fmt.Sprintf("%.2f", totalAmount),
+		"TotalAmount": currency.Format(totalAmount, currencyCode),
+	}
+	if len(addOns) > 0 {
+		lines := make([]map[string]interface{}, len(addOns))
+		for i, item := range addOns {
+			lines[i] = map[string]interface{}{
+				"Name":     item.Name,
+				"Quantity": item.Quantity,
+				"Amount":   currency.Format(item.Amount, currencyCode),
+			}
+		}
+		data["AddOns"] = lines
 	}
 
-	// Render template
-	htmlBody, err := s.renderTemplate("order_confirmation", data)
-	if err != nil {
-		return err
+	job := EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  "Order Confirmed - Your Tickets are Ready!",
+		Summary:  fmt.Sprintf("Your order for %d ticket(s) is confirmed.", ticketCount),
+		Template: "order_confirmation",
+		Topic:    models.TopicOrders,
+		Data:     data,
 	}
 
-	// Send email
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
-		To:      []string{email},
-		Subject: "Order Confirmed - Your Tickets are Ready!",
-		Html:    htmlBody,
+	if event != nil {
+		calendar := NewCalendarService()
+		links := calendar.Links(event, fmt.Sprintf("Eventix order %s", orderID))
+		data["EventTitle"] = event.Title
+		data["GoogleCalendarLink"] = links.Google
+		data["OutlookCalendarLink"] = links.Outlook
+
+		invite := calendar.OrderInvite(event, orderID, ticketCount)
+		job.Attachments = append(job.Attachments, EmailAttachment{Filename: CalendarFilename(event), Content: invite})
+	}
+	if receipt != nil {
+		job.Attachments = append(job.Attachments, EmailAttachment{Filename: ReceiptFilename(orderID), Content: receipt})
+	}
+	if guest != nil {
+		data["ClaimLink"] = guest.ClaimLink
+		for _, ticket := range guest.Tickets {
+			png, err := ticketqr.PNG(ticket.QRCode)
+			if err != nil {
+				return fmt.Errorf("failed to generate ticket QR code: %w", err)
+			}
+			job.Attachments = append(job.Attachments, EmailAttachment{
+				Filename: fmt.Sprintf("eventix-ticket-%s.png", ticket.ID.String()[:8]),
+				Content:  png,
+			})
+		}
+	}
+
+	return s.send(ctx, job)
+}
+
+// SendOrderCancelledEmail notifies a buyer that their order was cancelled
+func (s *EmailService) SendOrderCancelledEmail(ctx context.Context, userID uuid.UUID, email, firstName string, orderID uuid.UUID, totalAmount int64, currencyCode string, ticketCount int, reason string) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":   firstName,
+		"OrderID":     orderID.String(),
+		"TicketCount": ticketCount,
+		"TotalAmount": currency.Format(totalAmount, currencyCode),
+		"Reason":      reason,
+	}
+
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  "Your Eventix Order Was Cancelled",
+		Summary:  reason,
+		Template: "order_cancelled",
+		Topic:    models.TopicOrders,
+		Data:     data,
+	})
+}
+
+// SendEventCancelledEmail tells a ticket holder that their event was cancelled
+func (s *EmailService) SendEventCancelledEmail(ctx context.Context, userID uuid.UUID, email, firstName string, event *models.Event, ticketCount int, reason string) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":   firstName,
+		"EventTitle":  event.Title,
+		"StartTime":   event.StartTime.UTC().Format("Mon, 2 Jan 2006 15:04 MST"),
+		"TicketCount": ticketCount,
+		"Reason":      reason,
+	}
+
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  fmt.Sprintf("%s Has Been Cancelled", event.Title),
+		Summary:  fmt.Sprintf("%s has been cancelled. Paid orders are refunded automatically.", event.Title),
+		Template: "event_cancelled",
+		Topic:    models.TopicOrders,
+		Data:     data,
+	})
+}
+
+// SendEventModeratedEmail tells an organizer that an admin unpublished or
+// banned their event, and why
+func (s *EmailService) SendEventModeratedEmail(ctx context.Context, userID uuid.UUID, email, firstName string, event *models.Event, reason, frontendURL string) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":     firstName,
+		"EventTitle":    event.Title,
+		"Banned":        event.Status == models.EventBanned,
+		"Reason":        reason,
+		"DashboardLink": fmt.Sprintf("%s/organizer/dashboard", frontendURL),
+	}
+
+	subject := fmt.Sprintf("%s Has Been Unpublished", event.Title)
+	summary := fmt.Sprintf("%s was taken down by Eventix: %s. You can edit it and submit it for review again.", event.Title, reason)
+	if event.Status == models.EventBanned {
+		subject = fmt.Sprintf("%s Has Been Removed", event.Title)
+		summary = fmt.Sprintf("%s was removed from Eventix: %s.", event.Title, reason)
 	}
 
-	_, err = s.client.Emails.Send(params)
-	return err
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  subject,
+		Summary:  summary,
+		Template: "event_moderated",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
+}
+
+// SendDisputeOpenedEmail tells an organizer that a buyer disputed the
+// payment for an order of their event and that its tickets are frozen
+func (s *EmailService) SendDisputeOpenedEmail(ctx context.Context, userID uuid.UUID, email, firstName string, event *models.Event, orderID uuid.UUID, amount, frontendURL string) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":     firstName,
+		"EventTitle":    event.Title,
+		"OrderID":       orderID.String(),
+		"Amount":        amount,
+		"DashboardLink": fmt.Sprintf("%s/organizer/dashboard", frontendURL),
+	}
+
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  fmt.Sprintf("Payment Disputed for %s", event.Title),
+		Summary:  fmt.Sprintf("A buyer disputed a %s payment for %s. The order's tickets are frozen until the dispute is resolved.", amount, event.Title),
+		Template: "dispute_opened",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
+}
+
+// SendEventRescheduledEmail tells a ticket holder that their event moved to
+// new dates and until when they can ask for a refund instead
+func (s *EmailService) SendEventRescheduledEmail(ctx context.Context, userID uuid.UUID, email, firstName string, event *models.Event, reschedule *models.EventReschedule, ticketCount int, frontendURL string) error {
+	const dateFormat = "Mon, 2 Jan 2006 15:04 MST"
+
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":         firstName,
+		"EventTitle":        event.Title,
+		"PreviousStartTime": reschedule.PreviousStartTime.UTC().Format(dateFormat),
+		"StartTime":         reschedule.StartTime.UTC().Format(dateFormat),
+		"EndTime":           reschedule.EndTime.UTC().Format(dateFormat),
+		"TicketCount":       ticketCount,
+		"Reason":            reschedule.Reason,
+		"RefundDeadline":    reschedule.RefundDeadline.UTC().Format(dateFormat),
+		"OrdersLink":        fmt.Sprintf("%s/orders", frontendURL),
+	}
+
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  fmt.Sprintf("%s Has Been Rescheduled", event.Title),
+		Summary:  fmt.Sprintf("%s now starts %s. Refunds are available until %s.", event.Title, data["StartTime"], data["RefundDeadline"]),
+		Template: "event_rescheduled",
+		Topic:    models.TopicOrders,
+		Data:     data,
+	})
+}
+
+// SendFavoriteEventEmail tells a user that an event they saved was published
+// or that its tickets went on sale
+func (s *EmailService) SendFavoriteEventEmail(ctx context.Context, userID uuid.UUID, email, firstName string, event *models.Event, published, onSale bool, frontendURL string) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":  firstName,
+		"EventTitle": event.Title,
+		"StartTime":  event.StartTime.UTC().Format("Mon, 2 Jan 2006 15:04 MST"),
+		"Location":   eventAddress(event),
+		"Published":  published,
+		"OnSale":     onSale,
+		"EventLink":  fmt.Sprintf("%s/events/%s", frontendURL, event.Slug),
+	}
+
+	subject := fmt.Sprintf("Tickets for %s Are On Sale", event.Title)
+	summary := fmt.Sprintf("Tickets for %s, an event you saved, are on sale now.", event.Title)
+	if published && !onSale {
+		subject = fmt.Sprintf("%s Is Now Live", event.Title)
+		summary = fmt.Sprintf("%s, an event you saved, has been published.", event.Title)
+	}
+
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  subject,
+		Summary:  summary,
+		Template: "favorite_event",
+		Topic:    models.TopicReminders,
+		Data:     data,
+	})
+}
+
+// SendOrganizerVerificationEmail tells an applicant the outcome of their organizer application
+func (s *EmailService) SendOrganizerVerificationEmail(ctx context.Context, userID uuid.UUID, email, firstName, organizationName string, approved bool, reason, frontendURL string) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":        firstName,
+		"OrganizationName": organizationName,
+		"Approved":         approved,
+		"Reason":           reason,
+		"DashboardLink":    fmt.Sprintf("%s/organizer/dashboard", frontendURL),
+	}
+
+	subject := "Your Organizer Application Was Approved"
+	summary := fmt.Sprintf("%s is now a verified organizer.", organizationName)
+	if !approved {
+		subject = "Update on Your Organizer Application"
+		summary = fmt.Sprintf("We were unable to verify %s: %s", organizationName, reason)
+	}
+
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  subject,
+		Summary:  summary,
+		Template: "organizer_verification",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
 }
 
 // SendWelcomeEmail sends a welcome email to new users
-func (s *EmailService) SendWelcomeEmail(email, firstName string) error {
+func (s *EmailService) SendWelcomeEmail(ctx context.Context, userID uuid.UUID, email, firstName string) error {
 	// Prepare template data
 	data := map[string]interface{}{
 		"FirstName": firstName,
 	}
 
-	// Render template
-	htmlBody, err := s.renderTemplate("welcome", data)
-	if err != nil {
-		return err
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  "Welcome to Eventix! 🎉",
+		Summary:  "Welcome to Eventix! Your account is ready.",
+		Template: "welcome",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
+}
+
+// SendDataExportEmail sends the download link of a user's data export
+func (s *EmailService) SendDataExportEmail(ctx context.Context, userID uuid.UUID, email, firstName, downloadLink string, expiresAt time.Time) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"FirstName":    firstName,
+		"DownloadLink": downloadLink,
+		"ExpiresAt":    expiresAt.UTC().Format("2 Jan 2006 15:04 MST"),
 	}
 
-	// Send email
-	params := &resend.SendEmailRequest{
-		From:    fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
-		To:      []string{email},
-		Subject: "Welcome to Eventix! 🎉",
-		Html:    htmlBody,
+	return s.send(ctx, EmailJob{
+		UserID:   userID,
+		To:       email,
+		Subject:  "Your Eventix Data Export is Ready",
+		Summary:  "Your data export is ready to download.",
+		Template: "data_export",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
+}
+
+// SendTeamInvitationEmail invites someone, who may not have an account yet,
+// to join an organizer's team
+func (s *EmailService) SendTeamInvitationEmail(ctx context.Context, email, organizationName string, role models.StaffRole, invitationLink string, expiresAt time.Time) error {
+	// Prepare template data
+	data := map[string]interface{}{
+		"OrganizationName": organizationName,
+		"Role":             string(role),
+		"InvitationLink":   invitationLink,
+		"ExpiresAt":        expiresAt.UTC().Format("2 Jan 2006 15:04 MST"),
 	}
 
-	_, err = s.client.Emails.Send(params)
-	return err
+	return s.send(ctx, EmailJob{
+		To:       email,
+		Subject:  fmt.Sprintf("You're Invited to Join %s on Eventix", organizationName),
+		Summary:  fmt.Sprintf("%s invited you to their event team.", organizationName),
+		Template: "team_invitation",
+		Topic:    models.TopicAccount,
+		Data:     data,
+	})
 }
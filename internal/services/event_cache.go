@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// eventCacheLoads collapses concurrent misses for the same key on this
+// replica into a single database load
+var eventCacheLoads singleflight.Group
+
+// EventCache caches rendered public event responses in Redis. Each key
+// embeds a generation number that every write to events or ticket tiers
+// bumps, so stale entries are never read again and simply expire.
+type EventCache struct {
+	ttl time.Duration
+}
+
+// NewEventCache creates a new event cache
+func NewEventCache(cfg *config.LimitsConfig) *EventCache {
+	return &EventCache{ttl: cfg.EventCacheTTL}
+}
+
+// Fetch returns the cached response stored under key, building and storing
+// it with load on a miss. Redis failures fall back to calling load directly;
+// load errors are returned as is and never cached.
+func (c *EventCache) Fetch(ctx context.Context, key string, load func() ([]byte, error)) ([]byte, error) {
+	if c.ttl <= 0 {
+		return load()
+	}
+
+	generation, err := cache.Client.Get(ctx, utils.EventCacheGenerationKey).Int64()
+	if err != nil && err != redis.Nil {
+		logger.WithContext(ctx).Warn("Failed to read event cache generation", zap.Error(err))
+		return load()
+	}
+
+	cacheKey := utils.EventCacheKey(generation, key)
+	if body, err := cache.Client.Get(ctx, cacheKey).Bytes(); err == nil {
+		return body, nil
+	}
+
+	body, err, _ := eventCacheLoads.Do(cacheKey, func() (interface{}, error) {
+		body, err := load()
+		if err != nil {
+			return nil, err
+		}
+		if err := cache.Client.Set(ctx, cacheKey, body, c.ttl).Err(); err != nil {
+			logger.WithContext(ctx).Warn("Failed to cache event response", zap.String("key", key), zap.Error(err))
+		}
+		return body, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return body.([]byte), nil
+}
+
+// InvalidateEventCache makes every cached event response stale
+func InvalidateEventCache(ctx context.Context) {
+	if err := cache.Client.Incr(ctx, utils.EventCacheGenerationKey).Err(); err != nil {
+		logger.WithContext(ctx).Warn("Failed to invalidate event cache", zap.Error(err))
+	}
+}
+
+// RegisterEventCacheInvalidation hooks into db so any create, update or
+// delete touching events, ticket tiers or sessions, inventory changes included,
+// invalidates the event cache. Writes inside a transaction invalidate before
+// commit, so a read racing the commit may cache the old state for one TTL.
+func RegisterEventCacheInvalidation(db *gorm.DB) error {
+	invalidate := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.RowsAffected == 0 {
+			return
+		}
+		switch tx.Statement.Table {
+		case "events", "ticket_tiers", "event_sessions", "ticket_tier_sessions", "price_phases":
+			InvalidateEventCache(tx.Statement.Context)
+		}
+	}
+
+	callbacks := db.Callback()
+	if err := callbacks.Create().After("gorm:create").Register("eventix:invalidate_event_cache", invalidate); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register("eventix:invalidate_event_cache", invalidate); err != nil {
+		return err
+	}
+	return callbacks.Delete().After("gorm:delete").Register("eventix:invalidate_event_cache", invalidate)
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/queue"
+)
+
+// RefundJobType identifies refund jobs on the payments queue
+const RefundJobType = "refund"
+
+// RefundJob is an order refund queued for the payment worker
+type RefundJob struct {
+	OrderID     uuid.UUID `json:"order_id"`
+	RequestedBy uuid.UUID `json:"requested_by"`
+	Reason      string    `json:"reason"`
+}
+
+// EventCancellation summarizes the fallout of cancelling an event
+type EventCancellation struct {
+	Event            *models.Event
+	TicketsCancelled int64
+	OrdersCancelled  int // pending orders that can no longer be paid
+	RefundsQueued    int
+	HoldersNotified  int
+}
+
+// EventCancellationService cancels events and refunds their orders
+type EventCancellationService struct {
+	events   *EventService
+	payments *PaymentService
+	emailCfg *config.EmailConfig
+}
+
+// NewEventCancellationService creates a new event cancellation service
+func NewEventCancellationService(cfg *config.Config) *EventCancellationService {
+	return &EventCancellationService{
+		events:   NewEventService(),
+		payments: NewPaymentService(cfg),
+		emailCfg: &cfg.Email,
+	}
+}
+
+// Cancel moves an event to cancelled, invalidates its unused tickets,
+// cancels its pending orders and queues a full refund of every paid order.
+// Ticket holders are emailed in the background. Calling Cancel again on a
+// cancelled event re-queues the refunds that have not completed.
+func (s *EventCancellationService) Cancel(ctx context.Context, eventID, actorID uuid.UUID, reason string) (*EventCancellation, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if event.Status != models.EventCancelled {
+		if err := s.events.Transition(&event, models.EventCancelled, actorID, reason); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &EventCancellation{Event: &event}
+
+	// Holders are collected before their tickets are invalidated
+	holders, err := eventTicketHolders(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := database.DB.WithContext(ctx).Model(&models.Ticket{}).
+		Where("tier_id IN (?) AND status IN ?",
+			database.DB.Model(&models.TicketTier{}).Select("id").Where("event_id = ?", eventID),
+			[]models.TicketStatus{models.TicketReserved, models.TicketActive}).
+		Update("status", models.TicketCancelled)
+	if tickets.Error != nil {
+		return nil, fmt.Errorf("failed to cancel tickets: %w", tickets.Error)
+	}
+	result.TicketsCancelled = tickets.RowsAffected
+
+	pending, err := s.eventOrders(ctx, eventID, models.OrderPending)
+	if err != nil {
+		return nil, err
+	}
+	orders := NewOrderService(NewTicketService(), NewOrderStore())
+	for _, orderID := range pending {
+		cancelled, err := orders.CancelOrder(orderID)
+		if err != nil {
+			logger.WithContext(ctx).Error("Failed to cancel pending order of cancelled event", zap.String("order_id", orderID.String()), zap.Error(err))
+			continue
+		}
+		if cancelled {
+			result.OrdersCancelled++
+		}
+	}
+
+	paid, err := s.eventOrders(ctx, eventID, models.OrderPaid)
+	if err != nil {
+		return nil, err
+	}
+	held, err := s.eventOrders(ctx, eventID, models.OrderReview)
+	if err != nil {
+		return nil, err
+	}
+	paid = append(paid, held...)
+
+	refundReason := "Event cancelled"
+	if reason != "" {
+		refundReason = "Event cancelled: " + reason
+	}
+
+	var queued []RefundJob
+	for _, orderID := range paid {
+		job := RefundJob{OrderID: orderID, RequestedBy: actorID, Reason: refundReason}
+		if queue.Enabled() {
+			err := queue.Publish(ctx, queue.PaymentsQueue(), RefundJobType, job)
+			if err == nil {
+				result.RefundsQueued++
+				continue
+			}
+			logger.WithContext(ctx).Warn("Failed to publish refund, queueing it as a background job",
+				zap.String("order_id", orderID.String()),
+				zap.Error(err),
+			)
+		}
+		queued = append(queued, job)
+	}
+
+	// Refunds and emails can take a while for a large event, so neither
+	// holds up the organizer's request
+	if err := refundJob.EnqueueMany(ctx, queued); err != nil {
+		return nil, err
+	}
+	result.RefundsQueued += len(queued)
+
+	emails := make([]eventCancelledEmail, 0, len(holders))
+	for _, holder := range holders {
+		emails = append(emails, eventCancelledEmail{
+			EventID:     event.ID,
+			UserID:      holder.UserID,
+			Email:       holder.Email,
+			FirstName:   holder.FirstName,
+			TicketCount: holder.TicketCount,
+			Reason:      reason,
+		})
+	}
+	if err := eventCancelledEmailJob.EnqueueMany(ctx, emails); err != nil {
+		// The event is cancelled; only the emails are lost
+		logger.WithContext(ctx).Error("Failed to queue event cancellation emails", zap.String("event_id", eventID.String()), zap.Error(err))
+	} else {
+		result.HoldersNotified = len(holders)
+	}
+
+	return result, nil
+}
+
+// Refund processes a queued refund job. Orders that are no longer paid or
+// held for review, for example because an earlier attempt went through, are
+// skipped.
+func (s *EventCancellationService) Refund(ctx context.Context, job RefundJob) error {
+	var order models.Order
+	if err := database.DB.WithContext(ctx).Select("id", "status").First(&order, job.OrderID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to fetch order: %w", err)
+	}
+	if order.Status != models.OrderPaid && order.Status != models.OrderReview {
+		return nil
+	}
+
+	if _, err := s.payments.RefundOrder(job.OrderID, job.RequestedBy, job.Reason); err != nil {
+		return err
+	}
+	return nil
+}
+
+// eventOrders returns the IDs of an event's orders in status
+func (s *EventCancellationService) eventOrders(ctx context.Context, eventID uuid.UUID, status models.OrderStatus) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := database.DB.WithContext(ctx).Model(&models.Order{}).
+		Joins("JOIN ticket_tiers ON ticket_tiers.id = orders.tier_id").
+		Where("ticket_tiers.event_id = ? AND orders.status = ?", eventID, status).
+		Pluck("orders.id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch orders: %w", err)
+	}
+	return ids, nil
+}
+
+// eventHolder is a user holding valid tickets to an event
+type eventHolder struct {
+	UserID      uuid.UUID
+	Email       string
+	FirstName   string
+	TicketCount int
+}
+
+// eventTicketHolders returns the users holding active or used tickets to an
+// event, with how many each holds
+func eventTicketHolders(ctx context.Context, eventID uuid.UUID) ([]eventHolder, error) {
+	var holders []eventHolder
+	if err := database.DB.WithContext(ctx).Table("tickets").
+		Select("users.id AS user_id, users.email, users.first_name, COUNT(tickets.id) AS ticket_count").
+		Joins("JOIN ticket_tiers ON ticket_tiers.id = tickets.tier_id").
+		Joins("JOIN users ON users.id = tickets.owner_id").
+		Where("ticket_tiers.event_id = ? AND tickets.status IN ? AND tickets.deleted_at IS NULL",
+			eventID, []models.TicketStatus{models.TicketActive, models.TicketUsed}).
+		Where("users.deleted_at IS NULL").
+		Group("users.id, users.email, users.first_name").
+		Scan(&holders).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch ticket holders: %w", err)
+	}
+	return holders, nil
+}
+
+// notifyHolder runs an eventCancelledEmailJob
+func (s *EventCancellationService) notifyHolder(ctx context.Context, job eventCancelledEmail) error {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).First(&event, job.EventID).Error; err != nil {
+		return fmt.Errorf("failed to fetch cancelled event: %w", err)
+	}
+
+	if err := NewEmailService(s.emailCfg).SendEventCancelledEmail(ctx, job.UserID, job.Email, job.FirstName, &event, job.TicketCount, job.Reason); err != nil {
+		return fmt.Errorf("failed to send event cancellation email: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// RescheduleInput describes an event's new dates. A zero RefundWindow uses
+// the configured default.
+type RescheduleInput struct {
+	StartTime    time.Time
+	EndTime      time.Time
+	Reason       string
+	RefundWindow time.Duration
+}
+
+// EventRescheduleService moves events to new dates and tells their ticket
+// holders how long they have to ask for a refund
+type EventRescheduleService struct {
+	emailCfg     *config.EmailConfig
+	refundWindow time.Duration
+	frontendURL  string
+}
+
+// NewEventRescheduleService creates a new event reschedule service
+func NewEventRescheduleService(cfg *config.Config) *EventRescheduleService {
+	return &EventRescheduleService{
+		emailCfg:     &cfg.Email,
+		refundWindow: cfg.Limits.RescheduleRefundWindow,
+		frontendURL:  cfg.Server.FrontendURL,
+	}
+}
+
+// Reschedule moves an event and its sessions to new dates, records the
+// change and emails ticket holders in the background. Holders of orders
+// placed before the change may ask for a refund until the returned
+// reschedule's RefundDeadline, which never falls after the new start.
+func (s *EventRescheduleService) Reschedule(ctx context.Context, eventID, actorID uuid.UUID, input RescheduleInput) (*models.Event, *models.EventReschedule, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).First(&event, eventID).Error; err != nil {
+		return nil, nil, fmt.Errorf("event not found")
+	}
+
+	if event.Status == models.EventCompleted || event.Status == models.EventCancelled || event.Status == models.EventBanned {
+		return nil, nil, fmt.Errorf("%s events cannot be rescheduled", event.Status)
+	}
+	if !input.EndTime.After(input.StartTime) {
+		return nil, nil, fmt.Errorf("end time must be after start time")
+	}
+	if !input.StartTime.After(time.Now()) {
+		return nil, nil, fmt.Errorf("start time must be in the future")
+	}
+	if input.StartTime.Equal(event.StartTime) && input.EndTime.Equal(event.EndTime) {
+		return nil, nil, fmt.Errorf("event is already scheduled for these dates")
+	}
+
+	window := input.RefundWindow
+	if window <= 0 {
+		window = s.refundWindow
+	}
+	deadline := time.Now().Add(window)
+	if deadline.After(input.StartTime) {
+		deadline = input.StartTime
+	}
+
+	reschedule := models.EventReschedule{
+		EventID:           event.ID,
+		PreviousStartTime: event.StartTime,
+		PreviousEndTime:   event.EndTime,
+		StartTime:         input.StartTime,
+		EndTime:           input.EndTime,
+		Reason:            input.Reason,
+		RefundDeadline:    deadline,
+		ActorID:           actorID,
+	}
+
+	offset := input.StartTime.Sub(event.StartTime)
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		// Conditional update so two concurrent reschedules cannot both
+		// record the same previous dates
+		result := tx.Model(&event).
+			Where("start_time = ? AND end_time = ?", event.StartTime, event.EndTime).
+			Updates(map[string]interface{}{"start_time": input.StartTime, "end_time": input.EndTime})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update event: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("event was rescheduled concurrently")
+		}
+
+		// Sessions keep their place relative to the event start
+		var sessions []models.EventSession
+		if err := tx.Where("event_id = ?", event.ID).Find(&sessions).Error; err != nil {
+			return fmt.Errorf("failed to fetch sessions: %w", err)
+		}
+		for _, session := range sessions {
+			if err := tx.Model(&session).Updates(map[string]interface{}{
+				"start_time": session.StartTime.Add(offset),
+				"end_time":   session.EndTime.Add(offset),
+			}).Error; err != nil {
+				return fmt.Errorf("failed to move session: %w", err)
+			}
+		}
+
+		if err := tx.Create(&reschedule).Error; err != nil {
+			return fmt.Errorf("failed to record reschedule: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	event.StartTime, event.EndTime = input.StartTime, input.EndTime
+
+	holders, err := eventTicketHolders(ctx, event.ID)
+	if err != nil {
+		// The event has moved; only the emails are lost
+		logger.WithContext(ctx).Error("Failed to fetch holders of rescheduled event", zap.String("event_id", event.ID.String()), zap.Error(err))
+	} else {
+		emails := make([]eventRescheduledEmail, 0, len(holders))
+		for _, holder := range holders {
+			emails = append(emails, eventRescheduledEmail{
+				RescheduleID: reschedule.ID,
+				UserID:       holder.UserID,
+				Email:        holder.Email,
+				FirstName:    holder.FirstName,
+				TicketCount:  holder.TicketCount,
+			})
+		}
+		if err := eventRescheduledEmailJob.EnqueueMany(ctx, emails); err != nil {
+			logger.WithContext(ctx).Error("Failed to queue event reschedule emails", zap.String("event_id", event.ID.String()), zap.Error(err))
+		}
+	}
+
+	return &event, &reschedule, nil
+}
+
+// History returns an event's date changes, newest first
+func (s *EventRescheduleService) History(ctx context.Context, eventID uuid.UUID) ([]models.EventReschedule, error) {
+	var reschedules []models.EventReschedule
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at DESC").
+		Find(&reschedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch reschedules: %w", err)
+	}
+	return reschedules, nil
+}
+
+// openRescheduleWindow reports whether an event was rescheduled after an
+// order was placed and the refund window of that change is still open
+func openRescheduleWindow(eventID uuid.UUID, order *models.Order) bool {
+	var count int64
+	database.DB.Model(&models.EventReschedule{}).
+		Where("event_id = ? AND created_at > ? AND refund_deadline > ?", eventID, order.CreatedAt, time.Now()).
+		Count(&count)
+	return count > 0
+}
+
+// notifyHolder runs an eventRescheduledEmailJob
+func (s *EventRescheduleService) notifyHolder(ctx context.Context, job eventRescheduledEmail) error {
+	var reschedule models.EventReschedule
+	if err := database.DB.WithContext(ctx).First(&reschedule, job.RescheduleID).Error; err != nil {
+		return fmt.Errorf("failed to fetch reschedule: %w", err)
+	}
+
+	var event models.Event
+	if err := database.DB.WithContext(ctx).First(&event, reschedule.EventID).Error; err != nil {
+		return fmt.Errorf("failed to fetch rescheduled event: %w", err)
+	}
+
+	if err := NewEmailService(s.emailCfg).SendEventRescheduledEmail(ctx, job.UserID, job.Email, job.FirstName, &event, &reschedule, job.TicketCount, s.frontendURL); err != nil {
+		return fmt.Errorf("failed to send event reschedule email: %w", err)
+	}
+	return nil
+}
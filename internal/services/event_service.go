@@ -0,0 +1,499 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/events"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/utils"
+)
+
+// maxSlugAttempts bounds the numbered suffixes tried before falling back to a random one
+const maxSlugAttempts = 20
+
+// eventTransitions lists the statuses an event may move to from each status
+var eventTransitions = map[models.EventStatus][]models.EventStatus{
+	models.EventDraft:       {models.EventUnderReview, models.EventCancelled},
+	models.EventUnderReview: {models.EventPublished, models.EventDraft, models.EventCancelled, models.EventBanned},
+	models.EventPublished:   {models.EventActive, models.EventCompleted, models.EventCancelled, models.EventUnpublished, models.EventBanned},
+	models.EventActive:      {models.EventCompleted, models.EventCancelled, models.EventUnpublished, models.EventBanned},
+	models.EventUnpublished: {models.EventUnderReview, models.EventCancelled, models.EventBanned},
+}
+
+// EventService handles event lifecycle operations
+type EventService struct{}
+
+// NewEventService creates a new event service
+func NewEventService() *EventService {
+	return &EventService{}
+}
+
+// UniqueSlug derives a URL slug from title, suffixing -2, -3, ... when it is already taken.
+// Soft-deleted events keep their slugs so links to them never point at a different event.
+func (s *EventService) UniqueSlug(ctx context.Context, title string) (string, error) {
+	base := utils.Slugify(title)
+	if base == "" {
+		base = "event"
+	}
+
+	candidate := base
+	for attempt := 2; attempt <= maxSlugAttempts+1; attempt++ {
+		var count int64
+		if err := database.DB.WithContext(ctx).Unscoped().Model(&models.Event{}).
+			Where("slug = ?", candidate).Count(&count).Error; err != nil {
+			return "", fmt.Errorf("failed to check slug: %w", err)
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+		candidate = base + "-" + strconv.Itoa(attempt)
+	}
+
+	return base + "-" + uuid.New().String()[:8], nil
+}
+
+// CanTransition reports whether an event may move from one status to another
+func (s *EventService) CanTransition(from, to models.EventStatus) bool {
+	for _, allowed := range eventTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// SubmitForReview moves a draft or unpublished event owned by userID into
+// the review queue
+func (s *EventService) SubmitForReview(eventID, userID uuid.UUID) (*models.Event, error) {
+	var event models.Event
+	if err := database.DB.Preload("Organizer").Preload("TicketTiers").First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if event.Organizer.UserID != userID {
+		return nil, fmt.Errorf("you can only submit your own events")
+	}
+
+	if len(event.TicketTiers) == 0 {
+		return nil, fmt.Errorf("event must have at least one ticket tier")
+	}
+
+	if err := s.Transition(&event, models.EventUnderReview, userID, ""); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// Approve publishes an event that is under review
+func (s *EventService) Approve(eventID, adminID uuid.UUID) (*models.Event, error) {
+	return s.review(eventID, adminID, models.EventPublished, "")
+}
+
+// Reject sends an event under review back to draft with a reason
+func (s *EventService) Reject(eventID, adminID uuid.UUID, reason string) (*models.Event, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("a rejection reason is required")
+	}
+	return s.review(eventID, adminID, models.EventDraft, reason)
+}
+
+func (s *EventService) review(eventID, adminID uuid.UUID, to models.EventStatus, reason string) (*models.Event, error) {
+	var event models.Event
+	if err := database.DB.First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if event.Status != models.EventUnderReview {
+		return nil, fmt.Errorf("event is not under review")
+	}
+
+	if err := s.Transition(&event, to, adminID, reason); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// Transition validates and applies a status change, recording it in the
+// event's status history within the same transaction
+func (s *EventService) Transition(event *models.Event, to models.EventStatus, actorID uuid.UUID, reason string) error {
+	from := event.Status
+	if !s.CanTransition(from, to) {
+		return utils.ConflictError("cannot move event from %s to %s", from, to)
+	}
+
+	return database.Transaction(func(tx *gorm.DB) error {
+		// Conditional update guards against concurrent transitions
+		result := tx.Model(&models.Event{}).
+			Where("id = ? AND status = ?", event.ID, from).
+			Update("status", to)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update event: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return utils.ConflictError("event status changed concurrently")
+		}
+
+		change := models.EventStatusChange{
+			EventID:    event.ID,
+			FromStatus: from,
+			ToStatus:   to,
+			ActorID:    actorID,
+			Reason:     reason,
+		}
+		if err := tx.Create(&change).Error; err != nil {
+			return fmt.Errorf("failed to record status change: %w", err)
+		}
+
+		if to == models.EventPublished {
+			if err := addOutboxEvent(tx, events.EventPublished, event.ID.String(), events.EventPublishedData{
+				EventID:     event.ID,
+				OrganizerID: event.OrganizerID,
+				Title:       event.Title,
+				StartTime:   event.StartTime,
+			}); err != nil {
+				return err
+			}
+		}
+
+		event.Status = to
+		return nil
+	})
+}
+
+// DuplicateOptions adjusts the copy made by Duplicate. Title defaults to the
+// original's, and EndTime to StartTime plus the original's duration.
+type DuplicateOptions struct {
+	Title     string
+	StartTime time.Time
+	EndTime   *time.Time
+}
+
+// Duplicate copies an event with its ticket tiers, sessions, zones, form
+// fields and add-ons into a new draft under the same organizer. Sale windows, price
+// phases and sessions move with the event dates, and every tier and add-on
+// starts with its full quantity available.
+func (s *EventService) Duplicate(ctx context.Context, eventID uuid.UUID, opts DuplicateOptions) (*models.Event, error) {
+	var original models.Event
+	if err := database.DB.WithContext(ctx).
+		Preload("TicketTiers").
+		Preload("TicketTiers.Sessions").
+		Preload("TicketTiers.Zones").
+		Preload("TicketTiers.PricePhases", orderPricePhases).
+		Preload("Sessions").
+		Preload("Zones").
+		Preload("FormFields").
+		Preload("AddOns").
+		First(&original, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	endTime := opts.StartTime.Add(original.EndTime.Sub(original.StartTime))
+	if opts.EndTime != nil {
+		endTime = *opts.EndTime
+	}
+	if endTime.Before(opts.StartTime) {
+		return nil, fmt.Errorf("end time must be after start time")
+	}
+
+	title := opts.Title
+	if title == "" {
+		title = original.Title
+	}
+	slug, err := s.UniqueSlug(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+
+	event := models.Event{
+		ID:          uuid.New(),
+		OrganizerID: original.OrganizerID,
+		Title:       title,
+		Slug:        slug,
+		Description: original.Description,
+		Category:    original.Category,
+		Location:    original.Location,
+		Venue:       original.Venue,
+		Currency:    original.Currency,
+		StartTime:   opts.StartTime,
+		EndTime:     endTime,
+		Status:      models.EventDraft,
+		WaitingRoom: original.WaitingRoom,
+
+		AllowReentry: original.AllowReentry,
+		MaxOccupancy: original.MaxOccupancy,
+
+		TaxName:        original.TaxName,
+		TaxBasisPoints: original.TaxBasisPoints,
+		TaxInclusive:   original.TaxInclusive,
+
+		RefundPolicy:         original.RefundPolicy,
+		RefundDaysBefore:     original.RefundDaysBefore,
+		RefundFeeBasisPoints: original.RefundFeeBasisPoints,
+	}
+	event.BannerURL = s.copyBanner(ctx, original.BannerURL, event.ID)
+
+	offset := opts.StartTime.Sub(original.StartTime)
+	err = database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&event).Error; err != nil {
+			return fmt.Errorf("failed to create event: %w", err)
+		}
+
+		copiedSessions := make(map[uuid.UUID]models.EventSession, len(original.Sessions))
+		for _, session := range original.Sessions {
+			copied := models.EventSession{
+				EventID:   event.ID,
+				Title:     session.Title,
+				StartTime: session.StartTime.Add(offset),
+				EndTime:   session.EndTime.Add(offset),
+				Capacity:  session.Capacity,
+			}
+			if err := tx.Create(&copied).Error; err != nil {
+				return fmt.Errorf("failed to create session: %w", err)
+			}
+			copiedSessions[session.ID] = copied
+			event.Sessions = append(event.Sessions, copied)
+		}
+
+		copiedZones := make(map[uuid.UUID]models.EventZone, len(original.Zones))
+		for _, zone := range original.Zones {
+			copied := models.EventZone{EventID: event.ID, Name: zone.Name, MaxOccupancy: zone.MaxOccupancy}
+			if err := tx.Create(&copied).Error; err != nil {
+				return fmt.Errorf("failed to create zone: %w", err)
+			}
+			copiedZones[zone.ID] = copied
+			event.Zones = append(event.Zones, copied)
+		}
+
+		for _, tier := range original.TicketTiers {
+			copied := models.TicketTier{
+				EventID:           event.ID,
+				TierName:          tier.TierName,
+				Description:       tier.Description,
+				Price:             tier.Price,
+				Currency:          tier.Currency,
+				TotalQuantity:     tier.TotalQuantity,
+				AvailableQuantity: tier.TotalQuantity,
+				SaleStartTime:     shiftTime(tier.SaleStartTime, offset),
+				SaleEndTime:       shiftTime(tier.SaleEndTime, offset),
+			}
+			for _, session := range tier.Sessions {
+				copied.Sessions = append(copied.Sessions, copiedSessions[session.ID])
+			}
+			for _, zone := range tier.Zones {
+				copied.Zones = append(copied.Zones, copiedZones[zone.ID])
+			}
+			for _, phase := range tier.PricePhases {
+				copied.PricePhases = append(copied.PricePhases, models.PricePhase{
+					Name:          phase.Name,
+					Price:         phase.Price,
+					StartTime:     shiftTime(phase.StartTime, offset),
+					EndTime:       shiftTime(phase.EndTime, offset),
+					QuantityLimit: phase.QuantityLimit,
+					Position:      phase.Position,
+				})
+			}
+			if err := tx.Create(&copied).Error; err != nil {
+				return fmt.Errorf("failed to create ticket tier: %w", err)
+			}
+			event.TicketTiers = append(event.TicketTiers, copied)
+		}
+
+		for _, field := range original.FormFields {
+			copied := models.FormField{
+				EventID:  event.ID,
+				Label:    field.Label,
+				Type:     field.Type,
+				Options:  field.Options,
+				Required: field.Required,
+				Position: field.Position,
+			}
+			if err := tx.Create(&copied).Error; err != nil {
+				return fmt.Errorf("failed to create form field: %w", err)
+			}
+			event.FormFields = append(event.FormFields, copied)
+		}
+
+		for _, addOn := range original.AddOns {
+			copied := models.AddOn{
+				EventID:     event.ID,
+				Name:        addOn.Name,
+				Description: addOn.Description,
+				Price:       addOn.Price,
+				Currency:    addOn.Currency,
+				Quantity:    addOn.Quantity,
+			}
+			if err := tx.Create(&copied).Error; err != nil {
+				return fmt.Errorf("failed to create add-on: %w", err)
+			}
+			event.AddOns = append(event.AddOns, copied)
+		}
+		return nil
+	})
+	if err != nil {
+		if key, ok := storage.KeyFromPublicURL(event.BannerURL); ok && event.BannerURL != original.BannerURL {
+			storage.Delete(ctx, key)
+		}
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// copyBanner gives a duplicated event its own copy of a stored banner, since
+// replacing either event's banner deletes the old object. Banners hosted
+// elsewhere are shared as is; a failed copy leaves the duplicate without one.
+func (s *EventService) copyBanner(ctx context.Context, bannerURL string, eventID uuid.UUID) string {
+	srcKey, ok := storage.KeyFromPublicURL(bannerURL)
+	if !ok {
+		return bannerURL
+	}
+
+	dstKey := storage.ObjectKey(fmt.Sprintf("events/%s/banners", eventID), srcKey)
+	if err := storage.Copy(ctx, srcKey, dstKey); err != nil {
+		logger.WithContext(ctx).Warn("Failed to copy event banner", zap.String("key", srcKey), zap.Error(err))
+		return ""
+	}
+	return storage.PublicURL(dstKey)
+}
+
+func shiftTime(t *time.Time, offset time.Duration) *time.Time {
+	if t == nil {
+		return nil
+	}
+	shifted := t.Add(offset)
+	return &shifted
+}
+
+// SetFeatured features an event on the home page or stops featuring it.
+// Only published events can be featured.
+func (s *EventService) SetFeatured(ctx context.Context, eventID uuid.UUID, featured bool) (*models.Event, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if featured && event.Status != models.EventPublished && event.Status != models.EventActive {
+		return nil, fmt.Errorf("only published events can be featured")
+	}
+
+	if err := database.DB.WithContext(ctx).Model(&event).Update("is_featured", featured).Error; err != nil {
+		return nil, fmt.Errorf("failed to update event: %w", err)
+	}
+	event.IsFeatured = featured
+	return &event, nil
+}
+
+// RefundPolicySettings holds an event's refund policy to save
+type RefundPolicySettings struct {
+	Policy         models.RefundPolicy
+	DaysBefore     int // deadline policy only
+	FeeBasisPoints int // none policy excepted
+}
+
+// SetRefundPolicy sets the terms under which attendees may refund their own
+// orders. Orders already placed follow the new terms as well.
+func (s *EventService) SetRefundPolicy(ctx context.Context, eventID uuid.UUID, settings RefundPolicySettings) (*models.Event, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	switch settings.Policy {
+	case models.RefundPolicyFlexible:
+		settings.DaysBefore = 0
+	case models.RefundPolicyDeadline:
+		if settings.DaysBefore < 1 {
+			return nil, fmt.Errorf("days_before must be at least 1 for a deadline policy")
+		}
+	case models.RefundPolicyNone:
+		settings.DaysBefore, settings.FeeBasisPoints = 0, 0
+	default:
+		return nil, fmt.Errorf("unknown refund policy %q", settings.Policy)
+	}
+
+	if err := database.DB.WithContext(ctx).Model(&event).Updates(map[string]interface{}{
+		"refund_policy":           settings.Policy,
+		"refund_days_before":      settings.DaysBefore,
+		"refund_fee_basis_points": settings.FeeBasisPoints,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update refund policy: %w", err)
+	}
+
+	event.RefundPolicy, event.RefundDaysBefore, event.RefundFeeBasisPoints = settings.Policy, settings.DaysBefore, settings.FeeBasisPoints
+	return &event, nil
+}
+
+// SetReentry sets whether an event's tickets can be scanned out and back in.
+// Once it is turned off, tickets scanned out cannot come back.
+func (s *EventService) SetReentry(ctx context.Context, eventID uuid.UUID, allowed bool) error {
+	result := database.DB.WithContext(ctx).Model(&models.Event{}).
+		Where("id = ?", eventID).
+		Update("allow_reentry", allowed)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update re-entry: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.NotFoundError("event not found")
+	}
+	return nil
+}
+
+// SetOccupancyLimit sets how many attendees may be inside an event at once;
+// 0 removes the limit. Attendees already inside are not affected.
+func (s *EventService) SetOccupancyLimit(ctx context.Context, eventID uuid.UUID, limit int) error {
+	result := database.DB.WithContext(ctx).Model(&models.Event{}).
+		Where("id = ?", eventID).
+		Update("max_occupancy", limit)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update occupancy limit: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.NotFoundError("event not found")
+	}
+	return nil
+}
+
+// AuthorizeEventAccess checks that a user may manage an event: admins may
+// manage any event, organizers only their own and team members only their
+// organizer's. Which actions a member may take is checked by the route's
+// permission.
+func (s *EventService) AuthorizeEventAccess(eventID, userID uuid.UUID, role string) error {
+	var event models.Event
+	if err := database.DB.Preload("Organizer").First(&event, eventID).Error; err != nil {
+		return fmt.Errorf("event not found")
+	}
+
+	if role == string(models.RoleAdmin) {
+		return nil
+	}
+
+	if event.Organizer.UserID == userID {
+		return nil
+	}
+
+	// Team members are attendee accounts; an organizer's own role would
+	// otherwise carry over to the events they were delegated
+	if role == string(models.RoleAttendee) {
+		member, err := NewPermissionService().IsMember(context.Background(), event.OrganizerID, userID)
+		if err != nil {
+			return err
+		}
+		if member {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("you do not manage this event")
+}
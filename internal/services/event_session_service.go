@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// EventSessionInput describes a new event session
+type EventSessionInput struct {
+	Title     string
+	StartTime time.Time
+	EndTime   time.Time
+	Capacity  int
+}
+
+// EventSessionUpdate holds the session fields to change; nil fields are left as they are
+type EventSessionUpdate struct {
+	Title     *string
+	StartTime *time.Time
+	EndTime   *time.Time
+	Capacity  *int
+}
+
+// EventSessionService manages the sessions of multi-session events and which
+// ticket tiers admit to them
+type EventSessionService struct {
+	tiers *TierService
+}
+
+// NewEventSessionService creates a new event session service
+func NewEventSessionService() *EventSessionService {
+	return &EventSessionService{tiers: NewTierService()}
+}
+
+// GetSession returns an event session by ID
+func (s *EventSessionService) GetSession(ctx context.Context, sessionID uuid.UUID) (*models.EventSession, error) {
+	var session models.EventSession
+	if err := database.DB.WithContext(ctx).First(&session, sessionID).Error; err != nil {
+		return nil, fmt.Errorf("session not found")
+	}
+	return &session, nil
+}
+
+// List returns an event's sessions in schedule order
+func (s *EventSessionService) List(ctx context.Context, eventID uuid.UUID) ([]models.EventSession, error) {
+	var sessions []models.EventSession
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("start_time ASC").
+		Find(&sessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Create adds a session to an event that is still open for changes
+func (s *EventSessionService) Create(ctx context.Context, eventID uuid.UUID, input EventSessionInput) (*models.EventSession, error) {
+	if _, err := s.tiers.ensureEditable(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	session := models.EventSession{
+		EventID:   eventID,
+		Title:     input.Title,
+		StartTime: input.StartTime,
+		EndTime:   input.EndTime,
+		Capacity:  input.Capacity,
+	}
+	if err := validateSessionTimes(&session); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.WithContext(ctx).Create(&session).Error; err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	return &session, nil
+}
+
+// Update applies update to a session
+func (s *EventSessionService) Update(ctx context.Context, sessionID uuid.UUID, update EventSessionUpdate) (*models.EventSession, error) {
+	session, err := s.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, session.EventID); err != nil {
+		return nil, err
+	}
+
+	if update.Title != nil {
+		session.Title = *update.Title
+	}
+	if update.StartTime != nil {
+		session.StartTime = *update.StartTime
+	}
+	if update.EndTime != nil {
+		session.EndTime = *update.EndTime
+	}
+	if update.Capacity != nil {
+		session.Capacity = *update.Capacity
+	}
+	if err := validateSessionTimes(session); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.WithContext(ctx).Model(session).Updates(map[string]interface{}{
+		"title":      session.Title,
+		"start_time": session.StartTime,
+		"end_time":   session.EndTime,
+		"capacity":   session.Capacity,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+	return session, nil
+}
+
+// Delete removes a session. Sessions still granted by a ticket tier are
+// kept, since dropping the link would open the tier to every session.
+func (s *EventSessionService) Delete(ctx context.Context, sessionID uuid.UUID) error {
+	session, err := s.GetSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, session.EventID); err != nil {
+		return err
+	}
+
+	var granted int64
+	if err := database.DB.WithContext(ctx).Table("ticket_tier_sessions").
+		Where("event_session_id = ?", sessionID).
+		Count(&granted).Error; err != nil {
+		return fmt.Errorf("failed to check ticket tiers: %w", err)
+	}
+	if granted > 0 {
+		return fmt.Errorf("remove the session from its ticket tiers before deleting it")
+	}
+
+	if err := database.DB.WithContext(ctx).Delete(session).Error; err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// SetTierSessions limits a tier to the given sessions of its event. An empty
+// list makes the tier valid for every session.
+func (s *EventSessionService) SetTierSessions(ctx context.Context, tierID uuid.UUID, sessionIDs []uuid.UUID) (*models.TicketTier, error) {
+	tier, err := s.tiers.GetTier(ctx, tierID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, tier.EventID); err != nil {
+		return nil, err
+	}
+
+	sessions := []models.EventSession{}
+	if len(sessionIDs) > 0 {
+		if err := database.DB.WithContext(ctx).
+			Where("id IN ? AND event_id = ?", sessionIDs, tier.EventID).
+			Find(&sessions).Error; err != nil {
+			return nil, fmt.Errorf("failed to fetch sessions: %w", err)
+		}
+		if len(sessions) != len(uniqueIDs(sessionIDs)) {
+			return nil, fmt.Errorf("every session must belong to the tier's event")
+		}
+	}
+
+	if err := database.DB.WithContext(ctx).Model(tier).Association("Sessions").Replace(sessions); err != nil {
+		return nil, fmt.Errorf("failed to update tier sessions: %w", err)
+	}
+	// Join table writes do not always pass through the cache hooks
+	InvalidateEventCache(ctx)
+
+	tier.Sessions = sessions
+	return tier, nil
+}
+
+// CheckIn admits a ticket to one session of its event. The ticket's tier
+// must grant the session, each ticket enters a session once, and a session
+// with a capacity admits no more than that many tickets.
+func (s *EventSessionService) CheckIn(ctx context.Context, ticket *models.Ticket, sessionID, validatorID uuid.UUID, zoneID *uuid.UUID, method models.CheckinMethod, device string) (*models.Checkin, error) {
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Preload("Sessions").First(&tier, ticket.TierID).Error; err != nil {
+		return nil, utils.NotFoundError("ticket tier not found")
+	}
+	if !tier.GrantsSession(sessionID) {
+		return nil, utils.BadRequestError("ticket is not valid for this session")
+	}
+
+	checkin := models.Checkin{
+		TicketID:   ticket.ID,
+		EventID:    tier.EventID,
+		SessionID:  &sessionID,
+		ZoneID:     zoneID,
+		ScannedBy:  validatorID,
+		ScannedAt:  time.Now(),
+		DeviceInfo: device,
+		Method:     method,
+	}
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		// Lock the session so concurrent scans cannot overfill it
+		var session models.EventSession
+		if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND event_id = ?", sessionID, tier.EventID).
+			First(&session).Error; err != nil {
+			return utils.NotFoundError("session not found")
+		}
+
+		var existing int64
+		if err := tx.WithContext(ctx).Model(&models.Checkin{}).
+			Where("ticket_id = ? AND session_id = ?", ticket.ID, sessionID).
+			Count(&existing).Error; err != nil {
+			return fmt.Errorf("failed to check previous check-ins: %w", err)
+		}
+		if existing > 0 {
+			return alreadyScannedError(ctx, ticket.ID, &sessionID)
+		}
+
+		if session.Capacity > 0 {
+			var admitted int64
+			if err := tx.WithContext(ctx).Model(&models.Checkin{}).
+				Where("session_id = ?", sessionID).
+				Count(&admitted).Error; err != nil {
+				return fmt.Errorf("failed to count session check-ins: %w", err)
+			}
+			if admitted >= int64(session.Capacity) {
+				return utils.BadRequestError("session is full")
+			}
+		}
+
+		if err := tx.WithContext(ctx).Create(&checkin).Error; err != nil {
+			return fmt.Errorf("failed to create check-in record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishCheckin(ctx, ticket, &checkin)
+	return &checkin, nil
+}
+
+func validateSessionTimes(session *models.EventSession) error {
+	if session.EndTime.Before(session.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	if session.Capacity < 0 {
+		return fmt.Errorf("capacity cannot be negative")
+	}
+	return nil
+}
+
+func uniqueIDs(ids []uuid.UUID) map[uuid.UUID]struct{} {
+	unique := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		unique[id] = struct{}{}
+	}
+	return unique
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// EventZoneInput describes an event zone
+type EventZoneInput struct {
+	Name         string
+	MaxOccupancy int // 0 means no limit
+}
+
+// EventZoneService manages the restricted areas of an event's venue and
+// which ticket tiers admit to them
+type EventZoneService struct {
+	tiers *TierService
+}
+
+// NewEventZoneService creates a new event zone service
+func NewEventZoneService() *EventZoneService {
+	return &EventZoneService{tiers: NewTierService()}
+}
+
+// GetZone returns an event zone by ID
+func (s *EventZoneService) GetZone(ctx context.Context, zoneID uuid.UUID) (*models.EventZone, error) {
+	var zone models.EventZone
+	if err := database.DB.WithContext(ctx).First(&zone, zoneID).Error; err != nil {
+		return nil, fmt.Errorf("zone not found")
+	}
+	return &zone, nil
+}
+
+// List returns an event's zones by name
+func (s *EventZoneService) List(ctx context.Context, eventID uuid.UUID) ([]models.EventZone, error) {
+	var zones []models.EventZone
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("name ASC").
+		Find(&zones).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch zones: %w", err)
+	}
+	return zones, nil
+}
+
+// Create adds a zone to an event that is still open for changes
+func (s *EventZoneService) Create(ctx context.Context, eventID uuid.UUID, input EventZoneInput) (*models.EventZone, error) {
+	if _, err := s.tiers.ensureEditable(ctx, eventID); err != nil {
+		return nil, err
+	}
+	if err := s.ensureUniqueName(ctx, eventID, input.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	zone := models.EventZone{EventID: eventID, Name: input.Name, MaxOccupancy: input.MaxOccupancy}
+	if err := database.DB.WithContext(ctx).Create(&zone).Error; err != nil {
+		return nil, fmt.Errorf("failed to create zone: %w", err)
+	}
+	return &zone, nil
+}
+
+// Update changes the name and occupancy limit of a zone
+func (s *EventZoneService) Update(ctx context.Context, zoneID uuid.UUID, input EventZoneInput) (*models.EventZone, error) {
+	zone, err := s.GetZone(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, zone.EventID); err != nil {
+		return nil, err
+	}
+	if err := s.ensureUniqueName(ctx, zone.EventID, input.Name, zone.ID); err != nil {
+		return nil, err
+	}
+
+	zone.Name, zone.MaxOccupancy = input.Name, input.MaxOccupancy
+	if err := database.DB.WithContext(ctx).Model(zone).Updates(map[string]interface{}{
+		"name":          zone.Name,
+		"max_occupancy": zone.MaxOccupancy,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update zone: %w", err)
+	}
+	return zone, nil
+}
+
+// Delete removes a zone that no ticket tier grants any more
+func (s *EventZoneService) Delete(ctx context.Context, zoneID uuid.UUID) error {
+	zone, err := s.GetZone(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, zone.EventID); err != nil {
+		return err
+	}
+
+	var granted int64
+	if err := database.DB.WithContext(ctx).Table("ticket_tier_zones").
+		Where("event_zone_id = ?", zoneID).
+		Count(&granted).Error; err != nil {
+		return fmt.Errorf("failed to check ticket tiers: %w", err)
+	}
+	if granted > 0 {
+		return fmt.Errorf("remove the zone from its ticket tiers before deleting it")
+	}
+
+	if err := database.DB.WithContext(ctx).Delete(zone).Error; err != nil {
+		return fmt.Errorf("failed to delete zone: %w", err)
+	}
+	return nil
+}
+
+// SetTierZones sets the zones of its event a tier admits to. An empty list
+// limits the tier to the main gate.
+func (s *EventZoneService) SetTierZones(ctx context.Context, tierID uuid.UUID, zoneIDs []uuid.UUID) (*models.TicketTier, error) {
+	tier, err := s.tiers.GetTier(ctx, tierID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, tier.EventID); err != nil {
+		return nil, err
+	}
+
+	zones := []models.EventZone{}
+	if len(zoneIDs) > 0 {
+		if err := database.DB.WithContext(ctx).
+			Where("id IN ? AND event_id = ?", zoneIDs, tier.EventID).
+			Find(&zones).Error; err != nil {
+			return nil, fmt.Errorf("failed to fetch zones: %w", err)
+		}
+		if len(zones) != len(uniqueIDs(zoneIDs)) {
+			return nil, fmt.Errorf("every zone must belong to the tier's event")
+		}
+	}
+
+	if err := database.DB.WithContext(ctx).Model(tier).Association("Zones").Replace(zones); err != nil {
+		return nil, fmt.Errorf("failed to update tier zones: %w", err)
+	}
+	// Join table writes do not always pass through the cache hooks
+	InvalidateEventCache(ctx)
+
+	tier.Zones = zones
+	return tier, nil
+}
+
+// Authorize checks that a ticket's tier admits to a zone of its event, for
+// scans at the zone's entrance
+func (s *EventZoneService) Authorize(ctx context.Context, ticket *models.Ticket, zoneID uuid.UUID) (*models.EventZone, error) {
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Preload("Zones").First(&tier, ticket.TierID).Error; err != nil {
+		return nil, utils.NotFoundError("ticket tier not found")
+	}
+
+	var zone models.EventZone
+	if err := database.DB.WithContext(ctx).
+		Where("id = ? AND event_id = ?", zoneID, tier.EventID).
+		First(&zone).Error; err != nil {
+		return nil, utils.NotFoundError("zone not found for this event")
+	}
+	if !tier.GrantsZone(zoneID) {
+		return nil, utils.ForbiddenError("%s tickets do not give access to %s", tier.TierName, zone.Name)
+	}
+	return &zone, nil
+}
+
+func (s *EventZoneService) ensureUniqueName(ctx context.Context, eventID uuid.UUID, name string, exceptID uuid.UUID) error {
+	var existing int64
+	if err := database.DB.WithContext(ctx).Model(&models.EventZone{}).
+		Where("event_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", eventID, name, exceptID).
+		Count(&existing).Error; err != nil {
+		return fmt.Errorf("failed to check zone names: %w", err)
+	}
+	if existing > 0 {
+		return fmt.Errorf("the event already has a zone named %s", name)
+	}
+	return nil
+}
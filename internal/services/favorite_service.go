@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+// FavoriteService manages the events attendees save for later
+type FavoriteService struct{}
+
+// NewFavoriteService creates a new favorite service
+func NewFavoriteService() *FavoriteService {
+	return &FavoriteService{}
+}
+
+// Add saves an event for a user. Saving an event twice is not an error.
+// Users are alerted when a saved event is published, unless it already
+// was, and when its tickets go on sale.
+func (s *FavoriteService) Add(ctx context.Context, userID, eventID uuid.UUID) (*models.Favorite, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Select("id", "status").First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+	if event.Status == models.EventCompleted || event.Status == models.EventCancelled || event.Status == models.EventBanned {
+		return nil, fmt.Errorf("%s events cannot be saved", event.Status)
+	}
+
+	favorite := models.Favorite{UserID: userID, EventID: eventID}
+	if event.Status == models.EventPublished || event.Status == models.EventActive {
+		now := time.Now()
+		favorite.PublishNotifiedAt = &now
+	}
+
+	if err := database.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&favorite).Error; err != nil {
+		return nil, fmt.Errorf("failed to save event: %w", err)
+	}
+	return &favorite, nil
+}
+
+// Remove unsaves an event. Removing an event that was not saved is not an error.
+func (s *FavoriteService) Remove(ctx context.Context, userID, eventID uuid.UUID) error {
+	if err := database.DB.WithContext(ctx).
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		Delete(&models.Favorite{}).Error; err != nil {
+		return fmt.Errorf("failed to remove saved event: %w", err)
+	}
+	return nil
+}
+
+// List returns a user's saved events with their tiers, most recently saved first
+func (s *FavoriteService) List(ctx context.Context, userID uuid.UUID, page, limit int) ([]models.Favorite, int64, error) {
+	query := database.Reader(database.DB).WithContext(ctx).Model(&models.Favorite{}).
+		Joins("JOIN events ON events.id = favorites.event_id AND events.deleted_at IS NULL").
+		Where("favorites.user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count saved events: %w", err)
+	}
+
+	var favorites []models.Favorite
+	if err := query.
+		Preload("Event").
+		Preload("Event.TicketTiers").
+		Order("favorites.created_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&favorites).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch saved events: %w", err)
+	}
+	return favorites, total, nil
+}
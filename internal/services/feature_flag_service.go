@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// Feature flags checked by the API. Flags not stored yet take their default.
+const (
+	FeatureGuestCheckout = "guest_checkout"
+	FeatureWaitingRoom   = "waiting_room"
+)
+
+// featureFlagDefault is the state and description of a flag nobody has set
+type featureFlagDefault struct {
+	enabled     bool
+	description string
+}
+
+var featureFlagDefaults = map[string]featureFlagDefault{
+	FeatureGuestCheckout: {true, "Buyers can check out without an account"},
+	FeatureWaitingRoom:   {true, "Events can queue buyers in a waiting room; when off, anyone may reserve"},
+}
+
+// featureFlagCacheTTL bounds how long a replica may act on a flag after it
+// changed, should the cache entry outlive the change
+const featureFlagCacheTTL = time.Minute
+
+// FeatureFlagService stores feature flags in the database with Redis in
+// front, so every replica sees a change within a request
+type FeatureFlagService struct{}
+
+// NewFeatureFlagService creates a new feature flag service
+func NewFeatureFlagService() *FeatureFlagService {
+	return &FeatureFlagService{}
+}
+
+// IsEnabled reports whether a flag is on. Unknown flags are off.
+func (s *FeatureFlagService) IsEnabled(ctx context.Context, key string) bool {
+	cached, err := cache.Client.Get(ctx, featureFlagKey(key)).Result()
+	if err == nil {
+		return cached == "1"
+	}
+	if err != redis.Nil {
+		logger.WithContext(ctx).Warn("Failed to read feature flag cache", zap.String("flag", key), zap.Error(err))
+	}
+
+	enabled := featureFlagDefaults[key].enabled
+	flag, err := s.Find(ctx, key)
+	if err != nil {
+		// Fall back to the default rather than failing the request
+		logger.WithContext(ctx).Error("Failed to read feature flag", zap.String("flag", key), zap.Error(err))
+		return enabled
+	}
+	if flag != nil {
+		enabled = flag.Enabled
+	}
+
+	value := "0"
+	if enabled {
+		value = "1"
+	}
+	cache.Client.Set(ctx, featureFlagKey(key), value, featureFlagCacheTTL)
+	return enabled
+}
+
+// Find returns a stored flag, or nil when it has never been set
+func (s *FeatureFlagService) Find(ctx context.Context, key string) (*models.FeatureFlag, error) {
+	var flag models.FeatureFlag
+	err := database.DB.WithContext(ctx).Where("key = ?", key).First(&flag).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch feature flag: %w", err)
+	}
+	return &flag, nil
+}
+
+// List returns every stored flag along with the known flags still at their
+// default, sorted by key
+func (s *FeatureFlagService) List(ctx context.Context) ([]models.FeatureFlag, error) {
+	var flags []models.FeatureFlag
+	if err := database.DB.WithContext(ctx).Find(&flags).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch feature flags: %w", err)
+	}
+
+	stored := make(map[string]bool, len(flags))
+	for _, flag := range flags {
+		stored[flag.Key] = true
+	}
+	for key, def := range featureFlagDefaults {
+		if !stored[key] {
+			flags = append(flags, models.FeatureFlag{Key: key, Enabled: def.enabled, Description: def.description})
+		}
+	}
+
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Key < flags[j].Key })
+	return flags, nil
+}
+
+// Set turns a flag on or off, creating it on first use. An empty description
+// keeps the current one.
+func (s *FeatureFlagService) Set(ctx context.Context, key string, enabled bool, description string, adminID uuid.UUID) (*models.FeatureFlag, error) {
+	flag, err := s.Find(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if flag == nil {
+		flag = &models.FeatureFlag{Key: key, Description: featureFlagDefaults[key].description}
+	}
+
+	flag.Enabled = enabled
+	flag.UpdatedBy = &adminID
+	if description = strings.TrimSpace(description); description != "" {
+		flag.Description = description
+	}
+
+	if err := database.DB.WithContext(ctx).Save(flag).Error; err != nil {
+		return nil, fmt.Errorf("failed to save feature flag: %w", err)
+	}
+
+	if err := cache.Client.Del(ctx, featureFlagKey(key)).Err(); err != nil {
+		logger.WithContext(ctx).Warn("Failed to clear feature flag cache", zap.String("flag", key), zap.Error(err))
+	}
+	return flag, nil
+}
+
+func featureFlagKey(key string) string {
+	return "feature_flag:" + key
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+// FormFieldInput describes a new registration form field
+type FormFieldInput struct {
+	Label    string
+	Type     models.FormFieldType
+	Options  []string
+	Required bool
+	Position int
+}
+
+// FormFieldUpdate holds the form field settings to change; nil fields are left as they are
+type FormFieldUpdate struct {
+	Label    *string
+	Type     *models.FormFieldType
+	Options  []string // replaces the choices when not nil
+	Required *bool
+	Position *int
+}
+
+// FormService manages the custom questions of an event's registration form
+// and the answers collected for them at checkout
+type FormService struct {
+	tiers *TierService
+}
+
+// NewFormService creates a new form service
+func NewFormService() *FormService {
+	return &FormService{tiers: NewTierService()}
+}
+
+// GetField returns a form field by ID
+func (s *FormService) GetField(ctx context.Context, fieldID uuid.UUID) (*models.FormField, error) {
+	var field models.FormField
+	if err := database.DB.WithContext(ctx).First(&field, fieldID).Error; err != nil {
+		return nil, fmt.Errorf("form field not found")
+	}
+	return &field, nil
+}
+
+// List returns an event's form fields in display order
+func (s *FormService) List(ctx context.Context, eventID uuid.UUID) ([]models.FormField, error) {
+	var fields []models.FormField
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("position ASC, created_at ASC").
+		Find(&fields).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch form fields: %w", err)
+	}
+	return fields, nil
+}
+
+// Create adds a field to an event's registration form
+func (s *FormService) Create(ctx context.Context, eventID uuid.UUID, input FormFieldInput) (*models.FormField, error) {
+	if _, err := s.tiers.ensureEditable(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	field := models.FormField{
+		EventID:  eventID,
+		Label:    strings.TrimSpace(input.Label),
+		Type:     input.Type,
+		Options:  input.Options,
+		Required: input.Required,
+		Position: input.Position,
+	}
+	if err := validateFormField(&field); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.WithContext(ctx).Create(&field).Error; err != nil {
+		return nil, fmt.Errorf("failed to create form field: %w", err)
+	}
+	return &field, nil
+}
+
+// Update applies update to a form field. Answers already given are kept.
+func (s *FormService) Update(ctx context.Context, fieldID uuid.UUID, update FormFieldUpdate) (*models.FormField, error) {
+	field, err := s.GetField(ctx, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, field.EventID); err != nil {
+		return nil, err
+	}
+
+	if update.Label != nil {
+		field.Label = strings.TrimSpace(*update.Label)
+	}
+	if update.Type != nil {
+		field.Type = *update.Type
+	}
+	if update.Options != nil {
+		field.Options = update.Options
+	}
+	if update.Required != nil {
+		field.Required = *update.Required
+	}
+	if update.Position != nil {
+		field.Position = *update.Position
+	}
+	if err := validateFormField(field); err != nil {
+		return nil, err
+	}
+
+	if err := database.DB.WithContext(ctx).Model(field).Select("label", "type", "options", "required", "position").Updates(field).Error; err != nil {
+		return nil, fmt.Errorf("failed to update form field: %w", err)
+	}
+	return field, nil
+}
+
+// Delete removes a field from an event's registration form
+func (s *FormService) Delete(ctx context.Context, fieldID uuid.UUID) error {
+	field, err := s.GetField(ctx, fieldID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.tiers.ensureEditable(ctx, field.EventID); err != nil {
+		return err
+	}
+
+	if err := database.DB.WithContext(ctx).Delete(field).Error; err != nil {
+		return fmt.Errorf("failed to delete form field: %w", err)
+	}
+	return nil
+}
+
+// ValidateAnswers checks the form answers given for an order of quantity
+// tickets and returns one normalized answer set per ticket. A single set is
+// used for every ticket in the order.
+func (s *FormService) ValidateAnswers(ctx context.Context, eventID uuid.UUID, quantity int, sets []models.AnswerSet) ([]models.AnswerSet, error) {
+	fields, err := s.List(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		if len(sets) > 0 {
+			return nil, fmt.Errorf("this event does not ask any questions")
+		}
+		return nil, nil
+	}
+
+	switch len(sets) {
+	case quantity:
+	case 0, 1:
+		shared := models.AnswerSet{}
+		if len(sets) == 1 {
+			shared = sets[0]
+		}
+		sets = make([]models.AnswerSet, quantity)
+		for i := range sets {
+			sets[i] = shared
+		}
+	default:
+		return nil, fmt.Errorf("give answers once for the order or once per ticket")
+	}
+
+	known := make(map[uuid.UUID]*models.FormField, len(fields))
+	for i := range fields {
+		known[fields[i].ID] = &fields[i]
+	}
+
+	normalized := make([]models.AnswerSet, len(sets))
+	for i, set := range sets {
+		for id := range set {
+			if _, ok := known[id]; !ok {
+				return nil, fmt.Errorf("form field %s does not belong to this event", id)
+			}
+		}
+
+		normalized[i] = models.AnswerSet{}
+		for _, field := range fields {
+			value, err := normalizeAnswer(&field, set[field.ID])
+			if err != nil {
+				return nil, err
+			}
+			if value != "" {
+				normalized[i][field.ID] = value
+			}
+		}
+	}
+	return normalized, nil
+}
+
+func validateFormField(field *models.FormField) error {
+	if field.Label == "" {
+		return fmt.Errorf("label is required")
+	}
+
+	switch field.Type {
+	case models.FormFieldSelect:
+		if len(field.Options) == 0 {
+			return fmt.Errorf("select fields need at least one option")
+		}
+	case models.FormFieldText, models.FormFieldNumber, models.FormFieldCheckbox:
+		field.Options = nil
+	default:
+		return fmt.Errorf("unsupported form field type %q", field.Type)
+	}
+	return nil
+}
+
+// normalizeAnswer checks one answer against its field, returning the value to
+// store or an empty string when the field was left blank
+func normalizeAnswer(field *models.FormField, value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch field.Type {
+	case models.FormFieldCheckbox:
+		if value == "" {
+			value = "false"
+		}
+		checked, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("%s must be true or false", field.Label)
+		}
+		if field.Required && !checked {
+			return "", fmt.Errorf("%s must be checked", field.Label)
+		}
+		return strconv.FormatBool(checked), nil
+	}
+
+	if value == "" {
+		if field.Required {
+			return "", fmt.Errorf("%s is required", field.Label)
+		}
+		return "", nil
+	}
+
+	switch field.Type {
+	case models.FormFieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "", fmt.Errorf("%s must be a number", field.Label)
+		}
+	case models.FormFieldSelect:
+		for _, option := range field.Options {
+			if option == value {
+				return value, nil
+			}
+		}
+		return "", fmt.Errorf("%s must be one of %s", field.Label, strings.Join(field.Options, ", "))
+	}
+	return value, nil
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/middleware"
+	"eventix-api/pkg/utils"
+)
+
+const (
+	// integrationKeyPrefix marks integration keys, so leaked keys are easy to
+	// recognise in logs and secret scanners
+	integrationKeyPrefix = "evx_"
+
+	// maxIntegrationKeys bounds the active keys of one organizer
+	maxIntegrationKeys = 10
+
+	// integrationKeyTouchInterval bounds how often a key's last use is written
+	integrationKeyTouchInterval = time.Minute
+)
+
+// IntegrationKeyService issues, revokes and checks the API keys organizers
+// connect Zapier, Make and similar tools with
+type IntegrationKeyService struct{}
+
+// NewIntegrationKeyService creates a new integration key service
+func NewIntegrationKeyService() *IntegrationKeyService {
+	return &IntegrationKeyService{}
+}
+
+// List returns an organizer's keys, newest first, including revoked ones
+func (s *IntegrationKeyService) List(ctx context.Context, organizerID uuid.UUID) ([]models.IntegrationKey, error) {
+	var keys []models.IntegrationKey
+	if err := database.DB.WithContext(ctx).
+		Where("organizer_id = ?", organizerID).
+		Order("created_at DESC").
+		Find(&keys).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch API keys: %w", err)
+	}
+	return keys, nil
+}
+
+// Create issues a key and returns it with the plaintext key, which is only
+// shown once
+func (s *IntegrationKeyService) Create(ctx context.Context, organizerID uuid.UUID, name string, createdBy uuid.UUID) (*models.IntegrationKey, string, error) {
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&models.IntegrationKey{}).
+		Where("organizer_id = ? AND revoked_at IS NULL", organizerID).
+		Count(&count).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to count API keys: %w", err)
+	}
+	if count >= maxIntegrationKeys {
+		return nil, "", utils.BadRequestError("an organizer can have at most %d active API keys", maxIntegrationKeys)
+	}
+
+	random, err := utils.GenerateRandomString(40)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	plaintext := integrationKeyPrefix + random
+
+	key := &models.IntegrationKey{
+		OrganizerID: organizerID,
+		Name:        name,
+		Prefix:      plaintext[:12],
+		KeyHash:     hashIntegrationKey(plaintext),
+		CreatedBy:   createdBy,
+	}
+	if err := database.DB.WithContext(ctx).Create(key).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to save API key: %w", err)
+	}
+	return key, plaintext, nil
+}
+
+// Revoke stops a key from working; revoked keys stay listed
+func (s *IntegrationKeyService) Revoke(ctx context.Context, organizerID, keyID uuid.UUID) error {
+	result := database.DB.WithContext(ctx).Model(&models.IntegrationKey{}).
+		Where("id = ? AND organizer_id = ? AND revoked_at IS NULL", keyID, organizerID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke API key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.NotFoundError("API key not found")
+	}
+	return nil
+}
+
+// AuthenticateAPIKey resolves an active key to its organizer and creator,
+// recording when it was last used
+func (s *IntegrationKeyService) AuthenticateAPIKey(ctx context.Context, plaintext string) (*middleware.APIKeyIdentity, error) {
+	var key models.IntegrationKey
+	err := database.DB.WithContext(ctx).
+		Where("key_hash = ? AND revoked_at IS NULL", hashIntegrationKey(plaintext)).
+		First(&key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, middleware.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch API key: %w", err)
+	}
+
+	// A creator whose account was deleted takes their keys with them
+	var creator models.User
+	err = database.DB.WithContext(ctx).Select("id", "role").First(&creator, key.CreatedBy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, middleware.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch API key creator: %w", err)
+	}
+
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > integrationKeyTouchInterval {
+		database.DB.WithContext(ctx).Model(&key).UpdateColumn("last_used_at", now)
+	}
+
+	return &middleware.APIKeyIdentity{
+		KeyID:       key.ID.String(),
+		KeyName:     key.Name,
+		OrganizerID: key.OrganizerID.String(),
+		UserID:      creator.ID.String(),
+		Role:        string(creator.Role),
+	}, nil
+}
+
+func hashIntegrationKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// IntegrationAttendee is an issued ticket with its holder, as polled by
+// no-code integrations
+type IntegrationAttendee struct {
+	ID          uuid.UUID
+	OrderID     uuid.UUID
+	EventID     uuid.UUID
+	EventTitle  string
+	TierID      uuid.UUID
+	TierName    string
+	FirstName   string
+	LastName    string
+	Email       string
+	Status      models.TicketStatus
+	CheckedInAt *time.Time
+	CreatedAt   time.Time
+}
+
+// IntegrationOrder is a sold order with its buyer, as polled by no-code
+// integrations. SoldAt is when its tickets were issued.
+type IntegrationOrder struct {
+	ID          uuid.UUID
+	EventID     uuid.UUID
+	EventTitle  string
+	TierID      uuid.UUID
+	TierName    string
+	Quantity    int
+	TotalAmount int64
+	Currency    string
+	Status      models.OrderStatus
+	IsComp      bool
+	FirstName   string
+	LastName    string
+	Email       string
+	SoldAt      time.Time
+}
+
+// IntegrationFilter narrows an integration feed
+type IntegrationFilter struct {
+	EventID *uuid.UUID    // nil for every event of the organizer
+	Since   *utils.Cursor // only rows after this one
+	Limit   int
+}
+
+// IntegrationService serves the polling triggers and searches that Zapier,
+// Make and similar tools read an organizer's sales and attendees through
+type IntegrationService struct{}
+
+// NewIntegrationService creates a new integration service
+func NewIntegrationService() *IntegrationService {
+	return &IntegrationService{}
+}
+
+// Events returns an organizer's events, latest first, for integration dropdowns
+func (s *IntegrationService) Events(ctx context.Context, organizerID uuid.UUID) ([]models.Event, error) {
+	var events []models.Event
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Select("id", "title", "slug", "status", "start_time", "end_time").
+		Where("organizer_id = ?", organizerID).
+		Order("start_time DESC").
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+	return events, nil
+}
+
+// Tiers returns the ticket tiers of one of an organizer's events
+func (s *IntegrationService) Tiers(ctx context.Context, organizerID, eventID uuid.UUID) ([]models.TicketTier, error) {
+	if err := s.authorizeEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+
+	var tiers []models.TicketTier
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(&tiers).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch ticket tiers: %w", err)
+	}
+	return tiers, nil
+}
+
+// NewAttendees returns issued tickets newest first. With filter.Since, only
+// tickets issued after it are returned, the oldest of them first to fill the
+// limit, so a poller that keeps the newest cursor never skips any.
+func (s *IntegrationService) NewAttendees(ctx context.Context, organizerID uuid.UUID, filter IntegrationFilter) ([]IntegrationAttendee, error) {
+	if filter.EventID != nil {
+		if err := s.authorizeEvent(ctx, organizerID, *filter.EventID); err != nil {
+			return nil, err
+		}
+	}
+
+	query := s.attendees(ctx, organizerID, filter.EventID)
+	attendees := []IntegrationAttendee{}
+	if err := sinceCursor(query, filter, "t.created_at", "t.id").Scan(&attendees).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch attendees: %w", err)
+	}
+	if filter.Since != nil {
+		slices.Reverse(attendees)
+	}
+	return attendees, nil
+}
+
+// FindAttendees returns the issued tickets held by an email address, newest first
+func (s *IntegrationService) FindAttendees(ctx context.Context, organizerID uuid.UUID, eventID *uuid.UUID, email string, limit int) ([]IntegrationAttendee, error) {
+	if eventID != nil {
+		if err := s.authorizeEvent(ctx, organizerID, *eventID); err != nil {
+			return nil, err
+		}
+	}
+
+	attendees := []IntegrationAttendee{}
+	if err := s.attendees(ctx, organizerID, eventID).
+		Where("LOWER(u.email) = ?", strings.ToLower(strings.TrimSpace(email))).
+		Order("t.created_at DESC, t.id DESC").
+		Limit(limit).
+		Scan(&attendees).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch attendees: %w", err)
+	}
+	return attendees, nil
+}
+
+// NewOrders returns sold orders, comps included, newest first, paged by
+// filter.Since like NewAttendees. Refunded orders stay listed with their
+// current status.
+func (s *IntegrationService) NewOrders(ctx context.Context, organizerID uuid.UUID, filter IntegrationFilter) ([]IntegrationOrder, error) {
+	if filter.EventID != nil {
+		if err := s.authorizeEvent(ctx, organizerID, *filter.EventID); err != nil {
+			return nil, err
+		}
+	}
+
+	// An order is sold when its tickets are issued; its own timestamps are
+	// of the reservation and of its latest change
+	query := database.Reader(database.DB).WithContext(ctx).Table("orders o").
+		Select(`o.id, tt.event_id, e.title AS event_title, o.tier_id, tt.tier_name, o.quantity,
+			o.total_amount, o.currency, o.status, o.is_comp, u.first_name, u.last_name, u.email, s.sold_at`).
+		Joins("JOIN LATERAL (SELECT MIN(t.created_at) AS sold_at FROM tickets t WHERE t.order_id = o.id) s ON s.sold_at IS NOT NULL").
+		Joins("JOIN ticket_tiers tt ON tt.id = o.tier_id").
+		Joins("JOIN events e ON e.id = tt.event_id").
+		Joins("JOIN users u ON u.id = o.user_id").
+		Where("e.organizer_id = ? AND o.deleted_at IS NULL", organizerID)
+	if filter.EventID != nil {
+		query = query.Where("tt.event_id = ?", *filter.EventID)
+	}
+
+	orders := []IntegrationOrder{}
+	if err := sinceCursor(query, filter, "s.sold_at", "o.id").Scan(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch orders: %w", err)
+	}
+	if filter.Since != nil {
+		slices.Reverse(orders)
+	}
+	return orders, nil
+}
+
+// attendees selects the tickets an organizer has issued that still admit
+func (s *IntegrationService) attendees(ctx context.Context, organizerID uuid.UUID, eventID *uuid.UUID) *gorm.DB {
+	query := database.Reader(database.DB).WithContext(ctx).Table("tickets t").
+		Select(`t.id, t.order_id, tt.event_id, e.title AS event_title, t.tier_id, tt.tier_name,
+			u.first_name, u.last_name, u.email, t.status, t.checked_in_at, t.created_at`).
+		Joins("JOIN ticket_tiers tt ON tt.id = t.tier_id").
+		Joins("JOIN events e ON e.id = tt.event_id").
+		Joins("JOIN users u ON u.id = t.owner_id").
+		Where("e.organizer_id = ? AND t.deleted_at IS NULL AND t.status IN ?", organizerID,
+			[]models.TicketStatus{models.TicketActive, models.TicketUsed})
+	if eventID != nil {
+		query = query.Where("tt.event_id = ?", *eventID)
+	}
+	return query
+}
+
+func (s *IntegrationService) authorizeEvent(ctx context.Context, organizerID, eventID uuid.UUID) error {
+	var count int64
+	if err := database.Reader(database.DB).WithContext(ctx).Model(&models.Event{}).
+		Where("id = ? AND organizer_id = ?", eventID, organizerID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to fetch event: %w", err)
+	}
+	if count == 0 {
+		return utils.NotFoundError("event not found")
+	}
+	return nil
+}
+
+// sinceCursor orders a feed newest first, or from just after filter.Since
+// oldest first
+func sinceCursor(query *gorm.DB, filter IntegrationFilter, timeColumn, idColumn string) *gorm.DB {
+	if filter.Since == nil {
+		return query.Order(timeColumn + " DESC, " + idColumn + " DESC").Limit(filter.Limit)
+	}
+	return query.
+		Where("("+timeColumn+", "+idColumn+") > (?, ?)", filter.Since.Time, filter.Since.ID).
+		Order(timeColumn + " ASC, " + idColumn + " ASC").
+		Limit(filter.Limit)
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// JobFilter selects background jobs for the admin list
+type JobFilter struct {
+	Status models.JobStatus
+	Type   string
+	Offset int
+	Limit  int
+}
+
+// JobService lets admins inspect the background job queue and retry or
+// discard the jobs in its dead letter state
+type JobService struct{}
+
+// NewJobService creates a new job service
+func NewJobService() *JobService {
+	return &JobService{}
+}
+
+// List returns the jobs matching filter, most recently updated first
+func (s *JobService) List(ctx context.Context, filter JobFilter) ([]models.Job, int64, error) {
+	query := database.Reader(database.DB).WithContext(ctx).Model(&models.Job{})
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count jobs: %w", err)
+	}
+
+	var jobs []models.Job
+	if err := query.Order("updated_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&jobs).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch jobs: %w", err)
+	}
+	return jobs, total, nil
+}
+
+// Get returns a job
+func (s *JobService) Get(ctx context.Context, jobID uuid.UUID) (*models.Job, error) {
+	var job models.Job
+	if err := database.DB.WithContext(ctx).First(&job, jobID).Error; err != nil {
+		return nil, utils.NotFoundError("job not found")
+	}
+	return &job, nil
+}
+
+// Retry returns a dead job to the queue with its attempts reset, to run as
+// soon as a runner is free
+func (s *JobService) Retry(ctx context.Context, jobID uuid.UUID) (*models.Job, error) {
+	job, err := s.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Conditional update so a job is only requeued once
+	result := database.DB.WithContext(ctx).Model(&models.Job{}).
+		Where("id = ? AND status = ?", jobID, models.JobDead).
+		Updates(map[string]interface{}{
+			"status":       models.JobPending,
+			"attempts":     0,
+			"run_at":       time.Now(),
+			"finished_at":  nil,
+			"locked_until": nil,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to retry job: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, utils.ConflictError("job is %s, only dead jobs can be retried", job.Status)
+	}
+	return s.Get(ctx, jobID)
+}
+
+// Discard deletes a dead or pending job so it never runs. Running jobs
+// cannot be discarded.
+func (s *JobService) Discard(ctx context.Context, jobID uuid.UUID) error {
+	job, err := s.Get(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	result := database.DB.WithContext(ctx).
+		Where("id = ? AND status IN ?", jobID, []models.JobStatus{models.JobDead, models.JobPending}).
+		Delete(&models.Job{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to discard job: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ConflictError("job is %s, only dead or pending jobs can be discarded", job.Status)
+	}
+	return nil
+}
+
+// Schedules returns the recorded job schedules by name
+func (s *JobService) Schedules(ctx context.Context) ([]models.JobSchedule, error) {
+	var schedules []models.JobSchedule
+	if err := database.Reader(database.DB).WithContext(ctx).Order("name ASC").Find(&schedules).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch job schedules: %w", err)
+	}
+	return schedules, nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+)
+
+// LoginGuard locks accounts after repeated failed logins
+type LoginGuard struct {
+	maxAttempts int
+	window      time.Duration
+	lockout     time.Duration
+}
+
+// NewLoginGuard creates a new login guard
+func NewLoginGuard(cfg *config.LimitsConfig) *LoginGuard {
+	return &LoginGuard{
+		maxAttempts: cfg.LoginMaxAttempts,
+		window:      cfg.LoginAttemptWindow,
+		lockout:     cfg.LoginLockoutDuration,
+	}
+}
+
+func loginFailuresKey(email string) string {
+	return fmt.Sprintf("login_failures:%s", strings.ToLower(email))
+}
+
+func loginLockKey(email string) string {
+	return fmt.Sprintf("login_lock:%s", strings.ToLower(email))
+}
+
+// LockedFor returns how much longer the account is locked, or zero if it is not
+func (g *LoginGuard) LockedFor(ctx context.Context, email string) (time.Duration, error) {
+	ttl, err := cache.Client.TTL(ctx, loginLockKey(email)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check account lock: %w", err)
+	}
+	if ttl < 0 {
+		// -2: no lock, -1: lock without expiry (never set by the guard)
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// RecordFailure counts a failed login and locks the account once the limit is
+// reached within the window. It reports whether the account is now locked.
+func (g *LoginGuard) RecordFailure(ctx context.Context, email string) (bool, error) {
+	key := loginFailuresKey(email)
+
+	failures, err := cache.Client.Incr(ctx, key).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to record login failure: %w", err)
+	}
+	if failures == 1 {
+		cache.Client.Expire(ctx, key, g.window)
+	}
+
+	if failures < int64(g.maxAttempts) {
+		return false, nil
+	}
+
+	if err := cache.Client.Set(ctx, loginLockKey(email), time.Now().Unix(), g.lockout).Err(); err != nil {
+		return false, fmt.Errorf("failed to lock account: %w", err)
+	}
+	cache.Client.Del(ctx, key)
+
+	logger.WithContext(ctx).Warn("Account locked after repeated failed logins",
+		zap.String("email", email),
+		zap.Int64("failures", failures),
+		zap.Duration("lockout", g.lockout),
+	)
+
+	return true, nil
+}
+
+// Reset clears the failure count and any lock on the account
+func (g *LoginGuard) Reset(ctx context.Context, email string) error {
+	if err := cache.Client.Del(ctx, loginFailuresKey(email), loginLockKey(email)).Err(); err != nil {
+		return fmt.Errorf("failed to reset login attempts: %w", err)
+	}
+	return nil
+}
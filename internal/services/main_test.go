@@ -0,0 +1,39 @@
+//go:build integration
+
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"eventix-api/internal/testutil"
+)
+
+var env *testutil.Env
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	var err error
+	env, err = testutil.Start(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start test environment: %v\n", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	if err := env.Close(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to stop test environment: %v\n", err)
+	}
+	os.Exit(code)
+}
+
+// reset empties the test database and Redis
+func reset(t *testing.T) {
+	t.Helper()
+	if err := env.Reset(context.Background()); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+}
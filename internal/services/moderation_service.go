@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// ModerationFilter selects events for the admin moderation queue. Reported
+// limits the queue to events with open reports, most reported first.
+type ModerationFilter struct {
+	Status   models.EventStatus
+	Reported bool
+	Offset   int
+	Limit    int
+}
+
+// ModerationItem is an event in the moderation queue with its open reports
+type ModerationItem struct {
+	Event       models.Event
+	OpenReports int64
+}
+
+// ModerationService handles user reports on events and the admin actions
+// that take events down
+type ModerationService struct {
+	events      *EventService
+	emailCfg    *config.EmailConfig
+	frontendURL string
+}
+
+// NewModerationService creates a new moderation service
+func NewModerationService(cfg *config.Config) *ModerationService {
+	return &ModerationService{
+		events:      NewEventService(),
+		emailCfg:    &cfg.Email,
+		frontendURL: cfg.Server.FrontendURL,
+	}
+}
+
+// Report flags an event for admins to review. Each user can report an event
+// once.
+func (s *ModerationService) Report(ctx context.Context, eventID, reporterID uuid.UUID, reason models.ReportReason, details string) (*models.EventReport, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Select("id", "status").First(&event, eventID).Error; err != nil {
+		return nil, utils.NotFoundError("event not found")
+	}
+	if event.Status != models.EventPublished && event.Status != models.EventActive {
+		return nil, utils.ConflictError("only published events can be reported")
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&models.EventReport{}).
+		Where("event_id = ? AND reporter_id = ?", eventID, reporterID).
+		Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to check reports: %w", err)
+	}
+	if count > 0 {
+		return nil, utils.ConflictError("you have already reported this event")
+	}
+
+	report := models.EventReport{
+		EventID:    eventID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		Details:    strings.TrimSpace(details),
+		Status:     models.ReportOpen,
+	}
+	if err := database.DB.WithContext(ctx).Create(&report).Error; err != nil {
+		return nil, fmt.Errorf("failed to record report: %w", err)
+	}
+	return &report, nil
+}
+
+// Queue returns the events awaiting moderation, oldest first, or the most
+// reported first when filter.Reported is set
+func (s *ModerationService) Queue(ctx context.Context, filter ModerationFilter) ([]ModerationItem, int64, error) {
+	query := database.Reader(database.DB).WithContext(ctx).Model(&models.Event{})
+	if filter.Status != "" {
+		query = query.Where("events.status = ?", filter.Status)
+	}
+	if filter.Reported {
+		query = query.Joins("JOIN (?) AS reports ON reports.event_id = events.id",
+			database.DB.Model(&models.EventReport{}).
+				Select("event_id, COUNT(*) AS open_reports").
+				Where("status = ?", models.ReportOpen).
+				Group("event_id"))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	if filter.Reported {
+		query = query.Order("reports.open_reports DESC").Order("events.updated_at ASC")
+	} else {
+		query = query.Order("events.updated_at ASC")
+	}
+
+	var events []models.Event
+	if err := query.Preload("Organizer").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&events).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch events: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, total, nil
+	}
+
+	ids := make([]uuid.UUID, len(events))
+	for i := range events {
+		ids[i] = events[i].ID
+	}
+
+	var counts []struct {
+		EventID uuid.UUID
+		Count   int64
+	}
+	if err := database.Reader(database.DB).WithContext(ctx).Model(&models.EventReport{}).
+		Select("event_id, COUNT(*) AS count").
+		Where("event_id IN ? AND status = ?", ids, models.ReportOpen).
+		Group("event_id").
+		Scan(&counts).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+	open := make(map[uuid.UUID]int64, len(counts))
+	for _, c := range counts {
+		open[c.EventID] = c.Count
+	}
+
+	items := make([]ModerationItem, len(events))
+	for i := range events {
+		items[i] = ModerationItem{Event: events[i], OpenReports: open[events[i].ID]}
+	}
+	return items, total, nil
+}
+
+// Reports returns every report on an event, newest first
+func (s *ModerationService) Reports(ctx context.Context, eventID uuid.UUID) ([]models.EventReport, error) {
+	var reports []models.EventReport
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at DESC").
+		Find(&reports).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch reports: %w", err)
+	}
+	return reports, nil
+}
+
+// Unpublish takes a published event off sale. The organizer is emailed the
+// reason and may edit the event and submit it for review again.
+func (s *ModerationService) Unpublish(ctx context.Context, eventID, adminID uuid.UUID, reason string) (*models.Event, error) {
+	return s.takeDown(ctx, eventID, adminID, models.EventUnpublished, reason)
+}
+
+// Ban removes an event for good. The organizer is emailed the reason.
+func (s *ModerationService) Ban(ctx context.Context, eventID, adminID uuid.UUID, reason string) (*models.Event, error) {
+	return s.takeDown(ctx, eventID, adminID, models.EventBanned, reason)
+}
+
+// DismissReports closes an event's open reports without acting on the
+// event, returning how many were closed
+func (s *ModerationService) DismissReports(ctx context.Context, eventID, adminID uuid.UUID) (int64, error) {
+	return resolveReports(database.DB.WithContext(ctx), eventID, adminID, models.ReportDismissed)
+}
+
+// History returns an event's status changes, newest first, including the
+// reasons given for rejections and take-downs
+func (s *ModerationService) History(ctx context.Context, eventID uuid.UUID) ([]models.EventStatusChange, error) {
+	var changes []models.EventStatusChange
+	if err := database.Reader(database.DB).WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Order("created_at DESC").
+		Find(&changes).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch status history: %w", err)
+	}
+	return changes, nil
+}
+
+func (s *ModerationService) takeDown(ctx context.Context, eventID, adminID uuid.UUID, to models.EventStatus, reason string) (*models.Event, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, utils.BadRequestError("a reason is required")
+	}
+
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Preload("Organizer.User").First(&event, eventID).Error; err != nil {
+		return nil, utils.NotFoundError("event not found")
+	}
+
+	if err := s.events.Transition(&event, to, adminID, reason); err != nil {
+		return nil, err
+	}
+
+	// The event is down either way; the reports only track why
+	if _, err := resolveReports(database.DB.WithContext(ctx), eventID, adminID, models.ReportActioned); err != nil {
+		logger.WithContext(ctx).Error("Failed to resolve reports of moderated event", zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+
+	if _, err := eventModeratedEmailJob.Enqueue(ctx, eventModeratedEmail{EventID: event.ID, Reason: reason}); err != nil {
+		logger.WithContext(ctx).Error("Failed to queue event moderation email", zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+
+	return &event, nil
+}
+
+// notifyOrganizer runs an eventModeratedEmailJob
+func (s *ModerationService) notifyOrganizer(ctx context.Context, job eventModeratedEmail) error {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Preload("Organizer.User").First(&event, job.EventID).Error; err != nil {
+		return fmt.Errorf("failed to fetch moderated event: %w", err)
+	}
+
+	organizer := event.Organizer.User
+	if err := NewEmailService(s.emailCfg).SendEventModeratedEmail(ctx, organizer.ID, organizer.Email, organizer.FirstName, &event, job.Reason, s.frontendURL); err != nil {
+		return fmt.Errorf("failed to send event moderation email: %w", err)
+	}
+	return nil
+}
+
+func resolveReports(db *gorm.DB, eventID, adminID uuid.UUID, status models.ReportStatus) (int64, error) {
+	result := db.Model(&models.EventReport{}).
+		Where("event_id = ? AND status = ?", eventID, models.ReportOpen).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"resolved_by": adminID,
+			"resolved_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to resolve reports: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
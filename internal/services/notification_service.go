@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// NotificationService records notifications sent to users
+type NotificationService struct{}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService() *NotificationService {
+	return &NotificationService{}
+}
+
+// Create records a notification about to be dispatched on channel
+func (s *NotificationService) Create(userID uuid.UUID, channel models.NotificationChannel, subject, message string, metadata map[string]interface{}) (*models.Notification, error) {
+	if message == "" {
+		message = subject
+	}
+
+	notification := models.Notification{
+		UserID:   userID,
+		Type:     models.NotificationType(channel),
+		Channel:  channel,
+		Subject:  subject,
+		Message:  message,
+		Metadata: "{}",
+	}
+
+	if len(metadata) > 0 {
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode notification metadata: %w", err)
+		}
+		notification.Metadata = string(data)
+	}
+
+	if err := database.DB.Create(&notification).Error; err != nil {
+		return nil, fmt.Errorf("failed to record notification: %w", err)
+	}
+
+	return &notification, nil
+}
+
+// MarkSent records that a notification was delivered by its channel
+func (s *NotificationService) MarkSent(notificationID uuid.UUID) error {
+	if err := database.DB.Model(&models.Notification{}).
+		Where("id = ?", notificationID).
+		Update("sent_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	return nil
+}
+
+// ListForUser returns a user's notifications, newest first
+func (s *NotificationService) ListForUser(userID uuid.UUID, unreadOnly bool, page, limit int) ([]models.Notification, int64, error) {
+	query := database.DB.Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("is_read = ?", false)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	var notifications []models.Notification
+	if err := query.Order("created_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&notifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch notifications: %w", err)
+	}
+
+	return notifications, total, nil
+}
+
+// MarkRead marks a notification owned by userID as read
+func (s *NotificationService) MarkRead(notificationID, userID uuid.UUID) error {
+	result := database.DB.Model(&models.Notification{}).
+		Where("id = ? AND user_id = ?", notificationID, userID).
+		Update("is_read", true)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update notification: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("notification not found")
+	}
+	return nil
+}
+
+// NotificationPreferenceUpdate holds the preference flags to change; nil fields are left as they are
+type NotificationPreferenceUpdate struct {
+	EmailEnabled   *bool
+	SMSEnabled     *bool
+	PushEnabled    *bool
+	OrderUpdates   *bool
+	EventReminders *bool
+	Marketing      *bool
+}
+
+// GetPreferences returns a user's notification preferences, falling back to
+// the defaults when the user has never changed them
+func (s *NotificationService) GetPreferences(userID uuid.UUID) (*models.NotificationPreference, error) {
+	var pref models.NotificationPreference
+	err := database.DB.Where("user_id = ?", userID).First(&pref).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		pref = models.DefaultNotificationPreference(userID)
+		return &pref, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch notification preferences: %w", err)
+	}
+	return &pref, nil
+}
+
+// UpdatePreferences applies update to a user's notification preferences
+func (s *NotificationService) UpdatePreferences(userID uuid.UUID, update NotificationPreferenceUpdate) (*models.NotificationPreference, error) {
+	pref, err := s.GetPreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	apply := func(dst *bool, src *bool) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	apply(&pref.EmailEnabled, update.EmailEnabled)
+	apply(&pref.SMSEnabled, update.SMSEnabled)
+	apply(&pref.PushEnabled, update.PushEnabled)
+	apply(&pref.OrderUpdates, update.OrderUpdates)
+	apply(&pref.EventReminders, update.EventReminders)
+	apply(&pref.Marketing, update.Marketing)
+
+	if err := database.DB.Save(pref).Error; err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return pref, nil
+}
+
+// Allowed reports whether userID accepts notifications on channel about topic.
+// Preferences that cannot be loaded do not block delivery.
+func (s *NotificationService) Allowed(ctx context.Context, userID uuid.UUID, channel models.NotificationChannel, topic models.NotificationTopic) bool {
+	if topic == models.TopicAccount {
+		return true
+	}
+
+	pref, err := s.GetPreferences(userID)
+	if err != nil {
+		logger.WithContext(ctx).Warn("Failed to load notification preferences", zap.String("user_id", userID.String()), zap.Error(err))
+		return true
+	}
+
+	return pref.Allows(channel, topic)
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// occupancyTTL keeps occupancy counters through a multi-day event; an
+// expired event counter is rebuilt from its check-ins
+const occupancyTTL = 72 * time.Hour
+
+// enterScript counts one more attendee in unless that goes over the limit
+// in ARGV[1] (0 for none), returning -1 when full
+var enterScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+local limit = tonumber(ARGV[1])
+if limit > 0 and n > limit then
+	redis.call('DECR', KEYS[1])
+	return -1
+end
+return n
+`)
+
+// leaveScript counts one attendee out, never going below zero
+var leaveScript = redis.NewScript(`
+local n = tonumber(redis.call('GET', KEYS[1]) or '0')
+if n > 0 then
+	return redis.call('DECR', KEYS[1])
+end
+return 0
+`)
+
+// ZoneOccupancy is the number of attendees inside an event zone
+type ZoneOccupancy struct {
+	ZoneID    uuid.UUID `json:"zone_id"`
+	Name      string    `json:"name"`
+	Occupancy int64     `json:"occupancy"`
+	Limit     int       `json:"limit"` // 0 means no limit
+}
+
+// EventOccupancy is the number of attendees inside an event and its zones
+type EventOccupancy struct {
+	EventID     uuid.UUID       `json:"event_id"`
+	Occupancy   int64           `json:"occupancy"`
+	Limit       int             `json:"limit"` // 0 means no limit
+	Zones       []ZoneOccupancy `json:"zones"`
+	GeneratedAt time.Time       `json:"generated_at"`
+}
+
+// OccupancyService tracks how many attendees are inside an event and its
+// zones with Redis counters: entries count in, check-outs count out. Zone
+// counters only count a ticket's first pass into the zone, which is recorded
+// as a ZonePresence. When Redis is unavailable scans go through untracked
+// rather than stopping the door.
+type OccupancyService struct{}
+
+// NewOccupancyService creates a new occupancy service
+func NewOccupancyService() *OccupancyService {
+	return &OccupancyService{}
+}
+
+// EnterEvent counts an attendee into an event, failing when the event is
+// at its occupancy limit
+func (s *OccupancyService) EnterEvent(ctx context.Context, eventID uuid.UUID, limit int) error {
+	key := utils.EventOccupancyKey(eventID)
+	if err := s.seedEvent(ctx, eventID, key); err != nil {
+		logger.WithContext(ctx).Warn("Failed to seed event occupancy", zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+	if full := s.enter(ctx, key, limit); full {
+		return utils.ConflictError("event is at its occupancy limit of %d", limit)
+	}
+	return nil
+}
+
+// LeaveEvent counts an attendee out of an event
+func (s *OccupancyService) LeaveEvent(ctx context.Context, eventID uuid.UUID) {
+	s.leave(ctx, utils.EventOccupancyKey(eventID))
+}
+
+// EnterZone counts a ticket into a zone, failing when the zone is at its
+// occupancy limit. A ticket already inside the zone passes without being
+// counted again.
+func (s *OccupancyService) EnterZone(ctx context.Context, zone *models.EventZone, ticketID uuid.UUID) error {
+	key := utils.ZoneOccupancyKey(zone.ID)
+	if err := s.seedZone(ctx, zone.ID, key); err != nil {
+		logger.WithContext(ctx).Warn("Failed to seed zone occupancy", zap.String("zone_id", zone.ID.String()), zap.Error(err))
+	}
+
+	presence := models.ZonePresence{TicketID: ticketID, ZoneID: zone.ID, EventID: zone.EventID, EnteredAt: time.Now()}
+	result := database.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&presence)
+	if result.Error != nil {
+		logger.WithContext(ctx).Warn("Failed to record zone presence", zap.String("zone_id", zone.ID.String()), zap.Error(result.Error))
+		return nil
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	if full := s.enter(ctx, key, zone.MaxOccupancy); full {
+		s.forget(ctx, zone.ID, ticketID)
+		return utils.ConflictError("%s is at its occupancy limit of %d", zone.Name, zone.MaxOccupancy)
+	}
+	return nil
+}
+
+// LeaveZone counts a ticket out of a zone it is inside
+func (s *OccupancyService) LeaveZone(ctx context.Context, zoneID, ticketID uuid.UUID) {
+	if s.forget(ctx, zoneID, ticketID) {
+		s.leave(ctx, utils.ZoneOccupancyKey(zoneID))
+	}
+}
+
+// LeaveZones counts a ticket out of every zone of an event it is inside, as
+// it leaves the event
+func (s *OccupancyService) LeaveZones(ctx context.Context, eventID, ticketID uuid.UUID) {
+	var zoneIDs []uuid.UUID
+	if err := database.DB.WithContext(ctx).Model(&models.ZonePresence{}).
+		Where("event_id = ? AND ticket_id = ?", eventID, ticketID).
+		Pluck("zone_id", &zoneIDs).Error; err != nil {
+		logger.WithContext(ctx).Warn("Failed to fetch zone presence", zap.String("ticket_id", ticketID.String()), zap.Error(err))
+		return
+	}
+	for _, zoneID := range zoneIDs {
+		s.LeaveZone(ctx, zoneID, ticketID)
+	}
+}
+
+// Get returns the live occupancy of an event and its zones
+func (s *OccupancyService) Get(ctx context.Context, eventID uuid.UUID) (*EventOccupancy, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Select("id", "max_occupancy").First(&event, eventID).Error; err != nil {
+		return nil, utils.NotFoundError("event not found")
+	}
+	zones, err := NewEventZoneService().List(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := utils.EventOccupancyKey(eventID)
+	if err := s.seedEvent(ctx, eventID, key); err != nil {
+		return nil, utils.NewAppError(http.StatusServiceUnavailable, utils.CodeServiceUnavailable, "occupancy is unavailable").Wrap(err)
+	}
+
+	keys := []string{key}
+	for _, zone := range zones {
+		keys = append(keys, utils.ZoneOccupancyKey(zone.ID))
+	}
+	values, err := cache.Client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, utils.NewAppError(http.StatusServiceUnavailable, utils.CodeServiceUnavailable, "occupancy is unavailable").Wrap(err)
+	}
+
+	occupancy := EventOccupancy{
+		EventID:     eventID,
+		Occupancy:   counterValue(values[0]),
+		Limit:       event.MaxOccupancy,
+		Zones:       make([]ZoneOccupancy, len(zones)),
+		GeneratedAt: time.Now(),
+	}
+	for i, zone := range zones {
+		occupancy.Zones[i] = ZoneOccupancy{
+			ZoneID:    zone.ID,
+			Name:      zone.Name,
+			Occupancy: counterValue(values[i+1]),
+			Limit:     zone.MaxOccupancy,
+		}
+	}
+	return &occupancy, nil
+}
+
+// enter reports whether the counter at key is at limit. Redis errors are
+// logged and let the attendee in.
+func (s *OccupancyService) enter(ctx context.Context, key string, limit int) bool {
+	n, err := enterScript.Run(ctx, cache.Client, []string{key}, limit, int(occupancyTTL.Seconds())).Int64()
+	if err != nil {
+		logger.WithContext(ctx).Warn("Failed to count occupancy", zap.String("key", key), zap.Error(err))
+		return false
+	}
+	return n < 0
+}
+
+// forget deletes a ticket's presence in a zone and reports whether it was
+// inside
+func (s *OccupancyService) forget(ctx context.Context, zoneID, ticketID uuid.UUID) bool {
+	result := database.DB.WithContext(ctx).
+		Where("zone_id = ? AND ticket_id = ?", zoneID, ticketID).
+		Delete(&models.ZonePresence{})
+	if result.Error != nil {
+		logger.WithContext(ctx).Warn("Failed to clear zone presence", zap.String("zone_id", zoneID.String()), zap.Error(result.Error))
+		return false
+	}
+	return result.RowsAffected > 0
+}
+
+func (s *OccupancyService) leave(ctx context.Context, key string) {
+	if err := leaveScript.Run(ctx, cache.Client, []string{key}).Err(); err != nil {
+		logger.WithContext(ctx).Warn("Failed to count occupancy", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// seedEvent rebuilds a missing event counter from the tickets whose last
+// event scan was an entry, and the counters of the event's zones from their
+// recorded presences
+func (s *OccupancyService) seedEvent(ctx context.Context, eventID uuid.UUID, key string) error {
+	exists, err := cache.Client.Exists(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	var inside int64
+	if err := database.DB.WithContext(ctx).Raw(`SELECT COUNT(*) FROM (
+			SELECT DISTINCT ON (ticket_id) direction FROM checkins
+			WHERE event_id = ? AND session_id IS NULL
+			ORDER BY ticket_id, scanned_at DESC
+		) last WHERE direction = ?`, eventID, models.CheckinIn).
+		Scan(&inside).Error; err != nil {
+		return fmt.Errorf("failed to count attendees inside: %w", err)
+	}
+	if err := cache.Client.SetNX(ctx, key, inside, occupancyTTL).Err(); err != nil {
+		return err
+	}
+
+	// Zone counters went with the event's, so they are rebuilt outright
+	var zones []struct {
+		ZoneID uuid.UUID
+		Inside int64
+	}
+	if err := database.DB.WithContext(ctx).Model(&models.ZonePresence{}).
+		Select("zone_id, COUNT(*) AS inside").
+		Where("event_id = ?", eventID).
+		Group("zone_id").
+		Scan(&zones).Error; err != nil {
+		return fmt.Errorf("failed to count attendees inside zones: %w", err)
+	}
+	for _, zone := range zones {
+		if err := cache.Client.Set(ctx, utils.ZoneOccupancyKey(zone.ZoneID), zone.Inside, occupancyTTL).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// seedZone rebuilds a missing zone counter from the zone's recorded presences
+func (s *OccupancyService) seedZone(ctx context.Context, zoneID uuid.UUID, key string) error {
+	exists, err := cache.Client.Exists(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if exists > 0 {
+		return nil
+	}
+
+	var inside int64
+	if err := database.DB.WithContext(ctx).Model(&models.ZonePresence{}).
+		Where("zone_id = ?", zoneID).
+		Count(&inside).Error; err != nil {
+		return fmt.Errorf("failed to count attendees inside zone: %w", err)
+	}
+	return cache.Client.SetNX(ctx, key, inside, occupancyTTL).Err()
+}
+
+// counterValue reads a counter returned by MGET; missing counters are zero
+func counterValue(value interface{}) int64 {
+	s, _ := value.(string)
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
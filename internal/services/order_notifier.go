@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// OrderNotifier tells buyers about their orders on every channel they can receive
+type OrderNotifier struct {
+	emailCfg    *config.EmailConfig
+	sms         *SMSService
+	frontendURL string
+}
+
+// NewOrderNotifier creates a new order notifier. frontendURL is where
+// guest buyers are sent to claim their account.
+func NewOrderNotifier(emailCfg *config.EmailConfig, smsCfg *config.SMSConfig, frontendURL string) *OrderNotifier {
+	return &OrderNotifier{
+		emailCfg:    emailCfg,
+		sms:         NewSMSService(smsCfg),
+		frontendURL: frontendURL,
+	}
+}
+
+// OrderConfirmed sends the order confirmation by email, and by SMS when the
+// buyer has a phone number. Failures are logged; the order is already paid.
+func (n *OrderNotifier) OrderConfirmed(ctx context.Context, order *models.Order) {
+	var user models.User
+	if err := database.DB.First(&user, order.UserID).Error; err != nil {
+		logger.WithContext(ctx).Error("Failed to load buyer for order confirmation", zap.String("order_id", order.ID.String()), zap.Error(err))
+		return
+	}
+
+	// The confirmation still goes out without a receipt if rendering fails
+	receipt, err := NewReceiptService().Generate(ctx, order.ID)
+	if err != nil {
+		logger.WithContext(ctx).Error("Failed to generate order receipt", zap.String("order_id", order.ID.String()), zap.Error(err))
+	}
+
+	// Calendar links are omitted if the event cannot be loaded
+	var event *models.Event
+	var tier models.TicketTier
+	if err := database.DB.Preload("Event").First(&tier, order.TierID).Error; err != nil {
+		logger.WithContext(ctx).Error("Failed to load event for order confirmation", zap.String("order_id", order.ID.String()), zap.Error(err))
+	} else {
+		event = &tier.Event
+	}
+
+	var addOns []models.OrderItem
+	if err := database.DB.Where("order_id = ? AND type = ?", order.ID, models.OrderItemAddOn).Find(&addOns).Error; err != nil {
+		logger.WithContext(ctx).Error("Failed to load order add-ons", zap.String("order_id", order.ID.String()), zap.Error(err))
+	}
+
+	emailService := NewEmailService(n.emailCfg)
+
+	// Guests have no dashboard, so their tickets travel with the email
+	var guest *GuestDelivery
+	if user.Role == models.RoleGuest {
+		guest, err = n.guestDelivery(ctx, emailService, &user, order)
+		if err != nil {
+			logger.WithContext(ctx).Error("Failed to prepare guest ticket delivery", zap.String("order_id", order.ID.String()), zap.Error(err))
+		}
+	}
+
+	if err := emailService.SendOrderConfirmationEmail(
+		ctx,
+		user.ID,
+		user.Email,
+		user.FirstName,
+		order.ID,
+		order.TotalAmount,
+		order.Currency,
+		order.Quantity,
+		addOns,
+		event,
+		receipt,
+		guest,
+	); err != nil {
+		logger.WithContext(ctx).Error("Failed to send order confirmation email", zap.String("order_id", order.ID.String()), zap.Error(err))
+	}
+
+	if user.Phone == "" {
+		return
+	}
+
+	if err := n.sms.SendOrderConfirmationSMS(ctx, user.ID, user.Phone, order.ID, order.Quantity); err != nil {
+		logger.WithContext(ctx).Error("Failed to send order confirmation SMS", zap.String("order_id", order.ID.String()), zap.Error(err))
+	}
+}
+
+// guestDelivery collects the tickets of a guest's order and a fresh claim link
+func (n *OrderNotifier) guestDelivery(ctx context.Context, emailService *EmailService, user *models.User, order *models.Order) (*GuestDelivery, error) {
+	var tickets []models.Ticket
+	if err := database.DB.WithContext(ctx).Where("order_id = ?", order.ID).Find(&tickets).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch tickets: %w", err)
+	}
+
+	claimLink, err := emailService.NewAccountClaimLink(ctx, user.ID, n.frontendURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GuestDelivery{ClaimLink: claimLink, Tickets: tickets}, nil
+}
@@ -0,0 +1,443 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// OrderSagaService runs the saga that takes an order from reserve through
+// order and pay to fulfill. Each step records its completion in the
+// transaction that performs it, so a flow interrupted between steps leaves
+// a saga that names the step it stopped at. Stuck sagas are resumed from
+// that step, or compensated by undoing their completed steps in reverse:
+// issued tickets are revoked, the captured payment voided, the order
+// cancelled and its hold released.
+type OrderSagaService struct {
+	payments *PaymentService
+}
+
+// NewOrderSagaService creates a new order saga service
+func NewOrderSagaService(payments *PaymentService) *OrderSagaService {
+	return &OrderSagaService{payments: payments}
+}
+
+// Begin starts the saga of a newly placed order, whose tickets are held and
+// which awaits payment
+func (s *OrderSagaService) Begin(ctx context.Context, orderID uuid.UUID) error {
+	return database.Transaction(func(tx *gorm.DB) error {
+		return beginSaga(tx.WithContext(ctx), orderID)
+	})
+}
+
+// Get returns an order's saga with its log, oldest entry first
+func (s *OrderSagaService) Get(ctx context.Context, orderID uuid.UUID) (*models.OrderSaga, error) {
+	var saga models.OrderSaga
+	if err := database.DB.WithContext(ctx).
+		Preload("Log", func(db *gorm.DB) *gorm.DB { return db.Order("created_at ASC") }).
+		Where("order_id = ?", orderID).
+		First(&saga).Error; err != nil {
+		return nil, utils.NotFoundError("order saga not found")
+	}
+	return &saga, nil
+}
+
+// Resume retries the step a saga stopped at: a pending payment is verified
+// with its provider and a paid order is fulfilled. A saga that was being
+// compensated carries on undoing its steps.
+func (s *OrderSagaService) Resume(ctx context.Context, orderID, requestedBy uuid.UUID) (*models.OrderSaga, error) {
+	saga, err := s.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch saga.Status {
+	case models.SagaCompleted, models.SagaCompensated:
+		return nil, utils.ConflictError("order saga is already %s", saga.Status)
+	case models.SagaCompensating:
+		return s.Compensate(ctx, orderID, requestedBy, saga.Reason)
+	}
+
+	var order models.Order
+	if err := database.DB.WithContext(ctx).First(&order, orderID).Error; err != nil {
+		return nil, utils.NotFoundError("order not found")
+	}
+
+	switch {
+	case !saga.Done(models.SagaPay) && order.TotalAmount == 0:
+		if _, err := NewOrderService(NewTicketService(), NewOrderStore()).FulfillFreeOrder(ctx, &order); err != nil {
+			return nil, err
+		}
+	case !saga.Done(models.SagaPay):
+		var payment models.Payment
+		if err := database.DB.WithContext(ctx).
+			Where("order_id = ? AND status IN ?", orderID, []models.PaymentStatus{models.PaymentPending, models.PaymentProcessing}).
+			Order("created_at DESC").
+			First(&payment).Error; err != nil {
+			return nil, utils.ConflictError("order has no open payment to verify")
+		}
+		if _, err := s.payments.VerifyPayment(ctx, payment.TransactionID); err != nil {
+			return nil, err
+		}
+	case order.Status == models.OrderReview:
+		return nil, utils.ConflictError("order is held for review; approve or reject it instead")
+	case order.Status != models.OrderPaid:
+		return nil, utils.ConflictError("order is %s and cannot be fulfilled", order.Status)
+	default:
+		if err := s.payments.fulfil(ctx, &order); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.Get(ctx, orderID)
+}
+
+// Compensate undoes a saga's completed steps, latest first. A step that
+// cannot be undone, e.g. because the provider rejected the void, leaves the
+// saga compensating with the error recorded; Resume continues from it.
+// Orders with a checked-in ticket cannot be compensated. The void is
+// recorded as a refund requested by requestedBy, uuid.Nil for the system.
+func (s *OrderSagaService) Compensate(ctx context.Context, orderID, requestedBy uuid.UUID, reason string) (*models.OrderSaga, error) {
+	saga, err := s.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if saga.Status == models.SagaCompensated {
+		return nil, utils.ConflictError("order saga is already compensated")
+	}
+
+	if err := database.DB.WithContext(ctx).Model(saga).
+		Updates(map[string]interface{}{"status": models.SagaCompensating, "reason": reason}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update order saga: %w", err)
+	}
+
+	var order models.Order
+	if err := database.DB.WithContext(ctx).First(&order, orderID).Error; err != nil {
+		return nil, utils.NotFoundError("order not found")
+	}
+
+	steps := slices.Clone(saga.Completed)
+	slices.Reverse(steps)
+	for _, step := range steps {
+		if err := s.compensate(ctx, &order, step, requestedBy, reason); err != nil {
+			recordSagaFailure(ctx, orderID, step, models.SagaCompensate, err)
+			return nil, err
+		}
+	}
+
+	return s.Get(ctx, orderID)
+}
+
+// compensate undoes one step of an order's saga
+func (s *OrderSagaService) compensate(ctx context.Context, order *models.Order, step models.SagaStep, requestedBy uuid.UUID, reason string) error {
+	switch step {
+	case models.SagaFulfill:
+		return revokeOrderTickets(ctx, order)
+	case models.SagaPay:
+		return s.voidPayment(ctx, order, requestedBy, reason)
+	case models.SagaOrder:
+		return cancelSagaOrder(ctx, order)
+	case models.SagaReserve:
+		return releaseSagaHold(ctx, order)
+	}
+	return fmt.Errorf("unknown saga step %q", step)
+}
+
+// revokeOrderTickets cancels the tickets an order was issued
+func revokeOrderTickets(ctx context.Context, order *models.Order) error {
+	return database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		var used int64
+		if err := tx.Model(&models.Ticket{}).
+			Where("order_id = ? AND status = ?", order.ID, models.TicketUsed).
+			Count(&used).Error; err != nil {
+			return fmt.Errorf("failed to count used tickets: %w", err)
+		}
+		if used > 0 {
+			return utils.ConflictError("order has checked-in tickets and cannot be compensated")
+		}
+
+		if err := tx.Model(&models.Ticket{}).
+			Where("order_id = ? AND status = ?", order.ID, models.TicketActive).
+			Update("status", models.TicketCancelled).Error; err != nil {
+			return fmt.Errorf("failed to revoke tickets: %w", err)
+		}
+		return compensateSaga(tx, order.ID, models.SagaFulfill)
+	})
+}
+
+// voidPayment refunds an order's captured payment in full and reverses the
+// sale it was counted as
+func (s *OrderSagaService) voidPayment(ctx context.Context, order *models.Order, requestedBy uuid.UUID, reason string) error {
+	var payment models.Payment
+	err := database.DB.WithContext(ctx).
+		Where("order_id = ? AND status = ?", order.ID, models.PaymentCompleted).
+		First(&payment).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Free orders have nothing to void
+		return database.Transaction(func(tx *gorm.DB) error {
+			return compensateSaga(tx.WithContext(ctx), order.ID, models.SagaPay)
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch payment: %w", err)
+	}
+
+	// Mark the payment as refunding so concurrent refunds are rejected
+	result := database.DB.WithContext(ctx).Model(&models.Payment{}).
+		Where("id = ? AND status = ?", payment.ID, models.PaymentCompleted).
+		Update("status", models.PaymentRefunding)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update payment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ConflictError("payment is already being refunded")
+	}
+
+	refund := models.Refund{
+		OrderID:     order.ID,
+		PaymentID:   &payment.ID,
+		Amount:      payment.Amount,
+		Currency:    payment.Currency,
+		Reason:      reason,
+		Status:      models.RefundCompleted,
+		RequestedBy: requestedBy,
+	}
+
+	provider, err := s.payments.Provider(payment.Provider)
+	if err == nil {
+		var providerRefund *ProviderRefundResponse
+		providerRefund, err = provider.Refund(&payment, payment.Amount)
+		if err == nil {
+			refund.ProviderRefundID = providerRefund.RefundID
+		}
+	}
+	if err != nil {
+		database.DB.Model(&models.Payment{}).Where("id = ?", payment.ID).Update("status", models.PaymentCompleted)
+		refund.Status = models.RefundFailed
+		database.DB.Create(&refund)
+		return fmt.Errorf("failed to void payment: %w", err)
+	}
+
+	return database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		if err := tx.Create(&refund).Error; err != nil {
+			return fmt.Errorf("failed to record refund: %w", err)
+		}
+		if err := tx.Model(&models.Payment{}).Where("id = ?", payment.ID).
+			Update("status", models.PaymentRefunded).Error; err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+
+		// Held orders were never counted as sales
+		if order.Status == models.OrderPaid {
+			if err := recordRefund(tx, order, 0); err != nil {
+				return err
+			}
+		}
+		if order.Status == models.OrderPaid || order.Status == models.OrderReview {
+			order.Status = models.OrderRefunded
+			if err := tx.Model(order).Update("status", order.Status).Error; err != nil {
+				return fmt.Errorf("failed to update order: %w", err)
+			}
+		}
+		return compensateSaga(tx, order.ID, models.SagaPay)
+	})
+}
+
+// cancelSagaOrder cancels an order still awaiting payment and fails its open
+// payments
+func cancelSagaOrder(ctx context.Context, order *models.Order) error {
+	return database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		if err := tx.Model(&models.Order{}).
+			Where("id = ? AND status = ?", order.ID, models.OrderPending).
+			Update("status", models.OrderCancelled).Error; err != nil {
+			return fmt.Errorf("failed to cancel order: %w", err)
+		}
+		if err := tx.Model(&models.Payment{}).
+			Where("order_id = ? AND status IN ?", order.ID, []models.PaymentStatus{models.PaymentPending, models.PaymentProcessing}).
+			Update("status", models.PaymentFailed).Error; err != nil {
+			return fmt.Errorf("failed to update payments: %w", err)
+		}
+		return compensateSaga(tx, order.ID, models.SagaOrder)
+	})
+}
+
+// releaseSagaHold puts an order's held tickets and add-ons back on sale
+func releaseSagaHold(ctx context.Context, order *models.Order) error {
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		if order.Quantity > 0 {
+			if err := tx.Model(&models.TicketTier{}).
+				Where("id = ?", order.TierID).
+				UpdateColumn("available_quantity", gorm.Expr("available_quantity + ?", order.Quantity)).
+				Error; err != nil {
+				return fmt.Errorf("failed to release tickets: %w", err)
+			}
+		}
+		if err := releaseOrderAddOns(tx, order.ID); err != nil {
+			return err
+		}
+		return compensateSaga(tx, order.ID, models.SagaReserve)
+	})
+	if err != nil {
+		return err
+	}
+
+	if order.Quantity > 0 {
+		publishAvailability(ctx, order.TierID, AvailabilityReleased)
+	}
+	return nil
+}
+
+// beginSaga starts the saga of an order placed within tx
+func beginSaga(tx *gorm.DB, orderID uuid.UUID) error {
+	saga := models.OrderSaga{OrderID: orderID, Status: models.SagaRunning}
+	if err := tx.Create(&saga).Error; err != nil {
+		return fmt.Errorf("failed to start order saga: %w", err)
+	}
+	_, err := advanceSaga(tx, orderID, models.SagaReserve, models.SagaOrder)
+	return err
+}
+
+// lockSaga loads an order's saga for update within tx. Orders placed before
+// sagas were recorded, and comps, have none; it returns nil for those.
+func lockSaga(tx *gorm.DB, orderID uuid.UUID) (*models.OrderSaga, error) {
+	var saga models.OrderSaga
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("order_id = ?", orderID).First(&saga).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order saga: %w", err)
+	}
+	return &saga, nil
+}
+
+// advanceSaga records within tx that steps of an order's saga completed. It
+// reports whether the order has a saga.
+func advanceSaga(tx *gorm.DB, orderID uuid.UUID, steps ...models.SagaStep) (bool, error) {
+	saga, err := lockSaga(tx, orderID)
+	if saga == nil {
+		return false, err
+	}
+
+	entries := make([]models.OrderSagaEntry, len(steps))
+	for i, step := range steps {
+		if !saga.Done(step) {
+			saga.Completed = append(saga.Completed, step)
+		}
+		entries[i] = models.OrderSagaEntry{SagaID: saga.ID, Step: step, Action: models.SagaExecute}
+	}
+
+	saga.Status, saga.FailedStep, saga.Error = models.SagaRunning, "", ""
+	if saga.Done(models.SagaFulfill) {
+		saga.Status = models.SagaCompleted
+	}
+	return true, saveSaga(tx, saga, entries)
+}
+
+// compensateSaga records within tx that step of an order's saga was undone,
+// and marks the saga compensated once no completed step is left
+func compensateSaga(tx *gorm.DB, orderID uuid.UUID, step models.SagaStep) error {
+	saga, err := lockSaga(tx, orderID)
+	if saga == nil {
+		return err
+	}
+
+	saga.Completed = slices.DeleteFunc(saga.Completed, func(s models.SagaStep) bool { return s == step })
+	saga.FailedStep, saga.Error = "", ""
+	if len(saga.Completed) == 0 {
+		saga.Status = models.SagaCompensated
+	}
+	return saveSaga(tx, saga, []models.OrderSagaEntry{{SagaID: saga.ID, Step: step, Action: models.SagaCompensate}})
+}
+
+// closeSaga records within tx that an order was released by a flow that
+// undoes its saga's steps itself, such as expiry or a rejected review
+func closeSaga(tx *gorm.DB, orderID uuid.UUID, reason string) error {
+	saga, err := lockSaga(tx, orderID)
+	if saga == nil {
+		return err
+	}
+
+	entries := make([]models.OrderSagaEntry, 0, len(saga.Completed))
+	for i := len(saga.Completed) - 1; i >= 0; i-- {
+		entries = append(entries, models.OrderSagaEntry{SagaID: saga.ID, Step: saga.Completed[i], Action: models.SagaCompensate})
+	}
+
+	saga.Completed = []models.SagaStep{}
+	saga.Status, saga.FailedStep, saga.Error, saga.Reason = models.SagaCompensated, "", "", reason
+	return saveSaga(tx, saga, entries)
+}
+
+func saveSaga(tx *gorm.DB, saga *models.OrderSaga, entries []models.OrderSagaEntry) error {
+	if err := tx.Model(saga).
+		Select("status", "completed", "failed_step", "error", "reason").
+		Updates(saga).Error; err != nil {
+		return fmt.Errorf("failed to update order saga: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	if err := tx.Create(&entries).Error; err != nil {
+		return fmt.Errorf("failed to record order saga log: %w", err)
+	}
+	return nil
+}
+
+// recordSagaFailure records that a step of an order's saga failed with
+// cause. A failed step leaves the saga stuck; a failed compensation leaves
+// it compensating. The failure is only logged if it cannot be recorded.
+func recordSagaFailure(ctx context.Context, orderID uuid.UUID, step models.SagaStep, action models.SagaAction, cause error) {
+	status := models.SagaStuck
+	if action == models.SagaCompensate {
+		status = models.SagaCompensating
+	}
+
+	logger.WithContext(ctx).Warn("Order saga step failed",
+		zap.String("order_id", orderID.String()),
+		zap.String("step", string(step)),
+		zap.String("action", string(action)),
+		zap.Error(cause),
+	)
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		saga, err := lockSaga(tx, orderID)
+		if saga == nil {
+			return err
+		}
+		saga.Status, saga.FailedStep, saga.Error = status, step, cause.Error()
+		return saveSaga(tx, saga, []models.OrderSagaEntry{{
+			SagaID: saga.ID,
+			Step:   step,
+			Action: action,
+			Error:  cause.Error(),
+		}})
+	})
+	if err != nil {
+		logger.WithContext(ctx).Error("Failed to record order saga failure",
+			zap.String("order_id", orderID.String()),
+			zap.Error(err),
+		)
+	}
+}
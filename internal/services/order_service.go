@@ -0,0 +1,317 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/currency"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// CreateOrderInput is a buyer's request to turn their reservation into an order
+type CreateOrderInput struct {
+	UserID        uuid.UUID
+	ReservationID string
+	Provider      models.PaymentProvider // empty picks the first provider for the order's currency
+	AddOns        []AddOnSelection
+	Answers       []models.AnswerSet
+}
+
+// Reservations holds the tickets buyers have reserved until they order them
+type Reservations interface {
+	GetReservation(reservationID string) (*ReservationData, error)
+	ConsumeReservation(reservationID string) error
+	ReleaseTickets(tierID uuid.UUID, quantity int) error
+}
+
+// OrderStore prices and writes the orders placed from reservations
+type OrderStore interface {
+	// Quote prices an order for a reservation: its line items with the
+	// selected add-ons, tax, validated form answers and platform fee
+	Quote(ctx context.Context, cfg *config.Config, reservation *ReservationData, input CreateOrderInput) (*models.Order, error)
+	// Place writes order in one transaction. Its add-ons are taken out of
+	// stock, then claim is called, then the order is written and its saga
+	// started. Free orders are issued their tickets and marked paid in it.
+	Place(ctx context.Context, order *models.Order, claim func() error) ([]models.Ticket, error)
+}
+
+// OrderService handles order lifecycle operations
+type OrderService struct {
+	reservations Reservations
+	store        OrderStore
+}
+
+// NewOrderService creates an order service placing orders from reservations
+// into store
+func NewOrderService(reservations Reservations, store OrderStore) *OrderService {
+	return &OrderService{reservations: reservations, store: store}
+}
+
+// CreateOrder turns a reservation into an order awaiting payment with the
+// chosen provider, keeping the reserved tickets held for it. The reservation
+// is consumed while the order is placed, after its add-ons are secured, so a
+// sold-out add-on leaves it for another attempt. If placing the order then
+// fails the held tickets are released, as the reservation no longer holds
+// them.
+func (s *OrderService) CreateOrder(ctx context.Context, cfg *config.Config, input CreateOrderInput) (*models.Order, []models.Ticket, error) {
+	reservation, err := s.reservations.GetReservation(input.ReservationID)
+	if err != nil || !time.Now().Before(reservation.ExpiresAt) {
+		return nil, nil, utils.BadRequestError("Reservation not found or expired")
+	}
+	if reservation.UserID != input.UserID {
+		return nil, nil, utils.ForbiddenError("This reservation belongs to another user")
+	}
+
+	order, err := s.store.Quote(ctx, cfg, reservation, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Orders are charged in the currency of the reserved tier
+	order.Currency = reservation.Currency
+	if order.Currency == "" {
+		order.Currency = currency.Default
+	}
+
+	order.PaymentProvider = input.Provider
+	if order.PaymentProvider == "" {
+		order.PaymentProvider = models.ProviderPaystack
+		if providers := ProvidersForCurrency(order.Currency); len(providers) > 0 {
+			order.PaymentProvider = providers[0]
+		}
+	}
+	if order.TotalAmount > 0 && !SupportsCurrency(order.PaymentProvider, order.Currency) {
+		return nil, nil, utils.BadRequestError("%s does not support %s payments", order.PaymentProvider, order.Currency)
+	}
+
+	expiresAt := time.Now().Add(cfg.Limits.OrderExpiry)
+	order.UserID = input.UserID
+	order.TierID = reservation.TierID
+	order.Quantity = reservation.Quantity
+	order.UnitPrice = reservation.UnitPrice
+	order.Status = models.OrderPending
+	order.ExpiresAt = &expiresAt
+
+	// Claim the reservation without releasing the held tickets
+	consumed := false
+	tickets, err := s.store.Place(ctx, order, func() error {
+		if err := s.reservations.ConsumeReservation(input.ReservationID); err != nil {
+			return utils.BadRequestError("Reservation not found or expired")
+		}
+		consumed = true
+		return nil
+	})
+	if err != nil {
+		if consumed {
+			s.reservations.ReleaseTickets(order.TierID, order.Quantity)
+		}
+		return nil, nil, err
+	}
+
+	if order.Status == models.OrderPaid {
+		publishOrderPaid(ctx, order)
+		NewOrderNotifier(&cfg.Email, &cfg.SMS, cfg.Server.FrontendURL).OrderConfirmed(ctx, order)
+	}
+
+	return order, tickets, nil
+}
+
+type gormOrderStore struct{}
+
+// NewOrderStore creates an order store on the database
+func NewOrderStore() OrderStore {
+	return gormOrderStore{}
+}
+
+func (gormOrderStore) Quote(ctx context.Context, cfg *config.Config, reservation *ReservationData, input CreateOrderInput) (*models.Order, error) {
+	items, err := NewAddOnService().OrderItems(ctx, reservation, input.AddOns)
+	if err != nil {
+		return nil, utils.BadRequestError("%s", err)
+	}
+
+	taxRate, err := NewTaxService().RateFor(ctx, reservation.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate tax: %w", err)
+	}
+	taxAmount, totalAmount := taxRate.Apply(items)
+
+	answers, err := NewFormService().ValidateAnswers(ctx, reservation.EventID, reservation.Quantity, input.Answers)
+	if err != nil {
+		return nil, utils.BadRequestError("%s", err)
+	}
+
+	platformFee, err := NewPayoutService(&cfg.Payment).PlatformFee(ctx, reservation.EventID, totalAmount-taxAmount, reservation.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate fees: %w", err)
+	}
+
+	order := &models.Order{
+		TotalAmount: totalAmount,
+		PlatformFee: platformFee,
+		FormAnswers: answers,
+		Items:       items,
+		TaxAmount:   taxAmount,
+	}
+	if taxRate != nil {
+		order.TaxName = taxRate.Name
+		order.TaxBasisPoints = taxRate.BasisPoints
+		order.TaxInclusive = taxRate.Inclusive
+	}
+	return order, nil
+}
+
+func (gormOrderStore) Place(ctx context.Context, order *models.Order, claim func() error) ([]models.Ticket, error) {
+	var tickets []models.Ticket
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		// Add-ons are held with the order like its reserved tickets
+		if err := reserveAddOnItems(tx, order.Items); err != nil {
+			return err
+		}
+		if err := claim(); err != nil {
+			return err
+		}
+
+		if err := tx.Create(order).Error; err != nil {
+			return fmt.Errorf("failed to create order: %w", err)
+		}
+		if err := beginSaga(tx, order.ID); err != nil {
+			return err
+		}
+
+		// Free orders need no payment and are fulfilled immediately
+		if order.TotalAmount == 0 {
+			var err error
+			tickets, err = fulfillFreeOrder(tx, order)
+			return err
+		}
+		return nil
+	})
+	return tickets, err
+}
+
+// FindExpiredOrders returns pending orders whose payment window has elapsed
+func (s *OrderService) FindExpiredOrders(limit int) ([]models.Order, error) {
+	var orders []models.Order
+	if err := database.DB.Preload("User").
+		Where("status = ? AND expires_at IS NOT NULL AND expires_at < ?", models.OrderPending, time.Now()).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch expired orders: %w", err)
+	}
+	return orders, nil
+}
+
+// CancelOrder cancels a pending order, fails its open payments and releases
+// the held tickets. It returns false when the order was no longer pending,
+// e.g. because a payment landed concurrently.
+func (s *OrderService) CancelOrder(orderID uuid.UUID) (bool, error) {
+	cancelled := false
+	var released models.Order
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		var order models.Order
+		if err := tx.First(&order, orderID).Error; err != nil {
+			return fmt.Errorf("order not found: %w", err)
+		}
+
+		// Conditional update so a concurrent payment wins over cancellation
+		result := tx.Model(&models.Order{}).
+			Where("id = ? AND status = ?", orderID, models.OrderPending).
+			Update("status", models.OrderCancelled)
+		if result.Error != nil {
+			return fmt.Errorf("failed to cancel order: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&models.Payment{}).
+			Where("order_id = ? AND status IN ?", orderID, []models.PaymentStatus{models.PaymentPending, models.PaymentProcessing}).
+			Update("status", models.PaymentFailed).Error; err != nil {
+			return fmt.Errorf("failed to update payments: %w", err)
+		}
+
+		if order.Quantity > 0 {
+			if err := tx.Model(&models.TicketTier{}).
+				Where("id = ?", order.TierID).
+				UpdateColumn("available_quantity", gorm.Expr("available_quantity + ?", order.Quantity)).
+				Error; err != nil {
+				return fmt.Errorf("failed to release tickets: %w", err)
+			}
+		}
+		if err := releaseOrderAddOns(tx, orderID); err != nil {
+			return err
+		}
+		if err := closeSaga(tx, orderID, "order cancelled"); err != nil {
+			return err
+		}
+
+		cancelled = true
+		released = order
+		return nil
+	})
+
+	if cancelled && released.Quantity > 0 {
+		publishAvailability(context.Background(), released.TierID, AvailabilityReleased)
+	}
+
+	return cancelled, err
+}
+
+// FulfillFreeOrder issues tickets for an order that needs no payment and marks
+// it paid, completing the pay and fulfill steps of its saga together
+func (s *OrderService) FulfillFreeOrder(ctx context.Context, order *models.Order) ([]models.Ticket, error) {
+	var tickets []models.Ticket
+	err := database.Transaction(func(tx *gorm.DB) error {
+		var err error
+		tickets, err = fulfillFreeOrder(tx.WithContext(ctx), order)
+		return err
+	})
+	if err != nil {
+		recordSagaFailure(ctx, order.ID, models.SagaPay, models.SagaExecute, err)
+		return nil, err
+	}
+
+	publishOrderPaid(ctx, order)
+
+	return tickets, nil
+}
+
+// fulfillFreeOrder issues a free order's tickets and marks it paid within tx
+func fulfillFreeOrder(tx *gorm.DB, order *models.Order) ([]models.Ticket, error) {
+	tickets, err := createTicketsForOrder(tx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Status = models.OrderPaid
+	if err := tx.Model(order).Update("status", order.Status).Error; err != nil {
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+	if _, err := advanceSaga(tx, order.ID, models.SagaPay, models.SagaFulfill); err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// publishOrderPaid announces a fulfilled order to live availability, trending
+// and organizer webhooks. Its OrderPaid domain event was recorded in the
+// outbox with its tickets.
+func publishOrderPaid(ctx context.Context, order *models.Order) {
+	var tier models.TicketTier
+	database.DB.Select("id", "event_id").First(&tier, order.TierID)
+
+	publishAvailability(ctx, order.TierID, AvailabilitySold)
+	RecordTrendingSale(ctx, tier.EventID, order.Quantity)
+	emitTicketSold(ctx, order, tier.EventID)
+}
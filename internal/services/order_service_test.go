@@ -0,0 +1,270 @@
+//go:build integration
+
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/internal/testutil"
+	"eventix-api/pkg/utils"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// checkout is a buyer holding a reservation of two of the ten tickets of
+// an event's only tier
+type checkout struct {
+	event       *models.Event
+	tier        *models.TicketTier
+	buyer       *models.User
+	reservation *services.ReservationData
+}
+
+const (
+	checkoutCapacity = 10
+	checkoutQuantity = 2
+)
+
+func newCheckout(t *testing.T) *checkout {
+	t.Helper()
+	reset(t)
+
+	event := testutil.CreateEvent(t, testutil.CreateOrganizer(t), 5000, checkoutCapacity)
+	buyer := testutil.CreateUser(t, models.RoleAttendee)
+	reservation, err := services.NewTicketService().CreateReservation(buyer.ID, event.TicketTiers[0].ID, checkoutQuantity)
+	if err != nil {
+		t.Fatalf("create reservation: %v", err)
+	}
+	return &checkout{event: event, tier: &event.TicketTiers[0], buyer: buyer, reservation: reservation}
+}
+
+func (c *checkout) createOrder(userID uuid.UUID, addOns ...services.AddOnSelection) (*models.Order, error) {
+	order, _, err := services.NewOrderService(services.NewTicketService(), services.NewOrderStore()).CreateOrder(context.Background(), env.Config, services.CreateOrderInput{
+		UserID:        userID,
+		ReservationID: c.reservation.ReservationID,
+		Provider:      models.ProviderPaystack,
+		AddOns:        addOns,
+	})
+	return order, err
+}
+
+// expectHeld fails the test unless the reservation still holds its tickets,
+// so the buyer can try again
+func (c *checkout) expectHeld(t *testing.T) {
+	t.Helper()
+
+	ctx := context.Background()
+	if err := env.Redis.ZScore(ctx, utils.ReservationExpiryIndexKey, c.reservation.ReservationID).Err(); err != nil {
+		t.Errorf("reservation is no longer tracked for expiry: %v", err)
+	}
+	if err := env.Redis.HGet(ctx, utils.ReservationHoldsKey, c.reservation.ReservationID).Err(); err != nil {
+		t.Errorf("reservation hold is gone: %v", err)
+	}
+	c.expectAvailable(t, checkoutCapacity-checkoutQuantity)
+	c.expectOrders(t, 0)
+}
+
+func (c *checkout) expectAvailable(t *testing.T, available int) {
+	t.Helper()
+
+	var tier models.TicketTier
+	if err := env.DB.First(&tier, c.tier.ID).Error; err != nil {
+		t.Fatalf("load tier: %v", err)
+	}
+	if tier.AvailableQuantity != available {
+		t.Errorf("available quantity = %d, want %d", tier.AvailableQuantity, available)
+	}
+}
+
+func (c *checkout) expectOrders(t *testing.T, count int64) {
+	t.Helper()
+
+	var orders int64
+	if err := env.DB.Model(&models.Order{}).Where("tier_id = ?", c.tier.ID).Count(&orders).Error; err != nil {
+		t.Fatalf("count orders: %v", err)
+	}
+	if orders != count {
+		t.Errorf("%d orders, want %d", orders, count)
+	}
+}
+
+// addOn adds an add-on of the checkout's event with quantity in stock, of
+// which sold are gone
+func (c *checkout) addOn(t *testing.T, quantity, sold int) *models.AddOn {
+	t.Helper()
+
+	addOn := &models.AddOn{
+		EventID:  c.event.ID,
+		Name:     "Parking",
+		Price:    1500,
+		Currency: c.event.Currency,
+		Quantity: quantity,
+		Sold:     sold,
+	}
+	if err := env.DB.Create(addOn).Error; err != nil {
+		t.Fatalf("create add-on: %v", err)
+	}
+	return addOn
+}
+
+// failInserts makes every insert into table fail until the test ends
+func failInserts(t *testing.T, table string) {
+	t.Helper()
+
+	statements := []string{
+		`CREATE OR REPLACE FUNCTION test_fail_insert() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'insert into % failed by the test', TG_TABLE_NAME;
+		END
+		$$ LANGUAGE plpgsql`,
+		fmt.Sprintf(`CREATE TRIGGER test_fail_insert BEFORE INSERT ON %q FOR EACH ROW EXECUTE FUNCTION test_fail_insert()`, table),
+	}
+	for _, statement := range statements {
+		if err := env.DB.Exec(statement).Error; err != nil {
+			t.Fatalf("fail inserts into %s: %v", table, err)
+		}
+	}
+	t.Cleanup(func() {
+		if err := env.DB.Exec(fmt.Sprintf(`DROP TRIGGER IF EXISTS test_fail_insert ON %q`, table)).Error; err != nil {
+			t.Errorf("restore inserts into %s: %v", table, err)
+		}
+	})
+}
+
+func TestCreateOrder(t *testing.T) {
+	c := newCheckout(t)
+
+	order, err := c.createOrder(c.buyer.ID)
+	if err != nil {
+		t.Fatalf("create order: %v", err)
+	}
+	if order.Status != models.OrderPending {
+		t.Errorf("status = %s, want %s", order.Status, models.OrderPending)
+	}
+	if want := int64(checkoutQuantity * 5000); order.TotalAmount != want {
+		t.Errorf("total = %d, want %d", order.TotalAmount, want)
+	}
+
+	// The order keeps the held tickets and the reservation is used up
+	c.expectAvailable(t, checkoutCapacity-checkoutQuantity)
+	c.expectOrders(t, 1)
+	if err := env.Redis.ZScore(context.Background(), utils.ReservationExpiryIndexKey, c.reservation.ReservationID).Err(); !errors.Is(err, redis.Nil) {
+		t.Errorf("consumed reservation is still tracked for expiry: %v", err)
+	}
+	var saga models.OrderSaga
+	if err := env.DB.Where("order_id = ?", order.ID).First(&saga).Error; err != nil {
+		t.Errorf("order saga was not started: %v", err)
+	}
+
+	// A reservation only makes one order
+	_, err = c.createOrder(c.buyer.ID)
+	expectStatus(t, err, 400)
+	c.expectOrders(t, 1)
+}
+
+func TestCreateOrderExpiredReservation(t *testing.T) {
+	c := newCheckout(t)
+
+	// Age the reservation past its expiry without waiting for its key to go
+	ctx := context.Background()
+	key := utils.GetReservationKey(c.reservation.ReservationID)
+	expired := *c.reservation
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	data, _ := json.Marshal(expired)
+	if err := env.Redis.Set(ctx, key, data, redis.KeepTTL).Err(); err != nil {
+		t.Fatalf("expire reservation: %v", err)
+	}
+
+	_, err := c.createOrder(c.buyer.ID)
+	expectStatus(t, err, 400)
+
+	// The expiry worker releases the hold, not the failed checkout
+	c.expectHeld(t)
+}
+
+func TestCreateOrderMissingReservation(t *testing.T) {
+	c := newCheckout(t)
+	c.reservation.ReservationID = utils.GenerateReservationID()
+
+	_, err := c.createOrder(c.buyer.ID)
+	expectStatus(t, err, 400)
+	c.expectOrders(t, 0)
+}
+
+func TestCreateOrderOtherUsersReservation(t *testing.T) {
+	c := newCheckout(t)
+	other := testutil.CreateUser(t, models.RoleAttendee)
+
+	_, err := c.createOrder(other.ID)
+	expectStatus(t, err, 403)
+	c.expectHeld(t)
+
+	// The buyer can still check out with it
+	if _, err := c.createOrder(c.buyer.ID); err != nil {
+		t.Fatalf("create order as the buyer: %v", err)
+	}
+	c.expectOrders(t, 1)
+}
+
+func TestCreateOrderSoldOutAddOn(t *testing.T) {
+	c := newCheckout(t)
+	addOn := c.addOn(t, 3, 3)
+
+	_, err := c.createOrder(c.buyer.ID, services.AddOnSelection{AddOnID: addOn.ID, Quantity: 1})
+	expectStatus(t, err, 400)
+	c.expectHeld(t)
+
+	var stored models.AddOn
+	if err := env.DB.First(&stored, addOn.ID).Error; err != nil {
+		t.Fatalf("load add-on: %v", err)
+	}
+	if stored.Sold != 3 {
+		t.Errorf("add-on sold = %d, want 3", stored.Sold)
+	}
+
+	// The reservation was left for another attempt without the add-on
+	if _, err := c.createOrder(c.buyer.ID); err != nil {
+		t.Fatalf("create order without the add-on: %v", err)
+	}
+	c.expectOrders(t, 1)
+}
+
+func TestCreateOrderFailureAfterConsumingReservation(t *testing.T) {
+	c := newCheckout(t)
+	addOn := c.addOn(t, 5, 0)
+
+	// Starting the saga is the last write of the transaction, after the
+	// reservation has been consumed and the add-ons taken out of stock
+	failInserts(t, "order_sagas")
+
+	_, err := c.createOrder(c.buyer.ID, services.AddOnSelection{AddOnID: addOn.ID, Quantity: 2})
+	if err == nil {
+		t.Fatal("create order succeeded with its saga failing")
+	}
+
+	// The consumed reservation no longer holds the tickets, so they are
+	// released, once: any other count leaves the tier over or under its
+	// capacity
+	c.expectAvailable(t, checkoutCapacity)
+	c.expectOrders(t, 0)
+	if err := env.Redis.ZScore(context.Background(), utils.ReservationExpiryIndexKey, c.reservation.ReservationID).Err(); !errors.Is(err, redis.Nil) {
+		t.Errorf("consumed reservation is still tracked for expiry: %v", err)
+	}
+
+	// The add-ons went back with the rolled back transaction
+	var stored models.AddOn
+	if err := env.DB.First(&stored, addOn.ID).Error; err != nil {
+		t.Fatalf("load add-on: %v", err)
+	}
+	if stored.Sold != 0 {
+		t.Errorf("add-on sold = %d, want 0", stored.Sold)
+	}
+}
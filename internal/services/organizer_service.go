@@ -0,0 +1,175 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+// OrganizerApplication holds the details submitted with an organizer application
+type OrganizerApplication struct {
+	OrganizationName string
+	Description      string
+	Website          string
+	DocumentKey      string
+}
+
+// OrganizerService handles organizer verification
+type OrganizerService struct{}
+
+// NewOrganizerService creates a new organizer service
+func NewOrganizerService() *OrganizerService {
+	return &OrganizerService{}
+}
+
+// Apply creates or resubmits the organizer application for a user
+func (s *OrganizerService) Apply(userID uuid.UUID, app OrganizerApplication) (*models.Organizer, error) {
+	now := time.Now()
+
+	var organizer models.Organizer
+	err := database.DB.Where("user_id = ?", userID).First(&organizer).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("failed to fetch organizer profile: %w", err)
+	}
+
+	if err == nil {
+		switch {
+		case organizer.VerificationStatus == models.VerificationApproved:
+			return nil, fmt.Errorf("organizer is already verified")
+		case organizer.VerificationStatus == models.VerificationPending && organizer.AppliedAt != nil:
+			return nil, fmt.Errorf("an application is already under review")
+		}
+	}
+
+	organizer.UserID = userID
+	organizer.OrganizationName = app.OrganizationName
+	organizer.Description = app.Description
+	organizer.Website = app.Website
+	organizer.VerificationDocKey = app.DocumentKey
+	organizer.VerificationStatus = models.VerificationPending
+	organizer.RejectionReason = ""
+	organizer.AppliedAt = &now
+
+	if err := database.DB.Save(&organizer).Error; err != nil {
+		return nil, fmt.Errorf("failed to save application: %w", err)
+	}
+
+	return &organizer, nil
+}
+
+// GetByUserID returns the organizer profile of a user
+func (s *OrganizerService) GetByUserID(userID uuid.UUID) (*models.Organizer, error) {
+	var organizer models.Organizer
+	if err := database.DB.Where("user_id = ?", userID).First(&organizer).Error; err != nil {
+		return nil, fmt.Errorf("organizer profile not found")
+	}
+	return &organizer, nil
+}
+
+// GetByID retrieves an organizer profile by its ID
+func (s *OrganizerService) GetByID(organizerID uuid.UUID) (*models.Organizer, error) {
+	var organizer models.Organizer
+	if err := database.DB.First(&organizer, organizerID).Error; err != nil {
+		return nil, fmt.Errorf("organizer profile not found")
+	}
+	return &organizer, nil
+}
+
+// ListByStatus returns organizers with the given verification status, oldest application first
+func (s *OrganizerService) ListByStatus(status models.VerificationStatus, page, limit int) ([]models.Organizer, int64, error) {
+	query := database.DB.Model(&models.Organizer{})
+	if status != "" {
+		query = query.Where("verification_status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count organizers: %w", err)
+	}
+
+	var organizers []models.Organizer
+	if err := query.Preload("User").
+		Order("applied_at ASC NULLS LAST, created_at ASC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&organizers).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch organizers: %w", err)
+	}
+
+	return organizers, total, nil
+}
+
+// Approve verifies an organizer and grants the organizer role to its user
+func (s *OrganizerService) Approve(organizerID uuid.UUID) (*models.Organizer, error) {
+	var organizer models.Organizer
+	err := database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Preload("User").First(&organizer, organizerID).Error; err != nil {
+			return fmt.Errorf("organizer not found")
+		}
+
+		if organizer.VerificationStatus == models.VerificationApproved {
+			return fmt.Errorf("organizer is already verified")
+		}
+
+		now := time.Now()
+		if err := tx.Model(&organizer).Updates(map[string]interface{}{
+			"verification_status": models.VerificationApproved,
+			"verified_at":         now,
+			"rejection_reason":    "",
+		}).Error; err != nil {
+			return fmt.Errorf("failed to approve organizer: %w", err)
+		}
+		organizer.VerificationStatus = models.VerificationApproved
+		organizer.VerifiedAt = &now
+		organizer.RejectionReason = ""
+
+		// Admins keep their role; everyone else becomes an organizer
+		if organizer.User.Role == models.RoleAttendee {
+			if err := tx.Model(&models.User{}).Where("id = ?", organizer.UserID).
+				Update("role", models.RoleOrganizer).Error; err != nil {
+				return fmt.Errorf("failed to update user role: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizer, nil
+}
+
+// Reject declines an organizer application with a reason
+func (s *OrganizerService) Reject(organizerID uuid.UUID, reason string) (*models.Organizer, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("a rejection reason is required")
+	}
+
+	var organizer models.Organizer
+	if err := database.DB.Preload("User").First(&organizer, organizerID).Error; err != nil {
+		return nil, fmt.Errorf("organizer not found")
+	}
+
+	if organizer.VerificationStatus != models.VerificationPending || organizer.AppliedAt == nil {
+		return nil, fmt.Errorf("organizer is not awaiting verification")
+	}
+
+	if err := database.DB.Model(&organizer).Updates(map[string]interface{}{
+		"verification_status": models.VerificationRejected,
+		"rejection_reason":    reason,
+		"verified_at":         nil,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to reject organizer: %w", err)
+	}
+	organizer.VerificationStatus = models.VerificationRejected
+	organizer.RejectionReason = reason
+	organizer.VerifiedAt = nil
+
+	return &organizer, nil
+}
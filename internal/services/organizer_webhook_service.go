@@ -0,0 +1,500 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// Headers sent with every organizer webhook delivery. The signature is the
+// hex HMAC-SHA256 of "<timestamp>.<body>", keyed with the endpoint's secret,
+// so receivers can reject replays of old deliveries.
+const (
+	webhookSignatureHeader = "X-Eventix-Signature"
+	webhookTimestampHeader = "X-Eventix-Timestamp"
+	webhookEventHeader     = "X-Eventix-Event"
+	webhookDeliveryHeader  = "X-Eventix-Delivery"
+)
+
+const (
+	// maxWebhookEndpoints bounds the endpoints of one organizer
+	maxWebhookEndpoints = 10
+
+	// webhookRetryBase is the wait before a failed delivery's first retry;
+	// each further retry waits twice as long as the one before, up to
+	// webhookRetryMax
+	webhookRetryBase = 30 * time.Second
+	webhookRetryMax  = 6 * time.Hour
+
+	// webhookDeliveryLease keeps a claimed delivery from being claimed again
+	// by another replica while it is being sent
+	webhookDeliveryLease = 5 * time.Minute
+
+	// webhookResponseLimit bounds how much of an endpoint's response is kept
+	webhookResponseLimit = 1024
+)
+
+// WebhookPayload is the body POSTed to organizer endpoints. ID identifies the
+// event, so receivers can drop a redelivered event they have already handled.
+type WebhookPayload struct {
+	ID        uuid.UUID               `json:"id"`
+	Type      models.WebhookEventType `json:"type"`
+	CreatedAt time.Time               `json:"created_at"`
+	Data      interface{}             `json:"data"`
+}
+
+// WebhookTicketSoldData is the data of a ticket.sold event
+type WebhookTicketSoldData struct {
+	OrderID     uuid.UUID   `json:"order_id"`
+	EventID     uuid.UUID   `json:"event_id"`
+	TierID      uuid.UUID   `json:"tier_id"`
+	TicketIDs   []uuid.UUID `json:"ticket_ids"`
+	Quantity    int         `json:"quantity"`
+	TotalAmount int64       `json:"total_amount"` // minor units
+	Currency    string      `json:"currency"`
+}
+
+// WebhookAttendeeCheckedInData is the data of an attendee.checked_in event
+type WebhookAttendeeCheckedInData struct {
+	TicketID    uuid.UUID            `json:"ticket_id"`
+	CheckinID   uuid.UUID            `json:"checkin_id"`
+	EventID     uuid.UUID            `json:"event_id"`
+	TierID      uuid.UUID            `json:"tier_id"`
+	Method      models.CheckinMethod `json:"method"`
+	CheckedInAt time.Time            `json:"checked_in_at"`
+}
+
+// WebhookOrderRefundedData is the data of an order.refunded event
+type WebhookOrderRefundedData struct {
+	OrderID  uuid.UUID `json:"order_id"`
+	RefundID uuid.UUID `json:"refund_id"`
+	EventID  uuid.UUID `json:"event_id"`
+	TierID   uuid.UUID `json:"tier_id"`
+	Amount   int64     `json:"amount"` // minor units
+	Fee      int64     `json:"fee"`    // minor units
+	Currency string    `json:"currency"`
+}
+
+// WebhookEndpointInput configures an organizer webhook endpoint
+type WebhookEndpointInput struct {
+	URL          string
+	Description  string
+	Events       []models.WebhookEventType // empty subscribes to every event
+	Active       bool
+	RotateSecret bool
+}
+
+// OrganizerWebhookService manages organizers' webhook endpoints and delivers
+// signed event notifications to them, retrying failures with exponential backoff
+type OrganizerWebhookService struct {
+	httpClient *http.Client
+}
+
+// NewOrganizerWebhookService creates a new organizer webhook service
+func NewOrganizerWebhookService() *OrganizerWebhookService {
+	return &OrganizerWebhookService{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// List returns an organizer's endpoints, oldest first
+func (s *OrganizerWebhookService) List(ctx context.Context, organizerID uuid.UUID) ([]models.WebhookEndpoint, error) {
+	var endpoints []models.WebhookEndpoint
+	if err := database.DB.WithContext(ctx).
+		Where("organizer_id = ?", organizerID).
+		Order("created_at ASC").
+		Find(&endpoints).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+// Get returns one of an organizer's endpoints
+func (s *OrganizerWebhookService) Get(ctx context.Context, organizerID, endpointID uuid.UUID) (*models.WebhookEndpoint, error) {
+	var endpoint models.WebhookEndpoint
+	if err := database.DB.WithContext(ctx).
+		Where("id = ? AND organizer_id = ?", endpointID, organizerID).
+		First(&endpoint).Error; err != nil {
+		return nil, utils.NotFoundError("webhook endpoint not found")
+	}
+	return &endpoint, nil
+}
+
+// Create registers an endpoint and returns its signing secret, which is
+// never returned again
+func (s *OrganizerWebhookService) Create(ctx context.Context, organizerID uuid.UUID, input WebhookEndpointInput, createdBy uuid.UUID) (*models.WebhookEndpoint, string, error) {
+	if err := validateWebhookEvents(input.Events); err != nil {
+		return nil, "", err
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&models.WebhookEndpoint{}).
+		Where("organizer_id = ?", organizerID).
+		Count(&count).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to count webhook endpoints: %w", err)
+	}
+	if count >= maxWebhookEndpoints {
+		return nil, "", utils.BadRequestError("an organizer can have at most %d webhook endpoints", maxWebhookEndpoints)
+	}
+
+	secret, err := utils.GenerateRandomString(48)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	endpoint := models.WebhookEndpoint{
+		OrganizerID: organizerID,
+		URL:         input.URL,
+		Description: input.Description,
+		Secret:      secret,
+		Events:      input.Events,
+		Active:      true,
+		CreatedBy:   createdBy,
+	}
+	if err := database.DB.WithContext(ctx).Create(&endpoint).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return &endpoint, secret, nil
+}
+
+// Update replaces an endpoint's settings. The signing secret is returned when
+// it was rotated, and never again.
+func (s *OrganizerWebhookService) Update(ctx context.Context, organizerID, endpointID uuid.UUID, input WebhookEndpointInput) (*models.WebhookEndpoint, string, error) {
+	if err := validateWebhookEvents(input.Events); err != nil {
+		return nil, "", err
+	}
+
+	endpoint, err := s.Get(ctx, organizerID, endpointID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	endpoint.URL = input.URL
+	endpoint.Description = input.Description
+	endpoint.Events = input.Events
+	endpoint.Active = input.Active
+
+	var secret string
+	if input.RotateSecret {
+		if secret, err = utils.GenerateRandomString(48); err != nil {
+			return nil, "", fmt.Errorf("failed to generate webhook secret: %w", err)
+		}
+		endpoint.Secret = secret
+	}
+
+	if err := database.DB.WithContext(ctx).Save(endpoint).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+	return endpoint, secret, nil
+}
+
+// Delete removes an endpoint with its delivery log; pending deliveries are dropped
+func (s *OrganizerWebhookService) Delete(ctx context.Context, organizerID, endpointID uuid.UUID) error {
+	return database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+		result := tx.Where("id = ? AND organizer_id = ?", endpointID, organizerID).Delete(&models.WebhookEndpoint{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete webhook endpoint: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return utils.NotFoundError("webhook endpoint not found")
+		}
+		if err := tx.Where("endpoint_id = ?", endpointID).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+		return nil
+	})
+}
+
+// Deliveries returns an endpoint's delivery log newest first, optionally
+// filtered by status
+func (s *OrganizerWebhookService) Deliveries(ctx context.Context, organizerID, endpointID uuid.UUID, status models.WebhookDeliveryStatus, offset, limit int) ([]models.WebhookDelivery, int64, error) {
+	if _, err := s.Get(ctx, organizerID, endpointID); err != nil {
+		return nil, 0, err
+	}
+
+	query := database.Reader(database.DB).WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Where("endpoint_id = ?", endpointID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	var deliveries []models.WebhookDelivery
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch webhook deliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// Redeliver queues a new delivery of a logged delivery's payload, sent on
+// the next delivery sweep. The payload keeps its event ID.
+func (s *OrganizerWebhookService) Redeliver(ctx context.Context, organizerID, deliveryID uuid.UUID) (*models.WebhookDelivery, error) {
+	var original models.WebhookDelivery
+	if err := database.DB.WithContext(ctx).
+		Joins("JOIN webhook_endpoints e ON e.id = webhook_deliveries.endpoint_id").
+		Where("webhook_deliveries.id = ? AND e.organizer_id = ?", deliveryID, organizerID).
+		First(&original).Error; err != nil {
+		return nil, utils.NotFoundError("webhook delivery not found")
+	}
+
+	now := time.Now()
+	delivery := models.WebhookDelivery{
+		EndpointID:    original.EndpointID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        models.WebhookDeliveryPending,
+		NextAttemptAt: &now,
+	}
+	if err := database.DB.WithContext(ctx).Create(&delivery).Error; err != nil {
+		return nil, fmt.Errorf("failed to queue webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+// Emit queues an event for every active endpoint of the event's organizer
+// that subscribes to it. Failures are logged rather than returned: the sale,
+// check-in or refund behind the event has already happened.
+func (s *OrganizerWebhookService) Emit(ctx context.Context, eventID uuid.UUID, eventType models.WebhookEventType, data interface{}) {
+	log := logger.WithContext(ctx).With(zap.String("event_id", eventID.String()), zap.String("webhook_event", string(eventType)))
+
+	var endpoints []models.WebhookEndpoint
+	if err := database.DB.WithContext(ctx).
+		Where("active = ? AND organizer_id = (SELECT organizer_id FROM events WHERE id = ?)", true, eventID).
+		Find(&endpoints).Error; err != nil {
+		log.Error("Failed to fetch webhook endpoints", zap.Error(err))
+		return
+	}
+
+	var deliveries []models.WebhookDelivery
+	for i := range endpoints {
+		if endpoints[i].Subscribes(eventType) {
+			deliveries = append(deliveries, models.WebhookDelivery{EndpointID: endpoints[i].ID})
+		}
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(WebhookPayload{
+		ID:        uuid.New(),
+		Type:      eventType,
+		CreatedAt: now.UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		log.Error("Failed to encode webhook payload", zap.Error(err))
+		return
+	}
+
+	for i := range deliveries {
+		deliveries[i].EventType = eventType
+		deliveries[i].Payload = string(payload)
+		deliveries[i].Status = models.WebhookDeliveryPending
+		deliveries[i].NextAttemptAt = &now
+	}
+	if err := database.DB.WithContext(ctx).Create(&deliveries).Error; err != nil {
+		log.Error("Failed to queue webhook deliveries", zap.Error(err))
+	}
+}
+
+// DeliverDue sends up to limit deliveries whose next attempt is due and
+// returns how many succeeded. Deliveries are claimed with SKIP LOCKED, so
+// replicas sweeping at the same time send each one once.
+func (s *OrganizerWebhookService) DeliverDue(ctx context.Context, maxAttempts, limit int) (int, error) {
+	now := time.Now()
+
+	var deliveries []models.WebhookDelivery
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&deliveries).Error; err != nil {
+			return fmt.Errorf("failed to claim webhook deliveries: %w", err)
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(deliveries))
+		for i := range deliveries {
+			ids[i] = deliveries[i].ID
+		}
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(webhookDeliveryLease)).Error
+	})
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+
+	endpointIDs := make([]uuid.UUID, len(deliveries))
+	for i := range deliveries {
+		endpointIDs[i] = deliveries[i].EndpointID
+	}
+	var endpoints []models.WebhookEndpoint
+	if err := database.DB.WithContext(ctx).Where("id IN ?", endpointIDs).Find(&endpoints).Error; err != nil {
+		return 0, fmt.Errorf("failed to fetch webhook endpoints: %w", err)
+	}
+	byID := make(map[uuid.UUID]*models.WebhookEndpoint, len(endpoints))
+	for i := range endpoints {
+		byID[endpoints[i].ID] = &endpoints[i]
+	}
+
+	succeeded := 0
+	for i := range deliveries {
+		if s.attempt(ctx, &deliveries[i], byID[deliveries[i].EndpointID], maxAttempts) {
+			succeeded++
+		}
+	}
+	return succeeded, nil
+}
+
+// attempt sends a delivery once and records the outcome, scheduling a retry
+// or marking it failed when the endpoint did not accept it
+func (s *OrganizerWebhookService) attempt(ctx context.Context, delivery *models.WebhookDelivery, endpoint *models.WebhookEndpoint, maxAttempts int) bool {
+	delivery.Attempts++
+	delivery.ResponseStatus, delivery.ResponseBody, delivery.Error = 0, "", ""
+
+	var err error
+	switch {
+	case endpoint == nil:
+		err = fmt.Errorf("endpoint was deleted")
+		delivery.Attempts = maxAttempts
+	case !endpoint.Active:
+		err = fmt.Errorf("endpoint is disabled")
+		delivery.Attempts = maxAttempts
+	default:
+		err = s.send(ctx, delivery, endpoint)
+	}
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		delivery.Status, delivery.DeliveredAt, delivery.NextAttemptAt = models.WebhookDeliverySucceeded, &now, nil
+	case delivery.Attempts >= maxAttempts:
+		delivery.Status, delivery.Error, delivery.NextAttemptAt = models.WebhookDeliveryFailed, err.Error(), nil
+	default:
+		next := now.Add(webhookRetryDelay(delivery.Attempts))
+		delivery.Error, delivery.NextAttemptAt = err.Error(), &next
+	}
+
+	if err := database.DB.WithContext(ctx).Model(delivery).
+		Select("status", "attempts", "next_attempt_at", "response_status", "response_body", "error", "delivered_at").
+		Updates(delivery).Error; err != nil {
+		logger.WithContext(ctx).Error("Failed to record webhook delivery",
+			zap.String("delivery_id", delivery.ID.String()),
+			zap.Error(err),
+		)
+	}
+	return delivery.Status == models.WebhookDeliverySucceeded
+}
+
+// send POSTs a delivery's payload to its endpoint. Any 2xx answer accepts it.
+func (s *OrganizerWebhookService) send(ctx context.Context, delivery *models.WebhookDelivery, endpoint *models.WebhookEndpoint) error {
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Eventix-Webhooks/1.0")
+	req.Header.Set(webhookEventHeader, string(delivery.EventType))
+	req.Header.Set(webhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, signWebhook(endpoint.Secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	response, _ := io.ReadAll(io.LimitReader(resp.Body, webhookResponseLimit))
+	delivery.ResponseStatus, delivery.ResponseBody = resp.StatusCode, string(response)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint answered %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// webhookRetryDelay returns how long to wait after a delivery's attempts-th failure
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBase
+	for i := 1; i < attempts && delay < webhookRetryMax; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookRetryMax)
+}
+
+// signWebhook returns the hex HMAC-SHA256 of "<timestamp>.<body>"
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func validateWebhookEvents(eventTypes []models.WebhookEventType) error {
+	for _, eventType := range eventTypes {
+		known := false
+		for _, supported := range models.WebhookEventTypes {
+			if eventType == supported {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return utils.BadRequestError("unsupported webhook event: %s", eventType)
+		}
+	}
+	return nil
+}
+
+// emitTicketSold notifies the organizer of a paid order and its tickets
+func emitTicketSold(ctx context.Context, order *models.Order, eventID uuid.UUID) {
+	ticketIDs := []uuid.UUID{}
+	database.DB.WithContext(ctx).Model(&models.Ticket{}).
+		Where("order_id = ?", order.ID).
+		Order("created_at ASC").
+		Pluck("id", &ticketIDs)
+
+	NewOrganizerWebhookService().Emit(ctx, eventID, models.WebhookTicketSold, WebhookTicketSoldData{
+		OrderID:     order.ID,
+		EventID:     eventID,
+		TierID:      order.TierID,
+		TicketIDs:   ticketIDs,
+		Quantity:    order.Quantity,
+		TotalAmount: order.TotalAmount,
+		Currency:    order.Currency,
+	})
+}
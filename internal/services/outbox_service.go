@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/events"
+	"eventix-api/pkg/logger"
+)
+
+const (
+	// outboxRetryBase is the wait before a failed message's first retry;
+	// each further retry waits twice as long as the one before, up to
+	// outboxRetryMax. Messages are retried until they are published.
+	outboxRetryBase = time.Second
+	outboxRetryMax  = 5 * time.Minute
+
+	// outboxLease keeps a claimed message from being claimed again by
+	// another replica while it is being published
+	outboxLease = time.Minute
+)
+
+// OutboxService relays domain events recorded in the outbox to the message
+// bus. Events are recorded with addOutboxEvent in the transaction of the
+// change they describe, so an event is published if and only if its change
+// commits. Delivery is at least once: a message whose publication succeeds
+// but cannot be marked published is sent again.
+type OutboxService struct{}
+
+// NewOutboxService creates a new outbox service
+func NewOutboxService() *OutboxService {
+	return &OutboxService{}
+}
+
+// addOutboxEvent records a domain event within tx, to be published once tx commits
+func addOutboxEvent(tx *gorm.DB, eventType events.Type, key string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+
+	now := time.Now()
+	message := models.OutboxMessage{
+		EventType:     string(eventType),
+		Key:           key,
+		Payload:       string(payload),
+		RequestID:     logger.RequestIDFromContext(tx.Statement.Context),
+		NextAttemptAt: &now,
+	}
+	if err := tx.Create(&message).Error; err != nil {
+		return fmt.Errorf("failed to record %s event: %w", eventType, err)
+	}
+	return nil
+}
+
+// RelayDue publishes up to limit messages whose next attempt is due, oldest
+// first, and returns how many were published. Messages are claimed with
+// SKIP LOCKED, so replicas relaying at the same time publish each one once.
+func (s *OutboxService) RelayDue(ctx context.Context, limit int) (int, error) {
+	now := time.Now()
+
+	var messages []models.OutboxMessage
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL AND next_attempt_at <= ?", now).
+			Order("created_at ASC").
+			Limit(limit).
+			Find(&messages).Error; err != nil {
+			return fmt.Errorf("failed to claim outbox messages: %w", err)
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(messages))
+		for i := range messages {
+			ids[i] = messages[i].ID
+		}
+		return tx.Model(&models.OutboxMessage{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(outboxLease)).Error
+	})
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+
+	published := 0
+	for i := range messages {
+		if s.attempt(ctx, &messages[i]) {
+			published++
+		}
+	}
+	return published, nil
+}
+
+// attempt publishes a message once and records the outcome, scheduling a
+// retry when the bus did not accept it
+func (s *OutboxService) attempt(ctx context.Context, message *models.OutboxMessage) bool {
+	envelope := events.Envelope{
+		ID:         message.ID.String(),
+		Type:       events.Type(message.EventType),
+		OccurredAt: message.CreatedAt.UTC(),
+		RequestID:  message.RequestID,
+		Data:       json.RawMessage(message.Payload),
+	}
+
+	message.Attempts++
+	err := events.Publish(ctx, message.Key, envelope)
+
+	now := time.Now()
+	if err == nil {
+		message.PublishedAt, message.NextAttemptAt, message.Error = &now, nil, ""
+	} else {
+		next := now.Add(outboxRetryDelay(message.Attempts))
+		message.NextAttemptAt, message.Error = &next, err.Error()
+		logger.WithContext(ctx).Warn("Failed to publish outbox message",
+			zap.String("message_id", message.ID.String()),
+			zap.String("type", message.EventType),
+			zap.Int("attempts", message.Attempts),
+			zap.Error(err),
+		)
+	}
+
+	if err := database.DB.WithContext(ctx).Model(message).
+		Select("attempts", "next_attempt_at", "error", "published_at").
+		Updates(message).Error; err != nil {
+		logger.WithContext(ctx).Error("Failed to record outbox message",
+			zap.String("message_id", message.ID.String()),
+			zap.Error(err),
+		)
+	}
+	return message.PublishedAt != nil
+}
+
+// Prune deletes messages published before cutoff and returns how many it removed
+func (s *OutboxService) Prune(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := database.DB.WithContext(ctx).
+		Where("published_at < ?", cutoff).
+		Delete(&models.OutboxMessage{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to prune outbox: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// outboxRetryDelay returns how long to wait after a message's attempts-th failure
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := outboxRetryBase
+	for i := 1; i < attempts && delay < outboxRetryMax; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxRetryMax)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/currency"
+)
+
+// ProviderPaymentStatus is the provider-agnostic outcome of a payment
+type ProviderPaymentStatus string
+
+const (
+	ProviderStatusPending ProviderPaymentStatus = "pending"
+	ProviderStatusSuccess ProviderPaymentStatus = "success"
+	ProviderStatusFailed  ProviderPaymentStatus = "failed"
+)
+
+// ProviderInitRequest holds the data needed to start a payment with a provider
+type ProviderInitRequest struct {
+	Reference   string
+	Email       string
+	Amount      int64 // minor units
+	Currency    string
+	CallbackURL string
+	Description string
+	Metadata    map[string]string
+}
+
+// ProviderInitResponse is returned once a provider has created a checkout
+type ProviderInitResponse struct {
+	CheckoutURL       string
+	ProviderReference string
+}
+
+// ProviderVerifyResponse describes the provider-side state of a payment
+type ProviderVerifyResponse struct {
+	Status   ProviderPaymentStatus
+	Amount   int64 // minor units
+	Currency string
+	Card     PaymentCard
+}
+
+// PaymentCard identifies the card a payment was made with, as far as the
+// provider reports it. Empty fields are unknown.
+type PaymentCard struct {
+	Fingerprint string // stable across payments with the same card
+	Country     string // ISO 3166-1 alpha-2 country of the issuing bank
+}
+
+// ProviderRefundResponse describes a refund issued by the provider
+type ProviderRefundResponse struct {
+	RefundID string
+	Status   string
+}
+
+// ProviderTransaction is a successful payment as recorded by the provider
+type ProviderTransaction struct {
+	Reference string
+	Amount    int64 // minor units
+	Currency  string
+}
+
+// PaymentProvider is implemented by every supported payment gateway
+type PaymentProvider interface {
+	Name() models.PaymentProvider
+	InitializePayment(req ProviderInitRequest) (*ProviderInitResponse, error)
+	VerifyPayment(payment *models.Payment) (*ProviderVerifyResponse, error)
+	Refund(payment *models.Payment, amount int64) (*ProviderRefundResponse, error)
+	// ListTransactions returns the successful payments made in [from, to)
+	ListTransactions(from, to time.Time) ([]ProviderTransaction, error)
+}
+
+// NewPaymentProvider returns the configured provider implementation for name
+func NewPaymentProvider(name models.PaymentProvider, cfg *config.PaymentConfig) (PaymentProvider, error) {
+	client := &http.Client{Timeout: 15 * time.Second}
+
+	switch name {
+	case models.ProviderPaystack:
+		if cfg.PaystackSecretKey == "" {
+			return nil, fmt.Errorf("paystack is not configured")
+		}
+		return NewPaystackProvider(cfg.PaystackSecretKey, client), nil
+	case models.ProviderStripe:
+		if cfg.StripeSecretKey == "" {
+			return nil, fmt.Errorf("stripe is not configured")
+		}
+		return NewStripeProvider(cfg.StripeSecretKey, client), nil
+	default:
+		return nil, fmt.Errorf("unsupported payment provider: %s", name)
+	}
+}
+
+// providerCurrencies lists the currencies each provider can charge in
+var providerCurrencies = map[models.PaymentProvider][]string{
+	models.ProviderPaystack: {"NGN", "GHS", "KES", "ZAR", "USD"},
+	models.ProviderStripe:   {"USD", "EUR", "GBP", "CAD", "NGN", "KES", "ZAR", "JPY"},
+}
+
+// paymentProviders lists the providers in order of preference
+var paymentProviders = []models.PaymentProvider{models.ProviderPaystack, models.ProviderStripe}
+
+// SupportsCurrency reports whether provider can charge in the currency code
+func SupportsCurrency(provider models.PaymentProvider, code string) bool {
+	for _, supported := range providerCurrencies[provider] {
+		if supported == currency.Normalize(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// ProvidersForCurrency returns the providers able to charge in code, in order of preference
+func ProvidersForCurrency(code string) []models.PaymentProvider {
+	providers := []models.PaymentProvider{}
+	for _, provider := range paymentProviders {
+		if SupportsCurrency(provider, code) {
+			providers = append(providers, provider)
+		}
+	}
+	return providers
+}
+
+// IsSupportedProvider reports whether name is a known payment provider
+func IsSupportedProvider(name models.PaymentProvider) bool {
+	return name == models.ProviderPaystack || name == models.ProviderStripe
+}
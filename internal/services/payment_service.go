@@ -0,0 +1,766 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// PaymentInitResult represents an initialized payment awaiting customer action
+type PaymentInitResult struct {
+	PaymentID        uuid.UUID              `json:"payment_id"`
+	Provider         models.PaymentProvider `json:"provider"`
+	Reference        string                 `json:"reference"`
+	AuthorizationURL string                 `json:"authorization_url"`
+}
+
+// PaymentClient describes the checkout request a payment was started from,
+// for risk scoring. Empty fields are unknown.
+type PaymentClient struct {
+	BillingCountry string // ISO 3166-1 alpha-2, as given by the buyer
+	IP             string
+	IPCountry      string // ISO 3166-1 alpha-2, as reported by the CDN
+}
+
+// PaymentService handles payment operations
+type PaymentService struct {
+	cfg      *config.PaymentConfig
+	notifier *OrderNotifier
+	risk     *RiskService
+}
+
+// NewPaymentService creates a new payment service
+func NewPaymentService(cfg *config.Config) *PaymentService {
+	return &PaymentService{
+		cfg:      &cfg.Payment,
+		notifier: NewOrderNotifier(&cfg.Email, &cfg.SMS, cfg.Server.FrontendURL),
+		risk:     NewRiskService(&cfg.Payment),
+	}
+}
+
+// Provider returns the payment provider implementation for name
+func (s *PaymentService) Provider(name models.PaymentProvider) (PaymentProvider, error) {
+	return NewPaymentProvider(name, s.cfg)
+}
+
+// InitializePayment creates a pending payment for an order with the order's
+// provider. The client details are kept on the order to score it once paid.
+// Orders with a payment still in flight are refused; a second checkout could
+// charge the buyer twice.
+func (s *PaymentService) InitializePayment(orderID, userID uuid.UUID, email, callbackURL string, client PaymentClient) (*PaymentInitResult, error) {
+	var order models.Order
+	var provider PaymentProvider
+	payment := models.Payment{
+		TransactionID: utils.GeneratePaymentReference(),
+		Status:        models.PaymentPending,
+	}
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		// Lock the order so concurrent checkouts of it start one payment
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderID).Error; err != nil {
+			return fmt.Errorf("order not found")
+		}
+
+		if order.UserID != userID {
+			return fmt.Errorf("order belongs to another user")
+		}
+
+		if order.Status != models.OrderPending {
+			return fmt.Errorf("order is %s", order.Status)
+		}
+
+		if order.ExpiresAt != nil && time.Now().After(*order.ExpiresAt) {
+			return fmt.Errorf("order has expired")
+		}
+
+		var inFlight int64
+		if err := tx.Model(&models.Payment{}).
+			Where("order_id = ? AND status IN ?", order.ID, []models.PaymentStatus{models.PaymentPending, models.PaymentProcessing}).
+			Count(&inFlight).Error; err != nil {
+			return fmt.Errorf("failed to fetch payments: %w", err)
+		}
+		if inFlight > 0 {
+			return fmt.Errorf("order already has a payment in progress")
+		}
+
+		providerName := order.PaymentProvider
+		if providerName == "" {
+			providerName = models.ProviderPaystack
+		}
+
+		if !SupportsCurrency(providerName, order.Currency) {
+			return fmt.Errorf("%s does not support %s payments", providerName, order.Currency)
+		}
+
+		var err error
+		if provider, err = s.Provider(providerName); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&order).Updates(map[string]interface{}{
+			"billing_country": strings.ToUpper(client.BillingCountry),
+			"client_ip":       client.IP,
+			"ip_country":      strings.ToUpper(client.IPCountry),
+		}).Error; err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+
+		// The payment is recorded before the provider is asked for a session,
+		// so the lock need not be held across the provider call
+		payment.OrderID = order.ID
+		payment.Provider = providerName
+		payment.Amount = order.TotalAmount
+		payment.Currency = order.Currency
+		if err := tx.Create(&payment).Error; err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := provider.InitializePayment(ProviderInitRequest{
+		Reference:   payment.TransactionID,
+		Email:       email,
+		Amount:      order.TotalAmount,
+		Currency:    order.Currency,
+		CallbackURL: callbackURL,
+		Description: fmt.Sprintf("Eventix order %s", order.ID.String()[:8]),
+		Metadata: map[string]string{
+			"order_id": order.ID.String(),
+		},
+	})
+	if err != nil {
+		// Nobody can pay a payment without a session; fail it so the buyer
+		// can check out again
+		database.DB.Model(&payment).Update("status", models.PaymentFailed)
+		return nil, err
+	}
+
+	if err := database.DB.Model(&payment).Update("payment_intent_id", session.ProviderReference).Error; err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+
+	return &PaymentInitResult{
+		PaymentID:        payment.ID,
+		Provider:         payment.Provider,
+		Reference:        payment.TransactionID,
+		AuthorizationURL: session.CheckoutURL,
+	}, nil
+}
+
+// RetryPayment starts a new payment for an order whose last payment failed,
+// as long as the order's reservation still holds
+func (s *PaymentService) RetryPayment(orderID, userID uuid.UUID, email, callbackURL string, client PaymentClient) (*PaymentInitResult, error) {
+	var order models.Order
+	if err := database.DB.First(&order, orderID).Error; err != nil {
+		return nil, fmt.Errorf("order not found")
+	}
+
+	if order.UserID != userID {
+		return nil, fmt.Errorf("order belongs to another user")
+	}
+
+	if order.Status != models.OrderPending {
+		return nil, fmt.Errorf("order is %s and can no longer be paid", order.Status)
+	}
+
+	var last models.Payment
+	if err := database.DB.Where("order_id = ?", orderID).Order("created_at DESC").First(&last).Error; err != nil {
+		return nil, fmt.Errorf("order has no payment to retry")
+	}
+
+	// A payment still in flight may yet succeed; charging again could take
+	// the buyer's money twice
+	if last.Status != models.PaymentFailed {
+		return nil, fmt.Errorf("last payment is %s and cannot be retried", last.Status)
+	}
+
+	return s.InitializePayment(orderID, userID, email, callbackURL, client)
+}
+
+// FindStalePayments returns payments that have been pending or processing for
+// longer than after, oldest first. Payments older than a day are left alone;
+// their orders have long expired.
+func (s *PaymentService) FindStalePayments(after time.Duration, limit int) ([]models.Payment, error) {
+	now := time.Now()
+	var payments []models.Payment
+	if err := database.DB.
+		Where("status IN ? AND created_at < ? AND created_at > ?",
+			[]models.PaymentStatus{models.PaymentPending, models.PaymentProcessing}, now.Add(-after), now.Add(-24*time.Hour)).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&payments).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch stale payments: %w", err)
+	}
+	return payments, nil
+}
+
+// GetPaymentByReference retrieves a payment with its order by provider reference
+func (s *PaymentService) GetPaymentByReference(reference string) (*models.Payment, error) {
+	var payment models.Payment
+	if err := database.DB.Preload("Order").Where("transaction_id = ?", reference).First(&payment).Error; err != nil {
+		return nil, fmt.Errorf("payment not found")
+	}
+	return &payment, nil
+}
+
+// VerifyPayment verifies a transaction with its provider and completes the order when it succeeded
+func (s *PaymentService) VerifyPayment(ctx context.Context, reference string) (*models.Payment, error) {
+	var payment models.Payment
+	if err := database.DB.Where("transaction_id = ?", reference).First(&payment).Error; err != nil {
+		return nil, fmt.Errorf("payment not found")
+	}
+
+	// Already settled, nothing to do
+	if payment.Status == models.PaymentCompleted || payment.Status == models.PaymentFailed {
+		return &payment, nil
+	}
+
+	provider, err := s.Provider(payment.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := provider.VerifyPayment(&payment)
+	if err != nil {
+		return nil, err
+	}
+
+	switch result.Status {
+	case ProviderStatusSuccess:
+		if result.Amount != payment.Amount {
+			return nil, fmt.Errorf("payment amount mismatch")
+		}
+		if result.Currency != "" && !strings.EqualFold(result.Currency, payment.Currency) {
+			return nil, fmt.Errorf("payment currency mismatch")
+		}
+		if err := s.CompletePayment(ctx, &payment, result.Card); err != nil {
+			return nil, err
+		}
+	case ProviderStatusFailed:
+		if err := s.FailPayment(&payment); err != nil {
+			return nil, err
+		}
+	}
+
+	return &payment, nil
+}
+
+// CompletePayment marks a payment as completed, the order as paid and issues
+// the tickets. Orders the risk rules score at or above the review threshold
+// are held for an admin instead; see ApproveReview and RefundOrder.
+func (s *PaymentService) CompletePayment(ctx context.Context, payment *models.Payment, card PaymentCard) error {
+	var order models.Order
+	settled, held, late, stray := false, false, false, false
+	err := database.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		// Conditional update so concurrent verifications only fulfil once
+		result := tx.Model(&models.Payment{}).
+			Where("id = ? AND status <> ?", payment.ID, models.PaymentCompleted).
+			Updates(map[string]interface{}{"status": models.PaymentCompleted, "paid_at": now})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update payment: %w", result.Error)
+		}
+		payment.Status = models.PaymentCompleted
+		payment.PaidAt = &now
+		if result.RowsAffected == 0 {
+			settled = true
+			return nil
+		}
+
+		if err := tx.First(&order, payment.OrderID).Error; err != nil {
+			return fmt.Errorf("order not found: %w", err)
+		}
+
+		// Expired orders have already released their tickets. Their saga
+		// voids a payment captured late; any other capture the order can no
+		// longer take, such as a second checkout of a paid order, is refunded
+		// on its own.
+		if order.Status != models.OrderPending {
+			if order.Status == models.OrderCancelled || order.Status == models.OrderFailed {
+				ok, err := advanceSaga(tx, order.ID, models.SagaPay)
+				if err != nil || ok {
+					late = true
+					return err
+				}
+			}
+			stray = true
+			return nil
+		}
+
+		assessment := s.risk.Assess(ctx, &RiskSignal{Order: &order, Card: card})
+		order.RiskScore, order.RiskReasons = assessment.Score, assessment.Reasons
+		order.Status = models.OrderPaid
+		if s.risk.NeedsReview(assessment) {
+			// Held orders keep their tickets reserved but are neither
+			// fulfilled nor counted as sales until an admin approves them
+			order.Status = models.OrderReview
+			held = true
+		}
+		if err := tx.Save(&order).Error; err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		if _, err := advanceSaga(tx, order.ID, models.SagaPay); err != nil {
+			return err
+		}
+
+		if held {
+			return nil
+		}
+		return recordSale(tx, &order)
+	})
+	if err != nil {
+		if !late {
+			recordSagaFailure(ctx, payment.OrderID, models.SagaPay, models.SagaExecute, err)
+		}
+		return err
+	}
+
+	if settled {
+		return nil
+	}
+	if late {
+		logger.WithContext(ctx).Warn("Payment captured after its order closed; voiding it",
+			zap.String("order_id", order.ID.String()),
+			zap.String("payment_id", payment.ID.String()),
+		)
+		_, err := NewOrderSagaService(s).Compensate(ctx, order.ID, uuid.Nil, fmt.Sprintf("paid after the order was %s", order.Status))
+		return err
+	}
+	if stray {
+		logger.WithContext(ctx).Warn("Payment captured for an order that can no longer take it; refunding it",
+			zap.String("order_id", order.ID.String()),
+			zap.String("payment_id", payment.ID.String()),
+			zap.String("order_status", string(order.Status)),
+		)
+		return s.refundStray(ctx, payment, fmt.Sprintf("captured after the order was %s", order.Status))
+	}
+	if held {
+		logger.WithContext(ctx).Warn("Order held for review",
+			zap.String("order_id", order.ID.String()),
+			zap.Int("risk_score", order.RiskScore),
+			zap.Strings("reasons", order.RiskReasons),
+		)
+		return nil
+	}
+
+	return s.fulfil(ctx, &order)
+}
+
+// ReviewQueue returns the orders held for review, oldest first
+func (s *PaymentService) ReviewQueue(ctx context.Context, offset, limit int) ([]models.Order, int64, error) {
+	query := database.DB.WithContext(ctx).Model(&models.Order{}).Where("status = ?", models.OrderReview)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	var orders []models.Order
+	if err := query.Preload("User").
+		Order("updated_at ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch orders: %w", err)
+	}
+	return orders, total, nil
+}
+
+// ApproveReview confirms an order held for review: it is recorded as a sale,
+// its tickets are issued and the buyer is sent the confirmation
+func (s *PaymentService) ApproveReview(ctx context.Context, orderID uuid.UUID) (*models.Order, error) {
+	var order models.Order
+	err := database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		// Conditional update so an order is only approved once
+		result := tx.Model(&models.Order{}).
+			Where("id = ? AND status = ?", orderID, models.OrderReview).
+			Update("status", models.OrderPaid)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update order: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("order is not held for review")
+		}
+
+		if err := tx.First(&order, orderID).Error; err != nil {
+			return fmt.Errorf("order not found: %w", err)
+		}
+		return recordSale(tx, &order)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.fulfil(ctx, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// RejectReview refunds an order held for review and puts its tickets back on
+// sale
+func (s *PaymentService) RejectReview(orderID, adminID uuid.UUID, reason string) (*models.Refund, error) {
+	var order models.Order
+	if err := database.DB.Select("id", "status").First(&order, orderID).Error; err != nil {
+		return nil, fmt.Errorf("order not found")
+	}
+	if order.Status != models.OrderReview {
+		return nil, fmt.Errorf("order is not held for review")
+	}
+	return s.RefundOrder(orderID, adminID, reason)
+}
+
+// fulfil issues a paid order's tickets and tells the buyer
+func (s *PaymentService) fulfil(ctx context.Context, order *models.Order) error {
+	ticketService := NewTicketService()
+	if _, err := ticketService.CreateTicketsFromOrder(order); err != nil {
+		recordSagaFailure(ctx, order.ID, models.SagaFulfill, models.SagaExecute, err)
+		return err
+	}
+
+	publishOrderPaid(ctx, order)
+	s.notifier.OrderConfirmed(ctx, order)
+
+	return nil
+}
+
+// refundStray refunds in full a completed payment its order never took. The
+// payment was not counted as a sale, so only the payment changes.
+func (s *PaymentService) refundStray(ctx context.Context, payment *models.Payment, reason string) error {
+	// Mark the payment as refunding so concurrent refunds are rejected
+	result := database.DB.WithContext(ctx).Model(&models.Payment{}).
+		Where("id = ? AND status = ?", payment.ID, models.PaymentCompleted).
+		Update("status", models.PaymentRefunding)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update payment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+
+	refund := models.Refund{
+		OrderID:   payment.OrderID,
+		PaymentID: &payment.ID,
+		Amount:    payment.Amount,
+		Currency:  payment.Currency,
+		Reason:    reason,
+		Status:    models.RefundCompleted,
+	}
+
+	provider, err := s.Provider(payment.Provider)
+	if err == nil {
+		var providerRefund *ProviderRefundResponse
+		providerRefund, err = provider.Refund(payment, payment.Amount)
+		if err == nil {
+			refund.ProviderRefundID = providerRefund.RefundID
+		}
+	}
+	if err != nil {
+		database.DB.Model(&models.Payment{}).Where("id = ?", payment.ID).Update("status", models.PaymentCompleted)
+		refund.Status = models.RefundFailed
+		database.DB.Create(&refund)
+		return fmt.Errorf("refund failed: %w", err)
+	}
+
+	err = database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+
+		if err := tx.Create(&refund).Error; err != nil {
+			return fmt.Errorf("failed to record refund: %w", err)
+		}
+		if err := tx.Model(&models.Payment{}).Where("id = ?", payment.ID).
+			Update("status", models.PaymentRefunded).Error; err != nil {
+			return fmt.Errorf("failed to update payment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	payment.Status = models.PaymentRefunded
+	return nil
+}
+
+// FailPayment marks a pending or processing payment as failed; settled
+// payments are left alone. Once the order's reservation has expired the order
+// fails too and its tickets are released; until then it stays pending so the
+// payment can be retried.
+func (s *PaymentService) FailPayment(payment *models.Payment) error {
+	var released *models.Order
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		// Conditional update so a payment completed concurrently stays
+		// completed
+		result := tx.Model(&models.Payment{}).
+			Where("id = ? AND status IN ?", payment.ID, []models.PaymentStatus{models.PaymentPending, models.PaymentProcessing}).
+			Update("status", models.PaymentFailed)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update payment: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		payment.Status = models.PaymentFailed
+
+		var order models.Order
+		if err := tx.First(&order, payment.OrderID).Error; err != nil {
+			return fmt.Errorf("order not found: %w", err)
+		}
+
+		if order.Status != models.OrderPending {
+			return nil
+		}
+
+		// While the reservation holds the buyer may retry the payment; the
+		// order expiry worker releases the tickets if they never pay
+		if order.ExpiresAt != nil && time.Now().Before(*order.ExpiresAt) {
+			return nil
+		}
+
+		order.Status = models.OrderFailed
+		if err := tx.Save(&order).Error; err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+
+		// Release tickets back to available quantity
+		if err := tx.Model(&models.TicketTier{}).
+			Where("id = ?", order.TierID).
+			UpdateColumn("available_quantity", gorm.Expr("available_quantity + ?", order.Quantity)).
+			Error; err != nil {
+			return fmt.Errorf("failed to release tickets: %w", err)
+		}
+		if err := releaseOrderAddOns(tx, order.ID); err != nil {
+			return err
+		}
+		if err := closeSaga(tx, order.ID, "payment failed after the order expired"); err != nil {
+			return err
+		}
+
+		released = &order
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if released != nil {
+		publishAvailability(context.Background(), released.TierID, AvailabilityReleased)
+	}
+	return nil
+}
+
+// ValidateRefundPolicy checks whether an attendee may request a refund for an
+// order under the event's refund policy and returns the fee, in basis points,
+// kept from the refund. While the refund window of a later reschedule is open
+// the order is refundable in full whatever the policy. Orders with a used
+// ticket cannot be refunded.
+func (s *PaymentService) ValidateRefundPolicy(order *models.Order) (int, error) {
+	if order.Status != models.OrderPaid {
+		return 0, fmt.Errorf("only paid orders can be refunded")
+	}
+
+	var tier models.TicketTier
+	if err := database.DB.Preload("Event").First(&tier, order.TierID).Error; err != nil {
+		return 0, fmt.Errorf("ticket tier not found")
+	}
+
+	feeBasisPoints := 0
+	if !openRescheduleWindow(tier.EventID, order) {
+		deadline, ok := tier.Event.RefundDeadline()
+		switch {
+		case !ok:
+			return 0, fmt.Errorf("this event does not offer refunds")
+		case !time.Now().Before(deadline) && deadline.Equal(tier.Event.StartTime):
+			return 0, fmt.Errorf("refunds are not available after the event has started")
+		case !time.Now().Before(deadline):
+			return 0, fmt.Errorf("refunds closed %d days before the event", tier.Event.RefundDaysBefore)
+		}
+		feeBasisPoints = tier.Event.RefundFeeBasisPoints
+	}
+
+	var used int64
+	database.DB.Model(&models.Ticket{}).
+		Where("order_id = ? AND status = ?", order.ID, models.TicketUsed).
+		Count(&used)
+	if used > 0 {
+		return 0, fmt.Errorf("orders with checked-in tickets cannot be refunded")
+	}
+
+	return feeBasisPoints, nil
+}
+
+// RefundOrder refunds the completed payment of an order through its provider,
+// marks the payment, order and unused tickets as refunded and returns those
+// tickets to the tier inventory.
+func (s *PaymentService) RefundOrder(orderID, requestedBy uuid.UUID, reason string) (*models.Refund, error) {
+	return s.RefundOrderWithFee(orderID, requestedBy, reason, 0)
+}
+
+// RefundOrderWithFee refunds an order like RefundOrder, keeping feeBasisPoints
+// of the payment as the refund policy's fee. The organizer keeps the fee.
+func (s *PaymentService) RefundOrderWithFee(orderID, requestedBy uuid.UUID, reason string, feeBasisPoints int) (*models.Refund, error) {
+	var order models.Order
+	if err := database.DB.Preload("Payments").First(&order, orderID).Error; err != nil {
+		return nil, fmt.Errorf("order not found")
+	}
+
+	// Orders held for review are refunded when an admin rejects them
+	if order.Status != models.OrderPaid && order.Status != models.OrderReview {
+		return nil, fmt.Errorf("only paid orders can be refunded")
+	}
+
+	refund := models.Refund{
+		OrderID:     order.ID,
+		Amount:      order.TotalAmount,
+		Currency:    order.Currency,
+		Reason:      reason,
+		Status:      models.RefundPending,
+		RequestedBy: requestedBy,
+	}
+
+	var payment *models.Payment
+	for i := range order.Payments {
+		if order.Payments[i].Status == models.PaymentCompleted {
+			payment = &order.Payments[i]
+			break
+		}
+	}
+
+	// Paid orders with an amount must have a settled payment to refund
+	if payment == nil && order.TotalAmount > 0 {
+		return nil, fmt.Errorf("no completed payment found for order")
+	}
+
+	if payment != nil {
+		refund.PaymentID = &payment.ID
+		// Round the fee half up to the nearest minor unit
+		refund.Fee = (payment.Amount*int64(feeBasisPoints) + 5000) / 10000
+		refund.Amount = payment.Amount - refund.Fee
+
+		// Mark the payment as refunding so concurrent requests are rejected
+		result := database.DB.Model(&models.Payment{}).
+			Where("id = ? AND status = ?", payment.ID, models.PaymentCompleted).
+			Update("status", models.PaymentRefunding)
+		if result.Error != nil {
+			return nil, fmt.Errorf("failed to update payment: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil, fmt.Errorf("payment is already being refunded")
+		}
+
+		// A fee of the whole payment leaves nothing to send back
+		provider, err := s.Provider(payment.Provider)
+		if err == nil && refund.Amount > 0 {
+			var providerRefund *ProviderRefundResponse
+			providerRefund, err = provider.Refund(payment, refund.Amount)
+			if err == nil {
+				refund.ProviderRefundID = providerRefund.RefundID
+			}
+		}
+
+		if err != nil {
+			database.DB.Model(&models.Payment{}).Where("id = ?", payment.ID).Update("status", models.PaymentCompleted)
+			refund.Status = models.RefundFailed
+			database.DB.Create(&refund)
+			return nil, fmt.Errorf("refund failed: %w", err)
+		}
+	}
+
+	restocked := false
+	err := database.Transaction(func(tx *gorm.DB) error {
+		refund.Status = models.RefundCompleted
+		if err := tx.Create(&refund).Error; err != nil {
+			return fmt.Errorf("failed to record refund: %w", err)
+		}
+
+		if payment != nil {
+			if err := tx.Model(&models.Payment{}).Where("id = ?", payment.ID).
+				Update("status", models.PaymentRefunded).Error; err != nil {
+				return fmt.Errorf("failed to update payment: %w", err)
+			}
+		}
+
+		if err := tx.Model(&models.Order{}).Where("id = ?", order.ID).
+			Update("status", models.OrderRefunded).Error; err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+
+		// A held order was never counted as a sale or issued tickets, so its
+		// whole reservation goes back on sale
+		released := int64(order.Quantity)
+		if order.Status == models.OrderPaid {
+			if err := recordRefund(tx, &order, refund.Fee); err != nil {
+				return err
+			}
+
+			// Unused tickets are invalidated and go back on sale
+			result := tx.Model(&models.Ticket{}).
+				Where("order_id = ? AND status = ?", order.ID, models.TicketActive).
+				Update("status", models.TicketRefunded)
+			if result.Error != nil {
+				return fmt.Errorf("failed to update tickets: %w", result.Error)
+			}
+			released = result.RowsAffected
+		}
+
+		if released > 0 {
+			if err := tx.Model(&models.TicketTier{}).
+				Where("id = ?", order.TierID).
+				UpdateColumn("available_quantity", gorm.Expr("available_quantity + ?", released)).
+				Error; err != nil {
+				return fmt.Errorf("failed to restore tickets: %w", err)
+			}
+			restocked = true
+		}
+
+		// Refunding a held order undoes its saga before it was fulfilled
+		if order.Status == models.OrderReview {
+			if err := closeSaga(tx, order.ID, reason); err != nil {
+				return err
+			}
+		}
+
+		// Refunded add-ons go back on sale with the tickets
+		return releaseOrderAddOns(tx, order.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if restocked {
+		publishAvailability(context.Background(), order.TierID, AvailabilityReleased)
+	}
+
+	var tier models.TicketTier
+	if err := database.DB.Select("id", "event_id").First(&tier, order.TierID).Error; err == nil {
+		NewOrganizerWebhookService().Emit(context.Background(), tier.EventID, models.WebhookOrderRefunded, WebhookOrderRefundedData{
+			OrderID:  order.ID,
+			RefundID: refund.ID,
+			EventID:  tier.EventID,
+			TierID:   order.TierID,
+			Amount:   refund.Amount,
+			Fee:      refund.Fee,
+			Currency: refund.Currency,
+		})
+	}
+
+	return &refund, nil
+}
@@ -0,0 +1,115 @@
+//go:build integration
+
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/internal/testutil"
+)
+
+// newPendingOrder places an order for two tickets of a new event's tier and
+// returns it with its buyer
+func newPendingOrder(t *testing.T) (*models.Order, *models.User) {
+	t.Helper()
+	reset(t)
+
+	event := testutil.CreateEvent(t, testutil.CreateOrganizer(t), 5000, 10)
+	buyer := testutil.CreateUser(t, models.RoleAttendee)
+	return testutil.CreateOrder(t, buyer, &event.TicketTiers[0], 2), buyer
+}
+
+func loadPayment(t *testing.T, payment *models.Payment) *models.Payment {
+	t.Helper()
+
+	var loaded models.Payment
+	if err := env.DB.First(&loaded, payment.ID).Error; err != nil {
+		t.Fatalf("load payment: %v", err)
+	}
+	return &loaded
+}
+
+func TestInitializePaymentInFlight(t *testing.T) {
+	order, buyer := newPendingOrder(t)
+	payments := services.NewPaymentService(env.Config)
+	pending := testutil.CreatePayment(t, order)
+
+	_, err := payments.InitializePayment(order.ID, buyer.ID, buyer.Email, "", services.PaymentClient{})
+	if err == nil || !strings.Contains(err.Error(), "payment in progress") {
+		t.Fatalf("initialize with a pending payment: err = %v, want a payment in progress", err)
+	}
+
+	// Once the payment fails the buyer may check out again. The test
+	// environment configures no provider, so the new session fails too.
+	if err := payments.FailPayment(pending); err != nil {
+		t.Fatalf("fail payment: %v", err)
+	}
+	_, err = payments.InitializePayment(order.ID, buyer.ID, buyer.Email, "", services.PaymentClient{})
+	if err == nil || strings.Contains(err.Error(), "payment in progress") {
+		t.Fatalf("initialize after a failed payment: err = %v, want the provider's error", err)
+	}
+
+	var inFlight int64
+	env.DB.Model(&models.Payment{}).
+		Where("order_id = ? AND status IN ?", order.ID, []models.PaymentStatus{models.PaymentPending, models.PaymentProcessing}).
+		Count(&inFlight)
+	if inFlight != 0 {
+		t.Errorf("%d payments left in flight, want 0", inFlight)
+	}
+}
+
+func TestCompletePaymentOfPaidOrder(t *testing.T) {
+	order, _ := newPendingOrder(t)
+	payments := services.NewPaymentService(env.Config)
+	testutil.PayOrder(t, payments, order)
+
+	// The second capture is refunded; with no provider configured the refund
+	// fails and is recorded for an admin
+	second := testutil.CreatePayment(t, order)
+	err := payments.CompletePayment(context.Background(), second, services.PaymentCard{})
+	if err == nil || !strings.Contains(err.Error(), "refund failed") {
+		t.Fatalf("complete second payment: err = %v, want a failed refund", err)
+	}
+
+	if got := loadPayment(t, second).Status; got != models.PaymentCompleted {
+		t.Errorf("second payment is %s, want %s", got, models.PaymentCompleted)
+	}
+	var refund models.Refund
+	if err := env.DB.Where("payment_id = ?", second.ID).First(&refund).Error; err != nil {
+		t.Fatalf("load refund: %v", err)
+	}
+	if refund.Status != models.RefundFailed || refund.Amount != second.Amount {
+		t.Errorf("refund = %s of %d, want %s of %d", refund.Status, refund.Amount, models.RefundFailed, second.Amount)
+	}
+
+	var paid models.Order
+	if err := env.DB.First(&paid, order.ID).Error; err != nil {
+		t.Fatalf("load order: %v", err)
+	}
+	if paid.Status != models.OrderPaid {
+		t.Errorf("order is %s, want %s", paid.Status, models.OrderPaid)
+	}
+}
+
+func TestFailPaymentAfterCompletion(t *testing.T) {
+	order, _ := newPendingOrder(t)
+	payments := services.NewPaymentService(env.Config)
+	payment := testutil.CreatePayment(t, order)
+
+	// A failure report read before the payment completed arrives after it
+	stale := *payment
+	if err := payments.CompletePayment(context.Background(), payment, services.PaymentCard{}); err != nil {
+		t.Fatalf("complete payment: %v", err)
+	}
+	if err := payments.FailPayment(&stale); err != nil {
+		t.Fatalf("fail payment: %v", err)
+	}
+
+	if got := loadPayment(t, payment).Status; got != models.PaymentCompleted {
+		t.Errorf("payment is %s, want %s", got, models.PaymentCompleted)
+	}
+}
@@ -0,0 +1,295 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/currency"
+	"eventix-api/pkg/database"
+)
+
+// PayoutService handles platform fees, organizer balances and payouts
+type PayoutService struct {
+	cfg *config.PaymentConfig
+}
+
+// NewPayoutService creates a new payout service
+func NewPayoutService(cfg *config.PaymentConfig) *PayoutService {
+	return &PayoutService{cfg: cfg}
+}
+
+// PlatformFee returns the fee, in minor units, charged to the organizer of
+// eventID for an order of quantity tickets totalling amount. Organizer
+// overrides take precedence over the platform defaults.
+func (s *PayoutService) PlatformFee(ctx context.Context, eventID uuid.UUID, amount int64, quantity int) (int64, error) {
+	if amount <= 0 {
+		return 0, nil
+	}
+
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Preload("Organizer").
+		Select("id", "organizer_id").First(&event, eventID).Error; err != nil {
+		return 0, fmt.Errorf("event not found")
+	}
+
+	basisPoints := s.cfg.PlatformFeeBasisPoints
+	if event.Organizer.FeeBasisPoints != nil {
+		basisPoints = *event.Organizer.FeeBasisPoints
+	}
+	fixed := s.cfg.PlatformFeeFixed
+	if event.Organizer.FeeFixedPerTicket != nil {
+		fixed = *event.Organizer.FeeFixedPerTicket
+	}
+
+	// Round the percentage half up to the nearest minor unit
+	fee := (amount*int64(basisPoints)+5000)/10000 + fixed*int64(quantity)
+	if fee > amount {
+		fee = amount
+	}
+
+	return fee, nil
+}
+
+// SetOrganizerFees sets or clears (nil) an organizer's fee overrides
+func (s *PayoutService) SetOrganizerFees(ctx context.Context, organizerID uuid.UUID, basisPoints *int, fixedPerTicket *int64) (*models.Organizer, error) {
+	var organizer models.Organizer
+	if err := database.DB.WithContext(ctx).First(&organizer, organizerID).Error; err != nil {
+		return nil, fmt.Errorf("organizer not found")
+	}
+
+	if err := database.DB.WithContext(ctx).Model(&organizer).Updates(map[string]interface{}{
+		"fee_basis_points":     basisPoints,
+		"fee_fixed_per_ticket": fixedPerTicket,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update organizer fees: %w", err)
+	}
+
+	organizer.FeeBasisPoints = basisPoints
+	organizer.FeeFixedPerTicket = fixedPerTicket
+	return &organizer, nil
+}
+
+// GetBalances returns an organizer's balance in every currency it has sold in
+func (s *PayoutService) GetBalances(ctx context.Context, organizerID uuid.UUID) ([]models.OrganizerBalance, error) {
+	var balances []models.OrganizerBalance
+	if err := database.DB.WithContext(ctx).Where("organizer_id = ?", organizerID).
+		Order("currency ASC").Find(&balances).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch balances: %w", err)
+	}
+	return balances, nil
+}
+
+// ListPayouts returns an organizer's payouts, newest first
+func (s *PayoutService) ListPayouts(ctx context.Context, organizerID uuid.UUID, page, limit int) ([]models.Payout, int64, error) {
+	query := database.DB.WithContext(ctx).Model(&models.Payout{}).Where("organizer_id = ?", organizerID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count payouts: %w", err)
+	}
+
+	var payouts []models.Payout
+	if err := query.Order("created_at DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&payouts).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch payouts: %w", err)
+	}
+
+	return payouts, total, nil
+}
+
+// InitiatePayout debits amount from an organizer's balance and records a
+// pending payout. An amount of zero pays out the whole balance.
+func (s *PayoutService) InitiatePayout(ctx context.Context, organizerID uuid.UUID, currencyCode string, amount int64, adminID uuid.UUID) (*models.Payout, error) {
+	code := currency.Normalize(currencyCode)
+	if !currency.IsSupported(code) {
+		return nil, fmt.Errorf("unsupported currency: %s", currencyCode)
+	}
+
+	var payout models.Payout
+	err := database.Transaction(func(tx *gorm.DB) error {
+		var balance models.OrganizerBalance
+		if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("organizer_id = ? AND currency = ?", organizerID, code).
+			First(&balance).Error; err != nil {
+			return fmt.Errorf("organizer has no %s balance", code)
+		}
+
+		if amount == 0 {
+			amount = balance.Balance
+		}
+		if amount <= 0 {
+			return fmt.Errorf("there is no balance to pay out")
+		}
+		if amount > balance.Balance {
+			return fmt.Errorf("payout exceeds the available balance of %s", currency.Format(balance.Balance, code))
+		}
+
+		payout = models.Payout{
+			OrganizerID: organizerID,
+			Amount:      amount,
+			Currency:    code,
+			Status:      models.PayoutPending,
+			InitiatedBy: adminID,
+		}
+		if err := tx.WithContext(ctx).Create(&payout).Error; err != nil {
+			return fmt.Errorf("failed to create payout: %w", err)
+		}
+
+		return postLedgerEntry(tx.WithContext(ctx), models.LedgerEntry{
+			OrganizerID: organizerID,
+			Type:        models.LedgerPayout,
+			Amount:      -amount,
+			Currency:    code,
+			PayoutID:    &payout.ID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &payout, nil
+}
+
+// CompletePayout marks a pending payout as transferred
+func (s *PayoutService) CompletePayout(ctx context.Context, payoutID uuid.UUID, reference string) (*models.Payout, error) {
+	var payout models.Payout
+	if err := database.DB.WithContext(ctx).First(&payout, payoutID).Error; err != nil {
+		return nil, fmt.Errorf("payout not found")
+	}
+
+	now := time.Now()
+	result := database.DB.WithContext(ctx).Model(&models.Payout{}).
+		Where("id = ? AND status = ?", payout.ID, models.PayoutPending).
+		Updates(map[string]interface{}{
+			"status":       models.PayoutCompleted,
+			"reference":    reference,
+			"completed_at": now,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to update payout: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("payout is %s", payout.Status)
+	}
+
+	payout.Status = models.PayoutCompleted
+	payout.Reference = reference
+	payout.CompletedAt = &now
+	return &payout, nil
+}
+
+// FailPayout marks a pending payout as failed and returns its amount to the organizer balance
+func (s *PayoutService) FailPayout(ctx context.Context, payoutID uuid.UUID, reason string) (*models.Payout, error) {
+	var payout models.Payout
+	err := database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.WithContext(ctx).First(&payout, payoutID).Error; err != nil {
+			return fmt.Errorf("payout not found")
+		}
+
+		result := tx.WithContext(ctx).Model(&models.Payout{}).
+			Where("id = ? AND status = ?", payout.ID, models.PayoutPending).
+			Updates(map[string]interface{}{"status": models.PayoutFailed, "reason": reason})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update payout: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("payout is %s", payout.Status)
+		}
+
+		payout.Status = models.PayoutFailed
+		payout.Reason = reason
+
+		return postLedgerEntry(tx.WithContext(ctx), models.LedgerEntry{
+			OrganizerID: payout.OrganizerID,
+			Type:        models.LedgerPayoutReversal,
+			Amount:      payout.Amount,
+			Currency:    payout.Currency,
+			PayoutID:    &payout.ID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &payout, nil
+}
+
+// recordSale credits the organizer of a paid order with its proceeds after the platform fee
+func recordSale(tx *gorm.DB, order *models.Order) error {
+	return recordOrderEntry(tx, order, models.LedgerSale, order.TotalAmount-order.PlatformFee)
+}
+
+// recordRefund debits the organizer of a refunded order with the proceeds it
+// was credited, less the refund fee they keep
+func recordRefund(tx *gorm.DB, order *models.Order, fee int64) error {
+	debit := order.TotalAmount - order.PlatformFee - fee
+	if debit < 0 {
+		debit = 0
+	}
+	return recordOrderEntry(tx, order, models.LedgerRefund, -debit)
+}
+
+// recordChargeback debits the organizer of an order whose dispute was lost
+// with the proceeds it was credited
+func recordChargeback(tx *gorm.DB, order *models.Order) error {
+	return recordOrderEntry(tx, order, models.LedgerChargeback, -(order.TotalAmount - order.PlatformFee))
+}
+
+func recordOrderEntry(tx *gorm.DB, order *models.Order, entryType models.LedgerEntryType, amount int64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	var organizerID uuid.UUID
+	if err := tx.Table("ticket_tiers tt").
+		Select("e.organizer_id").
+		Joins("JOIN events e ON e.id = tt.event_id").
+		Where("tt.id = ?", order.TierID).
+		Scan(&organizerID).Error; err != nil {
+		return fmt.Errorf("failed to resolve organizer: %w", err)
+	}
+	if organizerID == uuid.Nil {
+		return fmt.Errorf("organizer not found for order %s", order.ID)
+	}
+
+	return postLedgerEntry(tx, models.LedgerEntry{
+		OrganizerID: organizerID,
+		Type:        entryType,
+		Amount:      amount,
+		Currency:    order.Currency,
+		OrderID:     &order.ID,
+	})
+}
+
+// postLedgerEntry records entry and applies it to the organizer balance in its currency
+func postLedgerEntry(tx *gorm.DB, entry models.LedgerEntry) error {
+	if err := tx.Create(&entry).Error; err != nil {
+		return fmt.Errorf("failed to record ledger entry: %w", err)
+	}
+
+	balance := models.OrganizerBalance{
+		OrganizerID: entry.OrganizerID,
+		Currency:    entry.Currency,
+		Balance:     entry.Amount,
+	}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "organizer_id"}, {Name: "currency"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"balance":    gorm.Expr("organizer_balances.balance + ?", entry.Amount),
+			"updated_at": time.Now(),
+		}),
+	}).Create(&balance).Error; err != nil {
+		return fmt.Errorf("failed to update organizer balance: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventix-api/internal/models"
+)
+
+const paystackBaseURL = "https://api.paystack.co"
+
+// paystackPageSize is the largest page the transaction list returns
+const paystackPageSize = 100
+
+// paystackResponse is the envelope returned by every Paystack API call
+type paystackResponse struct {
+	Status  bool            `json:"status"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type paystackInitData struct {
+	AuthorizationURL string `json:"authorization_url"`
+	AccessCode       string `json:"access_code"`
+	Reference        string `json:"reference"`
+}
+
+type paystackVerifyData struct {
+	ID        int64  `json:"id"`
+	Status    string `json:"status"`
+	Reference string `json:"reference"`
+	Amount    int64  `json:"amount"`
+	Currency  string `json:"currency"`
+	PaidAt    string `json:"paid_at"`
+
+	Authorization paystackAuthorization `json:"authorization"`
+}
+
+// paystackAuthorization describes the card behind a Paystack charge
+type paystackAuthorization struct {
+	Signature   string `json:"signature"`
+	CountryCode string `json:"country_code"`
+}
+
+func (a paystackAuthorization) card() PaymentCard {
+	return PaymentCard{Fingerprint: a.Signature, Country: strings.ToUpper(a.CountryCode)}
+}
+
+type paystackRefundData struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+// PaystackProvider implements PaymentProvider using the Paystack REST API
+type PaystackProvider struct {
+	secretKey  string
+	httpClient *http.Client
+}
+
+// NewPaystackProvider creates a new Paystack provider
+func NewPaystackProvider(secretKey string, client *http.Client) *PaystackProvider {
+	return &PaystackProvider{
+		secretKey:  secretKey,
+		httpClient: client,
+	}
+}
+
+// Name returns the provider identifier
+func (p *PaystackProvider) Name() models.PaymentProvider {
+	return models.ProviderPaystack
+}
+
+// InitializePayment initializes a Paystack transaction
+func (p *PaystackProvider) InitializePayment(req ProviderInitRequest) (*ProviderInitResponse, error) {
+	body := map[string]interface{}{
+		"email":        req.Email,
+		"amount":       req.Amount,
+		"currency":     req.Currency,
+		"reference":    req.Reference,
+		"callback_url": req.CallbackURL,
+		"metadata":     req.Metadata,
+	}
+
+	var data paystackInitData
+	if err := p.request(http.MethodPost, "/transaction/initialize", body, &data); err != nil {
+		return nil, err
+	}
+
+	return &ProviderInitResponse{
+		CheckoutURL:       data.AuthorizationURL,
+		ProviderReference: data.AccessCode,
+	}, nil
+}
+
+// VerifyPayment fetches the transaction state from Paystack
+func (p *PaystackProvider) VerifyPayment(payment *models.Payment) (*ProviderVerifyResponse, error) {
+	var data paystackVerifyData
+	if err := p.request(http.MethodGet, "/transaction/verify/"+payment.TransactionID, nil, &data); err != nil {
+		return nil, err
+	}
+
+	status := ProviderStatusPending
+	switch data.Status {
+	case "success":
+		status = ProviderStatusSuccess
+	case "failed", "reversed":
+		status = ProviderStatusFailed
+	}
+
+	return &ProviderVerifyResponse{
+		Status:   status,
+		Amount:   data.Amount,
+		Currency: data.Currency,
+		Card:     data.Authorization.card(),
+	}, nil
+}
+
+// Refund refunds amount (minor units) of a Paystack transaction
+func (p *PaystackProvider) Refund(payment *models.Payment, amount int64) (*ProviderRefundResponse, error) {
+	body := map[string]interface{}{
+		"transaction": payment.TransactionID,
+		"amount":      amount,
+	}
+
+	var data paystackRefundData
+	if err := p.request(http.MethodPost, "/refund", body, &data); err != nil {
+		return nil, err
+	}
+
+	return &ProviderRefundResponse{
+		RefundID: fmt.Sprintf("%d", data.ID),
+		Status:   data.Status,
+	}, nil
+}
+
+// ListTransactions pages through the successful Paystack transactions
+// created in [from, to)
+func (p *PaystackProvider) ListTransactions(from, to time.Time) ([]ProviderTransaction, error) {
+	var transactions []ProviderTransaction
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("status", "success")
+		query.Set("from", from.UTC().Format(time.RFC3339))
+		query.Set("to", to.UTC().Format(time.RFC3339))
+		query.Set("perPage", strconv.Itoa(paystackPageSize))
+		query.Set("page", strconv.Itoa(page))
+
+		var data []paystackVerifyData
+		if err := p.request(http.MethodGet, "/transaction?"+query.Encode(), nil, &data); err != nil {
+			return nil, err
+		}
+
+		for _, tx := range data {
+			transactions = append(transactions, ProviderTransaction{
+				Reference: tx.Reference,
+				Amount:    tx.Amount,
+				Currency:  strings.ToUpper(tx.Currency),
+			})
+		}
+		if len(data) < paystackPageSize {
+			return transactions, nil
+		}
+	}
+}
+
+// request performs an authenticated request against the Paystack API
+func (p *PaystackProvider) request(method, path string, body interface{}, dest interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, paystackBaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.secretKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach paystack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var envelope paystackResponse
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("invalid response from paystack: %w", err)
+	}
+
+	if resp.StatusCode >= 400 || !envelope.Status {
+		return fmt.Errorf("paystack error: %s", envelope.Message)
+	}
+
+	if dest != nil {
+		if err := json.Unmarshal(envelope.Data, dest); err != nil {
+			return fmt.Errorf("invalid response data from paystack: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// PermissionInfo describes a permission for the admin permission editor.
+// Delegable permissions are the ones organizers may grant their team.
+type PermissionInfo struct {
+	Permission  models.Permission `json:"permission"`
+	Description string            `json:"description"`
+	Delegable   bool              `json:"delegable"`
+}
+
+var permissionCatalog = []PermissionInfo{
+	{models.PermEventsCreate, "Create events", false},
+	{models.PermEventsManage, "Edit, reschedule and cancel events, their tiers, sessions, forms and add-ons", true},
+	{models.PermCheckinScan, "Scan tickets at the door and follow check-in stats", true},
+	{models.PermAttendeesView, "View event analytics and export attendee lists", true},
+	{models.PermTicketsComp, "Issue complimentary tickets", true},
+	{models.PermPayoutsView, "View balances and payouts", true},
+	{models.PermOrganizerManage, "Update the organizer profile, tax details and team", false},
+}
+
+// defaultRolePermissions applies to roles an admin has not edited. Admins
+// hold every permission and cannot be edited, so they cannot lock
+// themselves out.
+var defaultRolePermissions = map[models.UserRole][]models.Permission{
+	models.RoleAttendee: {},
+	models.RoleOrganizer: {
+		models.PermEventsCreate,
+		models.PermEventsManage,
+		models.PermCheckinScan,
+		models.PermAttendeesView,
+		models.PermTicketsComp,
+		models.PermPayoutsView,
+		models.PermOrganizerManage,
+	},
+}
+
+// rolePermissionsCacheTTL bounds how long a replica may act on a role's
+// permissions after an admin changed them
+const rolePermissionsCacheTTL = time.Minute
+
+// PermissionService decides what users may do from the permissions of their
+// role and any an organizer delegated to them as a team member
+type PermissionService struct{}
+
+// NewPermissionService creates a new permission service
+func NewPermissionService() *PermissionService {
+	return &PermissionService{}
+}
+
+// Catalog lists every known permission
+func (s *PermissionService) Catalog() []PermissionInfo {
+	return permissionCatalog
+}
+
+// HasPermission reports whether a user holds a permission through their
+// role or as an active member of an organizer's team
+func (s *PermissionService) HasPermission(ctx context.Context, userID, role, permission string) (bool, error) {
+	switch role {
+	case string(models.RoleAdmin):
+		return true, nil
+	case string(models.RoleScanner):
+		// userID is whoever issued the scanner token, whose own
+		// permissions do not pass to door staff
+		return permission == string(models.PermCheckinScan), nil
+	}
+
+	granted, err := s.RolePermissions(ctx, models.UserRole(role))
+	if err != nil {
+		return false, err
+	}
+	if hasPermission(granted, models.Permission(permission)) {
+		return true, nil
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return false, nil
+	}
+	member, err := findActiveMember(ctx, uid)
+	if err != nil {
+		return false, err
+	}
+	return member != nil && hasPermission(member.Permissions, models.Permission(permission)), nil
+}
+
+// RolePermissions returns the permissions every user with a role holds
+func (s *PermissionService) RolePermissions(ctx context.Context, role models.UserRole) ([]models.Permission, error) {
+	if role == models.RoleAdmin {
+		return allPermissions(), nil
+	}
+
+	cached, err := cache.Client.Get(ctx, rolePermissionsKey(role)).Result()
+	if err == nil {
+		var permissions []models.Permission
+		if json.Unmarshal([]byte(cached), &permissions) == nil {
+			return permissions, nil
+		}
+	} else if err != redis.Nil {
+		logger.WithContext(ctx).Warn("Failed to read role permissions cache", zap.String("role", string(role)), zap.Error(err))
+	}
+
+	permissions := defaultRolePermissions[role]
+	var stored models.RolePermissions
+	err = database.DB.WithContext(ctx).Where("role = ?", role).First(&stored).Error
+	switch {
+	case err == nil:
+		permissions = stored.Permissions
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, fmt.Errorf("failed to fetch role permissions: %w", err)
+	}
+
+	if encoded, err := json.Marshal(permissions); err == nil {
+		cache.Client.Set(ctx, rolePermissionsKey(role), encoded, rolePermissionsCacheTTL)
+	}
+	return permissions, nil
+}
+
+// ListRoles returns the permissions of every role, admin included
+func (s *PermissionService) ListRoles(ctx context.Context) (map[models.UserRole][]models.Permission, error) {
+	roles := map[models.UserRole][]models.Permission{models.RoleAdmin: allPermissions()}
+	for role := range defaultRolePermissions {
+		permissions, err := s.RolePermissions(ctx, role)
+		if err != nil {
+			return nil, err
+		}
+		roles[role] = permissions
+	}
+	return roles, nil
+}
+
+// FindRole returns a role's stored permissions, or nil while it has its
+// built-in ones
+func (s *PermissionService) FindRole(ctx context.Context, role models.UserRole) (*models.RolePermissions, error) {
+	var stored models.RolePermissions
+	err := database.DB.WithContext(ctx).Where("role = ?", role).First(&stored).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch role permissions: %w", err)
+	}
+	return &stored, nil
+}
+
+// SetRolePermissions replaces the permissions of a role
+func (s *PermissionService) SetRolePermissions(ctx context.Context, role models.UserRole, permissions []models.Permission, adminID uuid.UUID) (*models.RolePermissions, error) {
+	if _, ok := defaultRolePermissions[role]; !ok {
+		return nil, utils.BadRequestError("permissions of role %q cannot be changed", role)
+	}
+	permissions, err := normalizePermissions(permissions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.FindRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		stored = &models.RolePermissions{Role: role}
+	}
+	stored.Permissions = permissions
+	stored.UpdatedBy = &adminID
+
+	if err := database.DB.WithContext(ctx).Save(stored).Error; err != nil {
+		return nil, fmt.Errorf("failed to save role permissions: %w", err)
+	}
+
+	if err := cache.Client.Del(ctx, rolePermissionsKey(role)).Err(); err != nil {
+		logger.WithContext(ctx).Warn("Failed to clear role permissions cache", zap.String("role", string(role)), zap.Error(err))
+	}
+	return stored, nil
+}
+
+// ActingOrganizer returns the organizer a user works for: their own, or the
+// one whose team they are on
+func (s *PermissionService) ActingOrganizer(ctx context.Context, userID uuid.UUID) (*models.Organizer, error) {
+	var organizer models.Organizer
+	err := database.DB.WithContext(ctx).Where("user_id = ?", userID).First(&organizer).Error
+	if err == nil {
+		return &organizer, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to fetch organizer: %w", err)
+	}
+
+	member, err := findActiveMember(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, utils.NotFoundError("organizer profile not found")
+	}
+	if err := database.DB.WithContext(ctx).First(&organizer, member.OrganizerID).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch organizer: %w", err)
+	}
+	return &organizer, nil
+}
+
+// IsMember reports whether a user is an active member of an organizer's team
+func (s *PermissionService) IsMember(ctx context.Context, organizerID, userID uuid.UUID) (bool, error) {
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&models.OrganizerMember{}).
+		Where("organizer_id = ? AND user_id = ? AND status = ?", organizerID, userID, models.MemberActive).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check team membership: %w", err)
+	}
+	return count > 0, nil
+}
+
+// normalizePermissions rejects unknown permissions, and those organizers
+// cannot delegate to their team when delegated is set, and drops duplicates
+func normalizePermissions(permissions []models.Permission, delegated bool) ([]models.Permission, error) {
+	seen := make(map[models.Permission]bool, len(permissions))
+	normalized := make([]models.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		info, ok := permissionInfo(permission)
+		if !ok {
+			return nil, utils.BadRequestError("unknown permission %q", permission)
+		}
+		if delegated && !info.Delegable {
+			return nil, utils.BadRequestError("permission %q cannot be delegated to team members", permission)
+		}
+		if !seen[permission] {
+			seen[permission] = true
+			normalized = append(normalized, permission)
+		}
+	}
+	return normalized, nil
+}
+
+func permissionInfo(permission models.Permission) (PermissionInfo, bool) {
+	for _, info := range permissionCatalog {
+		if info.Permission == permission {
+			return info, true
+		}
+	}
+	return PermissionInfo{}, false
+}
+
+func allPermissions() []models.Permission {
+	permissions := make([]models.Permission, len(permissionCatalog))
+	for i, info := range permissionCatalog {
+		permissions[i] = info.Permission
+	}
+	return permissions
+}
+
+func hasPermission(granted []models.Permission, permission models.Permission) bool {
+	for _, p := range granted {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func rolePermissionsKey(role models.UserRole) string {
+	return "permissions:role:" + string(role)
+}
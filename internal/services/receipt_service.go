@@ -0,0 +1,213 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/currency"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/storage"
+)
+
+// ReceiptContentType is the MIME type of rendered receipts
+const ReceiptContentType = "application/pdf"
+
+// ReceiptService renders and stores order receipts
+type ReceiptService struct{}
+
+// NewReceiptService creates a new receipt service
+func NewReceiptService() *ReceiptService {
+	return &ReceiptService{}
+}
+
+// ReceiptKey returns the storage key of an order's receipt
+func ReceiptKey(orderID uuid.UUID) string {
+	return fmt.Sprintf("receipts/%s.pdf", orderID)
+}
+
+// ReceiptFilename returns the file name receipts are downloaded and attached as
+func ReceiptFilename(orderID uuid.UUID) string {
+	return fmt.Sprintf("eventix-receipt-%s.pdf", orderID.String()[:8])
+}
+
+// Generate renders the receipt of a paid or refunded order and, when file
+// storage is configured, stores it so it can be retrieved later
+func (s *ReceiptService) Generate(ctx context.Context, orderID uuid.UUID) ([]byte, error) {
+	var order models.Order
+	if err := database.DB.WithContext(ctx).Preload("User").Preload("Payments").Preload("Items").First(&order, orderID).Error; err != nil {
+		return nil, fmt.Errorf("order not found")
+	}
+
+	if order.Status != models.OrderPaid && order.Status != models.OrderRefunded {
+		return nil, fmt.Errorf("receipts are only available for paid orders")
+	}
+
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Preload("Event").First(&tier, order.TierID).Error; err != nil {
+		return nil, fmt.Errorf("ticket tier not found for order")
+	}
+
+	pdf, err := renderReceipt(&order, &tier)
+	if err != nil {
+		return nil, err
+	}
+
+	if storage.Enabled() {
+		if err := storage.Upload(ctx, ReceiptKey(order.ID), ReceiptContentType, bytes.NewReader(pdf), int64(len(pdf))); err != nil {
+			return nil, fmt.Errorf("failed to store receipt: %w", err)
+		}
+	}
+
+	return pdf, nil
+}
+
+func renderReceipt(order *models.Order, tier *models.TicketTier) ([]byte, error) {
+	var payment *models.Payment
+	for i := range order.Payments {
+		status := order.Payments[i].Status
+		if status == models.PaymentCompleted || status == models.PaymentRefunded || status == models.PaymentRefunding {
+			payment = &order.Payments[i]
+			break
+		}
+	}
+
+	money := func(amount int64) string {
+		return order.Currency + " " + currency.Decimal(amount, order.Currency)
+	}
+
+	issuedAt := order.CreatedAt
+	if payment != nil && payment.PaidAt != nil {
+		issuedAt = *payment.PaidAt
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("Eventix Receipt", true)
+	pdf.SetMargins(20, 20, 20)
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	// Header
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(100, 10, "Eventix", "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(70, 10, "RECEIPT", "", 1, "R", false, 0, "")
+	if order.Status == models.OrderRefunded {
+		pdf.SetTextColor(220, 38, 38)
+		pdf.CellFormat(170, 6, "REFUNDED", "", 1, "R", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	}
+	pdf.Ln(6)
+
+	// Receipt and buyer details
+	pdf.SetFont("Helvetica", "", 10)
+	details := [][2]string{
+		{"Receipt number", order.ID.String()},
+		{"Date", issuedAt.UTC().Format("02 Jan 2006 15:04 MST")},
+		{"Billed to", tr(order.User.FirstName + " " + order.User.LastName)},
+		{"Email", order.User.Email},
+	}
+	for _, row := range details {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(40, 6, row[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(130, 6, row[1], "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	// Event
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(170, 7, tr(tier.Event.Title), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	location := tier.Event.Location
+	if tier.Event.Venue != "" {
+		location = tier.Event.Venue + ", " + location
+	}
+	pdf.CellFormat(170, 6, tr(location), "", 1, "L", false, 0, "")
+	pdf.CellFormat(170, 6, tier.Event.StartTime.UTC().Format(time.RFC1123), "", 1, "L", false, 0, "")
+	pdf.Ln(6)
+
+	// Order lines
+	pdf.SetFillColor(243, 244, 246)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(85, 8, "Description", "B", 0, "L", true, 0, "")
+	pdf.CellFormat(20, 8, "Qty", "B", 0, "R", true, 0, "")
+	pdf.CellFormat(30, 8, "Unit price", "B", 0, "R", true, 0, "")
+	pdf.CellFormat(35, 8, "Amount", "B", 1, "R", true, 0, "")
+
+	// Lines carry the name and price they were bought at; tickets come first
+	items := append([]models.OrderItem(nil), order.Items...)
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Type == models.OrderItemTicket && items[j].Type != models.OrderItemTicket
+	})
+
+	var subtotal int64
+	pdf.SetFont("Helvetica", "", 10)
+	for _, item := range items {
+		description := item.Name
+		if item.Type == models.OrderItemTicket {
+			description += " ticket"
+		}
+		pdf.CellFormat(85, 8, tr(description), "", 0, "L", false, 0, "")
+		pdf.CellFormat(20, 8, strconv.Itoa(item.Quantity), "", 0, "R", false, 0, "")
+		pdf.CellFormat(30, 8, money(item.UnitPrice), "", 0, "R", false, 0, "")
+		pdf.CellFormat(35, 8, money(item.Amount), "", 1, "R", false, 0, "")
+		subtotal += item.Amount
+	}
+
+	// Totals. Exclusive tax is charged on top of the lines and inclusive tax
+	// is part of them. Any other difference between the charged total and the
+	// line items (normally none) is shown as fees so the receipt always adds up.
+	taxRow := [2]string{"Taxes", "Included"}
+	fees := order.TotalAmount - subtotal
+	if order.TaxBasisPoints > 0 {
+		rate := strconv.FormatFloat(float64(order.TaxBasisPoints)/100, 'f', -1, 64)
+		taxRow = [2]string{tr(fmt.Sprintf("%s (%s%%)", order.TaxName, rate)), money(order.TaxAmount)}
+		if order.TaxInclusive {
+			taxRow[0] += " included"
+		} else {
+			fees -= order.TaxAmount
+		}
+	}
+	totals := [][2]string{
+		{"Subtotal", money(subtotal)},
+		{"Fees", money(fees)},
+		taxRow,
+	}
+	pdf.Ln(2)
+	for _, row := range totals {
+		pdf.CellFormat(135, 7, row[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(35, 7, row[1], "", 1, "R", false, 0, "")
+	}
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(135, 9, "Total paid", "T", 0, "R", false, 0, "")
+	pdf.CellFormat(35, 9, money(order.TotalAmount), "T", 1, "R", false, 0, "")
+	pdf.Ln(6)
+
+	// Payment
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(170, 6, "Payment", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	if payment != nil {
+		pdf.CellFormat(40, 6, "Method", "", 0, "L", false, 0, "")
+		pdf.CellFormat(130, 6, string(payment.Provider), "", 1, "L", false, 0, "")
+		pdf.CellFormat(40, 6, "Reference", "", 0, "L", false, 0, "")
+		pdf.CellFormat(130, 6, payment.TransactionID, "", 1, "L", false, 0, "")
+	} else {
+		pdf.CellFormat(170, 6, "No payment was required for this order.", "", 1, "L", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render receipt: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+)
+
+// ReconciliationService compares the payments recorded here with the
+// transactions each provider settled
+type ReconciliationService struct {
+	cfg *config.PaymentConfig
+}
+
+// NewReconciliationService creates a new reconciliation service
+func NewReconciliationService(cfg *config.PaymentConfig) *ReconciliationService {
+	return &ReconciliationService{cfg: cfg}
+}
+
+// RunNightly reconciles yesterday (UTC) for every configured provider that
+// has no report for it yet. A lock in Redis keeps replicas from running the
+// same report twice.
+func (s *ReconciliationService) RunNightly(ctx context.Context) {
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+
+	for _, name := range paymentProviders {
+		if _, err := s.Provider(name); err != nil {
+			continue
+		}
+
+		var count int64
+		if err := database.DB.WithContext(ctx).Model(&models.ReconciliationReport{}).
+			Where("provider = ? AND date = ?", name, day).
+			Count(&count).Error; err != nil {
+			logger.Error("Failed to check reconciliation reports", zap.String("provider", string(name)), zap.Error(err))
+			continue
+		}
+		if count > 0 {
+			continue
+		}
+
+		acquired, err := cache.Client.SetNX(ctx, reconciliationLockKey(name, day), "1", time.Hour).Result()
+		if err != nil {
+			logger.Error("Failed to lock reconciliation", zap.String("provider", string(name)), zap.Error(err))
+			continue
+		}
+		if !acquired {
+			continue
+		}
+
+		report, err := s.Reconcile(ctx, name, day)
+		if err != nil {
+			logger.Error("Failed to reconcile payments", zap.String("provider", string(name)), zap.Error(err))
+			continue
+		}
+		if len(report.Issues) > 0 {
+			logger.Warn("Payment reconciliation found issues",
+				zap.String("provider", string(name)),
+				zap.Time("date", day),
+				zap.Int("issues", len(report.Issues)),
+			)
+		}
+	}
+}
+
+// Provider returns the provider implementation for name, or an error when the
+// provider is not configured
+func (s *ReconciliationService) Provider(name models.PaymentProvider) (PaymentProvider, error) {
+	return NewPaymentProvider(name, s.cfg)
+}
+
+// Reconcile compares a provider's successful transactions of one UTC day with
+// our payments and stores the result, replacing any earlier report for it
+func (s *ReconciliationService) Reconcile(ctx context.Context, name models.PaymentProvider, day time.Time) (*models.ReconciliationReport, error) {
+	provider, err := s.Provider(name)
+	if err != nil {
+		return nil, err
+	}
+
+	day = day.UTC().Truncate(24 * time.Hour)
+	transactions, err := provider.ListTransactions(day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s transactions: %w", name, err)
+	}
+
+	references := make([]string, 0, len(transactions))
+	for _, tx := range transactions {
+		if tx.Reference != "" {
+			references = append(references, tx.Reference)
+		}
+	}
+
+	var payments []models.Payment
+	if len(references) > 0 {
+		if err := database.DB.WithContext(ctx).
+			Where("provider = ? AND transaction_id IN ?", name, references).
+			Find(&payments).Error; err != nil {
+			return nil, fmt.Errorf("failed to fetch payments: %w", err)
+		}
+	}
+	byReference := make(map[string]*models.Payment, len(payments))
+	for i := range payments {
+		byReference[payments[i].TransactionID] = &payments[i]
+	}
+
+	report := models.ReconciliationReport{
+		Provider:     name,
+		Date:         day,
+		Transactions: len(transactions),
+	}
+	for _, tx := range transactions {
+		issue := reconciliationIssue(tx, byReference[tx.Reference])
+		if issue == nil {
+			report.Matched++
+			continue
+		}
+		report.Issues = append(report.Issues, *issue)
+	}
+
+	err = database.Transaction(func(tx *gorm.DB) error {
+		var previous []models.ReconciliationReport
+		if err := tx.Where("provider = ? AND date = ?", name, day).Find(&previous).Error; err != nil {
+			return fmt.Errorf("failed to fetch previous report: %w", err)
+		}
+		for _, old := range previous {
+			if err := tx.Where("report_id = ?", old.ID).Delete(&models.ReconciliationIssue{}).Error; err != nil {
+				return fmt.Errorf("failed to delete previous issues: %w", err)
+			}
+			if err := tx.Delete(&old).Error; err != nil {
+				return fmt.Errorf("failed to delete previous report: %w", err)
+			}
+		}
+
+		if err := tx.Create(&report).Error; err != nil {
+			return fmt.Errorf("failed to save report: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// Reports returns the reports of one day with their issues, or those of the
+// latest reconciled day when day is nil
+func (s *ReconciliationService) Reports(ctx context.Context, day *time.Time, provider models.PaymentProvider) ([]models.ReconciliationReport, error) {
+	db := database.Reader(database.DB).WithContext(ctx)
+
+	var date time.Time
+	if day != nil {
+		date = day.UTC().Truncate(24 * time.Hour)
+	} else {
+		var latest models.ReconciliationReport
+		err := db.Order("date DESC").First(&latest).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return []models.ReconciliationReport{}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reconciliation reports: %w", err)
+		}
+		date = latest.Date
+	}
+
+	query := db.Preload("Issues", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("type ASC").Order("reference ASC")
+	}).Where("date = ?", date)
+	if provider != "" {
+		query = query.Where("provider = ?", provider)
+	}
+
+	var reports []models.ReconciliationReport
+	if err := query.Order("provider ASC").Find(&reports).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch reconciliation reports: %w", err)
+	}
+	return reports, nil
+}
+
+// reconciliationIssue returns what is wrong with a provider transaction given
+// the payment recorded for it, or nil when the two agree
+func reconciliationIssue(tx ProviderTransaction, payment *models.Payment) *models.ReconciliationIssue {
+	issue := models.ReconciliationIssue{
+		Reference:        tx.Reference,
+		ProviderAmount:   tx.Amount,
+		ProviderCurrency: tx.Currency,
+	}
+	if payment == nil {
+		issue.Type = models.ReconciliationOrphaned
+		return &issue
+	}
+
+	issue.PaymentID = &payment.ID
+	issue.PaymentStatus = payment.Status
+	issue.Amount = payment.Amount
+	issue.Currency = payment.Currency
+
+	switch {
+	case payment.Amount != tx.Amount || !strings.EqualFold(payment.Currency, tx.Currency):
+		issue.Type = models.ReconciliationAmountMismatch
+	case payment.Status == models.PaymentPending || payment.Status == models.PaymentProcessing || payment.Status == models.PaymentFailed:
+		issue.Type = models.ReconciliationMissingWebhook
+	default:
+		return nil
+	}
+	return &issue
+}
+
+func reconciliationLockKey(provider models.PaymentProvider, day time.Time) string {
+	return "reconciliation:" + string(provider) + ":" + day.Format("2006-01-02") + ":lock"
+}
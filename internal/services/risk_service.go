@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+)
+
+// RiskSignal is what is known about a paid order when it is scored
+type RiskSignal struct {
+	Order *models.Order
+	Card  PaymentCard
+}
+
+// RiskRule scores one aspect of an order. A rule that finds nothing wrong
+// returns zero; otherwise it returns its points and a reason for admins.
+type RiskRule interface {
+	Name() string
+	Score(ctx context.Context, signal *RiskSignal) (int, string)
+}
+
+// RiskAssessment is the combined score of every rule that fired
+type RiskAssessment struct {
+	Score   int
+	Reasons []string
+}
+
+var (
+	riskRulesMu sync.RWMutex
+	riskRules   []RiskRule
+)
+
+// RegisterRiskRule adds a rule that scores every paid order alongside the
+// built-in velocity and billing country rules
+func RegisterRiskRule(rule RiskRule) {
+	riskRulesMu.Lock()
+	defer riskRulesMu.Unlock()
+	riskRules = append(riskRules, rule)
+}
+
+// RiskService scores paid orders and decides which are held for review
+type RiskService struct {
+	threshold int
+	rules     []RiskRule
+}
+
+// NewRiskService creates a risk service with the built-in rules configured
+// by cfg followed by any registered rules
+func NewRiskService(cfg *config.PaymentConfig) *RiskService {
+	rules := []RiskRule{
+		&velocityRule{name: "user", limit: cfg.RiskMaxOrdersPerUser, window: cfg.RiskVelocityWindow, points: 40,
+			value: func(signal *RiskSignal) string { return signal.Order.UserID.String() }},
+		&velocityRule{name: "ip", limit: cfg.RiskMaxOrdersPerIP, window: cfg.RiskVelocityWindow, points: 40,
+			value: func(signal *RiskSignal) string { return signal.Order.ClientIP }},
+		&velocityRule{name: "card", limit: cfg.RiskMaxOrdersPerCard, window: cfg.RiskVelocityWindow, points: 50,
+			value: func(signal *RiskSignal) string { return signal.Card.Fingerprint }},
+		&countryRule{name: "card country", points: 50,
+			country: func(signal *RiskSignal) string { return signal.Card.Country }},
+		&countryRule{name: "IP country", points: 30,
+			country: func(signal *RiskSignal) string { return signal.Order.IPCountry }},
+	}
+
+	riskRulesMu.RLock()
+	rules = append(rules, riskRules...)
+	riskRulesMu.RUnlock()
+
+	return &RiskService{threshold: cfg.RiskReviewThreshold, rules: rules}
+}
+
+// Assess runs every rule against a paid order
+func (s *RiskService) Assess(ctx context.Context, signal *RiskSignal) RiskAssessment {
+	var assessment RiskAssessment
+	for _, rule := range s.rules {
+		points, reason := rule.Score(ctx, signal)
+		if points <= 0 {
+			continue
+		}
+		assessment.Score += points
+		assessment.Reasons = append(assessment.Reasons, reason)
+	}
+	return assessment
+}
+
+// NeedsReview reports whether an assessed order must wait for an admin
+func (s *RiskService) NeedsReview(assessment RiskAssessment) bool {
+	return s.threshold > 0 && assessment.Score >= s.threshold
+}
+
+// velocityRule counts paid orders sharing a value, such as the buyer or
+// their IP address, in a fixed window and fires once the limit is passed
+type velocityRule struct {
+	name   string
+	limit  int
+	window time.Duration
+	points int
+	value  func(signal *RiskSignal) string
+}
+
+func (r *velocityRule) Name() string {
+	return r.name + " velocity"
+}
+
+func (r *velocityRule) Score(ctx context.Context, signal *RiskSignal) (int, string) {
+	value := r.value(signal)
+	if r.limit <= 0 || value == "" {
+		return 0, ""
+	}
+
+	key := riskVelocityKey(r.name, value)
+	count, err := cache.Client.Incr(ctx, key).Result()
+	if err != nil {
+		// Scoring is best effort; an unknown count does not hold the order
+		logger.WithContext(ctx).Warn("Failed to count order velocity", zap.String("rule", r.Name()), zap.Error(err))
+		return 0, ""
+	}
+	if count == 1 {
+		cache.Client.Expire(ctx, key, r.window)
+	}
+
+	if count <= int64(r.limit) {
+		return 0, ""
+	}
+	return r.points, fmt.Sprintf("%d orders from the same %s within %s", count, r.name, r.window)
+}
+
+// countryRule fires when the billing country given at checkout differs
+// from another country known about the buyer
+type countryRule struct {
+	name    string
+	points  int
+	country func(signal *RiskSignal) string
+}
+
+func (r *countryRule) Name() string {
+	return "billing " + r.name
+}
+
+func (r *countryRule) Score(ctx context.Context, signal *RiskSignal) (int, string) {
+	billing := signal.Order.BillingCountry
+	other := r.country(signal)
+	if billing == "" || other == "" || strings.EqualFold(billing, other) {
+		return 0, ""
+	}
+	return r.points, fmt.Sprintf("billing country %s does not match %s %s", billing, r.name, other)
+}
+
+func riskVelocityKey(name, value string) string {
+	return "risk:velocity:" + strings.ReplaceAll(name, " ", "_") + ":" + value
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// Scanner tokens cover a shift at the door; a longer event needs new ones
+const (
+	DefaultScannerTokenExpiry = 12 * time.Hour
+	MaxScannerTokenExpiry     = 24 * time.Hour
+)
+
+// ScannerTokenService issues and revokes tokens that let temporary door staff
+// check in tickets of one event without an account
+type ScannerTokenService struct{}
+
+// NewScannerTokenService creates a new scanner token service
+func NewScannerTokenService() *ScannerTokenService {
+	return &ScannerTokenService{}
+}
+
+// Issue creates a scanner token for an event and returns it with the signed
+// JWT, which is only shown once
+func (s *ScannerTokenService) Issue(ctx context.Context, eventID, issuedBy uuid.UUID, label string, expiry time.Duration) (*models.ScannerToken, string, error) {
+	if expiry <= 0 {
+		expiry = DefaultScannerTokenExpiry
+	}
+	if expiry > MaxScannerTokenExpiry {
+		return nil, "", utils.BadRequestError("scanner tokens can last at most %s", MaxScannerTokenExpiry)
+	}
+
+	token := &models.ScannerToken{
+		ID:        uuid.New(),
+		EventID:   eventID,
+		Label:     label,
+		IssuedBy:  issuedBy,
+		ExpiresAt: time.Now().Add(expiry),
+	}
+	signed, err := jwt.GenerateScannerToken(issuedBy.String(), eventID.String(), token.ID.String(), token.ExpiresAt)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign scanner token: %w", err)
+	}
+	if err := database.DB.WithContext(ctx).Create(token).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to save scanner token: %w", err)
+	}
+	return token, signed, nil
+}
+
+// List returns the scanner tokens of an event that are still usable, newest
+// first
+func (s *ScannerTokenService) List(ctx context.Context, eventID uuid.UUID) ([]models.ScannerToken, error) {
+	var tokens []models.ScannerToken
+	if err := database.DB.WithContext(ctx).
+		Where("event_id = ? AND revoked_at IS NULL AND expires_at > ?", eventID, time.Now()).
+		Order("created_at DESC").
+		Find(&tokens).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch scanner tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// Get returns a scanner token
+func (s *ScannerTokenService) Get(ctx context.Context, tokenID uuid.UUID) (*models.ScannerToken, error) {
+	var token models.ScannerToken
+	if err := database.DB.WithContext(ctx).First(&token, tokenID).Error; err != nil {
+		return nil, utils.NotFoundError("scanner token not found")
+	}
+	return &token, nil
+}
+
+// Revoke stops a scanner token from checking in tickets before it expires
+func (s *ScannerTokenService) Revoke(ctx context.Context, token *models.ScannerToken) error {
+	if !token.IsActive() {
+		return nil
+	}
+
+	now := time.Now()
+	if err := database.DB.WithContext(ctx).Model(token).Update("revoked_at", now).Error; err != nil {
+		return fmt.Errorf("failed to revoke scanner token: %w", err)
+	}
+	token.RevokedAt = &now
+
+	// The auth middleware rejects tokens of revoked sessions until they expire
+	if err := cache.Client.Set(ctx, utils.RevokedSessionKey(token.ID.String()), "1", time.Until(token.ExpiresAt)).Err(); err != nil {
+		logger.WithContext(ctx).Error("Failed to flag revoked scanner token", zap.String("token_id", token.ID.String()), zap.Error(err))
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// SessionService tracks the devices a user is signed in on and the refresh
+// tokens issued to each
+type SessionService struct {
+	cfg *config.JWTConfig
+}
+
+// NewSessionService creates a new session service
+func NewSessionService(cfg *config.JWTConfig) *SessionService {
+	return &SessionService{cfg: cfg}
+}
+
+// Start signs a user in on a new device and returns its first token pair
+func (s *SessionService) Start(ctx context.Context, user *models.User, userAgent, ipAddress string) (*jwt.TokenPair, error) {
+	now := time.Now()
+	session := models.Session{
+		ID:         uuid.New(),
+		UserID:     user.ID,
+		UserAgent:  userAgent,
+		IPAddress:  ipAddress,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(s.cfg.RefreshTokenExpiry),
+	}
+
+	pair, err := jwt.GenerateTokenPair(user.ID.String(), user.Email, string(user.Role), session.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	session.RefreshTokenID = pair.RefreshTokenID
+
+	if err := database.DB.WithContext(ctx).Create(&session).Error; err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	return pair, nil
+}
+
+// Refresh exchanges the refresh token described by claims for a new token
+// pair on the same session. Presenting a refresh token that was already
+// exchanged revokes the session, since only a stolen copy could do that.
+func (s *SessionService) Refresh(ctx context.Context, claims *jwt.Claims, user *models.User, userAgent, ipAddress string) (*jwt.TokenPair, error) {
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("session expired, please log in again")
+	}
+
+	var session models.Session
+	if err := database.DB.WithContext(ctx).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL AND expires_at > ?", sessionID, user.ID, time.Now()).
+		First(&session).Error; err != nil {
+		return nil, fmt.Errorf("session expired, please log in again")
+	}
+
+	pair, err := jwt.GenerateTokenPair(user.ID.String(), user.Email, string(user.Role), session.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Compare-and-swap so two concurrent refreshes cannot both succeed
+	result := database.DB.WithContext(ctx).Model(&models.Session{}).
+		Where("id = ? AND refresh_token_id = ? AND revoked_at IS NULL", session.ID, claims.ID).
+		Updates(map[string]interface{}{
+			"refresh_token_id": pair.RefreshTokenID,
+			"user_agent":       userAgent,
+			"ip_address":       ipAddress,
+			"last_used_at":     time.Now(),
+			"expires_at":       time.Now().Add(s.cfg.RefreshTokenExpiry),
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to refresh session: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		logger.WithContext(ctx).Warn("Refresh token reused, revoking session",
+			zap.String("session_id", session.ID.String()),
+			zap.String("user_id", user.ID.String()),
+		)
+		if err := s.Revoke(ctx, user.ID, session.ID); err != nil {
+			logger.WithContext(ctx).Error("Failed to revoke session", zap.Error(err))
+		}
+		return nil, fmt.Errorf("session expired, please log in again")
+	}
+
+	return pair, nil
+}
+
+// List returns a user's active sessions, most recently used first
+func (s *SessionService) List(ctx context.Context, userID uuid.UUID) ([]models.Session, error) {
+	var sessions []models.Session
+	if err := database.DB.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Revoke signs a user out of one session
+func (s *SessionService) Revoke(ctx context.Context, userID, sessionID uuid.UUID) error {
+	result := database.DB.WithContext(ctx).Model(&models.Session{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke session: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("session not found")
+	}
+
+	s.rejectAccessTokens(ctx, sessionID)
+	return nil
+}
+
+// RevokeAll signs a user out of every session
+func (s *SessionService) RevokeAll(ctx context.Context, userID uuid.UUID) error {
+	var sessionIDs []uuid.UUID
+	if err := database.DB.WithContext(ctx).Model(&models.Session{}).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Pluck("id", &sessionIDs).Error; err != nil {
+		return fmt.Errorf("failed to fetch sessions: %w", err)
+	}
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	if err := database.DB.WithContext(ctx).Model(&models.Session{}).
+		Where("id IN ?", sessionIDs).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	s.rejectAccessTokens(ctx, sessionIDs...)
+	return nil
+}
+
+// rejectAccessTokens flags sessions in Redis so the auth middleware refuses
+// access tokens they were issued, which stay signature-valid until expiry
+func (s *SessionService) rejectAccessTokens(ctx context.Context, sessionIDs ...uuid.UUID) {
+	pipe := cache.Client.Pipeline()
+	for _, id := range sessionIDs {
+		pipe.Set(ctx, utils.RevokedSessionKey(id.String()), "1", s.cfg.Expiry)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.WithContext(ctx).Error("Failed to flag revoked sessions", zap.Error(err))
+	}
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"eventix-api/pkg/config"
+)
+
+// SMSProvider is implemented by every supported SMS gateway
+type SMSProvider interface {
+	Name() string
+	Send(to, body string) (messageID string, err error)
+}
+
+// NewSMSProvider returns the configured SMS provider implementation
+func NewSMSProvider(cfg *config.SMSConfig) (SMSProvider, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	switch strings.ToLower(cfg.Provider) {
+	case "twilio":
+		if cfg.TwilioAccountSID == "" || cfg.TwilioAuthToken == "" || cfg.TwilioFromNumber == "" {
+			return nil, fmt.Errorf("twilio is not configured")
+		}
+		return &TwilioProvider{
+			accountSID: cfg.TwilioAccountSID,
+			authToken:  cfg.TwilioAuthToken,
+			from:       cfg.TwilioFromNumber,
+			httpClient: client,
+		}, nil
+	case "termii":
+		if cfg.TermiiAPIKey == "" {
+			return nil, fmt.Errorf("termii is not configured")
+		}
+		return &TermiiProvider{
+			apiKey:     cfg.TermiiAPIKey,
+			senderID:   cfg.TermiiSenderID,
+			httpClient: client,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported SMS provider: %s", cfg.Provider)
+	}
+}
+
+// TwilioProvider sends SMS through the Twilio Messages API
+type TwilioProvider struct {
+	accountSID string
+	authToken  string
+	from       string
+	httpClient *http.Client
+}
+
+// Name returns the provider identifier
+func (p *TwilioProvider) Name() string {
+	return "twilio"
+}
+
+// Send delivers an SMS and returns the Twilio message SID
+func (p *TwilioProvider) Send(to, body string) (string, error) {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", p.from)
+	form.Set("Body", body)
+
+	endpoint := fmt.Sprintf("https://api.twilio.com/2010-04-01/Accounts/%s/Messages.json", p.accountSID)
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(p.accountSID, p.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var result struct {
+		SID     string `json:"sid"`
+		Message string `json:"message"`
+	}
+	if err := doSMSRequest(p.httpClient, req, "twilio", &result); err != nil {
+		return "", err
+	}
+
+	return result.SID, nil
+}
+
+// TermiiProvider sends SMS through the Termii messaging API
+type TermiiProvider struct {
+	apiKey     string
+	senderID   string
+	httpClient *http.Client
+}
+
+// Name returns the provider identifier
+func (p *TermiiProvider) Name() string {
+	return "termii"
+}
+
+// Send delivers an SMS and returns the Termii message ID
+func (p *TermiiProvider) Send(to, body string) (string, error) {
+	payload, err := json.Marshal(map[string]string{
+		"to":      strings.TrimPrefix(to, "+"),
+		"from":    p.senderID,
+		"sms":     body,
+		"type":    "plain",
+		"channel": "generic",
+		"api_key": p.apiKey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.ng.termii.com/api/sms/send", bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var result struct {
+		MessageID string `json:"message_id"`
+		Message   string `json:"message"`
+	}
+	if err := doSMSRequest(p.httpClient, req, "termii", &result); err != nil {
+		return "", err
+	}
+
+	return result.MessageID, nil
+}
+
+// doSMSRequest performs a provider request and decodes the JSON response
+func doSMSRequest(client *http.Client, req *http.Request, provider string, dest interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach %s: %w", provider, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read %s response: %w", provider, err)
+	}
+
+	if resp.StatusCode >= 400 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(body, &apiErr)
+		return fmt.Errorf("%s error: %s", provider, apiErr.Message)
+	}
+
+	if err := json.Unmarshal(body, dest); err != nil {
+		return fmt.Errorf("invalid response from %s: %w", provider, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/queue"
+)
+
+// SMSService handles SMS notifications
+type SMSService struct {
+	provider SMSProvider
+}
+
+// NewSMSService creates a new SMS service. SMS is disabled when the
+// configured provider has no credentials.
+func NewSMSService(cfg *config.SMSConfig) *SMSService {
+	provider, _ := NewSMSProvider(cfg)
+
+	return &SMSService{
+		provider: provider,
+	}
+}
+
+// Enabled reports whether an SMS provider is configured
+func (s *SMSService) Enabled() bool {
+	return s.provider != nil
+}
+
+// SMSJob is an SMS queued for delivery by the notification worker
+type SMSJob struct {
+	UserID         uuid.UUID                `json:"user_id"`
+	NotificationID uuid.UUID                `json:"notification_id"`
+	To             string                   `json:"to"`
+	Kind           string                   `json:"kind"`
+	Topic          models.NotificationTopic `json:"topic"`
+	Body           string                   `json:"body"`
+}
+
+// SMSJobType identifies SMS jobs on the notifications queue
+const SMSJobType = "sms"
+
+// send records the SMS in the user's notifications and hands it to the
+// notification queue, delivering it inline when queueing is disabled or the
+// broker is unreachable. Messages the user opted out of are dropped.
+func (s *SMSService) send(ctx context.Context, job SMSJob) error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	if job.UserID != uuid.Nil {
+		if !NewNotificationService().Allowed(ctx, job.UserID, models.ChannelSMS, job.Topic) {
+			return nil
+		}
+
+		notification, err := NewNotificationService().Create(job.UserID, models.ChannelSMS, "", job.Body,
+			map[string]interface{}{"kind": job.Kind})
+		if err != nil {
+			// Still send the SMS; the notification feed is secondary
+			logger.WithContext(ctx).Error("Failed to record SMS notification", zap.String("kind", job.Kind), zap.Error(err))
+		} else {
+			job.NotificationID = notification.ID
+		}
+	}
+
+	if queue.Enabled() {
+		err := queue.Publish(ctx, queue.NotificationsQueue(), SMSJobType, job)
+		if err == nil {
+			return nil
+		}
+		logger.WithContext(ctx).Warn("Failed to enqueue SMS, delivering inline",
+			zap.String("kind", job.Kind),
+			zap.Error(err),
+		)
+	}
+
+	return s.Deliver(ctx, job)
+}
+
+// Deliver sends an SMS immediately through the configured provider
+func (s *SMSService) Deliver(ctx context.Context, job SMSJob) error {
+	if !s.Enabled() {
+		return fmt.Errorf("SMS provider is not configured")
+	}
+
+	messageID, err := s.provider.Send(job.To, job.Body)
+	if err != nil {
+		return fmt.Errorf("failed to send %s SMS: %w", job.Kind, err)
+	}
+
+	logger.WithContext(ctx).Debug("SMS sent",
+		zap.String("provider", s.provider.Name()),
+		zap.String("kind", job.Kind),
+		zap.String("message_id", messageID),
+	)
+
+	if job.NotificationID != uuid.Nil {
+		NewNotificationService().MarkSent(job.NotificationID)
+	}
+
+	return nil
+}
+
+// SendOrderConfirmationSMS tells a buyer their tickets are ready
+func (s *SMSService) SendOrderConfirmationSMS(ctx context.Context, userID uuid.UUID, phone string, orderID uuid.UUID, ticketCount int) error {
+	return s.send(ctx, SMSJob{
+		UserID: userID,
+		To:     phone,
+		Kind:   "order_confirmation",
+		Topic:  models.TopicOrders,
+		Body: fmt.Sprintf("Eventix: your order %s for %d ticket(s) is confirmed. Your tickets are in the app.",
+			orderID.String()[:8], ticketCount),
+	})
+}
+
+// SendEventReminderSMS reminds a ticket holder that their event is coming up
+func (s *SMSService) SendEventReminderSMS(ctx context.Context, userID uuid.UUID, phone, eventTitle, venue string, startTime time.Time) error {
+	where := venue
+	if where == "" {
+		where = "the venue"
+	}
+
+	return s.send(ctx, SMSJob{
+		UserID: userID,
+		To:     phone,
+		Kind:   "event_reminder",
+		Topic:  models.TopicReminders,
+		Body: fmt.Sprintf("Eventix reminder: %s starts %s at %s. Have your ticket QR code ready.",
+			eventTitle, startTime.Format("Mon 2 Jan, 15:04 MST"), where),
+	})
+}
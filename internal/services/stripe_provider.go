@@ -0,0 +1,245 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventix-api/internal/models"
+)
+
+const stripeBaseURL = "https://api.stripe.com/v1"
+
+type stripeError struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type stripeCheckoutSession struct {
+	ID                string `json:"id"`
+	URL               string `json:"url"`
+	Status            string `json:"status"`
+	PaymentStatus     string `json:"payment_status"`
+	PaymentIntent     string `json:"payment_intent"`
+	ClientReferenceID string `json:"client_reference_id"`
+	AmountTotal       int64  `json:"amount_total"`
+	Currency          string `json:"currency"`
+}
+
+type stripeRefund struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// StripeProvider implements PaymentProvider using Stripe Checkout Sessions
+type StripeProvider struct {
+	secretKey  string
+	httpClient *http.Client
+}
+
+// NewStripeProvider creates a new Stripe provider
+func NewStripeProvider(secretKey string, client *http.Client) *StripeProvider {
+	return &StripeProvider{
+		secretKey:  secretKey,
+		httpClient: client,
+	}
+}
+
+// Name returns the provider identifier
+func (p *StripeProvider) Name() models.PaymentProvider {
+	return models.ProviderStripe
+}
+
+// InitializePayment creates a Stripe Checkout Session for the payment
+func (p *StripeProvider) InitializePayment(req ProviderInitRequest) (*ProviderInitResponse, error) {
+	separator := "?"
+	if strings.Contains(req.CallbackURL, "?") {
+		separator = "&"
+	}
+
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("client_reference_id", req.Reference)
+	form.Set("customer_email", req.Email)
+	form.Set("success_url", req.CallbackURL+separator+"reference="+url.QueryEscape(req.Reference))
+	form.Set("cancel_url", req.CallbackURL+separator+"reference="+url.QueryEscape(req.Reference)+"&cancelled=true")
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", strings.ToLower(req.Currency))
+	form.Set("line_items[0][price_data][unit_amount]", strconv.FormatInt(req.Amount, 10))
+	form.Set("line_items[0][price_data][product_data][name]", req.Description)
+	form.Set("metadata[reference]", req.Reference)
+	for key, value := range req.Metadata {
+		form.Set(fmt.Sprintf("metadata[%s]", key), value)
+	}
+
+	var session stripeCheckoutSession
+	if err := p.request(http.MethodPost, "/checkout/sessions", form, &session); err != nil {
+		return nil, err
+	}
+
+	return &ProviderInitResponse{
+		CheckoutURL:       session.URL,
+		ProviderReference: session.ID,
+	}, nil
+}
+
+// VerifyPayment retrieves the Checkout Session backing the payment
+func (p *StripeProvider) VerifyPayment(payment *models.Payment) (*ProviderVerifyResponse, error) {
+	session, err := p.getSession(payment.PaymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := ProviderStatusPending
+	switch {
+	case session.PaymentStatus == "paid":
+		status = ProviderStatusSuccess
+	case session.Status == "expired":
+		status = ProviderStatusFailed
+	}
+
+	return &ProviderVerifyResponse{
+		Status:   status,
+		Amount:   session.AmountTotal,
+		Currency: strings.ToUpper(session.Currency),
+	}, nil
+}
+
+// Refund refunds amount (minor units) of the payment intent behind the session
+func (p *StripeProvider) Refund(payment *models.Payment, amount int64) (*ProviderRefundResponse, error) {
+	session, err := p.getSession(payment.PaymentIntentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.PaymentIntent == "" {
+		return nil, fmt.Errorf("stripe session has no payment intent")
+	}
+
+	form := url.Values{}
+	form.Set("payment_intent", session.PaymentIntent)
+	form.Set("amount", strconv.FormatInt(amount, 10))
+
+	var refund stripeRefund
+	if err := p.request(http.MethodPost, "/refunds", form, &refund); err != nil {
+		return nil, err
+	}
+
+	return &ProviderRefundResponse{
+		RefundID: refund.ID,
+		Status:   refund.Status,
+	}, nil
+}
+
+// CheckoutReference returns the payment reference of the Checkout Session
+// that created a payment intent
+func (p *StripeProvider) CheckoutReference(paymentIntent string) (string, error) {
+	var sessions struct {
+		Data []struct {
+			ClientReferenceID string `json:"client_reference_id"`
+		} `json:"data"`
+	}
+	if err := p.request(http.MethodGet, "/checkout/sessions?payment_intent="+url.QueryEscape(paymentIntent), nil, &sessions); err != nil {
+		return "", err
+	}
+	if len(sessions.Data) == 0 || sessions.Data[0].ClientReferenceID == "" {
+		return "", fmt.Errorf("no checkout session found for payment intent %s", paymentIntent)
+	}
+	return sessions.Data[0].ClientReferenceID, nil
+}
+
+// ListTransactions pages through the paid Checkout Sessions created in [from, to)
+func (p *StripeProvider) ListTransactions(from, to time.Time) ([]ProviderTransaction, error) {
+	var transactions []ProviderTransaction
+	startingAfter := ""
+	for {
+		query := url.Values{}
+		query.Set("created[gte]", strconv.FormatInt(from.Unix(), 10))
+		query.Set("created[lt]", strconv.FormatInt(to.Unix(), 10))
+		query.Set("limit", "100")
+		if startingAfter != "" {
+			query.Set("starting_after", startingAfter)
+		}
+
+		var sessions struct {
+			Data    []stripeCheckoutSession `json:"data"`
+			HasMore bool                    `json:"has_more"`
+		}
+		if err := p.request(http.MethodGet, "/checkout/sessions?"+query.Encode(), nil, &sessions); err != nil {
+			return nil, err
+		}
+
+		for _, session := range sessions.Data {
+			if session.PaymentStatus != "paid" {
+				continue
+			}
+			transactions = append(transactions, ProviderTransaction{
+				Reference: session.ClientReferenceID,
+				Amount:    session.AmountTotal,
+				Currency:  strings.ToUpper(session.Currency),
+			})
+		}
+		if !sessions.HasMore || len(sessions.Data) == 0 {
+			return transactions, nil
+		}
+		startingAfter = sessions.Data[len(sessions.Data)-1].ID
+	}
+}
+
+func (p *StripeProvider) getSession(sessionID string) (*stripeCheckoutSession, error) {
+	if sessionID == "" {
+		return nil, fmt.Errorf("missing stripe session ID")
+	}
+
+	var session stripeCheckoutSession
+	if err := p.request(http.MethodGet, "/checkout/sessions/"+url.PathEscape(sessionID), nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// request performs an authenticated form-encoded request against the Stripe API
+func (p *StripeProvider) request(method, path string, form url.Values, dest interface{}) error {
+	var reader io.Reader
+	if form != nil {
+		reader = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequest(method, stripeBaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.secretKey)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach stripe: %w", err)
+	}
+	defer resp.Body.Close()
+
+	payload, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read stripe response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		var apiErr stripeError
+		_ = json.Unmarshal(payload, &apiErr)
+		return fmt.Errorf("stripe error: %s", apiErr.Error.Message)
+	}
+
+	if err := json.Unmarshal(payload, dest); err != nil {
+		return fmt.Errorf("invalid response from stripe: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+// defaultTaxName labels tax lines when the organizer has not named the tax
+const defaultTaxName = "Tax"
+
+// TaxRate is the sales tax or VAT charged on an event's orders
+type TaxRate struct {
+	Name        string
+	BasisPoints int
+	Inclusive   bool // prices already include the tax
+}
+
+// TaxSettings holds a tax configuration to save; a nil BasisPoints clears it
+type TaxSettings struct {
+	Name        string
+	BasisPoints *int
+	Inclusive   bool
+}
+
+// TaxService resolves and applies the tax settings of events and organizers
+type TaxService struct{}
+
+// NewTaxService creates a new tax service
+func NewTaxService() *TaxService {
+	return &TaxService{}
+}
+
+// RateFor returns the tax charged on orders for eventID, or nil when there is
+// none. An event's own settings take precedence over its organizer's.
+func (s *TaxService) RateFor(ctx context.Context, eventID uuid.UUID) (*TaxRate, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Preload("Organizer").
+		Select("id", "organizer_id", "tax_name", "tax_basis_points", "tax_inclusive").
+		First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	rate := &TaxRate{}
+	switch {
+	case event.TaxBasisPoints != nil:
+		rate.Name, rate.BasisPoints, rate.Inclusive = event.TaxName, *event.TaxBasisPoints, event.TaxInclusive
+	case event.Organizer.TaxBasisPoints != nil:
+		rate.Name, rate.BasisPoints, rate.Inclusive = event.Organizer.TaxName, *event.Organizer.TaxBasisPoints, event.Organizer.TaxInclusive
+	default:
+		return nil, nil
+	}
+
+	if rate.BasisPoints == 0 {
+		return nil, nil
+	}
+	if rate.Name == "" {
+		rate.Name = defaultTaxName
+	}
+	return rate, nil
+}
+
+// Apply computes the tax on each line of an order and records it on the
+// line, returning the order's total tax and the amount to charge. Inclusive
+// tax is taken out of the line amounts; exclusive tax is added on top.
+func (rate *TaxRate) Apply(items []models.OrderItem) (tax, total int64) {
+	for i := range items {
+		total += items[i].Amount
+		if rate == nil {
+			continue
+		}
+
+		// Round half up to the nearest minor unit
+		if rate.Inclusive {
+			divisor := int64(10000 + rate.BasisPoints)
+			items[i].TaxAmount = (items[i].Amount*int64(rate.BasisPoints) + divisor/2) / divisor
+		} else {
+			items[i].TaxAmount = (items[i].Amount*int64(rate.BasisPoints) + 5000) / 10000
+		}
+		tax += items[i].TaxAmount
+	}
+
+	if rate != nil && !rate.Inclusive {
+		total += tax
+	}
+	return tax, total
+}
+
+// SetEventTax sets or clears an event's tax settings. Without them, the
+// organizer's settings apply.
+func (s *TaxService) SetEventTax(ctx context.Context, eventID uuid.UUID, settings TaxSettings) (*models.Event, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	settings = normalizeTaxSettings(settings)
+	if err := database.DB.WithContext(ctx).Model(&event).Updates(taxColumns(settings)).Error; err != nil {
+		return nil, fmt.Errorf("failed to update event tax: %w", err)
+	}
+
+	event.TaxName, event.TaxBasisPoints, event.TaxInclusive = settings.Name, settings.BasisPoints, settings.Inclusive
+	return &event, nil
+}
+
+// SetOrganizerTax sets or clears the tax settings of every event of an
+// organizer that does not have its own
+func (s *TaxService) SetOrganizerTax(ctx context.Context, organizerID uuid.UUID, settings TaxSettings) (*models.Organizer, error) {
+	var organizer models.Organizer
+	if err := database.DB.WithContext(ctx).First(&organizer, organizerID).Error; err != nil {
+		return nil, fmt.Errorf("organizer not found")
+	}
+
+	settings = normalizeTaxSettings(settings)
+	if err := database.DB.WithContext(ctx).Model(&organizer).Updates(taxColumns(settings)).Error; err != nil {
+		return nil, fmt.Errorf("failed to update organizer tax: %w", err)
+	}
+
+	organizer.TaxName, organizer.TaxBasisPoints, organizer.TaxInclusive = settings.Name, settings.BasisPoints, settings.Inclusive
+	return &organizer, nil
+}
+
+// normalizeTaxSettings trims the tax name and drops the name and mode of
+// cleared settings
+func normalizeTaxSettings(settings TaxSettings) TaxSettings {
+	settings.Name = strings.TrimSpace(settings.Name)
+	if settings.BasisPoints == nil {
+		settings.Name, settings.Inclusive = "", false
+	}
+	return settings
+}
+
+func taxColumns(settings TaxSettings) map[string]interface{} {
+	return map[string]interface{}{
+		"tax_name":         settings.Name,
+		"tax_basis_points": settings.BasisPoints,
+		"tax_inclusive":    settings.Inclusive,
+	}
+}
@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+// teamInvitationExpiry is how long an invitation link stays valid
+const teamInvitationExpiry = 7 * 24 * time.Hour
+
+// staffRolePermissions are the permissions each preset staff role grants
+var staffRolePermissions = map[models.StaffRole][]models.Permission{
+	models.StaffScanner: {models.PermCheckinScan},
+	models.StaffFinance: {models.PermAttendeesView, models.PermPayoutsView},
+	models.StaffEditor:  {models.PermEventsManage, models.PermAttendeesView, models.PermTicketsComp},
+}
+
+// TeamInvite is who an organizer invites and what they may do: a preset
+// role, or StaffCustom with the permissions listed
+type TeamInvite struct {
+	Email       string
+	Role        models.StaffRole
+	Permissions []models.Permission
+}
+
+// TeamService manages the members an organizer invites to work on their
+// events
+type TeamService struct {
+	emailCfg    *config.EmailConfig
+	frontendURL string
+}
+
+// NewTeamService creates a new team service
+func NewTeamService(cfg *config.Config) *TeamService {
+	return &TeamService{
+		emailCfg:    &cfg.Email,
+		frontendURL: cfg.Server.FrontendURL,
+	}
+}
+
+// List returns an organizer's members and pending invitations, oldest first
+func (s *TeamService) List(ctx context.Context, organizerID uuid.UUID) ([]models.OrganizerMember, error) {
+	var members []models.OrganizerMember
+	if err := database.DB.WithContext(ctx).Preload("User").
+		Where("organizer_id = ?", organizerID).
+		Order("created_at ASC").
+		Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch team members: %w", err)
+	}
+	return members, nil
+}
+
+// Get returns one of an organizer's members
+func (s *TeamService) Get(ctx context.Context, organizerID, memberID uuid.UUID) (*models.OrganizerMember, error) {
+	var member models.OrganizerMember
+	if err := database.DB.WithContext(ctx).Preload("User").
+		Where("id = ? AND organizer_id = ?", memberID, organizerID).
+		First(&member).Error; err != nil {
+		return nil, utils.NotFoundError("team member not found")
+	}
+	return &member, nil
+}
+
+// Invite emails an invitation to join the organizer's team. Inviting an
+// address with a pending invitation sends a fresh link. Organizer and admin
+// accounts cannot be invited, since their own role would reach beyond the
+// organizer's events.
+func (s *TeamService) Invite(ctx context.Context, organizer *models.Organizer, invite TeamInvite, invitedBy uuid.UUID) (*models.OrganizerMember, error) {
+	permissions, err := staffPermissions(invite.Role, invite.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	email := strings.ToLower(strings.TrimSpace(invite.Email))
+
+	var user models.User
+	err = database.DB.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	switch {
+	case err == nil:
+		if user.Role != models.RoleAttendee {
+			return nil, utils.BadRequestError("only attendee accounts can join an organizer's team")
+		}
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, fmt.Errorf("failed to fetch user: %w", err)
+	}
+
+	var member models.OrganizerMember
+	err = database.DB.WithContext(ctx).Where("organizer_id = ? AND email = ?", organizer.ID, email).First(&member).Error
+	switch {
+	case err == nil:
+		if member.Status == models.MemberActive {
+			return nil, utils.ConflictError("%s is already on your team", email)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		member = models.OrganizerMember{OrganizerID: organizer.ID, Email: email, Status: models.MemberInvited}
+	default:
+		return nil, fmt.Errorf("failed to fetch team member: %w", err)
+	}
+
+	token, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	expiresAt := time.Now().Add(teamInvitationExpiry)
+
+	member.Role = invite.Role
+	member.Permissions = permissions
+	member.InviteTokenHash = hashInviteToken(token)
+	member.InviteExpiresAt = &expiresAt
+	member.InvitedBy = invitedBy
+	if err := database.DB.WithContext(ctx).Save(&member).Error; err != nil {
+		return nil, fmt.Errorf("failed to save invitation: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/team-invitations/%s", s.frontendURL, token)
+	if err := NewEmailService(s.emailCfg).SendTeamInvitationEmail(ctx, email, organizer.OrganizationName, member.Role, link, expiresAt); err != nil {
+		// The organizer can invite again to resend the link
+		logger.WithContext(ctx).Error("Failed to send team invitation email",
+			zap.String("member_id", member.ID.String()),
+			zap.Error(err),
+		)
+	}
+
+	return &member, nil
+}
+
+// Accept joins the signed-in user to the team that invited them. The
+// invitation must have been sent to the user's email address.
+func (s *TeamService) Accept(ctx context.Context, token string, user *models.User) (*models.OrganizerMember, error) {
+	var member models.OrganizerMember
+	if err := database.DB.WithContext(ctx).Preload("Organizer").
+		Where("invite_token_hash = ? AND status = ?", hashInviteToken(token), models.MemberInvited).
+		First(&member).Error; err != nil {
+		return nil, utils.NotFoundError("invitation not found or already used")
+	}
+	if member.InviteExpiresAt == nil || time.Now().After(*member.InviteExpiresAt) {
+		return nil, utils.BadRequestError("this invitation has expired; ask the organizer to invite you again")
+	}
+	if !strings.EqualFold(member.Email, user.Email) {
+		return nil, utils.ForbiddenError("this invitation was sent to a different email address")
+	}
+	if user.Role != models.RoleAttendee {
+		return nil, utils.BadRequestError("only attendee accounts can join an organizer's team")
+	}
+
+	existing, err := findActiveMember(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, utils.ConflictError("you are already on an organizer's team; leave it before joining another")
+	}
+
+	now := time.Now()
+	result := database.DB.WithContext(ctx).Model(&models.OrganizerMember{}).
+		Where("id = ? AND status = ?", member.ID, models.MemberInvited).
+		Updates(map[string]interface{}{
+			"user_id":           user.ID,
+			"status":            models.MemberActive,
+			"accepted_at":       now,
+			"invite_token_hash": "",
+			"invite_expires_at": nil,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, utils.ConflictError("invitation was already used")
+	}
+
+	member.UserID, member.Status, member.AcceptedAt = &user.ID, models.MemberActive, &now
+	member.InviteTokenHash, member.InviteExpiresAt = "", nil
+	member.User = user
+	return &member, nil
+}
+
+// Update changes what a member or pending invitee may do
+func (s *TeamService) Update(ctx context.Context, organizerID, memberID uuid.UUID, role models.StaffRole, permissions []models.Permission) (*models.OrganizerMember, error) {
+	permissions, err := staffPermissions(role, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.Get(ctx, organizerID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	member.Role, member.Permissions = role, permissions
+	if err := database.DB.WithContext(ctx).Model(member).Select("role", "permissions").Updates(member).Error; err != nil {
+		return nil, fmt.Errorf("failed to update team member: %w", err)
+	}
+	return member, nil
+}
+
+// Remove takes a member off the team, or withdraws a pending invitation
+func (s *TeamService) Remove(ctx context.Context, organizerID, memberID uuid.UUID) error {
+	result := database.DB.WithContext(ctx).
+		Where("id = ? AND organizer_id = ?", memberID, organizerID).
+		Delete(&models.OrganizerMember{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove team member: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.NotFoundError("team member not found")
+	}
+	return nil
+}
+
+// staffPermissions returns the permissions of a preset role, or checks the
+// ones picked for a custom role
+func staffPermissions(role models.StaffRole, permissions []models.Permission) ([]models.Permission, error) {
+	if role == models.StaffCustom {
+		if len(permissions) == 0 {
+			return nil, utils.BadRequestError("a custom role needs at least one permission")
+		}
+		return normalizePermissions(permissions, true)
+	}
+
+	preset, ok := staffRolePermissions[role]
+	if !ok {
+		return nil, utils.BadRequestError("role must be scanner, finance, editor or custom")
+	}
+	return preset, nil
+}
+
+// findActiveMember returns the team membership of a user, or nil when they
+// are not on any organizer's team
+func findActiveMember(ctx context.Context, userID uuid.UUID) (*models.OrganizerMember, error) {
+	var member models.OrganizerMember
+	err := database.DB.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, models.MemberActive).
+		First(&member).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch team membership: %w", err)
+	}
+	return &member, nil
+}
+
+func hashInviteToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
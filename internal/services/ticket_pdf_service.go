@@ -0,0 +1,158 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/ticketqr"
+)
+
+// TicketPDFContentType is the MIME type of printable tickets
+const TicketPDFContentType = "application/pdf"
+
+// ticketTerms is printed at the foot of every ticket
+var ticketTerms = []string{
+	"This ticket admits one person and is valid only for the event, date and tier shown.",
+	"The QR code is checked at entry and can be scanned once; do not share or post it.",
+	"Copies are not valid once the original has been scanned.",
+	"Refunds are subject to the organizer's refund policy. Entry may be refused to anyone breaching venue rules.",
+}
+
+// TicketPDFService renders printable PDF tickets
+type TicketPDFService struct{}
+
+// NewTicketPDFService creates a new ticket PDF service
+func NewTicketPDFService() *TicketPDFService {
+	return &TicketPDFService{}
+}
+
+// TicketPDFFilename returns the file name printable tickets are downloaded as
+func TicketPDFFilename(ticketID uuid.UUID) string {
+	return fmt.Sprintf("eventix-ticket-%s.pdf", ticketID.String()[:8])
+}
+
+// ticketPDFKey is the storage key of a rendered ticket. It changes whenever
+// the ticket or its event does, e.g. when the event is rescheduled, so a
+// stored copy is never stale.
+func ticketPDFKey(ticket *models.Ticket) string {
+	return fmt.Sprintf("tickets/%s/%d-%d.pdf", ticket.ID, ticket.UpdatedAt.UnixNano(), ticket.Tier.Event.UpdatedAt.UnixNano())
+}
+
+// Render returns the printable PDF of an active or used ticket. When file
+// storage is configured, rendered tickets are stored and reused.
+func (s *TicketPDFService) Render(ctx context.Context, ticketID uuid.UUID) ([]byte, error) {
+	var ticket models.Ticket
+	if err := database.DB.WithContext(ctx).
+		Preload("Tier").
+		Preload("Tier.Event").
+		Preload("Owner").
+		First(&ticket, ticketID).Error; err != nil {
+		return nil, fmt.Errorf("ticket not found")
+	}
+
+	if ticket.Status != models.TicketActive && ticket.Status != models.TicketUsed {
+		return nil, fmt.Errorf("only active tickets can be printed")
+	}
+
+	key := ticketPDFKey(&ticket)
+	if storage.Enabled() {
+		if pdf, err := storage.Download(ctx, key); err == nil {
+			return pdf, nil
+		}
+	}
+
+	pdf, err := renderTicketPDF(&ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	// A failed upload only costs a re-render next time
+	if storage.Enabled() {
+		if err := storage.Upload(ctx, key, TicketPDFContentType, bytes.NewReader(pdf), int64(len(pdf))); err != nil {
+			logger.WithContext(ctx).Warn("Failed to store ticket PDF", zap.String("ticket_id", ticket.ID.String()), zap.Error(err))
+		}
+	}
+
+	return pdf, nil
+}
+
+func renderTicketPDF(ticket *models.Ticket) ([]byte, error) {
+	qr, err := ticketqr.PNG(ticket.QRCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ticket QR code: %w", err)
+	}
+
+	event := ticket.Tier.Event
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("Eventix Ticket", true)
+	pdf.SetMargins(20, 20, 20)
+	pdf.AddPage()
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	// Header
+	pdf.SetFont("Helvetica", "B", 20)
+	pdf.CellFormat(100, 10, "Eventix", "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(70, 10, "TICKET", "", 1, "R", false, 0, "")
+	if ticket.Status == models.TicketUsed {
+		pdf.SetTextColor(107, 114, 128)
+		pdf.CellFormat(170, 6, "CHECKED IN", "", 1, "R", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	}
+	pdf.Ln(6)
+
+	// Event
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.MultiCell(170, 8, tr(event.Title), "", "L", false)
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(170, 6, tr(eventAddress(&event)), "", 1, "L", false, 0, "")
+	pdf.CellFormat(170, 6, event.StartTime.UTC().Format(time.RFC1123)+" - "+event.EndTime.UTC().Format(time.RFC1123), "", 1, "L", false, 0, "")
+	pdf.Ln(6)
+
+	// QR code, centered
+	options := fpdf.ImageOptions{ImageType: "PNG"}
+	pdf.RegisterImageOptionsReader("qr", options, bytes.NewReader(qr))
+	pdf.ImageOptions("qr", 65, pdf.GetY(), 80, 80, false, options, 0, "")
+	pdf.SetY(pdf.GetY() + 84)
+
+	// Ticket details
+	details := [][2]string{
+		{"Ticket", tr(ticket.Tier.TierName)},
+		{"Holder", tr(ticket.Owner.FirstName + " " + ticket.Owner.LastName)},
+		{"Ticket number", ticket.ID.String()},
+		{"Order", ticket.OrderID.String()},
+	}
+	for _, row := range details {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(40, 6, row[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(130, 6, row[1], "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(8)
+
+	// Terms
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(170, 6, "Terms", "T", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 8)
+	for _, term := range ticketTerms {
+		pdf.MultiCell(170, 4, "- "+term, "", "L", false)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render ticket: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
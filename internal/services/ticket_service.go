@@ -7,11 +7,15 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"eventix-api/internal/models"
 	"eventix-api/pkg/cache"
 	"eventix-api/pkg/database"
+	"eventix-api/pkg/events"
+	"eventix-api/pkg/ticketqr"
 	"eventix-api/pkg/utils"
 )
 
@@ -22,8 +26,10 @@ type ReservationData struct {
 	TierID        uuid.UUID `json:"tier_id"`
 	EventID       uuid.UUID `json:"event_id"`
 	Quantity      int       `json:"quantity"`
-	UnitPrice     float64   `json:"unit_price"`
-	TotalPrice    float64   `json:"total_price"`
+	UnitPrice     int64     `json:"unit_price"`
+	PricePhase    string    `json:"price_phase,omitempty"` // phase the unit price was taken from
+	TotalPrice    int64     `json:"total_price"`
+	Currency      string    `json:"currency"`
 	ExpiresAt     time.Time `json:"expires_at"`
 	CreatedAt     time.Time `json:"created_at"`
 }
@@ -36,54 +42,102 @@ func NewTicketService() *TicketService {
 	return &TicketService{}
 }
 
-// CreateReservation creates a temporary ticket reservation
+// CreateReservation creates a temporary ticket reservation.
+// The tier row is locked with SELECT ... FOR UPDATE so concurrent
+// reservations against the same tier are serialized and cannot oversell.
+// The hold keeps the price of the phase active when it was made.
 func (s *TicketService) CreateReservation(userID, tierID uuid.UUID, quantity int) (*ReservationData, error) {
-	// Get ticket tier
-	var tier models.TicketTier
-	if err := database.DB.Preload("Event").First(&tier, tierID).Error; err != nil {
-		return nil, fmt.Errorf("tier not found: %w", err)
+	if quantity < 1 {
+		return nil, fmt.Errorf("quantity must be at least 1")
 	}
 
-	// Check availability
-	if tier.AvailableQuantity < quantity {
-		return nil, fmt.Errorf("only %d tickets available", tier.AvailableQuantity)
-	}
+	var reservation *ReservationData
+	ctx := context.Background()
 
-	// Check event status
-	if tier.Event.Status != models.EventPublished {
-		return nil, fmt.Errorf("event is not available for booking")
-	}
+	err := database.Transaction(func(tx *gorm.DB) error {
+		// Lock the tier row for the duration of the transaction
+		var tier models.TicketTier
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&tier, tierID).Error; err != nil {
+			return fmt.Errorf("tier not found: %w", err)
+		}
 
-	// Create reservation
-	reservation := &ReservationData{
-		ReservationID: utils.GenerateReservationID(),
-		UserID:        userID,
-		TierID:        tierID,
-		EventID:       tier.EventID,
-		Quantity:      quantity,
-		UnitPrice:     tier.Price,
-		TotalPrice:    utils.CalculateTotalPrice(tier.Price, quantity),
-		ExpiresAt:     time.Now().Add(utils.ReservationExpirySeconds()),
-		CreatedAt:     time.Now(),
-	}
+		// Check availability
+		if tier.AvailableQuantity < quantity {
+			return fmt.Errorf("only %d tickets available", tier.AvailableQuantity)
+		}
 
-	// Store in Redis
-	key := utils.GetReservationKey(reservation.ReservationID)
-	data, _ := json.Marshal(reservation)
+		if err := tx.Where("tier_id = ?", tierID).Order("position ASC").Find(&tier.PricePhases).Error; err != nil {
+			return fmt.Errorf("failed to load price phases: %w", err)
+		}
 
-	ctx := context.Background()
-	if err := cache.Client.Set(ctx, key, data, utils.ReservationExpirySeconds()).Err(); err != nil {
-		return nil, fmt.Errorf("failed to create reservation: %w", err)
-	}
+		// Check event status
+		var event models.Event
+		if err := tx.First(&event, tier.EventID).Error; err != nil {
+			return fmt.Errorf("event not found: %w", err)
+		}
+		if event.Status != models.EventPublished {
+			return fmt.Errorf("event is not available for booking")
+		}
+
+		// Reduce available quantity while holding the lock
+		result := tx.Model(&models.TicketTier{}).
+			Where("id = ? AND available_quantity >= ?", tierID, quantity).
+			UpdateColumn("available_quantity", gorm.Expr("available_quantity - ?", quantity))
+		if result.Error != nil {
+			return fmt.Errorf("failed to reserve tickets: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("tickets are no longer available")
+		}
+
+		unitPrice, phase := tier.CurrentPrice(time.Now())
+		reservation = &ReservationData{
+			ReservationID: utils.GenerateReservationID(),
+			UserID:        userID,
+			TierID:        tierID,
+			EventID:       tier.EventID,
+			Quantity:      quantity,
+			UnitPrice:     unitPrice,
+			TotalPrice:    utils.CalculateTotalPrice(unitPrice, quantity),
+			Currency:      tier.Currency,
+			ExpiresAt:     time.Now().Add(utils.ReservationExpirySeconds()),
+			CreatedAt:     time.Now(),
+		}
+		if phase != nil {
+			reservation.PricePhase = phase.Name
+		}
+
+		// Store in Redis; a failure rolls the decrement back
+		key := utils.GetReservationKey(reservation.ReservationID)
+		data, _ := json.Marshal(reservation)
+
+		pipe := cache.Client.TxPipeline()
+		pipe.Set(ctx, key, data, utils.ReservationExpirySeconds())
+		// Track the hold so the expiry worker can release it once the key expires
+		pipe.HSet(ctx, utils.ReservationHoldsKey, reservation.ReservationID, data)
+		pipe.ZAdd(ctx, utils.ReservationExpiryIndexKey, redis.Z{
+			Score:  float64(reservation.ExpiresAt.Unix()),
+			Member: reservation.ReservationID,
+		})
+		if _, err := pipe.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create reservation: %w", err)
+		}
 
-	// Temporarily reduce available quantity
-	tier.AvailableQuantity -= quantity
-	if err := database.DB.Save(&tier).Error; err != nil {
-		// Rollback Redis
-		cache.Client.Del(ctx, key)
-		return nil, fmt.Errorf("failed to reserve tickets: %w", err)
+		return nil
+	})
+	if err != nil {
+		// Commit failed after the hold was written; drop the orphaned key
+		if reservation != nil {
+			s.untrackReservation(ctx, reservation.ReservationID)
+		}
+		return nil, err
 	}
 
+	// Feeds reservation-to-purchase conversion in organizer analytics
+	cache.Increment(ctx, utils.EventReservationsMetricKey(reservation.EventID))
+
+	publishAvailability(ctx, tierID, AvailabilityReserved)
+
 	return reservation, nil
 }
 
@@ -113,42 +167,185 @@ func (s *TicketService) DeleteReservation(reservationID string) error {
 	}
 
 	// Release tickets back to available quantity
-	if err := database.DB.Model(&models.TicketTier{}).
-		Where("id = ?", reservation.TierID).
-		UpdateColumn("available_quantity", gorm.Expr("available_quantity + ?", reservation.Quantity)).
-		Error; err != nil {
-		return fmt.Errorf("failed to release tickets: %w", err)
+	if err := s.ReleaseTickets(reservation.TierID, reservation.Quantity); err != nil {
+		return err
 	}
 
 	// Delete from Redis
+	s.untrackReservation(context.Background(), reservationID)
+
+	return nil
+}
+
+// ConsumeReservation removes a reservation once it has been converted into an order.
+// Unlike DeleteReservation the held tickets stay allocated to the order.
+func (s *TicketService) ConsumeReservation(reservationID string) error {
 	ctx := context.Background()
-	cache.Client.Del(ctx, utils.GetReservationKey(reservationID))
 
+	// Claim the hold first so the expiry worker cannot release it concurrently
+	claimed, err := cache.Client.ZRem(ctx, utils.ReservationExpiryIndexKey, reservationID).Result()
+	if err != nil {
+		return fmt.Errorf("failed to consume reservation: %w", err)
+	}
+	if claimed == 0 {
+		return fmt.Errorf("reservation not found or expired")
+	}
+
+	return s.untrackReservation(ctx, reservationID)
+}
+
+// ReleaseTickets returns quantity tickets to a tier's available inventory
+func (s *TicketService) ReleaseTickets(tierID uuid.UUID, quantity int) error {
+	if err := database.DB.Model(&models.TicketTier{}).
+		Where("id = ?", tierID).
+		UpdateColumn("available_quantity", gorm.Expr("available_quantity + ?", quantity)).
+		Error; err != nil {
+		return fmt.Errorf("failed to release tickets: %w", err)
+	}
+
+	publishAvailability(context.Background(), tierID, AvailabilityReleased)
 	return nil
 }
 
-// CreateTicketsFromOrder creates tickets for a paid order
-func (s *TicketService) CreateTicketsFromOrder(orderID, tierID, userID uuid.UUID, quantity int) ([]models.Ticket, error) {
-	tickets := make([]models.Ticket, quantity)
+// ReleaseExpiredReservations restores inventory for holds whose Redis key has
+// expired without being converted into an order. It returns the number of
+// holds released. Claiming each hold with ZREM makes it safe to run on
+// several replicas at once.
+func (s *TicketService) ReleaseExpiredReservations(ctx context.Context) (int, error) {
+	now := fmt.Sprintf("%d", time.Now().Unix())
+	expired, err := cache.Client.ZRangeByScore(ctx, utils.ReservationExpiryIndexKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: now,
+	}).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired reservations: %w", err)
+	}
+
+	released := 0
+	for _, reservationID := range expired {
+		// Only the replica that removes the member releases the hold
+		claimed, err := cache.Client.ZRem(ctx, utils.ReservationExpiryIndexKey, reservationID).Result()
+		if err != nil || claimed == 0 {
+			continue
+		}
+
+		data, err := cache.Client.HGet(ctx, utils.ReservationHoldsKey, reservationID).Result()
+		if err != nil {
+			continue
+		}
+
+		var reservation ReservationData
+		if err := json.Unmarshal([]byte(data), &reservation); err != nil {
+			cache.Client.HDel(ctx, utils.ReservationHoldsKey, reservationID)
+			continue
+		}
+
+		if err := s.ReleaseTickets(reservation.TierID, reservation.Quantity); err != nil {
+			// Put the hold back so the next sweep retries it
+			cache.Client.ZAdd(ctx, utils.ReservationExpiryIndexKey, redis.Z{
+				Score:  float64(reservation.ExpiresAt.Unix()),
+				Member: reservationID,
+			})
+			return released, err
+		}
+
+		cache.Client.HDel(ctx, utils.ReservationHoldsKey, reservationID)
+		cache.Client.Del(ctx, utils.GetReservationKey(reservationID))
+		released++
+	}
+
+	if released > 0 {
+		cache.Client.IncrBy(ctx, utils.ReservationsReleasedMetricKey, int64(released))
+	}
+
+	return released, nil
+}
+
+// untrackReservation removes a reservation and its expiry bookkeeping from Redis
+func (s *TicketService) untrackReservation(ctx context.Context, reservationID string) error {
+	pipe := cache.Client.TxPipeline()
+	pipe.Del(ctx, utils.GetReservationKey(reservationID))
+	pipe.HDel(ctx, utils.ReservationHoldsKey, reservationID)
+	pipe.ZRem(ctx, utils.ReservationExpiryIndexKey, reservationID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// CreateTicketsFromOrder creates tickets for a paid order along with the
+// registration form answers given for each of them
+func (s *TicketService) CreateTicketsFromOrder(order *models.Order) ([]models.Ticket, error) {
+	var tickets []models.Ticket
+	err := database.Transaction(func(tx *gorm.DB) error {
+		var err error
+		tickets, err = createTicketsForOrder(tx, order)
+		if err != nil {
+			return err
+		}
+		_, err = advanceSaga(tx, order.ID, models.SagaFulfill)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// createTicketsForOrder issues an order's tickets and their form answers within tx
+func createTicketsForOrder(tx *gorm.DB, order *models.Order) ([]models.Ticket, error) {
+	var tier models.TicketTier
+	if err := tx.Select("id", "event_id").First(&tier, order.TierID).Error; err != nil {
+		return nil, fmt.Errorf("ticket tier not found: %w", err)
+	}
+
+	tickets := make([]models.Ticket, order.Quantity)
+	var answers []models.TicketAnswer
+	issuedAt := time.Now()
 
-	for i := 0; i < quantity; i++ {
+	for i := 0; i < order.Quantity; i++ {
 		ticketID := uuid.New()
-		qrCode := utils.GenerateQRCode(ticketID)
+		qrCode := ticketqr.Sign(ticketID, tier.EventID, issuedAt)
 
 		tickets[i] = models.Ticket{
 			ID:      ticketID,
-			TierID:  tierID,
-			OrderID: orderID,
-			OwnerID: userID,
+			TierID:  order.TierID,
+			OrderID: order.ID,
+			OwnerID: order.UserID,
 			QRCode:  qrCode,
 			Status:  models.TicketActive,
 		}
+
+		if i < len(order.FormAnswers) {
+			for fieldID, value := range order.FormAnswers[i] {
+				answers = append(answers, models.TicketAnswer{
+					TicketID:    ticketID,
+					FormFieldID: fieldID,
+					Value:       value,
+				})
+			}
+		}
 	}
 
-	// Create all tickets in database
-	if err := database.DB.Create(&tickets).Error; err != nil {
+	// Create all tickets and their answers in database
+	if err := tx.Create(&tickets).Error; err != nil {
 		return nil, fmt.Errorf("failed to create tickets: %w", err)
 	}
+	if len(answers) > 0 {
+		if err := tx.Create(&answers).Error; err != nil {
+			return nil, fmt.Errorf("failed to save form answers: %w", err)
+		}
+	}
+
+	if err := addOutboxEvent(tx, events.OrderPaid, order.ID.String(), events.OrderPaidData{
+		OrderID:     order.ID,
+		UserID:      order.UserID,
+		EventID:     tier.EventID,
+		TierID:      order.TierID,
+		Quantity:    order.Quantity,
+		TotalAmount: order.TotalAmount,
+		Currency:    order.Currency,
+	}); err != nil {
+		return nil, err
+	}
 
 	return tickets, nil
 }
@@ -187,54 +384,302 @@ func (s *TicketService) ProcessOrderPayment(orderID uuid.UUID) error {
 
 // ValidateTicketForCheckin validates a ticket for check-in
 func (s *TicketService) ValidateTicketForCheckin(qrCode string, eventID uuid.UUID) (*models.Ticket, error) {
+	ticket, err := s.findTicketForEvent(qrCode, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateEntry(ticket); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// ValidateTicketForSession validates a ticket for check-in to a session of
+// eventID. Tickets already checked in to the event itself remain valid.
+func (s *TicketService) ValidateTicketForSession(qrCode string, eventID uuid.UUID) (*models.Ticket, error) {
+	ticket, err := s.findTicketForEvent(qrCode, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSessionEntry(ticket); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// ValidateTicketForZone validates a ticket scanned at the entrance of a zone
+// of eventID. Tickets already checked in at the main gate remain valid.
+func (s *TicketService) ValidateTicketForZone(qrCode string, eventID uuid.UUID) (*models.Ticket, error) {
+	return s.ValidateTicketForSession(qrCode, eventID)
+}
+
+// ValidateTicketForCheckout looks up a ticket scanned on its way out of
+// eventID; CheckOutTicket checks it can leave
+func (s *TicketService) ValidateTicketForCheckout(qrCode string, eventID uuid.UUID) (*models.Ticket, error) {
+	return s.findTicketForEvent(qrCode, eventID)
+}
+
+// ValidateTicketForManualCheckin validates a ticket picked by ID from an
+// attendee search, for the event or, when reentry is set, one of its
+// sessions or zones
+func (s *TicketService) ValidateTicketForManualCheckin(ticketID, eventID uuid.UUID, reentry bool) (*models.Ticket, error) {
+	var ticket models.Ticket
+	if err := database.DB.Preload("Tier").Preload("Tier.Event").First(&ticket, ticketID).Error; err != nil || ticket.Tier.EventID != eventID {
+		return nil, utils.NotFoundError("ticket not found for this event")
+	}
+
+	validate := validateEntry
+	if reentry {
+		validate = validateSessionEntry
+	}
+	if err := validate(&ticket); err != nil {
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// validateEntry checks a ticket can be checked in to its event. Used tickets
+// may come back in when the event allows re-entry; CheckInTicket then checks
+// they were scanned out. ticket.Tier.Event must be loaded for that.
+func validateEntry(ticket *models.Ticket) error {
+	if ticket.Status == models.TicketUsed && !ticket.Tier.Event.AllowReentry {
+		return alreadyScannedError(context.Background(), ticket.ID, nil)
+	}
+	if ticket.Status == models.TicketUsed {
+		return nil
+	}
+	if ticket.Status != models.TicketActive {
+		return utils.BadRequestError("ticket is %s", ticket.Status)
+	}
+	return nil
+}
+
+// validateSessionEntry checks a ticket can be checked in to a session
+func validateSessionEntry(ticket *models.Ticket) error {
+	if ticket.Status != models.TicketActive && ticket.Status != models.TicketUsed {
+		return utils.BadRequestError("ticket is %s", ticket.Status)
+	}
+	return nil
+}
+
+// findTicketForEvent looks up the ticket behind a QR code and checks it is for eventID
+func (s *TicketService) findTicketForEvent(qrCode string, eventID uuid.UUID) (*models.Ticket, error) {
+	// Reject forged or mismatched signed payloads before touching the database
+	if ticketqr.IsSigned(qrCode) {
+		payload, err := ticketqr.Verify(qrCode)
+		if err != nil {
+			return nil, utils.BadRequestError("invalid QR code")
+		}
+		if payload.EventID != eventID {
+			return nil, utils.BadRequestError("QR code is not for this event")
+		}
+	}
+
 	var ticket models.Ticket
 	if err := database.DB.Where("qr_code = ?", qrCode).
 		Preload("Tier").
 		Preload("Tier.Event").
 		First(&ticket).Error; err != nil {
-		return nil, fmt.Errorf("invalid QR code")
+		return nil, utils.BadRequestError("invalid QR code")
 	}
 
 	// Verify event matches
 	if ticket.Tier.EventID != eventID {
-		return nil, fmt.Errorf("QR code is not for this event")
+		return nil, utils.BadRequestError("QR code is not for this event")
 	}
 
-	// Check if already checked in
-	if ticket.Status == models.TicketUsed {
-		return nil, fmt.Errorf("ticket already checked in")
+	return &ticket, nil
+}
+
+// CheckInTicket marks a ticket as checked in. The ticket only moves from
+// active to used once, so of two simultaneous scans the second is told when
+// and where the first happened. At events that allow re-entry, a used ticket
+// is checked in again if its last scan was on the way out. Entry is refused
+// while the event is at its occupancy limit.
+func (s *TicketService) CheckInTicket(ctx context.Context, ticket *models.Ticket, validatorID uuid.UUID, eventID uuid.UUID, zoneID *uuid.UUID, method models.CheckinMethod, device string) (*models.Checkin, error) {
+	now := time.Now()
+	checkin := models.Checkin{
+		TicketID:   ticket.ID,
+		EventID:    eventID,
+		ZoneID:     zoneID,
+		ScannedBy:  validatorID,
+		ScannedAt:  now,
+		DeviceInfo: device,
+		Method:     method,
+		Direction:  models.CheckinIn,
 	}
 
-	// Check if ticket is active
-	if ticket.Status != models.TicketActive {
-		return nil, fmt.Errorf("ticket is %s", ticket.Status)
+	occupancy := NewOccupancyService()
+	if err := occupancy.EnterEvent(ctx, eventID, ticket.Tier.Event.MaxOccupancy); err != nil {
+		return nil, err
 	}
 
-	return &ticket, nil
-}
+	admitted, firstEntry := true, true
+	err := database.Transaction(func(tx *gorm.DB) error {
+		result := tx.WithContext(ctx).Model(&models.Ticket{}).
+			Where("id = ? AND status = ?", ticket.ID, models.TicketActive).
+			Updates(map[string]interface{}{"status": models.TicketUsed, "checked_in_at": now})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update ticket: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			if !ticket.Tier.Event.AllowReentry {
+				admitted = false
+				return nil
+			}
+			firstEntry = false
+			if err := lockForReentry(ctx, tx, ticket.ID, models.CheckinOut); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.WithContext(ctx).Create(&checkin).Error; err != nil {
+			return fmt.Errorf("failed to create check-in record: %w", err)
+		}
+		if !firstEntry {
+			return nil
+		}
+		if err := queueCheckinBadge(tx.WithContext(ctx), &checkin); err != nil {
+			return err
+		}
+		return addOutboxEvent(tx.WithContext(ctx), events.TicketCheckedIn, ticket.ID.String(), events.TicketCheckedInData{
+			TicketID:  ticket.ID,
+			EventID:   eventID,
+			TierID:    ticket.TierID,
+			ScannedBy: validatorID,
+			ScannedAt: now,
+		})
+	})
+	if err != nil {
+		occupancy.LeaveEvent(ctx, eventID)
+		return nil, err
+	}
+
+	if !admitted {
+		occupancy.LeaveEvent(ctx, eventID)
+		// Another scan, a refund or a cancellation got there first
+		var current models.Ticket
+		if err := database.DB.WithContext(ctx).Select("id", "status").First(&current, ticket.ID).Error; err != nil {
+			return nil, fmt.Errorf("failed to fetch ticket: %w", err)
+		}
+		if err := validateEntry(&current); err != nil {
+			return nil, err
+		}
+		return nil, utils.ConflictError("ticket changed during check-in; scan it again")
+	}
+
+	publishCheckin(ctx, ticket, &checkin)
+	if !firstEntry {
+		return &checkin, nil
+	}
 
-// CheckInTicket marks a ticket as checked in
-func (s *TicketService) CheckInTicket(ticket *models.Ticket, validatorID uuid.UUID, eventID uuid.UUID) (*models.Checkin, error) {
-	// Update ticket status
 	ticket.Status = models.TicketUsed
-	now := time.Now()
 	ticket.CheckedInAt = &now
 
-	if err := database.DB.Save(ticket).Error; err != nil {
-		return nil, fmt.Errorf("failed to update ticket: %w", err)
+	NewOrganizerWebhookService().Emit(ctx, eventID, models.WebhookAttendeeCheckedIn, WebhookAttendeeCheckedInData{
+		TicketID:    ticket.ID,
+		CheckinID:   checkin.ID,
+		EventID:     eventID,
+		TierID:      ticket.TierID,
+		Method:      checkin.Method,
+		CheckedInAt: now,
+	})
+
+	return &checkin, nil
+}
+
+// IsInside reports whether a ticket's last scan at its event's entrance was
+// on the way in. Tickets without scans are outside.
+func (s *TicketService) IsInside(ctx context.Context, ticketID uuid.UUID) (bool, error) {
+	var last []models.Checkin
+	if err := database.DB.WithContext(ctx).
+		Where("ticket_id = ? AND session_id IS NULL", ticketID).
+		Order("scanned_at DESC").
+		Limit(1).
+		Find(&last).Error; err != nil {
+		return false, fmt.Errorf("failed to fetch last scan: %w", err)
+	}
+	return len(last) > 0 && last[0].Direction == models.CheckinIn, nil
+}
+
+// CheckOutTicket records a checked-in ticket leaving an event that allows
+// re-entry, so it can be scanned back in later
+func (s *TicketService) CheckOutTicket(ctx context.Context, ticket *models.Ticket, validatorID uuid.UUID, eventID uuid.UUID, method models.CheckinMethod, device string) (*models.Checkin, error) {
+	if !ticket.Tier.Event.AllowReentry {
+		return nil, utils.BadRequestError("event does not allow re-entry")
+	}
+	if ticket.Status == models.TicketActive {
+		return nil, utils.BadRequestError("ticket has not been checked in")
+	}
+	if ticket.Status != models.TicketUsed {
+		return nil, utils.BadRequestError("ticket is %s", ticket.Status)
 	}
 
-	// Create check-in record
 	checkin := models.Checkin{
-		TicketID:  ticket.ID,
-		EventID:   eventID,
-		ScannedBy: validatorID,
-		ScannedAt: now,
+		TicketID:   ticket.ID,
+		EventID:    eventID,
+		ScannedBy:  validatorID,
+		ScannedAt:  time.Now(),
+		DeviceInfo: device,
+		Method:     method,
+		Direction:  models.CheckinOut,
 	}
 
-	if err := database.DB.Create(&checkin).Error; err != nil {
-		return nil, fmt.Errorf("failed to create check-in record: %w", err)
+	err := database.Transaction(func(tx *gorm.DB) error {
+		if err := lockForReentry(ctx, tx, ticket.ID, models.CheckinIn); err != nil {
+			return err
+		}
+		if err := tx.WithContext(ctx).Create(&checkin).Error; err != nil {
+			return fmt.Errorf("failed to create check-out record: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	occupancy := NewOccupancyService()
+	occupancy.LeaveEvent(ctx, eventID)
+	occupancy.LeaveZones(ctx, eventID, ticket.ID)
+	publishCheckin(ctx, ticket, &checkin)
 	return &checkin, nil
 }
+
+// lockForReentry locks a used ticket so concurrent scans are recorded one at
+// a time, and checks its last event scan went in direction want
+func lockForReentry(ctx context.Context, tx *gorm.DB, ticketID uuid.UUID, want models.CheckinDirection) error {
+	var current models.Ticket
+	if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "status").
+		First(&current, ticketID).Error; err != nil {
+		return fmt.Errorf("failed to lock ticket: %w", err)
+	}
+	if current.Status != models.TicketUsed {
+		return utils.BadRequestError("ticket is %s", current.Status)
+	}
+
+	var last []models.Checkin
+	if err := tx.WithContext(ctx).
+		Where("ticket_id = ? AND session_id IS NULL", ticketID).
+		Order("scanned_at DESC").
+		Limit(1).
+		Find(&last).Error; err != nil {
+		return fmt.Errorf("failed to fetch last scan: %w", err)
+	}
+
+	// A ticket without scans counts as outside
+	direction := models.CheckinOut
+	if len(last) > 0 {
+		direction = last[0].Direction
+	}
+	switch {
+	case direction == want:
+		return nil
+	case direction == models.CheckinIn:
+		return alreadyScannedError(ctx, ticketID, nil)
+	case len(last) == 0:
+		return utils.BadRequestError("ticket has not been checked in")
+	default:
+		return utils.ConflictError("ticket was already scanned out at %s", last[0].ScannedAt.UTC().Format("15:04 MST"))
+	}
+}
@@ -0,0 +1,54 @@
+//go:build integration
+
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/internal/testutil"
+)
+
+func TestIsInsideAcrossReentry(t *testing.T) {
+	reset(t)
+	ctx := context.Background()
+
+	organizer := testutil.CreateOrganizer(t)
+	event := testutil.CreateEvent(t, organizer, 5000, 10)
+	if err := env.DB.Model(event).Update("allow_reentry", true).Error; err != nil {
+		t.Fatalf("allow re-entry: %v", err)
+	}
+	buyer := testutil.CreateUser(t, models.RoleAttendee)
+	order := testutil.CreateOrder(t, buyer, &event.TicketTiers[0], 1)
+	ticket := testutil.PayOrder(t, services.NewPaymentService(env.Config), order)[0]
+
+	tickets := services.NewTicketService()
+	expectInside := func(want bool) {
+		t.Helper()
+		inside, err := tickets.IsInside(ctx, ticket.ID)
+		if err != nil {
+			t.Fatalf("is inside: %v", err)
+		}
+		if inside != want {
+			t.Errorf("inside = %v, want %v", inside, want)
+		}
+	}
+
+	expectInside(false)
+	testutil.CheckIn(t, &organizer.User, event, &ticket)
+	expectInside(true)
+
+	validated, err := tickets.ValidateTicketForCheckin(ticket.QRCode, event.ID)
+	if err != nil {
+		t.Fatalf("validate ticket: %v", err)
+	}
+	if _, err := tickets.CheckOutTicket(ctx, validated, organizer.UserID, event.ID, models.CheckinQR, "tests"); err != nil {
+		t.Fatalf("check out ticket: %v", err)
+	}
+	expectInside(false)
+
+	testutil.CheckIn(t, &organizer.User, event, &ticket)
+	expectInside(true)
+}
@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+// TierInput describes a new ticket tier
+type TierInput struct {
+	Name        string
+	Description string
+	Price       int64 // minor units
+	Quantity    int
+}
+
+// TierUpdate holds the tier fields to change; nil fields are left as they are
+type TierUpdate struct {
+	Name        *string
+	Description *string
+	Price       *int64
+	Quantity    *int
+}
+
+// PricePhaseInput describes one price phase of a ticket tier
+type PricePhaseInput struct {
+	Name          string
+	Price         int64 // minor units
+	StartTime     *time.Time
+	EndTime       *time.Time
+	QuantityLimit int
+}
+
+// TierService manages the ticket tiers of an event after it has been created
+type TierService struct{}
+
+// NewTierService creates a new tier service
+func NewTierService() *TierService {
+	return &TierService{}
+}
+
+// GetTier returns a ticket tier by ID with its price phases
+func (s *TierService) GetTier(ctx context.Context, tierID uuid.UUID) (*models.TicketTier, error) {
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Preload("PricePhases", orderPricePhases).First(&tier, tierID).Error; err != nil {
+		return nil, fmt.Errorf("ticket tier not found")
+	}
+	return &tier, nil
+}
+
+// CreateTier adds a ticket tier to an event that is still open for changes
+func (s *TierService) CreateTier(ctx context.Context, eventID uuid.UUID, input TierInput) (*models.TicketTier, error) {
+	event, err := s.ensureEditable(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	tier := models.TicketTier{
+		EventID:           eventID,
+		TierName:          input.Name,
+		Description:       input.Description,
+		Price:             input.Price,
+		Currency:          event.Currency,
+		TotalQuantity:     input.Quantity,
+		AvailableQuantity: input.Quantity,
+	}
+	if err := database.DB.WithContext(ctx).Create(&tier).Error; err != nil {
+		return nil, fmt.Errorf("failed to create ticket tier: %w", err)
+	}
+
+	publishEventAvailability(ctx, eventID, AvailabilityUpdated)
+
+	return &tier, nil
+}
+
+// UpdateTier applies update to a tier. The quantity cannot drop below the number
+// of tickets already sold or reserved, and the price is frozen once sales begin.
+func (s *TierService) UpdateTier(ctx context.Context, tierID uuid.UUID, update TierUpdate) (*models.TicketTier, error) {
+	var tier models.TicketTier
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.WithContext(ctx).First(&tier, tierID).Error; err != nil {
+			return fmt.Errorf("ticket tier not found")
+		}
+
+		if _, err := s.ensureEditable(ctx, tier.EventID); err != nil {
+			return err
+		}
+
+		sold := tier.TotalQuantity - tier.AvailableQuantity
+		updates := map[string]interface{}{}
+
+		if update.Name != nil {
+			updates["tier_name"] = *update.Name
+		}
+		if update.Description != nil {
+			updates["description"] = *update.Description
+		}
+		if update.Price != nil && *update.Price != tier.Price {
+			if sold > 0 {
+				return fmt.Errorf("price cannot be changed after tickets have been sold")
+			}
+			updates["price"] = *update.Price
+		}
+		if update.Quantity != nil && *update.Quantity != tier.TotalQuantity {
+			if *update.Quantity < sold {
+				return fmt.Errorf("quantity cannot be less than the %d tickets already sold", sold)
+			}
+			updates["total_quantity"] = *update.Quantity
+			updates["available_quantity"] = gorm.Expr("available_quantity + ?", *update.Quantity-tier.TotalQuantity)
+		}
+
+		if len(updates) == 0 {
+			return nil
+		}
+
+		// Guard against reservations made since the tier was read
+		result := tx.WithContext(ctx).Model(&models.TicketTier{}).
+			Where("id = ? AND available_quantity = ?", tier.ID, tier.AvailableQuantity).
+			Updates(updates)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update ticket tier: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("ticket tier changed concurrently, please retry")
+		}
+
+		return tx.WithContext(ctx).Preload("PricePhases", orderPricePhases).First(&tier, tier.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEventAvailability(ctx, tier.EventID, AvailabilityUpdated)
+
+	return &tier, nil
+}
+
+// DeleteTier removes a tier that has no sold or reserved tickets. An event
+// always keeps at least one tier.
+func (s *TierService) DeleteTier(ctx context.Context, tierID uuid.UUID) error {
+	var tier models.TicketTier
+
+	err := database.Transaction(func(tx *gorm.DB) error {
+		if err := tx.WithContext(ctx).First(&tier, tierID).Error; err != nil {
+			return fmt.Errorf("ticket tier not found")
+		}
+
+		if _, err := s.ensureEditable(ctx, tier.EventID); err != nil {
+			return err
+		}
+
+		if tier.TotalQuantity != tier.AvailableQuantity {
+			return fmt.Errorf("cannot delete a tier with sold or reserved tickets")
+		}
+
+		var remaining int64
+		if err := tx.WithContext(ctx).Model(&models.TicketTier{}).
+			Where("event_id = ? AND id <> ?", tier.EventID, tier.ID).
+			Count(&remaining).Error; err != nil {
+			return fmt.Errorf("failed to count ticket tiers: %w", err)
+		}
+		if remaining == 0 {
+			return fmt.Errorf("an event must have at least one ticket tier")
+		}
+
+		result := tx.WithContext(ctx).
+			Where("id = ? AND available_quantity = total_quantity", tier.ID).
+			Delete(&models.TicketTier{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete ticket tier: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("cannot delete a tier with sold or reserved tickets")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	publishEventAvailability(ctx, tier.EventID, AvailabilityUpdated)
+	return nil
+}
+
+// SetPricePhases replaces a tier's price phases, which apply in the order
+// given. Reservations already made keep the price they were held at.
+func (s *TierService) SetPricePhases(ctx context.Context, tierID uuid.UUID, inputs []PricePhaseInput) (*models.TicketTier, error) {
+	tier, err := s.GetTier(ctx, tierID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.ensureEditable(ctx, tier.EventID); err != nil {
+		return nil, err
+	}
+
+	phases := make([]models.PricePhase, len(inputs))
+	for i, input := range inputs {
+		phases[i] = models.PricePhase{
+			TierID:        tier.ID,
+			Name:          strings.TrimSpace(input.Name),
+			Price:         input.Price,
+			StartTime:     input.StartTime,
+			EndTime:       input.EndTime,
+			QuantityLimit: input.QuantityLimit,
+			Position:      i,
+		}
+		if err := validatePricePhase(&phases[i], tier); err != nil {
+			return nil, err
+		}
+	}
+
+	err = database.Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+		if err := tx.Where("tier_id = ?", tier.ID).Delete(&models.PricePhase{}).Error; err != nil {
+			return fmt.Errorf("failed to clear price phases: %w", err)
+		}
+		if len(phases) > 0 {
+			if err := tx.Create(&phases).Error; err != nil {
+				return fmt.Errorf("failed to save price phases: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEventAvailability(ctx, tier.EventID, AvailabilityUpdated)
+
+	tier.PricePhases = phases
+	return tier, nil
+}
+
+func validatePricePhase(phase *models.PricePhase, tier *models.TicketTier) error {
+	if phase.Name == "" {
+		return fmt.Errorf("every price phase needs a name")
+	}
+	if phase.Price < 0 {
+		return fmt.Errorf("price phase %s cannot have a negative price", phase.Name)
+	}
+	if phase.StartTime != nil && phase.EndTime != nil && !phase.EndTime.After(*phase.StartTime) {
+		return fmt.Errorf("price phase %s must end after it starts", phase.Name)
+	}
+	if phase.QuantityLimit < 0 || phase.QuantityLimit > tier.TotalQuantity {
+		return fmt.Errorf("price phase %s quantity limit must be between 0 and %d", phase.Name, tier.TotalQuantity)
+	}
+	if phase.StartTime == nil && phase.EndTime == nil && phase.QuantityLimit == 0 {
+		return fmt.Errorf("price phase %s needs an end time or a quantity limit", phase.Name)
+	}
+	return nil
+}
+
+func orderPricePhases(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
+
+// ensureEditable rejects tier changes on events that have finished or been
+// cancelled or banned
+func (s *TierService) ensureEditable(ctx context.Context, eventID uuid.UUID) (*models.Event, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Select("id", "status", "currency").First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if event.Status == models.EventCompleted || event.Status == models.EventCancelled || event.Status == models.EventBanned {
+		return nil, fmt.Errorf("tiers of a %s event cannot be changed", event.Status)
+	}
+
+	return &event, nil
+}
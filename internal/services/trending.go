@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/logger"
+)
+
+const (
+	// trendingWindow is how far back sales count towards trending
+	trendingWindow = 24
+
+	// trendingBucket is the width of each sales counter
+	trendingBucket = time.Hour
+)
+
+// trendingKey is the sorted set of ticket sales per event in the bucket
+// starting at start
+func trendingKey(start time.Time) string {
+	return "trending:" + strconv.FormatInt(start.Unix(), 10)
+}
+
+// RecordTrendingSale counts quantity tickets sold for an event towards its
+// trending score. Sales are bucketed by hour and expire with the window.
+func RecordTrendingSale(ctx context.Context, eventID uuid.UUID, quantity int) {
+	if quantity <= 0 {
+		return
+	}
+
+	key := trendingKey(time.Now().Truncate(trendingBucket))
+	pipe := cache.Client.TxPipeline()
+	pipe.ZIncrBy(ctx, key, float64(quantity), eventID.String())
+	pipe.Expire(ctx, key, (trendingWindow+1)*trendingBucket)
+	if _, err := pipe.Exec(ctx); err != nil {
+		// Trending is best effort; the sale itself has gone through
+		logger.WithContext(ctx).Warn("Failed to record trending sale", zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+}
+
+// TrendingEventIDs returns up to limit events with the highest recent sales
+// velocity, best first. Each hour's sales count less the older they are, so
+// an event selling fast right now outranks one that sold well yesterday.
+// Events that have since ended or been unpublished are included, so callers
+// should ask for more than they show.
+func TrendingEventIDs(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	now := time.Now().Truncate(trendingBucket)
+	store := redis.ZStore{Aggregate: "SUM"}
+	for age := 0; age < trendingWindow; age++ {
+		store.Keys = append(store.Keys, trendingKey(now.Add(-time.Duration(age)*trendingBucket)))
+		store.Weights = append(store.Weights, float64(trendingWindow-age)/trendingWindow)
+	}
+
+	scores, err := cache.Client.ZUnionWithScores(ctx, store).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch trending events: %w", err)
+	}
+
+	// ZUNION sorts by ascending score
+	var ids []uuid.UUID
+	for i := len(scores) - 1; i >= 0 && len(ids) < limit; i-- {
+		member, _ := scores[i].Member.(string)
+		id, err := uuid.Parse(member)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
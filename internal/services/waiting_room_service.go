@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/utils"
+)
+
+// WaitingRoomStatus is where a queue token stands
+type WaitingRoomStatus string
+
+const (
+	WaitingRoomWaiting  WaitingRoomStatus = "waiting"
+	WaitingRoomAdmitted WaitingRoomStatus = "admitted"
+	WaitingRoomExpired  WaitingRoomStatus = "expired"
+)
+
+// WaitingRoomPosition describes a user's place in an event's waiting room
+type WaitingRoomPosition struct {
+	EventID       uuid.UUID         `json:"event_id"`
+	Token         string            `json:"token"`
+	Status        WaitingRoomStatus `json:"status"`
+	Position      int64             `json:"position,omitempty"`       // 1-based, while waiting
+	EstimatedWait int64             `json:"estimated_wait,omitempty"` // seconds, while waiting
+	AdmittedUntil *time.Time        `json:"admitted_until,omitempty"`
+}
+
+// WaitingRoomService queues buyers for high-demand events and admits them
+// in batches so only a bounded number can reserve tickets at once
+type WaitingRoomService struct {
+	cfg *config.LimitsConfig
+}
+
+// NewWaitingRoomService creates a new waiting room service
+func NewWaitingRoomService(cfg *config.LimitsConfig) *WaitingRoomService {
+	return &WaitingRoomService{cfg: cfg}
+}
+
+// SetEnabled opens or closes the waiting room of an event. Closing it
+// discards every queued and admitted token.
+func (s *WaitingRoomService) SetEnabled(ctx context.Context, eventID uuid.UUID, enabled bool) error {
+	if err := database.DB.WithContext(ctx).Model(&models.Event{}).
+		Where("id = ?", eventID).
+		Update("waiting_room", enabled).Error; err != nil {
+		return fmt.Errorf("failed to update waiting room: %w", err)
+	}
+
+	if enabled {
+		return cache.Client.SAdd(ctx, utils.WaitingRoomEventsKey, eventID.String()).Err()
+	}
+
+	pipe := cache.Client.TxPipeline()
+	pipe.SRem(ctx, utils.WaitingRoomEventsKey, eventID.String())
+	pipe.Del(ctx,
+		utils.WaitingRoomQueueKey(eventID),
+		utils.WaitingRoomAdmittedKey(eventID),
+		utils.WaitingRoomTokensKey(eventID),
+		utils.WaitingRoomUsersKey(eventID),
+		utils.WaitingRoomSequenceKey(eventID),
+	)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear waiting room: %w", err)
+	}
+	return nil
+}
+
+// Join puts a user in an event's waiting room and returns their position.
+// Joining again returns the existing token until its admission expires.
+func (s *WaitingRoomService) Join(ctx context.Context, eventID, userID uuid.UUID) (*WaitingRoomPosition, error) {
+	var event models.Event
+	if err := database.DB.WithContext(ctx).Select("id", "status", "waiting_room").First(&event, eventID).Error; err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+	if !event.WaitingRoom {
+		return nil, fmt.Errorf("this event does not use a waiting room")
+	}
+	if event.Status != models.EventPublished {
+		return nil, fmt.Errorf("event is not available for booking")
+	}
+
+	usersKey := utils.WaitingRoomUsersKey(eventID)
+	if token, err := cache.Client.HGet(ctx, usersKey, userID.String()).Result(); err == nil {
+		position, err := s.position(ctx, eventID, token)
+		if err != nil {
+			return nil, err
+		}
+		if position.Status != WaitingRoomExpired {
+			return position, nil
+		}
+		// The admission lapsed; requeue at the back
+		cache.Client.HDel(ctx, utils.WaitingRoomTokensKey(eventID), token)
+		cache.Client.HDel(ctx, usersKey, userID.String())
+	} else if err != redis.Nil {
+		return nil, fmt.Errorf("failed to join waiting room: %w", err)
+	}
+
+	token := uuid.New().String()
+	claimed, err := cache.Client.HSetNX(ctx, usersKey, userID.String(), token).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to join waiting room: %w", err)
+	}
+	if !claimed {
+		// A concurrent join from the same user won
+		existing, err := cache.Client.HGet(ctx, usersKey, userID.String()).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to join waiting room: %w", err)
+		}
+		return s.position(ctx, eventID, existing)
+	}
+
+	seq, err := cache.Client.Incr(ctx, utils.WaitingRoomSequenceKey(eventID)).Result()
+	if err != nil {
+		cache.Client.HDel(ctx, usersKey, userID.String())
+		return nil, fmt.Errorf("failed to join waiting room: %w", err)
+	}
+
+	pipe := cache.Client.TxPipeline()
+	pipe.HSet(ctx, utils.WaitingRoomTokensKey(eventID), token, userID.String())
+	pipe.ZAdd(ctx, utils.WaitingRoomQueueKey(eventID), redis.Z{Score: float64(seq), Member: token})
+	pipe.SAdd(ctx, utils.WaitingRoomEventsKey, eventID.String())
+	if _, err := pipe.Exec(ctx); err != nil {
+		cache.Client.HDel(ctx, usersKey, userID.String())
+		return nil, fmt.Errorf("failed to join waiting room: %w", err)
+	}
+
+	return s.position(ctx, eventID, token)
+}
+
+// Position returns where a user's token stands in an event's waiting room
+func (s *WaitingRoomService) Position(ctx context.Context, eventID, userID uuid.UUID, token string) (*WaitingRoomPosition, error) {
+	if err := s.verifyOwner(ctx, eventID, userID, token); err != nil {
+		return nil, err
+	}
+	return s.position(ctx, eventID, token)
+}
+
+// CheckAdmission allows a reservation on tierID only if its event has no
+// waiting room or the user holds a currently admitted token
+func (s *WaitingRoomService) CheckAdmission(ctx context.Context, tierID, userID uuid.UUID, token string) error {
+	var tier models.TicketTier
+	if err := database.DB.WithContext(ctx).Preload("Event").First(&tier, tierID).Error; err != nil {
+		// Unknown tiers are reported by the reservation itself
+		return nil
+	}
+	// With the waiting room switched off platform-wide, everyone may reserve
+	if !tier.Event.WaitingRoom || !NewFeatureFlagService().IsEnabled(ctx, FeatureWaitingRoom) {
+		return nil
+	}
+
+	if token == "" {
+		return fmt.Errorf("this event uses a waiting room; join the queue before reserving")
+	}
+	if err := s.verifyOwner(ctx, tier.EventID, userID, token); err != nil {
+		return err
+	}
+
+	position, err := s.position(ctx, tier.EventID, token)
+	if err != nil {
+		return err
+	}
+	switch position.Status {
+	case WaitingRoomWaiting:
+		return fmt.Errorf("you are still in the waiting room (position %d)", position.Position)
+	case WaitingRoomExpired:
+		return fmt.Errorf("your waiting room admission has expired; join the queue again")
+	}
+	return nil
+}
+
+// AdmitNext admits the next batch of every open waiting room and drops
+// lapsed admissions. A per-event lock keeps the admission rate the same no
+// matter how many replicas run the worker. It returns the number admitted.
+func (s *WaitingRoomService) AdmitNext(ctx context.Context) (int, error) {
+	eventIDs, err := cache.Client.SMembers(ctx, utils.WaitingRoomEventsKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list waiting rooms: %w", err)
+	}
+
+	admitted := 0
+	for _, raw := range eventIDs {
+		eventID, err := uuid.Parse(raw)
+		if err != nil {
+			cache.Client.SRem(ctx, utils.WaitingRoomEventsKey, raw)
+			continue
+		}
+
+		// Slightly shorter than the interval so the next tick can always take it
+		lock := s.cfg.WaitingRoomAdmitInterval * 9 / 10
+		acquired, err := cache.Client.SetNX(ctx, utils.WaitingRoomAdmitLockKey(eventID), "1", lock).Result()
+		if err != nil {
+			return admitted, fmt.Errorf("failed to lock waiting room: %w", err)
+		}
+		if !acquired {
+			continue
+		}
+
+		now := time.Now()
+		cache.Client.ZRemRangeByScore(ctx, utils.WaitingRoomAdmittedKey(eventID), "-inf", strconv.FormatInt(now.Unix(), 10))
+
+		next, err := cache.Client.ZPopMin(ctx, utils.WaitingRoomQueueKey(eventID), int64(s.cfg.WaitingRoomBatchSize)).Result()
+		if err != nil {
+			return admitted, fmt.Errorf("failed to admit from waiting room: %w", err)
+		}
+		if len(next) == 0 {
+			continue
+		}
+
+		expiresAt := float64(now.Add(s.cfg.WaitingRoomAdmission).Unix())
+		members := make([]redis.Z, 0, len(next))
+		for _, z := range next {
+			members = append(members, redis.Z{Score: expiresAt, Member: z.Member})
+		}
+		if err := cache.Client.ZAdd(ctx, utils.WaitingRoomAdmittedKey(eventID), members...).Err(); err != nil {
+			// Put the batch back at the front so nobody loses their place
+			cache.Client.ZAdd(ctx, utils.WaitingRoomQueueKey(eventID), next...)
+			return admitted, fmt.Errorf("failed to admit from waiting room: %w", err)
+		}
+		admitted += len(next)
+	}
+
+	return admitted, nil
+}
+
+func (s *WaitingRoomService) verifyOwner(ctx context.Context, eventID, userID uuid.UUID, token string) error {
+	owner, err := cache.Client.HGet(ctx, utils.WaitingRoomTokensKey(eventID), token).Result()
+	if err == redis.Nil || (err == nil && owner != userID.String()) {
+		return fmt.Errorf("invalid waiting room token")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check waiting room token: %w", err)
+	}
+	return nil
+}
+
+func (s *WaitingRoomService) position(ctx context.Context, eventID uuid.UUID, token string) (*WaitingRoomPosition, error) {
+	position := &WaitingRoomPosition{EventID: eventID, Token: token}
+
+	expiry, err := cache.Client.ZScore(ctx, utils.WaitingRoomAdmittedKey(eventID), token).Result()
+	if err == nil {
+		until := time.Unix(int64(expiry), 0)
+		if until.After(time.Now()) {
+			position.Status = WaitingRoomAdmitted
+			position.AdmittedUntil = &until
+		} else {
+			position.Status = WaitingRoomExpired
+		}
+		return position, nil
+	}
+	if err != redis.Nil {
+		return nil, fmt.Errorf("failed to read waiting room: %w", err)
+	}
+
+	rank, err := cache.Client.ZRank(ctx, utils.WaitingRoomQueueKey(eventID), token).Result()
+	if err == redis.Nil {
+		position.Status = WaitingRoomExpired
+		return position, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read waiting room: %w", err)
+	}
+
+	position.Status = WaitingRoomWaiting
+	position.Position = rank + 1
+	if s.cfg.WaitingRoomBatchSize > 0 {
+		batches := rank/int64(s.cfg.WaitingRoomBatchSize) + 1
+		position.EstimatedWait = batches * int64(s.cfg.WaitingRoomAdmitInterval/time.Second)
+	}
+	return position, nil
+}
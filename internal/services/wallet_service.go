@@ -0,0 +1,395 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/smallstep/pkcs7"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+)
+
+const (
+	// PassContentType is the MIME type of Apple Wallet passes
+	PassContentType = "application/vnd.apple.pkpass"
+
+	googleWalletSaveURL = "https://pay.google.com/gp/v/save/"
+)
+
+// brandColor is the background of generated passes and their icon
+var brandColor = color.RGBA{R: 79, G: 70, B: 229, A: 255}
+
+// WalletService builds Apple Wallet passes and Google Wallet save links for tickets
+type WalletService struct {
+	cfg     *config.WalletConfig
+	origins []string
+}
+
+// NewWalletService creates a new wallet service. origins are the web origins
+// allowed to render the Google Wallet save button.
+func NewWalletService(cfg *config.WalletConfig, origins ...string) *WalletService {
+	return &WalletService{
+		cfg:     cfg,
+		origins: origins,
+	}
+}
+
+// AppleEnabled reports whether Apple Wallet signing is configured
+func (s *WalletService) AppleEnabled() bool {
+	return s.cfg.AppleTeamID != "" && s.cfg.ApplePassTypeID != "" &&
+		s.cfg.AppleCertFile != "" && s.cfg.AppleKeyFile != "" && s.cfg.AppleWWDRCertFile != ""
+}
+
+// GoogleEnabled reports whether Google Wallet signing is configured
+func (s *WalletService) GoogleEnabled() bool {
+	return s.cfg.GoogleIssuerID != "" && s.cfg.GoogleCredentialsFile != ""
+}
+
+// ApplePass builds a signed .pkpass bundle for an active ticket
+func (s *WalletService) ApplePass(ctx context.Context, ticketID uuid.UUID) ([]byte, error) {
+	if !s.AppleEnabled() {
+		return nil, fmt.Errorf("apple wallet is not configured")
+	}
+
+	ticket, err := loadWalletTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	passJSON, err := json.Marshal(s.applePassDefinition(ticket))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode pass: %w", err)
+	}
+
+	files := map[string][]byte{"pass.json": passJSON}
+	for name, size := range map[string]int{"icon.png": 29, "icon@2x.png": 58, "icon@3x.png": 87} {
+		icon, err := passIcon(size)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = icon
+	}
+
+	manifest := make(map[string]string, len(files))
+	for name, content := range files {
+		sum := sha1.Sum(content)
+		manifest[name] = hex.EncodeToString(sum[:])
+	}
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode pass manifest: %w", err)
+	}
+	files["manifest.json"] = manifestJSON
+
+	signature, err := s.signManifest(manifestJSON)
+	if err != nil {
+		return nil, err
+	}
+	files["signature"] = signature
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := archive.Create(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build pass: %w", err)
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, fmt.Errorf("failed to build pass: %w", err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build pass: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GoogleSaveURL returns an "Add to Google Wallet" link carrying a signed JWT
+// that defines the event class and the ticket object
+func (s *WalletService) GoogleSaveURL(ctx context.Context, ticketID uuid.UUID) (string, error) {
+	if !s.GoogleEnabled() {
+		return "", fmt.Errorf("google wallet is not configured")
+	}
+
+	ticket, err := loadWalletTicket(ctx, ticketID)
+	if err != nil {
+		return "", err
+	}
+
+	raw, err := os.ReadFile(s.cfg.GoogleCredentialsFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read google wallet credentials: %w", err)
+	}
+	var credentials struct {
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+	}
+	if err := json.Unmarshal(raw, &credentials); err != nil {
+		return "", fmt.Errorf("invalid google wallet credentials: %w", err)
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(credentials.PrivateKey))
+	if err != nil {
+		return "", fmt.Errorf("invalid google wallet private key: %w", err)
+	}
+
+	event := ticket.Tier.Event
+	classID := fmt.Sprintf("%s.event-%s", s.cfg.GoogleIssuerID, event.ID)
+	eventClass := map[string]interface{}{
+		"id":           classID,
+		"issuerName":   issuerName(&event),
+		"reviewStatus": "UNDER_REVIEW",
+		"eventName":    localized(event.Title),
+		"venue": map[string]interface{}{
+			"name":    localized(venueName(&event)),
+			"address": localized(event.Location),
+		},
+		"dateTime": map[string]string{
+			"start": event.StartTime.UTC().Format(time.RFC3339),
+			"end":   event.EndTime.UTC().Format(time.RFC3339),
+		},
+		"hexBackgroundColor": hexColor(brandColor),
+	}
+	ticketObject := map[string]interface{}{
+		"id":               fmt.Sprintf("%s.ticket-%s", s.cfg.GoogleIssuerID, ticket.ID),
+		"classId":          classID,
+		"state":            "ACTIVE",
+		"ticketHolderName": ticket.Owner.FirstName + " " + ticket.Owner.LastName,
+		"ticketNumber":     ticket.ID.String(),
+		"ticketType":       localized(ticket.Tier.TierName),
+		"seatInfo": map[string]interface{}{
+			"section": localized(ticket.Tier.TierName),
+		},
+		"barcode": map[string]string{
+			"type":          "QR_CODE",
+			"value":         ticket.QRCode,
+			"alternateText": ticket.ID.String()[:8],
+		},
+		"validTimeInterval": map[string]interface{}{
+			"end": map[string]string{"date": event.EndTime.UTC().Format(time.RFC3339)},
+		},
+	}
+
+	claims := jwt.MapClaims{
+		"iss":     credentials.ClientEmail,
+		"aud":     "google",
+		"typ":     "savetowallet",
+		"iat":     time.Now().Unix(),
+		"origins": s.origins,
+		"payload": map[string]interface{}{
+			"eventTicketClasses": []interface{}{eventClass},
+			"eventTicketObjects": []interface{}{ticketObject},
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign google wallet pass: %w", err)
+	}
+
+	return googleWalletSaveURL + token, nil
+}
+
+// PassFilename returns the file name Apple Wallet passes are downloaded as
+func PassFilename(ticketID uuid.UUID) string {
+	return fmt.Sprintf("eventix-ticket-%s.pkpass", ticketID.String()[:8])
+}
+
+func loadWalletTicket(ctx context.Context, ticketID uuid.UUID) (*models.Ticket, error) {
+	var ticket models.Ticket
+	if err := database.DB.WithContext(ctx).
+		Preload("Owner").
+		Preload("Tier").
+		Preload("Tier.Event").
+		Preload("Tier.Event.Organizer").
+		First(&ticket, ticketID).Error; err != nil {
+		return nil, fmt.Errorf("ticket not found")
+	}
+
+	if ticket.Status != models.TicketActive {
+		return nil, fmt.Errorf("wallet passes are only available for active tickets")
+	}
+
+	return &ticket, nil
+}
+
+func (s *WalletService) applePassDefinition(ticket *models.Ticket) map[string]interface{} {
+	event := ticket.Tier.Event
+	field := func(key, label, value string) map[string]string {
+		return map[string]string{"key": key, "label": label, "value": value}
+	}
+
+	return map[string]interface{}{
+		"formatVersion":      1,
+		"passTypeIdentifier": s.cfg.ApplePassTypeID,
+		"teamIdentifier":     s.cfg.AppleTeamID,
+		"serialNumber":       ticket.ID.String(),
+		"organizationName":   issuerName(&event),
+		"description":        "Ticket for " + event.Title,
+		"logoText":           "Eventix",
+		"backgroundColor":    rgb(brandColor),
+		"foregroundColor":    "rgb(255, 255, 255)",
+		"labelColor":         "rgb(224, 231, 255)",
+		"relevantDate":       event.StartTime.UTC().Format(time.RFC3339),
+		"expirationDate":     event.EndTime.UTC().Format(time.RFC3339),
+		"barcodes": []map[string]string{{
+			"format":          "PKBarcodeFormatQR",
+			"message":         ticket.QRCode,
+			"messageEncoding": "iso-8859-1",
+			"altText":         ticket.ID.String()[:8],
+		}},
+		"eventTicket": map[string]interface{}{
+			"primaryFields": []interface{}{
+				field("event", "EVENT", event.Title),
+			},
+			"secondaryFields": []interface{}{
+				map[string]string{
+					"key":       "date",
+					"label":     "DATE",
+					"value":     event.StartTime.UTC().Format(time.RFC3339),
+					"dateStyle": "PKDateStyleMedium",
+					"timeStyle": "PKDateStyleShort",
+				},
+				field("venue", "VENUE", venueName(&event)),
+			},
+			"auxiliaryFields": []interface{}{
+				field("seat", "SEAT", ticket.Tier.TierName),
+				field("holder", "ATTENDEE", ticket.Owner.FirstName+" "+ticket.Owner.LastName),
+			},
+			"backFields": []interface{}{
+				field("ticket", "Ticket ID", ticket.ID.String()),
+				field("order", "Order ID", ticket.OrderID.String()),
+				field("location", "Location", event.Location),
+			},
+		},
+	}
+}
+
+// signManifest produces the detached PKCS #7 signature Apple Wallet
+// requires over manifest.json, chained to the WWDR intermediate
+func (s *WalletService) signManifest(manifest []byte) ([]byte, error) {
+	cert, err := readCertificate(s.cfg.AppleCertFile)
+	if err != nil {
+		return nil, err
+	}
+	wwdr, err := readCertificate(s.cfg.AppleWWDRCertFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := readPrivateKey(s.cfg.AppleKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := pkcs7.NewSignedData(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign pass: %w", err)
+	}
+	signed.SetDigestAlgorithm(pkcs7.OIDDigestAlgorithmSHA256)
+	if err := signed.AddSignerChain(cert, key, []*x509.Certificate{wwdr}, pkcs7.SignerInfoConfig{}); err != nil {
+		return nil, fmt.Errorf("failed to sign pass: %w", err)
+	}
+	signed.Detach()
+
+	signature, err := signed.Finish()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign pass: %w", err)
+	}
+	return signature, nil
+}
+
+func readCertificate(path string) (*x509.Certificate, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificate: %w", err)
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("invalid certificate %s: no PEM data", path)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate %s: %w", path, err)
+	}
+	return cert, nil
+}
+
+func readPrivateKey(path string) (crypto.PrivateKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("invalid private key %s: no PEM data", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key %s: %w", path, err)
+	}
+	return key, nil
+}
+
+// passIcon renders a square brand-coloured icon; Apple Wallet rejects passes without one
+func passIcon(size int) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			img.Set(x, y, brandColor)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to render pass icon: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func issuerName(event *models.Event) string {
+	if event.Organizer.OrganizationName != "" {
+		return event.Organizer.OrganizationName
+	}
+	return "Eventix"
+}
+
+func venueName(event *models.Event) string {
+	if event.Venue != "" {
+		return event.Venue
+	}
+	return event.Location
+}
+
+func localized(value string) map[string]interface{} {
+	return map[string]interface{}{
+		"defaultValue": map[string]string{"language": "en-US", "value": value},
+	}
+}
+
+func rgb(c color.RGBA) string {
+	return fmt.Sprintf("rgb(%d, %d, %d)", c.R, c.G, c.B)
+}
+
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/storage"
+	"eventix-api/pkg/utils"
+)
+
+// warehouseDataset describes a table exported to the data warehouse. Rows
+// land in the partition of the day their changedColumn falls on.
+type warehouseDataset struct {
+	table         string
+	changedColumn string
+	columns       []string
+}
+
+// warehouseDatasets leave out secrets and personal data: QR codes, provider
+// metadata, client IPs, device details and form answers
+var warehouseDatasets = map[string]warehouseDataset{
+	"orders": {
+		table:         "orders",
+		changedColumn: "updated_at",
+		columns: []string{
+			"id", "user_id", "tier_id", "quantity", "unit_price", "total_amount", "platform_fee",
+			"tax_name", "tax_basis_points", "tax_inclusive", "tax_amount", "currency", "payment_provider",
+			"status", "is_comp", "billing_country", "risk_score", "created_at", "updated_at", "deleted_at",
+		},
+	},
+	"tickets": {
+		table:         "tickets",
+		changedColumn: "updated_at",
+		columns: []string{
+			"id", "tier_id", "order_id", "owner_id", "status", "checked_in_at", "created_at", "updated_at", "deleted_at",
+		},
+	},
+	"payments": {
+		table:         "payments",
+		changedColumn: "updated_at",
+		columns: []string{
+			"id", "order_id", "provider", "amount", "currency", "transaction_id", "status", "paid_at",
+			"created_at", "updated_at",
+		},
+	},
+	"checkins": {
+		table:         "checkins",
+		changedColumn: "scanned_at",
+		columns: []string{
+			"id", "ticket_id", "event_id", "session_id", "zone_id", "scanned_by", "scanned_at", "method", "direction",
+		},
+	},
+}
+
+// WarehouseExportService writes a day of sales and check-in data to S3 as
+// date-partitioned, gzipped CSV files for BI tooling
+type WarehouseExportService struct {
+	cfg *config.ExportConfig
+}
+
+// NewWarehouseExportService creates a new warehouse export service
+func NewWarehouseExportService(cfg *config.ExportConfig) *WarehouseExportService {
+	return &WarehouseExportService{cfg: cfg}
+}
+
+// RunNightly exports yesterday (UTC) unless an export of it has completed.
+// A lock in Redis keeps replicas from running the same export twice.
+func (s *WarehouseExportService) RunNightly(ctx context.Context) {
+	if !s.cfg.Enabled || !storage.Enabled() {
+		return
+	}
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&models.WarehouseExport{}).
+		Where("date = ? AND status = ?", day, models.WarehouseExportCompleted).
+		Count(&count).Error; err != nil {
+		logger.Error("Failed to check warehouse exports", zap.Error(err))
+		return
+	}
+	if count > 0 {
+		return
+	}
+
+	acquired, err := cache.Client.SetNX(ctx, warehouseExportLockKey(day), "1", time.Hour).Result()
+	if err != nil {
+		logger.Error("Failed to lock warehouse export", zap.Error(err))
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	if _, err := s.Export(ctx, day, nil); err != nil {
+		logger.Error("Failed to export to the data warehouse", zap.Time("date", day), zap.Error(err))
+	}
+}
+
+// Export writes every configured dataset's rows changed on one UTC day to
+// its partition, replacing the files of any earlier export of that day. The
+// run is recorded even when it fails.
+func (s *WarehouseExportService) Export(ctx context.Context, day time.Time, requestedBy *uuid.UUID) (*models.WarehouseExport, error) {
+	if !storage.Enabled() {
+		return nil, utils.NewAppError(http.StatusServiceUnavailable, utils.CodeServiceUnavailable, "file storage is not configured")
+	}
+
+	day = day.UTC().Truncate(24 * time.Hour)
+	if day.After(time.Now()) {
+		return nil, utils.BadRequestError("cannot export a day that has not started")
+	}
+
+	export := models.WarehouseExport{
+		Date:        day,
+		Status:      models.WarehouseExportRunning,
+		RequestedBy: requestedBy,
+	}
+	if err := database.DB.WithContext(ctx).Create(&export).Error; err != nil {
+		return nil, fmt.Errorf("failed to record export: %w", err)
+	}
+
+	exportErr := s.writeDatasets(ctx, &export)
+	if exportErr != nil {
+		export.Status, export.Error = models.WarehouseExportFailed, exportErr.Error()
+	} else {
+		now := time.Now()
+		export.Status, export.CompletedAt = models.WarehouseExportCompleted, &now
+	}
+	if err := database.DB.WithContext(ctx).Model(&export).
+		Select("status", "error", "completed_at").
+		Updates(&export).Error; err != nil {
+		return nil, fmt.Errorf("failed to record export: %w", err)
+	}
+	return &export, exportErr
+}
+
+func (s *WarehouseExportService) writeDatasets(ctx context.Context, export *models.WarehouseExport) error {
+	for _, name := range s.cfg.Datasets {
+		dataset, ok := warehouseDatasets[name]
+		if !ok {
+			return fmt.Errorf("unknown export dataset %s", name)
+		}
+
+		data, rows, err := s.extract(ctx, dataset, export.Date)
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %w", name, err)
+		}
+
+		file := models.WarehouseExportFile{
+			ExportID: export.ID,
+			Dataset:  name,
+			Key:      warehousePartitionKey(s.cfg.Prefix, name, export.Date),
+			Rows:     rows,
+			Size:     int64(len(data)),
+		}
+		if err := storage.Upload(ctx, file.Key, "application/gzip", bytes.NewReader(data), file.Size); err != nil {
+			return fmt.Errorf("failed to upload %s: %w", name, err)
+		}
+		if err := database.DB.WithContext(ctx).Create(&file).Error; err != nil {
+			return fmt.Errorf("failed to record %s export: %w", name, err)
+		}
+		export.Files = append(export.Files, file)
+	}
+	return nil
+}
+
+// extract reads a dataset's rows changed on day from a replica and encodes
+// them as gzipped CSV with a header row. Soft-deleted rows are included so
+// the warehouse sees deletions.
+func (s *WarehouseExportService) extract(ctx context.Context, dataset warehouseDataset, day time.Time) ([]byte, int64, error) {
+	rows, err := database.Reader(database.DB).WithContext(ctx).Table(dataset.table).
+		Select(dataset.columns).
+		Where(dataset.changedColumn+" >= ? AND "+dataset.changedColumn+" < ?", day, day.AddDate(0, 0, 1)).
+		Order("id").
+		Rows()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	w := csv.NewWriter(gz)
+	if err := w.Write(dataset.columns); err != nil {
+		return nil, 0, err
+	}
+
+	values := make([]interface{}, len(dataset.columns))
+	dest := make([]interface{}, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	record := make([]string, len(values))
+
+	var count int64
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, 0, err
+		}
+		for i, value := range values {
+			record[i] = warehouseValue(value)
+		}
+		if err := w.Write(record); err != nil {
+			return nil, 0, err
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, 0, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, 0, err
+	}
+	return buf.Bytes(), count, nil
+}
+
+// List returns exports newest first with their files
+func (s *WarehouseExportService) List(ctx context.Context, offset, limit int) ([]models.WarehouseExport, int64, error) {
+	db := database.Reader(database.DB).WithContext(ctx)
+
+	var total int64
+	if err := db.Model(&models.WarehouseExport{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count exports: %w", err)
+	}
+
+	var exports []models.WarehouseExport
+	if err := db.Preload("Files", func(tx *gorm.DB) *gorm.DB { return tx.Order("dataset ASC") }).
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&exports).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch exports: %w", err)
+	}
+	return exports, total, nil
+}
+
+// Get returns an export with its files
+func (s *WarehouseExportService) Get(ctx context.Context, exportID uuid.UUID) (*models.WarehouseExport, error) {
+	var export models.WarehouseExport
+	if err := database.DB.WithContext(ctx).
+		Preload("Files", func(tx *gorm.DB) *gorm.DB { return tx.Order("dataset ASC") }).
+		First(&export, exportID).Error; err != nil {
+		return nil, utils.NotFoundError("export not found")
+	}
+	return &export, nil
+}
+
+// warehousePartitionKey places a dataset's day under a Hive-style date=
+// partition, so query engines such as Athena can prune by date
+func warehousePartitionKey(prefix, dataset string, day time.Time) string {
+	return path.Join(prefix, dataset, "date="+day.Format("2006-01-02"), "part-00000.csv.gz")
+}
+
+// warehouseValue formats a scanned column for CSV: times in RFC 3339 UTC
+// and NULL as an empty cell
+func warehouseValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func warehouseExportLockKey(day time.Time) string {
+	return "warehouse_export:" + day.Format("2006-01-02") + ":lock"
+}
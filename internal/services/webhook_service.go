@@ -0,0 +1,313 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/database"
+)
+
+// stripeSignatureTolerance bounds how old a signed Stripe event may be
+const stripeSignatureTolerance = 5 * time.Minute
+
+// WebhookEvent is a provider callback normalized to a payment outcome
+type WebhookEvent struct {
+	Provider  models.PaymentProvider
+	Type      string
+	Reference string
+	Status    ProviderPaymentStatus
+	Amount    int64 // minor units
+	Card      PaymentCard
+	Dispute   *DisputeNotice // set for dispute events
+}
+
+type paystackWebhookPayload struct {
+	Event string `json:"event"`
+	Data  struct {
+		Reference string `json:"reference"`
+		Amount    int64  `json:"amount"`
+		Status    string `json:"status"`
+
+		Authorization paystackAuthorization `json:"authorization"`
+	} `json:"data"`
+}
+
+type paystackDisputePayload struct {
+	Data struct {
+		ID           int64  `json:"id"`
+		RefundAmount int64  `json:"refund_amount"`
+		Currency     string `json:"currency"`
+		Category     string `json:"category"`
+		Resolution   string `json:"resolution"`
+		DueAt        string `json:"due_at"`
+		Transaction  struct {
+			Reference string `json:"reference"`
+		} `json:"transaction"`
+	} `json:"data"`
+}
+
+type stripeWebhookPayload struct {
+	Type string `json:"type"`
+	Data struct {
+		Object struct {
+			ClientReferenceID string `json:"client_reference_id"`
+			PaymentStatus     string `json:"payment_status"`
+			AmountTotal       int64  `json:"amount_total"`
+		} `json:"object"`
+	} `json:"data"`
+}
+
+type stripeDisputePayload struct {
+	Data struct {
+		Object struct {
+			ID              string `json:"id"`
+			Amount          int64  `json:"amount"`
+			Currency        string `json:"currency"`
+			Reason          string `json:"reason"`
+			Status          string `json:"status"`
+			PaymentIntent   string `json:"payment_intent"`
+			EvidenceDetails struct {
+				DueBy int64 `json:"due_by"`
+			} `json:"evidence_details"`
+		} `json:"object"`
+	} `json:"data"`
+}
+
+// WebhookService verifies and applies payment provider webhooks
+type WebhookService struct {
+	secret         string
+	paymentService *PaymentService
+	disputes       *DisputeService
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(secret string, paymentService *PaymentService, disputes *DisputeService) *WebhookService {
+	return &WebhookService{
+		secret:         secret,
+		paymentService: paymentService,
+		disputes:       disputes,
+	}
+}
+
+// ParsePaystackEvent verifies the x-paystack-signature header and decodes the event
+func (s *WebhookService) ParsePaystackEvent(payload []byte, signature string) (*WebhookEvent, error) {
+	mac := hmac.New(sha512.New, []byte(s.secret))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return nil, fmt.Errorf("invalid webhook signature")
+	}
+
+	var body paystackWebhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload")
+	}
+
+	event := &WebhookEvent{
+		Provider:  models.ProviderPaystack,
+		Type:      body.Event,
+		Reference: body.Data.Reference,
+		Amount:    body.Data.Amount,
+		Status:    ProviderStatusPending,
+		Card:      body.Data.Authorization.card(),
+	}
+
+	switch body.Event {
+	case "charge.success":
+		event.Status = ProviderStatusSuccess
+	case "charge.failed":
+		event.Status = ProviderStatusFailed
+	case "charge.dispute.create", "charge.dispute.remind", "charge.dispute.resolve":
+		var dispute paystackDisputePayload
+		if err := json.Unmarshal(payload, &dispute); err != nil {
+			return nil, fmt.Errorf("invalid webhook payload")
+		}
+		data := dispute.Data
+		event.Reference = data.Transaction.Reference
+		event.Dispute = &DisputeNotice{
+			ID:       strconv.FormatInt(data.ID, 10),
+			Amount:   data.RefundAmount,
+			Currency: data.Currency,
+			Reason:   data.Category,
+			Status:   models.DisputeOpen,
+		}
+		if dueAt, err := time.Parse(time.RFC3339, data.DueAt); err == nil {
+			event.Dispute.DueBy = &dueAt
+		}
+		// Accepting a dispute refunds the cardholder; declining it keeps the funds
+		switch data.Resolution {
+		case "merchant-accepted":
+			event.Dispute.Status = models.DisputeLost
+		case "declined":
+			event.Dispute.Status = models.DisputeWon
+		}
+	}
+
+	return event, nil
+}
+
+// ParseStripeEvent verifies the Stripe-Signature header and decodes the event
+func (s *WebhookService) ParseStripeEvent(payload []byte, header string) (*WebhookEvent, error) {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			timestamp = kv[1]
+		case "v1":
+			signatures = append(signatures, kv[1])
+		}
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return nil, fmt.Errorf("invalid webhook signature")
+	}
+
+	if time.Since(time.Unix(ts, 0)) > stripeSignatureTolerance {
+		return nil, fmt.Errorf("webhook timestamp outside tolerance")
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := hex.EncodeToString(mac.Sum(nil))
+
+	valid := false
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(expected), []byte(sig)) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid webhook signature")
+	}
+
+	var body stripeWebhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload")
+	}
+
+	event := &WebhookEvent{
+		Provider:  models.ProviderStripe,
+		Type:      body.Type,
+		Reference: body.Data.Object.ClientReferenceID,
+		Amount:    body.Data.Object.AmountTotal,
+		Status:    ProviderStatusPending,
+	}
+
+	switch body.Type {
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+		if body.Data.Object.PaymentStatus == "paid" {
+			event.Status = ProviderStatusSuccess
+		}
+	case "checkout.session.expired", "checkout.session.async_payment_failed":
+		event.Status = ProviderStatusFailed
+	case "charge.dispute.created", "charge.dispute.updated", "charge.dispute.closed":
+		var dispute stripeDisputePayload
+		if err := json.Unmarshal(payload, &dispute); err != nil {
+			return nil, fmt.Errorf("invalid webhook payload")
+		}
+		object := dispute.Data.Object
+		event.Amount = object.Amount
+		event.Dispute = &DisputeNotice{
+			ID:            object.ID,
+			PaymentIntent: object.PaymentIntent,
+			Amount:        object.Amount,
+			Currency:      object.Currency,
+			Reason:        object.Reason,
+			Status:        models.DisputeOpen,
+		}
+		if object.EvidenceDetails.DueBy > 0 {
+			dueBy := time.Unix(object.EvidenceDetails.DueBy, 0)
+			event.Dispute.DueBy = &dueBy
+		}
+		switch object.Status {
+		case "won":
+			event.Dispute.Status = models.DisputeWon
+		case "lost":
+			event.Dispute.Status = models.DisputeLost
+		}
+	}
+
+	return event, nil
+}
+
+// Apply transitions the payment and order referenced by event, or records
+// the dispute it carries. Events without a final outcome are acknowledged
+// and ignored.
+func (s *WebhookService) Apply(ctx context.Context, event *WebhookEvent) error {
+	if event.Dispute != nil {
+		return s.applyDispute(ctx, event)
+	}
+
+	if event.Status == ProviderStatusPending || event.Reference == "" {
+		return nil
+	}
+
+	var payment models.Payment
+	if err := database.DB.Where("transaction_id = ? AND provider = ?", event.Reference, event.Provider).
+		First(&payment).Error; err != nil {
+		return fmt.Errorf("payment not found for reference %s", event.Reference)
+	}
+
+	switch payment.Status {
+	case models.PaymentCompleted, models.PaymentFailed, models.PaymentDisputed, models.PaymentChargeback:
+		return nil
+	}
+
+	switch event.Status {
+	case ProviderStatusSuccess:
+		if event.Amount != payment.Amount {
+			return fmt.Errorf("payment amount mismatch")
+		}
+		return s.paymentService.CompletePayment(ctx, &payment, event.Card)
+	case ProviderStatusFailed:
+		return s.paymentService.FailPayment(&payment)
+	}
+
+	return nil
+}
+
+// applyDispute records a dispute against the payment it was raised on.
+// Stripe disputes name the payment intent, which is traced back to the
+// Checkout Session holding the payment reference.
+func (s *WebhookService) applyDispute(ctx context.Context, event *WebhookEvent) error {
+	reference := event.Reference
+	if reference == "" && event.Dispute.PaymentIntent != "" {
+		provider, err := s.paymentService.Provider(event.Provider)
+		if err != nil {
+			return err
+		}
+		stripe, ok := provider.(*StripeProvider)
+		if !ok {
+			return fmt.Errorf("%s disputes must carry a payment reference", event.Provider)
+		}
+		if reference, err = stripe.CheckoutReference(event.Dispute.PaymentIntent); err != nil {
+			return err
+		}
+	}
+
+	var payment models.Payment
+	if err := database.DB.Where("transaction_id = ? AND provider = ?", reference, event.Provider).
+		First(&payment).Error; err != nil {
+		return fmt.Errorf("payment not found for reference %s", reference)
+	}
+
+	return s.disputes.Record(ctx, &payment, event.Dispute)
+}
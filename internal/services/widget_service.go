@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"eventix-api/internal/models"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+)
+
+const (
+	// maxWidgetOrigins bounds the sites one organizer can embed the widget on
+	maxWidgetOrigins = 20
+
+	// widgetOriginCacheTTL bounds how long an origin check stays cached
+	widgetOriginCacheTTL = time.Minute
+)
+
+// WidgetService manages the sites organizers embed the checkout widget on
+type WidgetService struct{}
+
+// NewWidgetService creates a new widget service
+func NewWidgetService() *WidgetService {
+	return &WidgetService{}
+}
+
+// ListOrigins returns an organizer's widget origins, oldest first
+func (s *WidgetService) ListOrigins(ctx context.Context, organizerID uuid.UUID) ([]models.WidgetOrigin, error) {
+	var origins []models.WidgetOrigin
+	if err := database.DB.WithContext(ctx).
+		Where("organizer_id = ?", organizerID).
+		Order("created_at ASC").
+		Find(&origins).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch widget origins: %w", err)
+	}
+	return origins, nil
+}
+
+// AddOrigin allows the widget to be embedded on origin
+func (s *WidgetService) AddOrigin(ctx context.Context, organizerID uuid.UUID, origin string, createdBy uuid.UUID) (*models.WidgetOrigin, error) {
+	normalized, err := NormalizeOrigin(origin)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int64
+	if err := database.DB.WithContext(ctx).Model(&models.WidgetOrigin{}).
+		Where("organizer_id = ?", organizerID).
+		Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to count widget origins: %w", err)
+	}
+	if count >= maxWidgetOrigins {
+		return nil, utils.BadRequestError("an organizer can have at most %d widget origins", maxWidgetOrigins)
+	}
+
+	var existing int64
+	if err := database.DB.WithContext(ctx).Model(&models.WidgetOrigin{}).
+		Where("organizer_id = ? AND origin = ?", organizerID, normalized).
+		Count(&existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch widget origins: %w", err)
+	}
+	if existing > 0 {
+		return nil, utils.ConflictError("this origin is already allowed")
+	}
+
+	widgetOrigin := &models.WidgetOrigin{
+		OrganizerID: organizerID,
+		Origin:      normalized,
+		CreatedBy:   createdBy,
+	}
+	if err := database.DB.WithContext(ctx).Create(widgetOrigin).Error; err != nil {
+		return nil, fmt.Errorf("failed to save widget origin: %w", err)
+	}
+	s.invalidate(ctx, normalized)
+	return widgetOrigin, nil
+}
+
+// RemoveOrigin stops the widget from answering requests from an origin
+func (s *WidgetService) RemoveOrigin(ctx context.Context, organizerID, originID uuid.UUID) error {
+	var widgetOrigin models.WidgetOrigin
+	err := database.DB.WithContext(ctx).
+		Where("id = ? AND organizer_id = ?", originID, organizerID).
+		First(&widgetOrigin).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return utils.NotFoundError("widget origin not found")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch widget origin: %w", err)
+	}
+
+	if err := database.DB.WithContext(ctx).Delete(&widgetOrigin).Error; err != nil {
+		return fmt.Errorf("failed to remove widget origin: %w", err)
+	}
+	s.invalidate(ctx, widgetOrigin.Origin)
+	return nil
+}
+
+// EventOrigins returns the origins the organizer of an event allows the
+// widget on
+func (s *WidgetService) EventOrigins(ctx context.Context, eventID uuid.UUID) ([]string, error) {
+	origins := []string{}
+	if err := database.Reader(database.DB).WithContext(ctx).Model(&models.WidgetOrigin{}).
+		Joins("JOIN events e ON e.organizer_id = widget_origins.organizer_id").
+		Where("e.id = ?", eventID).
+		Order("widget_origins.created_at ASC").
+		Pluck("widget_origins.origin", &origins).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch widget origins: %w", err)
+	}
+	return origins, nil
+}
+
+// AllowsWidgetOrigin reports whether the organizer of an event allows the
+// widget on origin. Unknown events allow no origin.
+func (s *WidgetService) AllowsWidgetOrigin(ctx context.Context, eventID, origin string) (bool, error) {
+	id, err := uuid.Parse(eventID)
+	if err != nil {
+		return false, nil
+	}
+	normalized, err := NormalizeOrigin(origin)
+	if err != nil {
+		return false, nil
+	}
+
+	var widgetOrigin models.WidgetOrigin
+	err = database.Reader(database.DB).WithContext(ctx).
+		Select("widget_origins.id").
+		Joins("JOIN events e ON e.organizer_id = widget_origins.organizer_id").
+		Where("e.id = ? AND widget_origins.origin = ?", id, normalized).
+		First(&widgetOrigin).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch widget origin: %w", err)
+	}
+	return true, nil
+}
+
+// AllowsOrigin reports whether any organizer embeds the widget on origin.
+// With CORS_ORGANIZER_ORIGINS set, those sites may call the whole API.
+func (s *WidgetService) AllowsOrigin(ctx context.Context, origin string) (bool, error) {
+	normalized, err := NormalizeOrigin(origin)
+	if err != nil {
+		return false, nil
+	}
+
+	cached, err := cache.Client.Get(ctx, widgetOriginKey(normalized)).Result()
+	if err == nil {
+		return cached == "1", nil
+	}
+	if err != redis.Nil {
+		logger.WithContext(ctx).Warn("Failed to read widget origin cache", zap.String("origin", normalized), zap.Error(err))
+	}
+
+	var count int64
+	if err := database.Reader(database.DB).WithContext(ctx).Model(&models.WidgetOrigin{}).
+		Where("origin = ?", normalized).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to fetch widget origins: %w", err)
+	}
+
+	value := "0"
+	if count > 0 {
+		value = "1"
+	}
+	cache.Client.Set(ctx, widgetOriginKey(normalized), value, widgetOriginCacheTTL)
+	return count > 0, nil
+}
+
+func (s *WidgetService) invalidate(ctx context.Context, origin string) {
+	if err := cache.Client.Del(ctx, widgetOriginKey(origin)).Err(); err != nil {
+		logger.WithContext(ctx).Warn("Failed to clear widget origin cache", zap.String("origin", origin), zap.Error(err))
+	}
+}
+
+func widgetOriginKey(origin string) string {
+	return "widget_origin:" + origin
+}
+
+// NormalizeOrigin reduces a site URL to the scheme, host and port browsers
+// send in the Origin header. Plain http is only accepted for local development.
+func NormalizeOrigin(origin string) (string, error) {
+	u, err := url.Parse(strings.ToLower(strings.TrimSpace(origin)))
+	if err != nil || u.Host == "" || u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return "", utils.BadRequestError("origin must be a scheme and host, such as https://tickets.example.com")
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if host := u.Hostname(); host != "localhost" && host != "127.0.0.1" {
+			return "", utils.BadRequestError("origin must use https")
+		}
+	default:
+		return "", utils.BadRequestError("origin must use https")
+	}
+	return u.Scheme + "://" + u.Host, nil
+}
@@ -0,0 +1,219 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"eventix-api/internal/migrations"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/ticketqr"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/redis/go-redis/v9"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+	"gorm.io/gorm"
+)
+
+// Images the containers run, matching docker-compose.yml
+const (
+	PostgresImage = "postgres:16-alpine"
+	RedisImage    = "redis:7-alpine"
+)
+
+// WebhookSecret signs the payment provider webhooks the tests send
+const WebhookSecret = "integration-test-webhook-secret"
+
+// Env is a throwaway Postgres and Redis pair with the schema migrated. Start
+// points the database and cache globals at it and initializes token
+// signing, so services and handlers under test use it without further wiring.
+type Env struct {
+	Config *config.Config
+	DB     *gorm.DB
+	Redis  *redis.Client
+
+	postgres *tcpostgres.PostgresContainer
+	redis    *tcredis.RedisContainer
+}
+
+// Start runs the containers, connects to them and migrates the database.
+// It is meant for TestMain; call Close when the tests are done.
+func Start(ctx context.Context) (*Env, error) {
+	env := &Env{}
+
+	var err error
+	env.postgres, err = tcpostgres.Run(ctx, PostgresImage,
+		tcpostgres.WithDatabase("ticket_booking_test"),
+		tcpostgres.WithUsername("postgres"),
+		tcpostgres.WithPassword("postgres"),
+		tcpostgres.BasicWaitStrategies(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start postgres: %w", err)
+	}
+
+	env.redis, err = tcredis.Run(ctx, RedisImage)
+	if err != nil {
+		env.Close(ctx)
+		return nil, fmt.Errorf("failed to start redis: %w", err)
+	}
+
+	env.Config, err = env.config(ctx)
+	if err != nil {
+		env.Close(ctx)
+		return nil, err
+	}
+
+	if err := database.Connect(&env.Config.Database); err != nil {
+		env.Close(ctx)
+		return nil, err
+	}
+	if err := cache.Connect(&env.Config.Redis); err != nil {
+		env.Close(ctx)
+		return nil, err
+	}
+	ticketqr.Init(&env.Config.Ticket)
+	if err := jwt.Init(&env.Config.JWT); err != nil {
+		env.Close(ctx)
+		return nil, err
+	}
+
+	env.DB, env.Redis = database.DB, cache.Client
+
+	if err := migrations.Run(env.DB); err != nil {
+		env.Close(ctx)
+		return nil, err
+	}
+
+	return env, nil
+}
+
+// config builds the configuration the tests run with. It is not read from
+// the environment, so a developer's .env cannot point tests at a real database.
+func (e *Env) config(ctx context.Context) (*config.Config, error) {
+	dbHost, dbPort, err := endpoint(ctx, e.postgres, "5432/tcp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve postgres address: %w", err)
+	}
+	redisHost, redisPort, err := endpoint(ctx, e.redis, "6379/tcp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve redis address: %w", err)
+	}
+
+	return &config.Config{
+		App: config.AppConfig{
+			Name:        "Eventix",
+			Environment: "test",
+			Version:     "v1",
+			Versions:    []string{"v1", "v2"},
+		},
+		Database: config.DatabaseConfig{
+			Host:           dbHost,
+			Port:           dbPort,
+			User:           "postgres",
+			Password:       "postgres",
+			Name:           "ticket_booking_test",
+			SSLMode:        "disable",
+			MaxConnections: 20,
+			MaxIdleConns:   5,
+			MaxLifetime:    time.Minute,
+		},
+		Redis: config.RedisConfig{
+			Host: redisHost,
+			Port: redisPort,
+			TTL:  time.Hour,
+		},
+		JWT: config.JWTConfig{
+			Algorithm:          "HS256",
+			Secret:             "integration-test-secret",
+			Expiry:             15 * time.Minute,
+			RefreshTokenExpiry: 24 * time.Hour,
+			Issuer:             "eventix-test",
+		},
+		Payment: config.PaymentConfig{
+			WebhookSecret: WebhookSecret,
+		},
+		Limits: config.LimitsConfig{
+			TicketReservationTimeout: 10 * time.Minute,
+			OrderExpiry:              15 * time.Minute,
+			MaxTicketsPerOrder:       10,
+			IdempotencyTTL:           time.Hour,
+			RequestTimeout:           30 * time.Second,
+			LongRequestTimeout:       2 * time.Minute,
+			MaxBodySize:              1024 * 1024,
+		},
+		Ticket: config.TicketConfig{
+			QRSigningSecret: "integration-test-qr-secret",
+			QRImageSize:     256,
+		},
+	}, nil
+}
+
+// Reset empties every table but the seeded categories and flushes Redis,
+// so each test starts from a freshly migrated database
+func (e *Env) Reset(ctx context.Context) error {
+	var tables []string
+	if err := e.DB.WithContext(ctx).Raw(
+		"SELECT tablename FROM pg_tables WHERE schemaname = current_schema() AND tablename <> 'categories'",
+	).Scan(&tables).Error; err != nil {
+		return fmt.Errorf("failed to list tables: %w", err)
+	}
+
+	for _, table := range tables {
+		if err := e.DB.WithContext(ctx).Exec(fmt.Sprintf("TRUNCATE TABLE %q CASCADE", table)).Error; err != nil {
+			return fmt.Errorf("failed to truncate %s: %w", table, err)
+		}
+	}
+
+	if err := e.Redis.FlushDB(ctx).Err(); err != nil {
+		return fmt.Errorf("failed to flush redis: %w", err)
+	}
+	return nil
+}
+
+// Close disconnects from and removes the containers
+func (e *Env) Close(ctx context.Context) error {
+	if e.DB != nil {
+		database.Close()
+	}
+	if e.Redis != nil {
+		cache.Close()
+	}
+
+	var errs []error
+	if e.redis != nil {
+		if err := e.redis.Terminate(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop redis: %w", err))
+		}
+	}
+	if e.postgres != nil {
+		if err := e.postgres.Terminate(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop postgres: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// endpoint returns the host and mapped port a container serves port on
+func endpoint(ctx context.Context, container testcontainers.Container, port nat.Port) (string, int, error) {
+	host, err := container.Host(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	mapped, err := container.MappedPort(ctx, port)
+	if err != nil {
+		return "", 0, err
+	}
+	n, err := strconv.Atoi(mapped.Port())
+	if err != nil {
+		return "", 0, err
+	}
+	return host, n, nil
+}
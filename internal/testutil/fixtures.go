@@ -0,0 +1,202 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// Password is the password of every user the fixtures create
+const Password = "Password123!"
+
+// CreateUser adds an active, verified user with the given role
+func CreateUser(t testing.TB, role models.UserRole) *models.User {
+	t.Helper()
+
+	hash, err := utils.HashPassword(Password)
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+
+	user := &models.User{
+		Email:         fmt.Sprintf("%s-%s@example.com", role, uuid.NewString()[:8]),
+		PasswordHash:  hash,
+		FirstName:     "Test",
+		LastName:      string(role),
+		Role:          role,
+		EmailVerified: true,
+		IsActive:      true,
+	}
+	if err := database.DB.Create(user).Error; err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	return user
+}
+
+// AccessToken signs an access token for user, as logging in does
+func AccessToken(t testing.TB, user *models.User) string {
+	t.Helper()
+
+	token, _, err := jwt.GenerateToken(user.ID.String(), user.Email, string(user.Role), 15*time.Minute)
+	if err != nil {
+		t.Fatalf("generate access token: %v", err)
+	}
+	return token
+}
+
+// CreateOrganizer adds an organizer user and its approved organizer profile
+func CreateOrganizer(t testing.TB) *models.Organizer {
+	t.Helper()
+
+	user := CreateUser(t, models.RoleOrganizer)
+	now := time.Now()
+	organizer := &models.Organizer{
+		UserID:             user.ID,
+		OrganizationName:   "Test Events " + user.ID.String()[:8],
+		VerificationStatus: models.VerificationApproved,
+		AppliedAt:          &now,
+		VerifiedAt:         &now,
+		User:               *user,
+	}
+	if err := database.DB.Omit("User").Create(organizer).Error; err != nil {
+		t.Fatalf("create organizer: %v", err)
+	}
+	return organizer
+}
+
+// CreateEvent adds a published event starting tomorrow with one ticket tier
+// of quantity tickets at price minor units. Its tier is in TicketTiers[0].
+func CreateEvent(t testing.TB, organizer *models.Organizer, price int64, quantity int) *models.Event {
+	t.Helper()
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	event := &models.Event{
+		OrganizerID: organizer.ID,
+		Title:       "Test Event",
+		Slug:        "test-event-" + uuid.NewString()[:8],
+		Description: "An event created by the integration tests",
+		Category:    "music",
+		Location:    "Lagos",
+		Venue:       "Test Arena",
+		Currency:    "USD",
+		StartTime:   start,
+		EndTime:     start.Add(3 * time.Hour),
+		Status:      models.EventPublished,
+		TicketTiers: []models.TicketTier{{
+			TierName:          "General Admission",
+			Price:             price,
+			Currency:          "USD",
+			TotalQuantity:     quantity,
+			AvailableQuantity: quantity,
+		}},
+	}
+	if err := database.DB.Create(event).Error; err != nil {
+		t.Fatalf("create event: %v", err)
+	}
+	return event
+}
+
+// CreateOrder reserves quantity tickets of tier for user and turns the
+// reservation into a pending order, as placing an order through the API does
+func CreateOrder(t testing.TB, user *models.User, tier *models.TicketTier, quantity int) *models.Order {
+	t.Helper()
+
+	ticketService := services.NewTicketService()
+	reservation, err := ticketService.CreateReservation(user.ID, tier.ID, quantity)
+	if err != nil {
+		t.Fatalf("create reservation: %v", err)
+	}
+	if err := ticketService.ConsumeReservation(reservation.ReservationID); err != nil {
+		t.Fatalf("consume reservation: %v", err)
+	}
+
+	tierID := tier.ID
+	expiresAt := time.Now().Add(15 * time.Minute)
+	order := &models.Order{
+		UserID:          user.ID,
+		TierID:          tier.ID,
+		Quantity:        quantity,
+		UnitPrice:       reservation.UnitPrice,
+		TotalAmount:     reservation.TotalPrice,
+		Currency:        reservation.Currency,
+		PaymentProvider: models.ProviderPaystack,
+		Status:          models.OrderPending,
+		ExpiresAt:       &expiresAt,
+		Items: []models.OrderItem{{
+			Type:      models.OrderItemTicket,
+			TierID:    &tierID,
+			Name:      tier.TierName,
+			Quantity:  quantity,
+			UnitPrice: reservation.UnitPrice,
+			Amount:    reservation.TotalPrice,
+		}},
+	}
+	if err := database.DB.Create(order).Error; err != nil {
+		t.Fatalf("create order: %v", err)
+	}
+	if err := services.NewOrderSagaService(nil).Begin(context.Background(), order.ID); err != nil {
+		t.Fatalf("begin order saga: %v", err)
+	}
+	return order
+}
+
+// CreatePayment adds a pending payment of order's total with its provider,
+// as initializing the payment would without calling the provider
+func CreatePayment(t testing.TB, order *models.Order) *models.Payment {
+	t.Helper()
+
+	payment := &models.Payment{
+		OrderID:       order.ID,
+		Provider:      order.PaymentProvider,
+		Amount:        order.TotalAmount,
+		Currency:      order.Currency,
+		TransactionID: utils.GeneratePaymentReference(),
+		Status:        models.PaymentPending,
+	}
+	if err := database.DB.Create(payment).Error; err != nil {
+		t.Fatalf("create payment: %v", err)
+	}
+	return payment
+}
+
+// PayOrder records a successful payment for order and completes it the way
+// a verified provider webhook does, issuing the order's tickets
+func PayOrder(t testing.TB, paymentService *services.PaymentService, order *models.Order) []models.Ticket {
+	t.Helper()
+
+	payment := CreatePayment(t, order)
+	if err := paymentService.CompletePayment(context.Background(), payment, services.PaymentCard{}); err != nil {
+		t.Fatalf("complete payment: %v", err)
+	}
+
+	var tickets []models.Ticket
+	if err := database.DB.Where("order_id = ?", order.ID).Find(&tickets).Error; err != nil {
+		t.Fatalf("load tickets: %v", err)
+	}
+	return tickets
+}
+
+// CheckIn scans ticket's QR code at the main gate of event as scanner
+func CheckIn(t testing.TB, scanner *models.User, event *models.Event, ticket *models.Ticket) *models.Checkin {
+	t.Helper()
+
+	ticketService := services.NewTicketService()
+	validated, err := ticketService.ValidateTicketForCheckin(ticket.QRCode, event.ID)
+	if err != nil {
+		t.Fatalf("validate ticket: %v", err)
+	}
+	checkin, err := ticketService.CheckInTicket(context.Background(), validated, scanner.ID, event.ID, nil, models.CheckinQR, "testutil")
+	if err != nil {
+		t.Fatalf("check in ticket: %v", err)
+	}
+	return checkin
+}
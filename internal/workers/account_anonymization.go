@@ -0,0 +1,63 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// accountAnonymizationBatchSize bounds how many accounts are scrubbed per sweep
+const accountAnonymizationBatchSize = 100
+
+// AccountAnonymizationWorker scrubs personal data from accounts whose deletion grace period has ended
+type AccountAnonymizationWorker struct {
+	interval       time.Duration
+	grace          time.Duration
+	accountService *services.AccountService
+}
+
+// NewAccountAnonymizationWorker creates a new account anonymization worker
+func NewAccountAnonymizationWorker(interval, grace time.Duration) *AccountAnonymizationWorker {
+	return &AccountAnonymizationWorker{
+		interval:       interval,
+		grace:          grace,
+		accountService: services.NewAccountService(),
+	}
+}
+
+// Start runs the sweep loop until ctx is cancelled
+func (w *AccountAnonymizationWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Account anonymization worker started",
+		zap.Duration("interval", w.interval),
+		zap.Duration("grace", w.grace),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Account anonymization worker stopped")
+			return
+		case <-ticker.C:
+			w.sweep(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (w *AccountAnonymizationWorker) sweep(ctx context.Context) {
+	anonymized, err := w.accountService.AnonymizeDeleted(ctx, time.Now().Add(-w.grace), accountAnonymizationBatchSize)
+	if err != nil {
+		logger.Error("Failed to anonymize deleted accounts", zap.Int("anonymized", anonymized), zap.Error(err))
+		return
+	}
+
+	if anonymized > 0 {
+		logger.Info("Anonymized deleted accounts", zap.Int("anonymized", anonymized))
+	}
+}
@@ -0,0 +1,90 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// eventReminderBatchSize bounds how many events are processed per sweep
+const eventReminderBatchSize = 50
+
+// EventReminderWorker texts ticket holders shortly before their event starts
+type EventReminderWorker struct {
+	lead       time.Duration
+	smsService *services.SMSService
+}
+
+// NewEventReminderWorker creates a new event reminder worker
+func NewEventReminderWorker(lead time.Duration, smsCfg *config.SMSConfig) *EventReminderWorker {
+	return &EventReminderWorker{
+		lead:       lead,
+		smsService: services.NewSMSService(smsCfg),
+	}
+}
+
+// reminderRecipient is a ticket holder who can receive an SMS
+type reminderRecipient struct {
+	UserID uuid.UUID
+	Phone  string
+}
+
+// sweep texts the holders of events starting within the lead time
+func (w *EventReminderWorker) sweep(ctx context.Context, _ sweepJob) error {
+	now := time.Now()
+
+	var events []models.Event
+	if err := database.DB.
+		Where("status = ? AND start_time > ? AND start_time <= ?", models.EventPublished, now, now.Add(w.lead)).
+		Order("start_time ASC").
+		Limit(eventReminderBatchSize).
+		Find(&events).Error; err != nil {
+		return fmt.Errorf("failed to fetch upcoming events: %w", err)
+	}
+
+	sent := 0
+	for _, event := range events {
+		var recipients []reminderRecipient
+		if err := database.DB.Table("tickets").
+			Select("DISTINCT users.id AS user_id, users.phone").
+			Joins("JOIN ticket_tiers ON ticket_tiers.id = tickets.tier_id").
+			Joins("JOIN users ON users.id = tickets.owner_id").
+			Where("ticket_tiers.event_id = ? AND tickets.status = ? AND tickets.deleted_at IS NULL", event.ID, models.TicketActive).
+			Where("users.phone <> '' AND users.deleted_at IS NULL").
+			Scan(&recipients).Error; err != nil {
+			logger.Error("Failed to fetch reminder recipients", zap.String("event_id", event.ID.String()), zap.Error(err))
+			continue
+		}
+
+		for _, recipient := range recipients {
+			// Each holder is reminded once per event, even across sweeps
+			key := fmt.Sprintf("event_reminder:%s:%s", event.ID, recipient.UserID)
+			first, err := cache.Client.SetNX(ctx, key, "1", w.lead+time.Hour).Result()
+			if err != nil || !first {
+				continue
+			}
+
+			if err := w.smsService.SendEventReminderSMS(ctx, recipient.UserID, recipient.Phone, event.Title, event.Venue, event.StartTime); err != nil {
+				logger.Error("Failed to send event reminder", zap.String("event_id", event.ID.String()), zap.Error(err))
+				cache.Client.Del(ctx, key)
+				continue
+			}
+			sent++
+		}
+	}
+
+	if sent > 0 {
+		logger.Info("Sent event reminders", zap.Int("sent", sent))
+	}
+	return nil
+}
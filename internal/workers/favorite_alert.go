@@ -0,0 +1,159 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"eventix-api/internal/models"
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/database"
+	"eventix-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// favoriteAlertBatchSize bounds how many alerts of each kind are sent per sweep
+const favoriteAlertBatchSize = 200
+
+// FavoriteAlertWorker emails users when an event they saved is published or
+// its tickets go on sale
+type FavoriteAlertWorker struct {
+	emailService *services.EmailService
+	frontendURL  string
+}
+
+// NewFavoriteAlertWorker creates a new favorite alert worker
+func NewFavoriteAlertWorker(emailCfg *config.EmailConfig, frontendURL string) *FavoriteAlertWorker {
+	return &FavoriteAlertWorker{
+		emailService: services.NewEmailService(emailCfg),
+		frontendURL:  frontendURL,
+	}
+}
+
+// favoriteAlert is a saved event whose saver is due an alert
+type favoriteAlert struct {
+	UserID    uuid.UUID
+	EventID   uuid.UUID
+	Email     string
+	FirstName string
+}
+
+// sweep emails a batch of due publish and on-sale alerts
+func (w *FavoriteAlertWorker) sweep(ctx context.Context, _ sweepJob) error {
+	now := time.Now()
+	events := make(map[uuid.UUID]*models.Event)
+	sent := 0
+
+	// Events published since they were saved
+	var published []favoriteAlert
+	if err := w.pending(now).
+		Where("favorites.publish_notified_at IS NULL").
+		Limit(favoriteAlertBatchSize).
+		Scan(&published).Error; err != nil {
+		return fmt.Errorf("failed to fetch published favorites: %w", err)
+	}
+	for _, alert := range published {
+		event, onSale, ok := w.event(events, alert.EventID, now)
+		if !ok {
+			continue
+		}
+		if !w.send(ctx, alert, event, true, onSale) {
+			continue
+		}
+
+		updates := map[string]interface{}{"publish_notified_at": now}
+		if onSale {
+			updates["on_sale_notified_at"] = now
+		}
+		w.markNotified(alert, updates)
+		sent++
+	}
+
+	// Tiers whose sales opened after the event was saved
+	var onSale []favoriteAlert
+	if err := w.pending(now).
+		Where("favorites.publish_notified_at IS NOT NULL AND favorites.on_sale_notified_at IS NULL").
+		Where(`EXISTS (SELECT 1 FROM ticket_tiers WHERE ticket_tiers.event_id = events.id
+			AND ticket_tiers.deleted_at IS NULL AND ticket_tiers.available_quantity > 0
+			AND ticket_tiers.sale_start_time > favorites.created_at AND ticket_tiers.sale_start_time <= ?
+			AND (ticket_tiers.sale_end_time IS NULL OR ticket_tiers.sale_end_time > ?))`, now, now).
+		Limit(favoriteAlertBatchSize).
+		Scan(&onSale).Error; err != nil {
+		return fmt.Errorf("failed to fetch on-sale favorites: %w", err)
+	}
+	for _, alert := range onSale {
+		event, _, ok := w.event(events, alert.EventID, now)
+		if !ok {
+			continue
+		}
+		if !w.send(ctx, alert, event, false, true) {
+			continue
+		}
+		w.markNotified(alert, map[string]interface{}{"on_sale_notified_at": now})
+		sent++
+	}
+
+	if sent > 0 {
+		logger.Info("Sent favorite alerts", zap.Int("sent", sent))
+	}
+	return nil
+}
+
+// pending selects the favorites of live events saved by active users
+func (w *FavoriteAlertWorker) pending(now time.Time) *gorm.DB {
+	return database.DB.Table("favorites").
+		Select("favorites.user_id, favorites.event_id, users.email, users.first_name").
+		Joins("JOIN events ON events.id = favorites.event_id").
+		Joins("JOIN users ON users.id = favorites.user_id").
+		Where("events.status IN ? AND events.start_time > ? AND events.deleted_at IS NULL",
+			[]models.EventStatus{models.EventPublished, models.EventActive}, now).
+		Where("users.deleted_at IS NULL")
+}
+
+// event loads an event once per sweep and reports whether any of its tiers
+// is on sale
+func (w *FavoriteAlertWorker) event(events map[uuid.UUID]*models.Event, eventID uuid.UUID, now time.Time) (*models.Event, bool, bool) {
+	event, ok := events[eventID]
+	if !ok {
+		var loaded models.Event
+		if err := database.DB.Preload("TicketTiers").First(&loaded, eventID).Error; err != nil {
+			logger.Error("Failed to load saved event", zap.String("event_id", eventID.String()), zap.Error(err))
+			return nil, false, false
+		}
+		event = &loaded
+		events[eventID] = event
+	}
+
+	for _, tier := range event.TicketTiers {
+		if tier.AvailableQuantity > 0 &&
+			(tier.SaleStartTime == nil || !tier.SaleStartTime.After(now)) &&
+			(tier.SaleEndTime == nil || tier.SaleEndTime.After(now)) {
+			return event, true, true
+		}
+	}
+	return event, false, true
+}
+
+func (w *FavoriteAlertWorker) send(ctx context.Context, alert favoriteAlert, event *models.Event, published, onSale bool) bool {
+	if err := w.emailService.SendFavoriteEventEmail(ctx, alert.UserID, alert.Email, alert.FirstName, event, published, onSale, w.frontendURL); err != nil {
+		logger.Error("Failed to send favorite alert",
+			zap.String("event_id", alert.EventID.String()),
+			zap.String("user_id", alert.UserID.String()),
+			zap.Error(err),
+		)
+		return false
+	}
+	return true
+}
+
+func (w *FavoriteAlertWorker) markNotified(alert favoriteAlert, updates map[string]interface{}) {
+	if err := database.DB.Model(&models.Favorite{}).
+		Where("user_id = ? AND event_id = ?", alert.UserID, alert.EventID).
+		Updates(updates).Error; err != nil {
+		logger.Error("Failed to record favorite alert", zap.String("event_id", alert.EventID.String()), zap.Error(err))
+	}
+}
@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/jobs"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// sweepJob is the payload of the periodic sweeps, which need none
+type sweepJob struct{}
+
+var (
+	reservationExpiryJob = jobs.NewType[sweepJob]("reservations.expire")
+	orderExpiryJob       = jobs.NewType[sweepJob]("orders.expire")
+	eventReminderJob     = jobs.NewType[sweepJob]("events.remind")
+	favoriteAlertJob     = jobs.NewType[sweepJob]("favorites.alert")
+	pruneJobsJob         = jobs.NewType[sweepJob]("jobs.prune")
+)
+
+// RegisterJobs schedules the periodic sweeps on r: releasing expired
+// reservations, cancelling unpaid orders, event reminders, saved-event
+// alerts and deleting succeeded jobs past cfg.Limits.JobRetention
+func RegisterJobs(r *jobs.Runner, cfg *config.Config) error {
+	reservations := NewReservationExpiryWorker()
+	jobs.Handle(r, reservationExpiryJob, reservations.sweep)
+	if err := jobs.Schedule(r, "reservation expiry", jobs.Every(cfg.Limits.ReservationSweepInterval), reservationExpiryJob, sweepJob{}); err != nil {
+		return err
+	}
+
+	orders := NewOrderExpiryWorker(&cfg.Email)
+	jobs.Handle(r, orderExpiryJob, orders.sweep)
+	if err := jobs.Schedule(r, "order expiry", jobs.Every(cfg.Limits.OrderSweepInterval), orderExpiryJob, sweepJob{}); err != nil {
+		return err
+	}
+
+	reminders := NewEventReminderWorker(cfg.Limits.EventReminderLead, &cfg.SMS)
+	if reminders.smsService.Enabled() {
+		jobs.Handle(r, eventReminderJob, reminders.sweep)
+		if err := jobs.Schedule(r, "event reminders", jobs.Every(cfg.Limits.EventReminderInterval), eventReminderJob, sweepJob{}); err != nil {
+			return err
+		}
+	} else {
+		logger.Info("Event reminders disabled: no SMS provider configured")
+	}
+
+	alerts := NewFavoriteAlertWorker(&cfg.Email, cfg.Server.FrontendURL)
+	jobs.Handle(r, favoriteAlertJob, alerts.sweep)
+	if err := jobs.Schedule(r, "favorite alerts", jobs.Every(cfg.Limits.FavoriteAlertInterval), favoriteAlertJob, sweepJob{}); err != nil {
+		return err
+	}
+
+	retention := cfg.Limits.JobRetention
+	jobs.Handle(r, pruneJobsJob, func(ctx context.Context, _ sweepJob) error {
+		pruned, err := jobs.Prune(ctx, time.Now().Add(-retention))
+		if pruned > 0 {
+			logger.Info("Pruned succeeded jobs", zap.Int64("pruned", pruned))
+		}
+		return err
+	})
+	return jobs.Schedule(r, "prune jobs", "@hourly", pruneJobsJob, sweepJob{})
+}
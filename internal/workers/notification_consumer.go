@@ -0,0 +1,56 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/queue"
+
+	"go.uber.org/zap"
+)
+
+// NotificationConsumer delivers notification jobs pulled from the queue
+type NotificationConsumer struct {
+	emailService *services.EmailService
+	smsService   *services.SMSService
+}
+
+// NewNotificationConsumer creates a new notification consumer
+func NewNotificationConsumer(emailCfg *config.EmailConfig, smsCfg *config.SMSConfig) *NotificationConsumer {
+	return &NotificationConsumer{
+		emailService: services.NewEmailService(emailCfg),
+		smsService:   services.NewSMSService(smsCfg),
+	}
+}
+
+// Start consumes the notifications queue until ctx is cancelled
+func (w *NotificationConsumer) Start(ctx context.Context) error {
+	return queue.Consume(ctx, queue.NotificationsQueue(), w.Handle)
+}
+
+// Handle dispatches a single job by type
+func (w *NotificationConsumer) Handle(ctx context.Context, job queue.Job) error {
+	switch job.Type {
+	case services.EmailJobType:
+		var email services.EmailJob
+		if err := json.Unmarshal(job.Payload, &email); err != nil {
+			// Retrying cannot fix a malformed payload
+			logger.WithContext(ctx).Error("Discarding malformed email job", zap.String("job_id", job.ID), zap.Error(err))
+			return nil
+		}
+		return w.emailService.Deliver(ctx, email)
+	case services.SMSJobType:
+		var sms services.SMSJob
+		if err := json.Unmarshal(job.Payload, &sms); err != nil {
+			logger.WithContext(ctx).Error("Discarding malformed SMS job", zap.String("job_id", job.ID), zap.Error(err))
+			return nil
+		}
+		return w.smsService.Deliver(ctx, sms)
+	default:
+		return fmt.Errorf("unknown notification job type %q", job.Type)
+	}
+}
@@ -0,0 +1,75 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// orderExpiryBatchSize bounds how many orders are cancelled per sweep
+const orderExpiryBatchSize = 100
+
+// OrderExpiryWorker cancels pending orders that were not paid in time
+type OrderExpiryWorker struct {
+	emailCfg     *config.EmailConfig
+	orderService *services.OrderService
+}
+
+// NewOrderExpiryWorker creates a new order expiry worker
+func NewOrderExpiryWorker(emailCfg *config.EmailConfig) *OrderExpiryWorker {
+	return &OrderExpiryWorker{
+		emailCfg:     emailCfg,
+		orderService: services.NewOrderService(services.NewTicketService(), services.NewOrderStore()),
+	}
+}
+
+// sweep cancels a batch of expired orders and emails their buyers
+func (w *OrderExpiryWorker) sweep(ctx context.Context, _ sweepJob) error {
+	orders, err := w.orderService.FindExpiredOrders(orderExpiryBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to fetch expired orders: %w", err)
+	}
+
+	if len(orders) == 0 {
+		return nil
+	}
+
+	emailService := services.NewEmailService(w.emailCfg)
+	cancelled := 0
+
+	for _, order := range orders {
+		ok, err := w.orderService.CancelOrder(order.ID)
+		if err != nil {
+			logger.Error("Failed to cancel expired order", zap.String("order_id", order.ID.String()), zap.Error(err))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		cancelled++
+
+		if err := emailService.SendOrderCancelledEmail(
+			ctx,
+			order.UserID,
+			order.User.Email,
+			order.User.FirstName,
+			order.ID,
+			order.TotalAmount,
+			order.Currency,
+			order.Quantity,
+			"Your order was cancelled because payment was not completed in time.",
+		); err != nil {
+			logger.Error("Failed to send order cancelled email", zap.String("order_id", order.ID.String()), zap.Error(err))
+		}
+	}
+
+	if cancelled > 0 {
+		logger.Info("Cancelled expired orders", zap.Int("cancelled", cancelled))
+	}
+	return nil
+}
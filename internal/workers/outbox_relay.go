@@ -0,0 +1,86 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// outboxRelayBatchSize bounds how many messages are published per sweep
+const outboxRelayBatchSize = 100
+
+// OutboxRelayWorker publishes the domain events recorded in the outbox and
+// deletes them once they have been published for the retention period
+type OutboxRelayWorker struct {
+	interval      time.Duration
+	retention     time.Duration
+	outboxService *services.OutboxService
+}
+
+// NewOutboxRelayWorker creates a new outbox relay worker
+func NewOutboxRelayWorker(interval, retention time.Duration) *OutboxRelayWorker {
+	return &OutboxRelayWorker{
+		interval:      interval,
+		retention:     retention,
+		outboxService: services.NewOutboxService(),
+	}
+}
+
+// Start runs the sweep loop until ctx is cancelled
+func (w *OutboxRelayWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Pruning needs no more than an hourly pass
+	prune := time.NewTicker(time.Hour)
+	defer prune.Stop()
+
+	logger.Info("Outbox relay worker started",
+		zap.Duration("interval", w.interval),
+		zap.Duration("retention", w.retention),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Outbox relay worker stopped")
+			return
+		case <-ticker.C:
+			w.sweep(context.WithoutCancel(ctx))
+		case <-prune.C:
+			w.prune(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (w *OutboxRelayWorker) sweep(ctx context.Context) {
+	// A full batch means more are waiting, so keep going until the backlog drains
+	for {
+		published, err := w.outboxService.RelayDue(ctx, outboxRelayBatchSize)
+		if err != nil {
+			logger.Error("Failed to relay outbox messages", zap.Error(err))
+			return
+		}
+		if published > 0 {
+			logger.Debug("Published outbox messages", zap.Int("published", published))
+		}
+		if published < outboxRelayBatchSize {
+			return
+		}
+	}
+}
+
+func (w *OutboxRelayWorker) prune(ctx context.Context) {
+	deleted, err := w.outboxService.Prune(ctx, time.Now().Add(-w.retention))
+	if err != nil {
+		logger.Error("Failed to prune outbox", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		logger.Info("Pruned published outbox messages", zap.Int64("deleted", deleted))
+	}
+}
@@ -0,0 +1,47 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/queue"
+
+	"go.uber.org/zap"
+)
+
+// PaymentConsumer processes payment jobs pulled from the queue
+type PaymentConsumer struct {
+	cancellations *services.EventCancellationService
+}
+
+// NewPaymentConsumer creates a new payment consumer
+func NewPaymentConsumer(cfg *config.Config) *PaymentConsumer {
+	return &PaymentConsumer{
+		cancellations: services.NewEventCancellationService(cfg),
+	}
+}
+
+// Start consumes the payments queue until ctx is cancelled
+func (w *PaymentConsumer) Start(ctx context.Context) error {
+	return queue.Consume(ctx, queue.PaymentsQueue(), w.Handle)
+}
+
+// Handle dispatches a single job by type
+func (w *PaymentConsumer) Handle(ctx context.Context, job queue.Job) error {
+	switch job.Type {
+	case services.RefundJobType:
+		var refund services.RefundJob
+		if err := json.Unmarshal(job.Payload, &refund); err != nil {
+			// Retrying cannot fix a malformed payload
+			logger.WithContext(ctx).Error("Discarding malformed refund job", zap.String("job_id", job.ID), zap.Error(err))
+			return nil
+		}
+		return w.cancellations.Refund(ctx, refund)
+	default:
+		return fmt.Errorf("unknown payment job type %q", job.Type)
+	}
+}
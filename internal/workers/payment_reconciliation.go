@@ -0,0 +1,74 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// paymentReconcileBatchSize bounds how many payments are re-queried per sweep
+const paymentReconcileBatchSize = 50
+
+// PaymentReconciliationWorker re-queries the provider for payments stuck
+// pending, completing or failing them as the provider reports
+type PaymentReconciliationWorker struct {
+	interval       time.Duration
+	after          time.Duration
+	paymentService *services.PaymentService
+}
+
+// NewPaymentReconciliationWorker creates a new payment reconciliation worker
+func NewPaymentReconciliationWorker(cfg *config.Config) *PaymentReconciliationWorker {
+	return &PaymentReconciliationWorker{
+		interval:       cfg.Payment.ReconcileInterval,
+		after:          cfg.Payment.ReconcileAfter,
+		paymentService: services.NewPaymentService(cfg),
+	}
+}
+
+// Start runs the sweep loop until ctx is cancelled
+func (w *PaymentReconciliationWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Payment reconciliation worker started", zap.Duration("interval", w.interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Payment reconciliation worker stopped")
+			return
+		case <-ticker.C:
+			w.sweep(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (w *PaymentReconciliationWorker) sweep(ctx context.Context) {
+	payments, err := w.paymentService.FindStalePayments(w.after, paymentReconcileBatchSize)
+	if err != nil {
+		logger.Error("Failed to fetch stale payments", zap.Error(err))
+		return
+	}
+
+	settled := 0
+	for _, payment := range payments {
+		result, err := w.paymentService.VerifyPayment(ctx, payment.TransactionID)
+		if err != nil {
+			logger.Error("Failed to reconcile payment", zap.String("reference", payment.TransactionID), zap.Error(err))
+			continue
+		}
+		if result.Status != payment.Status {
+			settled++
+		}
+	}
+
+	if settled > 0 {
+		logger.Info("Reconciled stale payments", zap.Int("settled", settled))
+	}
+}
@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// ReconciliationReportWorker builds the nightly report comparing our
+// payments with each provider's settled transactions
+type ReconciliationReportWorker struct {
+	interval              time.Duration
+	reconciliationService *services.ReconciliationService
+}
+
+// NewReconciliationReportWorker creates a new reconciliation report worker
+func NewReconciliationReportWorker(cfg *config.PaymentConfig) *ReconciliationReportWorker {
+	return &ReconciliationReportWorker{
+		interval:              cfg.ReconciliationReportInterval,
+		reconciliationService: services.NewReconciliationService(cfg),
+	}
+}
+
+// Start runs the report loop until ctx is cancelled. Each tick reports the
+// previous day unless that report already exists.
+func (w *ReconciliationReportWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Reconciliation report worker started", zap.Duration("interval", w.interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Reconciliation report worker stopped")
+			return
+		case <-ticker.C:
+			w.reconciliationService.RunNightly(context.WithoutCancel(ctx))
+		}
+	}
+}
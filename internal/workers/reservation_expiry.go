@@ -0,0 +1,35 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// ReservationExpiryWorker releases ticket holds whose reservation expired
+type ReservationExpiryWorker struct {
+	ticketService *services.TicketService
+}
+
+// NewReservationExpiryWorker creates a new reservation expiry worker
+func NewReservationExpiryWorker() *ReservationExpiryWorker {
+	return &ReservationExpiryWorker{
+		ticketService: services.NewTicketService(),
+	}
+}
+
+// sweep puts the tickets of expired reservations back on sale
+func (w *ReservationExpiryWorker) sweep(ctx context.Context, _ sweepJob) error {
+	released, err := w.ticketService.ReleaseExpiredReservations(ctx)
+	if released > 0 {
+		logger.Info("Released expired reservations", zap.Int("released", released))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to release expired reservations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// WaitingRoomWorker admits the next batch of queued buyers on every interval
+type WaitingRoomWorker struct {
+	interval           time.Duration
+	waitingRoomService *services.WaitingRoomService
+}
+
+// NewWaitingRoomWorker creates a new waiting room worker
+func NewWaitingRoomWorker(cfg *config.LimitsConfig) *WaitingRoomWorker {
+	return &WaitingRoomWorker{
+		interval:           cfg.WaitingRoomAdmitInterval,
+		waitingRoomService: services.NewWaitingRoomService(cfg),
+	}
+}
+
+// Start runs the admission loop until ctx is cancelled
+func (w *WaitingRoomWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Waiting room worker started", zap.Duration("interval", w.interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Waiting room worker stopped")
+			return
+		case <-ticker.C:
+			w.admit(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (w *WaitingRoomWorker) admit(ctx context.Context) {
+	admitted, err := w.waitingRoomService.AdmitNext(ctx)
+	if err != nil {
+		logger.Error("Failed to admit from waiting rooms", zap.Error(err))
+	}
+
+	if admitted > 0 {
+		logger.Info("Admitted buyers from waiting rooms", zap.Int("admitted", admitted))
+	}
+}
@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// WarehouseExportWorker exports the previous day's data to the warehouse
+// bucket every night
+type WarehouseExportWorker struct {
+	interval      time.Duration
+	exportService *services.WarehouseExportService
+}
+
+// NewWarehouseExportWorker creates a new warehouse export worker
+func NewWarehouseExportWorker(cfg *config.ExportConfig) *WarehouseExportWorker {
+	return &WarehouseExportWorker{
+		interval:      cfg.Interval,
+		exportService: services.NewWarehouseExportService(cfg),
+	}
+}
+
+// Start runs the export loop until ctx is cancelled. Each tick exports the
+// previous day unless that export already completed.
+func (w *WarehouseExportWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Warehouse export worker started", zap.Duration("interval", w.interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Warehouse export worker stopped")
+			return
+		case <-ticker.C:
+			w.exportService.RunNightly(context.WithoutCancel(ctx))
+		}
+	}
+}
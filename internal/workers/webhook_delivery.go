@@ -0,0 +1,63 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"eventix-api/internal/services"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// webhookDeliveryBatchSize bounds how many deliveries are sent per sweep
+const webhookDeliveryBatchSize = 20
+
+// WebhookDeliveryWorker sends queued organizer webhook deliveries and their retries
+type WebhookDeliveryWorker struct {
+	interval       time.Duration
+	maxAttempts    int
+	webhookService *services.OrganizerWebhookService
+}
+
+// NewWebhookDeliveryWorker creates a new webhook delivery worker
+func NewWebhookDeliveryWorker(interval time.Duration, maxAttempts int) *WebhookDeliveryWorker {
+	return &WebhookDeliveryWorker{
+		interval:       interval,
+		maxAttempts:    maxAttempts,
+		webhookService: services.NewOrganizerWebhookService(),
+	}
+}
+
+// Start runs the sweep loop until ctx is cancelled
+func (w *WebhookDeliveryWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	logger.Info("Webhook delivery worker started",
+		zap.Duration("interval", w.interval),
+		zap.Int("max_attempts", w.maxAttempts),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Webhook delivery worker stopped")
+			return
+		case <-ticker.C:
+			w.sweep(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (w *WebhookDeliveryWorker) sweep(ctx context.Context) {
+	delivered, err := w.webhookService.DeliverDue(ctx, w.maxAttempts, webhookDeliveryBatchSize)
+	if err != nil {
+		logger.Error("Failed to deliver webhooks", zap.Error(err))
+		return
+	}
+
+	if delivered > 0 {
+		logger.Info("Delivered webhooks", zap.Int("delivered", delivered))
+	}
+}
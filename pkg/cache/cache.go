@@ -124,10 +124,7 @@ func TTL(ctx context.Context, key string) (time.Duration, error) {
 }
 
 // Health checks the Redis health
-func Health() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-	defer cancel()
-
+func Health(ctx context.Context) error {
 	return Client.Ping(ctx).Err()
 }
 
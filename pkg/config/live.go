@@ -0,0 +1,40 @@
+package config
+
+import "sync/atomic"
+
+// Live holds the configuration in use by a running server. Reload swaps in
+// a copy with freshly loaded non-critical settings, so readers always see a
+// consistent Config without locking.
+type Live struct {
+	path    string
+	current atomic.Pointer[Config]
+}
+
+// NewLive wraps cfg, which was loaded from the config file at path (empty
+// for none), for reloading
+func NewLive(cfg *Config, path string) *Live {
+	l := &Live{path: path}
+	l.current.Store(cfg)
+	return l
+}
+
+// Get returns the configuration currently in use
+func (l *Live) Get() *Config {
+	return l.current.Load()
+}
+
+// Reload loads the environment and config file again and applies the
+// settings that are safe to change while running, the Limits. Only code
+// reading them through Get sees the change; background workers and
+// connections keep their startup values until a restart.
+func (l *Live) Reload() (*Config, error) {
+	loaded, err := LoadFile(l.path)
+	if err != nil {
+		return nil, err
+	}
+
+	next := *l.Get()
+	next.Limits = loaded.Limits
+	l.current.Store(&next)
+	return &next, nil
+}
@@ -0,0 +1,90 @@
+package currency
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Default is used for events and orders that do not specify a currency
+const Default = "USD"
+
+// Currency describes an ISO 4217 currency accepted for ticket sales
+type Currency struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Exponent int    `json:"exponent"` // digits after the decimal point in the minor unit
+}
+
+var supported = map[string]Currency{
+	"USD": {Code: "USD", Name: "US Dollar", Symbol: "$", Exponent: 2},
+	"EUR": {Code: "EUR", Name: "Euro", Symbol: "€", Exponent: 2},
+	"GBP": {Code: "GBP", Name: "Pound Sterling", Symbol: "£", Exponent: 2},
+	"CAD": {Code: "CAD", Name: "Canadian Dollar", Symbol: "CA$", Exponent: 2},
+	"NGN": {Code: "NGN", Name: "Nigerian Naira", Symbol: "₦", Exponent: 2},
+	"GHS": {Code: "GHS", Name: "Ghanaian Cedi", Symbol: "GH₵", Exponent: 2},
+	"KES": {Code: "KES", Name: "Kenyan Shilling", Symbol: "KSh", Exponent: 2},
+	"ZAR": {Code: "ZAR", Name: "South African Rand", Symbol: "R", Exponent: 2},
+	"JPY": {Code: "JPY", Name: "Japanese Yen", Symbol: "¥", Exponent: 0},
+}
+
+// Normalize upper-cases and trims a currency code
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Lookup returns the supported currency for code
+func Lookup(code string) (Currency, bool) {
+	c, ok := supported[Normalize(code)]
+	return c, ok
+}
+
+// IsSupported reports whether code is a supported ISO 4217 currency
+func IsSupported(code string) bool {
+	_, ok := Lookup(code)
+	return ok
+}
+
+// Supported returns all supported currencies ordered by code
+func Supported() []Currency {
+	list := make([]Currency, 0, len(supported))
+	for _, c := range supported {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// exponent returns the minor unit exponent for code, assuming 2 for unknown codes
+func exponent(code string) int {
+	if c, ok := Lookup(code); ok {
+		return c.Exponent
+	}
+	return 2
+}
+
+// ToMinor converts an amount to the smallest unit of code (cents, kobo, yen)
+func ToMinor(amount float64, code string) int64 {
+	return int64(math.Round(amount * math.Pow10(exponent(code))))
+}
+
+// FromMinor converts an amount in the smallest unit of code to a major-unit amount
+func FromMinor(amount int64, code string) float64 {
+	return float64(amount) / math.Pow10(exponent(code))
+}
+
+// Decimal renders an amount in minor units as a plain decimal, e.g. 5000.00
+func Decimal(amount int64, code string) string {
+	return fmt.Sprintf("%.*f", exponent(code), FromMinor(amount, code))
+}
+
+// Format renders an amount in minor units with the currency symbol, e.g. ₦5000.00
+func Format(amount int64, code string) string {
+	c, ok := Lookup(code)
+	if !ok {
+		return fmt.Sprintf("%s %.2f", Normalize(code), FromMinor(amount, code))
+	}
+	return fmt.Sprintf("%s%.*f", c.Symbol, c.Exponent, FromMinor(amount, code))
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,11 +11,19 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
 )
 
+// replicaResolver names the dbresolver set serving replica reads. Nothing
+// is routed to it unless a query opts in through Reader.
+const replicaResolver = "eventix:replicas"
+
 // DB holds the database connection
 var DB *gorm.DB
 
+// hasReplicas reports whether read replicas were configured
+var hasReplicas bool
+
 // Connect establishes a connection to the database
 func Connect(cfg *config.DatabaseConfig) error {
 	dsn := fmt.Sprintf(
@@ -57,14 +66,45 @@ func Connect(cfg *config.DatabaseConfig) error {
 		return fmt.Errorf("failed to ping database: %w", err)
 	}
 
+	if len(cfg.ReplicaDSNs) > 0 {
+		replicas := make([]gorm.Dialector, 0, len(cfg.ReplicaDSNs))
+		for _, replicaDSN := range cfg.ReplicaDSNs {
+			replicas = append(replicas, postgres.Open(replicaDSN))
+		}
+
+		resolver := dbresolver.Register(dbresolver.Config{
+			Replicas: replicas,
+			Policy:   dbresolver.RandomPolicy{},
+		}, replicaResolver).
+			SetMaxOpenConns(cfg.MaxConnections).
+			SetMaxIdleConns(cfg.MaxIdleConns).
+			SetConnMaxLifetime(cfg.MaxLifetime)
+
+		if err := DB.Use(resolver); err != nil {
+			return fmt.Errorf("failed to register read replicas: %w", err)
+		}
+		hasReplicas = true
+	}
+
 	logger.Info("Database connected successfully",
 		zap.String("host", cfg.Host),
 		zap.String("database", cfg.Name),
+		zap.Int("replicas", len(cfg.ReplicaDSNs)),
 	)
 
 	return nil
 }
 
+// Reader routes the reads of db to a read replica when any are configured.
+// Replicas may lag the primary, so use it only for listings and reports that
+// tolerate slightly stale data, never to read back a write.
+func Reader(db *gorm.DB) *gorm.DB {
+	if !hasReplicas {
+		return db
+	}
+	return db.Clauses(dbresolver.Use(replicaResolver))
+}
+
 // Close closes the database connection
 func Close() error {
 	if DB == nil {
@@ -85,7 +125,7 @@ func Get() *gorm.DB {
 }
 
 // Health checks the database health
-func Health() error {
+func Health(ctx context.Context) error {
 	if DB == nil {
 		return fmt.Errorf("database not initialized")
 	}
@@ -95,7 +135,7 @@ func Health() error {
 		return err
 	}
 
-	return sqlDB.Ping()
+	return sqlDB.PingContext(ctx)
 }
 
 // Migrate runs auto migration for given models
@@ -0,0 +1,142 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Type identifies a domain event
+type Type string
+
+const (
+	OrderPaid       Type = "order.paid"
+	TicketCheckedIn Type = "ticket.checked_in"
+	EventPublished  Type = "event.published"
+)
+
+// Envelope is the structure written to the message bus for every event
+type Envelope struct {
+	ID         string      `json:"id"`
+	Type       Type        `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	RequestID  string      `json:"request_id,omitempty"`
+	Data       interface{} `json:"data"`
+}
+
+// OrderPaidData is published once an order has been paid and its tickets issued
+type OrderPaidData struct {
+	OrderID     uuid.UUID `json:"order_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	EventID     uuid.UUID `json:"event_id"`
+	TierID      uuid.UUID `json:"tier_id"`
+	Quantity    int       `json:"quantity"`
+	TotalAmount int64     `json:"total_amount"` // minor units
+	Currency    string    `json:"currency"`
+}
+
+// TicketCheckedInData is published when a ticket is scanned at the door
+type TicketCheckedInData struct {
+	TicketID  uuid.UUID `json:"ticket_id"`
+	EventID   uuid.UUID `json:"event_id"`
+	TierID    uuid.UUID `json:"tier_id"`
+	ScannedBy uuid.UUID `json:"scanned_by"`
+	ScannedAt time.Time `json:"scanned_at"`
+}
+
+// EventPublishedData is published when an event goes live
+type EventPublishedData struct {
+	EventID     uuid.UUID `json:"event_id"`
+	OrganizerID uuid.UUID `json:"organizer_id"`
+	Title       string    `json:"title"`
+	StartTime   time.Time `json:"start_time"`
+}
+
+// Publisher delivers envelopes to a message bus
+type Publisher interface {
+	// Publish waits until the bus has accepted the envelope
+	Publish(ctx context.Context, topic, key string, envelope Envelope) error
+	Close() error
+}
+
+var (
+	publisher Publisher = noopPublisher{}
+	topics    map[Type]string
+)
+
+// Init configures the publisher. Publishing is a no-op unless Kafka is enabled.
+func Init(cfg *config.KafkaConfig) {
+	topics = map[Type]string{
+		OrderPaid:       cfg.TopicPayments,
+		TicketCheckedIn: cfg.TopicCheckins,
+		EventPublished:  cfg.TopicEvents,
+	}
+
+	if !cfg.Enabled {
+		logger.Info("Kafka disabled, domain events will not be published")
+		return
+	}
+
+	publisher = NewKafkaPublisher(cfg.Brokers)
+	logger.Info("Kafka publisher configured", zap.Strings("brokers", cfg.Brokers))
+}
+
+// NewEnvelope wraps data in an envelope with a new ID, stamped with the
+// current time and ctx's request ID
+func NewEnvelope(ctx context.Context, eventType Type, data interface{}) Envelope {
+	return Envelope{
+		ID:         uuid.New().String(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		RequestID:  logger.RequestIDFromContext(ctx),
+		Data:       data,
+	}
+}
+
+// Publish sends an envelope keyed by key, which determines its partition,
+// to the topic of its type. Domain events reach it through the outbox relay
+// rather than from request handlers, so a failure leaves the event to be
+// retried instead of losing it.
+func Publish(ctx context.Context, key string, envelope Envelope) error {
+	topic, ok := topics[envelope.Type]
+	if !ok {
+		return fmt.Errorf("no topic configured for event %s", envelope.Type)
+	}
+	return publisher.Publish(ctx, topic, key, envelope)
+}
+
+// Enabled reports whether domain events are published to Kafka
+func Enabled() bool {
+	_, ok := publisher.(*KafkaPublisher)
+	return ok
+}
+
+// Health checks that a Kafka broker is reachable
+func Health(ctx context.Context) error {
+	kafkaPublisher, ok := publisher.(*KafkaPublisher)
+	if !ok {
+		return nil
+	}
+	return kafkaPublisher.Ping(ctx)
+}
+
+// Close flushes and closes the publisher
+func Close() error {
+	return publisher.Close()
+}
+
+type noopPublisher struct{}
+
+func (noopPublisher) Publish(ctx context.Context, topic, key string, envelope Envelope) error {
+	return nil
+}
+
+func (noopPublisher) Close() error {
+	return nil
+}
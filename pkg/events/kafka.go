@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaPublisher publishes envelopes to Kafka topics
+type KafkaPublisher struct {
+	brokers []string
+	writer  *kafka.Writer
+}
+
+// NewKafkaPublisher creates a publisher for the given brokers. Writes wait
+// for every in-sync replica to acknowledge them, so a returned nil means the
+// event is stored.
+func NewKafkaPublisher(brokers []string) *KafkaPublisher {
+	return &KafkaPublisher{
+		brokers: brokers,
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+// Publish writes an envelope to topic
+func (p *KafkaPublisher) Publish(ctx context.Context, topic, key string, envelope Envelope) error {
+	value, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: "event_type", Value: []byte(envelope.Type)},
+		},
+	})
+}
+
+// Ping succeeds once any broker accepts a connection
+func (p *KafkaPublisher) Ping(ctx context.Context) error {
+	var err error
+	for _, broker := range p.brokers {
+		var conn *kafka.Conn
+		conn, err = kafka.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			return conn.Close()
+		}
+	}
+	if err == nil {
+		return fmt.Errorf("no kafka brokers configured")
+	}
+	return fmt.Errorf("no kafka broker reachable: %w", err)
+}
+
+// Close closes the writer
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
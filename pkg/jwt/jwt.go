@@ -9,68 +9,125 @@ import (
 	"eventix-api/pkg/config"
 )
 
+// Token types, carried in the token_type claim
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+	TokenTypeScanner = "scanner" // door staff, check-in of one event only
+)
+
+// ScannerRole is the role carried by scanner tokens
+const ScannerRole = "scanner"
+
 type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
-	Role   string `json:"role"`
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	EventID   string `json:"event_id,omitempty"` // scanner tokens only
 	jwt.RegisteredClaims
 }
 
 type TokenPair struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	ExpiresAt    int64  `json:"expires_at"`
+	AccessToken    string `json:"access_token"`
+	RefreshToken   string `json:"refresh_token"`
+	RefreshTokenID string `json:"-"` // jti of the refresh token
+	ExpiresAt      int64  `json:"expires_at"`
 }
 
 var jwtConfig *config.JWTConfig
 
-// Init initializes the JWT configuration
-func Init(cfg *config.JWTConfig) {
+// Init initializes the JWT configuration and loads the signing keys of
+// asymmetric algorithms
+func Init(cfg *config.JWTConfig) error {
+	ring, err := loadKeyRing(cfg.Algorithm, cfg.PrivateKeyFile, cfg.PreviousPublicKeyFiles)
+	if err != nil {
+		return err
+	}
+
 	jwtConfig = cfg
+	keys = ring
+	return nil
 }
 
-// GenerateTokenPair generates access and refresh tokens
-func GenerateTokenPair(userID, email, role string) (*TokenPair, error) {
+// GenerateTokenPair generates access and refresh tokens for a session
+func GenerateTokenPair(userID, email, role, sessionID string) (*TokenPair, error) {
 	// Generate access token
-	accessToken, expiresAt, err := GenerateToken(userID, email, role, jwtConfig.Expiry)
+	accessToken, expiresAt, err := signToken(userID, email, role, sessionID, TokenTypeAccess, uuid.New().String(), jwtConfig.Expiry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	// Generate refresh token
-	refreshToken, _, err := GenerateToken(userID, email, role, jwtConfig.RefreshTokenExpiry)
+	refreshTokenID := uuid.New().String()
+	refreshToken, _, err := signToken(userID, email, role, sessionID, TokenTypeRefresh, refreshTokenID, jwtConfig.RefreshTokenExpiry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
 	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresAt:    expiresAt.Unix(),
+		AccessToken:    accessToken,
+		RefreshToken:   refreshToken,
+		RefreshTokenID: refreshTokenID,
+		ExpiresAt:      expiresAt.Unix(),
 	}, nil
 }
 
-// GenerateToken generates a JWT token
+// GenerateToken generates a JWT access token not tied to a session
 func GenerateToken(userID, email, role string, expiry time.Duration) (string, time.Time, error) {
+	return signToken(userID, email, role, "", TokenTypeAccess, uuid.New().String(), expiry)
+}
+
+// GenerateScannerToken generates a token that only reaches the check-in
+// endpoints of one event. Check-ins are recorded against issuerID, and
+// tokenID doubles as the session ID so the token can be revoked like a
+// signed-out session.
+func GenerateScannerToken(issuerID, eventID, tokenID string, expiresAt time.Time) (string, error) {
+	claims := &Claims{
+		UserID:    issuerID,
+		Role:      ScannerRole,
+		SessionID: tokenID,
+		TokenType: TokenTypeScanner,
+		EventID:   eventID,
+	}
+	token, _, err := signClaims(claims, tokenID, time.Until(expiresAt))
+	return token, err
+}
+
+func signToken(userID, email, role, sessionID, tokenType, tokenID string, expiry time.Duration) (string, time.Time, error) {
+	return signClaims(&Claims{
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		SessionID: sessionID,
+		TokenType: tokenType,
+	}, tokenID, expiry)
+}
+
+func signClaims(claims *Claims, tokenID string, expiry time.Duration) (string, time.Time, error) {
 	now := time.Now()
 	expiresAt := now.Add(expiry)
 
-	claims := &Claims{
-		UserID: userID,
-		Email:  email,
-		Role:   role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expiresAt),
-			IssuedAt:  jwt.NewNumericDate(now),
-			NotBefore: jwt.NewNumericDate(now),
-			Issuer:    jwtConfig.Issuer,
-			Subject:   userID,
-			ID:        uuid.New().String(),
-		},
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(jwtConfig.Secret))
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		Issuer:    jwtConfig.Issuer,
+		Subject:   claims.UserID,
+		ID:        tokenID,
+	}
+
+	var tokenString string
+	var err error
+	if keys != nil {
+		token := jwt.NewWithClaims(keys.method, claims)
+		token.Header["kid"] = keys.signingKid
+		tokenString, err = token.SignedString(keys.signingKey)
+	} else {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		tokenString, err = token.SignedString([]byte(jwtConfig.Secret))
+	}
 	if err != nil {
 		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -81,6 +138,10 @@ func GenerateToken(userID, email, role string, expiry time.Duration) (string, ti
 // ValidateToken validates a JWT token and returns the claims
 func ValidateToken(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		if keys != nil {
+			return keys.verificationKey(token)
+		}
+
 		// Validate the signing method
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -100,15 +161,29 @@ func ValidateToken(tokenString string) (*Claims, error) {
 	return claims, nil
 }
 
-// RefreshAccessToken generates a new access token from a refresh token
+// ValidateRefreshToken validates a refresh token and returns its claims.
+// Access tokens are rejected so they cannot be exchanged for new sessions.
+func ValidateRefreshToken(tokenString string) (*Claims, error) {
+	claims, err := ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeRefresh || claims.SessionID == "" {
+		return nil, fmt.Errorf("not a refresh token")
+	}
+	return claims, nil
+}
+
+// RefreshAccessToken generates a new token pair for the session of a refresh
+// token. It does not check whether the session was revoked.
 func RefreshAccessToken(refreshToken string) (*TokenPair, error) {
-	claims, err := ValidateToken(refreshToken)
+	claims, err := ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return nil, fmt.Errorf("invalid refresh token: %w", err)
 	}
 
 	// Generate new token pair
-	return GenerateTokenPair(claims.UserID, claims.Email, claims.Role)
+	return GenerateTokenPair(claims.UserID, claims.Email, claims.Role, claims.SessionID)
 }
 
 // ExtractUserID extracts user ID from token
@@ -0,0 +1,255 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported signing algorithms
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+)
+
+// JWK is a public key in JSON Web Key form (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWKSet is the document served at /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// keyRing holds the asymmetric keys tokens are signed and verified with.
+// Only the signing key issues tokens; the others keep tokens signed before
+// a rotation valid until they expire.
+type keyRing struct {
+	method     jwt.SigningMethod
+	signingKey crypto.Signer
+	signingKid string
+	verifiers  map[string]crypto.PublicKey
+	jwks       JWKSet
+}
+
+var keys *keyRing
+
+// loadKeyRing reads the signing key and any previous public keys for an
+// asymmetric algorithm. It returns nil for HS256.
+func loadKeyRing(algorithm, privateKeyFile string, previousKeyFiles []string) (*keyRing, error) {
+	var method jwt.SigningMethod
+	switch algorithm {
+	case AlgorithmHS256:
+		return nil, nil
+	case AlgorithmRS256:
+		method = jwt.SigningMethodRS256
+	case AlgorithmES256:
+		method = jwt.SigningMethodES256
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", algorithm)
+	}
+
+	if privateKeyFile == "" {
+		return nil, fmt.Errorf("a private key file is required for %s", algorithm)
+	}
+	signer, err := readSigningKey(privateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ring := &keyRing{
+		method:     method,
+		signingKey: signer,
+		verifiers:  make(map[string]crypto.PublicKey),
+		jwks:       JWKSet{Keys: []JWK{}},
+	}
+
+	publicKeys := []crypto.PublicKey{signer.Public()}
+	for _, path := range previousKeyFiles {
+		key, err := readPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, key)
+	}
+
+	for i, key := range publicKeys {
+		jwk, err := toJWK(key, algorithm)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			ring.signingKid = jwk.Kid
+		}
+		if _, dup := ring.verifiers[jwk.Kid]; dup {
+			continue
+		}
+		ring.verifiers[jwk.Kid] = key
+		ring.jwks.Keys = append(ring.jwks.Keys, jwk)
+	}
+
+	return ring, nil
+}
+
+// JWKS returns the public keys that verify tokens issued by this service.
+// The set is empty when tokens are signed with a shared HS256 secret.
+func JWKS() JWKSet {
+	if keys == nil {
+		return JWKSet{Keys: []JWK{}}
+	}
+	return keys.jwks
+}
+
+// verificationKey picks the public key named by a token's kid header
+func (r *keyRing) verificationKey(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() != r.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		kid = r.signingKid
+	}
+	key, ok := r.verifiers[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func readSigningKey(path string) (crypto.Signer, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("JWT private key must be an RSA or ECDSA key")
+	}
+	return signer, nil
+}
+
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWT verification certificate: %w", err)
+		}
+		return cert.PublicKey, nil
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWT verification key: %w", err)
+		}
+		return key, nil
+	default:
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWT verification key: %w", err)
+		}
+		return key, nil
+	}
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", path)
+	}
+	return block, nil
+}
+
+// toJWK encodes a public key for algorithm, using its RFC 7638 thumbprint as kid
+func toJWK(key crypto.PublicKey, algorithm string) (JWK, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if algorithm != AlgorithmRS256 {
+			return JWK{}, fmt.Errorf("%s requires ECDSA keys, got RSA", algorithm)
+		}
+		jwk := JWK{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: algorithm,
+			N:   encodeBase64URL(k.N.Bytes()),
+			E:   encodeBase64URL(big.NewInt(int64(k.E)).Bytes()),
+		}
+		jwk.Kid = thumbprint(map[string]string{"e": jwk.E, "kty": jwk.Kty, "n": jwk.N})
+		return jwk, nil
+	case *ecdsa.PublicKey:
+		if algorithm != AlgorithmES256 {
+			return JWK{}, fmt.Errorf("%s requires RSA keys, got ECDSA", algorithm)
+		}
+		if k.Curve != elliptic.P256() {
+			return JWK{}, fmt.Errorf("ES256 keys must use the P-256 curve")
+		}
+		jwk := JWK{
+			Kty: "EC",
+			Use: "sig",
+			Alg: algorithm,
+			Crv: "P-256",
+			X:   encodeBase64URL(k.X.FillBytes(make([]byte, 32))),
+			Y:   encodeBase64URL(k.Y.FillBytes(make([]byte, 32))),
+		}
+		jwk.Kid = thumbprint(map[string]string{"crv": jwk.Crv, "kty": jwk.Kty, "x": jwk.X, "y": jwk.Y})
+		return jwk, nil
+	default:
+		return JWK{}, fmt.Errorf("unsupported JWT key type %T", key)
+	}
+}
+
+// thumbprint hashes the required JWK members; json.Marshal sorts map keys
+// and emits no whitespace, which is the canonical form RFC 7638 asks for
+func thumbprint(members map[string]string) string {
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return encodeBase64URL(sum[:])
+}
+
+func encodeBase64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
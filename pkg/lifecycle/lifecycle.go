@@ -0,0 +1,82 @@
+// Package lifecycle runs a process's background workers and drains them on
+// shutdown. Workers stop taking new work once the shared context is
+// cancelled; work already started should run on context.WithoutCancel so it
+// finishes rather than failing halfway.
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Manager starts workers on a shared context and waits for them to return
+// when it is cancelled
+type Manager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[string]int
+}
+
+// New creates a manager whose context is cancelled by Shutdown
+func New() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{ctx: ctx, cancel: cancel, running: map[string]int{}}
+}
+
+// Context returns the context shared by every worker
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Go runs a worker in its own goroutine until it returns. name identifies
+// the worker in shutdown logs.
+func (m *Manager) Go(name string, run func(ctx context.Context)) {
+	m.wg.Add(1)
+	m.mu.Lock()
+	m.running[name]++
+	m.mu.Unlock()
+
+	go func() {
+		defer m.wg.Done()
+		defer func() {
+			m.mu.Lock()
+			if m.running[name]--; m.running[name] == 0 {
+				delete(m.running, name)
+			}
+			m.mu.Unlock()
+		}()
+		run(m.ctx)
+	}()
+}
+
+// Shutdown cancels the shared context and waits for every worker to return
+// or for ctx to end, in which case it names the workers still running
+func (m *Manager) Shutdown(ctx context.Context) error {
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		names := make([]string, 0, len(m.running))
+		for name := range m.running {
+			names = append(names, name)
+		}
+		m.mu.Unlock()
+		sort.Strings(names)
+		return fmt.Errorf("workers still running: %s", strings.Join(names, ", "))
+	}
+}
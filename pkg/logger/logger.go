@@ -12,6 +12,13 @@ import (
 
 var log *zap.Logger
 
+type contextKey string
+
+const (
+	requestIDKey contextKey = "request_id"
+	userIDKey    contextKey = "user_id"
+)
+
 // Init initializes the logger
 func Init(level, format string) error {
 	var config zap.Config
@@ -79,10 +86,43 @@ func Fatal(msg string, fields ...zap.Field) {
 	Get().Fatal(msg, fields...)
 }
 
-// WithContext returns a logger with context fields
+// WithContext returns a logger carrying the request and user IDs stored in ctx
 func WithContext(ctx context.Context) *zap.Logger {
-	// Extract request ID or trace ID from context if available
-	return Get()
+	if ctx == nil {
+		return Get()
+	}
+
+	var fields []zap.Field
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		fields = append(fields, zap.String("request_id", requestID))
+	}
+	if userID, ok := ctx.Value(userIDKey).(string); ok && userID != "" {
+		fields = append(fields, zap.String("user_id", userID))
+	}
+
+	if len(fields) == 0 {
+		return Get()
+	}
+	return Get().With(fields...)
+}
+
+// ContextWithRequestID returns a copy of ctx carrying requestID
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// ContextWithUserID returns a copy of ctx carrying the authenticated user's ID
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, if any
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
 }
 
 // Sync flushes any buffered log entries
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// APIKeyHeader carries an organizer integration key
+const APIKeyHeader = "X-API-Key"
+
+// ErrInvalidAPIKey is returned by an APIKeyAuthenticator for unknown or
+// revoked keys
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// APIKeyIdentity is who an integration key acts as
+type APIKeyIdentity struct {
+	KeyID       string
+	KeyName     string
+	OrganizerID string
+	UserID      string // the user who created the key
+	Role        string
+}
+
+// APIKeyAuthenticator resolves an integration key
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*APIKeyIdentity, error)
+}
+
+// APIKeyMiddleware authenticates organizer integrations such as Zapier by the
+// key in the X-API-Key header. The key's organizer is set in the
+// organizer_id local, and user_id and role are those of the key's creator so
+// audited actions are recorded against them.
+func APIKeyMiddleware(authenticator APIKeyAuthenticator) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(APIKeyHeader)
+		if key == "" {
+			return utils.UnauthorizedResponse(c, "X-API-Key header required")
+		}
+
+		identity, err := authenticator.AuthenticateAPIKey(c.UserContext(), key)
+		if errors.Is(err, ErrInvalidAPIKey) {
+			return utils.UnauthorizedResponse(c, "Invalid or revoked API key")
+		}
+		if err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to check API key", zap.Error(err))
+			return utils.InternalServerErrorResponse(c, "Failed to check API key")
+		}
+
+		c.Locals("user_id", identity.UserID)
+		c.Locals("role", identity.Role)
+		c.Locals("organizer_id", identity.OrganizerID)
+		c.Locals("api_key_id", identity.KeyID)
+		c.Locals("api_key_name", identity.KeyName)
+		c.SetUserContext(logger.ContextWithUserID(c.UserContext(), identity.UserID))
+
+		return c.Next()
+	}
+}
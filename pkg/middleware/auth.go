@@ -4,12 +4,33 @@ import (
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+	"eventix-api/pkg/cache"
 	"eventix-api/pkg/jwt"
+	"eventix-api/pkg/logger"
 	"eventix-api/pkg/utils"
+	"go.uber.org/zap"
 )
 
-// AuthMiddleware validates JWT token
+// AuthMiddleware validates JWT token. Guest checkout and scanner tokens are
+// rejected.
 func AuthMiddleware() fiber.Handler {
+	return authenticate(false, false)
+}
+
+// GuestCheckoutMiddleware validates JWT token like AuthMiddleware but also
+// accepts guest checkout tokens. Use it only on reservation and payment routes.
+func GuestCheckoutMiddleware() fiber.Handler {
+	return authenticate(true, false)
+}
+
+// CheckinAuthMiddleware validates JWT token like AuthMiddleware but also
+// accepts door staff scanner tokens, whose event is set in the scanner_event_id
+// local. Use it only on check-in routes.
+func CheckinAuthMiddleware() fiber.Handler {
+	return authenticate(false, true)
+}
+
+func authenticate(allowGuests, allowScanners bool) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Get authorization header
 		authHeader := c.Get("Authorization")
@@ -27,14 +48,31 @@ func AuthMiddleware() fiber.Handler {
 
 		// Validate token
 		claims, err := jwt.ValidateToken(token)
-		if err != nil {
+		if err != nil || claims.TokenType == jwt.TokenTypeRefresh {
 			return utils.UnauthorizedResponse(c, "Invalid or expired token")
 		}
 
+		if !allowGuests && claims.Role == "guest" {
+			return utils.ForbiddenResponse(c, "Claim your account to access this resource")
+		}
+		if claims.TokenType == jwt.TokenTypeScanner && (!allowScanners || claims.EventID == "") {
+			return utils.ForbiddenResponse(c, "Scanner tokens can only be used for check-in")
+		}
+
+		// Tokens of a signed-out device stay rejected until they expire
+		if sessionRevoked(c, claims) {
+			return utils.UnauthorizedResponse(c, "Session has been revoked")
+		}
+
 		// Set user info in context
 		c.Locals("user_id", claims.UserID)
 		c.Locals("email", claims.Email)
 		c.Locals("role", claims.Role)
+		c.Locals("session_id", claims.SessionID)
+		if claims.TokenType == jwt.TokenTypeScanner {
+			c.Locals("scanner_event_id", claims.EventID)
+		}
+		c.SetUserContext(logger.ContextWithUserID(c.UserContext(), claims.UserID))
 
 		return c.Next()
 	}
@@ -76,7 +114,12 @@ func OptionalAuthMiddleware() fiber.Handler {
 
 		token := parts[1]
 		claims, err := jwt.ValidateToken(token)
-		if err != nil {
+		if err != nil || claims.TokenType == jwt.TokenTypeRefresh || claims.TokenType == jwt.TokenTypeScanner {
+			return c.Next()
+		}
+
+		// A signed-out device browses anonymously
+		if sessionRevoked(c, claims) {
 			return c.Next()
 		}
 
@@ -84,11 +127,25 @@ func OptionalAuthMiddleware() fiber.Handler {
 		c.Locals("user_id", claims.UserID)
 		c.Locals("email", claims.Email)
 		c.Locals("role", claims.Role)
+		c.SetUserContext(logger.ContextWithUserID(c.UserContext(), claims.UserID))
 
 		return c.Next()
 	}
 }
 
+// sessionRevoked reports whether the token's session was signed out. A
+// failed check is logged and lets the token through.
+func sessionRevoked(c *fiber.Ctx, claims *jwt.Claims) bool {
+	if claims.SessionID == "" {
+		return false
+	}
+	revoked, err := cache.Exists(c.UserContext(), utils.RevokedSessionKey(claims.SessionID))
+	if err != nil {
+		logger.WithContext(c.UserContext()).Warn("Failed to check session revocation", zap.Error(err))
+	}
+	return revoked
+}
+
 // GetUserID extracts user ID from context
 func GetUserID(c *fiber.Ctx) string {
 	userID := c.Locals("user_id")
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"regexp"
+	"strings"
+
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/openapi"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// routeParam matches fiber path parameters such as :id
+var routeParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// ContractCheck compares JSON responses with the schemas the Swagger docs
+// give for their route and status, and logs every mismatch. Responses are
+// sent unchanged; it is meant for development and CI runs, where a drifted
+// annotation or handler shows up in the logs instead of in a client SDK.
+// Routes outside the documented base path, such as other API versions, are
+// not checked.
+func ContractCheck(spec *openapi.Spec) fiber.Handler {
+	basePath := strings.TrimSuffix(spec.BasePath, "/")
+
+	return func(c *fiber.Ctx) error {
+		// Errors are rendered by the app's error handler after this returns
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		route := c.Route().Path
+		if !strings.HasPrefix(route, basePath+"/") {
+			return nil
+		}
+		if !strings.HasPrefix(string(c.Response().Header.ContentType()), fiber.MIMEApplicationJSON) {
+			return nil
+		}
+
+		path := strings.TrimSuffix(strings.TrimPrefix(route, basePath), "/")
+		path = routeParam.ReplaceAllString(path, "{$1}")
+		op := spec.Operation(c.Method(), path)
+		if op == nil {
+			logger.WithContext(c.UserContext()).Warn("Route is not documented",
+				zap.String("method", c.Method()), zap.String("route", path))
+			return nil
+		}
+
+		status := c.Response().StatusCode()
+		for _, violation := range spec.ValidateResponse(op, status, c.Response().Body()) {
+			logger.WithContext(c.UserContext()).Warn("Response does not match the documented schema",
+				zap.String("method", c.Method()),
+				zap.String("route", path),
+				zap.Int("status", status),
+				zap.String("path", violation.Path),
+				zap.String("violation", violation.Message),
+			)
+		}
+		return nil
+	}
+}
@@ -1,6 +1,11 @@
 package middleware
 
 import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
 	"eventix-api/pkg/config"
 	"eventix-api/pkg/logger"
 
@@ -10,16 +15,59 @@ import (
 	"go.uber.org/zap"
 )
 
-// CORS creates a CORS middleware
-func CORS(cfg *config.CORSConfig) fiber.Handler {
-	return cors.New(cors.Config{
-		AllowOrigins:     "*", // Allow all origins in development
-		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS,PATCH",
-		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, X-Requested-With",
-		AllowCredentials: false, // Must be false when using wildcard origin
-		ExposeHeaders:    "Content-Length,Content-Type,Authorization",
-		MaxAge:           86400,
-	})
+// OriginResolver reports whether an origin missing from the configured list
+// may call the API, such as a site an organizer registered
+type OriginResolver interface {
+	AllowsOrigin(ctx context.Context, origin string) (bool, error)
+}
+
+// originResolveTimeout bounds a resolver lookup during a request
+const originResolveTimeout = 2 * time.Second
+
+// CORS creates a CORS middleware allowing the configured origins, such as
+// the web app, and those resolver allows when it is not nil. Credentials are
+// only allowed with an explicit origin list. The widget routes answer the
+// origins organizers allow instead; see WidgetCORS.
+func CORS(cfg *config.CORSConfig, resolver OriginResolver) fiber.Handler {
+	allowAll := slices.Contains(cfg.AllowedOrigins, "*")
+
+	corsConfig := cors.Config{
+		Next: func(c *fiber.Ctx) bool {
+			return isWidgetPath(c.Path())
+		},
+		AllowOrigins:     strings.Join(cfg.AllowedOrigins, ","),
+		AllowMethods:     strings.Join(cfg.AllowedMethods, ","),
+		AllowHeaders:     strings.Join(cfg.AllowedHeaders, ", "),
+		AllowCredentials: cfg.AllowCredentials && !allowAll,
+		ExposeHeaders:    strings.Join(cfg.ExposedHeaders, ","),
+		MaxAge:           int(cfg.MaxAge.Seconds()),
+	}
+
+	if resolver != nil && !allowAll {
+		// The cors middleware warns when given both a list and a function,
+		// so the function checks the list itself
+		static := make(map[string]bool, len(cfg.AllowedOrigins))
+		for _, origin := range cfg.AllowedOrigins {
+			static[strings.ToLower(origin)] = true
+		}
+		corsConfig.AllowOrigins = ""
+		corsConfig.AllowOriginsFunc = func(origin string) bool {
+			if static[origin] {
+				return true
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), originResolveTimeout)
+			defer cancel()
+			allowed, err := resolver.AllowsOrigin(ctx, origin)
+			if err != nil {
+				logger.Error("Failed to resolve CORS origin", zap.String("origin", origin), zap.Error(err))
+				return false
+			}
+			return allowed
+		}
+	}
+
+	return cors.New(corsConfig)
 }
 
 // Recover creates a panic recovery middleware
@@ -35,14 +83,3 @@ func Recover() fiber.Handler {
 		},
 	})
 }
-
-func joinStrings(slice []string, sep string) string {
-	if len(slice) == 0 {
-		return ""
-	}
-	result := slice[0]
-	for i := 1; i < len(slice); i++ {
-		result += sep + slice[i]
-	}
-	return result
-}
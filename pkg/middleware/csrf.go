@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// AuthModeHeader selects how login-style endpoints return the refresh
+	// token; "cookie" asks for the httpOnly cookie when cookie mode is enabled
+	AuthModeHeader = "X-Auth-Mode"
+
+	// RefreshCookie holds the refresh token in cookie mode. It is httpOnly, so
+	// scripts cannot read it.
+	RefreshCookie = "eventix_refresh"
+
+	// CSRFCookie holds the double-submit token, readable by the web app which
+	// echoes it in CSRFHeader
+	CSRFCookie = "eventix_csrf"
+	CSRFHeader = "X-CSRF-Token"
+)
+
+// CSRF rejects requests that carry the refresh cookie unless CSRFHeader
+// matches the CSRF cookie. Another site can make a browser send the cookies
+// but cannot read them to set the header. Requests without the refresh cookie,
+// such as from mobile apps using bearer tokens, pass through.
+func CSRF() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Cookies(RefreshCookie) == "" {
+			return c.Next()
+		}
+
+		expected := c.Cookies(CSRFCookie)
+		actual := c.Get(CSRFHeader)
+		if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) != 1 {
+			return utils.ForbiddenResponse(c, "Missing or invalid CSRF token")
+		}
+		return c.Next()
+	}
+}
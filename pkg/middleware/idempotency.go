@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header clients use to make a POST safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// idempotencyLockTTL bounds how long an in-flight request holds its key
+	idempotencyLockTTL = time.Minute
+
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotentResponse is the cached outcome of the first request made with a key
+type idempotentResponse struct {
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Idempotency replays the stored response when a request is retried with the
+// same Idempotency-Key. Keys are scoped to the authenticated user and route,
+// so it must run after AuthMiddleware. Requests without the header pass through.
+func Idempotency(ttl time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(IdempotencyKeyHeader)
+		if key == "" {
+			return c.Next()
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return utils.BadRequestResponse(c, "Idempotency-Key must be at most 255 characters")
+		}
+
+		userID, _ := c.Locals("user_id").(string)
+		cacheKey := fmt.Sprintf("idempotency:%s:%s:%s:%s", userID, c.Method(), c.Route().Path, key)
+
+		sum := sha256.Sum256(c.Body())
+		fingerprint := hex.EncodeToString(sum[:])
+
+		ctx := c.UserContext()
+		lock, _ := json.Marshal(idempotentResponse{Fingerprint: fingerprint})
+		acquired, err := cache.Client.SetNX(ctx, cacheKey, lock, idempotencyLockTTL).Result()
+		if err != nil {
+			// Without Redis the request is processed as if no key was sent
+			logger.WithContext(ctx).Warn("Idempotency store unavailable", zap.Error(err))
+			return c.Next()
+		}
+
+		if !acquired {
+			return replayIdempotent(c, cacheKey, fingerprint)
+		}
+
+		if err := c.Next(); err != nil {
+			cache.Client.Del(ctx, cacheKey)
+			return err
+		}
+
+		status := c.Response().StatusCode()
+		if status >= fiber.StatusInternalServerError {
+			// Server errors are not final; let the client retry with the same key
+			cache.Client.Del(ctx, cacheKey)
+			return nil
+		}
+
+		record, _ := json.Marshal(idempotentResponse{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			Status:      status,
+			ContentType: string(c.Response().Header.ContentType()),
+			Body:        c.Response().Body(),
+		})
+		if err := cache.Client.Set(ctx, cacheKey, record, ttl).Err(); err != nil {
+			logger.WithContext(ctx).Warn("Failed to store idempotent response", zap.Error(err))
+		}
+
+		return nil
+	}
+}
+
+func replayIdempotent(c *fiber.Ctx, cacheKey, fingerprint string) error {
+	data, err := cache.Client.Get(c.UserContext(), cacheKey).Bytes()
+	if err != nil {
+		// The first request finished and released its key in the meantime
+		return utils.ConflictResponse(c, "A request with this Idempotency-Key is being processed, please retry")
+	}
+
+	var stored idempotentResponse
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return utils.InternalServerErrorResponse(c, "Failed to read idempotent response")
+	}
+
+	if stored.Fingerprint != fingerprint {
+		return utils.ErrorResponse(c, fiber.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED",
+			"Idempotency-Key was already used with a different request body", nil)
+	}
+
+	if !stored.Completed {
+		return utils.ConflictResponse(c, "A request with this Idempotency-Key is being processed, please retry")
+	}
+
+	c.Set("Idempotent-Replayed", "true")
+	if stored.ContentType != "" {
+		c.Set(fiber.HeaderContentType, stored.ContentType)
+	}
+	return c.Status(stored.Status).Send(stored.Body)
+}
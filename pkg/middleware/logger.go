@@ -9,8 +9,9 @@ import (
 	"go.uber.org/zap"
 )
 
-// Logger middleware logs HTTP requests
-func Logger() fiber.Handler {
+// Logger middleware logs HTTP requests. Requests taking longer than
+// slowThreshold are logged as warnings; zero disables slow request logging.
+func Logger(slowThreshold time.Duration) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		start := time.Now()
 
@@ -18,8 +19,12 @@ func Logger() fiber.Handler {
 		requestID := c.Get("X-Request-ID")
 		if requestID == "" {
 			requestID = uuid.New().String()
-			c.Set("X-Request-ID", requestID)
 		}
+		c.Set("X-Request-ID", requestID)
+
+		// Make the request ID available to handlers and services
+		c.Locals("request_id", requestID)
+		c.SetUserContext(logger.ContextWithRequestID(c.UserContext(), requestID))
 
 		// Process request
 		err := c.Next()
@@ -48,11 +53,16 @@ func Logger() fiber.Handler {
 			fields = append(fields, zap.Error(err))
 		}
 
+		// WebSocket connections last as long as the client stays
+		slow := slowThreshold > 0 && duration > slowThreshold && status != fiber.StatusSwitchingProtocols
+
 		// Log based on status code
 		if status >= 500 {
 			logger.Error("HTTP request error", fields...)
 		} else if status >= 400 {
 			logger.Warn("HTTP request warning", fields...)
+		} else if slow {
+			logger.Warn("Slow HTTP request", append(fields, zap.Duration("threshold", slowThreshold))...)
 		} else {
 			logger.Info("HTTP request", fields...)
 		}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"context"
+
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// PermissionChecker decides whether a user holds a permission
+type PermissionChecker interface {
+	HasPermission(ctx context.Context, userID, role, permission string) (bool, error)
+}
+
+// PermissionMiddleware lets a request through when the authenticated user
+// holds permission, through their role or on an organizer's team. Routes
+// acting on an event still check that the user manages it.
+func PermissionMiddleware(checker PermissionChecker, permission string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, _ := c.Locals("user_id").(string)
+		role, _ := c.Locals("role").(string)
+		if userID == "" {
+			return utils.UnauthorizedResponse(c, "User not authenticated")
+		}
+
+		allowed, err := checker.HasPermission(c.UserContext(), userID, role, permission)
+		if err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to check permission", zap.String("permission", permission), zap.Error(err))
+			return utils.InternalServerErrorResponse(c, "Failed to check permissions")
+		}
+		if !allowed {
+			return utils.ForbiddenResponse(c, "You don't have permission to access this resource")
+		}
+
+		return c.Next()
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -8,11 +9,36 @@ import (
 	"eventix-api/pkg/config"
 )
 
-// RateLimiter creates a rate limiting middleware
-func RateLimiter(cfg *config.LimitsConfig) fiber.Handler {
+// RateLimiter creates a rate limiting middleware. The limits are read on
+// every request so a configuration reload applies them at once; request
+// counts start over when they change.
+func RateLimiter(limits func() *config.LimitsConfig) fiber.Handler {
+	var (
+		mu      sync.Mutex
+		current fiber.Handler
+		limit   int
+		window  time.Duration
+	)
+
+	return func(c *fiber.Ctx) error {
+		cfg := limits()
+
+		mu.Lock()
+		if current == nil || cfg.RateLimitRequests != limit || cfg.RateLimitWindow != window {
+			limit, window = cfg.RateLimitRequests, cfg.RateLimitWindow
+			current = newRateLimiter(limit, window)
+		}
+		handler := current
+		mu.Unlock()
+
+		return handler(c)
+	}
+}
+
+func newRateLimiter(max int, window time.Duration) fiber.Handler {
 	return limiter.New(limiter.Config{
-		Max:        cfg.RateLimitRequests,
-		Expiration: cfg.RateLimitWindow,
+		Max:        max,
+		Expiration: window,
 		KeyGenerator: func(c *fiber.Ctx) string {
 			return c.IP()
 		},
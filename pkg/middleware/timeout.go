@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Timeout gives the request context a deadline, which reaches GORM and
+// Redis wherever handlers pass c.UserContext() on. It replaces any deadline
+// set before it, so a route can be given more time than the global default
+// as well as less. Handlers are not interrupted; a request that fails after
+// its deadline passed is answered with 504.
+func Timeout(timeout time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(c.UserContext()), timeout)
+		defer cancel()
+		c.SetUserContext(ctx)
+		c.Locals("request_deadline", ctx)
+
+		err := c.Next()
+
+		// A later Timeout owns the deadline of its route. Its context is
+		// recorded in Locals because the auth middleware wraps the user
+		// context in its own.
+		if c.Locals("request_deadline") != ctx || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return err
+		}
+		if err == nil && c.Response().StatusCode() < fiber.StatusInternalServerError {
+			return nil
+		}
+		return utils.ErrorResponse(c, fiber.StatusGatewayTimeout, utils.CodeRequestTimeout, "The request took too long to process", nil)
+	}
+}
+
+// BodyLimit rejects request bodies larger than limit bytes. Multipart
+// uploads are left to the server-wide limit.
+func BodyLimit(limit int) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+			return c.Next()
+		}
+		if len(c.Body()) > limit {
+			return utils.ErrorResponse(c, fiber.StatusRequestEntityTooLarge, utils.CodePayloadTooLarge, "Request body is too large", nil)
+		}
+		return c.Next()
+	}
+}
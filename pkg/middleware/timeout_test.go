@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"eventix-api/pkg/logger"
+)
+
+// slowQuery fails once the request context's deadline passes, as a
+// database call given c.UserContext() does
+func slowQuery(c *fiber.Ctx) error {
+	select {
+	case <-c.UserContext().Done():
+		return c.UserContext().Err()
+	case <-time.After(time.Second):
+		return errors.New("deadline never passed")
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	// Wraps the user context the way the auth middleware does
+	authenticate := func(c *fiber.Ctx) error {
+		c.SetUserContext(logger.ContextWithUserID(c.UserContext(), "user"))
+		return c.Next()
+	}
+
+	app := fiber.New()
+	app.Get("/plain", Timeout(10*time.Millisecond), slowQuery)
+	app.Get("/authenticated", Timeout(10*time.Millisecond), authenticate, slowQuery)
+	app.Get("/extended", Timeout(10*time.Millisecond), Timeout(time.Minute), func(c *fiber.Ctx) error {
+		time.Sleep(20 * time.Millisecond)
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+
+	for path, want := range map[string]int{
+		"/plain":         fiber.StatusGatewayTimeout,
+		"/authenticated": fiber.StatusGatewayTimeout,
+		"/extended":      fiber.StatusNoContent,
+	} {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, path, nil), -1)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if resp.StatusCode != want {
+			t.Errorf("%s: status = %d, want %d", path, resp.StatusCode, want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventix-api/pkg/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// APIVersion is one version of the public API, served under /api/<Name>
+type APIVersion struct {
+	Name       string
+	Deprecated *time.Time // when the version was deprecated, nil while supported
+	Sunset     *time.Time // announced date after which the version may be removed
+	Successor  string     // version replacing it, empty for the newest
+}
+
+// APIVersions reads the served versions, oldest first, with their
+// deprecation and sunset dates
+func APIVersions(cfg *config.AppConfig) ([]APIVersion, error) {
+	deprecated, err := versionDates(cfg.DeprecatedVersions)
+	if err != nil {
+		return nil, fmt.Errorf("API_DEPRECATED_VERSIONS: %w", err)
+	}
+	sunsets, err := versionDates(cfg.SunsetVersions)
+	if err != nil {
+		return nil, fmt.Errorf("API_SUNSET_VERSIONS: %w", err)
+	}
+
+	versions := make([]APIVersion, len(cfg.Versions))
+	for i, name := range cfg.Versions {
+		versions[i] = APIVersion{Name: name}
+		if date, ok := deprecated[name]; ok {
+			versions[i].Deprecated = &date
+		}
+		if date, ok := sunsets[name]; ok {
+			versions[i].Sunset = &date
+		}
+		if i+1 < len(cfg.Versions) {
+			versions[i].Successor = cfg.Versions[i+1]
+		}
+	}
+	return versions, nil
+}
+
+// versionDates parses version:YYYY-MM-DD pairs
+func versionDates(pairs []string) (map[string]time.Time, error) {
+	dates := make(map[string]time.Time, len(pairs))
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("%q is not version:YYYY-MM-DD", pair)
+		}
+		date, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not version:YYYY-MM-DD", pair)
+		}
+		dates[name] = date
+	}
+	return dates, nil
+}
+
+// Versioned records the API version of a route group for handlers and, once
+// the version is deprecated, announces it on every response with the
+// Deprecation (RFC 9745) and Sunset (RFC 8594) headers and a link to the
+// same path in the successor version
+func Versioned(version APIVersion) fiber.Handler {
+	prefix := "/api/" + version.Name
+
+	return func(c *fiber.Ctx) error {
+		c.Locals("api_version", version.Name)
+
+		if version.Deprecated != nil {
+			c.Set("Deprecation", "@"+strconv.FormatInt(version.Deprecated.Unix(), 10))
+			if version.Successor != "" {
+				successor := "/api/" + version.Successor + strings.TrimPrefix(c.Path(), prefix)
+				c.Append(fiber.HeaderLink, fmt.Sprintf(`<%s>; rel="successor-version"`, successor))
+			}
+		}
+		if version.Sunset != nil {
+			c.Set("Sunset", version.Sunset.UTC().Format(http.TimeFormat))
+		}
+
+		return c.Next()
+	}
+}
+
+// GetAPIVersion returns the API version a request was routed to
+func GetAPIVersion(c *fiber.Ctx) string {
+	version, _ := c.Locals("api_version").(string)
+	return version
+}
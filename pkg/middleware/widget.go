@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"eventix-api/pkg/logger"
+	"eventix-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// WidgetPathPrefix is the route group of the embeddable checkout widget, whose
+// cross-origin requests are answered by WidgetCORS rather than CORS
+const WidgetPathPrefix = "/widget/"
+
+// WidgetOriginChecker reports whether the organizer of an event allows the
+// checkout widget on a site
+type WidgetOriginChecker interface {
+	AllowsWidgetOrigin(ctx context.Context, eventID, origin string) (bool, error)
+}
+
+// WidgetCORS answers cross-origin requests to the widget routes of an event,
+// including preflights, from the origins its organizer allowed. Requests
+// without an Origin header, such as from servers, pass through.
+func WidgetCORS(checker WidgetOriginChecker) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		origin := c.Get(fiber.HeaderOrigin)
+		if origin == "" {
+			return c.Next()
+		}
+
+		allowed, err := checker.AllowsWidgetOrigin(c.UserContext(), c.Params("id"), origin)
+		if err != nil {
+			logger.WithContext(c.UserContext()).Error("Failed to check widget origin", zap.Error(err))
+			return utils.InternalServerErrorResponse(c, "Failed to check origin")
+		}
+
+		c.Vary(fiber.HeaderOrigin)
+		if !allowed {
+			return utils.ForbiddenResponse(c, "This site is not allowed to embed the event's checkout")
+		}
+
+		c.Set(fiber.HeaderAccessControlAllowOrigin, origin)
+		c.Set(fiber.HeaderAccessControlExposeHeaders, "Content-Length,Content-Type,X-Request-ID,Idempotent-Replayed")
+
+		if c.Method() == fiber.MethodOptions {
+			c.Set(fiber.HeaderAccessControlAllowMethods, "GET,POST,OPTIONS")
+			c.Set(fiber.HeaderAccessControlAllowHeaders, "Content-Type, Authorization, Idempotency-Key")
+			c.Set(fiber.HeaderAccessControlMaxAge, "600")
+			return c.SendStatus(fiber.StatusNoContent)
+		}
+		return c.Next()
+	}
+}
+
+// isWidgetPath reports whether a request is for the widget routes
+func isWidgetPath(path string) bool {
+	return strings.Contains(path, WidgetPathPrefix)
+}
@@ -0,0 +1,153 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Spec is the subset of a Swagger 2.0 document that the SDK generator and
+// the response contract check read
+type Spec struct {
+	Info                Info                      `json:"info"`
+	BasePath            string                    `json:"basePath"`
+	Paths               map[string]PathItem       `json:"paths"`
+	Definitions         map[string]*Schema        `json:"definitions"`
+	SecurityDefinitions map[string]SecurityScheme `json:"securityDefinitions"`
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+// PathItem maps lowercase HTTP methods to the operations on one path
+type PathItem map[string]*Operation
+
+// Operation is one documented route
+type Operation struct {
+	ID          string                `json:"operationId"`
+	Summary     string                `json:"summary"`
+	Description string                `json:"description"`
+	Tags        []string              `json:"tags"`
+	Consumes    []string              `json:"consumes"`
+	Produces    []string              `json:"produces"`
+	Parameters  []Parameter           `json:"parameters"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security"`
+}
+
+// Parameter is a path, query, header, form or body parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Type        string  `json:"type"`
+	Format      string  `json:"format"`
+	Items       *Schema `json:"items"`
+	Enum        []any   `json:"enum"`
+	Default     any     `json:"default"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Response is the documented response for one status code
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema as swag emits it
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties *Schema            `json:"additionalProperties"`
+	AllOf                []*Schema          `json:"allOf"`
+	Enum                 []any              `json:"enum"`
+	EnumVarNames         []string           `json:"x-enum-varnames"`
+}
+
+// SecurityScheme is an entry of securityDefinitions
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description"`
+}
+
+// Route is an operation together with where it is served
+type Route struct {
+	Method string // uppercase, e.g. GET
+	Path   string // relative to the base path, e.g. /events/{id}
+	*Operation
+}
+
+// Load parses a Swagger 2.0 JSON document
+func Load(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+	return &spec, nil
+}
+
+// Routes lists every operation ordered by path and then method, so output
+// generated from it is stable
+func (s *Spec) Routes() []Route {
+	var routes []Route
+	for path, item := range s.Paths {
+		for method, op := range item {
+			routes = append(routes, Route{Method: strings.ToUpper(method), Path: path, Operation: op})
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// Operation finds the operation for a method and a path relative to the
+// base path, written with {param} placeholders
+func (s *Spec) Operation(method, path string) *Operation {
+	item, ok := s.Paths[path]
+	if !ok {
+		return nil
+	}
+	return item[strings.ToLower(method)]
+}
+
+// Resolve follows a $ref, or a single-entry allOf wrapping one, to the
+// definition it names. Other schemas are returned as they are.
+func (s *Spec) Resolve(schema *Schema) *Schema {
+	for schema != nil {
+		switch {
+		case schema.Ref != "":
+			def, ok := s.Definitions[RefName(schema.Ref)]
+			if !ok {
+				return schema
+			}
+			schema = def
+		case len(schema.AllOf) == 1 && schema.Type == "" && len(schema.Properties) == 0:
+			schema = schema.AllOf[0]
+		default:
+			return schema
+		}
+	}
+	return nil
+}
+
+// RefName returns the definition name a $ref points to
+func RefName(ref string) string {
+	return strings.TrimPrefix(ref, "#/definitions/")
+}
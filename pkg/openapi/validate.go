@@ -0,0 +1,161 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Violation is a place where a response body departs from its documented
+// schema
+type Violation struct {
+	Path    string // JSON path into the body, e.g. $.data[0].id
+	Message string
+}
+
+func (v Violation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// ValidateResponse checks a JSON response body against the schema documented
+// for the operation and status code. A status the operation does not
+// document is itself a violation; responses documented without a schema
+// accept any body.
+func (s *Spec) ValidateResponse(op *Operation, status int, body []byte) []Violation {
+	resp, ok := op.Responses[strconv.Itoa(status)]
+	if !ok {
+		resp, ok = op.Responses["default"]
+	}
+	if !ok {
+		return []Violation{{Path: "$", Message: fmt.Sprintf("status %d is not documented", status)}}
+	}
+	if resp.Schema == nil {
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return []Violation{{Path: "$", Message: "body is not valid JSON"}}
+	}
+
+	var violations []Violation
+	s.validate(resp.Schema, value, "$", &violations)
+	return violations
+}
+
+func (s *Spec) validate(schema *Schema, value any, path string, violations *[]Violation) {
+	schema = s.Resolve(schema)
+	if schema == nil {
+		return
+	}
+	// Go handlers encode nil slices, maps and pointers as null, which
+	// Swagger 2.0 cannot express, so null is accepted everywhere
+	if value == nil {
+		return
+	}
+
+	report := func(format string, args ...any) {
+		*violations = append(*violations, Violation{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for _, part := range schema.AllOf {
+		s.validate(part, value, path, violations)
+	}
+
+	switch schema.Type {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			report("expected object, got %s", jsonType(value))
+			return
+		}
+		for _, name := range schema.Required {
+			if _, ok := obj[name]; !ok {
+				report("missing required property %q", name)
+			}
+		}
+		// An object documented without properties is free-form
+		if len(schema.Properties) == 0 && schema.AdditionalProperties == nil {
+			return
+		}
+		names := make([]string, 0, len(obj))
+		for name := range obj {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			child := path + "." + name
+			if prop, ok := schema.Properties[name]; ok {
+				s.validate(prop, obj[name], child, violations)
+			} else if schema.AdditionalProperties != nil {
+				s.validate(schema.AdditionalProperties, obj[name], child, violations)
+			} else {
+				*violations = append(*violations, Violation{Path: child, Message: "property is not documented"})
+			}
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			report("expected array, got %s", jsonType(value))
+			return
+		}
+		if schema.Items != nil {
+			for i, item := range items {
+				s.validate(schema.Items, item, path+"["+strconv.Itoa(i)+"]", violations)
+			}
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			report("expected string, got %s", jsonType(value))
+			return
+		}
+		if len(schema.Enum) > 0 && !inEnum(schema.Enum, str) {
+			report("%q is not one of the documented values", str)
+		}
+	case "integer":
+		num, ok := value.(float64)
+		if !ok || num != math.Trunc(num) {
+			report("expected integer, got %s", jsonType(value))
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			report("expected number, got %s", jsonType(value))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			report("expected boolean, got %s", jsonType(value))
+		}
+	}
+}
+
+func inEnum(enum []any, value string) bool {
+	for _, allowed := range enum {
+		if allowed == value {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonType(value any) string {
+	switch v := value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	default:
+		return "null"
+	}
+}
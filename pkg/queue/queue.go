@@ -0,0 +1,285 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+)
+
+// attemptsHeader counts how many times a job has been delivered
+const attemptsHeader = "x-attempts"
+
+// Job is a unit of asynchronous work
+type Job struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	RequestID string          `json:"request_id,omitempty"` // request that enqueued the job, for log correlation
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// Handler processes a job; returning an error schedules a retry
+type Handler func(ctx context.Context, job Job) error
+
+var (
+	conn      *amqp.Connection
+	channel   *amqp.Channel
+	publishMu sync.Mutex
+	queueCfg  *config.RabbitMQConfig
+)
+
+// Connect dials RabbitMQ and declares the exchanges and queues from config.
+// Queueing is disabled unless RabbitMQ is enabled.
+func Connect(cfg *config.RabbitMQConfig) error {
+	queueCfg = cfg
+	if !cfg.Enabled {
+		logger.Info("RabbitMQ disabled, jobs will run inline")
+		return nil
+	}
+
+	var err error
+	conn, err = amqp.Dial(cfg.URL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	channel, err = conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open RabbitMQ channel: %w", err)
+	}
+
+	for _, name := range []string{cfg.QueueNotifications, cfg.QueuePayments} {
+		if err := declare(name); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("RabbitMQ connected successfully", zap.String("exchange", cfg.Exchange))
+	return nil
+}
+
+// declare sets up a work queue with a delayed retry queue and a dead-letter queue:
+//
+//	exchange --name--> name
+//	retry exchange --name--> name.retry --(TTL)--> exchange
+//	dead-letter exchange --name--> name.dead
+func declare(name string) error {
+	retryExchange := queueCfg.Exchange + ".retry"
+	deadExchange := queueCfg.Exchange + ".dlx"
+
+	for _, exchange := range []string{queueCfg.Exchange, retryExchange, deadExchange} {
+		if err := channel.ExchangeDeclare(exchange, amqp.ExchangeDirect, true, false, false, false, nil); err != nil {
+			return fmt.Errorf("failed to declare exchange %s: %w", exchange, err)
+		}
+	}
+
+	queues := []struct {
+		name     string
+		exchange string
+		args     amqp.Table
+	}{
+		{name, queueCfg.Exchange, nil},
+		{name + ".retry", retryExchange, amqp.Table{
+			"x-message-ttl":             queueCfg.RetryDelay.Milliseconds(),
+			"x-dead-letter-exchange":    queueCfg.Exchange,
+			"x-dead-letter-routing-key": name,
+		}},
+		{name + ".dead", deadExchange, nil},
+	}
+
+	for _, q := range queues {
+		if _, err := channel.QueueDeclare(q.name, true, false, false, false, q.args); err != nil {
+			return fmt.Errorf("failed to declare queue %s: %w", q.name, err)
+		}
+		if err := channel.QueueBind(q.name, name, q.exchange, false, nil); err != nil {
+			return fmt.Errorf("failed to bind queue %s: %w", q.name, err)
+		}
+	}
+
+	return nil
+}
+
+// Enabled reports whether jobs are dispatched through RabbitMQ
+func Enabled() bool {
+	return channel != nil
+}
+
+// Health reports whether the RabbitMQ connection and publishing channel are open
+func Health() error {
+	if conn == nil || conn.IsClosed() {
+		return fmt.Errorf("rabbitmq connection is closed")
+	}
+	if channel.IsClosed() {
+		return fmt.Errorf("rabbitmq channel is closed")
+	}
+	return nil
+}
+
+// NotificationsQueue returns the name of the notification jobs queue
+func NotificationsQueue() string {
+	return queueCfg.QueueNotifications
+}
+
+// PaymentsQueue returns the name of the payment jobs queue
+func PaymentsQueue() string {
+	return queueCfg.QueuePayments
+}
+
+// Close closes the RabbitMQ channel and connection
+func Close() error {
+	if channel != nil {
+		channel.Close()
+	}
+	if conn != nil {
+		return conn.Close()
+	}
+	return nil
+}
+
+// Publish enqueues a job on the named queue
+func Publish(ctx context.Context, queueName, jobType string, payload interface{}) error {
+	if !Enabled() {
+		return fmt.Errorf("queue is not configured")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode job payload: %w", err)
+	}
+
+	job := Job{
+		ID:        uuid.New().String(),
+		Type:      jobType,
+		RequestID: logger.RequestIDFromContext(ctx),
+		Payload:   data,
+	}
+
+	return publish(ctx, queueCfg.Exchange, queueName, job, 1)
+}
+
+func publish(ctx context.Context, exchange, routingKey string, job Job, attempt int) error {
+	body, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to encode job: %w", err)
+	}
+
+	publishMu.Lock()
+	defer publishMu.Unlock()
+
+	if err := channel.PublishWithContext(ctx, exchange, routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    job.ID,
+		Type:         job.Type,
+		Timestamp:    time.Now(),
+		Headers:      amqp.Table{attemptsHeader: int32(attempt)},
+		Body:         body,
+	}); err != nil {
+		return fmt.Errorf("failed to publish job: %w", err)
+	}
+
+	return nil
+}
+
+// Consume processes jobs from the named queue until ctx is cancelled. Failed
+// jobs are retried after the configured delay and dead-lettered once they
+// have been attempted MaxRetries+1 times.
+func Consume(ctx context.Context, queueName string, handler Handler) error {
+	if !Enabled() {
+		return fmt.Errorf("queue is not configured")
+	}
+
+	consumer, err := conn.Channel()
+	if err != nil {
+		return fmt.Errorf("failed to open consumer channel: %w", err)
+	}
+	defer consumer.Close()
+
+	if err := consumer.Qos(queueCfg.Prefetch, 0, false); err != nil {
+		return fmt.Errorf("failed to set prefetch: %w", err)
+	}
+
+	deliveries, err := consumer.Consume(queueName, "", false, false, false, false, nil)
+	if err != nil {
+		return fmt.Errorf("failed to consume %s: %w", queueName, err)
+	}
+
+	logger.Info("Consuming jobs", zap.String("queue", queueName))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return fmt.Errorf("delivery channel for %s closed", queueName)
+			}
+			// A job already taken off the queue finishes even if shutdown begins
+			process(context.WithoutCancel(ctx), queueName, delivery, handler)
+		}
+	}
+}
+
+func process(ctx context.Context, queueName string, delivery amqp.Delivery, handler Handler) {
+	attempt := attempts(delivery)
+
+	var job Job
+	if err := json.Unmarshal(delivery.Body, &job); err != nil {
+		logger.Error("Discarding malformed job", zap.String("queue", queueName), zap.Error(err))
+		delivery.Reject(false)
+		return
+	}
+
+	if job.RequestID != "" {
+		ctx = logger.ContextWithRequestID(ctx, job.RequestID)
+	}
+
+	err := handler(ctx, job)
+	if err == nil {
+		delivery.Ack(false)
+		return
+	}
+
+	exchange := queueCfg.Exchange + ".retry"
+	if attempt > queueCfg.MaxRetries {
+		exchange = queueCfg.Exchange + ".dlx"
+	}
+
+	logger.WithContext(ctx).Warn("Job failed",
+		zap.String("queue", queueName),
+		zap.String("job_id", job.ID),
+		zap.String("type", job.Type),
+		zap.Int("attempt", attempt),
+		zap.Bool("dead_lettered", attempt > queueCfg.MaxRetries),
+		zap.Error(err),
+	)
+
+	if err := publish(ctx, exchange, queueName, job, attempt+1); err != nil {
+		// Leave the job on the queue rather than lose it
+		logger.WithContext(ctx).Error("Failed to reschedule job", zap.String("job_id", job.ID), zap.Error(err))
+		delivery.Nack(false, true)
+		return
+	}
+
+	delivery.Ack(false)
+}
+
+func attempts(delivery amqp.Delivery) int {
+	switch v := delivery.Headers[attemptsHeader].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 1
+}
@@ -0,0 +1,143 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"eventix-api/pkg/cache"
+	"eventix-api/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// channelPrefix namespaces realtime topics on Redis pub/sub
+const channelPrefix = "realtime:"
+
+// subscriberBuffer is how many messages a slow subscriber may fall behind
+// before further messages are dropped for it
+const subscriberBuffer = 16
+
+// Subscriber receives the messages published to the topics it follows
+type Subscriber struct {
+	C chan []byte
+
+	mu     sync.Mutex
+	topics map[string]struct{}
+}
+
+var (
+	mu          sync.RWMutex
+	subscribers = make(map[string]map[*Subscriber]struct{})
+)
+
+// Publish sends payload, encoded as JSON, to every subscriber of topic on
+// every replica. Delivery is best effort: subscribers that are not connected
+// when the message is published never see it.
+func Publish(ctx context.Context, topic string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	if err := cache.Client.Publish(ctx, channelPrefix+topic, data).Err(); err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+	return nil
+}
+
+// Run relays messages from Redis to local subscribers until ctx is cancelled.
+// Each replica runs one relay so publishers need not know where clients are connected.
+func Run(ctx context.Context) {
+	pubsub := cache.Client.PSubscribe(ctx, channelPrefix+"*")
+	defer pubsub.Close()
+
+	logger.Info("Realtime relay started")
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Realtime relay stopped")
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			deliver(strings.TrimPrefix(msg.Channel, channelPrefix), []byte(msg.Payload))
+		}
+	}
+}
+
+// NewSubscriber creates a subscriber that follows no topics yet
+func NewSubscriber() *Subscriber {
+	return &Subscriber{
+		C:      make(chan []byte, subscriberBuffer),
+		topics: make(map[string]struct{}),
+	}
+}
+
+// Subscribe starts delivering messages published to topic
+func (s *Subscriber) Subscribe(topic string) {
+	s.mu.Lock()
+	s.topics[topic] = struct{}{}
+	s.mu.Unlock()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if subscribers[topic] == nil {
+		subscribers[topic] = make(map[*Subscriber]struct{})
+	}
+	subscribers[topic][s] = struct{}{}
+}
+
+// Unsubscribe stops delivering messages published to topic
+func (s *Subscriber) Unsubscribe(topic string) {
+	s.mu.Lock()
+	delete(s.topics, topic)
+	s.mu.Unlock()
+
+	mu.Lock()
+	defer mu.Unlock()
+	delete(subscribers[topic], s)
+	if len(subscribers[topic]) == 0 {
+		delete(subscribers, topic)
+	}
+}
+
+// Topics returns the number of topics the subscriber follows
+func (s *Subscriber) Topics() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.topics)
+}
+
+// Close unsubscribes from every topic. C is not closed so in-flight
+// deliveries cannot panic; the subscriber should simply be discarded.
+func (s *Subscriber) Close() {
+	s.mu.Lock()
+	topics := make([]string, 0, len(s.topics))
+	for topic := range s.topics {
+		topics = append(topics, topic)
+	}
+	s.mu.Unlock()
+
+	for _, topic := range topics {
+		s.Unsubscribe(topic)
+	}
+}
+
+func deliver(topic string, payload []byte) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	for sub := range subscribers[topic] {
+		select {
+		case sub.C <- payload:
+		default:
+			logger.Warn("Dropping realtime message for slow subscriber", zap.String("topic", topic))
+		}
+	}
+}
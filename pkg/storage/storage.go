@@ -0,0 +1,217 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"eventix-api/pkg/config"
+	"eventix-api/pkg/logger"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Client holds the S3 client
+var Client *s3.Client
+
+var (
+	presigner *s3.PresignClient
+	bucket    string
+	publicURL string
+)
+
+// Connect configures an S3-compatible client. A custom endpoint (e.g. MinIO)
+// switches the client to path-style addressing.
+func Connect(cfg *config.S3Config) error {
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		logger.Warn("S3 credentials not set, file uploads are disabled")
+		return nil
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint != "" && !strings.Contains(endpoint, "://") {
+		scheme := "https"
+		if !cfg.UseSSL {
+			scheme = "http"
+		}
+		endpoint = scheme + "://" + endpoint
+	}
+
+	Client = s3.New(s3.Options{
+		Region:      cfg.Region,
+		Credentials: credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+	}, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		}
+	})
+	presigner = s3.NewPresignClient(Client)
+	bucket = cfg.Bucket
+
+	if endpoint != "" {
+		publicURL = fmt.Sprintf("%s/%s", strings.TrimRight(endpoint, "/"), cfg.Bucket)
+	} else {
+		publicURL = fmt.Sprintf("https://%s.s3.%s.amazonaws.com", cfg.Bucket, cfg.Region)
+	}
+
+	logger.Info("S3 storage configured",
+		zap.String("bucket", cfg.Bucket),
+		zap.String("region", cfg.Region),
+	)
+
+	return nil
+}
+
+// Enabled reports whether file storage is configured
+func Enabled() bool {
+	return Client != nil
+}
+
+// Upload stores an object under key
+func Upload(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	if !Enabled() {
+		return fmt.Errorf("file storage is not configured")
+	}
+
+	_, err := Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(key),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+		ContentType:   aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+
+	return nil
+}
+
+// Download returns the contents of an object
+func Download(ctx context.Context, key string) ([]byte, error) {
+	if !Enabled() {
+		return nil, fmt.Errorf("file storage is not configured")
+	}
+
+	out, err := Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download object: %w", err)
+	}
+	defer out.Body.Close()
+
+	body, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return body, nil
+}
+
+// Delete removes an object
+func Delete(ctx context.Context, key string) error {
+	if !Enabled() {
+		return fmt.Errorf("file storage is not configured")
+	}
+
+	if _, err := Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// Copy duplicates an object under a new key. Keys built by ObjectKey need
+// no escaping in the copy source.
+func Copy(ctx context.Context, srcKey, dstKey string) error {
+	if !Enabled() {
+		return fmt.Errorf("file storage is not configured")
+	}
+
+	if _, err := Client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(bucket),
+		CopySource: aws.String(bucket + "/" + srcKey),
+		Key:        aws.String(dstKey),
+	}); err != nil {
+		return fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	return nil
+}
+
+// PublicURL returns the URL of an object in a publicly readable bucket
+func PublicURL(key string) string {
+	return publicURL + "/" + key
+}
+
+// KeyFromPublicURL extracts the object key from a URL built by PublicURL
+func KeyFromPublicURL(url string) (string, bool) {
+	prefix := publicURL + "/"
+	if publicURL == "" || !strings.HasPrefix(url, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(url, prefix), true
+}
+
+// PresignGet returns a time-limited download URL for a private object
+func PresignGet(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if !Enabled() {
+		return "", fmt.Errorf("file storage is not configured")
+	}
+
+	req, err := presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", fmt.Errorf("failed to presign object: %w", err)
+	}
+
+	return req.URL, nil
+}
+
+// ObjectKey builds a unique object key under prefix, keeping the file extension
+func ObjectKey(prefix, filename string) string {
+	return fmt.Sprintf("%s/%s%s", strings.Trim(prefix, "/"), uuid.New().String(), strings.ToLower(path.Ext(filename)))
+}
+
+// DetectContentType validates an uploaded file against a size limit and a set
+// of allowed MIME types, sniffing the content rather than trusting the client
+func DetectContentType(file *multipart.FileHeader, allowed []string, maxSize int64) (string, error) {
+	if file.Size > maxSize {
+		return "", fmt.Errorf("file exceeds the %d MB limit", maxSize/(1024*1024))
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to read file")
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(f, head)
+	contentType := http.DetectContentType(head[:n])
+
+	for _, t := range allowed {
+		if contentType == t {
+			return contentType, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported file type %s", contentType)
+}
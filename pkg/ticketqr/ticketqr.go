@@ -0,0 +1,95 @@
+package ticketqr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"eventix-api/pkg/config"
+
+	"github.com/google/uuid"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// payloadPrefix identifies signed ticket payloads and their format version
+const payloadPrefix = "EVX1"
+
+// Payload is the verified content of a signed ticket QR code
+type Payload struct {
+	TicketID uuid.UUID
+	EventID  uuid.UUID
+	IssuedAt time.Time
+}
+
+var ticketConfig *config.TicketConfig
+
+// Init initializes the QR signing configuration
+func Init(cfg *config.TicketConfig) {
+	ticketConfig = cfg
+}
+
+// Sign builds a QR payload for a ticket, authenticated with an HMAC so
+// scanners holding the signing secret can verify it offline.
+// Format: EVX1.{ticket_id}.{event_id}.{issued_at_unix}.{signature}
+func Sign(ticketID, eventID uuid.UUID, issuedAt time.Time) string {
+	body := fmt.Sprintf("%s.%s.%s.%d", payloadPrefix, ticketID, eventID, issuedAt.Unix())
+	return body + "." + signature(body)
+}
+
+// IsSigned reports whether a QR code uses the signed payload format
+func IsSigned(code string) bool {
+	return strings.HasPrefix(code, payloadPrefix+".")
+}
+
+// Verify checks the signature of a QR payload and returns its content
+func Verify(code string) (*Payload, error) {
+	parts := strings.Split(code, ".")
+	if len(parts) != 5 || parts[0] != payloadPrefix {
+		return nil, fmt.Errorf("invalid QR payload format")
+	}
+
+	body := strings.Join(parts[:4], ".")
+	if !hmac.Equal([]byte(signature(body)), []byte(parts[4])) {
+		return nil, fmt.Errorf("invalid QR signature")
+	}
+
+	ticketID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid ticket ID in QR payload")
+	}
+
+	eventID, err := uuid.Parse(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID in QR payload")
+	}
+
+	issuedAt, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issue time in QR payload")
+	}
+
+	return &Payload{
+		TicketID: ticketID,
+		EventID:  eventID,
+		IssuedAt: time.Unix(issuedAt, 0),
+	}, nil
+}
+
+// PNG renders a QR code as a PNG image using the configured size
+func PNG(code string) ([]byte, error) {
+	png, err := qrcode.Encode(code, qrcode.Medium, ticketConfig.QRImageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate QR image: %w", err)
+	}
+	return png, nil
+}
+
+func signature(body string) string {
+	mac := hmac.New(sha256.New, []byte(ticketConfig.QRSigningSecret))
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// Error codes returned in the error.code field of failed responses
+const (
+	CodeBadRequest         = "BAD_REQUEST"
+	CodeUnauthorized       = "UNAUTHORIZED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeNotFound           = "NOT_FOUND"
+	CodeConflict           = "CONFLICT"
+	CodeValidation         = "VALIDATION_ERROR"
+	CodePayloadTooLarge    = "PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMedia   = "UNSUPPORTED_MEDIA_TYPE"
+	CodeRateLimitExceeded  = "RATE_LIMIT_EXCEEDED"
+	CodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	CodeRequestTimeout     = "REQUEST_TIMEOUT"
+	CodeInternal           = "INTERNAL_ERROR"
+)
+
+const internalErrorMessage = "An internal server error occurred"
+
+// AppError is an error that is safe to show to clients. Services return it
+// for failures the caller can act on; Err keeps the underlying cause for
+// logs and is never sent.
+type AppError struct {
+	Status  int
+	Code    string
+	Message string
+	Details interface{}
+	Err     error
+}
+
+func (e *AppError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+// NewAppError creates an error answered with the given status and code
+func NewAppError(status int, code, message string) *AppError {
+	return &AppError{Status: status, Code: code, Message: message}
+}
+
+// WithDetails returns a copy of the error carrying details for the client
+func (e *AppError) WithDetails(details interface{}) *AppError {
+	copied := *e
+	copied.Details = details
+	return &copied
+}
+
+// Wrap returns a copy of the error that records err as its cause
+func (e *AppError) Wrap(err error) *AppError {
+	copied := *e
+	copied.Err = err
+	return &copied
+}
+
+// BadRequestError reports input the request cannot be served with
+func BadRequestError(format string, args ...interface{}) *AppError {
+	return NewAppError(fiber.StatusBadRequest, CodeBadRequest, fmt.Sprintf(format, args...))
+}
+
+// ForbiddenError reports an action the caller is not allowed to take
+func ForbiddenError(format string, args ...interface{}) *AppError {
+	return NewAppError(fiber.StatusForbidden, CodeForbidden, fmt.Sprintf(format, args...))
+}
+
+// NotFoundError reports a missing resource
+func NotFoundError(format string, args ...interface{}) *AppError {
+	return NewAppError(fiber.StatusNotFound, CodeNotFound, fmt.Sprintf(format, args...))
+}
+
+// ConflictError reports a resource whose state does not allow the action
+func ConflictError(format string, args ...interface{}) *AppError {
+	return NewAppError(fiber.StatusConflict, CodeConflict, fmt.Sprintf(format, args...))
+}
+
+// AsAppError maps err onto the error catalog. Record-not-found and
+// validation errors are recognised wherever they are wrapped; anything
+// else becomes an internal error, whose message is only kept outside
+// production.
+func AsAppError(err error, production bool) *AppError {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return NotFoundError("The requested resource was not found").Wrap(err)
+	}
+
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return NewAppError(fiber.StatusUnprocessableEntity, CodeValidation, "Validation failed").
+			WithDetails(toFieldErrors(validationErrors)).Wrap(err)
+	}
+
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) && fiberErr.Code < fiber.StatusInternalServerError {
+		return NewAppError(fiberErr.Code, statusCode(fiberErr.Code), fiberErr.Message)
+	}
+
+	status := fiber.StatusInternalServerError
+	if fiberErr != nil {
+		status = fiberErr.Code
+	}
+	message := internalErrorMessage
+	if !production {
+		message = err.Error()
+	}
+	return NewAppError(status, statusCode(status), message).Wrap(err)
+}
+
+// statusCode picks the catalog code for an HTTP status
+func statusCode(status int) string {
+	switch status {
+	case fiber.StatusBadRequest, fiber.StatusMethodNotAllowed:
+		return CodeBadRequest
+	case fiber.StatusUnauthorized:
+		return CodeUnauthorized
+	case fiber.StatusForbidden:
+		return CodeForbidden
+	case fiber.StatusNotFound:
+		return CodeNotFound
+	case fiber.StatusConflict:
+		return CodeConflict
+	case fiber.StatusRequestEntityTooLarge:
+		return CodePayloadTooLarge
+	case fiber.StatusUnsupportedMediaType:
+		return CodeUnsupportedMedia
+	case fiber.StatusUnprocessableEntity:
+		return CodeValidation
+	case fiber.StatusTooManyRequests:
+		return CodeRateLimitExceeded
+	case fiber.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	case fiber.StatusGatewayTimeout:
+		return CodeRequestTimeout
+	}
+	if status < fiber.StatusInternalServerError {
+		return CodeBadRequest
+	}
+	return CodeInternal
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor identifies the last row of a keyset page by its sort column and ID
+type Cursor struct {
+	Time time.Time `json:"t"`
+	ID   uuid.UUID `json:"id"`
+}
+
+// CursorEncode returns the opaque cursor string for the row sorted at t with id
+func CursorEncode(t time.Time, id uuid.UUID) string {
+	data, _ := json.Marshal(Cursor{Time: t.UTC(), ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// CursorDecode parses a cursor string produced by CursorEncode
+func CursorDecode(cursor string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	var decoded Cursor
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.ID == uuid.Nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return &decoded, nil
+}
@@ -76,40 +76,40 @@ func ErrorResponse(c *fiber.Ctx, statusCode int, code, message string, details i
 
 // BadRequestResponse sends a bad request error response
 func BadRequestResponse(c *fiber.Ctx, message string) error {
-	return ErrorResponse(c, fiber.StatusBadRequest, "BAD_REQUEST", message, nil)
+	return ErrorResponse(c, fiber.StatusBadRequest, CodeBadRequest, message, nil)
 }
 
 // UnauthorizedResponse sends an unauthorized error response
 func UnauthorizedResponse(c *fiber.Ctx, message string) error {
-	return ErrorResponse(c, fiber.StatusUnauthorized, "UNAUTHORIZED", message, nil)
+	return ErrorResponse(c, fiber.StatusUnauthorized, CodeUnauthorized, message, nil)
 }
 
 // ForbiddenResponse sends a forbidden error response
 func ForbiddenResponse(c *fiber.Ctx, message string) error {
-	return ErrorResponse(c, fiber.StatusForbidden, "FORBIDDEN", message, nil)
+	return ErrorResponse(c, fiber.StatusForbidden, CodeForbidden, message, nil)
 }
 
 // NotFoundResponse sends a not found error response
 func NotFoundResponse(c *fiber.Ctx, message string) error {
-	return ErrorResponse(c, fiber.StatusNotFound, "NOT_FOUND", message, nil)
+	return ErrorResponse(c, fiber.StatusNotFound, CodeNotFound, message, nil)
 }
 
 // ConflictResponse sends a conflict error response
 func ConflictResponse(c *fiber.Ctx, message string) error {
-	return ErrorResponse(c, fiber.StatusConflict, "CONFLICT", message, nil)
+	return ErrorResponse(c, fiber.StatusConflict, CodeConflict, message, nil)
 }
 
 // ValidationErrorResponse sends a validation error response
 func ValidationErrorResponse(c *fiber.Ctx, errors interface{}) error {
-	return ErrorResponse(c, fiber.StatusUnprocessableEntity, "VALIDATION_ERROR", "Validation failed", errors)
+	return ErrorResponse(c, fiber.StatusUnprocessableEntity, CodeValidation, "Validation failed", errors)
 }
 
 // InternalServerErrorResponse sends an internal server error response
 func InternalServerErrorResponse(c *fiber.Ctx, message string) error {
 	if message == "" {
-		message = "An internal server error occurred"
+		message = internalErrorMessage
 	}
-	return ErrorResponse(c, fiber.StatusInternalServerError, "INTERNAL_ERROR", message, nil)
+	return ErrorResponse(c, fiber.StatusInternalServerError, CodeInternal, message, nil)
 }
 
 // PaginatedSuccessResponse sends a paginated success response
@@ -31,9 +31,28 @@ func GenerateReservationID() string {
 	return uuid.New().String()
 }
 
-// CalculateTotalPrice calculates total price for tickets
-func CalculateTotalPrice(price float64, quantity int) float64 {
-	return price * float64(quantity)
+// GeneratePaymentReference generates a unique payment reference for providers
+func GeneratePaymentReference() string {
+	return fmt.Sprintf("EVX-%d-%s", time.Now().Unix(), uuid.New().String()[:8])
+}
+
+// CalculateTotalPrice calculates total price for tickets in minor units
+func CalculateTotalPrice(price int64, quantity int) int64 {
+	return price * int64(quantity)
+}
+
+const (
+	// ReservationExpiryIndexKey is the sorted set of reservation IDs scored by expiry time
+	ReservationExpiryIndexKey = "reservations:expiry"
+	// ReservationHoldsKey is the hash of reservation ID to held reservation data
+	ReservationHoldsKey = "reservations:holds"
+	// ReservationsReleasedMetricKey counts holds released by the expiry worker
+	ReservationsReleasedMetricKey = "metrics:reservations:released_total"
+)
+
+// EventReservationsMetricKey returns the Redis counter of reservations made for an event
+func EventReservationsMetricKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("metrics:event:%s:reservations_total", eventID)
 }
 
 // GetReservationKey returns Redis key for reservation
@@ -45,3 +64,59 @@ func GetReservationKey(reservationID string) string {
 func ReservationExpirySeconds() time.Duration {
 	return 15 * time.Minute
 }
+
+// WaitingRoomEventsKey is the set of event IDs with an open waiting room
+const WaitingRoomEventsKey = "waitingroom:events"
+
+// WaitingRoomQueueKey returns the sorted set of waiting tokens scored by arrival order
+func WaitingRoomQueueKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("waitingroom:%s:queue", eventID)
+}
+
+// WaitingRoomAdmittedKey returns the sorted set of admitted tokens scored by admission expiry
+func WaitingRoomAdmittedKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("waitingroom:%s:admitted", eventID)
+}
+
+// WaitingRoomTokensKey returns the hash of waiting room token to user ID
+func WaitingRoomTokensKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("waitingroom:%s:tokens", eventID)
+}
+
+// WaitingRoomUsersKey returns the hash of user ID to their current waiting room token
+func WaitingRoomUsersKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("waitingroom:%s:users", eventID)
+}
+
+// WaitingRoomSequenceKey returns the counter that orders arrivals in a waiting room
+func WaitingRoomSequenceKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("waitingroom:%s:seq", eventID)
+}
+
+// WaitingRoomAdmitLockKey returns the lock that limits admissions to one batch per interval across replicas
+func WaitingRoomAdmitLockKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("waitingroom:%s:admit_lock", eventID)
+}
+
+// EventCacheGenerationKey is the counter bumped on every event or tier write to invalidate cached responses
+const EventCacheGenerationKey = "cache:events:generation"
+
+// EventCacheKey returns the key of a cached public event response for a cache generation
+func EventCacheKey(generation int64, key string) string {
+	return fmt.Sprintf("cache:events:%d:%s", generation, key)
+}
+
+// RevokedSessionKey marks a signed-out session so its unexpired access tokens are rejected
+func RevokedSessionKey(sessionID string) string {
+	return fmt.Sprintf("session:revoked:%s", sessionID)
+}
+
+// EventOccupancyKey returns the counter of attendees currently inside an event
+func EventOccupancyKey(eventID uuid.UUID) string {
+	return fmt.Sprintf("occupancy:event:%s", eventID)
+}
+
+// ZoneOccupancyKey returns the counter of attendees currently inside an event zone
+func ZoneOccupancyKey(zoneID uuid.UUID) string {
+	return fmt.Sprintf("occupancy:zone:%s", zoneID)
+}
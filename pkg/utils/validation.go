@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"eventix-api/pkg/currency"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError describes a single field that failed validation
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// Report fields by their JSON names so clients can map errors to inputs
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+
+	// currency accepts the ISO 4217 codes tickets can be sold in
+	_ = v.RegisterValidation("currency", func(fl validator.FieldLevel) bool {
+		return currency.IsSupported(fl.Field().String())
+	})
+
+	return v
+}
+
+// ValidateStruct runs the validate tags on s and returns the failing fields,
+// or nil when s is valid
+func ValidateStruct(s interface{}) []FieldError {
+	err := validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []FieldError{{Field: "", Rule: "invalid", Message: err.Error()}}
+	}
+
+	return toFieldErrors(validationErrors)
+}
+
+func toFieldErrors(validationErrors validator.ValidationErrors) []FieldError {
+	fieldErrors := make([]FieldError, len(validationErrors))
+	for i, fe := range validationErrors {
+		fieldErrors[i] = FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: fieldErrorMessage(fe),
+		}
+	}
+
+	return fieldErrors
+}
+
+// fieldPath returns the JSON path of the field without the root struct name,
+// e.g. ticket_tiers[0].name
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "e164":
+		return "must be a phone number in international format, e.g. +2348012345678"
+	case "currency":
+		return "must be a supported ISO 4217 currency code"
+	case "uuid", "uuid4":
+		return "must be a valid UUID"
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", strings.Join(strings.Fields(fe.Param()), ", "))
+	case "min", "max", "gte", "lte":
+		bound := "at least"
+		if fe.Tag() == "max" || fe.Tag() == "lte" {
+			bound = "at most"
+		}
+		switch fe.Kind() {
+		case reflect.String:
+			return fmt.Sprintf("must be %s %s characters", bound, fe.Param())
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return fmt.Sprintf("must contain %s %s items", bound, fe.Param())
+		default:
+			return fmt.Sprintf("must be %s %s", bound, fe.Param())
+		}
+	}
+
+	return "is invalid"
+}
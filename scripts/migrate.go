@@ -1,10 +1,11 @@
 package main
 
 import (
-	"eventix-api/internal/models"
+	"log"
+
+	"eventix-api/internal/migrations"
 	"eventix-api/pkg/config"
 	"eventix-api/pkg/database"
-	"log"
 )
 
 func main() {
@@ -22,20 +23,7 @@ func main() {
 
 	log.Println("Running database migrations...")
 
-	// Auto-migrate all models
-	err = database.DB.AutoMigrate(
-		&models.User{},
-		&models.Organizer{},
-		&models.Event{},
-		&models.TicketTier{},
-		&models.Ticket{},
-		&models.Order{},
-		&models.Payment{},
-		&models.Checkin{},
-		&models.Notification{},
-	)
-
-	if err != nil {
+	if err := migrations.Run(database.DB); err != nil {
 		log.Fatalf("Migration failed: %v", err)
 	}
 
@@ -0,0 +1,3 @@
+module github.com/timileyin42/TicketBooking-backend/sdk/go
+
+go 1.21
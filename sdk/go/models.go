@@ -0,0 +1,1784 @@
+// Code generated by cmd/gensdk. DO NOT EDIT.
+
+package eventix
+
+import (
+	"encoding/json"
+)
+
+type AddOnResponse struct {
+	Currency    string `json:"currency,omitempty"`
+	Description string `json:"description,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Price       int    `json:"price,omitempty"`
+	Quantity    int    `json:"quantity,omitempty"`
+	// omitted when unlimited
+	Remaining int `json:"remaining,omitempty"`
+}
+
+type AddWidgetOriginRequest struct {
+	// Site the widget is embedded on, e.g. "https://tickets.example.com"
+	Origin string `json:"origin"`
+}
+
+type AuditLogResponse struct {
+	Action     AuditAction     `json:"action,omitempty"`
+	ActorID    string          `json:"actor_id,omitempty"`
+	ActorRole  UserRole        `json:"actor_role,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	CreatedAt  string          `json:"created_at,omitempty"`
+	ID         string          `json:"id,omitempty"`
+	IPAddress  string          `json:"ip_address,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+	TargetID   string          `json:"target_id,omitempty"`
+	TargetType AuditTargetType `json:"target_type,omitempty"`
+}
+
+type AvailabilitySocketMessage struct {
+	Data    json.RawMessage `json:"data,omitempty"`
+	EventID string          `json:"event_id,omitempty"`
+	Message string          `json:"message,omitempty"`
+	// subscribed, unsubscribed, availability or error
+	Type string `json:"type,omitempty"`
+}
+
+type BadgePrinterResponse struct {
+	AutoPrint bool        `json:"auto_print,omitempty"`
+	Driver    BadgeDriver `json:"driver,omitempty"`
+	// only returned when generated
+	Secret    string `json:"secret,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+type BalanceResponse struct {
+	Balance  int    `json:"balance,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+type CancelEventRequest struct {
+	// shown to ticket holders
+	Reason *string `json:"reason,omitempty"`
+}
+
+type CategoryResponse struct {
+	Icon     string `json:"icon,omitempty"`
+	ID       string `json:"id,omitempty"`
+	IsActive bool   `json:"is_active,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Slug     string `json:"slug,omitempty"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+type CheckoutRequest struct {
+	Device  *string `json:"device,omitempty"`
+	EventID string  `json:"event_id"`
+	QRCode  string  `json:"qr_code"`
+	// leaving a zone rather than the event
+	ZoneID *string `json:"zone_id,omitempty"`
+}
+
+type ClaimAccountRequest struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+type CompOrderResponse struct {
+	Email    string `json:"email,omitempty"`
+	OrderID  string `json:"order_id,omitempty"`
+	Quantity int    `json:"quantity,omitempty"`
+}
+
+type CompRecipientRequest struct {
+	Email     string  `json:"email"`
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	// defaults to 1
+	Quantity *int `json:"quantity,omitempty"`
+}
+
+type CompensateOrderSagaRequest struct {
+	Reason string `json:"reason"`
+}
+
+type CompletePayoutRequest struct {
+	Reference string `json:"reference"`
+}
+
+type CreateAddOnRequest struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	// minor units
+	Price *int `json:"price,omitempty"`
+	// 0 or omitted means unlimited
+	Quantity *int `json:"quantity,omitempty"`
+}
+
+type CreateCategoryRequest struct {
+	Icon *string `json:"icon,omitempty"`
+	Name string  `json:"name"`
+	// derived from the name when omitted
+	Slug *string `json:"slug,omitempty"`
+}
+
+type CreateEventRequest struct {
+	Category     string          `json:"category"`
+	Currency     *string         `json:"currency,omitempty"`
+	Description  string          `json:"description"`
+	EndTime      string          `json:"end_time"`
+	Location     string          `json:"location"`
+	MaxAttendees *int            `json:"max_attendees,omitempty"`
+	StartTime    string          `json:"start_time"`
+	TicketTiers  []TicketTierReq `json:"ticket_tiers"`
+	Title        string          `json:"title"`
+}
+
+type CreateEventSessionRequest struct {
+	// 0 means no limit
+	Capacity  *int   `json:"capacity,omitempty"`
+	EndTime   string `json:"end_time"`
+	StartTime string `json:"start_time"`
+	Title     string `json:"title"`
+}
+
+type CreateFormFieldRequest struct {
+	Label string `json:"label"`
+	// choices of a select field
+	Options  []string `json:"options,omitempty"`
+	Position *int     `json:"position,omitempty"`
+	Required *bool    `json:"required,omitempty"`
+	Type     string   `json:"type"`
+}
+
+type CreateIntegrationKeyRequest struct {
+	// e.g. "Zapier"
+	Name string `json:"name"`
+}
+
+type CreateOrderRequest struct {
+	// Extras such as parking or merchandise (see GET /events/{id}/add-ons)
+	AddOns []OrderAddOnRequest `json:"add_ons,omitempty"`
+	// Registration form answers keyed by form field ID, once per ticket or
+	// once for the whole order
+	Answers       []AnswerSet `json:"answers,omitempty"`
+	Provider      *string     `json:"provider,omitempty"`
+	ReservationID string      `json:"reservation_id"`
+}
+
+type CreateWarehouseExportRequest struct {
+	// UTC day (YYYY-MM-DD), yesterday by default
+	Date *string `json:"date,omitempty"`
+}
+
+type CreateWebhookEndpointRequest struct {
+	Description *string `json:"description,omitempty"`
+	// empty subscribes to every event
+	Events []WebhookEventType `json:"events"`
+	URL    string             `json:"url"`
+}
+
+type CurrencyResponse struct {
+	Code      string            `json:"code,omitempty"`
+	Exponent  int               `json:"exponent,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Providers []PaymentProvider `json:"providers,omitempty"`
+	Symbol    string            `json:"symbol,omitempty"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
+type DisputeResponse struct {
+	Amount            int                `json:"amount,omitempty"`
+	CreatedAt         string             `json:"created_at,omitempty"`
+	Currency          string             `json:"currency,omitempty"`
+	Evidence          []EvidenceResponse `json:"evidence,omitempty"`
+	EvidenceDueBy     string             `json:"evidence_due_by,omitempty"`
+	ID                string             `json:"id,omitempty"`
+	OrderID           string             `json:"order_id,omitempty"`
+	PaymentID         string             `json:"payment_id,omitempty"`
+	Provider          PaymentProvider    `json:"provider,omitempty"`
+	ProviderDisputeID string             `json:"provider_dispute_id,omitempty"`
+	Reason            string             `json:"reason,omitempty"`
+	Resolution        string             `json:"resolution,omitempty"`
+	ResolvedAt        string             `json:"resolved_at,omitempty"`
+	ResolvedBy        string             `json:"resolved_by,omitempty"`
+	Status            DisputeStatus      `json:"status,omitempty"`
+}
+
+type DuplicateEventRequest struct {
+	// defaults to the original event's duration
+	EndTime   *string `json:"end_time,omitempty"`
+	StartTime string  `json:"start_time"`
+	Title     *string `json:"title,omitempty"`
+}
+
+type EventCancellationResponse struct {
+	Event           *EventStatusResponse `json:"event,omitempty"`
+	HoldersNotified int                  `json:"holders_notified,omitempty"`
+	// pending orders that can no longer be paid
+	OrdersCancelled  int `json:"orders_cancelled,omitempty"`
+	RefundsQueued    int `json:"refunds_queued,omitempty"`
+	TicketsCancelled int `json:"tickets_cancelled,omitempty"`
+}
+
+type EventFeaturedResponse struct {
+	ID         string `json:"id,omitempty"`
+	IsFeatured bool   `json:"is_featured,omitempty"`
+	Title      string `json:"title,omitempty"`
+}
+
+type EventReportResponse struct {
+	CreatedAt  string       `json:"created_at,omitempty"`
+	Details    string       `json:"details,omitempty"`
+	EventID    string       `json:"event_id,omitempty"`
+	ID         string       `json:"id,omitempty"`
+	Reason     ReportReason `json:"reason,omitempty"`
+	ResolvedAt string       `json:"resolved_at,omitempty"`
+	Status     ReportStatus `json:"status,omitempty"`
+}
+
+type EventRescheduleResponse struct {
+	CreatedAt         string `json:"created_at,omitempty"`
+	EndTime           string `json:"end_time,omitempty"`
+	EventID           string `json:"event_id,omitempty"`
+	ID                string `json:"id,omitempty"`
+	PreviousEndTime   string `json:"previous_end_time,omitempty"`
+	PreviousStartTime string `json:"previous_start_time,omitempty"`
+	Reason            string `json:"reason,omitempty"`
+	RefundDeadline    string `json:"refund_deadline,omitempty"`
+	StartTime         string `json:"start_time,omitempty"`
+}
+
+type EventResponse struct {
+	AllowReentry bool                   `json:"allow_reentry,omitempty"`
+	BannerURL    string                 `json:"banner_url,omitempty"`
+	Category     string                 `json:"category,omitempty"`
+	CreatedAt    string                 `json:"created_at,omitempty"`
+	Currency     string                 `json:"currency,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	EndTime      string                 `json:"end_time,omitempty"`
+	ID           string                 `json:"id,omitempty"`
+	IsFeatured   bool                   `json:"is_featured,omitempty"`
+	Location     string                 `json:"location,omitempty"`
+	MaxAttendees int                    `json:"max_attendees,omitempty"`
+	MaxOccupancy int                    `json:"max_occupancy,omitempty"`
+	OrganizerID  string                 `json:"organizer_id,omitempty"`
+	RefundPolicy *RefundPolicyResponse  `json:"refund_policy,omitempty"`
+	Sessions     []EventSessionResponse `json:"sessions,omitempty"`
+	Slug         string                 `json:"slug,omitempty"`
+	StartTime    string                 `json:"start_time,omitempty"`
+	Status       EventStatus            `json:"status,omitempty"`
+	TicketTiers  []TicketTierResponse   `json:"ticket_tiers,omitempty"`
+	TicketsSold  int                    `json:"tickets_sold,omitempty"`
+	Title        string                 `json:"title,omitempty"`
+	WaitingRoom  bool                   `json:"waiting_room,omitempty"`
+	Zones        []EventZoneResponse    `json:"zones,omitempty"`
+}
+
+type EventSessionResponse struct {
+	Capacity  int    `json:"capacity,omitempty"`
+	EndTime   string `json:"end_time,omitempty"`
+	ID        string `json:"id,omitempty"`
+	StartTime string `json:"start_time,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+type EventStatusChangeResponse struct {
+	CreatedAt  string      `json:"created_at,omitempty"`
+	FromStatus EventStatus `json:"from_status,omitempty"`
+	Reason     string      `json:"reason,omitempty"`
+	ToStatus   EventStatus `json:"to_status,omitempty"`
+}
+
+type EventStatusResponse struct {
+	ID     string      `json:"id,omitempty"`
+	Status EventStatus `json:"status,omitempty"`
+	Title  string      `json:"title,omitempty"`
+}
+
+type EventZoneRequest struct {
+	// 0 means no limit
+	MaxOccupancy *int `json:"max_occupancy,omitempty"`
+	// e.g. "VIP lounge"
+	Name string `json:"name"`
+}
+
+type EventZoneResponse struct {
+	ID           string `json:"id,omitempty"`
+	MaxOccupancy int    `json:"max_occupancy,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+type EvidenceResponse struct {
+	ContentType string `json:"content_type,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	FileName    string `json:"file_name,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Note        string `json:"note,omitempty"`
+	Size        int    `json:"size,omitempty"`
+	UploadedBy  string `json:"uploaded_by,omitempty"`
+	// expires after 15 minutes
+	URL string `json:"url,omitempty"`
+}
+
+type FailPayoutRequest struct {
+	Reason string `json:"reason"`
+}
+
+type FavoriteResponse struct {
+	Event   *EventResponse `json:"event,omitempty"`
+	SavedAt string         `json:"saved_at,omitempty"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type FormFieldResponse struct {
+	ID       string   `json:"id,omitempty"`
+	Label    string   `json:"label,omitempty"`
+	Options  []string `json:"options,omitempty"`
+	Position int      `json:"position,omitempty"`
+	Required bool     `json:"required,omitempty"`
+	Type     string   `json:"type,omitempty"`
+}
+
+type GoogleWalletPassResponse struct {
+	SaveURL string `json:"save_url,omitempty"`
+}
+
+type GuestCheckoutRequest struct {
+	Email     string  `json:"email"`
+	FirstName string  `json:"first_name"`
+	LastName  string  `json:"last_name"`
+	Phone     *string `json:"phone,omitempty"`
+}
+
+type InitializePaymentRequest struct {
+	// ISO 3166-1 alpha-2 billing country, checked against the card and IP
+	BillingCountry *string `json:"billing_country,omitempty"`
+	CallbackURL    *string `json:"callback_url,omitempty"`
+	OrderID        string  `json:"order_id"`
+}
+
+type InitiatePayoutRequest struct {
+	// minor units; omit to pay out the full balance
+	Amount   *int   `json:"amount,omitempty"`
+	Currency string `json:"currency"`
+}
+
+type IntegrationAccountResponse struct {
+	KeyName          string `json:"key_name,omitempty"`
+	OrganizationName string `json:"organization_name,omitempty"`
+	OrganizerID      string `json:"organizer_id,omitempty"`
+}
+
+type IntegrationAttendeeResponse struct {
+	CheckedInAt string `json:"checked_in_at,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	// pass as since to fetch newer records
+	Cursor     string `json:"cursor,omitempty"`
+	Email      string `json:"email,omitempty"`
+	EventID    string `json:"event_id,omitempty"`
+	EventTitle string `json:"event_title,omitempty"`
+	FirstName  string `json:"first_name,omitempty"`
+	// ticket ID
+	ID       string       `json:"id,omitempty"`
+	LastName string       `json:"last_name,omitempty"`
+	OrderID  string       `json:"order_id,omitempty"`
+	Status   TicketStatus `json:"status,omitempty"`
+	TierID   string       `json:"tier_id,omitempty"`
+	TierName string       `json:"tier_name,omitempty"`
+}
+
+type IntegrationCompResponse struct {
+	Email    string `json:"email,omitempty"`
+	EventID  string `json:"event_id,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Quantity int    `json:"quantity,omitempty"`
+	TierID   string `json:"tier_id,omitempty"`
+}
+
+type IntegrationCompTicketsRequest struct {
+	Email     string  `json:"email"`
+	EventID   string  `json:"event_id"`
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	// defaults to 1
+	Quantity *int   `json:"quantity,omitempty"`
+	TierID   string `json:"tier_id"`
+}
+
+type IntegrationEventResponse struct {
+	EndTime   string      `json:"end_time,omitempty"`
+	ID        string      `json:"id,omitempty"`
+	Slug      string      `json:"slug,omitempty"`
+	StartTime string      `json:"start_time,omitempty"`
+	Status    EventStatus `json:"status,omitempty"`
+	Title     string      `json:"title,omitempty"`
+}
+
+type IntegrationKeyResponse struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+	ID        string `json:"id,omitempty"`
+	// only returned when created
+	Key        string `json:"key,omitempty"`
+	LastUsedAt string `json:"last_used_at,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Prefix     string `json:"prefix,omitempty"`
+	RevokedAt  string `json:"revoked_at,omitempty"`
+}
+
+type IntegrationOrderResponse struct {
+	// when the tickets were issued
+	CreatedAt string `json:"created_at,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	// pass as since to fetch newer records
+	Cursor     string      `json:"cursor,omitempty"`
+	Email      string      `json:"email,omitempty"`
+	EventID    string      `json:"event_id,omitempty"`
+	EventTitle string      `json:"event_title,omitempty"`
+	FirstName  string      `json:"first_name,omitempty"`
+	ID         string      `json:"id,omitempty"`
+	IsComp     bool        `json:"is_comp,omitempty"`
+	LastName   string      `json:"last_name,omitempty"`
+	Quantity   int         `json:"quantity,omitempty"`
+	Status     OrderStatus `json:"status,omitempty"`
+	TierID     string      `json:"tier_id,omitempty"`
+	TierName   string      `json:"tier_name,omitempty"`
+	// decimal, e.g. "50.00"
+	Total string `json:"total,omitempty"`
+}
+
+type IntegrationTierResponse struct {
+	Available int    `json:"available,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	EventID   string `json:"event_id,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// decimal, e.g. "25.00"
+	Price string `json:"price,omitempty"`
+}
+
+type InviteTeamMemberRequest struct {
+	Email string `json:"email"`
+	// custom role only
+	Permissions []Permission `json:"permissions"`
+	Role        StaffRole    `json:"role"`
+}
+
+type IssueCompTicketsRequest struct {
+	Recipients []CompRecipientRequest `json:"recipients"`
+	TierID     string                 `json:"tier_id"`
+}
+
+type IssueScannerTokenRequest struct {
+	// defaults to 12 hours
+	ExpiresInMinutes *int    `json:"expires_in_minutes,omitempty"`
+	Label            *string `json:"label,omitempty"`
+}
+
+type JobResponse struct {
+	Attempts    int             `json:"attempts,omitempty"`
+	CreatedAt   string          `json:"created_at,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	FinishedAt  string          `json:"finished_at,omitempty"`
+	ID          string          `json:"id,omitempty"`
+	LockedUntil string          `json:"locked_until,omitempty"`
+	MaxAttempts int             `json:"max_attempts,omitempty"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	RequestID   string          `json:"request_id,omitempty"`
+	RunAt       string          `json:"run_at,omitempty"`
+	Schedule    string          `json:"schedule,omitempty"`
+	Status      JobStatus       `json:"status,omitempty"`
+	Type        string          `json:"type,omitempty"`
+	UpdatedAt   string          `json:"updated_at,omitempty"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type LogoutRequest struct {
+	// bearer mode; cookie mode sends the refresh cookie
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+type ManualCheckinRequest struct {
+	// shown when the ticket is scanned again
+	Device  *string `json:"device,omitempty"`
+	EventID string  `json:"event_id"`
+	// check in to one session of a multi-session event
+	SessionID *string `json:"session_id,omitempty"`
+	TicketID  string  `json:"ticket_id"`
+	// checking in at the entrance of a zone
+	ZoneID *string `json:"zone_id,omitempty"`
+}
+
+type ModerateEventRequest struct {
+	// shown to the organizer
+	Reason string `json:"reason"`
+}
+
+type ModerationEventResponse struct {
+	ID               string      `json:"id,omitempty"`
+	OpenReports      int         `json:"open_reports,omitempty"`
+	OrganizationName string      `json:"organization_name,omitempty"`
+	OrganizerID      string      `json:"organizer_id,omitempty"`
+	Slug             string      `json:"slug,omitempty"`
+	StartTime        string      `json:"start_time,omitempty"`
+	Status           EventStatus `json:"status,omitempty"`
+	Title            string      `json:"title,omitempty"`
+	UpdatedAt        string      `json:"updated_at,omitempty"`
+}
+
+type NotificationPreferencesRequest struct {
+	EmailEnabled   *bool `json:"email_enabled,omitempty"`
+	EventReminders *bool `json:"event_reminders,omitempty"`
+	Marketing      *bool `json:"marketing,omitempty"`
+	OrderUpdates   *bool `json:"order_updates,omitempty"`
+	PushEnabled    *bool `json:"push_enabled,omitempty"`
+	SMSEnabled     *bool `json:"sms_enabled,omitempty"`
+}
+
+type NotificationPreferencesResponse struct {
+	EmailEnabled   bool `json:"email_enabled,omitempty"`
+	EventReminders bool `json:"event_reminders,omitempty"`
+	Marketing      bool `json:"marketing,omitempty"`
+	OrderUpdates   bool `json:"order_updates,omitempty"`
+	PushEnabled    bool `json:"push_enabled,omitempty"`
+	SMSEnabled     bool `json:"sms_enabled,omitempty"`
+}
+
+type NotificationResponse struct {
+	Channel   NotificationChannel `json:"channel,omitempty"`
+	CreatedAt string              `json:"created_at,omitempty"`
+	ID        string              `json:"id,omitempty"`
+	IsRead    bool                `json:"is_read,omitempty"`
+	Message   string              `json:"message,omitempty"`
+	SentAt    string              `json:"sent_at,omitempty"`
+	Subject   string              `json:"subject,omitempty"`
+}
+
+type OrderAddOnRequest struct {
+	AddOnID  string `json:"add_on_id"`
+	Quantity int    `json:"quantity"`
+}
+
+type OrderDetailResponse struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	// payment deadline of a pending order
+	ExpiresAt string              `json:"expires_at,omitempty"`
+	ID        string              `json:"id,omitempty"`
+	IsComp    bool                `json:"is_comp,omitempty"`
+	Items     []OrderItemResponse `json:"items,omitempty"`
+	Payments  []PaymentResponse   `json:"payments,omitempty"`
+	Refunds   []RefundResponse    `json:"refunds,omitempty"`
+	Status    OrderStatus         `json:"status,omitempty"`
+	// included in the total
+	TaxAmount      int              `json:"tax_amount,omitempty"`
+	TaxBasisPoints int              `json:"tax_basis_points,omitempty"`
+	TaxInclusive   bool             `json:"tax_inclusive,omitempty"`
+	TaxName        string           `json:"tax_name,omitempty"`
+	Tickets        []TicketResponse `json:"tickets,omitempty"`
+	TotalAmount    int              `json:"total_amount,omitempty"`
+}
+
+type OrderItemResponse struct {
+	Amount    int           `json:"amount,omitempty"`
+	Name      string        `json:"name,omitempty"`
+	Quantity  int           `json:"quantity,omitempty"`
+	TaxAmount int           `json:"tax_amount,omitempty"`
+	Type      OrderItemType `json:"type,omitempty"`
+	UnitPrice int           `json:"unit_price,omitempty"`
+}
+
+type OrderResponse struct {
+	CreatedAt string      `json:"created_at,omitempty"`
+	Currency  string      `json:"currency,omitempty"`
+	ID        string      `json:"id,omitempty"`
+	Status    OrderStatus `json:"status,omitempty"`
+	// included in the total
+	TaxAmount   int `json:"tax_amount,omitempty"`
+	TicketCount int `json:"ticket_count,omitempty"`
+	TotalAmount int `json:"total_amount,omitempty"`
+}
+
+type OrganizerFeesResponse struct {
+	FeeBasisPoints    int    `json:"fee_basis_points,omitempty"`
+	FeeFixedPerTicket int    `json:"fee_fixed_per_ticket,omitempty"`
+	OrganizerID       string `json:"organizer_id,omitempty"`
+}
+
+type OrganizerResponse struct {
+	AppliedAt          string             `json:"applied_at,omitempty"`
+	Description        string             `json:"description,omitempty"`
+	DocumentURL        string             `json:"document_url,omitempty"`
+	Email              string             `json:"email,omitempty"`
+	ID                 string             `json:"id,omitempty"`
+	OrganizationName   string             `json:"organization_name,omitempty"`
+	RejectionReason    string             `json:"rejection_reason,omitempty"`
+	UserID             string             `json:"user_id,omitempty"`
+	VerificationStatus VerificationStatus `json:"verification_status,omitempty"`
+	VerifiedAt         string             `json:"verified_at,omitempty"`
+	Website            string             `json:"website,omitempty"`
+}
+
+type PaymentResponse struct {
+	Amount    int    `json:"amount,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	ID        string `json:"id,omitempty"`
+	OrderID   string `json:"order_id,omitempty"`
+	PaidAt    string `json:"paid_at,omitempty"`
+	Provider  string `json:"provider,omitempty"`
+	Reference string `json:"reference,omitempty"`
+	Status    string `json:"status,omitempty"`
+}
+
+type PayoutResponse struct {
+	Amount      int          `json:"amount,omitempty"`
+	CompletedAt string       `json:"completed_at,omitempty"`
+	CreatedAt   string       `json:"created_at,omitempty"`
+	Currency    string       `json:"currency,omitempty"`
+	ID          string       `json:"id,omitempty"`
+	OrganizerID string       `json:"organizer_id,omitempty"`
+	Reason      string       `json:"reason,omitempty"`
+	Reference   string       `json:"reference,omitempty"`
+	Status      PayoutStatus `json:"status,omitempty"`
+}
+
+type PermissionsResponse struct {
+	Permissions []PermissionInfo        `json:"permissions,omitempty"`
+	Roles       map[string][]Permission `json:"roles,omitempty"`
+}
+
+type PricePhaseRequest struct {
+	EndTime *string `json:"end_time,omitempty"`
+	Name    string  `json:"name"`
+	// minor units
+	Price *int `json:"price,omitempty"`
+	// phase ends once the tier has sold this many
+	QuantityLimit *int    `json:"quantity_limit,omitempty"`
+	StartTime     *string `json:"start_time,omitempty"`
+}
+
+type PricePhaseResponse struct {
+	EndTime       string `json:"end_time,omitempty"`
+	ID            string `json:"id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Price         int    `json:"price,omitempty"`
+	QuantityLimit int    `json:"quantity_limit,omitempty"`
+	StartTime     string `json:"start_time,omitempty"`
+}
+
+type ReconciliationReportResponse struct {
+	CreatedAt    string                `json:"created_at,omitempty"`
+	Date         string                `json:"date,omitempty"`
+	ID           string                `json:"id,omitempty"`
+	Issues       []ReconciliationIssue `json:"issues,omitempty"`
+	Matched      int                   `json:"matched,omitempty"`
+	Provider     PaymentProvider       `json:"provider,omitempty"`
+	Transactions int                   `json:"transactions,omitempty"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefundPolicyResponse struct {
+	DaysBefore     int          `json:"days_before,omitempty"`
+	FeeBasisPoints int          `json:"fee_basis_points,omitempty"`
+	Policy         RefundPolicy `json:"policy,omitempty"`
+	// When attendee refunds close; omitted when the event offers none
+	RefundableUntil string `json:"refundable_until,omitempty"`
+}
+
+type RefundRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+type RefundResponse struct {
+	Amount    int    `json:"amount,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	// kept under the event's refund policy
+	Fee     int          `json:"fee,omitempty"`
+	ID      string       `json:"id,omitempty"`
+	OrderID string       `json:"order_id,omitempty"`
+	Reason  string       `json:"reason,omitempty"`
+	Status  RefundStatus `json:"status,omitempty"`
+}
+
+type RegisterRequest struct {
+	Email     string  `json:"email"`
+	FirstName string  `json:"first_name"`
+	LastName  string  `json:"last_name"`
+	Password  string  `json:"password"`
+	Phone     *string `json:"phone,omitempty"`
+}
+
+type RejectEventRequest struct {
+	Reason string `json:"reason"`
+}
+
+type RejectOrderRequest struct {
+	Reason string `json:"reason"`
+}
+
+type RejectOrganizerRequest struct {
+	Reason string `json:"reason"`
+}
+
+type ReportEventRequest struct {
+	Details *string `json:"details,omitempty"`
+	Reason  string  `json:"reason"`
+}
+
+type RescheduleEventRequest struct {
+	EndTime string `json:"end_time"`
+	// shown to ticket holders
+	Reason *string `json:"reason,omitempty"`
+	// defaults to RESCHEDULE_REFUND_WINDOW
+	RefundWindowDays *int   `json:"refund_window_days,omitempty"`
+	StartTime        string `json:"start_time"`
+}
+
+type ReserveTicketRequest struct {
+	Quantity int `json:"quantity"`
+	// required when the event has a waiting room
+	QueueToken *string `json:"queue_token,omitempty"`
+	TierID     string  `json:"tier_id"`
+}
+
+type ResetPasswordRequest struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+type ResolveDisputeRequest struct {
+	Outcome    DisputeStatus `json:"outcome"`
+	Resolution *string       `json:"resolution,omitempty"`
+}
+
+type RetryPaymentRequest struct {
+	BillingCountry *string `json:"billing_country,omitempty"`
+	CallbackURL    *string `json:"callback_url,omitempty"`
+}
+
+type ReviewOrderResponse struct {
+	BillingCountry string   `json:"billing_country,omitempty"`
+	ClientIP       string   `json:"client_ip,omitempty"`
+	CreatedAt      string   `json:"created_at,omitempty"`
+	Currency       string   `json:"currency,omitempty"`
+	Email          string   `json:"email,omitempty"`
+	ID             string   `json:"id,omitempty"`
+	IPCountry      string   `json:"ip_country,omitempty"`
+	Quantity       int      `json:"quantity,omitempty"`
+	RiskReasons    []string `json:"risk_reasons,omitempty"`
+	RiskScore      int      `json:"risk_score,omitempty"`
+	TierID         string   `json:"tier_id,omitempty"`
+	TotalAmount    int      `json:"total_amount,omitempty"`
+	UserID         string   `json:"user_id,omitempty"`
+}
+
+type ScannerTokenResponse struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	EventID   string `json:"event_id,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Label     string `json:"label,omitempty"`
+	// only returned when issued
+	Token string `json:"token,omitempty"`
+}
+
+type SessionResponse struct {
+	CreatedAt  string `json:"created_at,omitempty"`
+	Current    bool   `json:"current,omitempty"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	ID         string `json:"id,omitempty"`
+	IPAddress  string `json:"ip_address,omitempty"`
+	LastUsedAt string `json:"last_used_at,omitempty"`
+	UserAgent  string `json:"user_agent,omitempty"`
+}
+
+type SetPricePhasesRequest struct {
+	// applied in order; empty sells at the regular price
+	Phases []PricePhaseRequest `json:"phases,omitempty"`
+}
+
+type SetTierSessionsRequest struct {
+	// empty admits the tier to every session
+	SessionIds []string `json:"session_ids,omitempty"`
+}
+
+type SetTierZonesRequest struct {
+	// empty limits the tier to the main gate
+	ZoneIds []string `json:"zone_ids,omitempty"`
+}
+
+type TaxResponse struct {
+	BasisPoints int    `json:"basis_points,omitempty"`
+	Inclusive   bool   `json:"inclusive,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+type TeamMemberResponse struct {
+	AcceptedAt      string       `json:"accepted_at,omitempty"`
+	CreatedAt       string       `json:"created_at,omitempty"`
+	Email           string       `json:"email,omitempty"`
+	FirstName       string       `json:"first_name,omitempty"`
+	ID              string       `json:"id,omitempty"`
+	InviteExpiresAt string       `json:"invite_expires_at,omitempty"`
+	LastName        string       `json:"last_name,omitempty"`
+	Permissions     []Permission `json:"permissions,omitempty"`
+	Role            StaffRole    `json:"role,omitempty"`
+	Status          MemberStatus `json:"status,omitempty"`
+	UserID          string       `json:"user_id,omitempty"`
+}
+
+type TicketAnswerResponse struct {
+	Label string `json:"label,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type TicketDetailResponse struct {
+	// registration form answers
+	Answers        []TicketAnswerResponse `json:"answers,omitempty"`
+	CheckedInAt    string                 `json:"checked_in_at,omitempty"`
+	CreatedAt      string                 `json:"created_at,omitempty"`
+	EventEndTime   string                 `json:"event_end_time,omitempty"`
+	EventID        string                 `json:"event_id,omitempty"`
+	EventStartTime string                 `json:"event_start_time,omitempty"`
+	EventTitle     string                 `json:"event_title,omitempty"`
+	ID             string                 `json:"id,omitempty"`
+	Location       string                 `json:"location,omitempty"`
+	OrderID        string                 `json:"order_id,omitempty"`
+	QRCode         string                 `json:"qr_code,omitempty"`
+	Status         TicketStatus           `json:"status,omitempty"`
+	TierName       string                 `json:"tier_name,omitempty"`
+	Venue          string                 `json:"venue,omitempty"`
+}
+
+type TicketResponse struct {
+	CreatedAt  string       `json:"created_at,omitempty"`
+	EventID    string       `json:"event_id,omitempty"`
+	EventTitle string       `json:"event_title,omitempty"`
+	ID         string       `json:"id,omitempty"`
+	QRCode     string       `json:"qr_code,omitempty"`
+	Status     TicketStatus `json:"status,omitempty"`
+	TierName   string       `json:"tier_name,omitempty"`
+}
+
+type TicketTierReq struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	// minor units, e.g. cents
+	Price    *int `json:"price,omitempty"`
+	Quantity int  `json:"quantity"`
+}
+
+type TicketTierResponse struct {
+	Available int    `json:"available,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	// Price a reservation made now would hold, and the phase it comes from
+	CurrentPrice int                  `json:"current_price,omitempty"`
+	Description  string               `json:"description,omitempty"`
+	ID           string               `json:"id,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Price        int                  `json:"price,omitempty"`
+	PricePhase   string               `json:"price_phase,omitempty"`
+	PricePhases  []PricePhaseResponse `json:"price_phases,omitempty"`
+	Quantity     int                  `json:"quantity,omitempty"`
+	// sessions the tier admits to; empty admits to all
+	SessionIds []string `json:"session_ids,omitempty"`
+	Sold       int      `json:"sold,omitempty"`
+	// zones the tier admits to besides the main gate
+	ZoneIds []string `json:"zone_ids,omitempty"`
+}
+
+type TokenResponse struct {
+	AccessToken string `json:"access_token,omitempty"`
+	// cookie mode only
+	CSRFToken string `json:"csrf_token,omitempty"`
+	ExpiresIn int    `json:"expires_in,omitempty"`
+	// omitted in cookie mode, where it is set in the refresh cookie
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+}
+
+type UndoCheckinRequest struct {
+	CheckinID string `json:"checkin_id"`
+}
+
+type UpdateAddOnRequest struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Price       *int    `json:"price,omitempty"`
+	Quantity    *int    `json:"quantity,omitempty"`
+}
+
+type UpdateBadgePrinterRequest struct {
+	AutoPrint    *bool       `json:"auto_print,omitempty"`
+	Driver       BadgeDriver `json:"driver"`
+	RotateSecret *bool       `json:"rotate_secret,omitempty"`
+	URL          string      `json:"url"`
+}
+
+type UpdateCategoryRequest struct {
+	Icon     *string `json:"icon,omitempty"`
+	IsActive *bool   `json:"is_active,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+type UpdateEventFeaturedRequest struct {
+	Featured *bool `json:"featured,omitempty"`
+}
+
+type UpdateEventSessionRequest struct {
+	Capacity  *int    `json:"capacity,omitempty"`
+	EndTime   *string `json:"end_time,omitempty"`
+	StartTime *string `json:"start_time,omitempty"`
+	Title     *string `json:"title,omitempty"`
+}
+
+type UpdateFeatureFlagRequest struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     bool    `json:"enabled"`
+}
+
+type UpdateFormFieldRequest struct {
+	Label    *string  `json:"label,omitempty"`
+	Options  []string `json:"options,omitempty"`
+	Position *int     `json:"position,omitempty"`
+	Required *bool    `json:"required,omitempty"`
+	Type     *string  `json:"type,omitempty"`
+}
+
+type UpdateOccupancyLimitRequest struct {
+	// 0 removes the limit
+	MaxOccupancy *int `json:"max_occupancy,omitempty"`
+}
+
+type UpdateOrganizerFeesRequest struct {
+	FeeBasisPoints    *int `json:"fee_basis_points,omitempty"`
+	FeeFixedPerTicket *int `json:"fee_fixed_per_ticket,omitempty"`
+}
+
+type UpdateProfileRequest struct {
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+	Phone     *string `json:"phone,omitempty"`
+}
+
+type UpdateReentryRequest struct {
+	Allowed bool `json:"allowed"`
+}
+
+type UpdateRefundPolicyRequest struct {
+	// deadline policy only
+	DaysBefore *int `json:"days_before,omitempty"`
+	// 500 keeps 5% of each refund
+	FeeBasisPoints *int         `json:"fee_basis_points,omitempty"`
+	Policy         RefundPolicy `json:"policy"`
+}
+
+type UpdateRolePermissionsRequest struct {
+	Permissions []Permission `json:"permissions"`
+}
+
+type UpdateTaxRequest struct {
+	BasisPoints *int `json:"basis_points,omitempty"`
+	// prices already include the tax
+	Inclusive *bool `json:"inclusive,omitempty"`
+	// e.g. "VAT" or "Sales tax"
+	Name *string `json:"name,omitempty"`
+}
+
+type UpdateTeamMemberRequest struct {
+	// custom role only
+	Permissions []Permission `json:"permissions"`
+	Role        StaffRole    `json:"role"`
+}
+
+type UpdateTicketTierRequest struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Price       *int    `json:"price,omitempty"`
+	Quantity    *int    `json:"quantity,omitempty"`
+}
+
+type UpdateWaitingRoomRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+type UpdateWebhookEndpointRequest struct {
+	Active      *bool   `json:"active,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// empty subscribes to every event
+	Events       []WebhookEventType `json:"events"`
+	RotateSecret *bool              `json:"rotate_secret,omitempty"`
+	URL          string             `json:"url"`
+}
+
+type UserResponse struct {
+	CreatedAt     string `json:"created_at,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	ID            string `json:"id,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+	Role          string `json:"role,omitempty"`
+}
+
+type ValidateQRRequest struct {
+	// shown when the ticket is scanned again
+	Device  *string `json:"device,omitempty"`
+	EventID string  `json:"event_id"`
+	QRCode  string  `json:"qr_code"`
+	// check in to one session of a multi-session event
+	SessionID *string `json:"session_id,omitempty"`
+	// scanning at the entrance of a zone, e.g. the VIP area
+	ZoneID *string `json:"zone_id,omitempty"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type WarehouseExportFileResponse struct {
+	Dataset string `json:"dataset,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Rows    int    `json:"rows,omitempty"`
+	Size    int    `json:"size,omitempty"`
+	// expires after 15 minutes
+	URL string `json:"url,omitempty"`
+}
+
+type WarehouseExportResponse struct {
+	CompletedAt string                        `json:"completed_at,omitempty"`
+	CreatedAt   string                        `json:"created_at,omitempty"`
+	Date        string                        `json:"date,omitempty"`
+	Error       string                        `json:"error,omitempty"`
+	Files       []WarehouseExportFileResponse `json:"files,omitempty"`
+	ID          string                        `json:"id,omitempty"`
+	RequestedBy string                        `json:"requested_by,omitempty"`
+	Status      WarehouseExportStatus         `json:"status,omitempty"`
+}
+
+type WebhookDeliveryResponse struct {
+	Attempts      int              `json:"attempts,omitempty"`
+	CreatedAt     string           `json:"created_at,omitempty"`
+	DeliveredAt   string           `json:"delivered_at,omitempty"`
+	EndpointID    string           `json:"endpoint_id,omitempty"`
+	Error         string           `json:"error,omitempty"`
+	EventType     WebhookEventType `json:"event_type,omitempty"`
+	ID            string           `json:"id,omitempty"`
+	NextAttemptAt string           `json:"next_attempt_at,omitempty"`
+	Payload       string           `json:"payload,omitempty"`
+	// first 1KB
+	ResponseBody   string                `json:"response_body,omitempty"`
+	ResponseStatus int                   `json:"response_status,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status,omitempty"`
+}
+
+type WebhookEndpointResponse struct {
+	Active      bool               `json:"active,omitempty"`
+	CreatedAt   string             `json:"created_at,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Events      []WebhookEventType `json:"events,omitempty"`
+	ID          string             `json:"id,omitempty"`
+	// only returned when generated
+	Secret    string `json:"secret,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	URL       string `json:"url,omitempty"`
+}
+
+type WidgetEventResponse struct {
+	// Sites the organizer embeds the widget on, for the frame's
+	// Content-Security-Policy frame-ancestors
+	AllowedOrigins []string `json:"allowed_origins,omitempty"`
+	BannerURL      string   `json:"banner_url,omitempty"`
+	Currency       string   `json:"currency,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	EndTime        string   `json:"end_time,omitempty"`
+	// whether buyers can check out without an account
+	GuestCheckout bool                 `json:"guest_checkout,omitempty"`
+	ID            string               `json:"id,omitempty"`
+	Location      string               `json:"location,omitempty"`
+	OrganizerName string               `json:"organizer_name,omitempty"`
+	Slug          string               `json:"slug,omitempty"`
+	StartTime     string               `json:"start_time,omitempty"`
+	TicketTiers   []TicketTierResponse `json:"ticket_tiers,omitempty"`
+	Title         string               `json:"title,omitempty"`
+	WaitingRoom   bool                 `json:"waiting_room,omitempty"`
+}
+
+type WidgetOriginResponse struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Origin    string `json:"origin,omitempty"`
+}
+
+type AnswerSet map[string]string
+
+type AuditAction string
+
+const (
+	AuditEventCreated             AuditAction = "event.created"
+	AuditEventDuplicated          AuditAction = "event.duplicated"
+	AuditEventSubmitted           AuditAction = "event.submitted"
+	AuditEventApproved            AuditAction = "event.approved"
+	AuditEventRejected            AuditAction = "event.rejected"
+	AuditEventBannerUpdated       AuditAction = "event.banner_updated"
+	AuditEventWaitingRoomUpdated  AuditAction = "event.waiting_room_updated"
+	AuditEventReentryUpdated      AuditAction = "event.reentry_updated"
+	AuditEventOccupancyUpdated    AuditAction = "event.occupancy_limit_updated"
+	AuditEventTaxUpdated          AuditAction = "event.tax_updated"
+	AuditEventRefundsUpdated      AuditAction = "event.refunds_updated"
+	AuditEventCancelled           AuditAction = "event.cancelled"
+	AuditEventRescheduled         AuditAction = "event.rescheduled"
+	AuditEventFeaturedUpdated     AuditAction = "event.featured_updated"
+	AuditEventUnpublished         AuditAction = "event.unpublished"
+	AuditEventBanned              AuditAction = "event.banned"
+	AuditEventReportsDismissed    AuditAction = "event.reports_dismissed"
+	AuditEventBadgePrinterUpdated AuditAction = "event.badge_printer_updated"
+	AuditEventBadgePrinterRemoved AuditAction = "event.badge_printer_removed"
+	AuditTierCreated              AuditAction = "tier.created"
+	AuditTierUpdated              AuditAction = "tier.updated"
+	AuditTierDeleted              AuditAction = "tier.deleted"
+	AuditSessionCreated           AuditAction = "session.created"
+	AuditSessionUpdated           AuditAction = "session.updated"
+	AuditSessionDeleted           AuditAction = "session.deleted"
+	AuditZoneCreated              AuditAction = "zone.created"
+	AuditZoneUpdated              AuditAction = "zone.updated"
+	AuditZoneDeleted              AuditAction = "zone.deleted"
+	AuditFormFieldCreated         AuditAction = "form_field.created"
+	AuditFormFieldUpdated         AuditAction = "form_field.updated"
+	AuditFormFieldDeleted         AuditAction = "form_field.deleted"
+	AuditAddOnCreated             AuditAction = "add_on.created"
+	AuditAddOnUpdated             AuditAction = "add_on.updated"
+	AuditAddOnDeleted             AuditAction = "add_on.deleted"
+	AuditCategoryCreated          AuditAction = "category.created"
+	AuditCategoryUpdated          AuditAction = "category.updated"
+	AuditCategoryDeleted          AuditAction = "category.deleted"
+	AuditOrderComped              AuditAction = "order.comped"
+	AuditOrderRefunded            AuditAction = "order.refunded"
+	AuditOrderApproved            AuditAction = "order.approved"
+	AuditOrderRejected            AuditAction = "order.rejected"
+	AuditOrderSagaResumed         AuditAction = "order.saga_resumed"
+	AuditOrderSagaCompensated     AuditAction = "order.saga_compensated"
+	AuditUserUnlocked             AuditAction = "user.unlocked"
+	AuditOrganizerApproved        AuditAction = "organizer.approved"
+	AuditOrganizerRejected        AuditAction = "organizer.rejected"
+	AuditOrganizerFeesUpdated     AuditAction = "organizer.fees_updated"
+	AuditOrganizerLogoUpdated     AuditAction = "organizer.logo_updated"
+	AuditOrganizerTaxUpdated      AuditAction = "organizer.tax_updated"
+	AuditPayoutInitiated          AuditAction = "payout.initiated"
+	AuditPayoutCompleted          AuditAction = "payout.completed"
+	AuditPayoutFailed             AuditAction = "payout.failed"
+	AuditDisputeEvidenceAdded     AuditAction = "dispute.evidence_added"
+	AuditDisputeResolved          AuditAction = "dispute.resolved"
+	AuditFeatureFlagUpdated       AuditAction = "feature_flag.updated"
+	AuditRolePermissionsUpdated   AuditAction = "role.permissions_updated"
+	AuditMemberInvited            AuditAction = "team_member.invited"
+	AuditMemberJoined             AuditAction = "team_member.joined"
+	AuditMemberUpdated            AuditAction = "team_member.updated"
+	AuditMemberRemoved            AuditAction = "team_member.removed"
+	AuditScannerTokenIssued       AuditAction = "scanner_token.issued"
+	AuditScannerTokenRevoked      AuditAction = "scanner_token.revoked"
+	AuditCheckinUndone            AuditAction = "checkin.undone"
+	AuditWebhookCreated           AuditAction = "webhook.created"
+	AuditWebhookUpdated           AuditAction = "webhook.updated"
+	AuditWebhookDeleted           AuditAction = "webhook.deleted"
+	AuditAPIKeyCreated            AuditAction = "api_key.created"
+	AuditAPIKeyRevoked            AuditAction = "api_key.revoked"
+	AuditWidgetOriginAdded        AuditAction = "widget_origin.added"
+	AuditWidgetOriginRemoved      AuditAction = "widget_origin.removed"
+	AuditJobRetried               AuditAction = "job.retried"
+	AuditJobDiscarded             AuditAction = "job.discarded"
+)
+
+type AuditTargetType string
+
+const (
+	AuditTargetEvent        AuditTargetType = "event"
+	AuditTargetTier         AuditTargetType = "tier"
+	AuditTargetSession      AuditTargetType = "session"
+	AuditTargetZone         AuditTargetType = "zone"
+	AuditTargetFormField    AuditTargetType = "form_field"
+	AuditTargetAddOn        AuditTargetType = "add_on"
+	AuditTargetCategory     AuditTargetType = "category"
+	AuditTargetOrder        AuditTargetType = "order"
+	AuditTargetUser         AuditTargetType = "user"
+	AuditTargetOrganizer    AuditTargetType = "organizer"
+	AuditTargetPayout       AuditTargetType = "payout"
+	AuditTargetDispute      AuditTargetType = "dispute"
+	AuditTargetFeatureFlag  AuditTargetType = "feature_flag"
+	AuditTargetRole         AuditTargetType = "role"
+	AuditTargetMember       AuditTargetType = "team_member"
+	AuditTargetScannerToken AuditTargetType = "scanner_token"
+	AuditTargetCheckin      AuditTargetType = "checkin"
+	AuditTargetWebhook      AuditTargetType = "webhook"
+	AuditTargetAPIKey       AuditTargetType = "api_key"
+	AuditTargetWidgetOrigin AuditTargetType = "widget_origin"
+	AuditTargetJob          AuditTargetType = "job"
+)
+
+type BadgeDriver string
+
+const (
+	BadgeDriverWebhook BadgeDriver = "webhook"
+)
+
+type CheckinDirection string
+
+const (
+	CheckinIn  CheckinDirection = "in"
+	CheckinOut CheckinDirection = "out"
+)
+
+type CheckinMethod string
+
+const (
+	CheckinQR     CheckinMethod = "qr"
+	CheckinManual CheckinMethod = "manual"
+)
+
+type DisputeStatus string
+
+const (
+	DisputeOpen DisputeStatus = "open"
+	DisputeWon  DisputeStatus = "won"
+	DisputeLost DisputeStatus = "lost"
+)
+
+type EventStatus string
+
+const (
+	EventDraft       EventStatus = "draft"
+	EventUnderReview EventStatus = "under_review"
+	EventPublished   EventStatus = "published"
+	EventActive      EventStatus = "active"
+	EventCompleted   EventStatus = "completed"
+	EventCancelled   EventStatus = "cancelled"
+	EventUnpublished EventStatus = "unpublished"
+	EventBanned      EventStatus = "banned"
+)
+
+type FeatureFlag struct {
+	CreatedAt   string `json:"created_at,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Key         string `json:"key,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	UpdatedBy   string `json:"updated_by,omitempty"`
+}
+
+type JobSchedule struct {
+	JobType   string `json:"job_type,omitempty"`
+	LastJobID string `json:"last_job_id,omitempty"`
+	LastRunAt string `json:"last_run_at,omitempty"`
+	Name      string `json:"name,omitempty"`
+	NextRunAt string `json:"next_run_at,omitempty"`
+	// cron expression or @every duration
+	Spec      string `json:"spec,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+type JobStatus string
+
+const (
+	JobPending   JobStatus = "pending"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobDead      JobStatus = "dead"
+)
+
+type MemberStatus string
+
+const (
+	MemberInvited MemberStatus = "invited"
+	MemberActive  MemberStatus = "active"
+)
+
+type NotificationChannel string
+
+const (
+	ChannelEmail NotificationChannel = "email"
+	ChannelPush  NotificationChannel = "push"
+	ChannelSMS   NotificationChannel = "sms"
+)
+
+type OrderItemType string
+
+const (
+	OrderItemTicket OrderItemType = "ticket"
+	OrderItemAddOn  OrderItemType = "add_on"
+)
+
+type OrderSaga struct {
+	Completed []SagaStep `json:"completed,omitempty"`
+	CreatedAt string     `json:"created_at,omitempty"`
+	// from the step that last failed
+	Error      string           `json:"error,omitempty"`
+	FailedStep SagaStep         `json:"failed_step,omitempty"`
+	ID         string           `json:"id,omitempty"`
+	Log        []OrderSagaEntry `json:"log,omitempty"`
+	OrderID    string           `json:"order_id,omitempty"`
+	// why it was compensated
+	Reason    string     `json:"reason,omitempty"`
+	Status    SagaStatus `json:"status,omitempty"`
+	UpdatedAt string     `json:"updated_at,omitempty"`
+}
+
+type OrderSagaEntry struct {
+	Action    SagaAction `json:"action,omitempty"`
+	CreatedAt string     `json:"created_at,omitempty"`
+	// empty when the attempt succeeded
+	Error string   `json:"error,omitempty"`
+	ID    string   `json:"id,omitempty"`
+	Step  SagaStep `json:"step,omitempty"`
+}
+
+type OrderStatus string
+
+const (
+	OrderPending   OrderStatus = "pending"
+	OrderPaid      OrderStatus = "paid"
+	OrderFailed    OrderStatus = "failed"
+	OrderCancelled OrderStatus = "cancelled"
+	OrderRefunded  OrderStatus = "refunded"
+	OrderReview    OrderStatus = "review"
+)
+
+type PaymentProvider string
+
+const (
+	ProviderPaystack PaymentProvider = "paystack"
+	ProviderStripe   PaymentProvider = "stripe"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentPending    PaymentStatus = "pending"
+	PaymentAuthorized PaymentStatus = "authorized"
+	PaymentProcessing PaymentStatus = "processing"
+	PaymentCompleted  PaymentStatus = "completed"
+	PaymentFailed     PaymentStatus = "failed"
+	PaymentRefunding  PaymentStatus = "refunding"
+	PaymentRefunded   PaymentStatus = "refunded"
+	PaymentDisputed   PaymentStatus = "disputed"
+	PaymentChargeback PaymentStatus = "charged_back"
+)
+
+type PayoutStatus string
+
+const (
+	PayoutPending   PayoutStatus = "pending"
+	PayoutCompleted PayoutStatus = "completed"
+	PayoutFailed    PayoutStatus = "failed"
+)
+
+type Permission string
+
+const (
+	PermEventsCreate    Permission = "events:create"
+	PermEventsManage    Permission = "events:manage"
+	PermCheckinScan     Permission = "checkin:scan"
+	PermAttendeesView   Permission = "attendees:view"
+	PermTicketsComp     Permission = "tickets:comp"
+	PermPayoutsView     Permission = "payouts:view"
+	PermOrganizerManage Permission = "organizer:manage"
+)
+
+type ReconciliationIssue struct {
+	// ours, minor units
+	Amount        int           `json:"amount,omitempty"`
+	CreatedAt     string        `json:"created_at,omitempty"`
+	Currency      string        `json:"currency,omitempty"`
+	ID            string        `json:"id,omitempty"`
+	PaymentID     string        `json:"payment_id,omitempty"`
+	PaymentStatus PaymentStatus `json:"payment_status,omitempty"`
+	// minor units
+	ProviderAmount   int                     `json:"provider_amount,omitempty"`
+	ProviderCurrency string                  `json:"provider_currency,omitempty"`
+	Reference        string                  `json:"reference,omitempty"`
+	ReportID         string                  `json:"report_id,omitempty"`
+	Type             ReconciliationIssueType `json:"type,omitempty"`
+}
+
+type ReconciliationIssueType string
+
+const (
+	ReconciliationAmountMismatch ReconciliationIssueType = "amount_mismatch"
+	ReconciliationOrphaned       ReconciliationIssueType = "orphaned"
+	ReconciliationMissingWebhook ReconciliationIssueType = "missing_webhook"
+)
+
+type RefundPolicy string
+
+const (
+	RefundPolicyFlexible RefundPolicy = "flexible"
+	RefundPolicyDeadline RefundPolicy = "deadline"
+	RefundPolicyNone     RefundPolicy = "none"
+)
+
+type RefundStatus string
+
+const (
+	RefundPending   RefundStatus = "pending"
+	RefundCompleted RefundStatus = "completed"
+	RefundFailed    RefundStatus = "failed"
+)
+
+type ReportReason string
+
+const (
+	ReportSpam       ReportReason = "spam"
+	ReportFraud      ReportReason = "fraud"
+	ReportMisleading ReportReason = "misleading"
+	ReportOffensive  ReportReason = "offensive"
+	ReportOther      ReportReason = "other"
+)
+
+type ReportStatus string
+
+const (
+	ReportOpen      ReportStatus = "open"
+	ReportActioned  ReportStatus = "actioned"
+	ReportDismissed ReportStatus = "dismissed"
+)
+
+type RolePermissions struct {
+	CreatedAt   string       `json:"created_at,omitempty"`
+	ID          string       `json:"id,omitempty"`
+	Permissions []Permission `json:"permissions,omitempty"`
+	Role        UserRole     `json:"role,omitempty"`
+	UpdatedAt   string       `json:"updated_at,omitempty"`
+	UpdatedBy   string       `json:"updated_by,omitempty"`
+}
+
+type SagaAction string
+
+const (
+	SagaExecute    SagaAction = "execute"
+	SagaCompensate SagaAction = "compensate"
+)
+
+type SagaStatus string
+
+const (
+	SagaRunning      SagaStatus = "running"
+	SagaStuck        SagaStatus = "stuck"
+	SagaCompleted    SagaStatus = "completed"
+	SagaCompensating SagaStatus = "compensating"
+	SagaCompensated  SagaStatus = "compensated"
+)
+
+type SagaStep string
+
+const (
+	SagaReserve SagaStep = "reserve"
+	SagaOrder   SagaStep = "order"
+	SagaPay     SagaStep = "pay"
+	SagaFulfill SagaStep = "fulfill"
+)
+
+type StaffRole string
+
+const (
+	StaffScanner StaffRole = "scanner"
+	StaffFinance StaffRole = "finance"
+	StaffEditor  StaffRole = "editor"
+	StaffCustom  StaffRole = "custom"
+)
+
+type TicketStatus string
+
+const (
+	TicketReserved  TicketStatus = "reserved"
+	TicketActive    TicketStatus = "active"
+	TicketUsed      TicketStatus = "used"
+	TicketCancelled TicketStatus = "cancelled"
+	TicketRefunded  TicketStatus = "refunded"
+	TicketFrozen    TicketStatus = "frozen"
+)
+
+type UserRole string
+
+const (
+	RoleAttendee  UserRole = "attendee"
+	RoleOrganizer UserRole = "organizer"
+	RoleAdmin     UserRole = "admin"
+	RoleGuest     UserRole = "guest"
+	RoleScanner   UserRole = "scanner"
+)
+
+type VerificationStatus string
+
+const (
+	VerificationPending  VerificationStatus = "pending"
+	VerificationApproved VerificationStatus = "approved"
+	VerificationRejected VerificationStatus = "rejected"
+)
+
+type WarehouseExportStatus string
+
+const (
+	WarehouseExportRunning   WarehouseExportStatus = "running"
+	WarehouseExportCompleted WarehouseExportStatus = "completed"
+	WarehouseExportFailed    WarehouseExportStatus = "failed"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookTicketSold        WebhookEventType = "ticket.sold"
+	WebhookAttendeeCheckedIn WebhookEventType = "attendee.checked_in"
+	WebhookOrderRefunded     WebhookEventType = "order.refunded"
+)
+
+type AttendeeMatch struct {
+	AttendeeName string       `json:"attendee_name,omitempty"`
+	CheckedInAt  string       `json:"checked_in_at,omitempty"`
+	Email        string       `json:"email,omitempty"`
+	Status       TicketStatus `json:"status,omitempty"`
+	TicketID     string       `json:"ticket_id,omitempty"`
+	TierName     string       `json:"tier_name,omitempty"`
+}
+
+type Badge struct {
+	AttendeeName string `json:"attendee_name,omitempty"`
+	CheckedInAt  string `json:"checked_in_at,omitempty"`
+	CheckinID    string `json:"checkin_id,omitempty"`
+	EventID      string `json:"event_id,omitempty"`
+	EventTitle   string `json:"event_title,omitempty"`
+	QRCode       string `json:"qr_code,omitempty"`
+	TicketID     string `json:"ticket_id,omitempty"`
+	TierName     string `json:"tier_name,omitempty"`
+}
+
+type CheckinFeedEntry struct {
+	AttendeeName string           `json:"attendee_name,omitempty"`
+	Direction    CheckinDirection `json:"direction,omitempty"`
+	EventID      string           `json:"event_id,omitempty"`
+	ScannedAt    string           `json:"scanned_at,omitempty"`
+	ScannedBy    string           `json:"scanned_by,omitempty"`
+	TicketID     string           `json:"ticket_id,omitempty"`
+	TierID       string           `json:"tier_id,omitempty"`
+	TierName     string           `json:"tier_name,omitempty"`
+}
+
+type CheckinRateBucket struct {
+	BucketStart string `json:"bucket_start,omitempty"`
+	Count       int    `json:"count,omitempty"`
+}
+
+type CheckinStats struct {
+	BucketMinutes int                 `json:"bucket_minutes,omitempty"`
+	CheckedIn     int                 `json:"checked_in,omitempty"`
+	EventID       string              `json:"event_id,omitempty"`
+	GeneratedAt   string              `json:"generated_at,omitempty"`
+	Rate          []CheckinRateBucket `json:"rate,omitempty"`
+	Remaining     int                 `json:"remaining,omitempty"`
+	Tiers         []TierCheckinStats  `json:"tiers,omitempty"`
+	TotalTickets  int                 `json:"total_tickets,omitempty"`
+}
+
+type DailyRevenue struct {
+	Date        string `json:"date,omitempty"`
+	Orders      int    `json:"orders,omitempty"`
+	Revenue     int    `json:"revenue,omitempty"`
+	TicketsSold int    `json:"tickets_sold,omitempty"`
+}
+
+type DashboardInterval string
+
+const (
+	DashboardDaily   DashboardInterval = "day"
+	DashboardWeekly  DashboardInterval = "week"
+	DashboardMonthly DashboardInterval = "month"
+)
+
+type DashboardPeriod struct {
+	NewUsers       int              `json:"new_users,omitempty"`
+	Orders         int              `json:"orders,omitempty"`
+	PeriodStart    string           `json:"period_start,omitempty"`
+	RefundedAmount map[string]int64 `json:"refunded_amount,omitempty"`
+	Refunds        int              `json:"refunds,omitempty"`
+	Revenue        map[string]int64 `json:"revenue,omitempty"`
+	TicketsSold    int              `json:"tickets_sold,omitempty"`
+}
+
+type DashboardTotals struct {
+	NewUsers int `json:"new_users,omitempty"`
+	Orders   int `json:"orders,omitempty"`
+	// share of the range's orders since refunded
+	RefundRate     float64          `json:"refund_rate,omitempty"`
+	RefundedAmount map[string]int64 `json:"refunded_amount,omitempty"`
+	Refunds        int              `json:"refunds,omitempty"`
+	Revenue        map[string]int64 `json:"revenue,omitempty"`
+	TicketsSold    int              `json:"tickets_sold,omitempty"`
+}
+
+type EventAnalytics struct {
+	CompTickets    int            `json:"comp_tickets,omitempty"`
+	ConversionRate float64        `json:"conversion_rate,omitempty"`
+	DailyRevenue   []DailyRevenue `json:"daily_revenue,omitempty"`
+	EventID        string         `json:"event_id,omitempty"`
+	GeneratedAt    string         `json:"generated_at,omitempty"`
+	GrossRevenue   int            `json:"gross_revenue,omitempty"`
+	NetRevenue     int            `json:"net_revenue,omitempty"`
+	PaidOrders     int            `json:"paid_orders,omitempty"`
+	RefundRate     float64        `json:"refund_rate,omitempty"`
+	RefundedAmount int            `json:"refunded_amount,omitempty"`
+	RefundedOrders int            `json:"refunded_orders,omitempty"`
+	Reservations   int            `json:"reservations,omitempty"`
+	// on orders still paid, part of gross revenue
+	TaxCollected int              `json:"tax_collected,omitempty"`
+	TicketsSold  int              `json:"tickets_sold,omitempty"`
+	Tiers        []TierSalesStats `json:"tiers,omitempty"`
+}
+
+type EventOccupancy struct {
+	EventID     string `json:"event_id,omitempty"`
+	GeneratedAt string `json:"generated_at,omitempty"`
+	// 0 means no limit
+	Limit     int             `json:"limit,omitempty"`
+	Occupancy int             `json:"occupancy,omitempty"`
+	Zones     []ZoneOccupancy `json:"zones,omitempty"`
+}
+
+type PaymentInitResult struct {
+	AuthorizationURL string          `json:"authorization_url,omitempty"`
+	PaymentID        string          `json:"payment_id,omitempty"`
+	Provider         PaymentProvider `json:"provider,omitempty"`
+	Reference        string          `json:"reference,omitempty"`
+}
+
+type PermissionInfo struct {
+	Delegable   bool       `json:"delegable,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Permission  Permission `json:"permission,omitempty"`
+}
+
+type PlatformDashboard struct {
+	From          string            `json:"from,omitempty"`
+	GeneratedAt   string            `json:"generated_at,omitempty"`
+	Interval      DashboardInterval `json:"interval,omitempty"`
+	Series        []DashboardPeriod `json:"series,omitempty"`
+	To            string            `json:"to,omitempty"`
+	TopEvents     []TopEvent        `json:"top_events,omitempty"`
+	TopOrganizers []TopOrganizer    `json:"top_organizers,omitempty"`
+	Totals        *DashboardTotals  `json:"totals,omitempty"`
+}
+
+type ScanHistory struct {
+	EventID string `json:"event_id,omitempty"`
+	// the last event scan was an entry
+	Inside   bool         `json:"inside,omitempty"`
+	Scans    []ScanRecord `json:"scans,omitempty"`
+	Status   TicketStatus `json:"status,omitempty"`
+	TicketID string       `json:"ticket_id,omitempty"`
+}
+
+type ScanRecord struct {
+	Device    string           `json:"device,omitempty"`
+	Direction CheckinDirection `json:"direction,omitempty"`
+	ID        string           `json:"id,omitempty"`
+	Method    CheckinMethod    `json:"method,omitempty"`
+	ScannedAt string           `json:"scanned_at,omitempty"`
+	ScannedBy string           `json:"scanned_by,omitempty"`
+	SessionID string           `json:"session_id,omitempty"`
+	ZoneID    string           `json:"zone_id,omitempty"`
+}
+
+type TierCheckinStats struct {
+	CheckedIn int    `json:"checked_in,omitempty"`
+	TierID    string `json:"tier_id,omitempty"`
+	TierName  string `json:"tier_name,omitempty"`
+	Total     int    `json:"total,omitempty"`
+}
+
+type TierSalesStats struct {
+	Comps         int    `json:"comps,omitempty"`
+	Revenue       int    `json:"revenue,omitempty"`
+	Sold          int    `json:"sold,omitempty"`
+	TierID        string `json:"tier_id,omitempty"`
+	TierName      string `json:"tier_name,omitempty"`
+	TotalQuantity int    `json:"total_quantity,omitempty"`
+}
+
+type TopEvent struct {
+	Currency    string `json:"currency,omitempty"`
+	EventID     string `json:"event_id,omitempty"`
+	OrganizerID string `json:"organizer_id,omitempty"`
+	Revenue     int    `json:"revenue,omitempty"`
+	TicketsSold int    `json:"tickets_sold,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+type TopOrganizer struct {
+	Events           int              `json:"events,omitempty"`
+	OrganizationName string           `json:"organization_name,omitempty"`
+	OrganizerID      string           `json:"organizer_id,omitempty"`
+	Revenue          map[string]int64 `json:"revenue,omitempty"`
+	TicketsSold      int              `json:"tickets_sold,omitempty"`
+}
+
+type WaitingRoomPosition struct {
+	AdmittedUntil string `json:"admitted_until,omitempty"`
+	// seconds, while waiting
+	EstimatedWait int    `json:"estimated_wait,omitempty"`
+	EventID       string `json:"event_id,omitempty"`
+	// 1-based, while waiting
+	Position int               `json:"position,omitempty"`
+	Status   WaitingRoomStatus `json:"status,omitempty"`
+	Token    string            `json:"token,omitempty"`
+}
+
+type WaitingRoomStatus string
+
+const (
+	WaitingRoomWaiting  WaitingRoomStatus = "waiting"
+	WaitingRoomAdmitted WaitingRoomStatus = "admitted"
+	WaitingRoomExpired  WaitingRoomStatus = "expired"
+)
+
+type ZoneOccupancy struct {
+	// 0 means no limit
+	Limit     int    `json:"limit,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Occupancy int    `json:"occupancy,omitempty"`
+	ZoneID    string `json:"zone_id,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message,omitempty"`
+	Rule    string `json:"rule,omitempty"`
+}
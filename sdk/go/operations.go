@@ -0,0 +1,4265 @@
+// Code generated by cmd/gensdk. DO NOT EDIT.
+
+package eventix
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+)
+
+type DeleteAddOnResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteAddOn calls DELETE /add-ons/{id}. Delete an add-on.
+//
+// Take an add-on off sale. Orders that already include it are unaffected (Organizer/Admin only).
+func (c *Client) DeleteAddOn(ctx context.Context, id string) (*DeleteAddOnResponse, error) {
+	req := newRequest("DELETE", "/add-ons/"+url.PathEscape(id))
+	var out DeleteAddOnResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateAddOnResponse struct {
+	Data    *AddOnResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// UpdateAddOn calls PUT /add-ons/{id}. Update an add-on.
+//
+// Update an add-on's name, description, price or quantity. Orders keep the price they were placed at, and the quantity cannot drop below the number sold (Organizer/Admin only).
+func (c *Client) UpdateAddOn(ctx context.Context, id string, body *UpdateAddOnRequest) (*UpdateAddOnResponse, error) {
+	req := newRequest("PUT", "/add-ons/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateAddOnResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListAuditLogsResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListAuditLogsResponse struct {
+	Data       []AuditLogResponse               `json:"data,omitempty"`
+	Pagination *ListAuditLogsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                             `json:"success,omitempty"`
+}
+
+// ListAuditLogsParams are the query and header parameters of ListAuditLogs.
+type ListAuditLogsParams struct {
+	// Filter by acting user
+	ActorID string
+	// Filter by action, e.g. event.approved
+	Action string
+	// Filter by target type
+	TargetType string
+	// Filter by target
+	TargetID string
+	// Only entries at or after this RFC 3339 time
+	From string
+	// Only entries before this RFC 3339 time
+	To string
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListAuditLogs calls GET /admin/audit-logs. List audit logs.
+//
+// List recorded admin and organizer mutations, newest first, with before/after snapshots of their targets (Admin only).
+func (c *Client) ListAuditLogs(ctx context.Context, params *ListAuditLogsParams) (*ListAuditLogsResponse, error) {
+	req := newRequest("GET", "/admin/audit-logs")
+	if params != nil {
+		if params.ActorID != "" {
+			req.query.Set("actor_id", params.ActorID)
+		}
+		if params.Action != "" {
+			req.query.Set("action", params.Action)
+		}
+		if params.TargetType != "" {
+			req.query.Set("target_type", params.TargetType)
+		}
+		if params.TargetID != "" {
+			req.query.Set("target_id", params.TargetID)
+		}
+		if params.From != "" {
+			req.query.Set("from", params.From)
+		}
+		if params.To != "" {
+			req.query.Set("to", params.To)
+		}
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListAuditLogsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type AdminListCategoriesResponse struct {
+	Data    []CategoryResponse `json:"data,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// AdminListCategories calls GET /admin/categories. List all event categories.
+//
+// List every category, including inactive ones (Admin only).
+func (c *Client) AdminListCategories(ctx context.Context) (*AdminListCategoriesResponse, error) {
+	req := newRequest("GET", "/admin/categories")
+	var out AdminListCategoriesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateCategoryResponse struct {
+	Data    *CategoryResponse `json:"data,omitempty"`
+	Message string            `json:"message,omitempty"`
+	Success bool              `json:"success,omitempty"`
+}
+
+// CreateCategory calls POST /admin/categories. Create an event category.
+//
+// Add a category events can be filed under (Admin only).
+func (c *Client) CreateCategory(ctx context.Context, body *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	req := newRequest("POST", "/admin/categories")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateCategoryResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DeleteCategoryResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteCategory calls DELETE /admin/categories/{id}. Delete an event category.
+//
+// Delete a category no event has been filed under. Deactivate categories that are in use instead (Admin only).
+func (c *Client) DeleteCategory(ctx context.Context, id string) (*DeleteCategoryResponse, error) {
+	req := newRequest("DELETE", "/admin/categories/"+url.PathEscape(id))
+	var out DeleteCategoryResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateCategoryResponse struct {
+	Data    *CategoryResponse `json:"data,omitempty"`
+	Message string            `json:"message,omitempty"`
+	Success bool              `json:"success,omitempty"`
+}
+
+// UpdateCategory calls PUT /admin/categories/{id}. Update an event category.
+//
+// Rename a category, change its icon or deactivate it. Inactive categories are hidden and cannot be chosen for new events; existing events keep them (Admin only).
+func (c *Client) UpdateCategory(ctx context.Context, id string, body *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	req := newRequest("PUT", "/admin/categories/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateCategoryResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListDisputesResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListDisputesResponse struct {
+	Data       []DisputeResponse               `json:"data,omitempty"`
+	Pagination *ListDisputesResponsePagination `json:"pagination,omitempty"`
+	Success    bool                            `json:"success,omitempty"`
+}
+
+// ListDisputesParams are the query and header parameters of ListDisputes.
+type ListDisputesParams struct {
+	// Dispute status (open, won, lost)
+	Status string
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListDisputes calls GET /admin/disputes. List payment disputes.
+//
+// List chargebacks raised against payments, newest first, by default those still open (Admin only).
+func (c *Client) ListDisputes(ctx context.Context, params *ListDisputesParams) (*ListDisputesResponse, error) {
+	req := newRequest("GET", "/admin/disputes")
+	if params != nil {
+		if params.Status != "" {
+			req.query.Set("status", params.Status)
+		}
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListDisputesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetDisputeResponse struct {
+	Data    *DisputeResponse `json:"data,omitempty"`
+	Success bool             `json:"success,omitempty"`
+}
+
+// GetDispute calls GET /admin/disputes/{id}. Get a payment dispute.
+//
+// Get a dispute with its evidence files and short-lived download links (Admin only).
+func (c *Client) GetDispute(ctx context.Context, id string) (*GetDisputeResponse, error) {
+	req := newRequest("GET", "/admin/disputes/"+url.PathEscape(id))
+	var out GetDisputeResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UploadDisputeEvidenceResponse struct {
+	Data    *EvidenceResponse `json:"data,omitempty"`
+	Message string            `json:"message,omitempty"`
+	Success bool              `json:"success,omitempty"`
+}
+
+// UploadDisputeEvidenceForm is the multipart form sent by UploadDisputeEvidence.
+type UploadDisputeEvidenceForm struct {
+	// Evidence file (required)
+	File *File
+	// What the file shows
+	Note string
+}
+
+// UploadDisputeEvidence calls POST /admin/disputes/{id}/evidence. Upload dispute evidence.
+//
+// Attach a receipt, check-in record or correspondence (PDF, JPEG or PNG, max 10MB) to an open dispute. Files are stored privately (Admin only).
+func (c *Client) UploadDisputeEvidence(ctx context.Context, id string, form *UploadDisputeEvidenceForm) (*UploadDisputeEvidenceResponse, error) {
+	req := newRequest("POST", "/admin/disputes/"+url.PathEscape(id)+"/evidence")
+	if form != nil {
+		req.addFile("file", form.File)
+		req.addField("note", form.Note)
+	}
+	var out UploadDisputeEvidenceResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ResolveDisputeResponse struct {
+	Data    *DisputeResponse `json:"data,omitempty"`
+	Message string           `json:"message,omitempty"`
+	Success bool             `json:"success,omitempty"`
+}
+
+// ResolveDispute calls POST /admin/disputes/{id}/resolve. Resolve a payment dispute.
+//
+// Record the outcome of an open dispute. Won disputes unfreeze the order's tickets; lost ones refund the order, debit the organizer and put the tickets back on sale (Admin only).
+func (c *Client) ResolveDispute(ctx context.Context, id string, body *ResolveDisputeRequest) (*ResolveDisputeResponse, error) {
+	req := newRequest("POST", "/admin/disputes/"+url.PathEscape(id)+"/resolve")
+	if body != nil {
+		req.body = body
+	}
+	var out ResolveDisputeResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListModerationQueueResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListModerationQueueResponse struct {
+	Data       []ModerationEventResponse              `json:"data,omitempty"`
+	Pagination *ListModerationQueueResponsePagination `json:"pagination,omitempty"`
+	Success    bool                                   `json:"success,omitempty"`
+}
+
+// ListModerationQueueParams are the query and header parameters of ListModerationQueue.
+type ListModerationQueueParams struct {
+	// Event status; defaults to under_review, or any status when reported=true
+	Status string
+	// Only events with open reports
+	Reported *bool
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListModerationQueue calls GET /admin/events. Event moderation queue.
+//
+// List events by status, by default those awaiting review, oldest first. With reported=true, list events with open user reports, most reported first (Admin only).
+func (c *Client) ListModerationQueue(ctx context.Context, params *ListModerationQueueParams) (*ListModerationQueueResponse, error) {
+	req := newRequest("GET", "/admin/events")
+	if params != nil {
+		if params.Status != "" {
+			req.query.Set("status", params.Status)
+		}
+		if params.Reported != nil {
+			req.query.Set("reported", strconv.FormatBool(*params.Reported))
+		}
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListModerationQueueResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ApproveEventResponse struct {
+	Data    *EventStatusResponse `json:"data,omitempty"`
+	Message string               `json:"message,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// ApproveEvent calls POST /admin/events/{id}/approve. Approve an event.
+//
+// Publish an event that is under review (Admin only).
+func (c *Client) ApproveEvent(ctx context.Context, id string) (*ApproveEventResponse, error) {
+	req := newRequest("POST", "/admin/events/"+url.PathEscape(id)+"/approve")
+	var out ApproveEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type BanEventResponse struct {
+	Data    *EventStatusResponse `json:"data,omitempty"`
+	Message string               `json:"message,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// BanEvent calls POST /admin/events/{id}/ban. Ban an event.
+//
+// Remove an event for good and email the organizer the reason. Banned events cannot be published or sold again. Open reports on the event are closed (Admin only).
+func (c *Client) BanEvent(ctx context.Context, id string, body *ModerateEventRequest) (*BanEventResponse, error) {
+	req := newRequest("POST", "/admin/events/"+url.PathEscape(id)+"/ban")
+	if body != nil {
+		req.body = body
+	}
+	var out BanEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateEventFeaturedResponse struct {
+	Data    *EventFeaturedResponse `json:"data,omitempty"`
+	Message string                 `json:"message,omitempty"`
+	Success bool                   `json:"success,omitempty"`
+}
+
+// UpdateEventFeatured calls PUT /admin/events/{id}/featured. Feature an event.
+//
+// Feature a published event on GET /events/featured or stop featuring it (Admin only).
+func (c *Client) UpdateEventFeatured(ctx context.Context, id string, body *UpdateEventFeaturedRequest) (*UpdateEventFeaturedResponse, error) {
+	req := newRequest("PUT", "/admin/events/"+url.PathEscape(id)+"/featured")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateEventFeaturedResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RejectEventResponse struct {
+	Data    *EventStatusResponse `json:"data,omitempty"`
+	Message string               `json:"message,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// RejectEvent calls POST /admin/events/{id}/reject. Reject an event.
+//
+// Return an event under review to draft with a reason (Admin only).
+func (c *Client) RejectEvent(ctx context.Context, id string, body *RejectEventRequest) (*RejectEventResponse, error) {
+	req := newRequest("POST", "/admin/events/"+url.PathEscape(id)+"/reject")
+	if body != nil {
+		req.body = body
+	}
+	var out RejectEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListEventReportsResponse struct {
+	Data    []EventReportResponse `json:"data,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// ListEventReports calls GET /admin/events/{id}/reports. List an event's reports.
+//
+// List every user report on an event, newest first (Admin only).
+func (c *Client) ListEventReports(ctx context.Context, id string) (*ListEventReportsResponse, error) {
+	req := newRequest("GET", "/admin/events/"+url.PathEscape(id)+"/reports")
+	var out ListEventReportsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DismissEventReportsResponseData struct {
+	Dismissed int `json:"dismissed,omitempty"`
+}
+
+type DismissEventReportsResponse struct {
+	Data    *DismissEventReportsResponseData `json:"data,omitempty"`
+	Message string                           `json:"message,omitempty"`
+	Success bool                             `json:"success,omitempty"`
+}
+
+// DismissEventReports calls POST /admin/events/{id}/reports/dismiss. Dismiss an event's reports.
+//
+// Close an event's open reports without taking the event down (Admin only).
+func (c *Client) DismissEventReports(ctx context.Context, id string) (*DismissEventReportsResponse, error) {
+	req := newRequest("POST", "/admin/events/"+url.PathEscape(id)+"/reports/dismiss")
+	var out DismissEventReportsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UnpublishEventResponse struct {
+	Data    *EventStatusResponse `json:"data,omitempty"`
+	Message string               `json:"message,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// UnpublishEvent calls POST /admin/events/{id}/unpublish. Unpublish an event.
+//
+// Take a published event off sale and email the organizer the reason. The organizer can edit the event and submit it for review again. Open reports on the event are closed (Admin only).
+func (c *Client) UnpublishEvent(ctx context.Context, id string, body *ModerateEventRequest) (*UnpublishEventResponse, error) {
+	req := newRequest("POST", "/admin/events/"+url.PathEscape(id)+"/unpublish")
+	if body != nil {
+		req.body = body
+	}
+	var out UnpublishEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListWarehouseExportsResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListWarehouseExportsResponse struct {
+	Data       []WarehouseExportResponse               `json:"data,omitempty"`
+	Pagination *ListWarehouseExportsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                                    `json:"success,omitempty"`
+}
+
+// ListWarehouseExportsParams are the query and header parameters of ListWarehouseExports.
+type ListWarehouseExportsParams struct {
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListWarehouseExports calls GET /admin/exports. List data warehouse exports.
+//
+// List nightly and on-demand warehouse exports with their files, newest first (Admin only).
+func (c *Client) ListWarehouseExports(ctx context.Context, params *ListWarehouseExportsParams) (*ListWarehouseExportsResponse, error) {
+	req := newRequest("GET", "/admin/exports")
+	if params != nil {
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListWarehouseExportsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateWarehouseExportResponse struct {
+	Data    *WarehouseExportResponse `json:"data,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Success bool                     `json:"success,omitempty"`
+}
+
+// CreateWarehouseExport calls POST /admin/exports. Export a day to the data warehouse.
+//
+// Write the orders, tickets, payments and check-ins created or changed on a UTC day to S3 as gzipped CSV, one date-partitioned file per dataset, replacing any earlier export of that day. The nightly job does the same for yesterday (Admin only).
+func (c *Client) CreateWarehouseExport(ctx context.Context, body *CreateWarehouseExportRequest) (*CreateWarehouseExportResponse, error) {
+	req := newRequest("POST", "/admin/exports")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateWarehouseExportResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetWarehouseExportResponse struct {
+	Data    *WarehouseExportResponse `json:"data,omitempty"`
+	Success bool                     `json:"success,omitempty"`
+}
+
+// GetWarehouseExport calls GET /admin/exports/{id}. Get a data warehouse export.
+//
+// Get an export with short-lived download links to its files (Admin only).
+func (c *Client) GetWarehouseExport(ctx context.Context, id string) (*GetWarehouseExportResponse, error) {
+	req := newRequest("GET", "/admin/exports/"+url.PathEscape(id))
+	var out GetWarehouseExportResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListFeatureFlagsResponse struct {
+	Data    []FeatureFlag `json:"data,omitempty"`
+	Success bool          `json:"success,omitempty"`
+}
+
+// ListFeatureFlags calls GET /admin/feature-flags. List feature flags.
+//
+// List every feature flag with its state, including known flags still at their default (Admin only).
+func (c *Client) ListFeatureFlags(ctx context.Context) (*ListFeatureFlagsResponse, error) {
+	req := newRequest("GET", "/admin/feature-flags")
+	var out ListFeatureFlagsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateFeatureFlagResponse struct {
+	Data    *FeatureFlag `json:"data,omitempty"`
+	Message string       `json:"message,omitempty"`
+	Success bool         `json:"success,omitempty"`
+}
+
+// UpdateFeatureFlag calls PUT /admin/feature-flags/{key}. Update a feature flag.
+//
+// Turn a feature flag on or off, creating it if needed. Every replica sees the change within a minute at most (Admin only).
+func (c *Client) UpdateFeatureFlag(ctx context.Context, key string, body *UpdateFeatureFlagRequest) (*UpdateFeatureFlagResponse, error) {
+	req := newRequest("PUT", "/admin/feature-flags/"+url.PathEscape(key))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateFeatureFlagResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListJobsResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListJobsResponse struct {
+	Data       []JobResponse               `json:"data,omitempty"`
+	Pagination *ListJobsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                        `json:"success,omitempty"`
+}
+
+// ListJobsParams are the query and header parameters of ListJobs.
+type ListJobsParams struct {
+	// Job status (pending, running, succeeded, dead)
+	Status string
+	// Job type, such as emails.event_cancelled
+	Type string
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListJobs calls GET /admin/jobs. List background jobs.
+//
+// List jobs in the background queue, most recently updated first, by default the dead letters that failed every attempt (Admin only).
+func (c *Client) ListJobs(ctx context.Context, params *ListJobsParams) (*ListJobsResponse, error) {
+	req := newRequest("GET", "/admin/jobs")
+	if params != nil {
+		if params.Status != "" {
+			req.query.Set("status", params.Status)
+		}
+		if params.Type != "" {
+			req.query.Set("type", params.Type)
+		}
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListJobsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListJobSchedulesResponse struct {
+	Data    []JobSchedule `json:"data,omitempty"`
+	Success bool          `json:"success,omitempty"`
+}
+
+// ListJobSchedules calls GET /admin/jobs/schedules. List background job schedules.
+//
+// List the cron schedules that enqueue periodic jobs, with when each last ran and runs next (Admin only).
+func (c *Client) ListJobSchedules(ctx context.Context) (*ListJobSchedulesResponse, error) {
+	req := newRequest("GET", "/admin/jobs/schedules")
+	var out ListJobSchedulesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DiscardJobResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DiscardJob calls DELETE /admin/jobs/{id}. Discard a background job.
+//
+// Delete a dead or pending job so it never runs. Running jobs cannot be discarded (Admin only).
+func (c *Client) DiscardJob(ctx context.Context, id string) (*DiscardJobResponse, error) {
+	req := newRequest("DELETE", "/admin/jobs/"+url.PathEscape(id))
+	var out DiscardJobResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetJobResponse struct {
+	Data    *JobResponse `json:"data,omitempty"`
+	Success bool         `json:"success,omitempty"`
+}
+
+// GetJob calls GET /admin/jobs/{id}. Get a background job.
+//
+// Get a job with its payload and the error of its latest failed attempt (Admin only).
+func (c *Client) GetJob(ctx context.Context, id string) (*GetJobResponse, error) {
+	req := newRequest("GET", "/admin/jobs/"+url.PathEscape(id))
+	var out GetJobResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RetryJobResponse struct {
+	Data    *JobResponse `json:"data,omitempty"`
+	Message string       `json:"message,omitempty"`
+	Success bool         `json:"success,omitempty"`
+}
+
+// RetryJob calls POST /admin/jobs/{id}/retry. Retry a dead background job.
+//
+// Return a dead job to the queue with its attempts reset, once whatever made it fail is fixed (Admin only).
+func (c *Client) RetryJob(ctx context.Context, id string) (*RetryJobResponse, error) {
+	req := newRequest("POST", "/admin/jobs/"+url.PathEscape(id)+"/retry")
+	var out RetryJobResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListReviewOrdersResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListReviewOrdersResponse struct {
+	Data       []ReviewOrderResponse               `json:"data,omitempty"`
+	Pagination *ListReviewOrdersResponsePagination `json:"pagination,omitempty"`
+	Success    bool                                `json:"success,omitempty"`
+}
+
+// ListReviewOrdersParams are the query and header parameters of ListReviewOrders.
+type ListReviewOrdersParams struct {
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListReviewOrders calls GET /admin/orders/review. Orders held for review.
+//
+// List paid orders the risk rules held for manual review, oldest first, with their score and the rules that fired (Admin only).
+func (c *Client) ListReviewOrders(ctx context.Context, params *ListReviewOrdersParams) (*ListReviewOrdersResponse, error) {
+	req := newRequest("GET", "/admin/orders/review")
+	if params != nil {
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListReviewOrdersResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ApproveReviewOrderResponse struct {
+	Data    *OrderResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// ApproveReviewOrder calls POST /admin/orders/{id}/approve. Approve an order held for review.
+//
+// Confirm a held order: its tickets are issued and the buyer is sent the confirmation (Admin only).
+func (c *Client) ApproveReviewOrder(ctx context.Context, id string) (*ApproveReviewOrderResponse, error) {
+	req := newRequest("POST", "/admin/orders/"+url.PathEscape(id)+"/approve")
+	var out ApproveReviewOrderResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type AdminRefundOrderResponse struct {
+	Data    *RefundResponse `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// AdminRefundOrder calls POST /admin/orders/{id}/refund. Refund an order (admin).
+//
+// Refund any paid order regardless of the event refund policy (Admin only).
+func (c *Client) AdminRefundOrder(ctx context.Context, id string, body *RefundRequest) (*AdminRefundOrderResponse, error) {
+	req := newRequest("POST", "/admin/orders/"+url.PathEscape(id)+"/refund")
+	if body != nil {
+		req.body = body
+	}
+	var out AdminRefundOrderResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RejectReviewOrderResponse struct {
+	Data    *RefundResponse `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// RejectReviewOrder calls POST /admin/orders/{id}/reject. Reject an order held for review.
+//
+// Refund a held order in full and put its tickets back on sale (Admin only).
+func (c *Client) RejectReviewOrder(ctx context.Context, id string, body *RejectOrderRequest) (*RejectReviewOrderResponse, error) {
+	req := newRequest("POST", "/admin/orders/"+url.PathEscape(id)+"/reject")
+	if body != nil {
+		req.body = body
+	}
+	var out RejectReviewOrderResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetOrderSagaResponse struct {
+	Data    *OrderSaga `json:"data,omitempty"`
+	Success bool       `json:"success,omitempty"`
+}
+
+// GetOrderSaga calls GET /admin/orders/{id}/saga. Get an order's saga.
+//
+// Show which of the reserve, order, pay and fulfill steps an order has completed, the step it is stuck at and every execution and compensation attempt (Admin only).
+func (c *Client) GetOrderSaga(ctx context.Context, id string) (*GetOrderSagaResponse, error) {
+	req := newRequest("GET", "/admin/orders/"+url.PathEscape(id)+"/saga")
+	var out GetOrderSagaResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CompensateOrderSagaResponse struct {
+	Data    *OrderSaga `json:"data,omitempty"`
+	Message string     `json:"message,omitempty"`
+	Success bool       `json:"success,omitempty"`
+}
+
+// CompensateOrderSaga calls POST /admin/orders/{id}/saga/compensate. Compensate an order saga.
+//
+// Undo an order's completed steps, latest first: its tickets are revoked, its payment voided, the order cancelled and its tickets put back on sale. Orders with a checked-in ticket cannot be compensated (Admin only).
+func (c *Client) CompensateOrderSaga(ctx context.Context, id string, body *CompensateOrderSagaRequest) (*CompensateOrderSagaResponse, error) {
+	req := newRequest("POST", "/admin/orders/"+url.PathEscape(id)+"/saga/compensate")
+	if body != nil {
+		req.body = body
+	}
+	var out CompensateOrderSagaResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ResumeOrderSagaResponse struct {
+	Data    *OrderSaga `json:"data,omitempty"`
+	Message string     `json:"message,omitempty"`
+	Success bool       `json:"success,omitempty"`
+}
+
+// ResumeOrderSaga calls POST /admin/orders/{id}/saga/resume. Resume a stuck order saga.
+//
+// Retry the step an order's saga stopped at: its open payment is verified with the provider, or its paid order is fulfilled. A saga being compensated carries on undoing its steps (Admin only).
+func (c *Client) ResumeOrderSaga(ctx context.Context, id string) (*ResumeOrderSagaResponse, error) {
+	req := newRequest("POST", "/admin/orders/"+url.PathEscape(id)+"/saga/resume")
+	var out ResumeOrderSagaResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListOrganizersResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListOrganizersResponse struct {
+	Data       []OrganizerResponse               `json:"data,omitempty"`
+	Pagination *ListOrganizersResponsePagination `json:"pagination,omitempty"`
+	Success    bool                              `json:"success,omitempty"`
+}
+
+// ListOrganizersParams are the query and header parameters of ListOrganizers.
+type ListOrganizersParams struct {
+	// Verification status (pending, approved, rejected)
+	Status string
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListOrganizers calls GET /admin/organizers. List organizers by verification status.
+//
+// List organizer applications, by default those awaiting verification (Admin only).
+func (c *Client) ListOrganizers(ctx context.Context, params *ListOrganizersParams) (*ListOrganizersResponse, error) {
+	req := newRequest("GET", "/admin/organizers")
+	if params != nil {
+		if params.Status != "" {
+			req.query.Set("status", params.Status)
+		}
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListOrganizersResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ApproveOrganizerResponse struct {
+	Data    *OrganizerResponse `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// ApproveOrganizer calls POST /admin/organizers/{id}/approve. Approve an organizer.
+//
+// Verify an organizer application and notify the applicant (Admin only).
+func (c *Client) ApproveOrganizer(ctx context.Context, id string) (*ApproveOrganizerResponse, error) {
+	req := newRequest("POST", "/admin/organizers/"+url.PathEscape(id)+"/approve")
+	var out ApproveOrganizerResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateOrganizerFeesResponse struct {
+	Data    *OrganizerFeesResponse `json:"data,omitempty"`
+	Message string                 `json:"message,omitempty"`
+	Success bool                   `json:"success,omitempty"`
+}
+
+// UpdateOrganizerFees calls PUT /admin/organizers/{id}/fees. Set organizer fees.
+//
+// Override the platform fee for an organizer. Omitted fields fall back to the platform defaults (Admin only).
+func (c *Client) UpdateOrganizerFees(ctx context.Context, id string, body *UpdateOrganizerFeesRequest) (*UpdateOrganizerFeesResponse, error) {
+	req := newRequest("PUT", "/admin/organizers/"+url.PathEscape(id)+"/fees")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateOrganizerFeesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type InitiatePayoutResponse struct {
+	Data    *PayoutResponse `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// InitiatePayout calls POST /admin/organizers/{id}/payouts. Initiate an organizer payout.
+//
+// Debit an organizer's balance and create a pending payout (Admin only).
+func (c *Client) InitiatePayout(ctx context.Context, id string, body *InitiatePayoutRequest) (*InitiatePayoutResponse, error) {
+	req := newRequest("POST", "/admin/organizers/"+url.PathEscape(id)+"/payouts")
+	if body != nil {
+		req.body = body
+	}
+	var out InitiatePayoutResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RejectOrganizerResponse struct {
+	Data    *OrganizerResponse `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// RejectOrganizer calls POST /admin/organizers/{id}/reject. Reject an organizer.
+//
+// Decline an organizer application with a reason and notify the applicant (Admin only).
+func (c *Client) RejectOrganizer(ctx context.Context, id string, body *RejectOrganizerRequest) (*RejectOrganizerResponse, error) {
+	req := newRequest("POST", "/admin/organizers/"+url.PathEscape(id)+"/reject")
+	if body != nil {
+		req.body = body
+	}
+	var out RejectOrganizerResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CompletePayoutResponse struct {
+	Data    *PayoutResponse `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// CompletePayout calls POST /admin/payouts/{id}/complete. Complete a payout.
+//
+// Mark a pending payout as transferred with the transfer reference (Admin only).
+func (c *Client) CompletePayout(ctx context.Context, id string, body *CompletePayoutRequest) (*CompletePayoutResponse, error) {
+	req := newRequest("POST", "/admin/payouts/"+url.PathEscape(id)+"/complete")
+	if body != nil {
+		req.body = body
+	}
+	var out CompletePayoutResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type FailPayoutResponse struct {
+	Data    *PayoutResponse `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// FailPayout calls POST /admin/payouts/{id}/fail. Fail a payout.
+//
+// Mark a pending payout as failed and return its amount to the organizer balance (Admin only).
+func (c *Client) FailPayout(ctx context.Context, id string, body *FailPayoutRequest) (*FailPayoutResponse, error) {
+	req := newRequest("POST", "/admin/payouts/"+url.PathEscape(id)+"/fail")
+	if body != nil {
+		req.body = body
+	}
+	var out FailPayoutResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListPermissionsResponse struct {
+	Data    *PermissionsResponse `json:"data,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// ListPermissions calls GET /admin/permissions. List permissions and role mappings.
+//
+// List every permission, whether organizers may delegate it to their team, and the permissions each role holds (Admin only).
+func (c *Client) ListPermissions(ctx context.Context) (*ListPermissionsResponse, error) {
+	req := newRequest("GET", "/admin/permissions")
+	var out ListPermissionsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateRolePermissionsResponse struct {
+	Data    *RolePermissions `json:"data,omitempty"`
+	Message string           `json:"message,omitempty"`
+	Success bool             `json:"success,omitempty"`
+}
+
+// UpdateRolePermissions calls PUT /admin/permissions/{role}. Update a role's permissions.
+//
+// Replace the permissions every attendee or organizer holds. Admins always hold every permission. Every replica sees the change within a minute at most (Admin only).
+func (c *Client) UpdateRolePermissions(ctx context.Context, role string, body *UpdateRolePermissionsRequest) (*UpdateRolePermissionsResponse, error) {
+	req := newRequest("PUT", "/admin/permissions/"+url.PathEscape(role))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateRolePermissionsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetReconciliationReportResponse struct {
+	Data    []ReconciliationReportResponse `json:"data,omitempty"`
+	Success bool                           `json:"success,omitempty"`
+}
+
+// GetReconciliationReportParams are the query and header parameters of GetReconciliationReport.
+type GetReconciliationReportParams struct {
+	// UTC day (YYYY-MM-DD)
+	Date string
+	// Payment provider (paystack, stripe)
+	Provider string
+}
+
+// GetReconciliationReport calls GET /admin/reconciliation. Get payment reconciliation reports.
+//
+// Compare a day of Paystack and Stripe settlements with our payments: mismatched amounts, transactions unknown here (orphaned) and payments whose webhook never arrived. Defaults to the latest reconciled day (Admin only).
+func (c *Client) GetReconciliationReport(ctx context.Context, params *GetReconciliationReportParams) (*GetReconciliationReportResponse, error) {
+	req := newRequest("GET", "/admin/reconciliation")
+	if params != nil {
+		if params.Date != "" {
+			req.query.Set("date", params.Date)
+		}
+		if params.Provider != "" {
+			req.query.Set("provider", params.Provider)
+		}
+	}
+	var out GetReconciliationReportResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetAdminStatsResponseData struct {
+	Dashboard *PlatformDashboard `json:"dashboard,omitempty"`
+}
+
+type GetAdminStatsResponse struct {
+	Data    *GetAdminStatsResponseData `json:"data,omitempty"`
+	Success bool                       `json:"success,omitempty"`
+}
+
+// GetAdminStatsParams are the query and header parameters of GetAdminStats.
+type GetAdminStatsParams struct {
+	// First day of the range (YYYY-MM-DD)
+	From string
+	// Last day of the range, inclusive (YYYY-MM-DD)
+	To string
+	// Bucket size of the time series
+	Interval string
+}
+
+// GetAdminStats calls GET /admin/stats. Get admin statistics.
+//
+// Get platform totals and a dashboard of orders, tickets sold, revenue and refunds per currency and new user signups per day, week or month, with the top events and organizers by tickets sold. The range defaults to the last 30 days; the dashboard is refreshed every 5 minutes (Admin only).
+func (c *Client) GetAdminStats(ctx context.Context, params *GetAdminStatsParams) (*GetAdminStatsResponse, error) {
+	req := newRequest("GET", "/admin/stats")
+	if params != nil {
+		if params.From != "" {
+			req.query.Set("from", params.From)
+		}
+		if params.To != "" {
+			req.query.Set("to", params.To)
+		}
+		if params.Interval != "" {
+			req.query.Set("interval", params.Interval)
+		}
+	}
+	var out GetAdminStatsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UnlockUserResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// UnlockUser calls POST /admin/users/{id}/unlock. Unlock a user account.
+//
+// Clear failed login attempts and any login lockout on a user account (Admin only).
+func (c *Client) UnlockUser(ctx context.Context, id string) (*UnlockUserResponse, error) {
+	req := newRequest("POST", "/admin/users/"+url.PathEscape(id)+"/unlock")
+	var out UnlockUserResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ClaimAccountResponse struct {
+	Data    *TokenResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// ClaimAccount calls POST /auth/claim-account. Claim a guest account.
+//
+// Upgrade a guest to a full account by setting a password, using the claim link from an order confirmation email. Tickets bought as a guest stay with the account.
+func (c *Client) ClaimAccount(ctx context.Context, body *ClaimAccountRequest) (*ClaimAccountResponse, error) {
+	req := newRequest("POST", "/auth/claim-account")
+	if body != nil {
+		req.body = body
+	}
+	var out ClaimAccountResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetCSRFTokenResponseData struct {
+	CSRFToken string `json:"csrf_token,omitempty"`
+}
+
+type GetCSRFTokenResponse struct {
+	Data    *GetCSRFTokenResponseData `json:"data,omitempty"`
+	Success bool                      `json:"success,omitempty"`
+}
+
+// GetCSRFToken calls GET /auth/csrf. Get a CSRF token.
+//
+// Issue a double-submit CSRF token for cookie sessions, set in a readable cookie and returned. Send it in the X-CSRF-Token header on requests that carry the refresh cookie. Login and refresh in cookie mode issue one too.
+func (c *Client) GetCSRFToken(ctx context.Context) (*GetCSRFTokenResponse, error) {
+	req := newRequest("GET", "/auth/csrf")
+	var out GetCSRFTokenResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ForgotPasswordResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// ForgotPassword calls POST /auth/forgot-password. Request password reset.
+//
+// Send a password reset link to the account email if it exists.
+func (c *Client) ForgotPassword(ctx context.Context, body *ForgotPasswordRequest) (*ForgotPasswordResponse, error) {
+	req := newRequest("POST", "/auth/forgot-password")
+	if body != nil {
+		req.body = body
+	}
+	var out ForgotPasswordResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GuestCheckoutResponse struct {
+	Data    *TokenResponse `json:"data,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// GuestCheckout calls POST /auth/guest. Start a guest checkout.
+//
+// Get tokens to reserve and pay for tickets with just an email. Guest tokens are only accepted by the reservation, order, payment and waiting room endpoints; tickets are emailed with a link to claim the account.
+func (c *Client) GuestCheckout(ctx context.Context, body *GuestCheckoutRequest) (*GuestCheckoutResponse, error) {
+	req := newRequest("POST", "/auth/guest")
+	if body != nil {
+		req.body = body
+	}
+	var out GuestCheckoutResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type LoginResponse struct {
+	Data    *TokenResponse `json:"data,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// LoginParams are the query and header parameters of Login.
+type LoginParams struct {
+	// cookie for a cookie session
+	XAuthMode string
+}
+
+// Login calls POST /auth/login. User login.
+//
+// Authenticate user and return JWT tokens. Browsers may send X-Auth-Mode: cookie, when cookie sessions are enabled, to get the refresh token in an httpOnly cookie with a CSRF token instead.
+func (c *Client) Login(ctx context.Context, body *LoginRequest, params *LoginParams) (*LoginResponse, error) {
+	req := newRequest("POST", "/auth/login")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.XAuthMode != "" {
+			req.header.Set("X-Auth-Mode", params.XAuthMode)
+		}
+	}
+	var out LoginResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type LogoutResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// LogoutParams are the query and header parameters of Logout.
+type LogoutParams struct {
+	// CSRF token, in cookie mode
+	XCSRFToken string
+}
+
+// Logout calls POST /auth/logout. Log out.
+//
+// End the session of a refresh token, from the request body or the refresh cookie, and clear the session cookies. Cookie sessions need the X-CSRF-Token header.
+func (c *Client) Logout(ctx context.Context, body *LogoutRequest, params *LogoutParams) (*LogoutResponse, error) {
+	req := newRequest("POST", "/auth/logout")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.XCSRFToken != "" {
+			req.header.Set("X-CSRF-Token", params.XCSRFToken)
+		}
+	}
+	var out LogoutResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RefreshTokenResponse struct {
+	Data    *TokenResponse `json:"data,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// RefreshTokenParams are the query and header parameters of RefreshToken.
+type RefreshTokenParams struct {
+	// CSRF token, in cookie mode
+	XCSRFToken string
+}
+
+// RefreshToken calls POST /auth/refresh. Refresh access token.
+//
+// Get a new token pair using a refresh token. Cookie sessions may leave the body empty to use the refresh cookie, and must send the X-CSRF-Token header; the new refresh token is set in the cookie.
+func (c *Client) RefreshToken(ctx context.Context, body *RefreshTokenRequest, params *RefreshTokenParams) (*RefreshTokenResponse, error) {
+	req := newRequest("POST", "/auth/refresh")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.XCSRFToken != "" {
+			req.header.Set("X-CSRF-Token", params.XCSRFToken)
+		}
+	}
+	var out RefreshTokenResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RegisterResponse struct {
+	Data    *UserResponse `json:"data,omitempty"`
+	Message string        `json:"message,omitempty"`
+	Success bool          `json:"success,omitempty"`
+}
+
+// Register calls POST /auth/register. Register a new user.
+//
+// Create a new user account.
+func (c *Client) Register(ctx context.Context, body *RegisterRequest) (*RegisterResponse, error) {
+	req := newRequest("POST", "/auth/register")
+	if body != nil {
+		req.body = body
+	}
+	var out RegisterResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ResetPasswordResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// ResetPassword calls POST /auth/reset-password. Reset password.
+//
+// Set a new password using a password reset token.
+func (c *Client) ResetPassword(ctx context.Context, body *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	req := newRequest("POST", "/auth/reset-password")
+	if body != nil {
+		req.body = body
+	}
+	var out ResetPasswordResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type VerifyEmailResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// VerifyEmail calls POST /auth/verify-email. Verify email address.
+//
+// Verify a user's email address using verification token.
+func (c *Client) VerifyEmail(ctx context.Context, body *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	req := newRequest("POST", "/auth/verify-email")
+	if body != nil {
+		req.body = body
+	}
+	var out VerifyEmailResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListCategoriesResponse struct {
+	Data    []CategoryResponse `json:"data,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// ListCategories calls GET /categories. List event categories.
+//
+// List the active categories events can be filed under. Use the slug as an event's category and to filter GET /events.
+func (c *Client) ListCategories(ctx context.Context) (*ListCategoriesResponse, error) {
+	req := newRequest("GET", "/categories")
+	var out ListCategoriesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type PrintBadgeResponse struct {
+	Data    *Badge `json:"data,omitempty"`
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// PrintBadge calls POST /checkin/badges/{id}/print. Print an attendee badge.
+//
+// Send a checked-in attendee's badge to the event's badge printer, e.g. to reprint one (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) PrintBadge(ctx context.Context, id string) (*PrintBadgeResponse, error) {
+	req := newRequest("POST", "/checkin/badges/"+url.PathEscape(id)+"/print")
+	var out PrintBadgeResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CheckoutResponse struct {
+	Data    json.RawMessage `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// Checkout calls POST /checkin/checkout. Check a ticket out.
+//
+// Scan a checked-in ticket on its way out of an event that allows re-entry, so it can be scanned back in with /checkin/validate. With zone_id the ticket only leaves that zone, which frees a place in its occupancy (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) Checkout(ctx context.Context, body *CheckoutRequest) (*CheckoutResponse, error) {
+	req := newRequest("POST", "/checkin/checkout")
+	if body != nil {
+		req.body = body
+	}
+	var out CheckoutResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListBadgesResponse struct {
+	Data    []Badge `json:"data,omitempty"`
+	Success bool    `json:"success,omitempty"`
+}
+
+// ListBadgesParams are the query and header parameters of ListBadges.
+type ListBadgesParams struct {
+	// Only badges checked in after this RFC 3339 time
+	Since string
+	// Badges to return (1-100)
+	Limit int
+}
+
+// ListBadges calls GET /checkin/events/{id}/badges. List attendee badges.
+//
+// Printable badge data (name, tier and QR code) of attendees checked in to an event, in check-in order. Check-in desks poll with since set to the last badge's checked_in_at (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) ListBadges(ctx context.Context, id string, params *ListBadgesParams) (*ListBadgesResponse, error) {
+	req := newRequest("GET", "/checkin/events/"+url.PathEscape(id)+"/badges")
+	if params != nil {
+		if params.Since != "" {
+			req.query.Set("since", params.Since)
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListBadgesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetEventOccupancyResponse struct {
+	Data    *EventOccupancy `json:"data,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// GetEventOccupancy calls GET /checkin/events/{id}/occupancy. Get live occupancy.
+//
+// How many attendees are inside an event and each of its zones right now: entries minus check-outs, next to any occupancy limits (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) GetEventOccupancy(ctx context.Context, id string) (*GetEventOccupancyResponse, error) {
+	req := newRequest("GET", "/checkin/events/"+url.PathEscape(id)+"/occupancy")
+	var out GetEventOccupancyResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type SearchCheckinAttendeesResponse struct {
+	Data    []AttendeeMatch `json:"data,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// SearchCheckinAttendeesParams are the query and header parameters of SearchCheckinAttendees.
+type SearchCheckinAttendeesParams struct {
+	// Name or email, at least 2 characters (required)
+	Q string
+}
+
+// SearchCheckinAttendees calls GET /checkin/events/{id}/search. Search attendees for manual check-in.
+//
+// Find an event's tickets by the owner's name or email, for attendees who arrive without their ticket. Returns at most 20 active or checked-in tickets (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) SearchCheckinAttendees(ctx context.Context, id string, params *SearchCheckinAttendeesParams) (*SearchCheckinAttendeesResponse, error) {
+	req := newRequest("GET", "/checkin/events/"+url.PathEscape(id)+"/search")
+	if params != nil {
+		req.query.Set("q", params.Q)
+	}
+	var out SearchCheckinAttendeesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetCheckinStatsResponse struct {
+	Data    *CheckinStats `json:"data,omitempty"`
+	Success bool          `json:"success,omitempty"`
+}
+
+// GetCheckinStatsParams are the query and header parameters of GetCheckinStats.
+type GetCheckinStatsParams struct {
+	// Rate bucket size in minutes (1-60)
+	Interval int
+}
+
+// GetCheckinStats calls GET /checkin/events/{id}/stats. Get check-in statistics.
+//
+// Get live attendance for an event: totals, per-tier check-ins and check-in rate over time (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) GetCheckinStats(ctx context.Context, id string, params *GetCheckinStatsParams) (*GetCheckinStatsResponse, error) {
+	req := newRequest("GET", "/checkin/events/"+url.PathEscape(id)+"/stats")
+	if params != nil {
+		if params.Interval != 0 {
+			req.query.Set("interval", strconv.Itoa(params.Interval))
+		}
+	}
+	var out GetCheckinStatsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StreamCheckins calls GET /checkin/events/{id}/stream. Live check-in feed.
+//
+// Server-sent events stream pushing each successful check-in for an event as a "checkin" event (Organizer/Admin only, or a scanner token for the event).
+//
+// The caller reads the event stream and must close it.
+func (c *Client) StreamCheckins(ctx context.Context, id string) (io.ReadCloser, error) {
+	req := newRequest("GET", "/checkin/events/"+url.PathEscape(id)+"/stream")
+	return c.doStream(ctx, req)
+}
+
+type ManualCheckinResponse struct {
+	Data    json.RawMessage `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// ManualCheckin calls POST /checkin/manual. Check in a ticket manually.
+//
+// Check in a ticket found with the attendee search, for the event or one of its sessions when session_id is given, at the entrance of a zone when zone_id is given. The check-in is recorded with method "manual" (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) ManualCheckin(ctx context.Context, body *ManualCheckinRequest) (*ManualCheckinResponse, error) {
+	req := newRequest("POST", "/checkin/manual")
+	if body != nil {
+		req.body = body
+	}
+	var out ManualCheckinResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetTicketScansResponse struct {
+	Data    *ScanHistory `json:"data,omitempty"`
+	Success bool         `json:"success,omitempty"`
+}
+
+// GetTicketScans calls GET /checkin/tickets/{id}/scans. Get a ticket's scan history.
+//
+// Every entry, exit and session scan of a ticket, oldest first, and whether it is inside the event (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) GetTicketScans(ctx context.Context, id string) (*GetTicketScansResponse, error) {
+	req := newRequest("GET", "/checkin/tickets/"+url.PathEscape(id)+"/scans")
+	var out GetTicketScansResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UndoCheckinResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// UndoCheckin calls POST /checkin/undo. Undo a check-in.
+//
+// Reverse a mistaken check-in within 5 minutes of the scan. Undoing an event check-in makes the ticket valid for entry again (Organizer/Admin only).
+func (c *Client) UndoCheckin(ctx context.Context, body *UndoCheckinRequest) (*UndoCheckinResponse, error) {
+	req := newRequest("POST", "/checkin/undo")
+	if body != nil {
+		req.body = body
+	}
+	var out UndoCheckinResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ValidateQRCodeResponse struct {
+	Data    json.RawMessage `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// ValidateQRCode calls POST /checkin/validate. Validate QR code.
+//
+// Validate a ticket QR code for event check-in, or for one of its sessions when session_id is given. With zone_id the scan is at a zone's entrance and only tickets whose tier grants the zone get in; tickets already checked in at the main gate are let through without a new check-in (Organizer/Admin only, or a scanner token for the event).
+func (c *Client) ValidateQRCode(ctx context.Context, body *ValidateQRRequest) (*ValidateQRCodeResponse, error) {
+	req := newRequest("POST", "/checkin/validate")
+	if body != nil {
+		req.body = body
+	}
+	var out ValidateQRCodeResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListCurrenciesResponse struct {
+	Data    []CurrencyResponse `json:"data,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// ListCurrencies calls GET /currencies. List supported currencies.
+//
+// List the currencies events can be priced in and the payment providers that accept each one.
+func (c *Client) ListCurrencies(ctx context.Context) (*ListCurrenciesResponse, error) {
+	req := newRequest("GET", "/currencies")
+	var out ListCurrenciesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DeleteEventSessionResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteEventSession calls DELETE /event-sessions/{id}. Delete an event session.
+//
+// Delete a session that no ticket tier is limited to (Organizer/Admin only).
+func (c *Client) DeleteEventSession(ctx context.Context, id string) (*DeleteEventSessionResponse, error) {
+	req := newRequest("DELETE", "/event-sessions/"+url.PathEscape(id))
+	var out DeleteEventSessionResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateEventSessionResponse struct {
+	Data    *EventSessionResponse `json:"data,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// UpdateEventSession calls PUT /event-sessions/{id}. Update an event session.
+//
+// Update a session's title, times or capacity (Organizer/Admin only).
+func (c *Client) UpdateEventSession(ctx context.Context, id string, body *UpdateEventSessionRequest) (*UpdateEventSessionResponse, error) {
+	req := newRequest("PUT", "/event-sessions/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateEventSessionResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DeleteEventZoneResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteEventZone calls DELETE /event-zones/{id}. Delete an event zone.
+//
+// Delete a zone that no ticket tier grants (Organizer/Admin only).
+func (c *Client) DeleteEventZone(ctx context.Context, id string) (*DeleteEventZoneResponse, error) {
+	req := newRequest("DELETE", "/event-zones/"+url.PathEscape(id))
+	var out DeleteEventZoneResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateEventZoneResponse struct {
+	Data    *EventZoneResponse `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// UpdateEventZone calls PUT /event-zones/{id}. Update an event zone.
+//
+// Change the name or occupancy limit of a zone (Organizer/Admin only).
+func (c *Client) UpdateEventZone(ctx context.Context, id string, body *EventZoneRequest) (*UpdateEventZoneResponse, error) {
+	req := newRequest("PUT", "/event-zones/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateEventZoneResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListEventsResponsePagination struct {
+	HasMore    bool   `json:"has_more,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	Total      int    `json:"total,omitempty"`
+}
+
+type ListEventsResponse struct {
+	Data       []EventResponse               `json:"data,omitempty"`
+	Pagination *ListEventsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                          `json:"success,omitempty"`
+}
+
+// ListEventsParams are the query and header parameters of ListEvents.
+type ListEventsParams struct {
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+	// Cursor from the previous page
+	Cursor string
+	// Filter by category
+	Category string
+	// Filter by status
+	Status string
+}
+
+// ListEvents calls GET /events. List all events.
+//
+// Get a list of all published events. Pass cursor (empty for the first page, then pagination.next_cursor) for keyset pagination instead of page numbers.
+func (c *Client) ListEvents(ctx context.Context, params *ListEventsParams) (*ListEventsResponse, error) {
+	req := newRequest("GET", "/events")
+	if params != nil {
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+		if params.Cursor != "" {
+			req.query.Set("cursor", params.Cursor)
+		}
+		if params.Category != "" {
+			req.query.Set("category", params.Category)
+		}
+		if params.Status != "" {
+			req.query.Set("status", params.Status)
+		}
+	}
+	var out ListEventsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateEventResponse struct {
+	Data    *EventResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// CreateEvent calls POST /events. Create a new event.
+//
+// Create a new event. Requires the events:create permission, held by organizers and admins unless an admin changes the role mapping.
+func (c *Client) CreateEvent(ctx context.Context, body *CreateEventRequest) (*CreateEventResponse, error) {
+	req := newRequest("POST", "/events")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetFeaturedEventsResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type GetFeaturedEventsResponse struct {
+	Data       []EventResponse                      `json:"data,omitempty"`
+	Pagination *GetFeaturedEventsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                                 `json:"success,omitempty"`
+}
+
+// GetFeaturedEventsParams are the query and header parameters of GetFeaturedEvents.
+type GetFeaturedEventsParams struct {
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// GetFeaturedEvents calls GET /events/featured. Featured events.
+//
+// Upcoming published events picked by the Eventix team, soonest first.
+func (c *Client) GetFeaturedEvents(ctx context.Context, params *GetFeaturedEventsParams) (*GetFeaturedEventsResponse, error) {
+	req := newRequest("GET", "/events/featured")
+	if params != nil {
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetFeaturedEventsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetRecommendedEventsResponse struct {
+	Data         []EventResponse `json:"data,omitempty"`
+	Personalized bool            `json:"personalized,omitempty"`
+	Success      bool            `json:"success,omitempty"`
+}
+
+// GetRecommendedEventsParams are the query and header parameters of GetRecommendedEvents.
+type GetRecommendedEventsParams struct {
+	// Number of events
+	Limit int
+}
+
+// GetRecommendedEvents calls GET /events/recommended. Recommended events.
+//
+// Upcoming events ranked for the authenticated user by the organizers, categories and locations of their past purchases, topped up with featured and best-selling events. Anonymous users and users without purchases get featured and best-selling events only.
+func (c *Client) GetRecommendedEvents(ctx context.Context, params *GetRecommendedEventsParams) (*GetRecommendedEventsResponse, error) {
+	req := newRequest("GET", "/events/recommended")
+	if params != nil {
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetRecommendedEventsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetEventBySlugResponse struct {
+	Data    *EventResponse `json:"data,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// GetEventBySlug calls GET /events/slug/{slug}. Get event by slug.
+//
+// Get detailed information about an event using its URL slug.
+func (c *Client) GetEventBySlug(ctx context.Context, slug string) (*GetEventBySlugResponse, error) {
+	req := newRequest("GET", "/events/slug/"+url.PathEscape(slug))
+	var out GetEventBySlugResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetTrendingEventsResponse struct {
+	Data    []EventResponse `json:"data,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// GetTrendingEventsParams are the query and header parameters of GetTrendingEvents.
+type GetTrendingEventsParams struct {
+	// Number of events
+	Limit int
+}
+
+// GetTrendingEvents calls GET /events/trending. Trending events.
+//
+// Upcoming published events ranked by tickets sold over the last day, with recent sales weighing most.
+func (c *Client) GetTrendingEvents(ctx context.Context, params *GetTrendingEventsParams) (*GetTrendingEventsResponse, error) {
+	req := newRequest("GET", "/events/trending")
+	if params != nil {
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetTrendingEventsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetEventResponse struct {
+	Data    *EventResponse `json:"data,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// GetEvent calls GET /events/{id}. Get event by ID.
+//
+// Get detailed information about a specific event.
+func (c *Client) GetEvent(ctx context.Context, id string) (*GetEventResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id))
+	var out GetEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListAddOnsResponse struct {
+	Data    []AddOnResponse `json:"data,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// ListAddOns calls GET /events/{id}/add-ons. List an event's add-ons.
+//
+// List the extras, such as parking or merchandise, that can be added to an order for the event's tickets.
+func (c *Client) ListAddOns(ctx context.Context, id string) (*ListAddOnsResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/add-ons")
+	var out ListAddOnsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateAddOnResponse struct {
+	Data    *AddOnResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// CreateAddOn calls POST /events/{id}/add-ons. Add an add-on.
+//
+// Sell an extra, such as parking or merchandise, alongside the event's tickets. It is priced in the event's currency (Organizer/Admin only).
+func (c *Client) CreateAddOn(ctx context.Context, id string, body *CreateAddOnRequest) (*CreateAddOnResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/add-ons")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateAddOnResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RemoveBadgePrinterResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// RemoveBadgePrinter calls DELETE /events/{id}/badge-printer. Remove an event's badge printer.
+//
+// Stop sending the event's badges for printing (Organizer/Admin only).
+func (c *Client) RemoveBadgePrinter(ctx context.Context, id string) (*RemoveBadgePrinterResponse, error) {
+	req := newRequest("DELETE", "/events/"+url.PathEscape(id)+"/badge-printer")
+	var out RemoveBadgePrinterResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetBadgePrinterResponse struct {
+	Data    *BadgePrinterResponse `json:"data,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// GetBadgePrinter calls GET /events/{id}/badge-printer. Get an event's badge printer.
+//
+// Where the event's badges are sent for printing (Organizer/Admin only).
+func (c *Client) GetBadgePrinter(ctx context.Context, id string) (*GetBadgePrinterResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/badge-printer")
+	var out GetBadgePrinterResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateBadgePrinterResponse struct {
+	Data    *BadgePrinterResponse `json:"data,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// UpdateBadgePrinter calls PUT /events/{id}/badge-printer. Set an event's badge printer.
+//
+// Send the event's badges to a print server. The webhook driver POSTs {"type":"badge.print","badge":{...}} to the URL, signed with a hex HMAC-SHA256 of the body in X-Eventix-Signature. The signing secret is returned when first generated or rotated. With auto_print, every event check-in prints a badge (Organizer/Admin only).
+func (c *Client) UpdateBadgePrinter(ctx context.Context, id string, body *UpdateBadgePrinterRequest) (*UpdateBadgePrinterResponse, error) {
+	req := newRequest("PUT", "/events/"+url.PathEscape(id)+"/badge-printer")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateBadgePrinterResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UploadEventBannerResponseData struct {
+	BannerURL string `json:"banner_url,omitempty"`
+}
+
+type UploadEventBannerResponse struct {
+	Data    *UploadEventBannerResponseData `json:"data,omitempty"`
+	Message string                         `json:"message,omitempty"`
+	Success bool                           `json:"success,omitempty"`
+}
+
+// UploadEventBannerForm is the multipart form sent by UploadEventBanner.
+type UploadEventBannerForm struct {
+	// Banner image (required)
+	File *File
+}
+
+// UploadEventBanner calls POST /events/{id}/banner. Upload an event banner.
+//
+// Upload a banner image for an event (JPEG, PNG or WebP, max 5MB) (Organizer/Admin only).
+func (c *Client) UploadEventBanner(ctx context.Context, id string, form *UploadEventBannerForm) (*UploadEventBannerResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/banner")
+	if form != nil {
+		req.addFile("file", form.File)
+	}
+	var out UploadEventBannerResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CancelEventResponse struct {
+	Data    *EventCancellationResponse `json:"data,omitempty"`
+	Message string                     `json:"message,omitempty"`
+	Success bool                       `json:"success,omitempty"`
+}
+
+// CancelEvent calls POST /events/{id}/cancel. Cancel an event.
+//
+// Cancel an event: its tickets stop being valid, pending orders are cancelled, every paid order is queued for a full refund and ticket holders are emailed in the background. Repeating the call on a cancelled event re-queues any refunds that have not completed (Organizer/Admin only).
+func (c *Client) CancelEvent(ctx context.Context, id string, body *CancelEventRequest) (*CancelEventResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/cancel")
+	if body != nil {
+		req.body = body
+	}
+	var out CancelEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DuplicateEventResponse struct {
+	Data    *EventResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// DuplicateEvent calls POST /events/{id}/duplicate. Duplicate an event.
+//
+// Copy an event's details, ticket tiers, sessions and registration questions into a new draft with new dates. Sale windows, price phases and sessions move with the event. (Organizer/Admin only).
+func (c *Client) DuplicateEvent(ctx context.Context, id string, body *DuplicateEventRequest) (*DuplicateEventResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/duplicate")
+	if body != nil {
+		req.body = body
+	}
+	var out DuplicateEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UnfavoriteEventResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// UnfavoriteEvent calls DELETE /events/{id}/favorite. Remove a saved event.
+//
+// Remove an event from the authenticated user's saved events.
+func (c *Client) UnfavoriteEvent(ctx context.Context, id string) (*UnfavoriteEventResponse, error) {
+	req := newRequest("DELETE", "/events/"+url.PathEscape(id)+"/favorite")
+	var out UnfavoriteEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type FavoriteEventResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// FavoriteEvent calls POST /events/{id}/favorite. Save an event.
+//
+// Add an event to the authenticated user's saved events. The user is emailed when a saved event is published and when its tickets go on sale. Saving an event twice has no effect.
+func (c *Client) FavoriteEvent(ctx context.Context, id string) (*FavoriteEventResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/favorite")
+	var out FavoriteEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListFormFieldsResponse struct {
+	Data    []FormFieldResponse `json:"data,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// ListFormFields calls GET /events/{id}/form-fields. List an event's registration questions.
+//
+// List the custom questions buyers answer for each ticket when ordering, in display order.
+func (c *Client) ListFormFields(ctx context.Context, id string) (*ListFormFieldsResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/form-fields")
+	var out ListFormFieldsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateFormFieldResponse struct {
+	Data    *FormFieldResponse `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// CreateFormField calls POST /events/{id}/form-fields. Add a registration question.
+//
+// Add a custom question, such as a t-shirt size or dietary needs, that buyers answer for each ticket (Organizer/Admin only).
+func (c *Client) CreateFormField(ctx context.Context, id string, body *CreateFormFieldRequest) (*CreateFormFieldResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/form-fields")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateFormFieldResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateEventOccupancyLimitResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// UpdateEventOccupancyLimit calls PUT /events/{id}/occupancy-limit. Set an event's occupancy limit.
+//
+// Refuse entry scans while this many attendees are inside the event; 0 removes the limit. Zones have their own limits (Organizer/Admin only).
+func (c *Client) UpdateEventOccupancyLimit(ctx context.Context, id string, body *UpdateOccupancyLimitRequest) (*UpdateEventOccupancyLimitResponse, error) {
+	req := newRequest("PUT", "/events/"+url.PathEscape(id)+"/occupancy-limit")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateEventOccupancyLimitResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type JoinWaitingRoomResponse struct {
+	Data    *WaitingRoomPosition `json:"data,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// JoinWaitingRoom calls POST /events/{id}/queue. Join an event's waiting room.
+//
+// Get a queue token for a high-demand event. Poll its status until admitted, then pass it as queue_token to /tickets/reserve.
+func (c *Client) JoinWaitingRoom(ctx context.Context, id string) (*JoinWaitingRoomResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/queue")
+	var out JoinWaitingRoomResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetWaitingRoomPositionResponse struct {
+	Data    *WaitingRoomPosition `json:"data,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// GetWaitingRoomPosition calls GET /events/{id}/queue/{token}. Get waiting room status.
+//
+// Get the position of a queue token, or when it was admitted until.
+func (c *Client) GetWaitingRoomPosition(ctx context.Context, id string, token string) (*GetWaitingRoomPositionResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/queue/"+url.PathEscape(token))
+	var out GetWaitingRoomPositionResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateEventReentryResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// UpdateEventReentry calls PUT /events/{id}/reentry. Allow or disallow re-entry.
+//
+// When allowed, checked-in tickets can be scanned out with /checkin/checkout and back in, and every scan is kept in the ticket's history. Otherwise a ticket gets in once (Organizer/Admin only).
+func (c *Client) UpdateEventReentry(ctx context.Context, id string, body *UpdateReentryRequest) (*UpdateEventReentryResponse, error) {
+	req := newRequest("PUT", "/events/"+url.PathEscape(id)+"/reentry")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateEventReentryResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateEventRefundPolicyResponse struct {
+	Data    *RefundPolicyResponse `json:"data,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// UpdateEventRefundPolicy calls PUT /events/{id}/refund-policy. Set an event's refund policy.
+//
+// Set when attendees may refund their own orders: until the event starts (flexible), until days_before days before it (deadline) or never (none), and the fee in basis points kept from each refund. Refunds offered after a reschedule are always in full (Organizer/Admin only).
+func (c *Client) UpdateEventRefundPolicy(ctx context.Context, id string, body *UpdateRefundPolicyRequest) (*UpdateEventRefundPolicyResponse, error) {
+	req := newRequest("PUT", "/events/"+url.PathEscape(id)+"/refund-policy")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateEventRefundPolicyResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ReportEventResponse struct {
+	Data    *EventReportResponse `json:"data,omitempty"`
+	Message string               `json:"message,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// ReportEvent calls POST /events/{id}/report. Report an event.
+//
+// Flag a published event as spam, fraud, misleading, offensive or other for the Eventix team to review. Each user can report an event once.
+func (c *Client) ReportEvent(ctx context.Context, id string, body *ReportEventRequest) (*ReportEventResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/report")
+	if body != nil {
+		req.body = body
+	}
+	var out ReportEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RescheduleEventResponse struct {
+	Data    *EventRescheduleResponse `json:"data,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Success bool                     `json:"success,omitempty"`
+}
+
+// RescheduleEvent calls PUT /events/{id}/reschedule. Reschedule an event.
+//
+// Move an event and its sessions to new dates. Ticket holders are emailed, and buyers of existing orders can request a refund until the refund window closes, even if the refund policy would otherwise refuse it. The window never extends past the new start (Organizer/Admin only).
+func (c *Client) RescheduleEvent(ctx context.Context, id string, body *RescheduleEventRequest) (*RescheduleEventResponse, error) {
+	req := newRequest("PUT", "/events/"+url.PathEscape(id)+"/reschedule")
+	if body != nil {
+		req.body = body
+	}
+	var out RescheduleEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListEventReschedulesResponse struct {
+	Data    []EventRescheduleResponse `json:"data,omitempty"`
+	Success bool                      `json:"success,omitempty"`
+}
+
+// ListEventReschedules calls GET /events/{id}/reschedules. List an event's date changes.
+//
+// List the times an event was rescheduled, newest first, with each change's refund deadline (Organizer/Admin only).
+func (c *Client) ListEventReschedules(ctx context.Context, id string) (*ListEventReschedulesResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/reschedules")
+	var out ListEventReschedulesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListEventSessionsResponse struct {
+	Data    []EventSessionResponse `json:"data,omitempty"`
+	Success bool                   `json:"success,omitempty"`
+}
+
+// ListEventSessions calls GET /events/{id}/sessions. List event sessions.
+//
+// List the sessions of a multi-session event in schedule order.
+func (c *Client) ListEventSessions(ctx context.Context, id string) (*ListEventSessionsResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/sessions")
+	var out ListEventSessionsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateEventSessionResponse struct {
+	Data    *EventSessionResponse `json:"data,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// CreateEventSession calls POST /events/{id}/sessions. Add an event session.
+//
+// Add a session, such as a talk or festival day, that attendees check in to separately (Organizer/Admin only).
+func (c *Client) CreateEventSession(ctx context.Context, id string, body *CreateEventSessionRequest) (*CreateEventSessionResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/sessions")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateEventSessionResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetEventStatusHistoryResponse struct {
+	Data    []EventStatusChangeResponse `json:"data,omitempty"`
+	Success bool                        `json:"success,omitempty"`
+}
+
+// GetEventStatusHistory calls GET /events/{id}/status-history. Event status history.
+//
+// List an event's status changes, newest first, with the reasons given when it was rejected, unpublished, banned or cancelled (Organizer/Admin only).
+func (c *Client) GetEventStatusHistory(ctx context.Context, id string) (*GetEventStatusHistoryResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/status-history")
+	var out GetEventStatusHistoryResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type SubmitEventResponse struct {
+	Data    *EventStatusResponse `json:"data,omitempty"`
+	Message string               `json:"message,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// SubmitEvent calls POST /events/{id}/submit. Submit an event for review.
+//
+// Move a draft event into the admin review queue (Organizer/Admin only).
+func (c *Client) SubmitEvent(ctx context.Context, id string) (*SubmitEventResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/submit")
+	var out SubmitEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateEventTaxResponse struct {
+	Data    *TaxResponse `json:"data,omitempty"`
+	Message string       `json:"message,omitempty"`
+	Success bool         `json:"success,omitempty"`
+}
+
+// UpdateEventTax calls PUT /events/{id}/tax. Set an event's tax.
+//
+// Set the sales tax or VAT charged on the event's orders, in basis points (750 is 7.5%). Inclusive tax is part of the ticket and add-on prices; exclusive tax is added at checkout. A null basis_points falls back to the organizer's tax settings (Organizer/Admin only).
+func (c *Client) UpdateEventTax(ctx context.Context, id string, body *UpdateTaxRequest) (*UpdateEventTaxResponse, error) {
+	req := newRequest("PUT", "/events/"+url.PathEscape(id)+"/tax")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateEventTaxResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateTicketTierResponse struct {
+	Data    *TicketTierResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// CreateTicketTier calls POST /events/{id}/tiers. Add a ticket tier.
+//
+// Add a ticket tier to an existing event (Organizer/Admin only).
+func (c *Client) CreateTicketTier(ctx context.Context, id string, body *TicketTierReq) (*CreateTicketTierResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/tiers")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateTicketTierResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateWaitingRoomResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// UpdateWaitingRoom calls PUT /events/{id}/waiting-room. Enable or disable an event's waiting room.
+//
+// When enabled, buyers must be admitted from the virtual queue before reserving tickets. Disabling it discards the queue. (Organizer/Admin only).
+func (c *Client) UpdateWaitingRoom(ctx context.Context, id string, body *UpdateWaitingRoomRequest) (*UpdateWaitingRoomResponse, error) {
+	req := newRequest("PUT", "/events/"+url.PathEscape(id)+"/waiting-room")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateWaitingRoomResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListEventZonesResponse struct {
+	Data    []EventZoneResponse `json:"data,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// ListEventZones calls GET /events/{id}/zones. List event zones.
+//
+// List the restricted areas of an event's venue, such as a VIP lounge or backstage.
+func (c *Client) ListEventZones(ctx context.Context, id string) (*ListEventZonesResponse, error) {
+	req := newRequest("GET", "/events/"+url.PathEscape(id)+"/zones")
+	var out ListEventZonesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateEventZoneResponse struct {
+	Data    *EventZoneResponse `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// CreateEventZone calls POST /events/{id}/zones. Add an event zone.
+//
+// Add a restricted area with its own entrance. Only tickets whose tier grants the zone are let in there, and no more than max_occupancy at once when set (Organizer/Admin only).
+func (c *Client) CreateEventZone(ctx context.Context, id string, body *EventZoneRequest) (*CreateEventZoneResponse, error) {
+	req := newRequest("POST", "/events/"+url.PathEscape(id)+"/zones")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateEventZoneResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DeleteFormFieldResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteFormField calls DELETE /form-fields/{id}. Delete a registration question.
+//
+// Stop asking a question. It also drops out of the attendee export (Organizer/Admin only).
+func (c *Client) DeleteFormField(ctx context.Context, id string) (*DeleteFormFieldResponse, error) {
+	req := newRequest("DELETE", "/form-fields/"+url.PathEscape(id))
+	var out DeleteFormFieldResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateFormFieldResponse struct {
+	Data    *FormFieldResponse `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// UpdateFormField calls PUT /form-fields/{id}. Update a registration question.
+//
+// Update a question's label, type, options, order or whether it is required. Answers already given are kept (Organizer/Admin only).
+func (c *Client) UpdateFormField(ctx context.Context, id string, body *UpdateFormFieldRequest) (*UpdateFormFieldResponse, error) {
+	req := newRequest("PUT", "/form-fields/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateFormFieldResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetGraphqlResponseErrorsExtensions struct {
+	Code string `json:"code,omitempty"`
+}
+
+type GetGraphqlResponseErrors struct {
+	Extensions *GetGraphqlResponseErrorsExtensions `json:"extensions,omitempty"`
+	Message    string                              `json:"message,omitempty"`
+	Path       []string                            `json:"path,omitempty"`
+}
+
+type GetGraphqlResponse struct {
+	Data   json.RawMessage            `json:"data,omitempty"`
+	Errors []GetGraphqlResponseErrors `json:"errors,omitempty"`
+}
+
+// GetGraphqlParams are the query and header parameters of GetGraphql.
+type GetGraphqlParams struct {
+	// Query, for GET requests
+	Query string
+}
+
+// GetGraphql calls GET /graphql. Query the GraphQL API.
+//
+// Run a GraphQL query over events, ticket tiers, organizers and, when authenticated, the caller's tickets and orders. Relationships are batched, so an event with its tiers and organizer is one round trip. See the schema at internal/graph/schema.graphqls or introspect it.
+func (c *Client) GetGraphql(ctx context.Context, params *GetGraphqlParams) (*GetGraphqlResponse, error) {
+	req := newRequest("GET", "/graphql")
+	if params != nil {
+		if params.Query != "" {
+			req.query.Set("query", params.Query)
+		}
+	}
+	var out GetGraphqlResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type PostGraphqlResponseErrorsExtensions struct {
+	Code string `json:"code,omitempty"`
+}
+
+type PostGraphqlResponseErrors struct {
+	Extensions *PostGraphqlResponseErrorsExtensions `json:"extensions,omitempty"`
+	Message    string                               `json:"message,omitempty"`
+	Path       []string                             `json:"path,omitempty"`
+}
+
+type PostGraphqlResponse struct {
+	Data   json.RawMessage             `json:"data,omitempty"`
+	Errors []PostGraphqlResponseErrors `json:"errors,omitempty"`
+}
+
+// PostGraphqlParams are the query and header parameters of PostGraphql.
+type PostGraphqlParams struct {
+	// Query, for GET requests
+	Query string
+}
+
+// PostGraphql calls POST /graphql. Query the GraphQL API.
+//
+// Run a GraphQL query over events, ticket tiers, organizers and, when authenticated, the caller's tickets and orders. Relationships are batched, so an event with its tiers and organizer is one round trip. See the schema at internal/graph/schema.graphqls or introspect it.
+func (c *Client) PostGraphql(ctx context.Context, params *PostGraphqlParams) (*PostGraphqlResponse, error) {
+	req := newRequest("POST", "/graphql")
+	if params != nil {
+		if params.Query != "" {
+			req.query.Set("query", params.Query)
+		}
+	}
+	var out PostGraphqlResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// IssueCompTicketsAction calls POST /integrations/actions/comp-tickets. Issue comp tickets from an integration.
+//
+// Action giving one person free tickets of a tier, for example everyone added to a sponsor sheet. They get a zero-priced order and their tickets by email, like comps issued from the dashboard.
+func (c *Client) IssueCompTicketsAction(ctx context.Context, body *IntegrationCompTicketsRequest) (*IntegrationCompResponse, error) {
+	req := newRequest("POST", "/integrations/actions/comp-tickets")
+	if body != nil {
+		req.body = body
+	}
+	var out IntegrationCompResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListIntegrationEvents calls GET /integrations/events. List events for an integration.
+//
+// List the organizer's events, latest first, for event pickers in integration setup.
+func (c *Client) ListIntegrationEvents(ctx context.Context) ([]IntegrationEventResponse, error) {
+	req := newRequest("GET", "/integrations/events")
+	var out []IntegrationEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListIntegrationTiers calls GET /integrations/events/{id}/tiers. List ticket tiers for an integration.
+//
+// List an event's ticket tiers, for tier pickers in integration setup.
+func (c *Client) ListIntegrationTiers(ctx context.Context, id string) ([]IntegrationTierResponse, error) {
+	req := newRequest("GET", "/integrations/events/"+url.PathEscape(id)+"/tiers")
+	var out []IntegrationTierResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetIntegrationAccount calls GET /integrations/me. Test an API key.
+//
+// Return the organizer an API key belongs to. Integrations call it to test a connection and label it.
+func (c *Client) GetIntegrationAccount(ctx context.Context) (*IntegrationAccountResponse, error) {
+	req := newRequest("GET", "/integrations/me")
+	var out IntegrationAccountResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindAttendeeSearchParams are the query and header parameters of FindAttendeeSearch.
+type FindAttendeeSearchParams struct {
+	// Attendee email (required)
+	Email string
+	// Only this event
+	EventID string
+	// Maximum records
+	Limit int
+}
+
+// FindAttendeeSearch calls GET /integrations/searches/attendees. Find an attendee.
+//
+// Search action returning the issued tickets held by an email address, newest first. An empty array means no match.
+func (c *Client) FindAttendeeSearch(ctx context.Context, params *FindAttendeeSearchParams) ([]IntegrationAttendeeResponse, error) {
+	req := newRequest("GET", "/integrations/searches/attendees")
+	if params != nil {
+		req.query.Set("email", params.Email)
+		if params.EventID != "" {
+			req.query.Set("event_id", params.EventID)
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out []IntegrationAttendeeResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NewAttendeesTriggerParams are the query and header parameters of NewAttendeesTrigger.
+type NewAttendeesTriggerParams struct {
+	// Only this event
+	EventID string
+	// Cursor of the newest record already seen
+	Since string
+	// Maximum records
+	Limit int
+}
+
+// NewAttendeesTrigger calls GET /integrations/triggers/attendees. Poll for new attendees.
+//
+// Polling trigger for issued tickets with their holder's name and email, newest first. Zapier deduplicates by id; other pollers pass the newest record's cursor as since to get only the records issued after it.
+func (c *Client) NewAttendeesTrigger(ctx context.Context, params *NewAttendeesTriggerParams) ([]IntegrationAttendeeResponse, error) {
+	req := newRequest("GET", "/integrations/triggers/attendees")
+	if params != nil {
+		if params.EventID != "" {
+			req.query.Set("event_id", params.EventID)
+		}
+		if params.Since != "" {
+			req.query.Set("since", params.Since)
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out []IntegrationAttendeeResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NewOrdersTriggerParams are the query and header parameters of NewOrdersTrigger.
+type NewOrdersTriggerParams struct {
+	// Only this event
+	EventID string
+	// Cursor of the newest record already seen
+	Since string
+	// Maximum records
+	Limit int
+}
+
+// NewOrdersTrigger calls GET /integrations/triggers/orders. Poll for new orders.
+//
+// Polling trigger for sold orders, comps included, with the buyer's name and email, newest first by when their tickets were issued. Refunded orders keep their place with status refunded. Paged by since like the attendees trigger.
+func (c *Client) NewOrdersTrigger(ctx context.Context, params *NewOrdersTriggerParams) ([]IntegrationOrderResponse, error) {
+	req := newRequest("GET", "/integrations/triggers/orders")
+	if params != nil {
+		if params.EventID != "" {
+			req.query.Set("event_id", params.EventID)
+		}
+		if params.Since != "" {
+			req.query.Set("since", params.Since)
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out []IntegrationOrderResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type MarkNotificationReadResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// MarkNotificationRead calls POST /notifications/{id}/read. Mark a notification as read.
+//
+// Mark a notification belonging to the authenticated user as read.
+func (c *Client) MarkNotificationRead(ctx context.Context, id string) (*MarkNotificationReadResponse, error) {
+	req := newRequest("POST", "/notifications/"+url.PathEscape(id)+"/read")
+	var out MarkNotificationReadResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateOrderResponse struct {
+	Data    *OrderResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// CreateOrderParams are the query and header parameters of CreateOrder.
+type CreateOrderParams struct {
+	// Unique key that makes retries of this request safe
+	IdempotencyKey string
+}
+
+// CreateOrder calls POST /orders. Create an order.
+//
+// Create an order for reserved tickets, answering the event's registration form questions (see GET /events/{id}/form-fields) and optionally adding extras such as parking or merchandise (see GET /events/{id}/add-ons).
+func (c *Client) CreateOrder(ctx context.Context, body *CreateOrderRequest, params *CreateOrderParams) (*CreateOrderResponse, error) {
+	req := newRequest("POST", "/orders")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			req.header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var out CreateOrderResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetMyOrdersResponsePagination struct {
+	HasMore    bool   `json:"has_more,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type GetMyOrdersResponse struct {
+	Data       []OrderResponse                `json:"data,omitempty"`
+	Pagination *GetMyOrdersResponsePagination `json:"pagination,omitempty"`
+	Success    bool                           `json:"success,omitempty"`
+}
+
+// GetMyOrdersParams are the query and header parameters of GetMyOrders.
+type GetMyOrdersParams struct {
+	// Cursor from the previous page
+	Cursor string
+	// Items per page when paginating
+	Limit int
+}
+
+// GetMyOrders calls GET /orders/my-orders. Get user's orders.
+//
+// Get all orders placed by the authenticated user, newest first. Pass cursor (empty for the first page, then pagination.next_cursor) to page through them.
+func (c *Client) GetMyOrders(ctx context.Context, params *GetMyOrdersParams) (*GetMyOrdersResponse, error) {
+	req := newRequest("GET", "/orders/my-orders")
+	if params != nil {
+		if params.Cursor != "" {
+			req.query.Set("cursor", params.Cursor)
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetMyOrdersResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetOrderResponse struct {
+	Data    *OrderDetailResponse `json:"data,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// GetOrder calls GET /orders/{id}. Get an order.
+//
+// Get an order owned by the authenticated user with its line items, tickets, payments and refunds. QR codes are included for tickets the user still holds.
+func (c *Client) GetOrder(ctx context.Context, id string) (*GetOrderResponse, error) {
+	req := newRequest("GET", "/orders/"+url.PathEscape(id))
+	var out GetOrderResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOrderReceipt calls GET /orders/{id}/receipt. Download an order receipt.
+//
+// Render the PDF receipt of a paid or refunded order owned by the authenticated user.
+//
+// The response body is returned as it was sent.
+func (c *Client) GetOrderReceipt(ctx context.Context, id string) ([]byte, error) {
+	req := newRequest("GET", "/orders/"+url.PathEscape(id)+"/receipt")
+	return c.doBytes(ctx, req)
+}
+
+type RequestRefundResponse struct {
+	Data    *RefundResponse `json:"data,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// RequestRefund calls POST /orders/{id}/refund. Request a refund.
+//
+// Refund a paid order owned by the authenticated user, subject to the event refund policy.
+func (c *Client) RequestRefund(ctx context.Context, id string, body *RefundRequest) (*RequestRefundResponse, error) {
+	req := newRequest("POST", "/orders/"+url.PathEscape(id)+"/refund")
+	if body != nil {
+		req.body = body
+	}
+	var out RequestRefundResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RetryPaymentResponse struct {
+	Data    *PaymentInitResult `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// RetryPaymentParams are the query and header parameters of RetryPayment.
+type RetryPaymentParams struct {
+	// Unique key that makes retries of this request safe
+	IdempotencyKey string
+}
+
+// RetryPayment calls POST /orders/{id}/retry-payment. Retry a failed payment.
+//
+// Start a new payment for an order whose last payment failed, while its tickets are still reserved.
+func (c *Client) RetryPayment(ctx context.Context, id string, body *RetryPaymentRequest, params *RetryPaymentParams) (*RetryPaymentResponse, error) {
+	req := newRequest("POST", "/orders/"+url.PathEscape(id)+"/retry-payment")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			req.header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var out RetryPaymentResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListIntegrationKeysResponse struct {
+	Data    []IntegrationKeyResponse `json:"data,omitempty"`
+	Success bool                     `json:"success,omitempty"`
+}
+
+// ListIntegrationKeys calls GET /organizer/api-keys. List my API keys.
+//
+// List the API keys that connect Zapier, Make and similar tools to the organizer account, including revoked ones.
+func (c *Client) ListIntegrationKeys(ctx context.Context) (*ListIntegrationKeysResponse, error) {
+	req := newRequest("GET", "/organizer/api-keys")
+	var out ListIntegrationKeysResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateIntegrationKeyResponse struct {
+	Data    *IntegrationKeyResponse `json:"data,omitempty"`
+	Message string                  `json:"message,omitempty"`
+	Success bool                    `json:"success,omitempty"`
+}
+
+// CreateIntegrationKey calls POST /organizer/api-keys. Create an API key.
+//
+// Create a key for the /integrations routes, sent in the X-API-Key header. The key reads the organizer's orders and attendees and can issue comp tickets, acting as the signed-in user. It is only returned here.
+func (c *Client) CreateIntegrationKey(ctx context.Context, body *CreateIntegrationKeyRequest) (*CreateIntegrationKeyResponse, error) {
+	req := newRequest("POST", "/organizer/api-keys")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateIntegrationKeyResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RevokeIntegrationKeyResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// RevokeIntegrationKey calls DELETE /organizer/api-keys/{id}. Revoke an API key.
+//
+// Stop an API key from working. Integrations using it get 401 responses.
+func (c *Client) RevokeIntegrationKey(ctx context.Context, id string) (*RevokeIntegrationKeyResponse, error) {
+	req := newRequest("DELETE", "/organizer/api-keys/"+url.PathEscape(id))
+	var out RevokeIntegrationKeyResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ApplyOrganizerResponse struct {
+	Data    *OrganizerResponse `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// ApplyOrganizerForm is the multipart form sent by ApplyOrganizer.
+type ApplyOrganizerForm struct {
+	// Organization name (required)
+	OrganizationName string
+	// Organization description
+	Description string
+	// Organization website
+	Website string
+	// Verification document (required)
+	Document *File
+}
+
+// ApplyOrganizer calls POST /organizer/apply. Apply to become an organizer.
+//
+// Submit an organizer application with a verification document (PDF, JPEG or PNG, max 5MB).
+func (c *Client) ApplyOrganizer(ctx context.Context, form *ApplyOrganizerForm) (*ApplyOrganizerResponse, error) {
+	req := newRequest("POST", "/organizer/apply")
+	if form != nil {
+		req.addField("organization_name", form.OrganizationName)
+		req.addField("description", form.Description)
+		req.addField("website", form.Website)
+		req.addFile("document", form.Document)
+	}
+	var out ApplyOrganizerResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetEventAnalyticsResponse struct {
+	Data    *EventAnalytics `json:"data,omitempty"`
+	Success bool            `json:"success,omitempty"`
+}
+
+// GetEventAnalytics calls GET /organizer/events/{id}/analytics. Get event analytics.
+//
+// Get tickets sold per tier, daily revenue, refund rate and reservation-to-purchase conversion for an event (Organizer/Admin only).
+func (c *Client) GetEventAnalytics(ctx context.Context, id string) (*GetEventAnalyticsResponse, error) {
+	req := newRequest("GET", "/organizer/events/"+url.PathEscape(id)+"/analytics")
+	var out GetEventAnalyticsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportAttendeesParams are the query and header parameters of ExportAttendees.
+type ExportAttendeesParams struct {
+	// Export format
+	Format string
+}
+
+// ExportAttendees calls GET /organizer/events/{id}/attendees/export. Export event attendees.
+//
+// Download an event's ticket holders with their tier, order, check-in status and registration form answers as CSV or XLSX. The file is streamed as it is generated (Organizer/Admin only).
+//
+// The response body is returned as it was sent.
+func (c *Client) ExportAttendees(ctx context.Context, id string, params *ExportAttendeesParams) ([]byte, error) {
+	req := newRequest("GET", "/organizer/events/"+url.PathEscape(id)+"/attendees/export")
+	if params != nil {
+		if params.Format != "" {
+			req.query.Set("format", params.Format)
+		}
+	}
+	return c.doBytes(ctx, req)
+}
+
+type IssueCompTicketsResponse struct {
+	Data    []CompOrderResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// IssueCompTickets calls POST /organizer/events/{id}/comp-tickets. Issue comp tickets.
+//
+// Give free tickets of one tier to a list of people. Each recipient gets a zero-priced order and their tickets by email; people without an account can claim one from it. Comps use up tier stock and are reported separately from sales (Organizer/Admin only).
+func (c *Client) IssueCompTickets(ctx context.Context, id string, body *IssueCompTicketsRequest) (*IssueCompTicketsResponse, error) {
+	req := newRequest("POST", "/organizer/events/"+url.PathEscape(id)+"/comp-tickets")
+	if body != nil {
+		req.body = body
+	}
+	var out IssueCompTicketsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListScannerTokensResponse struct {
+	Data    []ScannerTokenResponse `json:"data,omitempty"`
+	Success bool                   `json:"success,omitempty"`
+}
+
+// ListScannerTokens calls GET /organizer/events/{id}/scanner-tokens. List scanner tokens.
+//
+// List the unexpired, unrevoked scanner tokens of an event. Token values are not returned.
+func (c *Client) ListScannerTokens(ctx context.Context, id string) (*ListScannerTokensResponse, error) {
+	req := newRequest("GET", "/organizer/events/"+url.PathEscape(id)+"/scanner-tokens")
+	var out ListScannerTokensResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type IssueScannerTokenResponse struct {
+	Data    *ScannerTokenResponse `json:"data,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// IssueScannerToken calls POST /organizer/events/{id}/scanner-tokens. Issue a door staff scanner token.
+//
+// Issue a short-lived token for temporary gate staff. It only reaches the check-in routes of this event, so staff never need organizer credentials. The token is returned once; check-ins made with it are recorded against the issuer.
+func (c *Client) IssueScannerToken(ctx context.Context, id string, body *IssueScannerTokenRequest) (*IssueScannerTokenResponse, error) {
+	req := newRequest("POST", "/organizer/events/"+url.PathEscape(id)+"/scanner-tokens")
+	if body != nil {
+		req.body = body
+	}
+	var out IssueScannerTokenResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UploadOrganizerLogoResponseData struct {
+	LogoURL string `json:"logo_url,omitempty"`
+}
+
+type UploadOrganizerLogoResponse struct {
+	Data    *UploadOrganizerLogoResponseData `json:"data,omitempty"`
+	Message string                           `json:"message,omitempty"`
+	Success bool                             `json:"success,omitempty"`
+}
+
+// UploadOrganizerLogoForm is the multipart form sent by UploadOrganizerLogo.
+type UploadOrganizerLogoForm struct {
+	// Logo image (required)
+	File *File
+}
+
+// UploadOrganizerLogo calls POST /organizer/logo. Upload an organizer logo.
+//
+// Upload a logo for the authenticated user's organizer profile (JPEG, PNG or WebP, max 5MB).
+func (c *Client) UploadOrganizerLogo(ctx context.Context, form *UploadOrganizerLogoForm) (*UploadOrganizerLogoResponse, error) {
+	req := newRequest("POST", "/organizer/logo")
+	if form != nil {
+		req.addFile("file", form.File)
+	}
+	var out UploadOrganizerLogoResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetMyPayoutsResponseData struct {
+	Balances []BalanceResponse `json:"balances,omitempty"`
+	Payouts  []PayoutResponse  `json:"payouts,omitempty"`
+}
+
+type GetMyPayoutsResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type GetMyPayoutsResponse struct {
+	Data       *GetMyPayoutsResponseData       `json:"data,omitempty"`
+	Pagination *GetMyPayoutsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                            `json:"success,omitempty"`
+}
+
+// GetMyPayoutsParams are the query and header parameters of GetMyPayouts.
+type GetMyPayoutsParams struct {
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// GetMyPayouts calls GET /organizer/payouts. Get organizer balances and payouts.
+//
+// Get the authenticated organizer's balance per currency and payout history (Organizer/Admin only).
+func (c *Client) GetMyPayouts(ctx context.Context, params *GetMyPayoutsParams) (*GetMyPayoutsResponse, error) {
+	req := newRequest("GET", "/organizer/payouts")
+	if params != nil {
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetMyPayoutsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RevokeScannerTokenResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// RevokeScannerToken calls DELETE /organizer/scanner-tokens/{id}. Revoke a scanner token.
+//
+// Stop a scanner token from checking in tickets before it expires.
+func (c *Client) RevokeScannerToken(ctx context.Context, id string) (*RevokeScannerTokenResponse, error) {
+	req := newRequest("DELETE", "/organizer/scanner-tokens/"+url.PathEscape(id))
+	var out RevokeScannerTokenResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateOrganizerTaxResponse struct {
+	Data    *TaxResponse `json:"data,omitempty"`
+	Message string       `json:"message,omitempty"`
+	Success bool         `json:"success,omitempty"`
+}
+
+// UpdateOrganizerTax calls PUT /organizer/tax. Set the organizer's default tax.
+//
+// Set the sales tax or VAT charged on orders for the organizer's events that have no tax settings of their own, in basis points (750 is 7.5%). A null basis_points charges no tax (Organizer only).
+func (c *Client) UpdateOrganizerTax(ctx context.Context, body *UpdateTaxRequest) (*UpdateOrganizerTaxResponse, error) {
+	req := newRequest("PUT", "/organizer/tax")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateOrganizerTaxResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListTeamMembersResponse struct {
+	Data    []TeamMemberResponse `json:"data,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// ListTeamMembers calls GET /organizer/team. List my team.
+//
+// List the organizer's team members and pending invitations.
+func (c *Client) ListTeamMembers(ctx context.Context) (*ListTeamMembersResponse, error) {
+	req := newRequest("GET", "/organizer/team")
+	var out ListTeamMembersResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type InviteTeamMemberResponse struct {
+	Data    *TeamMemberResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// InviteTeamMember calls POST /organizer/team. Invite a team member.
+//
+// Email an invitation to join the organizer's team as a scanner (check-in only), finance (analytics and payouts), editor (event editing and comps) or with custom permissions. Members only act on this organizer's events. Inviting a pending address again sends a fresh link.
+func (c *Client) InviteTeamMember(ctx context.Context, body *InviteTeamMemberRequest) (*InviteTeamMemberResponse, error) {
+	req := newRequest("POST", "/organizer/team")
+	if body != nil {
+		req.body = body
+	}
+	var out InviteTeamMemberResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RemoveTeamMemberResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// RemoveTeamMember calls DELETE /organizer/team/{id}. Remove a team member.
+//
+// Take a member off the team, or withdraw a pending invitation.
+func (c *Client) RemoveTeamMember(ctx context.Context, id string) (*RemoveTeamMemberResponse, error) {
+	req := newRequest("DELETE", "/organizer/team/"+url.PathEscape(id))
+	var out RemoveTeamMemberResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateTeamMemberResponse struct {
+	Data    *TeamMemberResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// UpdateTeamMember calls PUT /organizer/team/{id}. Change a team member's role.
+//
+// Change the role or custom permissions of a team member or pending invitee.
+func (c *Client) UpdateTeamMember(ctx context.Context, id string, body *UpdateTeamMemberRequest) (*UpdateTeamMemberResponse, error) {
+	req := newRequest("PUT", "/organizer/team/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateTeamMemberResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RedeliverWebhookResponse struct {
+	Data    *WebhookDeliveryResponse `json:"data,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Success bool                     `json:"success,omitempty"`
+}
+
+// RedeliverWebhook calls POST /organizer/webhook-deliveries/{id}/redeliver. Redeliver a webhook.
+//
+// Queue a logged delivery's payload to be sent again on the next delivery sweep, as a new delivery. The payload keeps its event id so receivers can recognise it.
+func (c *Client) RedeliverWebhook(ctx context.Context, id string) (*RedeliverWebhookResponse, error) {
+	req := newRequest("POST", "/organizer/webhook-deliveries/"+url.PathEscape(id)+"/redeliver")
+	var out RedeliverWebhookResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListWebhookEndpointsResponse struct {
+	Data    []WebhookEndpointResponse `json:"data,omitempty"`
+	Success bool                      `json:"success,omitempty"`
+}
+
+// ListWebhookEndpoints calls GET /organizer/webhooks. List my webhook endpoints.
+//
+// List the URLs the organizer receives event notifications at.
+func (c *Client) ListWebhookEndpoints(ctx context.Context) (*ListWebhookEndpointsResponse, error) {
+	req := newRequest("GET", "/organizer/webhooks")
+	var out ListWebhookEndpointsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type CreateWebhookEndpointResponse struct {
+	Data    *WebhookEndpointResponse `json:"data,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Success bool                     `json:"success,omitempty"`
+}
+
+// CreateWebhookEndpoint calls POST /organizer/webhooks. Register a webhook endpoint.
+//
+// Receive ticket.sold, attendee.checked_in and order.refunded events at an HTTPS URL. Each event is POSTed as {"id","type","created_at","data"} with X-Eventix-Event, X-Eventix-Delivery and X-Eventix-Timestamp headers, signed with a hex HMAC-SHA256 of "<timestamp>.<body>" in X-Eventix-Signature. Any 2xx answer accepts a delivery; others are retried with exponential backoff. The signing secret is only returned here and when rotated.
+func (c *Client) CreateWebhookEndpoint(ctx context.Context, body *CreateWebhookEndpointRequest) (*CreateWebhookEndpointResponse, error) {
+	req := newRequest("POST", "/organizer/webhooks")
+	if body != nil {
+		req.body = body
+	}
+	var out CreateWebhookEndpointResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DeleteWebhookEndpointResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteWebhookEndpoint calls DELETE /organizer/webhooks/{id}. Delete a webhook endpoint.
+//
+// Stop sending events to an endpoint and drop its delivery log, including deliveries still waiting to be retried.
+func (c *Client) DeleteWebhookEndpoint(ctx context.Context, id string) (*DeleteWebhookEndpointResponse, error) {
+	req := newRequest("DELETE", "/organizer/webhooks/"+url.PathEscape(id))
+	var out DeleteWebhookEndpointResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateWebhookEndpointResponse struct {
+	Data    *WebhookEndpointResponse `json:"data,omitempty"`
+	Message string                   `json:"message,omitempty"`
+	Success bool                     `json:"success,omitempty"`
+}
+
+// UpdateWebhookEndpoint calls PUT /organizer/webhooks/{id}. Update a webhook endpoint.
+//
+// Change an endpoint's URL, description or events, pause it with active=false, or rotate its signing secret. A rotated secret is returned once.
+func (c *Client) UpdateWebhookEndpoint(ctx context.Context, id string, body *UpdateWebhookEndpointRequest) (*UpdateWebhookEndpointResponse, error) {
+	req := newRequest("PUT", "/organizer/webhooks/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateWebhookEndpointResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListWebhookDeliveriesResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Data       []WebhookDeliveryResponse                `json:"data,omitempty"`
+	Pagination *ListWebhookDeliveriesResponsePagination `json:"pagination,omitempty"`
+	Success    bool                                     `json:"success,omitempty"`
+}
+
+// ListWebhookDeliveriesParams are the query and header parameters of ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Filter by status
+	Status string
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// ListWebhookDeliveries calls GET /organizer/webhooks/{id}/deliveries. List webhook deliveries.
+//
+// List an endpoint's deliveries newest first, with the payload sent, the number of attempts, the endpoint's last response and when the next retry is due.
+func (c *Client) ListWebhookDeliveries(ctx context.Context, id string, params *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesResponse, error) {
+	req := newRequest("GET", "/organizer/webhooks/"+url.PathEscape(id)+"/deliveries")
+	if params != nil {
+		if params.Status != "" {
+			req.query.Set("status", params.Status)
+		}
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out ListWebhookDeliveriesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ListWidgetOriginsResponse struct {
+	Data    []WidgetOriginResponse `json:"data,omitempty"`
+	Success bool                   `json:"success,omitempty"`
+}
+
+// ListWidgetOrigins calls GET /organizer/widget-origins. List widget origins.
+//
+// List the sites the organizer's checkout widget can be embedded on.
+func (c *Client) ListWidgetOrigins(ctx context.Context) (*ListWidgetOriginsResponse, error) {
+	req := newRequest("GET", "/organizer/widget-origins")
+	var out ListWidgetOriginsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type AddWidgetOriginResponse struct {
+	Data    *WidgetOriginResponse `json:"data,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// AddWidgetOrigin calls POST /organizer/widget-origins. Allow a widget origin.
+//
+// Allow the checkout widget of the organizer's events on a site. The widget routes answer cross-origin requests from it; the origin must use https, except for localhost.
+func (c *Client) AddWidgetOrigin(ctx context.Context, body *AddWidgetOriginRequest) (*AddWidgetOriginResponse, error) {
+	req := newRequest("POST", "/organizer/widget-origins")
+	if body != nil {
+		req.body = body
+	}
+	var out AddWidgetOriginResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RemoveWidgetOriginResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// RemoveWidgetOrigin calls DELETE /organizer/widget-origins/{id}. Remove a widget origin.
+//
+// Stop the checkout widget from answering requests from a site.
+func (c *Client) RemoveWidgetOrigin(ctx context.Context, id string) (*RemoveWidgetOriginResponse, error) {
+	req := newRequest("DELETE", "/organizer/widget-origins/"+url.PathEscape(id))
+	var out RemoveWidgetOriginResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type InitializePaymentResponse struct {
+	Data    *PaymentInitResult `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// InitializePaymentParams are the query and header parameters of InitializePayment.
+type InitializePaymentParams struct {
+	// Unique key that makes retries of this request safe
+	IdempotencyKey string
+}
+
+// InitializePayment calls POST /payments/initialize. Initialize payment.
+//
+// Initialize a transaction with the order's payment provider (Paystack or Stripe) and return the checkout URL.
+func (c *Client) InitializePayment(ctx context.Context, body *InitializePaymentRequest, params *InitializePaymentParams) (*InitializePaymentResponse, error) {
+	req := newRequest("POST", "/payments/initialize")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			req.header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var out InitializePaymentResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type VerifyPaymentResponse struct {
+	Data    *PaymentResponse `json:"data,omitempty"`
+	Success bool             `json:"success,omitempty"`
+}
+
+// VerifyPayment calls GET /payments/verify/{reference}. Verify payment.
+//
+// Verify a transaction with the payment provider and complete the order on success.
+func (c *Client) VerifyPayment(ctx context.Context, reference string) (*VerifyPaymentResponse, error) {
+	req := newRequest("GET", "/payments/verify/"+url.PathEscape(reference))
+	var out VerifyPaymentResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type AcceptTeamInvitationResponse struct {
+	Data    *TeamMemberResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// AcceptTeamInvitation calls POST /team-invitations/{token}/accept. Accept a team invitation.
+//
+// Join the organizer team that invited the signed-in user's email address. Only attendee accounts can join, and only one team at a time.
+func (c *Client) AcceptTeamInvitation(ctx context.Context, token string) (*AcceptTeamInvitationResponse, error) {
+	req := newRequest("POST", "/team-invitations/"+url.PathEscape(token)+"/accept")
+	var out AcceptTeamInvitationResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetMyTicketsResponsePagination struct {
+	HasMore    bool   `json:"has_more,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type GetMyTicketsResponse struct {
+	Data       []TicketResponse                `json:"data,omitempty"`
+	Pagination *GetMyTicketsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                            `json:"success,omitempty"`
+}
+
+// GetMyTicketsParams are the query and header parameters of GetMyTickets.
+type GetMyTicketsParams struct {
+	// Cursor from the previous page
+	Cursor string
+	// Items per page when paginating
+	Limit int
+}
+
+// GetMyTickets calls GET /tickets/my-tickets. Get user's tickets.
+//
+// Get all tickets owned by the authenticated user, newest first. Pass cursor (empty for the first page, then pagination.next_cursor) to page through them.
+func (c *Client) GetMyTickets(ctx context.Context, params *GetMyTicketsParams) (*GetMyTicketsResponse, error) {
+	req := newRequest("GET", "/tickets/my-tickets")
+	if params != nil {
+		if params.Cursor != "" {
+			req.query.Set("cursor", params.Cursor)
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetMyTicketsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ReserveTicketResponseData struct {
+	EventID       string `json:"event_id,omitempty"`
+	ExpiresAt     string `json:"expires_at,omitempty"`
+	PricePhase    string `json:"price_phase,omitempty"`
+	Quantity      int    `json:"quantity,omitempty"`
+	ReservationID string `json:"reservation_id,omitempty"`
+	TierID        string `json:"tier_id,omitempty"`
+	TotalPrice    int    `json:"total_price,omitempty"`
+	UnitPrice     int    `json:"unit_price,omitempty"`
+}
+
+type ReserveTicketResponse struct {
+	Data    *ReserveTicketResponseData `json:"data,omitempty"`
+	Message string                     `json:"message,omitempty"`
+	Success bool                       `json:"success,omitempty"`
+}
+
+// ReserveTicket calls POST /tickets/reserve. Reserve a ticket.
+//
+// Reserve a ticket for an event (15-minute hold).
+func (c *Client) ReserveTicket(ctx context.Context, body *ReserveTicketRequest) (*ReserveTicketResponse, error) {
+	req := newRequest("POST", "/tickets/reserve")
+	if body != nil {
+		req.body = body
+	}
+	var out ReserveTicketResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetTicketResponse struct {
+	Data    *TicketDetailResponse `json:"data,omitempty"`
+	Success bool                  `json:"success,omitempty"`
+}
+
+// GetTicket calls GET /tickets/{id}. Get a ticket.
+//
+// Get a ticket owned by the authenticated user with its event, QR code, check-in status and registration answers.
+func (c *Client) GetTicket(ctx context.Context, id string) (*GetTicketResponse, error) {
+	req := newRequest("GET", "/tickets/"+url.PathEscape(id))
+	var out GetTicketResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTicketCalendar calls GET /tickets/{id}/calendar.ics. Get a calendar invite for a ticket.
+//
+// Download an iCalendar (.ics) file with the event time, venue and ticket reference for a ticket owned by the authenticated user.
+//
+// The response body is returned as it was sent.
+func (c *Client) GetTicketCalendar(ctx context.Context, id string) ([]byte, error) {
+	req := newRequest("GET", "/tickets/"+url.PathEscape(id)+"/calendar.ics")
+	return c.doBytes(ctx, req)
+}
+
+// GetTicketPDF calls GET /tickets/{id}/pdf. Download a printable ticket.
+//
+// Download a PDF of a ticket owned by the authenticated user with its QR code, event details and terms of entry.
+//
+// The response body is returned as it was sent.
+func (c *Client) GetTicketPDF(ctx context.Context, id string) ([]byte, error) {
+	req := newRequest("GET", "/tickets/"+url.PathEscape(id)+"/pdf")
+	return c.doBytes(ctx, req)
+}
+
+// GetTicketQRCode calls GET /tickets/{id}/qr. Get ticket QR code.
+//
+// Render the signed QR code of a ticket owned by the authenticated user as a PNG image.
+//
+// The response body is returned as it was sent.
+func (c *Client) GetTicketQRCode(ctx context.Context, id string) ([]byte, error) {
+	req := newRequest("GET", "/tickets/"+url.PathEscape(id)+"/qr")
+	return c.doBytes(ctx, req)
+}
+
+// GetTicketWalletPassParams are the query and header parameters of GetTicketWalletPass.
+type GetTicketWalletPassParams struct {
+	// Wallet platform (apple, google)
+	Platform string
+}
+
+// GetTicketWalletPass calls GET /tickets/{id}/wallet-pass. Get a wallet pass for a ticket.
+//
+// Download a signed Apple Wallet pass (.pkpass) for an active ticket owned by the authenticated user, or get an "Add to Google Wallet" link with platform=google.
+//
+// The response body is returned as it was sent.
+func (c *Client) GetTicketWalletPass(ctx context.Context, id string, params *GetTicketWalletPassParams) ([]byte, error) {
+	req := newRequest("GET", "/tickets/"+url.PathEscape(id)+"/wallet-pass")
+	if params != nil {
+		if params.Platform != "" {
+			req.query.Set("platform", params.Platform)
+		}
+	}
+	return c.doBytes(ctx, req)
+}
+
+type DeleteTicketTierResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteTicketTier calls DELETE /tiers/{id}. Delete a ticket tier.
+//
+// Delete a ticket tier that has no sold or reserved tickets (Organizer/Admin only).
+func (c *Client) DeleteTicketTier(ctx context.Context, id string) (*DeleteTicketTierResponse, error) {
+	req := newRequest("DELETE", "/tiers/"+url.PathEscape(id))
+	var out DeleteTicketTierResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateTicketTierResponse struct {
+	Data    *TicketTierResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// UpdateTicketTier calls PUT /tiers/{id}. Update a ticket tier.
+//
+// Update a ticket tier. Quantity cannot drop below tickets sold and price is locked once sales begin (Organizer/Admin only).
+func (c *Client) UpdateTicketTier(ctx context.Context, id string, body *UpdateTicketTierRequest) (*UpdateTicketTierResponse, error) {
+	req := newRequest("PUT", "/tiers/"+url.PathEscape(id))
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateTicketTierResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type SetPricePhasesResponse struct {
+	Data    *TicketTierResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// SetPricePhases calls PUT /tiers/{id}/price-phases. Set a ticket tier's price phases.
+//
+// Replace a tier's price phases, such as an early-bird price that ends at a date or after a number of sales. The first phase that applies sets the price; the tier's regular price applies after them all. Reservations keep the price they were held at (Organizer/Admin only).
+func (c *Client) SetPricePhases(ctx context.Context, id string, body *SetPricePhasesRequest) (*SetPricePhasesResponse, error) {
+	req := newRequest("PUT", "/tiers/"+url.PathEscape(id)+"/price-phases")
+	if body != nil {
+		req.body = body
+	}
+	var out SetPricePhasesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type SetTierSessionsResponse struct {
+	Data    *TicketTierResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// SetTierSessions calls PUT /tiers/{id}/sessions. Set the sessions a ticket tier admits to.
+//
+// Limit a tier's tickets to some sessions of its event. An empty list makes them valid for every session (Organizer/Admin only).
+func (c *Client) SetTierSessions(ctx context.Context, id string, body *SetTierSessionsRequest) (*SetTierSessionsResponse, error) {
+	req := newRequest("PUT", "/tiers/"+url.PathEscape(id)+"/sessions")
+	if body != nil {
+		req.body = body
+	}
+	var out SetTierSessionsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type SetTierZonesResponse struct {
+	Data    *TicketTierResponse `json:"data,omitempty"`
+	Message string              `json:"message,omitempty"`
+	Success bool                `json:"success,omitempty"`
+}
+
+// SetTierZones calls PUT /tiers/{id}/zones. Set the zones a ticket tier admits to.
+//
+// Let a tier's tickets into some zones of its event besides the main gate. An empty list limits them to the main gate (Organizer/Admin only).
+func (c *Client) SetTierZones(ctx context.Context, id string, body *SetTierZonesRequest) (*SetTierZonesResponse, error) {
+	req := newRequest("PUT", "/tiers/"+url.PathEscape(id)+"/zones")
+	if body != nil {
+		req.body = body
+	}
+	var out SetTierZonesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type DeleteAccountResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// DeleteAccount calls DELETE /users/me. Delete current user account.
+//
+// Deactivate and delete the authenticated user's account. Personal data is anonymized once the deletion grace period ends.
+func (c *Client) DeleteAccount(ctx context.Context, body *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	req := newRequest("DELETE", "/users/me")
+	if body != nil {
+		req.body = body
+	}
+	var out DeleteAccountResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetCurrentUserResponse struct {
+	Data    *UserResponse `json:"data,omitempty"`
+	Success bool          `json:"success,omitempty"`
+}
+
+// GetCurrentUser calls GET /users/me. Get current user profile.
+//
+// Get authenticated user's profile information.
+func (c *Client) GetCurrentUser(ctx context.Context) (*GetCurrentUserResponse, error) {
+	req := newRequest("GET", "/users/me")
+	var out GetCurrentUserResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateProfileResponse struct {
+	Data    *UserResponse `json:"data,omitempty"`
+	Message string        `json:"message,omitempty"`
+	Success bool          `json:"success,omitempty"`
+}
+
+// UpdateProfile calls PUT /users/me. Update current user profile.
+//
+// Update the authenticated user's name and phone number. Omitted fields are unchanged; an empty phone removes it.
+func (c *Client) UpdateProfile(ctx context.Context, body *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	req := newRequest("PUT", "/users/me")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateProfileResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ChangePasswordResponse struct {
+	Data    *TokenResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// ChangePassword calls POST /users/me/change-password. Change password.
+//
+// Change the authenticated user's password. Refresh tokens issued before the change stop working; a new token pair is returned.
+func (c *Client) ChangePassword(ctx context.Context, body *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	req := newRequest("POST", "/users/me/change-password")
+	if body != nil {
+		req.body = body
+	}
+	var out ChangePasswordResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type ExportAccountResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// ExportAccountParams are the query and header parameters of ExportAccount.
+type ExportAccountParams struct {
+	// Export format
+	Format string
+}
+
+// ExportAccount calls GET /users/me/export. Export current user data.
+//
+// Generate an export of the authenticated user's profile, orders and tickets and email a download link.
+func (c *Client) ExportAccount(ctx context.Context, params *ExportAccountParams) (*ExportAccountResponse, error) {
+	req := newRequest("GET", "/users/me/export")
+	if params != nil {
+		if params.Format != "" {
+			req.query.Set("format", params.Format)
+		}
+	}
+	var out ExportAccountResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetMyFavoritesResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type GetMyFavoritesResponse struct {
+	Data       []FavoriteResponse                `json:"data,omitempty"`
+	Pagination *GetMyFavoritesResponsePagination `json:"pagination,omitempty"`
+	Success    bool                              `json:"success,omitempty"`
+}
+
+// GetMyFavoritesParams are the query and header parameters of GetMyFavorites.
+type GetMyFavoritesParams struct {
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// GetMyFavorites calls GET /users/me/favorites. List saved events.
+//
+// List the authenticated user's saved events, most recently saved first.
+func (c *Client) GetMyFavorites(ctx context.Context, params *GetMyFavoritesParams) (*GetMyFavoritesResponse, error) {
+	req := newRequest("GET", "/users/me/favorites")
+	if params != nil {
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetMyFavoritesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetNotificationPreferencesResponse struct {
+	Data    *NotificationPreferencesResponse `json:"data,omitempty"`
+	Success bool                             `json:"success,omitempty"`
+}
+
+// GetNotificationPreferences calls GET /users/me/notification-preferences. Get notification preferences.
+//
+// Get the channels and topics the authenticated user receives notifications for.
+func (c *Client) GetNotificationPreferences(ctx context.Context) (*GetNotificationPreferencesResponse, error) {
+	req := newRequest("GET", "/users/me/notification-preferences")
+	var out GetNotificationPreferencesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	Data    *NotificationPreferencesResponse `json:"data,omitempty"`
+	Message string                           `json:"message,omitempty"`
+	Success bool                             `json:"success,omitempty"`
+}
+
+// UpdateNotificationPreferences calls PUT /users/me/notification-preferences. Update notification preferences.
+//
+// Choose which channels (email, SMS, push) and topics (orders, reminders, marketing) the authenticated user receives. Omitted fields are unchanged; account and security emails are always sent.
+func (c *Client) UpdateNotificationPreferences(ctx context.Context, body *NotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error) {
+	req := newRequest("PUT", "/users/me/notification-preferences")
+	if body != nil {
+		req.body = body
+	}
+	var out UpdateNotificationPreferencesResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetMyNotificationsResponsePagination struct {
+	Limit int `json:"limit,omitempty"`
+	Page  int `json:"page,omitempty"`
+	Total int `json:"total,omitempty"`
+}
+
+type GetMyNotificationsResponse struct {
+	Data       []NotificationResponse                `json:"data,omitempty"`
+	Pagination *GetMyNotificationsResponsePagination `json:"pagination,omitempty"`
+	Success    bool                                  `json:"success,omitempty"`
+}
+
+// GetMyNotificationsParams are the query and header parameters of GetMyNotifications.
+type GetMyNotificationsParams struct {
+	// Only return unread notifications
+	Unread *bool
+	// Page number
+	Page int
+	// Items per page
+	Limit int
+}
+
+// GetMyNotifications calls GET /users/me/notifications. Get user's notifications.
+//
+// Get notifications sent to the authenticated user, newest first.
+func (c *Client) GetMyNotifications(ctx context.Context, params *GetMyNotificationsParams) (*GetMyNotificationsResponse, error) {
+	req := newRequest("GET", "/users/me/notifications")
+	if params != nil {
+		if params.Unread != nil {
+			req.query.Set("unread", strconv.FormatBool(*params.Unread))
+		}
+		if params.Page != 0 {
+			req.query.Set("page", strconv.Itoa(params.Page))
+		}
+		if params.Limit != 0 {
+			req.query.Set("limit", strconv.Itoa(params.Limit))
+		}
+	}
+	var out GetMyNotificationsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetMySessionsResponse struct {
+	Data    []SessionResponse `json:"data,omitempty"`
+	Success bool              `json:"success,omitempty"`
+}
+
+// GetMySessions calls GET /users/me/sessions. List my sessions.
+//
+// List the devices the authenticated user is signed in on, most recently used first.
+func (c *Client) GetMySessions(ctx context.Context) (*GetMySessionsResponse, error) {
+	req := newRequest("GET", "/users/me/sessions")
+	var out GetMySessionsResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type RevokeMySessionResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// RevokeMySession calls DELETE /users/me/sessions/{id}. Revoke a session.
+//
+// Sign the authenticated user out of one device. Its refresh token stops working and its access tokens are rejected.
+func (c *Client) RevokeMySession(ctx context.Context, id string) (*RevokeMySessionResponse, error) {
+	req := newRequest("DELETE", "/users/me/sessions/"+url.PathEscape(id))
+	var out RevokeMySessionResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type PaymentWebhookResponse struct {
+	Message string `json:"message,omitempty"`
+	Success bool   `json:"success,omitempty"`
+}
+
+// PaymentWebhook calls POST /webhooks/payments. Payment provider webhook.
+//
+// Receive signed Paystack (x-paystack-signature) or Stripe (Stripe-Signature) callbacks and settle the referenced payment.
+func (c *Client) PaymentWebhook(ctx context.Context) (*PaymentWebhookResponse, error) {
+	req := newRequest("POST", "/webhooks/payments")
+	var out PaymentWebhookResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetWidgetEventResponse struct {
+	Data    *WidgetEventResponse `json:"data,omitempty"`
+	Success bool                 `json:"success,omitempty"`
+}
+
+// GetWidgetEvent calls GET /widget/events/{id}. Get an event for the widget.
+//
+// Get a published event with its ticket tiers, live availability and the sites its checkout widget is embedded on.
+func (c *Client) GetWidgetEvent(ctx context.Context, id string) (*GetWidgetEventResponse, error) {
+	req := newRequest("GET", "/widget/events/"+url.PathEscape(id))
+	var out GetWidgetEventResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type WidgetGuestCheckoutResponse struct {
+	Data    *TokenResponse `json:"data,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// WidgetGuestCheckout calls POST /widget/events/{id}/guest. Start a widget guest checkout.
+//
+// Get guest tokens for the widget's reservation, order and payment routes, as with POST /auth/guest.
+func (c *Client) WidgetGuestCheckout(ctx context.Context, id string, body *GuestCheckoutRequest) (*WidgetGuestCheckoutResponse, error) {
+	req := newRequest("POST", "/widget/events/"+url.PathEscape(id)+"/guest")
+	if body != nil {
+		req.body = body
+	}
+	var out WidgetGuestCheckoutResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type WidgetCreateOrderResponse struct {
+	Data    *OrderResponse `json:"data,omitempty"`
+	Message string         `json:"message,omitempty"`
+	Success bool           `json:"success,omitempty"`
+}
+
+// WidgetCreateOrderParams are the query and header parameters of WidgetCreateOrder.
+type WidgetCreateOrderParams struct {
+	// Unique key that makes retries of this request safe
+	IdempotencyKey string
+}
+
+// WidgetCreateOrder calls POST /widget/events/{id}/orders. Create an order from the widget.
+//
+// Create an order for tickets reserved through the widget, as with POST /orders.
+func (c *Client) WidgetCreateOrder(ctx context.Context, id string, body *CreateOrderRequest, params *WidgetCreateOrderParams) (*WidgetCreateOrderResponse, error) {
+	req := newRequest("POST", "/widget/events/"+url.PathEscape(id)+"/orders")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			req.header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var out WidgetCreateOrderResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type WidgetInitializePaymentResponse struct {
+	Data    *PaymentInitResult `json:"data,omitempty"`
+	Message string             `json:"message,omitempty"`
+	Success bool               `json:"success,omitempty"`
+}
+
+// WidgetInitializePaymentParams are the query and header parameters of WidgetInitializePayment.
+type WidgetInitializePaymentParams struct {
+	// Unique key that makes retries of this request safe
+	IdempotencyKey string
+}
+
+// WidgetInitializePayment calls POST /widget/events/{id}/payments/initialize. Pay for a widget order.
+//
+// Initialize the payment of an order for the event and return the checkout URL, as with POST /payments/initialize.
+func (c *Client) WidgetInitializePayment(ctx context.Context, id string, body *InitializePaymentRequest, params *WidgetInitializePaymentParams) (*WidgetInitializePaymentResponse, error) {
+	req := newRequest("POST", "/widget/events/"+url.PathEscape(id)+"/payments/initialize")
+	if body != nil {
+		req.body = body
+	}
+	if params != nil {
+		if params.IdempotencyKey != "" {
+			req.header.Set("Idempotency-Key", params.IdempotencyKey)
+		}
+	}
+	var out WidgetInitializePaymentResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type WidgetReserveTicketResponseData struct {
+	EventID       string `json:"event_id,omitempty"`
+	ExpiresAt     string `json:"expires_at,omitempty"`
+	PricePhase    string `json:"price_phase,omitempty"`
+	Quantity      int    `json:"quantity,omitempty"`
+	ReservationID string `json:"reservation_id,omitempty"`
+	TierID        string `json:"tier_id,omitempty"`
+	TotalPrice    int    `json:"total_price,omitempty"`
+	UnitPrice     int    `json:"unit_price,omitempty"`
+}
+
+type WidgetReserveTicketResponse struct {
+	Data    *WidgetReserveTicketResponseData `json:"data,omitempty"`
+	Message string                           `json:"message,omitempty"`
+	Success bool                             `json:"success,omitempty"`
+}
+
+// WidgetReserveTicket calls POST /widget/events/{id}/reserve. Reserve tickets from the widget.
+//
+// Reserve tickets of one of the event's tiers (15-minute hold), as with POST /tickets/reserve.
+func (c *Client) WidgetReserveTicket(ctx context.Context, id string, body *ReserveTicketRequest) (*WidgetReserveTicketResponse, error) {
+	req := newRequest("POST", "/widget/events/"+url.PathEscape(id)+"/reserve")
+	if body != nil {
+		req.body = body
+	}
+	var out WidgetReserveTicketResponse
+	if err := c.doJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,5937 @@
+// Code generated by cmd/gensdk. DO NOT EDIT.
+
+/** Where the API version this SDK was generated for is served */
+export const BASE_PATH = "/api/v1";
+
+export interface ClientOptions {
+  /** Server to call, such as https://api.example.com; BASE_PATH is added to it */
+  serverURL: string;
+  /** Access token sent as a Bearer token */
+  token?: string;
+  /** Organizer API key, as the /integrations routes expect */
+  apiKey?: string;
+  /** fetch implementation; defaults to the global one */
+  fetch?: typeof fetch;
+  /** Sent with every request, e.g. credentials: "include" for cookie sessions */
+  init?: RequestInit;
+}
+
+/** Thrown for responses with a non-2xx status */
+export class APIError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly code: string,
+    message: string,
+    public readonly details?: unknown,
+  ) {
+    super(message || `HTTP ${status}`);
+    this.name = "APIError";
+  }
+}
+
+/** A request as the generated methods describe it */
+export interface RequestOptions {
+  method: string;
+  path: string;
+  query?: Record<string, string | number | boolean | string[] | undefined>;
+  headers?: Record<string, string | undefined>;
+  body?: unknown;
+  form?: Record<string, string | Blob | undefined>;
+  as: "json" | "blob" | "response" | "none";
+}
+
+/** Sends requests for EventixClient */
+export class BaseClient {
+  private readonly baseURL: string;
+  private readonly fetchImpl: typeof fetch;
+  private token?: string;
+  private readonly apiKey?: string;
+  private readonly init?: RequestInit;
+
+  constructor(options: ClientOptions) {
+    this.baseURL = options.serverURL.replace(/\/+$/, "") + BASE_PATH;
+    this.fetchImpl = options.fetch ?? fetch.bind(globalThis);
+    this.token = options.token;
+    this.apiKey = options.apiKey;
+    this.init = options.init;
+  }
+
+  /** Replaces the access token, e.g. after a login or refresh */
+  setToken(token?: string): void {
+    this.token = token;
+  }
+
+  protected async request<T>(options: RequestOptions): Promise<T> {
+    const url = new URL(this.baseURL + options.path);
+    for (const [name, value] of Object.entries(options.query ?? {})) {
+      if (value === undefined) continue;
+      for (const item of Array.isArray(value) ? value : [value]) {
+        url.searchParams.append(name, String(item));
+      }
+    }
+
+    const headers = new Headers(this.init?.headers);
+    for (const [name, value] of Object.entries(options.headers ?? {})) {
+      if (value !== undefined) headers.set(name, value);
+    }
+    if (this.token) headers.set("Authorization", `Bearer ${this.token}`);
+    if (this.apiKey) headers.set("X-API-Key", this.apiKey);
+
+    let body: BodyInit | undefined;
+    if (options.form) {
+      const form = new FormData();
+      for (const [name, value] of Object.entries(options.form)) {
+        if (value !== undefined) form.append(name, value);
+      }
+      body = form;
+    } else if (options.body !== undefined) {
+      headers.set("Content-Type", "application/json");
+      body = JSON.stringify(options.body);
+    }
+
+    const response = await this.fetchImpl(url, { ...this.init, method: options.method, headers, body });
+    if (!response.ok) {
+      let error: { code?: string; message?: string; details?: unknown } = {};
+      try {
+        error = ((await response.json()) as { error?: typeof error }).error ?? {};
+      } catch {
+        // Not a JSON error envelope
+      }
+      throw new APIError(response.status, error.code ?? "", error.message ?? "", error.details);
+    }
+
+    switch (options.as) {
+      case "json":
+        return (await response.json()) as T;
+      case "blob":
+        return (await response.blob()) as T;
+      case "response":
+        return response as T;
+      default:
+        await response.body?.cancel();
+        return undefined as T;
+    }
+  }
+}
+
+export interface AddOnResponse {
+  currency?: string;
+  description?: string;
+  id?: string;
+  name?: string;
+  price?: number;
+  quantity?: number;
+  /** omitted when unlimited */
+  remaining?: number;
+}
+
+export interface AddWidgetOriginRequest {
+  /** Site the widget is embedded on, e.g. "https://tickets.example.com" */
+  origin: string;
+}
+
+export interface AuditLogResponse {
+  action?: AuditAction;
+  actor_id?: string;
+  actor_role?: UserRole;
+  after?: unknown;
+  before?: unknown;
+  created_at?: string;
+  id?: string;
+  ip_address?: string;
+  request_id?: string;
+  target_id?: string;
+  target_type?: AuditTargetType;
+}
+
+export interface AvailabilitySocketMessage {
+  data?: unknown;
+  event_id?: string;
+  message?: string;
+  /** subscribed, unsubscribed, availability or error */
+  type?: string;
+}
+
+export interface BadgePrinterResponse {
+  auto_print?: boolean;
+  driver?: BadgeDriver;
+  /** only returned when generated */
+  secret?: string;
+  updated_at?: string;
+  url?: string;
+}
+
+export interface BalanceResponse {
+  balance?: number;
+  currency?: string;
+}
+
+export interface CancelEventRequest {
+  /** shown to ticket holders */
+  reason?: string;
+}
+
+export interface CategoryResponse {
+  icon?: string;
+  id?: string;
+  is_active?: boolean;
+  name?: string;
+  slug?: string;
+}
+
+export interface ChangePasswordRequest {
+  current_password: string;
+  new_password: string;
+}
+
+export interface CheckoutRequest {
+  device?: string;
+  event_id: string;
+  qr_code: string;
+  /** leaving a zone rather than the event */
+  zone_id?: string;
+}
+
+export interface ClaimAccountRequest {
+  password: string;
+  token: string;
+}
+
+export interface CompOrderResponse {
+  email?: string;
+  order_id?: string;
+  quantity?: number;
+}
+
+export interface CompRecipientRequest {
+  email: string;
+  first_name?: string;
+  last_name?: string;
+  /** defaults to 1 */
+  quantity?: number;
+}
+
+export interface CompensateOrderSagaRequest {
+  reason: string;
+}
+
+export interface CompletePayoutRequest {
+  reference: string;
+}
+
+export interface CreateAddOnRequest {
+  description?: string;
+  name: string;
+  /** minor units */
+  price?: number;
+  /** 0 or omitted means unlimited */
+  quantity?: number;
+}
+
+export interface CreateCategoryRequest {
+  icon?: string;
+  name: string;
+  /** derived from the name when omitted */
+  slug?: string;
+}
+
+export interface CreateEventRequest {
+  category: string;
+  currency?: string;
+  description: string;
+  end_time: string;
+  location: string;
+  max_attendees?: number;
+  start_time: string;
+  ticket_tiers: TicketTierReq[];
+  title: string;
+}
+
+export interface CreateEventSessionRequest {
+  /** 0 means no limit */
+  capacity?: number;
+  end_time: string;
+  start_time: string;
+  title: string;
+}
+
+export interface CreateFormFieldRequest {
+  label: string;
+  /** choices of a select field */
+  options?: string[];
+  position?: number;
+  required?: boolean;
+  type: string;
+}
+
+export interface CreateIntegrationKeyRequest {
+  /** e.g. "Zapier" */
+  name: string;
+}
+
+export interface CreateOrderRequest {
+  /** Extras such as parking or merchandise (see GET /events/{id}/add-ons) */
+  add_ons?: OrderAddOnRequest[];
+  /**
+   * Registration form answers keyed by form field ID, once per ticket or
+   * once for the whole order
+   */
+  answers?: AnswerSet[];
+  provider?: string;
+  reservation_id: string;
+}
+
+export interface CreateWarehouseExportRequest {
+  /** UTC day (YYYY-MM-DD), yesterday by default */
+  date?: string;
+}
+
+export interface CreateWebhookEndpointRequest {
+  description?: string;
+  /** empty subscribes to every event */
+  events: WebhookEventType[];
+  url: string;
+}
+
+export interface CurrencyResponse {
+  code?: string;
+  exponent?: number;
+  name?: string;
+  providers?: PaymentProvider[];
+  symbol?: string;
+}
+
+export interface DeleteAccountRequest {
+  password: string;
+}
+
+export interface DisputeResponse {
+  amount?: number;
+  created_at?: string;
+  currency?: string;
+  evidence?: EvidenceResponse[];
+  evidence_due_by?: string;
+  id?: string;
+  order_id?: string;
+  payment_id?: string;
+  provider?: PaymentProvider;
+  provider_dispute_id?: string;
+  reason?: string;
+  resolution?: string;
+  resolved_at?: string;
+  resolved_by?: string;
+  status?: DisputeStatus;
+}
+
+export interface DuplicateEventRequest {
+  /** defaults to the original event's duration */
+  end_time?: string;
+  start_time: string;
+  title?: string;
+}
+
+export interface EventCancellationResponse {
+  event?: EventStatusResponse;
+  holders_notified?: number;
+  /** pending orders that can no longer be paid */
+  orders_cancelled?: number;
+  refunds_queued?: number;
+  tickets_cancelled?: number;
+}
+
+export interface EventFeaturedResponse {
+  id?: string;
+  is_featured?: boolean;
+  title?: string;
+}
+
+export interface EventReportResponse {
+  created_at?: string;
+  details?: string;
+  event_id?: string;
+  id?: string;
+  reason?: ReportReason;
+  resolved_at?: string;
+  status?: ReportStatus;
+}
+
+export interface EventRescheduleResponse {
+  created_at?: string;
+  end_time?: string;
+  event_id?: string;
+  id?: string;
+  previous_end_time?: string;
+  previous_start_time?: string;
+  reason?: string;
+  refund_deadline?: string;
+  start_time?: string;
+}
+
+export interface EventResponse {
+  allow_reentry?: boolean;
+  banner_url?: string;
+  category?: string;
+  created_at?: string;
+  currency?: string;
+  description?: string;
+  end_time?: string;
+  id?: string;
+  is_featured?: boolean;
+  location?: string;
+  max_attendees?: number;
+  max_occupancy?: number;
+  organizer_id?: string;
+  refund_policy?: RefundPolicyResponse;
+  sessions?: EventSessionResponse[];
+  slug?: string;
+  start_time?: string;
+  status?: EventStatus;
+  ticket_tiers?: TicketTierResponse[];
+  tickets_sold?: number;
+  title?: string;
+  waiting_room?: boolean;
+  zones?: EventZoneResponse[];
+}
+
+export interface EventSessionResponse {
+  capacity?: number;
+  end_time?: string;
+  id?: string;
+  start_time?: string;
+  title?: string;
+}
+
+export interface EventStatusChangeResponse {
+  created_at?: string;
+  from_status?: EventStatus;
+  reason?: string;
+  to_status?: EventStatus;
+}
+
+export interface EventStatusResponse {
+  id?: string;
+  status?: EventStatus;
+  title?: string;
+}
+
+export interface EventZoneRequest {
+  /** 0 means no limit */
+  max_occupancy?: number;
+  /** e.g. "VIP lounge" */
+  name: string;
+}
+
+export interface EventZoneResponse {
+  id?: string;
+  max_occupancy?: number;
+  name?: string;
+}
+
+export interface EvidenceResponse {
+  content_type?: string;
+  created_at?: string;
+  file_name?: string;
+  id?: string;
+  note?: string;
+  size?: number;
+  uploaded_by?: string;
+  /** expires after 15 minutes */
+  url?: string;
+}
+
+export interface FailPayoutRequest {
+  reason: string;
+}
+
+export interface FavoriteResponse {
+  event?: EventResponse;
+  saved_at?: string;
+}
+
+export interface ForgotPasswordRequest {
+  email: string;
+}
+
+export interface FormFieldResponse {
+  id?: string;
+  label?: string;
+  options?: string[];
+  position?: number;
+  required?: boolean;
+  type?: string;
+}
+
+export interface GoogleWalletPassResponse {
+  save_url?: string;
+}
+
+export interface GuestCheckoutRequest {
+  email: string;
+  first_name: string;
+  last_name: string;
+  phone?: string;
+}
+
+export interface InitializePaymentRequest {
+  /** ISO 3166-1 alpha-2 billing country, checked against the card and IP */
+  billing_country?: string;
+  callback_url?: string;
+  order_id: string;
+}
+
+export interface InitiatePayoutRequest {
+  /** minor units; omit to pay out the full balance */
+  amount?: number;
+  currency: string;
+}
+
+export interface IntegrationAccountResponse {
+  key_name?: string;
+  organization_name?: string;
+  organizer_id?: string;
+}
+
+export interface IntegrationAttendeeResponse {
+  checked_in_at?: string;
+  created_at?: string;
+  /** pass as since to fetch newer records */
+  cursor?: string;
+  email?: string;
+  event_id?: string;
+  event_title?: string;
+  first_name?: string;
+  /** ticket ID */
+  id?: string;
+  last_name?: string;
+  order_id?: string;
+  status?: TicketStatus;
+  tier_id?: string;
+  tier_name?: string;
+}
+
+export interface IntegrationCompResponse {
+  email?: string;
+  event_id?: string;
+  id?: string;
+  quantity?: number;
+  tier_id?: string;
+}
+
+export interface IntegrationCompTicketsRequest {
+  email: string;
+  event_id: string;
+  first_name?: string;
+  last_name?: string;
+  /** defaults to 1 */
+  quantity?: number;
+  tier_id: string;
+}
+
+export interface IntegrationEventResponse {
+  end_time?: string;
+  id?: string;
+  slug?: string;
+  start_time?: string;
+  status?: EventStatus;
+  title?: string;
+}
+
+export interface IntegrationKeyResponse {
+  created_at?: string;
+  created_by?: string;
+  id?: string;
+  /** only returned when created */
+  key?: string;
+  last_used_at?: string;
+  name?: string;
+  prefix?: string;
+  revoked_at?: string;
+}
+
+export interface IntegrationOrderResponse {
+  /** when the tickets were issued */
+  created_at?: string;
+  currency?: string;
+  /** pass as since to fetch newer records */
+  cursor?: string;
+  email?: string;
+  event_id?: string;
+  event_title?: string;
+  first_name?: string;
+  id?: string;
+  is_comp?: boolean;
+  last_name?: string;
+  quantity?: number;
+  status?: OrderStatus;
+  tier_id?: string;
+  tier_name?: string;
+  /** decimal, e.g. "50.00" */
+  total?: string;
+}
+
+export interface IntegrationTierResponse {
+  available?: number;
+  currency?: string;
+  event_id?: string;
+  id?: string;
+  name?: string;
+  /** decimal, e.g. "25.00" */
+  price?: string;
+}
+
+export interface InviteTeamMemberRequest {
+  email: string;
+  /** custom role only */
+  permissions: Permission[];
+  role: StaffRole;
+}
+
+export interface IssueCompTicketsRequest {
+  recipients: CompRecipientRequest[];
+  tier_id: string;
+}
+
+export interface IssueScannerTokenRequest {
+  /** defaults to 12 hours */
+  expires_in_minutes?: number;
+  label?: string;
+}
+
+export interface JobResponse {
+  attempts?: number;
+  created_at?: string;
+  error?: string;
+  finished_at?: string;
+  id?: string;
+  locked_until?: string;
+  max_attempts?: number;
+  payload?: unknown;
+  request_id?: string;
+  run_at?: string;
+  schedule?: string;
+  status?: JobStatus;
+  type?: string;
+  updated_at?: string;
+}
+
+export interface LoginRequest {
+  email: string;
+  password: string;
+}
+
+export interface LogoutRequest {
+  /** bearer mode; cookie mode sends the refresh cookie */
+  refresh_token?: string;
+}
+
+export interface ManualCheckinRequest {
+  /** shown when the ticket is scanned again */
+  device?: string;
+  event_id: string;
+  /** check in to one session of a multi-session event */
+  session_id?: string;
+  ticket_id: string;
+  /** checking in at the entrance of a zone */
+  zone_id?: string;
+}
+
+export interface ModerateEventRequest {
+  /** shown to the organizer */
+  reason: string;
+}
+
+export interface ModerationEventResponse {
+  id?: string;
+  open_reports?: number;
+  organization_name?: string;
+  organizer_id?: string;
+  slug?: string;
+  start_time?: string;
+  status?: EventStatus;
+  title?: string;
+  updated_at?: string;
+}
+
+export interface NotificationPreferencesRequest {
+  email_enabled?: boolean;
+  event_reminders?: boolean;
+  marketing?: boolean;
+  order_updates?: boolean;
+  push_enabled?: boolean;
+  sms_enabled?: boolean;
+}
+
+export interface NotificationPreferencesResponse {
+  email_enabled?: boolean;
+  event_reminders?: boolean;
+  marketing?: boolean;
+  order_updates?: boolean;
+  push_enabled?: boolean;
+  sms_enabled?: boolean;
+}
+
+export interface NotificationResponse {
+  channel?: NotificationChannel;
+  created_at?: string;
+  id?: string;
+  is_read?: boolean;
+  message?: string;
+  sent_at?: string;
+  subject?: string;
+}
+
+export interface OrderAddOnRequest {
+  add_on_id: string;
+  quantity: number;
+}
+
+export interface OrderDetailResponse {
+  created_at?: string;
+  currency?: string;
+  /** payment deadline of a pending order */
+  expires_at?: string;
+  id?: string;
+  is_comp?: boolean;
+  items?: OrderItemResponse[];
+  payments?: PaymentResponse[];
+  refunds?: RefundResponse[];
+  status?: OrderStatus;
+  /** included in the total */
+  tax_amount?: number;
+  tax_basis_points?: number;
+  tax_inclusive?: boolean;
+  tax_name?: string;
+  tickets?: TicketResponse[];
+  total_amount?: number;
+}
+
+export interface OrderItemResponse {
+  amount?: number;
+  name?: string;
+  quantity?: number;
+  tax_amount?: number;
+  type?: OrderItemType;
+  unit_price?: number;
+}
+
+export interface OrderResponse {
+  created_at?: string;
+  currency?: string;
+  id?: string;
+  status?: OrderStatus;
+  /** included in the total */
+  tax_amount?: number;
+  ticket_count?: number;
+  total_amount?: number;
+}
+
+export interface OrganizerFeesResponse {
+  fee_basis_points?: number;
+  fee_fixed_per_ticket?: number;
+  organizer_id?: string;
+}
+
+export interface OrganizerResponse {
+  applied_at?: string;
+  description?: string;
+  document_url?: string;
+  email?: string;
+  id?: string;
+  organization_name?: string;
+  rejection_reason?: string;
+  user_id?: string;
+  verification_status?: VerificationStatus;
+  verified_at?: string;
+  website?: string;
+}
+
+export interface PaymentResponse {
+  amount?: number;
+  created_at?: string;
+  currency?: string;
+  id?: string;
+  order_id?: string;
+  paid_at?: string;
+  provider?: string;
+  reference?: string;
+  status?: string;
+}
+
+export interface PayoutResponse {
+  amount?: number;
+  completed_at?: string;
+  created_at?: string;
+  currency?: string;
+  id?: string;
+  organizer_id?: string;
+  reason?: string;
+  reference?: string;
+  status?: PayoutStatus;
+}
+
+export interface PermissionsResponse {
+  permissions?: PermissionInfo[];
+  roles?: Record<string, Permission[]>;
+}
+
+export interface PricePhaseRequest {
+  end_time?: string;
+  name: string;
+  /** minor units */
+  price?: number;
+  /** phase ends once the tier has sold this many */
+  quantity_limit?: number;
+  start_time?: string;
+}
+
+export interface PricePhaseResponse {
+  end_time?: string;
+  id?: string;
+  name?: string;
+  price?: number;
+  quantity_limit?: number;
+  start_time?: string;
+}
+
+export interface ReconciliationReportResponse {
+  created_at?: string;
+  date?: string;
+  id?: string;
+  issues?: ReconciliationIssue[];
+  matched?: number;
+  provider?: PaymentProvider;
+  transactions?: number;
+}
+
+export interface RefreshTokenRequest {
+  refresh_token: string;
+}
+
+export interface RefundPolicyResponse {
+  days_before?: number;
+  fee_basis_points?: number;
+  policy?: RefundPolicy;
+  /** When attendee refunds close; omitted when the event offers none */
+  refundable_until?: string;
+}
+
+export interface RefundRequest {
+  reason?: string;
+}
+
+export interface RefundResponse {
+  amount?: number;
+  created_at?: string;
+  currency?: string;
+  /** kept under the event's refund policy */
+  fee?: number;
+  id?: string;
+  order_id?: string;
+  reason?: string;
+  status?: RefundStatus;
+}
+
+export interface RegisterRequest {
+  email: string;
+  first_name: string;
+  last_name: string;
+  password: string;
+  phone?: string;
+}
+
+export interface RejectEventRequest {
+  reason: string;
+}
+
+export interface RejectOrderRequest {
+  reason: string;
+}
+
+export interface RejectOrganizerRequest {
+  reason: string;
+}
+
+export interface ReportEventRequest {
+  details?: string;
+  reason: string;
+}
+
+export interface RescheduleEventRequest {
+  end_time: string;
+  /** shown to ticket holders */
+  reason?: string;
+  /** defaults to RESCHEDULE_REFUND_WINDOW */
+  refund_window_days?: number;
+  start_time: string;
+}
+
+export interface ReserveTicketRequest {
+  quantity: number;
+  /** required when the event has a waiting room */
+  queue_token?: string;
+  tier_id: string;
+}
+
+export interface ResetPasswordRequest {
+  password: string;
+  token: string;
+}
+
+export interface ResolveDisputeRequest {
+  outcome: DisputeStatus;
+  resolution?: string;
+}
+
+export interface RetryPaymentRequest {
+  billing_country?: string;
+  callback_url?: string;
+}
+
+export interface ReviewOrderResponse {
+  billing_country?: string;
+  client_ip?: string;
+  created_at?: string;
+  currency?: string;
+  email?: string;
+  id?: string;
+  ip_country?: string;
+  quantity?: number;
+  risk_reasons?: string[];
+  risk_score?: number;
+  tier_id?: string;
+  total_amount?: number;
+  user_id?: string;
+}
+
+export interface ScannerTokenResponse {
+  created_at?: string;
+  event_id?: string;
+  expires_at?: string;
+  id?: string;
+  label?: string;
+  /** only returned when issued */
+  token?: string;
+}
+
+export interface SessionResponse {
+  created_at?: string;
+  current?: boolean;
+  expires_at?: string;
+  id?: string;
+  ip_address?: string;
+  last_used_at?: string;
+  user_agent?: string;
+}
+
+export interface SetPricePhasesRequest {
+  /** applied in order; empty sells at the regular price */
+  phases?: PricePhaseRequest[];
+}
+
+export interface SetTierSessionsRequest {
+  /** empty admits the tier to every session */
+  session_ids?: string[];
+}
+
+export interface SetTierZonesRequest {
+  /** empty limits the tier to the main gate */
+  zone_ids?: string[];
+}
+
+export interface TaxResponse {
+  basis_points?: number;
+  inclusive?: boolean;
+  name?: string;
+}
+
+export interface TeamMemberResponse {
+  accepted_at?: string;
+  created_at?: string;
+  email?: string;
+  first_name?: string;
+  id?: string;
+  invite_expires_at?: string;
+  last_name?: string;
+  permissions?: Permission[];
+  role?: StaffRole;
+  status?: MemberStatus;
+  user_id?: string;
+}
+
+export interface TicketAnswerResponse {
+  label?: string;
+  value?: string;
+}
+
+export interface TicketDetailResponse {
+  /** registration form answers */
+  answers?: TicketAnswerResponse[];
+  checked_in_at?: string;
+  created_at?: string;
+  event_end_time?: string;
+  event_id?: string;
+  event_start_time?: string;
+  event_title?: string;
+  id?: string;
+  location?: string;
+  order_id?: string;
+  qr_code?: string;
+  status?: TicketStatus;
+  tier_name?: string;
+  venue?: string;
+}
+
+export interface TicketResponse {
+  created_at?: string;
+  event_id?: string;
+  event_title?: string;
+  id?: string;
+  qr_code?: string;
+  status?: TicketStatus;
+  tier_name?: string;
+}
+
+export interface TicketTierReq {
+  description?: string;
+  name: string;
+  /** minor units, e.g. cents */
+  price?: number;
+  quantity: number;
+}
+
+export interface TicketTierResponse {
+  available?: number;
+  currency?: string;
+  /** Price a reservation made now would hold, and the phase it comes from */
+  current_price?: number;
+  description?: string;
+  id?: string;
+  name?: string;
+  price?: number;
+  price_phase?: string;
+  price_phases?: PricePhaseResponse[];
+  quantity?: number;
+  /** sessions the tier admits to; empty admits to all */
+  session_ids?: string[];
+  sold?: number;
+  /** zones the tier admits to besides the main gate */
+  zone_ids?: string[];
+}
+
+export interface TokenResponse {
+  access_token?: string;
+  /** cookie mode only */
+  csrf_token?: string;
+  expires_in?: number;
+  /** omitted in cookie mode, where it is set in the refresh cookie */
+  refresh_token?: string;
+  token_type?: string;
+}
+
+export interface UndoCheckinRequest {
+  checkin_id: string;
+}
+
+export interface UpdateAddOnRequest {
+  description?: string;
+  name?: string;
+  price?: number;
+  quantity?: number;
+}
+
+export interface UpdateBadgePrinterRequest {
+  auto_print?: boolean;
+  driver: BadgeDriver;
+  rotate_secret?: boolean;
+  url: string;
+}
+
+export interface UpdateCategoryRequest {
+  icon?: string;
+  is_active?: boolean;
+  name?: string;
+}
+
+export interface UpdateEventFeaturedRequest {
+  featured?: boolean;
+}
+
+export interface UpdateEventSessionRequest {
+  capacity?: number;
+  end_time?: string;
+  start_time?: string;
+  title?: string;
+}
+
+export interface UpdateFeatureFlagRequest {
+  description?: string;
+  enabled: boolean;
+}
+
+export interface UpdateFormFieldRequest {
+  label?: string;
+  options?: string[];
+  position?: number;
+  required?: boolean;
+  type?: string;
+}
+
+export interface UpdateOccupancyLimitRequest {
+  /** 0 removes the limit */
+  max_occupancy?: number;
+}
+
+export interface UpdateOrganizerFeesRequest {
+  fee_basis_points?: number;
+  fee_fixed_per_ticket?: number;
+}
+
+export interface UpdateProfileRequest {
+  first_name?: string;
+  last_name?: string;
+  phone?: string;
+}
+
+export interface UpdateReentryRequest {
+  allowed: boolean;
+}
+
+export interface UpdateRefundPolicyRequest {
+  /** deadline policy only */
+  days_before?: number;
+  /** 500 keeps 5% of each refund */
+  fee_basis_points?: number;
+  policy: RefundPolicy;
+}
+
+export interface UpdateRolePermissionsRequest {
+  permissions: Permission[];
+}
+
+export interface UpdateTaxRequest {
+  basis_points?: number;
+  /** prices already include the tax */
+  inclusive?: boolean;
+  /** e.g. "VAT" or "Sales tax" */
+  name?: string;
+}
+
+export interface UpdateTeamMemberRequest {
+  /** custom role only */
+  permissions: Permission[];
+  role: StaffRole;
+}
+
+export interface UpdateTicketTierRequest {
+  description?: string;
+  name?: string;
+  price?: number;
+  quantity?: number;
+}
+
+export interface UpdateWaitingRoomRequest {
+  enabled: boolean;
+}
+
+export interface UpdateWebhookEndpointRequest {
+  active?: boolean;
+  description?: string;
+  /** empty subscribes to every event */
+  events: WebhookEventType[];
+  rotate_secret?: boolean;
+  url: string;
+}
+
+export interface UserResponse {
+  created_at?: string;
+  email?: string;
+  email_verified?: boolean;
+  first_name?: string;
+  id?: string;
+  last_name?: string;
+  phone?: string;
+  role?: string;
+}
+
+export interface ValidateQRRequest {
+  /** shown when the ticket is scanned again */
+  device?: string;
+  event_id: string;
+  qr_code: string;
+  /** check in to one session of a multi-session event */
+  session_id?: string;
+  /** scanning at the entrance of a zone, e.g. the VIP area */
+  zone_id?: string;
+}
+
+export interface VerifyEmailRequest {
+  token: string;
+}
+
+export interface WarehouseExportFileResponse {
+  dataset?: string;
+  key?: string;
+  rows?: number;
+  size?: number;
+  /** expires after 15 minutes */
+  url?: string;
+}
+
+export interface WarehouseExportResponse {
+  completed_at?: string;
+  created_at?: string;
+  date?: string;
+  error?: string;
+  files?: WarehouseExportFileResponse[];
+  id?: string;
+  requested_by?: string;
+  status?: WarehouseExportStatus;
+}
+
+export interface WebhookDeliveryResponse {
+  attempts?: number;
+  created_at?: string;
+  delivered_at?: string;
+  endpoint_id?: string;
+  error?: string;
+  event_type?: WebhookEventType;
+  id?: string;
+  next_attempt_at?: string;
+  payload?: string;
+  /** first 1KB */
+  response_body?: string;
+  response_status?: number;
+  status?: WebhookDeliveryStatus;
+}
+
+export interface WebhookEndpointResponse {
+  active?: boolean;
+  created_at?: string;
+  description?: string;
+  events?: WebhookEventType[];
+  id?: string;
+  /** only returned when generated */
+  secret?: string;
+  updated_at?: string;
+  url?: string;
+}
+
+export interface WidgetEventResponse {
+  /**
+   * Sites the organizer embeds the widget on, for the frame's
+   * Content-Security-Policy frame-ancestors
+   */
+  allowed_origins?: string[];
+  banner_url?: string;
+  currency?: string;
+  description?: string;
+  end_time?: string;
+  /** whether buyers can check out without an account */
+  guest_checkout?: boolean;
+  id?: string;
+  location?: string;
+  organizer_name?: string;
+  slug?: string;
+  start_time?: string;
+  ticket_tiers?: TicketTierResponse[];
+  title?: string;
+  waiting_room?: boolean;
+}
+
+export interface WidgetOriginResponse {
+  created_at?: string;
+  created_by?: string;
+  id?: string;
+  origin?: string;
+}
+
+export type AnswerSet = Record<string, string>;
+
+export type AuditAction = "event.created" | "event.duplicated" | "event.submitted" | "event.approved" | "event.rejected" | "event.banner_updated" | "event.waiting_room_updated" | "event.reentry_updated" | "event.occupancy_limit_updated" | "event.tax_updated" | "event.refunds_updated" | "event.cancelled" | "event.rescheduled" | "event.featured_updated" | "event.unpublished" | "event.banned" | "event.reports_dismissed" | "event.badge_printer_updated" | "event.badge_printer_removed" | "tier.created" | "tier.updated" | "tier.deleted" | "session.created" | "session.updated" | "session.deleted" | "zone.created" | "zone.updated" | "zone.deleted" | "form_field.created" | "form_field.updated" | "form_field.deleted" | "add_on.created" | "add_on.updated" | "add_on.deleted" | "category.created" | "category.updated" | "category.deleted" | "order.comped" | "order.refunded" | "order.approved" | "order.rejected" | "order.saga_resumed" | "order.saga_compensated" | "user.unlocked" | "organizer.approved" | "organizer.rejected" | "organizer.fees_updated" | "organizer.logo_updated" | "organizer.tax_updated" | "payout.initiated" | "payout.completed" | "payout.failed" | "dispute.evidence_added" | "dispute.resolved" | "feature_flag.updated" | "role.permissions_updated" | "team_member.invited" | "team_member.joined" | "team_member.updated" | "team_member.removed" | "scanner_token.issued" | "scanner_token.revoked" | "checkin.undone" | "webhook.created" | "webhook.updated" | "webhook.deleted" | "api_key.created" | "api_key.revoked" | "widget_origin.added" | "widget_origin.removed" | "job.retried" | "job.discarded";
+
+export type AuditTargetType = "event" | "tier" | "session" | "zone" | "form_field" | "add_on" | "category" | "order" | "user" | "organizer" | "payout" | "dispute" | "feature_flag" | "role" | "team_member" | "scanner_token" | "checkin" | "webhook" | "api_key" | "widget_origin" | "job";
+
+export type BadgeDriver = "webhook";
+
+export type CheckinDirection = "in" | "out";
+
+export type CheckinMethod = "qr" | "manual";
+
+export type DisputeStatus = "open" | "won" | "lost";
+
+export type EventStatus = "draft" | "under_review" | "published" | "active" | "completed" | "cancelled" | "unpublished" | "banned";
+
+export interface FeatureFlag {
+  created_at?: string;
+  description?: string;
+  enabled?: boolean;
+  id?: string;
+  key?: string;
+  updated_at?: string;
+  updated_by?: string;
+}
+
+export interface JobSchedule {
+  job_type?: string;
+  last_job_id?: string;
+  last_run_at?: string;
+  name?: string;
+  next_run_at?: string;
+  /** cron expression or @every duration */
+  spec?: string;
+  updated_at?: string;
+}
+
+export type JobStatus = "pending" | "running" | "succeeded" | "dead";
+
+export type MemberStatus = "invited" | "active";
+
+export type NotificationChannel = "email" | "push" | "sms";
+
+export type OrderItemType = "ticket" | "add_on";
+
+export interface OrderSaga {
+  completed?: SagaStep[];
+  created_at?: string;
+  /** from the step that last failed */
+  error?: string;
+  failed_step?: SagaStep;
+  id?: string;
+  log?: OrderSagaEntry[];
+  order_id?: string;
+  /** why it was compensated */
+  reason?: string;
+  status?: SagaStatus;
+  updated_at?: string;
+}
+
+export interface OrderSagaEntry {
+  action?: SagaAction;
+  created_at?: string;
+  /** empty when the attempt succeeded */
+  error?: string;
+  id?: string;
+  step?: SagaStep;
+}
+
+export type OrderStatus = "pending" | "paid" | "failed" | "cancelled" | "refunded" | "review";
+
+export type PaymentProvider = "paystack" | "stripe";
+
+export type PaymentStatus = "pending" | "authorized" | "processing" | "completed" | "failed" | "refunding" | "refunded" | "disputed" | "charged_back";
+
+export type PayoutStatus = "pending" | "completed" | "failed";
+
+export type Permission = "events:create" | "events:manage" | "checkin:scan" | "attendees:view" | "tickets:comp" | "payouts:view" | "organizer:manage";
+
+export interface ReconciliationIssue {
+  /** ours, minor units */
+  amount?: number;
+  created_at?: string;
+  currency?: string;
+  id?: string;
+  payment_id?: string;
+  payment_status?: PaymentStatus;
+  /** minor units */
+  provider_amount?: number;
+  provider_currency?: string;
+  reference?: string;
+  report_id?: string;
+  type?: ReconciliationIssueType;
+}
+
+export type ReconciliationIssueType = "amount_mismatch" | "orphaned" | "missing_webhook";
+
+export type RefundPolicy = "flexible" | "deadline" | "none";
+
+export type RefundStatus = "pending" | "completed" | "failed";
+
+export type ReportReason = "spam" | "fraud" | "misleading" | "offensive" | "other";
+
+export type ReportStatus = "open" | "actioned" | "dismissed";
+
+export interface RolePermissions {
+  created_at?: string;
+  id?: string;
+  permissions?: Permission[];
+  role?: UserRole;
+  updated_at?: string;
+  updated_by?: string;
+}
+
+export type SagaAction = "execute" | "compensate";
+
+export type SagaStatus = "running" | "stuck" | "completed" | "compensating" | "compensated";
+
+export type SagaStep = "reserve" | "order" | "pay" | "fulfill";
+
+export type StaffRole = "scanner" | "finance" | "editor" | "custom";
+
+export type TicketStatus = "reserved" | "active" | "used" | "cancelled" | "refunded" | "frozen";
+
+export type UserRole = "attendee" | "organizer" | "admin" | "guest" | "scanner";
+
+export type VerificationStatus = "pending" | "approved" | "rejected";
+
+export type WarehouseExportStatus = "running" | "completed" | "failed";
+
+export type WebhookDeliveryStatus = "pending" | "succeeded" | "failed";
+
+export type WebhookEventType = "ticket.sold" | "attendee.checked_in" | "order.refunded";
+
+export interface AttendeeMatch {
+  attendee_name?: string;
+  checked_in_at?: string;
+  email?: string;
+  status?: TicketStatus;
+  ticket_id?: string;
+  tier_name?: string;
+}
+
+export interface Badge {
+  attendee_name?: string;
+  checked_in_at?: string;
+  checkin_id?: string;
+  event_id?: string;
+  event_title?: string;
+  qr_code?: string;
+  ticket_id?: string;
+  tier_name?: string;
+}
+
+export interface CheckinFeedEntry {
+  attendee_name?: string;
+  direction?: CheckinDirection;
+  event_id?: string;
+  scanned_at?: string;
+  scanned_by?: string;
+  ticket_id?: string;
+  tier_id?: string;
+  tier_name?: string;
+}
+
+export interface CheckinRateBucket {
+  bucket_start?: string;
+  count?: number;
+}
+
+export interface CheckinStats {
+  bucket_minutes?: number;
+  checked_in?: number;
+  event_id?: string;
+  generated_at?: string;
+  rate?: CheckinRateBucket[];
+  remaining?: number;
+  tiers?: TierCheckinStats[];
+  total_tickets?: number;
+}
+
+export interface DailyRevenue {
+  date?: string;
+  orders?: number;
+  revenue?: number;
+  tickets_sold?: number;
+}
+
+export type DashboardInterval = "day" | "week" | "month";
+
+export interface DashboardPeriod {
+  new_users?: number;
+  orders?: number;
+  period_start?: string;
+  refunded_amount?: Record<string, number>;
+  refunds?: number;
+  revenue?: Record<string, number>;
+  tickets_sold?: number;
+}
+
+export interface DashboardTotals {
+  new_users?: number;
+  orders?: number;
+  /** share of the range's orders since refunded */
+  refund_rate?: number;
+  refunded_amount?: Record<string, number>;
+  refunds?: number;
+  revenue?: Record<string, number>;
+  tickets_sold?: number;
+}
+
+export interface EventAnalytics {
+  comp_tickets?: number;
+  conversion_rate?: number;
+  daily_revenue?: DailyRevenue[];
+  event_id?: string;
+  generated_at?: string;
+  gross_revenue?: number;
+  net_revenue?: number;
+  paid_orders?: number;
+  refund_rate?: number;
+  refunded_amount?: number;
+  refunded_orders?: number;
+  reservations?: number;
+  /** on orders still paid, part of gross revenue */
+  tax_collected?: number;
+  tickets_sold?: number;
+  tiers?: TierSalesStats[];
+}
+
+export interface EventOccupancy {
+  event_id?: string;
+  generated_at?: string;
+  /** 0 means no limit */
+  limit?: number;
+  occupancy?: number;
+  zones?: ZoneOccupancy[];
+}
+
+export interface PaymentInitResult {
+  authorization_url?: string;
+  payment_id?: string;
+  provider?: PaymentProvider;
+  reference?: string;
+}
+
+export interface PermissionInfo {
+  delegable?: boolean;
+  description?: string;
+  permission?: Permission;
+}
+
+export interface PlatformDashboard {
+  from?: string;
+  generated_at?: string;
+  interval?: DashboardInterval;
+  series?: DashboardPeriod[];
+  to?: string;
+  top_events?: TopEvent[];
+  top_organizers?: TopOrganizer[];
+  totals?: DashboardTotals;
+}
+
+export interface ScanHistory {
+  event_id?: string;
+  /** the last event scan was an entry */
+  inside?: boolean;
+  scans?: ScanRecord[];
+  status?: TicketStatus;
+  ticket_id?: string;
+}
+
+export interface ScanRecord {
+  device?: string;
+  direction?: CheckinDirection;
+  id?: string;
+  method?: CheckinMethod;
+  scanned_at?: string;
+  scanned_by?: string;
+  session_id?: string;
+  zone_id?: string;
+}
+
+export interface TierCheckinStats {
+  checked_in?: number;
+  tier_id?: string;
+  tier_name?: string;
+  total?: number;
+}
+
+export interface TierSalesStats {
+  comps?: number;
+  revenue?: number;
+  sold?: number;
+  tier_id?: string;
+  tier_name?: string;
+  total_quantity?: number;
+}
+
+export interface TopEvent {
+  currency?: string;
+  event_id?: string;
+  organizer_id?: string;
+  revenue?: number;
+  tickets_sold?: number;
+  title?: string;
+}
+
+export interface TopOrganizer {
+  events?: number;
+  organization_name?: string;
+  organizer_id?: string;
+  revenue?: Record<string, number>;
+  tickets_sold?: number;
+}
+
+export interface WaitingRoomPosition {
+  admitted_until?: string;
+  /** seconds, while waiting */
+  estimated_wait?: number;
+  event_id?: string;
+  /** 1-based, while waiting */
+  position?: number;
+  status?: WaitingRoomStatus;
+  token?: string;
+}
+
+export type WaitingRoomStatus = "waiting" | "admitted" | "expired";
+
+export interface ZoneOccupancy {
+  /** 0 means no limit */
+  limit?: number;
+  name?: string;
+  occupancy?: number;
+  zone_id?: string;
+}
+
+export interface FieldError {
+  field?: string;
+  message?: string;
+  rule?: string;
+}
+
+export interface DeleteAddOnResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateAddOnResponse {
+  data?: AddOnResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListAuditLogsResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListAuditLogsResponse {
+  data?: AuditLogResponse[];
+  pagination?: ListAuditLogsResponsePagination;
+  success?: boolean;
+}
+
+export interface ListAuditLogsParams {
+  /** Filter by acting user */
+  actorID?: string;
+  /** Filter by action, e.g. event.approved */
+  action?: string;
+  /** Filter by target type */
+  targetType?: string;
+  /** Filter by target */
+  targetID?: string;
+  /** Only entries at or after this RFC 3339 time */
+  from?: string;
+  /** Only entries before this RFC 3339 time */
+  to?: string;
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface AdminListCategoriesResponse {
+  data?: CategoryResponse[];
+  success?: boolean;
+}
+
+export interface CreateCategoryResponse {
+  data?: CategoryResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface DeleteCategoryResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateCategoryResponse {
+  data?: CategoryResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListDisputesResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListDisputesResponse {
+  data?: DisputeResponse[];
+  pagination?: ListDisputesResponsePagination;
+  success?: boolean;
+}
+
+export interface ListDisputesParams {
+  /** Dispute status (open, won, lost) */
+  status?: string;
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface GetDisputeResponse {
+  data?: DisputeResponse;
+  success?: boolean;
+}
+
+export interface UploadDisputeEvidenceResponse {
+  data?: EvidenceResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UploadDisputeEvidenceForm {
+  /** Evidence file */
+  file: Blob;
+  /** What the file shows */
+  note?: string;
+}
+
+export interface ResolveDisputeResponse {
+  data?: DisputeResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListModerationQueueResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListModerationQueueResponse {
+  data?: ModerationEventResponse[];
+  pagination?: ListModerationQueueResponsePagination;
+  success?: boolean;
+}
+
+export interface ListModerationQueueParams {
+  /** Event status; defaults to under_review, or any status when reported=true */
+  status?: string;
+  /** Only events with open reports */
+  reported?: boolean;
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface ApproveEventResponse {
+  data?: EventStatusResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface BanEventResponse {
+  data?: EventStatusResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateEventFeaturedResponse {
+  data?: EventFeaturedResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RejectEventResponse {
+  data?: EventStatusResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListEventReportsResponse {
+  data?: EventReportResponse[];
+  success?: boolean;
+}
+
+export interface DismissEventReportsResponseData {
+  dismissed?: number;
+}
+
+export interface DismissEventReportsResponse {
+  data?: DismissEventReportsResponseData;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UnpublishEventResponse {
+  data?: EventStatusResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListWarehouseExportsResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListWarehouseExportsResponse {
+  data?: WarehouseExportResponse[];
+  pagination?: ListWarehouseExportsResponsePagination;
+  success?: boolean;
+}
+
+export interface ListWarehouseExportsParams {
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface CreateWarehouseExportResponse {
+  data?: WarehouseExportResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetWarehouseExportResponse {
+  data?: WarehouseExportResponse;
+  success?: boolean;
+}
+
+export interface ListFeatureFlagsResponse {
+  data?: FeatureFlag[];
+  success?: boolean;
+}
+
+export interface UpdateFeatureFlagResponse {
+  data?: FeatureFlag;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListJobsResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListJobsResponse {
+  data?: JobResponse[];
+  pagination?: ListJobsResponsePagination;
+  success?: boolean;
+}
+
+export interface ListJobsParams {
+  /** Job status (pending, running, succeeded, dead) */
+  status?: string;
+  /** Job type, such as emails.event_cancelled */
+  type?: string;
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface ListJobSchedulesResponse {
+  data?: JobSchedule[];
+  success?: boolean;
+}
+
+export interface DiscardJobResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetJobResponse {
+  data?: JobResponse;
+  success?: boolean;
+}
+
+export interface RetryJobResponse {
+  data?: JobResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListReviewOrdersResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListReviewOrdersResponse {
+  data?: ReviewOrderResponse[];
+  pagination?: ListReviewOrdersResponsePagination;
+  success?: boolean;
+}
+
+export interface ListReviewOrdersParams {
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface ApproveReviewOrderResponse {
+  data?: OrderResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface AdminRefundOrderResponse {
+  data?: RefundResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RejectReviewOrderResponse {
+  data?: RefundResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetOrderSagaResponse {
+  data?: OrderSaga;
+  success?: boolean;
+}
+
+export interface CompensateOrderSagaResponse {
+  data?: OrderSaga;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ResumeOrderSagaResponse {
+  data?: OrderSaga;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListOrganizersResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListOrganizersResponse {
+  data?: OrganizerResponse[];
+  pagination?: ListOrganizersResponsePagination;
+  success?: boolean;
+}
+
+export interface ListOrganizersParams {
+  /** Verification status (pending, approved, rejected) */
+  status?: string;
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface ApproveOrganizerResponse {
+  data?: OrganizerResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateOrganizerFeesResponse {
+  data?: OrganizerFeesResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface InitiatePayoutResponse {
+  data?: PayoutResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RejectOrganizerResponse {
+  data?: OrganizerResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface CompletePayoutResponse {
+  data?: PayoutResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface FailPayoutResponse {
+  data?: PayoutResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListPermissionsResponse {
+  data?: PermissionsResponse;
+  success?: boolean;
+}
+
+export interface UpdateRolePermissionsResponse {
+  data?: RolePermissions;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetReconciliationReportResponse {
+  data?: ReconciliationReportResponse[];
+  success?: boolean;
+}
+
+export interface GetReconciliationReportParams {
+  /** UTC day (YYYY-MM-DD) */
+  date?: string;
+  /** Payment provider (paystack, stripe) */
+  provider?: string;
+}
+
+export interface GetAdminStatsResponseData {
+  dashboard?: PlatformDashboard;
+}
+
+export interface GetAdminStatsResponse {
+  data?: GetAdminStatsResponseData;
+  success?: boolean;
+}
+
+export interface GetAdminStatsParams {
+  /** First day of the range (YYYY-MM-DD) */
+  from?: string;
+  /** Last day of the range, inclusive (YYYY-MM-DD) */
+  to?: string;
+  /** Bucket size of the time series */
+  interval?: string;
+}
+
+export interface UnlockUserResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface ClaimAccountResponse {
+  data?: TokenResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetCSRFTokenResponseData {
+  csrf_token?: string;
+}
+
+export interface GetCSRFTokenResponse {
+  data?: GetCSRFTokenResponseData;
+  success?: boolean;
+}
+
+export interface ForgotPasswordResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface GuestCheckoutResponse {
+  data?: TokenResponse;
+  success?: boolean;
+}
+
+export interface LoginResponse {
+  data?: TokenResponse;
+  success?: boolean;
+}
+
+export interface LoginParams {
+  /** cookie for a cookie session */
+  xAuthMode?: string;
+}
+
+export interface LogoutResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface LogoutParams {
+  /** CSRF token, in cookie mode */
+  xCSRFToken?: string;
+}
+
+export interface RefreshTokenResponse {
+  data?: TokenResponse;
+  success?: boolean;
+}
+
+export interface RefreshTokenParams {
+  /** CSRF token, in cookie mode */
+  xCSRFToken?: string;
+}
+
+export interface RegisterResponse {
+  data?: UserResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ResetPasswordResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface VerifyEmailResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListCategoriesResponse {
+  data?: CategoryResponse[];
+  success?: boolean;
+}
+
+export interface PrintBadgeResponse {
+  data?: Badge;
+  message?: string;
+  success?: boolean;
+}
+
+export interface CheckoutResponse {
+  data?: unknown;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListBadgesResponse {
+  data?: Badge[];
+  success?: boolean;
+}
+
+export interface ListBadgesParams {
+  /** Only badges checked in after this RFC 3339 time */
+  since?: string;
+  /** Badges to return (1-100) */
+  limit?: number;
+}
+
+export interface GetEventOccupancyResponse {
+  data?: EventOccupancy;
+  success?: boolean;
+}
+
+export interface SearchCheckinAttendeesResponse {
+  data?: AttendeeMatch[];
+  success?: boolean;
+}
+
+export interface SearchCheckinAttendeesParams {
+  /** Name or email, at least 2 characters */
+  q: string;
+}
+
+export interface GetCheckinStatsResponse {
+  data?: CheckinStats;
+  success?: boolean;
+}
+
+export interface GetCheckinStatsParams {
+  /** Rate bucket size in minutes (1-60) */
+  interval?: number;
+}
+
+export interface ManualCheckinResponse {
+  data?: unknown;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetTicketScansResponse {
+  data?: ScanHistory;
+  success?: boolean;
+}
+
+export interface UndoCheckinResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface ValidateQRCodeResponse {
+  data?: unknown;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListCurrenciesResponse {
+  data?: CurrencyResponse[];
+  success?: boolean;
+}
+
+export interface DeleteEventSessionResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateEventSessionResponse {
+  data?: EventSessionResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface DeleteEventZoneResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateEventZoneResponse {
+  data?: EventZoneResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListEventsResponsePagination {
+  has_more?: boolean;
+  limit?: number;
+  next_cursor?: string;
+  page?: number;
+  total?: number;
+}
+
+export interface ListEventsResponse {
+  data?: EventResponse[];
+  pagination?: ListEventsResponsePagination;
+  success?: boolean;
+}
+
+export interface ListEventsParams {
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+  /** Cursor from the previous page */
+  cursor?: string;
+  /** Filter by category */
+  category?: string;
+  /** Filter by status */
+  status?: string;
+}
+
+export interface CreateEventResponse {
+  data?: EventResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetFeaturedEventsResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface GetFeaturedEventsResponse {
+  data?: EventResponse[];
+  pagination?: GetFeaturedEventsResponsePagination;
+  success?: boolean;
+}
+
+export interface GetFeaturedEventsParams {
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface GetRecommendedEventsResponse {
+  data?: EventResponse[];
+  personalized?: boolean;
+  success?: boolean;
+}
+
+export interface GetRecommendedEventsParams {
+  /** Number of events */
+  limit?: number;
+}
+
+export interface GetEventBySlugResponse {
+  data?: EventResponse;
+  success?: boolean;
+}
+
+export interface GetTrendingEventsResponse {
+  data?: EventResponse[];
+  success?: boolean;
+}
+
+export interface GetTrendingEventsParams {
+  /** Number of events */
+  limit?: number;
+}
+
+export interface GetEventResponse {
+  data?: EventResponse;
+  success?: boolean;
+}
+
+export interface ListAddOnsResponse {
+  data?: AddOnResponse[];
+  success?: boolean;
+}
+
+export interface CreateAddOnResponse {
+  data?: AddOnResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RemoveBadgePrinterResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetBadgePrinterResponse {
+  data?: BadgePrinterResponse;
+  success?: boolean;
+}
+
+export interface UpdateBadgePrinterResponse {
+  data?: BadgePrinterResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UploadEventBannerResponseData {
+  banner_url?: string;
+}
+
+export interface UploadEventBannerResponse {
+  data?: UploadEventBannerResponseData;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UploadEventBannerForm {
+  /** Banner image */
+  file: Blob;
+}
+
+export interface CancelEventResponse {
+  data?: EventCancellationResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface DuplicateEventResponse {
+  data?: EventResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UnfavoriteEventResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface FavoriteEventResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListFormFieldsResponse {
+  data?: FormFieldResponse[];
+  success?: boolean;
+}
+
+export interface CreateFormFieldResponse {
+  data?: FormFieldResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateEventOccupancyLimitResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface JoinWaitingRoomResponse {
+  data?: WaitingRoomPosition;
+  success?: boolean;
+}
+
+export interface GetWaitingRoomPositionResponse {
+  data?: WaitingRoomPosition;
+  success?: boolean;
+}
+
+export interface UpdateEventReentryResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateEventRefundPolicyResponse {
+  data?: RefundPolicyResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ReportEventResponse {
+  data?: EventReportResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RescheduleEventResponse {
+  data?: EventRescheduleResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListEventReschedulesResponse {
+  data?: EventRescheduleResponse[];
+  success?: boolean;
+}
+
+export interface ListEventSessionsResponse {
+  data?: EventSessionResponse[];
+  success?: boolean;
+}
+
+export interface CreateEventSessionResponse {
+  data?: EventSessionResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetEventStatusHistoryResponse {
+  data?: EventStatusChangeResponse[];
+  success?: boolean;
+}
+
+export interface SubmitEventResponse {
+  data?: EventStatusResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateEventTaxResponse {
+  data?: TaxResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface CreateTicketTierResponse {
+  data?: TicketTierResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateWaitingRoomResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListEventZonesResponse {
+  data?: EventZoneResponse[];
+  success?: boolean;
+}
+
+export interface CreateEventZoneResponse {
+  data?: EventZoneResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface DeleteFormFieldResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateFormFieldResponse {
+  data?: FormFieldResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetGraphqlResponseErrorsExtensions {
+  code?: string;
+}
+
+export interface GetGraphqlResponseErrors {
+  extensions?: GetGraphqlResponseErrorsExtensions;
+  message?: string;
+  path?: string[];
+}
+
+export interface GetGraphqlResponse {
+  data?: unknown;
+  errors?: GetGraphqlResponseErrors[];
+}
+
+export interface GetGraphqlParams {
+  /** Query, for GET requests */
+  query?: string;
+}
+
+export interface PostGraphqlResponseErrorsExtensions {
+  code?: string;
+}
+
+export interface PostGraphqlResponseErrors {
+  extensions?: PostGraphqlResponseErrorsExtensions;
+  message?: string;
+  path?: string[];
+}
+
+export interface PostGraphqlResponse {
+  data?: unknown;
+  errors?: PostGraphqlResponseErrors[];
+}
+
+export interface PostGraphqlParams {
+  /** Query, for GET requests */
+  query?: string;
+}
+
+export interface FindAttendeeSearchParams {
+  /** Attendee email */
+  email: string;
+  /** Only this event */
+  eventID?: string;
+  /** Maximum records */
+  limit?: number;
+}
+
+export interface NewAttendeesTriggerParams {
+  /** Only this event */
+  eventID?: string;
+  /** Cursor of the newest record already seen */
+  since?: string;
+  /** Maximum records */
+  limit?: number;
+}
+
+export interface NewOrdersTriggerParams {
+  /** Only this event */
+  eventID?: string;
+  /** Cursor of the newest record already seen */
+  since?: string;
+  /** Maximum records */
+  limit?: number;
+}
+
+export interface MarkNotificationReadResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface CreateOrderResponse {
+  data?: OrderResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface CreateOrderParams {
+  /** Unique key that makes retries of this request safe */
+  idempotencyKey?: string;
+}
+
+export interface GetMyOrdersResponsePagination {
+  has_more?: boolean;
+  limit?: number;
+  next_cursor?: string;
+}
+
+export interface GetMyOrdersResponse {
+  data?: OrderResponse[];
+  pagination?: GetMyOrdersResponsePagination;
+  success?: boolean;
+}
+
+export interface GetMyOrdersParams {
+  /** Cursor from the previous page */
+  cursor?: string;
+  /** Items per page when paginating */
+  limit?: number;
+}
+
+export interface GetOrderResponse {
+  data?: OrderDetailResponse;
+  success?: boolean;
+}
+
+export interface RequestRefundResponse {
+  data?: RefundResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RetryPaymentResponse {
+  data?: PaymentInitResult;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RetryPaymentParams {
+  /** Unique key that makes retries of this request safe */
+  idempotencyKey?: string;
+}
+
+export interface ListIntegrationKeysResponse {
+  data?: IntegrationKeyResponse[];
+  success?: boolean;
+}
+
+export interface CreateIntegrationKeyResponse {
+  data?: IntegrationKeyResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RevokeIntegrationKeyResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface ApplyOrganizerResponse {
+  data?: OrganizerResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ApplyOrganizerForm {
+  /** Organization name */
+  organizationName: string;
+  /** Organization description */
+  description?: string;
+  /** Organization website */
+  website?: string;
+  /** Verification document */
+  document: Blob;
+}
+
+export interface GetEventAnalyticsResponse {
+  data?: EventAnalytics;
+  success?: boolean;
+}
+
+export interface ExportAttendeesParams {
+  /** Export format */
+  format?: string;
+}
+
+export interface IssueCompTicketsResponse {
+  data?: CompOrderResponse[];
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListScannerTokensResponse {
+  data?: ScannerTokenResponse[];
+  success?: boolean;
+}
+
+export interface IssueScannerTokenResponse {
+  data?: ScannerTokenResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UploadOrganizerLogoResponseData {
+  logo_url?: string;
+}
+
+export interface UploadOrganizerLogoResponse {
+  data?: UploadOrganizerLogoResponseData;
+  message?: string;
+  success?: boolean;
+}
+
+export interface UploadOrganizerLogoForm {
+  /** Logo image */
+  file: Blob;
+}
+
+export interface GetMyPayoutsResponseData {
+  balances?: BalanceResponse[];
+  payouts?: PayoutResponse[];
+}
+
+export interface GetMyPayoutsResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface GetMyPayoutsResponse {
+  data?: GetMyPayoutsResponseData;
+  pagination?: GetMyPayoutsResponsePagination;
+  success?: boolean;
+}
+
+export interface GetMyPayoutsParams {
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface RevokeScannerTokenResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateOrganizerTaxResponse {
+  data?: TaxResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListTeamMembersResponse {
+  data?: TeamMemberResponse[];
+  success?: boolean;
+}
+
+export interface InviteTeamMemberResponse {
+  data?: TeamMemberResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RemoveTeamMemberResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateTeamMemberResponse {
+  data?: TeamMemberResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RedeliverWebhookResponse {
+  data?: WebhookDeliveryResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListWebhookEndpointsResponse {
+  data?: WebhookEndpointResponse[];
+  success?: boolean;
+}
+
+export interface CreateWebhookEndpointResponse {
+  data?: WebhookEndpointResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface DeleteWebhookEndpointResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateWebhookEndpointResponse {
+  data?: WebhookEndpointResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ListWebhookDeliveriesResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface ListWebhookDeliveriesResponse {
+  data?: WebhookDeliveryResponse[];
+  pagination?: ListWebhookDeliveriesResponsePagination;
+  success?: boolean;
+}
+
+export interface ListWebhookDeliveriesParams {
+  /** Filter by status */
+  status?: string;
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface ListWidgetOriginsResponse {
+  data?: WidgetOriginResponse[];
+  success?: boolean;
+}
+
+export interface AddWidgetOriginResponse {
+  data?: WidgetOriginResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface RemoveWidgetOriginResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface InitializePaymentResponse {
+  data?: PaymentInitResult;
+  message?: string;
+  success?: boolean;
+}
+
+export interface InitializePaymentParams {
+  /** Unique key that makes retries of this request safe */
+  idempotencyKey?: string;
+}
+
+export interface VerifyPaymentResponse {
+  data?: PaymentResponse;
+  success?: boolean;
+}
+
+export interface AcceptTeamInvitationResponse {
+  data?: TeamMemberResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetMyTicketsResponsePagination {
+  has_more?: boolean;
+  limit?: number;
+  next_cursor?: string;
+}
+
+export interface GetMyTicketsResponse {
+  data?: TicketResponse[];
+  pagination?: GetMyTicketsResponsePagination;
+  success?: boolean;
+}
+
+export interface GetMyTicketsParams {
+  /** Cursor from the previous page */
+  cursor?: string;
+  /** Items per page when paginating */
+  limit?: number;
+}
+
+export interface ReserveTicketResponseData {
+  event_id?: string;
+  expires_at?: string;
+  price_phase?: string;
+  quantity?: number;
+  reservation_id?: string;
+  tier_id?: string;
+  total_price?: number;
+  unit_price?: number;
+}
+
+export interface ReserveTicketResponse {
+  data?: ReserveTicketResponseData;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetTicketResponse {
+  data?: TicketDetailResponse;
+  success?: boolean;
+}
+
+export interface GetTicketWalletPassParams {
+  /** Wallet platform (apple, google) */
+  platform?: string;
+}
+
+export interface DeleteTicketTierResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface UpdateTicketTierResponse {
+  data?: TicketTierResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface SetPricePhasesResponse {
+  data?: TicketTierResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface SetTierSessionsResponse {
+  data?: TicketTierResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface SetTierZonesResponse {
+  data?: TicketTierResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface DeleteAccountResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetCurrentUserResponse {
+  data?: UserResponse;
+  success?: boolean;
+}
+
+export interface UpdateProfileResponse {
+  data?: UserResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ChangePasswordResponse {
+  data?: TokenResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface ExportAccountResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface ExportAccountParams {
+  /** Export format */
+  format?: string;
+}
+
+export interface GetMyFavoritesResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface GetMyFavoritesResponse {
+  data?: FavoriteResponse[];
+  pagination?: GetMyFavoritesResponsePagination;
+  success?: boolean;
+}
+
+export interface GetMyFavoritesParams {
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface GetNotificationPreferencesResponse {
+  data?: NotificationPreferencesResponse;
+  success?: boolean;
+}
+
+export interface UpdateNotificationPreferencesResponse {
+  data?: NotificationPreferencesResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetMyNotificationsResponsePagination {
+  limit?: number;
+  page?: number;
+  total?: number;
+}
+
+export interface GetMyNotificationsResponse {
+  data?: NotificationResponse[];
+  pagination?: GetMyNotificationsResponsePagination;
+  success?: boolean;
+}
+
+export interface GetMyNotificationsParams {
+  /** Only return unread notifications */
+  unread?: boolean;
+  /** Page number */
+  page?: number;
+  /** Items per page */
+  limit?: number;
+}
+
+export interface GetMySessionsResponse {
+  data?: SessionResponse[];
+  success?: boolean;
+}
+
+export interface RevokeMySessionResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface PaymentWebhookResponse {
+  message?: string;
+  success?: boolean;
+}
+
+export interface GetWidgetEventResponse {
+  data?: WidgetEventResponse;
+  success?: boolean;
+}
+
+export interface WidgetGuestCheckoutResponse {
+  data?: TokenResponse;
+  success?: boolean;
+}
+
+export interface WidgetCreateOrderResponse {
+  data?: OrderResponse;
+  message?: string;
+  success?: boolean;
+}
+
+export interface WidgetCreateOrderParams {
+  /** Unique key that makes retries of this request safe */
+  idempotencyKey?: string;
+}
+
+export interface WidgetInitializePaymentResponse {
+  data?: PaymentInitResult;
+  message?: string;
+  success?: boolean;
+}
+
+export interface WidgetInitializePaymentParams {
+  /** Unique key that makes retries of this request safe */
+  idempotencyKey?: string;
+}
+
+export interface WidgetReserveTicketResponseData {
+  event_id?: string;
+  expires_at?: string;
+  price_phase?: string;
+  quantity?: number;
+  reservation_id?: string;
+  tier_id?: string;
+  total_price?: number;
+  unit_price?: number;
+}
+
+export interface WidgetReserveTicketResponse {
+  data?: WidgetReserveTicketResponseData;
+  message?: string;
+  success?: boolean;
+}
+
+/** Client for the Eventix API */
+export class EventixClient extends BaseClient {
+  /**
+   * Delete an add-on.
+   *
+   * DELETE /add-ons/{id}
+   *
+   * Take an add-on off sale. Orders that already include it are unaffected (Organizer/Admin only).
+   */
+  deleteAddOn(id: string): Promise<DeleteAddOnResponse> {
+    return this.request<DeleteAddOnResponse>({
+      method: "DELETE",
+      path: `/add-ons/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update an add-on.
+   *
+   * PUT /add-ons/{id}
+   *
+   * Update an add-on's name, description, price or quantity. Orders keep the price they were placed at, and the quantity cannot drop below the number sold (Organizer/Admin only).
+   */
+  updateAddOn(id: string, body: UpdateAddOnRequest): Promise<UpdateAddOnResponse> {
+    return this.request<UpdateAddOnResponse>({
+      method: "PUT",
+      path: `/add-ons/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List audit logs.
+   *
+   * GET /admin/audit-logs
+   *
+   * List recorded admin and organizer mutations, newest first, with before/after snapshots of their targets (Admin only).
+   */
+  listAuditLogs(params: ListAuditLogsParams = {}): Promise<ListAuditLogsResponse> {
+    return this.request<ListAuditLogsResponse>({
+      method: "GET",
+      path: `/admin/audit-logs`,
+      query: { actor_id: params.actorID, action: params.action, target_type: params.targetType, target_id: params.targetID, from: params.from, to: params.to, page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * List all event categories.
+   *
+   * GET /admin/categories
+   *
+   * List every category, including inactive ones (Admin only).
+   */
+  adminListCategories(): Promise<AdminListCategoriesResponse> {
+    return this.request<AdminListCategoriesResponse>({
+      method: "GET",
+      path: `/admin/categories`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Create an event category.
+   *
+   * POST /admin/categories
+   *
+   * Add a category events can be filed under (Admin only).
+   */
+  createCategory(body: CreateCategoryRequest): Promise<CreateCategoryResponse> {
+    return this.request<CreateCategoryResponse>({
+      method: "POST",
+      path: `/admin/categories`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Delete an event category.
+   *
+   * DELETE /admin/categories/{id}
+   *
+   * Delete a category no event has been filed under. Deactivate categories that are in use instead (Admin only).
+   */
+  deleteCategory(id: string): Promise<DeleteCategoryResponse> {
+    return this.request<DeleteCategoryResponse>({
+      method: "DELETE",
+      path: `/admin/categories/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update an event category.
+   *
+   * PUT /admin/categories/{id}
+   *
+   * Rename a category, change its icon or deactivate it. Inactive categories are hidden and cannot be chosen for new events; existing events keep them (Admin only).
+   */
+  updateCategory(id: string, body: UpdateCategoryRequest): Promise<UpdateCategoryResponse> {
+    return this.request<UpdateCategoryResponse>({
+      method: "PUT",
+      path: `/admin/categories/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List payment disputes.
+   *
+   * GET /admin/disputes
+   *
+   * List chargebacks raised against payments, newest first, by default those still open (Admin only).
+   */
+  listDisputes(params: ListDisputesParams = {}): Promise<ListDisputesResponse> {
+    return this.request<ListDisputesResponse>({
+      method: "GET",
+      path: `/admin/disputes`,
+      query: { status: params.status, page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get a payment dispute.
+   *
+   * GET /admin/disputes/{id}
+   *
+   * Get a dispute with its evidence files and short-lived download links (Admin only).
+   */
+  getDispute(id: string): Promise<GetDisputeResponse> {
+    return this.request<GetDisputeResponse>({
+      method: "GET",
+      path: `/admin/disputes/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Upload dispute evidence.
+   *
+   * POST /admin/disputes/{id}/evidence
+   *
+   * Attach a receipt, check-in record or correspondence (PDF, JPEG or PNG, max 10MB) to an open dispute. Files are stored privately (Admin only).
+   */
+  uploadDisputeEvidence(id: string, form: UploadDisputeEvidenceForm): Promise<UploadDisputeEvidenceResponse> {
+    return this.request<UploadDisputeEvidenceResponse>({
+      method: "POST",
+      path: `/admin/disputes/${encodeURIComponent(id)}/evidence`,
+      form: { file: form.file, note: form.note },
+      as: "json",
+    });
+  }
+
+  /**
+   * Resolve a payment dispute.
+   *
+   * POST /admin/disputes/{id}/resolve
+   *
+   * Record the outcome of an open dispute. Won disputes unfreeze the order's tickets; lost ones refund the order, debit the organizer and put the tickets back on sale (Admin only).
+   */
+  resolveDispute(id: string, body: ResolveDisputeRequest): Promise<ResolveDisputeResponse> {
+    return this.request<ResolveDisputeResponse>({
+      method: "POST",
+      path: `/admin/disputes/${encodeURIComponent(id)}/resolve`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Event moderation queue.
+   *
+   * GET /admin/events
+   *
+   * List events by status, by default those awaiting review, oldest first. With reported=true, list events with open user reports, most reported first (Admin only).
+   */
+  listModerationQueue(params: ListModerationQueueParams = {}): Promise<ListModerationQueueResponse> {
+    return this.request<ListModerationQueueResponse>({
+      method: "GET",
+      path: `/admin/events`,
+      query: { status: params.status, reported: params.reported, page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Approve an event.
+   *
+   * POST /admin/events/{id}/approve
+   *
+   * Publish an event that is under review (Admin only).
+   */
+  approveEvent(id: string): Promise<ApproveEventResponse> {
+    return this.request<ApproveEventResponse>({
+      method: "POST",
+      path: `/admin/events/${encodeURIComponent(id)}/approve`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Ban an event.
+   *
+   * POST /admin/events/{id}/ban
+   *
+   * Remove an event for good and email the organizer the reason. Banned events cannot be published or sold again. Open reports on the event are closed (Admin only).
+   */
+  banEvent(id: string, body: ModerateEventRequest): Promise<BanEventResponse> {
+    return this.request<BanEventResponse>({
+      method: "POST",
+      path: `/admin/events/${encodeURIComponent(id)}/ban`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Feature an event.
+   *
+   * PUT /admin/events/{id}/featured
+   *
+   * Feature a published event on GET /events/featured or stop featuring it (Admin only).
+   */
+  updateEventFeatured(id: string, body: UpdateEventFeaturedRequest): Promise<UpdateEventFeaturedResponse> {
+    return this.request<UpdateEventFeaturedResponse>({
+      method: "PUT",
+      path: `/admin/events/${encodeURIComponent(id)}/featured`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Reject an event.
+   *
+   * POST /admin/events/{id}/reject
+   *
+   * Return an event under review to draft with a reason (Admin only).
+   */
+  rejectEvent(id: string, body: RejectEventRequest): Promise<RejectEventResponse> {
+    return this.request<RejectEventResponse>({
+      method: "POST",
+      path: `/admin/events/${encodeURIComponent(id)}/reject`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List an event's reports.
+   *
+   * GET /admin/events/{id}/reports
+   *
+   * List every user report on an event, newest first (Admin only).
+   */
+  listEventReports(id: string): Promise<ListEventReportsResponse> {
+    return this.request<ListEventReportsResponse>({
+      method: "GET",
+      path: `/admin/events/${encodeURIComponent(id)}/reports`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Dismiss an event's reports.
+   *
+   * POST /admin/events/{id}/reports/dismiss
+   *
+   * Close an event's open reports without taking the event down (Admin only).
+   */
+  dismissEventReports(id: string): Promise<DismissEventReportsResponse> {
+    return this.request<DismissEventReportsResponse>({
+      method: "POST",
+      path: `/admin/events/${encodeURIComponent(id)}/reports/dismiss`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Unpublish an event.
+   *
+   * POST /admin/events/{id}/unpublish
+   *
+   * Take a published event off sale and email the organizer the reason. The organizer can edit the event and submit it for review again. Open reports on the event are closed (Admin only).
+   */
+  unpublishEvent(id: string, body: ModerateEventRequest): Promise<UnpublishEventResponse> {
+    return this.request<UnpublishEventResponse>({
+      method: "POST",
+      path: `/admin/events/${encodeURIComponent(id)}/unpublish`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List data warehouse exports.
+   *
+   * GET /admin/exports
+   *
+   * List nightly and on-demand warehouse exports with their files, newest first (Admin only).
+   */
+  listWarehouseExports(params: ListWarehouseExportsParams = {}): Promise<ListWarehouseExportsResponse> {
+    return this.request<ListWarehouseExportsResponse>({
+      method: "GET",
+      path: `/admin/exports`,
+      query: { page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Export a day to the data warehouse.
+   *
+   * POST /admin/exports
+   *
+   * Write the orders, tickets, payments and check-ins created or changed on a UTC day to S3 as gzipped CSV, one date-partitioned file per dataset, replacing any earlier export of that day. The nightly job does the same for yesterday (Admin only).
+   */
+  createWarehouseExport(body?: CreateWarehouseExportRequest): Promise<CreateWarehouseExportResponse> {
+    return this.request<CreateWarehouseExportResponse>({
+      method: "POST",
+      path: `/admin/exports`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get a data warehouse export.
+   *
+   * GET /admin/exports/{id}
+   *
+   * Get an export with short-lived download links to its files (Admin only).
+   */
+  getWarehouseExport(id: string): Promise<GetWarehouseExportResponse> {
+    return this.request<GetWarehouseExportResponse>({
+      method: "GET",
+      path: `/admin/exports/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * List feature flags.
+   *
+   * GET /admin/feature-flags
+   *
+   * List every feature flag with its state, including known flags still at their default (Admin only).
+   */
+  listFeatureFlags(): Promise<ListFeatureFlagsResponse> {
+    return this.request<ListFeatureFlagsResponse>({
+      method: "GET",
+      path: `/admin/feature-flags`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update a feature flag.
+   *
+   * PUT /admin/feature-flags/{key}
+   *
+   * Turn a feature flag on or off, creating it if needed. Every replica sees the change within a minute at most (Admin only).
+   */
+  updateFeatureFlag(key: string, body: UpdateFeatureFlagRequest): Promise<UpdateFeatureFlagResponse> {
+    return this.request<UpdateFeatureFlagResponse>({
+      method: "PUT",
+      path: `/admin/feature-flags/${encodeURIComponent(key)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List background jobs.
+   *
+   * GET /admin/jobs
+   *
+   * List jobs in the background queue, most recently updated first, by default the dead letters that failed every attempt (Admin only).
+   */
+  listJobs(params: ListJobsParams = {}): Promise<ListJobsResponse> {
+    return this.request<ListJobsResponse>({
+      method: "GET",
+      path: `/admin/jobs`,
+      query: { status: params.status, type: params.type, page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * List background job schedules.
+   *
+   * GET /admin/jobs/schedules
+   *
+   * List the cron schedules that enqueue periodic jobs, with when each last ran and runs next (Admin only).
+   */
+  listJobSchedules(): Promise<ListJobSchedulesResponse> {
+    return this.request<ListJobSchedulesResponse>({
+      method: "GET",
+      path: `/admin/jobs/schedules`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Discard a background job.
+   *
+   * DELETE /admin/jobs/{id}
+   *
+   * Delete a dead or pending job so it never runs. Running jobs cannot be discarded (Admin only).
+   */
+  discardJob(id: string): Promise<DiscardJobResponse> {
+    return this.request<DiscardJobResponse>({
+      method: "DELETE",
+      path: `/admin/jobs/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get a background job.
+   *
+   * GET /admin/jobs/{id}
+   *
+   * Get a job with its payload and the error of its latest failed attempt (Admin only).
+   */
+  getJob(id: string): Promise<GetJobResponse> {
+    return this.request<GetJobResponse>({
+      method: "GET",
+      path: `/admin/jobs/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Retry a dead background job.
+   *
+   * POST /admin/jobs/{id}/retry
+   *
+   * Return a dead job to the queue with its attempts reset, once whatever made it fail is fixed (Admin only).
+   */
+  retryJob(id: string): Promise<RetryJobResponse> {
+    return this.request<RetryJobResponse>({
+      method: "POST",
+      path: `/admin/jobs/${encodeURIComponent(id)}/retry`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Orders held for review.
+   *
+   * GET /admin/orders/review
+   *
+   * List paid orders the risk rules held for manual review, oldest first, with their score and the rules that fired (Admin only).
+   */
+  listReviewOrders(params: ListReviewOrdersParams = {}): Promise<ListReviewOrdersResponse> {
+    return this.request<ListReviewOrdersResponse>({
+      method: "GET",
+      path: `/admin/orders/review`,
+      query: { page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Approve an order held for review.
+   *
+   * POST /admin/orders/{id}/approve
+   *
+   * Confirm a held order: its tickets are issued and the buyer is sent the confirmation (Admin only).
+   */
+  approveReviewOrder(id: string): Promise<ApproveReviewOrderResponse> {
+    return this.request<ApproveReviewOrderResponse>({
+      method: "POST",
+      path: `/admin/orders/${encodeURIComponent(id)}/approve`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Refund an order (admin).
+   *
+   * POST /admin/orders/{id}/refund
+   *
+   * Refund any paid order regardless of the event refund policy (Admin only).
+   */
+  adminRefundOrder(id: string, body?: RefundRequest): Promise<AdminRefundOrderResponse> {
+    return this.request<AdminRefundOrderResponse>({
+      method: "POST",
+      path: `/admin/orders/${encodeURIComponent(id)}/refund`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Reject an order held for review.
+   *
+   * POST /admin/orders/{id}/reject
+   *
+   * Refund a held order in full and put its tickets back on sale (Admin only).
+   */
+  rejectReviewOrder(id: string, body: RejectOrderRequest): Promise<RejectReviewOrderResponse> {
+    return this.request<RejectReviewOrderResponse>({
+      method: "POST",
+      path: `/admin/orders/${encodeURIComponent(id)}/reject`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get an order's saga.
+   *
+   * GET /admin/orders/{id}/saga
+   *
+   * Show which of the reserve, order, pay and fulfill steps an order has completed, the step it is stuck at and every execution and compensation attempt (Admin only).
+   */
+  getOrderSaga(id: string): Promise<GetOrderSagaResponse> {
+    return this.request<GetOrderSagaResponse>({
+      method: "GET",
+      path: `/admin/orders/${encodeURIComponent(id)}/saga`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Compensate an order saga.
+   *
+   * POST /admin/orders/{id}/saga/compensate
+   *
+   * Undo an order's completed steps, latest first: its tickets are revoked, its payment voided, the order cancelled and its tickets put back on sale. Orders with a checked-in ticket cannot be compensated (Admin only).
+   */
+  compensateOrderSaga(id: string, body: CompensateOrderSagaRequest): Promise<CompensateOrderSagaResponse> {
+    return this.request<CompensateOrderSagaResponse>({
+      method: "POST",
+      path: `/admin/orders/${encodeURIComponent(id)}/saga/compensate`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Resume a stuck order saga.
+   *
+   * POST /admin/orders/{id}/saga/resume
+   *
+   * Retry the step an order's saga stopped at: its open payment is verified with the provider, or its paid order is fulfilled. A saga being compensated carries on undoing its steps (Admin only).
+   */
+  resumeOrderSaga(id: string): Promise<ResumeOrderSagaResponse> {
+    return this.request<ResumeOrderSagaResponse>({
+      method: "POST",
+      path: `/admin/orders/${encodeURIComponent(id)}/saga/resume`,
+      as: "json",
+    });
+  }
+
+  /**
+   * List organizers by verification status.
+   *
+   * GET /admin/organizers
+   *
+   * List organizer applications, by default those awaiting verification (Admin only).
+   */
+  listOrganizers(params: ListOrganizersParams = {}): Promise<ListOrganizersResponse> {
+    return this.request<ListOrganizersResponse>({
+      method: "GET",
+      path: `/admin/organizers`,
+      query: { status: params.status, page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Approve an organizer.
+   *
+   * POST /admin/organizers/{id}/approve
+   *
+   * Verify an organizer application and notify the applicant (Admin only).
+   */
+  approveOrganizer(id: string): Promise<ApproveOrganizerResponse> {
+    return this.request<ApproveOrganizerResponse>({
+      method: "POST",
+      path: `/admin/organizers/${encodeURIComponent(id)}/approve`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set organizer fees.
+   *
+   * PUT /admin/organizers/{id}/fees
+   *
+   * Override the platform fee for an organizer. Omitted fields fall back to the platform defaults (Admin only).
+   */
+  updateOrganizerFees(id: string, body: UpdateOrganizerFeesRequest): Promise<UpdateOrganizerFeesResponse> {
+    return this.request<UpdateOrganizerFeesResponse>({
+      method: "PUT",
+      path: `/admin/organizers/${encodeURIComponent(id)}/fees`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Initiate an organizer payout.
+   *
+   * POST /admin/organizers/{id}/payouts
+   *
+   * Debit an organizer's balance and create a pending payout (Admin only).
+   */
+  initiatePayout(id: string, body: InitiatePayoutRequest): Promise<InitiatePayoutResponse> {
+    return this.request<InitiatePayoutResponse>({
+      method: "POST",
+      path: `/admin/organizers/${encodeURIComponent(id)}/payouts`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Reject an organizer.
+   *
+   * POST /admin/organizers/{id}/reject
+   *
+   * Decline an organizer application with a reason and notify the applicant (Admin only).
+   */
+  rejectOrganizer(id: string, body: RejectOrganizerRequest): Promise<RejectOrganizerResponse> {
+    return this.request<RejectOrganizerResponse>({
+      method: "POST",
+      path: `/admin/organizers/${encodeURIComponent(id)}/reject`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Complete a payout.
+   *
+   * POST /admin/payouts/{id}/complete
+   *
+   * Mark a pending payout as transferred with the transfer reference (Admin only).
+   */
+  completePayout(id: string, body: CompletePayoutRequest): Promise<CompletePayoutResponse> {
+    return this.request<CompletePayoutResponse>({
+      method: "POST",
+      path: `/admin/payouts/${encodeURIComponent(id)}/complete`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Fail a payout.
+   *
+   * POST /admin/payouts/{id}/fail
+   *
+   * Mark a pending payout as failed and return its amount to the organizer balance (Admin only).
+   */
+  failPayout(id: string, body: FailPayoutRequest): Promise<FailPayoutResponse> {
+    return this.request<FailPayoutResponse>({
+      method: "POST",
+      path: `/admin/payouts/${encodeURIComponent(id)}/fail`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List permissions and role mappings.
+   *
+   * GET /admin/permissions
+   *
+   * List every permission, whether organizers may delegate it to their team, and the permissions each role holds (Admin only).
+   */
+  listPermissions(): Promise<ListPermissionsResponse> {
+    return this.request<ListPermissionsResponse>({
+      method: "GET",
+      path: `/admin/permissions`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update a role's permissions.
+   *
+   * PUT /admin/permissions/{role}
+   *
+   * Replace the permissions every attendee or organizer holds. Admins always hold every permission. Every replica sees the change within a minute at most (Admin only).
+   */
+  updateRolePermissions(role: string, body: UpdateRolePermissionsRequest): Promise<UpdateRolePermissionsResponse> {
+    return this.request<UpdateRolePermissionsResponse>({
+      method: "PUT",
+      path: `/admin/permissions/${encodeURIComponent(role)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get payment reconciliation reports.
+   *
+   * GET /admin/reconciliation
+   *
+   * Compare a day of Paystack and Stripe settlements with our payments: mismatched amounts, transactions unknown here (orphaned) and payments whose webhook never arrived. Defaults to the latest reconciled day (Admin only).
+   */
+  getReconciliationReport(params: GetReconciliationReportParams = {}): Promise<GetReconciliationReportResponse> {
+    return this.request<GetReconciliationReportResponse>({
+      method: "GET",
+      path: `/admin/reconciliation`,
+      query: { date: params.date, provider: params.provider },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get admin statistics.
+   *
+   * GET /admin/stats
+   *
+   * Get platform totals and a dashboard of orders, tickets sold, revenue and refunds per currency and new user signups per day, week or month, with the top events and organizers by tickets sold. The range defaults to the last 30 days; the dashboard is refreshed every 5 minutes (Admin only).
+   */
+  getAdminStats(params: GetAdminStatsParams = {}): Promise<GetAdminStatsResponse> {
+    return this.request<GetAdminStatsResponse>({
+      method: "GET",
+      path: `/admin/stats`,
+      query: { from: params.from, to: params.to, interval: params.interval },
+      as: "json",
+    });
+  }
+
+  /**
+   * Unlock a user account.
+   *
+   * POST /admin/users/{id}/unlock
+   *
+   * Clear failed login attempts and any login lockout on a user account (Admin only).
+   */
+  unlockUser(id: string): Promise<UnlockUserResponse> {
+    return this.request<UnlockUserResponse>({
+      method: "POST",
+      path: `/admin/users/${encodeURIComponent(id)}/unlock`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Claim a guest account.
+   *
+   * POST /auth/claim-account
+   *
+   * Upgrade a guest to a full account by setting a password, using the claim link from an order confirmation email. Tickets bought as a guest stay with the account.
+   */
+  claimAccount(body: ClaimAccountRequest): Promise<ClaimAccountResponse> {
+    return this.request<ClaimAccountResponse>({
+      method: "POST",
+      path: `/auth/claim-account`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get a CSRF token.
+   *
+   * GET /auth/csrf
+   *
+   * Issue a double-submit CSRF token for cookie sessions, set in a readable cookie and returned. Send it in the X-CSRF-Token header on requests that carry the refresh cookie. Login and refresh in cookie mode issue one too.
+   */
+  getCSRFToken(): Promise<GetCSRFTokenResponse> {
+    return this.request<GetCSRFTokenResponse>({
+      method: "GET",
+      path: `/auth/csrf`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Request password reset.
+   *
+   * POST /auth/forgot-password
+   *
+   * Send a password reset link to the account email if it exists.
+   */
+  forgotPassword(body: ForgotPasswordRequest): Promise<ForgotPasswordResponse> {
+    return this.request<ForgotPasswordResponse>({
+      method: "POST",
+      path: `/auth/forgot-password`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Start a guest checkout.
+   *
+   * POST /auth/guest
+   *
+   * Get tokens to reserve and pay for tickets with just an email. Guest tokens are only accepted by the reservation, order, payment and waiting room endpoints; tickets are emailed with a link to claim the account.
+   */
+  guestCheckout(body: GuestCheckoutRequest): Promise<GuestCheckoutResponse> {
+    return this.request<GuestCheckoutResponse>({
+      method: "POST",
+      path: `/auth/guest`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * User login.
+   *
+   * POST /auth/login
+   *
+   * Authenticate user and return JWT tokens. Browsers may send X-Auth-Mode: cookie, when cookie sessions are enabled, to get the refresh token in an httpOnly cookie with a CSRF token instead.
+   */
+  login(body: LoginRequest, params: LoginParams = {}): Promise<LoginResponse> {
+    return this.request<LoginResponse>({
+      method: "POST",
+      path: `/auth/login`,
+      headers: { "X-Auth-Mode": params.xAuthMode },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Log out.
+   *
+   * POST /auth/logout
+   *
+   * End the session of a refresh token, from the request body or the refresh cookie, and clear the session cookies. Cookie sessions need the X-CSRF-Token header.
+   */
+  logout(body?: LogoutRequest, params: LogoutParams = {}): Promise<LogoutResponse> {
+    return this.request<LogoutResponse>({
+      method: "POST",
+      path: `/auth/logout`,
+      headers: { "X-CSRF-Token": params.xCSRFToken },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Refresh access token.
+   *
+   * POST /auth/refresh
+   *
+   * Get a new token pair using a refresh token. Cookie sessions may leave the body empty to use the refresh cookie, and must send the X-CSRF-Token header; the new refresh token is set in the cookie.
+   */
+  refreshToken(body?: RefreshTokenRequest, params: RefreshTokenParams = {}): Promise<RefreshTokenResponse> {
+    return this.request<RefreshTokenResponse>({
+      method: "POST",
+      path: `/auth/refresh`,
+      headers: { "X-CSRF-Token": params.xCSRFToken },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Register a new user.
+   *
+   * POST /auth/register
+   *
+   * Create a new user account.
+   */
+  register(body: RegisterRequest): Promise<RegisterResponse> {
+    return this.request<RegisterResponse>({
+      method: "POST",
+      path: `/auth/register`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Reset password.
+   *
+   * POST /auth/reset-password
+   *
+   * Set a new password using a password reset token.
+   */
+  resetPassword(body: ResetPasswordRequest): Promise<ResetPasswordResponse> {
+    return this.request<ResetPasswordResponse>({
+      method: "POST",
+      path: `/auth/reset-password`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Verify email address.
+   *
+   * POST /auth/verify-email
+   *
+   * Verify a user's email address using verification token.
+   */
+  verifyEmail(body: VerifyEmailRequest): Promise<VerifyEmailResponse> {
+    return this.request<VerifyEmailResponse>({
+      method: "POST",
+      path: `/auth/verify-email`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List event categories.
+   *
+   * GET /categories
+   *
+   * List the active categories events can be filed under. Use the slug as an event's category and to filter GET /events.
+   */
+  listCategories(): Promise<ListCategoriesResponse> {
+    return this.request<ListCategoriesResponse>({
+      method: "GET",
+      path: `/categories`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Print an attendee badge.
+   *
+   * POST /checkin/badges/{id}/print
+   *
+   * Send a checked-in attendee's badge to the event's badge printer, e.g. to reprint one (Organizer/Admin only, or a scanner token for the event).
+   */
+  printBadge(id: string): Promise<PrintBadgeResponse> {
+    return this.request<PrintBadgeResponse>({
+      method: "POST",
+      path: `/checkin/badges/${encodeURIComponent(id)}/print`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Check a ticket out.
+   *
+   * POST /checkin/checkout
+   *
+   * Scan a checked-in ticket on its way out of an event that allows re-entry, so it can be scanned back in with /checkin/validate. With zone_id the ticket only leaves that zone, which frees a place in its occupancy (Organizer/Admin only, or a scanner token for the event).
+   */
+  checkout(body: CheckoutRequest): Promise<CheckoutResponse> {
+    return this.request<CheckoutResponse>({
+      method: "POST",
+      path: `/checkin/checkout`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List attendee badges.
+   *
+   * GET /checkin/events/{id}/badges
+   *
+   * Printable badge data (name, tier and QR code) of attendees checked in to an event, in check-in order. Check-in desks poll with since set to the last badge's checked_in_at (Organizer/Admin only, or a scanner token for the event).
+   */
+  listBadges(id: string, params: ListBadgesParams = {}): Promise<ListBadgesResponse> {
+    return this.request<ListBadgesResponse>({
+      method: "GET",
+      path: `/checkin/events/${encodeURIComponent(id)}/badges`,
+      query: { since: params.since, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get live occupancy.
+   *
+   * GET /checkin/events/{id}/occupancy
+   *
+   * How many attendees are inside an event and each of its zones right now: entries minus check-outs, next to any occupancy limits (Organizer/Admin only, or a scanner token for the event).
+   */
+  getEventOccupancy(id: string): Promise<GetEventOccupancyResponse> {
+    return this.request<GetEventOccupancyResponse>({
+      method: "GET",
+      path: `/checkin/events/${encodeURIComponent(id)}/occupancy`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Search attendees for manual check-in.
+   *
+   * GET /checkin/events/{id}/search
+   *
+   * Find an event's tickets by the owner's name or email, for attendees who arrive without their ticket. Returns at most 20 active or checked-in tickets (Organizer/Admin only, or a scanner token for the event).
+   */
+  searchCheckinAttendees(id: string, params: SearchCheckinAttendeesParams): Promise<SearchCheckinAttendeesResponse> {
+    return this.request<SearchCheckinAttendeesResponse>({
+      method: "GET",
+      path: `/checkin/events/${encodeURIComponent(id)}/search`,
+      query: { q: params.q },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get check-in statistics.
+   *
+   * GET /checkin/events/{id}/stats
+   *
+   * Get live attendance for an event: totals, per-tier check-ins and check-in rate over time (Organizer/Admin only, or a scanner token for the event).
+   */
+  getCheckinStats(id: string, params: GetCheckinStatsParams = {}): Promise<GetCheckinStatsResponse> {
+    return this.request<GetCheckinStatsResponse>({
+      method: "GET",
+      path: `/checkin/events/${encodeURIComponent(id)}/stats`,
+      query: { interval: params.interval },
+      as: "json",
+    });
+  }
+
+  /**
+   * Live check-in feed.
+   *
+   * GET /checkin/events/{id}/stream
+   *
+   * Server-sent events stream pushing each successful check-in for an event as a "checkin" event (Organizer/Admin only, or a scanner token for the event).
+   */
+  streamCheckins(id: string): Promise<Response> {
+    return this.request<Response>({
+      method: "GET",
+      path: `/checkin/events/${encodeURIComponent(id)}/stream`,
+      as: "response",
+    });
+  }
+
+  /**
+   * Check in a ticket manually.
+   *
+   * POST /checkin/manual
+   *
+   * Check in a ticket found with the attendee search, for the event or one of its sessions when session_id is given, at the entrance of a zone when zone_id is given. The check-in is recorded with method "manual" (Organizer/Admin only, or a scanner token for the event).
+   */
+  manualCheckin(body: ManualCheckinRequest): Promise<ManualCheckinResponse> {
+    return this.request<ManualCheckinResponse>({
+      method: "POST",
+      path: `/checkin/manual`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get a ticket's scan history.
+   *
+   * GET /checkin/tickets/{id}/scans
+   *
+   * Every entry, exit and session scan of a ticket, oldest first, and whether it is inside the event (Organizer/Admin only, or a scanner token for the event).
+   */
+  getTicketScans(id: string): Promise<GetTicketScansResponse> {
+    return this.request<GetTicketScansResponse>({
+      method: "GET",
+      path: `/checkin/tickets/${encodeURIComponent(id)}/scans`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Undo a check-in.
+   *
+   * POST /checkin/undo
+   *
+   * Reverse a mistaken check-in within 5 minutes of the scan. Undoing an event check-in makes the ticket valid for entry again (Organizer/Admin only).
+   */
+  undoCheckin(body: UndoCheckinRequest): Promise<UndoCheckinResponse> {
+    return this.request<UndoCheckinResponse>({
+      method: "POST",
+      path: `/checkin/undo`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Validate QR code.
+   *
+   * POST /checkin/validate
+   *
+   * Validate a ticket QR code for event check-in, or for one of its sessions when session_id is given. With zone_id the scan is at a zone's entrance and only tickets whose tier grants the zone get in; tickets already checked in at the main gate are let through without a new check-in (Organizer/Admin only, or a scanner token for the event).
+   */
+  validateQRCode(body: ValidateQRRequest): Promise<ValidateQRCodeResponse> {
+    return this.request<ValidateQRCodeResponse>({
+      method: "POST",
+      path: `/checkin/validate`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List supported currencies.
+   *
+   * GET /currencies
+   *
+   * List the currencies events can be priced in and the payment providers that accept each one.
+   */
+  listCurrencies(): Promise<ListCurrenciesResponse> {
+    return this.request<ListCurrenciesResponse>({
+      method: "GET",
+      path: `/currencies`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Delete an event session.
+   *
+   * DELETE /event-sessions/{id}
+   *
+   * Delete a session that no ticket tier is limited to (Organizer/Admin only).
+   */
+  deleteEventSession(id: string): Promise<DeleteEventSessionResponse> {
+    return this.request<DeleteEventSessionResponse>({
+      method: "DELETE",
+      path: `/event-sessions/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update an event session.
+   *
+   * PUT /event-sessions/{id}
+   *
+   * Update a session's title, times or capacity (Organizer/Admin only).
+   */
+  updateEventSession(id: string, body: UpdateEventSessionRequest): Promise<UpdateEventSessionResponse> {
+    return this.request<UpdateEventSessionResponse>({
+      method: "PUT",
+      path: `/event-sessions/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Delete an event zone.
+   *
+   * DELETE /event-zones/{id}
+   *
+   * Delete a zone that no ticket tier grants (Organizer/Admin only).
+   */
+  deleteEventZone(id: string): Promise<DeleteEventZoneResponse> {
+    return this.request<DeleteEventZoneResponse>({
+      method: "DELETE",
+      path: `/event-zones/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update an event zone.
+   *
+   * PUT /event-zones/{id}
+   *
+   * Change the name or occupancy limit of a zone (Organizer/Admin only).
+   */
+  updateEventZone(id: string, body: EventZoneRequest): Promise<UpdateEventZoneResponse> {
+    return this.request<UpdateEventZoneResponse>({
+      method: "PUT",
+      path: `/event-zones/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List all events.
+   *
+   * GET /events
+   *
+   * Get a list of all published events. Pass cursor (empty for the first page, then pagination.next_cursor) for keyset pagination instead of page numbers.
+   */
+  listEvents(params: ListEventsParams = {}): Promise<ListEventsResponse> {
+    return this.request<ListEventsResponse>({
+      method: "GET",
+      path: `/events`,
+      query: { page: params.page, limit: params.limit, cursor: params.cursor, category: params.category, status: params.status },
+      as: "json",
+    });
+  }
+
+  /**
+   * Create a new event.
+   *
+   * POST /events
+   *
+   * Create a new event. Requires the events:create permission, held by organizers and admins unless an admin changes the role mapping.
+   */
+  createEvent(body: CreateEventRequest): Promise<CreateEventResponse> {
+    return this.request<CreateEventResponse>({
+      method: "POST",
+      path: `/events`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Featured events.
+   *
+   * GET /events/featured
+   *
+   * Upcoming published events picked by the Eventix team, soonest first.
+   */
+  getFeaturedEvents(params: GetFeaturedEventsParams = {}): Promise<GetFeaturedEventsResponse> {
+    return this.request<GetFeaturedEventsResponse>({
+      method: "GET",
+      path: `/events/featured`,
+      query: { page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Recommended events.
+   *
+   * GET /events/recommended
+   *
+   * Upcoming events ranked for the authenticated user by the organizers, categories and locations of their past purchases, topped up with featured and best-selling events. Anonymous users and users without purchases get featured and best-selling events only.
+   */
+  getRecommendedEvents(params: GetRecommendedEventsParams = {}): Promise<GetRecommendedEventsResponse> {
+    return this.request<GetRecommendedEventsResponse>({
+      method: "GET",
+      path: `/events/recommended`,
+      query: { limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get event by slug.
+   *
+   * GET /events/slug/{slug}
+   *
+   * Get detailed information about an event using its URL slug.
+   */
+  getEventBySlug(slug: string): Promise<GetEventBySlugResponse> {
+    return this.request<GetEventBySlugResponse>({
+      method: "GET",
+      path: `/events/slug/${encodeURIComponent(slug)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Trending events.
+   *
+   * GET /events/trending
+   *
+   * Upcoming published events ranked by tickets sold over the last day, with recent sales weighing most.
+   */
+  getTrendingEvents(params: GetTrendingEventsParams = {}): Promise<GetTrendingEventsResponse> {
+    return this.request<GetTrendingEventsResponse>({
+      method: "GET",
+      path: `/events/trending`,
+      query: { limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get event by ID.
+   *
+   * GET /events/{id}
+   *
+   * Get detailed information about a specific event.
+   */
+  getEvent(id: string): Promise<GetEventResponse> {
+    return this.request<GetEventResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * List an event's add-ons.
+   *
+   * GET /events/{id}/add-ons
+   *
+   * List the extras, such as parking or merchandise, that can be added to an order for the event's tickets.
+   */
+  listAddOns(id: string): Promise<ListAddOnsResponse> {
+    return this.request<ListAddOnsResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/add-ons`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Add an add-on.
+   *
+   * POST /events/{id}/add-ons
+   *
+   * Sell an extra, such as parking or merchandise, alongside the event's tickets. It is priced in the event's currency (Organizer/Admin only).
+   */
+  createAddOn(id: string, body: CreateAddOnRequest): Promise<CreateAddOnResponse> {
+    return this.request<CreateAddOnResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/add-ons`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Remove an event's badge printer.
+   *
+   * DELETE /events/{id}/badge-printer
+   *
+   * Stop sending the event's badges for printing (Organizer/Admin only).
+   */
+  removeBadgePrinter(id: string): Promise<RemoveBadgePrinterResponse> {
+    return this.request<RemoveBadgePrinterResponse>({
+      method: "DELETE",
+      path: `/events/${encodeURIComponent(id)}/badge-printer`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get an event's badge printer.
+   *
+   * GET /events/{id}/badge-printer
+   *
+   * Where the event's badges are sent for printing (Organizer/Admin only).
+   */
+  getBadgePrinter(id: string): Promise<GetBadgePrinterResponse> {
+    return this.request<GetBadgePrinterResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/badge-printer`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set an event's badge printer.
+   *
+   * PUT /events/{id}/badge-printer
+   *
+   * Send the event's badges to a print server. The webhook driver POSTs {"type":"badge.print","badge":{...}} to the URL, signed with a hex HMAC-SHA256 of the body in X-Eventix-Signature. The signing secret is returned when first generated or rotated. With auto_print, every event check-in prints a badge (Organizer/Admin only).
+   */
+  updateBadgePrinter(id: string, body: UpdateBadgePrinterRequest): Promise<UpdateBadgePrinterResponse> {
+    return this.request<UpdateBadgePrinterResponse>({
+      method: "PUT",
+      path: `/events/${encodeURIComponent(id)}/badge-printer`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Upload an event banner.
+   *
+   * POST /events/{id}/banner
+   *
+   * Upload a banner image for an event (JPEG, PNG or WebP, max 5MB) (Organizer/Admin only).
+   */
+  uploadEventBanner(id: string, form: UploadEventBannerForm): Promise<UploadEventBannerResponse> {
+    return this.request<UploadEventBannerResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/banner`,
+      form: { file: form.file },
+      as: "json",
+    });
+  }
+
+  /**
+   * Cancel an event.
+   *
+   * POST /events/{id}/cancel
+   *
+   * Cancel an event: its tickets stop being valid, pending orders are cancelled, every paid order is queued for a full refund and ticket holders are emailed in the background. Repeating the call on a cancelled event re-queues any refunds that have not completed (Organizer/Admin only).
+   */
+  cancelEvent(id: string, body?: CancelEventRequest): Promise<CancelEventResponse> {
+    return this.request<CancelEventResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/cancel`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Duplicate an event.
+   *
+   * POST /events/{id}/duplicate
+   *
+   * Copy an event's details, ticket tiers, sessions and registration questions into a new draft with new dates. Sale windows, price phases and sessions move with the event. (Organizer/Admin only).
+   */
+  duplicateEvent(id: string, body: DuplicateEventRequest): Promise<DuplicateEventResponse> {
+    return this.request<DuplicateEventResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/duplicate`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Remove a saved event.
+   *
+   * DELETE /events/{id}/favorite
+   *
+   * Remove an event from the authenticated user's saved events.
+   */
+  unfavoriteEvent(id: string): Promise<UnfavoriteEventResponse> {
+    return this.request<UnfavoriteEventResponse>({
+      method: "DELETE",
+      path: `/events/${encodeURIComponent(id)}/favorite`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Save an event.
+   *
+   * POST /events/{id}/favorite
+   *
+   * Add an event to the authenticated user's saved events. The user is emailed when a saved event is published and when its tickets go on sale. Saving an event twice has no effect.
+   */
+  favoriteEvent(id: string): Promise<FavoriteEventResponse> {
+    return this.request<FavoriteEventResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/favorite`,
+      as: "json",
+    });
+  }
+
+  /**
+   * List an event's registration questions.
+   *
+   * GET /events/{id}/form-fields
+   *
+   * List the custom questions buyers answer for each ticket when ordering, in display order.
+   */
+  listFormFields(id: string): Promise<ListFormFieldsResponse> {
+    return this.request<ListFormFieldsResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/form-fields`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Add a registration question.
+   *
+   * POST /events/{id}/form-fields
+   *
+   * Add a custom question, such as a t-shirt size or dietary needs, that buyers answer for each ticket (Organizer/Admin only).
+   */
+  createFormField(id: string, body: CreateFormFieldRequest): Promise<CreateFormFieldResponse> {
+    return this.request<CreateFormFieldResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/form-fields`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set an event's occupancy limit.
+   *
+   * PUT /events/{id}/occupancy-limit
+   *
+   * Refuse entry scans while this many attendees are inside the event; 0 removes the limit. Zones have their own limits (Organizer/Admin only).
+   */
+  updateEventOccupancyLimit(id: string, body: UpdateOccupancyLimitRequest): Promise<UpdateEventOccupancyLimitResponse> {
+    return this.request<UpdateEventOccupancyLimitResponse>({
+      method: "PUT",
+      path: `/events/${encodeURIComponent(id)}/occupancy-limit`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Join an event's waiting room.
+   *
+   * POST /events/{id}/queue
+   *
+   * Get a queue token for a high-demand event. Poll its status until admitted, then pass it as queue_token to /tickets/reserve.
+   */
+  joinWaitingRoom(id: string): Promise<JoinWaitingRoomResponse> {
+    return this.request<JoinWaitingRoomResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/queue`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get waiting room status.
+   *
+   * GET /events/{id}/queue/{token}
+   *
+   * Get the position of a queue token, or when it was admitted until.
+   */
+  getWaitingRoomPosition(id: string, token: string): Promise<GetWaitingRoomPositionResponse> {
+    return this.request<GetWaitingRoomPositionResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/queue/${encodeURIComponent(token)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Allow or disallow re-entry.
+   *
+   * PUT /events/{id}/reentry
+   *
+   * When allowed, checked-in tickets can be scanned out with /checkin/checkout and back in, and every scan is kept in the ticket's history. Otherwise a ticket gets in once (Organizer/Admin only).
+   */
+  updateEventReentry(id: string, body: UpdateReentryRequest): Promise<UpdateEventReentryResponse> {
+    return this.request<UpdateEventReentryResponse>({
+      method: "PUT",
+      path: `/events/${encodeURIComponent(id)}/reentry`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set an event's refund policy.
+   *
+   * PUT /events/{id}/refund-policy
+   *
+   * Set when attendees may refund their own orders: until the event starts (flexible), until days_before days before it (deadline) or never (none), and the fee in basis points kept from each refund. Refunds offered after a reschedule are always in full (Organizer/Admin only).
+   */
+  updateEventRefundPolicy(id: string, body: UpdateRefundPolicyRequest): Promise<UpdateEventRefundPolicyResponse> {
+    return this.request<UpdateEventRefundPolicyResponse>({
+      method: "PUT",
+      path: `/events/${encodeURIComponent(id)}/refund-policy`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Report an event.
+   *
+   * POST /events/{id}/report
+   *
+   * Flag a published event as spam, fraud, misleading, offensive or other for the Eventix team to review. Each user can report an event once.
+   */
+  reportEvent(id: string, body: ReportEventRequest): Promise<ReportEventResponse> {
+    return this.request<ReportEventResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/report`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Reschedule an event.
+   *
+   * PUT /events/{id}/reschedule
+   *
+   * Move an event and its sessions to new dates. Ticket holders are emailed, and buyers of existing orders can request a refund until the refund window closes, even if the refund policy would otherwise refuse it. The window never extends past the new start (Organizer/Admin only).
+   */
+  rescheduleEvent(id: string, body: RescheduleEventRequest): Promise<RescheduleEventResponse> {
+    return this.request<RescheduleEventResponse>({
+      method: "PUT",
+      path: `/events/${encodeURIComponent(id)}/reschedule`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List an event's date changes.
+   *
+   * GET /events/{id}/reschedules
+   *
+   * List the times an event was rescheduled, newest first, with each change's refund deadline (Organizer/Admin only).
+   */
+  listEventReschedules(id: string): Promise<ListEventReschedulesResponse> {
+    return this.request<ListEventReschedulesResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/reschedules`,
+      as: "json",
+    });
+  }
+
+  /**
+   * List event sessions.
+   *
+   * GET /events/{id}/sessions
+   *
+   * List the sessions of a multi-session event in schedule order.
+   */
+  listEventSessions(id: string): Promise<ListEventSessionsResponse> {
+    return this.request<ListEventSessionsResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/sessions`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Add an event session.
+   *
+   * POST /events/{id}/sessions
+   *
+   * Add a session, such as a talk or festival day, that attendees check in to separately (Organizer/Admin only).
+   */
+  createEventSession(id: string, body: CreateEventSessionRequest): Promise<CreateEventSessionResponse> {
+    return this.request<CreateEventSessionResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/sessions`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Event status history.
+   *
+   * GET /events/{id}/status-history
+   *
+   * List an event's status changes, newest first, with the reasons given when it was rejected, unpublished, banned or cancelled (Organizer/Admin only).
+   */
+  getEventStatusHistory(id: string): Promise<GetEventStatusHistoryResponse> {
+    return this.request<GetEventStatusHistoryResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/status-history`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Submit an event for review.
+   *
+   * POST /events/{id}/submit
+   *
+   * Move a draft event into the admin review queue (Organizer/Admin only).
+   */
+  submitEvent(id: string): Promise<SubmitEventResponse> {
+    return this.request<SubmitEventResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/submit`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set an event's tax.
+   *
+   * PUT /events/{id}/tax
+   *
+   * Set the sales tax or VAT charged on the event's orders, in basis points (750 is 7.5%). Inclusive tax is part of the ticket and add-on prices; exclusive tax is added at checkout. A null basis_points falls back to the organizer's tax settings (Organizer/Admin only).
+   */
+  updateEventTax(id: string, body: UpdateTaxRequest): Promise<UpdateEventTaxResponse> {
+    return this.request<UpdateEventTaxResponse>({
+      method: "PUT",
+      path: `/events/${encodeURIComponent(id)}/tax`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Add a ticket tier.
+   *
+   * POST /events/{id}/tiers
+   *
+   * Add a ticket tier to an existing event (Organizer/Admin only).
+   */
+  createTicketTier(id: string, body: TicketTierReq): Promise<CreateTicketTierResponse> {
+    return this.request<CreateTicketTierResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/tiers`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Enable or disable an event's waiting room.
+   *
+   * PUT /events/{id}/waiting-room
+   *
+   * When enabled, buyers must be admitted from the virtual queue before reserving tickets. Disabling it discards the queue. (Organizer/Admin only).
+   */
+  updateWaitingRoom(id: string, body: UpdateWaitingRoomRequest): Promise<UpdateWaitingRoomResponse> {
+    return this.request<UpdateWaitingRoomResponse>({
+      method: "PUT",
+      path: `/events/${encodeURIComponent(id)}/waiting-room`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List event zones.
+   *
+   * GET /events/{id}/zones
+   *
+   * List the restricted areas of an event's venue, such as a VIP lounge or backstage.
+   */
+  listEventZones(id: string): Promise<ListEventZonesResponse> {
+    return this.request<ListEventZonesResponse>({
+      method: "GET",
+      path: `/events/${encodeURIComponent(id)}/zones`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Add an event zone.
+   *
+   * POST /events/{id}/zones
+   *
+   * Add a restricted area with its own entrance. Only tickets whose tier grants the zone are let in there, and no more than max_occupancy at once when set (Organizer/Admin only).
+   */
+  createEventZone(id: string, body: EventZoneRequest): Promise<CreateEventZoneResponse> {
+    return this.request<CreateEventZoneResponse>({
+      method: "POST",
+      path: `/events/${encodeURIComponent(id)}/zones`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Delete a registration question.
+   *
+   * DELETE /form-fields/{id}
+   *
+   * Stop asking a question. It also drops out of the attendee export (Organizer/Admin only).
+   */
+  deleteFormField(id: string): Promise<DeleteFormFieldResponse> {
+    return this.request<DeleteFormFieldResponse>({
+      method: "DELETE",
+      path: `/form-fields/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update a registration question.
+   *
+   * PUT /form-fields/{id}
+   *
+   * Update a question's label, type, options, order or whether it is required. Answers already given are kept (Organizer/Admin only).
+   */
+  updateFormField(id: string, body: UpdateFormFieldRequest): Promise<UpdateFormFieldResponse> {
+    return this.request<UpdateFormFieldResponse>({
+      method: "PUT",
+      path: `/form-fields/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Query the GraphQL API.
+   *
+   * GET /graphql
+   *
+   * Run a GraphQL query over events, ticket tiers, organizers and, when authenticated, the caller's tickets and orders. Relationships are batched, so an event with its tiers and organizer is one round trip. See the schema at internal/graph/schema.graphqls or introspect it.
+   */
+  getGraphql(params: GetGraphqlParams = {}): Promise<GetGraphqlResponse> {
+    return this.request<GetGraphqlResponse>({
+      method: "GET",
+      path: `/graphql`,
+      query: { query: params.query },
+      as: "json",
+    });
+  }
+
+  /**
+   * Query the GraphQL API.
+   *
+   * POST /graphql
+   *
+   * Run a GraphQL query over events, ticket tiers, organizers and, when authenticated, the caller's tickets and orders. Relationships are batched, so an event with its tiers and organizer is one round trip. See the schema at internal/graph/schema.graphqls or introspect it.
+   */
+  postGraphql(params: PostGraphqlParams = {}): Promise<PostGraphqlResponse> {
+    return this.request<PostGraphqlResponse>({
+      method: "POST",
+      path: `/graphql`,
+      query: { query: params.query },
+      as: "json",
+    });
+  }
+
+  /**
+   * Issue comp tickets from an integration.
+   *
+   * POST /integrations/actions/comp-tickets
+   *
+   * Action giving one person free tickets of a tier, for example everyone added to a sponsor sheet. They get a zero-priced order and their tickets by email, like comps issued from the dashboard.
+   */
+  issueCompTicketsAction(body: IntegrationCompTicketsRequest): Promise<IntegrationCompResponse> {
+    return this.request<IntegrationCompResponse>({
+      method: "POST",
+      path: `/integrations/actions/comp-tickets`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List events for an integration.
+   *
+   * GET /integrations/events
+   *
+   * List the organizer's events, latest first, for event pickers in integration setup.
+   */
+  listIntegrationEvents(): Promise<IntegrationEventResponse[]> {
+    return this.request<IntegrationEventResponse[]>({
+      method: "GET",
+      path: `/integrations/events`,
+      as: "json",
+    });
+  }
+
+  /**
+   * List ticket tiers for an integration.
+   *
+   * GET /integrations/events/{id}/tiers
+   *
+   * List an event's ticket tiers, for tier pickers in integration setup.
+   */
+  listIntegrationTiers(id: string): Promise<IntegrationTierResponse[]> {
+    return this.request<IntegrationTierResponse[]>({
+      method: "GET",
+      path: `/integrations/events/${encodeURIComponent(id)}/tiers`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Test an API key.
+   *
+   * GET /integrations/me
+   *
+   * Return the organizer an API key belongs to. Integrations call it to test a connection and label it.
+   */
+  getIntegrationAccount(): Promise<IntegrationAccountResponse> {
+    return this.request<IntegrationAccountResponse>({
+      method: "GET",
+      path: `/integrations/me`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Find an attendee.
+   *
+   * GET /integrations/searches/attendees
+   *
+   * Search action returning the issued tickets held by an email address, newest first. An empty array means no match.
+   */
+  findAttendeeSearch(params: FindAttendeeSearchParams): Promise<IntegrationAttendeeResponse[]> {
+    return this.request<IntegrationAttendeeResponse[]>({
+      method: "GET",
+      path: `/integrations/searches/attendees`,
+      query: { email: params.email, event_id: params.eventID, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Poll for new attendees.
+   *
+   * GET /integrations/triggers/attendees
+   *
+   * Polling trigger for issued tickets with their holder's name and email, newest first. Zapier deduplicates by id; other pollers pass the newest record's cursor as since to get only the records issued after it.
+   */
+  newAttendeesTrigger(params: NewAttendeesTriggerParams = {}): Promise<IntegrationAttendeeResponse[]> {
+    return this.request<IntegrationAttendeeResponse[]>({
+      method: "GET",
+      path: `/integrations/triggers/attendees`,
+      query: { event_id: params.eventID, since: params.since, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Poll for new orders.
+   *
+   * GET /integrations/triggers/orders
+   *
+   * Polling trigger for sold orders, comps included, with the buyer's name and email, newest first by when their tickets were issued. Refunded orders keep their place with status refunded. Paged by since like the attendees trigger.
+   */
+  newOrdersTrigger(params: NewOrdersTriggerParams = {}): Promise<IntegrationOrderResponse[]> {
+    return this.request<IntegrationOrderResponse[]>({
+      method: "GET",
+      path: `/integrations/triggers/orders`,
+      query: { event_id: params.eventID, since: params.since, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Mark a notification as read.
+   *
+   * POST /notifications/{id}/read
+   *
+   * Mark a notification belonging to the authenticated user as read.
+   */
+  markNotificationRead(id: string): Promise<MarkNotificationReadResponse> {
+    return this.request<MarkNotificationReadResponse>({
+      method: "POST",
+      path: `/notifications/${encodeURIComponent(id)}/read`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Create an order.
+   *
+   * POST /orders
+   *
+   * Create an order for reserved tickets, answering the event's registration form questions (see GET /events/{id}/form-fields) and optionally adding extras such as parking or merchandise (see GET /events/{id}/add-ons).
+   */
+  createOrder(body: CreateOrderRequest, params: CreateOrderParams = {}): Promise<CreateOrderResponse> {
+    return this.request<CreateOrderResponse>({
+      method: "POST",
+      path: `/orders`,
+      headers: { "Idempotency-Key": params.idempotencyKey },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get user's orders.
+   *
+   * GET /orders/my-orders
+   *
+   * Get all orders placed by the authenticated user, newest first. Pass cursor (empty for the first page, then pagination.next_cursor) to page through them.
+   */
+  getMyOrders(params: GetMyOrdersParams = {}): Promise<GetMyOrdersResponse> {
+    return this.request<GetMyOrdersResponse>({
+      method: "GET",
+      path: `/orders/my-orders`,
+      query: { cursor: params.cursor, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get an order.
+   *
+   * GET /orders/{id}
+   *
+   * Get an order owned by the authenticated user with its line items, tickets, payments and refunds. QR codes are included for tickets the user still holds.
+   */
+  getOrder(id: string): Promise<GetOrderResponse> {
+    return this.request<GetOrderResponse>({
+      method: "GET",
+      path: `/orders/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Download an order receipt.
+   *
+   * GET /orders/{id}/receipt
+   *
+   * Render the PDF receipt of a paid or refunded order owned by the authenticated user.
+   */
+  getOrderReceipt(id: string): Promise<Blob> {
+    return this.request<Blob>({
+      method: "GET",
+      path: `/orders/${encodeURIComponent(id)}/receipt`,
+      as: "blob",
+    });
+  }
+
+  /**
+   * Request a refund.
+   *
+   * POST /orders/{id}/refund
+   *
+   * Refund a paid order owned by the authenticated user, subject to the event refund policy.
+   */
+  requestRefund(id: string, body?: RefundRequest): Promise<RequestRefundResponse> {
+    return this.request<RequestRefundResponse>({
+      method: "POST",
+      path: `/orders/${encodeURIComponent(id)}/refund`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Retry a failed payment.
+   *
+   * POST /orders/{id}/retry-payment
+   *
+   * Start a new payment for an order whose last payment failed, while its tickets are still reserved.
+   */
+  retryPayment(id: string, body?: RetryPaymentRequest, params: RetryPaymentParams = {}): Promise<RetryPaymentResponse> {
+    return this.request<RetryPaymentResponse>({
+      method: "POST",
+      path: `/orders/${encodeURIComponent(id)}/retry-payment`,
+      headers: { "Idempotency-Key": params.idempotencyKey },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List my API keys.
+   *
+   * GET /organizer/api-keys
+   *
+   * List the API keys that connect Zapier, Make and similar tools to the organizer account, including revoked ones.
+   */
+  listIntegrationKeys(): Promise<ListIntegrationKeysResponse> {
+    return this.request<ListIntegrationKeysResponse>({
+      method: "GET",
+      path: `/organizer/api-keys`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Create an API key.
+   *
+   * POST /organizer/api-keys
+   *
+   * Create a key for the /integrations routes, sent in the X-API-Key header. The key reads the organizer's orders and attendees and can issue comp tickets, acting as the signed-in user. It is only returned here.
+   */
+  createIntegrationKey(body: CreateIntegrationKeyRequest): Promise<CreateIntegrationKeyResponse> {
+    return this.request<CreateIntegrationKeyResponse>({
+      method: "POST",
+      path: `/organizer/api-keys`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Revoke an API key.
+   *
+   * DELETE /organizer/api-keys/{id}
+   *
+   * Stop an API key from working. Integrations using it get 401 responses.
+   */
+  revokeIntegrationKey(id: string): Promise<RevokeIntegrationKeyResponse> {
+    return this.request<RevokeIntegrationKeyResponse>({
+      method: "DELETE",
+      path: `/organizer/api-keys/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Apply to become an organizer.
+   *
+   * POST /organizer/apply
+   *
+   * Submit an organizer application with a verification document (PDF, JPEG or PNG, max 5MB).
+   */
+  applyOrganizer(form: ApplyOrganizerForm): Promise<ApplyOrganizerResponse> {
+    return this.request<ApplyOrganizerResponse>({
+      method: "POST",
+      path: `/organizer/apply`,
+      form: { organization_name: form.organizationName, description: form.description, website: form.website, document: form.document },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get event analytics.
+   *
+   * GET /organizer/events/{id}/analytics
+   *
+   * Get tickets sold per tier, daily revenue, refund rate and reservation-to-purchase conversion for an event (Organizer/Admin only).
+   */
+  getEventAnalytics(id: string): Promise<GetEventAnalyticsResponse> {
+    return this.request<GetEventAnalyticsResponse>({
+      method: "GET",
+      path: `/organizer/events/${encodeURIComponent(id)}/analytics`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Export event attendees.
+   *
+   * GET /organizer/events/{id}/attendees/export
+   *
+   * Download an event's ticket holders with their tier, order, check-in status and registration form answers as CSV or XLSX. The file is streamed as it is generated (Organizer/Admin only).
+   */
+  exportAttendees(id: string, params: ExportAttendeesParams = {}): Promise<Blob> {
+    return this.request<Blob>({
+      method: "GET",
+      path: `/organizer/events/${encodeURIComponent(id)}/attendees/export`,
+      query: { format: params.format },
+      as: "blob",
+    });
+  }
+
+  /**
+   * Issue comp tickets.
+   *
+   * POST /organizer/events/{id}/comp-tickets
+   *
+   * Give free tickets of one tier to a list of people. Each recipient gets a zero-priced order and their tickets by email; people without an account can claim one from it. Comps use up tier stock and are reported separately from sales (Organizer/Admin only).
+   */
+  issueCompTickets(id: string, body: IssueCompTicketsRequest): Promise<IssueCompTicketsResponse> {
+    return this.request<IssueCompTicketsResponse>({
+      method: "POST",
+      path: `/organizer/events/${encodeURIComponent(id)}/comp-tickets`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List scanner tokens.
+   *
+   * GET /organizer/events/{id}/scanner-tokens
+   *
+   * List the unexpired, unrevoked scanner tokens of an event. Token values are not returned.
+   */
+  listScannerTokens(id: string): Promise<ListScannerTokensResponse> {
+    return this.request<ListScannerTokensResponse>({
+      method: "GET",
+      path: `/organizer/events/${encodeURIComponent(id)}/scanner-tokens`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Issue a door staff scanner token.
+   *
+   * POST /organizer/events/{id}/scanner-tokens
+   *
+   * Issue a short-lived token for temporary gate staff. It only reaches the check-in routes of this event, so staff never need organizer credentials. The token is returned once; check-ins made with it are recorded against the issuer.
+   */
+  issueScannerToken(id: string, body?: IssueScannerTokenRequest): Promise<IssueScannerTokenResponse> {
+    return this.request<IssueScannerTokenResponse>({
+      method: "POST",
+      path: `/organizer/events/${encodeURIComponent(id)}/scanner-tokens`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Upload an organizer logo.
+   *
+   * POST /organizer/logo
+   *
+   * Upload a logo for the authenticated user's organizer profile (JPEG, PNG or WebP, max 5MB).
+   */
+  uploadOrganizerLogo(form: UploadOrganizerLogoForm): Promise<UploadOrganizerLogoResponse> {
+    return this.request<UploadOrganizerLogoResponse>({
+      method: "POST",
+      path: `/organizer/logo`,
+      form: { file: form.file },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get organizer balances and payouts.
+   *
+   * GET /organizer/payouts
+   *
+   * Get the authenticated organizer's balance per currency and payout history (Organizer/Admin only).
+   */
+  getMyPayouts(params: GetMyPayoutsParams = {}): Promise<GetMyPayoutsResponse> {
+    return this.request<GetMyPayoutsResponse>({
+      method: "GET",
+      path: `/organizer/payouts`,
+      query: { page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Revoke a scanner token.
+   *
+   * DELETE /organizer/scanner-tokens/{id}
+   *
+   * Stop a scanner token from checking in tickets before it expires.
+   */
+  revokeScannerToken(id: string): Promise<RevokeScannerTokenResponse> {
+    return this.request<RevokeScannerTokenResponse>({
+      method: "DELETE",
+      path: `/organizer/scanner-tokens/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set the organizer's default tax.
+   *
+   * PUT /organizer/tax
+   *
+   * Set the sales tax or VAT charged on orders for the organizer's events that have no tax settings of their own, in basis points (750 is 7.5%). A null basis_points charges no tax (Organizer only).
+   */
+  updateOrganizerTax(body: UpdateTaxRequest): Promise<UpdateOrganizerTaxResponse> {
+    return this.request<UpdateOrganizerTaxResponse>({
+      method: "PUT",
+      path: `/organizer/tax`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List my team.
+   *
+   * GET /organizer/team
+   *
+   * List the organizer's team members and pending invitations.
+   */
+  listTeamMembers(): Promise<ListTeamMembersResponse> {
+    return this.request<ListTeamMembersResponse>({
+      method: "GET",
+      path: `/organizer/team`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Invite a team member.
+   *
+   * POST /organizer/team
+   *
+   * Email an invitation to join the organizer's team as a scanner (check-in only), finance (analytics and payouts), editor (event editing and comps) or with custom permissions. Members only act on this organizer's events. Inviting a pending address again sends a fresh link.
+   */
+  inviteTeamMember(body: InviteTeamMemberRequest): Promise<InviteTeamMemberResponse> {
+    return this.request<InviteTeamMemberResponse>({
+      method: "POST",
+      path: `/organizer/team`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Remove a team member.
+   *
+   * DELETE /organizer/team/{id}
+   *
+   * Take a member off the team, or withdraw a pending invitation.
+   */
+  removeTeamMember(id: string): Promise<RemoveTeamMemberResponse> {
+    return this.request<RemoveTeamMemberResponse>({
+      method: "DELETE",
+      path: `/organizer/team/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Change a team member's role.
+   *
+   * PUT /organizer/team/{id}
+   *
+   * Change the role or custom permissions of a team member or pending invitee.
+   */
+  updateTeamMember(id: string, body: UpdateTeamMemberRequest): Promise<UpdateTeamMemberResponse> {
+    return this.request<UpdateTeamMemberResponse>({
+      method: "PUT",
+      path: `/organizer/team/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Redeliver a webhook.
+   *
+   * POST /organizer/webhook-deliveries/{id}/redeliver
+   *
+   * Queue a logged delivery's payload to be sent again on the next delivery sweep, as a new delivery. The payload keeps its event id so receivers can recognise it.
+   */
+  redeliverWebhook(id: string): Promise<RedeliverWebhookResponse> {
+    return this.request<RedeliverWebhookResponse>({
+      method: "POST",
+      path: `/organizer/webhook-deliveries/${encodeURIComponent(id)}/redeliver`,
+      as: "json",
+    });
+  }
+
+  /**
+   * List my webhook endpoints.
+   *
+   * GET /organizer/webhooks
+   *
+   * List the URLs the organizer receives event notifications at.
+   */
+  listWebhookEndpoints(): Promise<ListWebhookEndpointsResponse> {
+    return this.request<ListWebhookEndpointsResponse>({
+      method: "GET",
+      path: `/organizer/webhooks`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Register a webhook endpoint.
+   *
+   * POST /organizer/webhooks
+   *
+   * Receive ticket.sold, attendee.checked_in and order.refunded events at an HTTPS URL. Each event is POSTed as {"id","type","created_at","data"} with X-Eventix-Event, X-Eventix-Delivery and X-Eventix-Timestamp headers, signed with a hex HMAC-SHA256 of "<timestamp>.<body>" in X-Eventix-Signature. Any 2xx answer accepts a delivery; others are retried with exponential backoff. The signing secret is only returned here and when rotated.
+   */
+  createWebhookEndpoint(body: CreateWebhookEndpointRequest): Promise<CreateWebhookEndpointResponse> {
+    return this.request<CreateWebhookEndpointResponse>({
+      method: "POST",
+      path: `/organizer/webhooks`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Delete a webhook endpoint.
+   *
+   * DELETE /organizer/webhooks/{id}
+   *
+   * Stop sending events to an endpoint and drop its delivery log, including deliveries still waiting to be retried.
+   */
+  deleteWebhookEndpoint(id: string): Promise<DeleteWebhookEndpointResponse> {
+    return this.request<DeleteWebhookEndpointResponse>({
+      method: "DELETE",
+      path: `/organizer/webhooks/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update a webhook endpoint.
+   *
+   * PUT /organizer/webhooks/{id}
+   *
+   * Change an endpoint's URL, description or events, pause it with active=false, or rotate its signing secret. A rotated secret is returned once.
+   */
+  updateWebhookEndpoint(id: string, body: UpdateWebhookEndpointRequest): Promise<UpdateWebhookEndpointResponse> {
+    return this.request<UpdateWebhookEndpointResponse>({
+      method: "PUT",
+      path: `/organizer/webhooks/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * List webhook deliveries.
+   *
+   * GET /organizer/webhooks/{id}/deliveries
+   *
+   * List an endpoint's deliveries newest first, with the payload sent, the number of attempts, the endpoint's last response and when the next retry is due.
+   */
+  listWebhookDeliveries(id: string, params: ListWebhookDeliveriesParams = {}): Promise<ListWebhookDeliveriesResponse> {
+    return this.request<ListWebhookDeliveriesResponse>({
+      method: "GET",
+      path: `/organizer/webhooks/${encodeURIComponent(id)}/deliveries`,
+      query: { status: params.status, page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * List widget origins.
+   *
+   * GET /organizer/widget-origins
+   *
+   * List the sites the organizer's checkout widget can be embedded on.
+   */
+  listWidgetOrigins(): Promise<ListWidgetOriginsResponse> {
+    return this.request<ListWidgetOriginsResponse>({
+      method: "GET",
+      path: `/organizer/widget-origins`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Allow a widget origin.
+   *
+   * POST /organizer/widget-origins
+   *
+   * Allow the checkout widget of the organizer's events on a site. The widget routes answer cross-origin requests from it; the origin must use https, except for localhost.
+   */
+  addWidgetOrigin(body: AddWidgetOriginRequest): Promise<AddWidgetOriginResponse> {
+    return this.request<AddWidgetOriginResponse>({
+      method: "POST",
+      path: `/organizer/widget-origins`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Remove a widget origin.
+   *
+   * DELETE /organizer/widget-origins/{id}
+   *
+   * Stop the checkout widget from answering requests from a site.
+   */
+  removeWidgetOrigin(id: string): Promise<RemoveWidgetOriginResponse> {
+    return this.request<RemoveWidgetOriginResponse>({
+      method: "DELETE",
+      path: `/organizer/widget-origins/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Initialize payment.
+   *
+   * POST /payments/initialize
+   *
+   * Initialize a transaction with the order's payment provider (Paystack or Stripe) and return the checkout URL.
+   */
+  initializePayment(body: InitializePaymentRequest, params: InitializePaymentParams = {}): Promise<InitializePaymentResponse> {
+    return this.request<InitializePaymentResponse>({
+      method: "POST",
+      path: `/payments/initialize`,
+      headers: { "Idempotency-Key": params.idempotencyKey },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Verify payment.
+   *
+   * GET /payments/verify/{reference}
+   *
+   * Verify a transaction with the payment provider and complete the order on success.
+   */
+  verifyPayment(reference: string): Promise<VerifyPaymentResponse> {
+    return this.request<VerifyPaymentResponse>({
+      method: "GET",
+      path: `/payments/verify/${encodeURIComponent(reference)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Accept a team invitation.
+   *
+   * POST /team-invitations/{token}/accept
+   *
+   * Join the organizer team that invited the signed-in user's email address. Only attendee accounts can join, and only one team at a time.
+   */
+  acceptTeamInvitation(token: string): Promise<AcceptTeamInvitationResponse> {
+    return this.request<AcceptTeamInvitationResponse>({
+      method: "POST",
+      path: `/team-invitations/${encodeURIComponent(token)}/accept`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get user's tickets.
+   *
+   * GET /tickets/my-tickets
+   *
+   * Get all tickets owned by the authenticated user, newest first. Pass cursor (empty for the first page, then pagination.next_cursor) to page through them.
+   */
+  getMyTickets(params: GetMyTicketsParams = {}): Promise<GetMyTicketsResponse> {
+    return this.request<GetMyTicketsResponse>({
+      method: "GET",
+      path: `/tickets/my-tickets`,
+      query: { cursor: params.cursor, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Reserve a ticket.
+   *
+   * POST /tickets/reserve
+   *
+   * Reserve a ticket for an event (15-minute hold).
+   */
+  reserveTicket(body: ReserveTicketRequest): Promise<ReserveTicketResponse> {
+    return this.request<ReserveTicketResponse>({
+      method: "POST",
+      path: `/tickets/reserve`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get a ticket.
+   *
+   * GET /tickets/{id}
+   *
+   * Get a ticket owned by the authenticated user with its event, QR code, check-in status and registration answers.
+   */
+  getTicket(id: string): Promise<GetTicketResponse> {
+    return this.request<GetTicketResponse>({
+      method: "GET",
+      path: `/tickets/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get a calendar invite for a ticket.
+   *
+   * GET /tickets/{id}/calendar.ics
+   *
+   * Download an iCalendar (.ics) file with the event time, venue and ticket reference for a ticket owned by the authenticated user.
+   */
+  getTicketCalendar(id: string): Promise<Blob> {
+    return this.request<Blob>({
+      method: "GET",
+      path: `/tickets/${encodeURIComponent(id)}/calendar.ics`,
+      as: "blob",
+    });
+  }
+
+  /**
+   * Download a printable ticket.
+   *
+   * GET /tickets/{id}/pdf
+   *
+   * Download a PDF of a ticket owned by the authenticated user with its QR code, event details and terms of entry.
+   */
+  getTicketPDF(id: string): Promise<Blob> {
+    return this.request<Blob>({
+      method: "GET",
+      path: `/tickets/${encodeURIComponent(id)}/pdf`,
+      as: "blob",
+    });
+  }
+
+  /**
+   * Get ticket QR code.
+   *
+   * GET /tickets/{id}/qr
+   *
+   * Render the signed QR code of a ticket owned by the authenticated user as a PNG image.
+   */
+  getTicketQRCode(id: string): Promise<Blob> {
+    return this.request<Blob>({
+      method: "GET",
+      path: `/tickets/${encodeURIComponent(id)}/qr`,
+      as: "blob",
+    });
+  }
+
+  /**
+   * Get a wallet pass for a ticket.
+   *
+   * GET /tickets/{id}/wallet-pass
+   *
+   * Download a signed Apple Wallet pass (.pkpass) for an active ticket owned by the authenticated user, or get an "Add to Google Wallet" link with platform=google.
+   */
+  getTicketWalletPass(id: string, params: GetTicketWalletPassParams = {}): Promise<Blob> {
+    return this.request<Blob>({
+      method: "GET",
+      path: `/tickets/${encodeURIComponent(id)}/wallet-pass`,
+      query: { platform: params.platform },
+      as: "blob",
+    });
+  }
+
+  /**
+   * Delete a ticket tier.
+   *
+   * DELETE /tiers/{id}
+   *
+   * Delete a ticket tier that has no sold or reserved tickets (Organizer/Admin only).
+   */
+  deleteTicketTier(id: string): Promise<DeleteTicketTierResponse> {
+    return this.request<DeleteTicketTierResponse>({
+      method: "DELETE",
+      path: `/tiers/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update a ticket tier.
+   *
+   * PUT /tiers/{id}
+   *
+   * Update a ticket tier. Quantity cannot drop below tickets sold and price is locked once sales begin (Organizer/Admin only).
+   */
+  updateTicketTier(id: string, body: UpdateTicketTierRequest): Promise<UpdateTicketTierResponse> {
+    return this.request<UpdateTicketTierResponse>({
+      method: "PUT",
+      path: `/tiers/${encodeURIComponent(id)}`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set a ticket tier's price phases.
+   *
+   * PUT /tiers/{id}/price-phases
+   *
+   * Replace a tier's price phases, such as an early-bird price that ends at a date or after a number of sales. The first phase that applies sets the price; the tier's regular price applies after them all. Reservations keep the price they were held at (Organizer/Admin only).
+   */
+  setPricePhases(id: string, body: SetPricePhasesRequest): Promise<SetPricePhasesResponse> {
+    return this.request<SetPricePhasesResponse>({
+      method: "PUT",
+      path: `/tiers/${encodeURIComponent(id)}/price-phases`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set the sessions a ticket tier admits to.
+   *
+   * PUT /tiers/{id}/sessions
+   *
+   * Limit a tier's tickets to some sessions of its event. An empty list makes them valid for every session (Organizer/Admin only).
+   */
+  setTierSessions(id: string, body: SetTierSessionsRequest): Promise<SetTierSessionsResponse> {
+    return this.request<SetTierSessionsResponse>({
+      method: "PUT",
+      path: `/tiers/${encodeURIComponent(id)}/sessions`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Set the zones a ticket tier admits to.
+   *
+   * PUT /tiers/{id}/zones
+   *
+   * Let a tier's tickets into some zones of its event besides the main gate. An empty list limits them to the main gate (Organizer/Admin only).
+   */
+  setTierZones(id: string, body: SetTierZonesRequest): Promise<SetTierZonesResponse> {
+    return this.request<SetTierZonesResponse>({
+      method: "PUT",
+      path: `/tiers/${encodeURIComponent(id)}/zones`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Delete current user account.
+   *
+   * DELETE /users/me
+   *
+   * Deactivate and delete the authenticated user's account. Personal data is anonymized once the deletion grace period ends.
+   */
+  deleteAccount(body: DeleteAccountRequest): Promise<DeleteAccountResponse> {
+    return this.request<DeleteAccountResponse>({
+      method: "DELETE",
+      path: `/users/me`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get current user profile.
+   *
+   * GET /users/me
+   *
+   * Get authenticated user's profile information.
+   */
+  getCurrentUser(): Promise<GetCurrentUserResponse> {
+    return this.request<GetCurrentUserResponse>({
+      method: "GET",
+      path: `/users/me`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update current user profile.
+   *
+   * PUT /users/me
+   *
+   * Update the authenticated user's name and phone number. Omitted fields are unchanged; an empty phone removes it.
+   */
+  updateProfile(body: UpdateProfileRequest): Promise<UpdateProfileResponse> {
+    return this.request<UpdateProfileResponse>({
+      method: "PUT",
+      path: `/users/me`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Change password.
+   *
+   * POST /users/me/change-password
+   *
+   * Change the authenticated user's password. Refresh tokens issued before the change stop working; a new token pair is returned.
+   */
+  changePassword(body: ChangePasswordRequest): Promise<ChangePasswordResponse> {
+    return this.request<ChangePasswordResponse>({
+      method: "POST",
+      path: `/users/me/change-password`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Export current user data.
+   *
+   * GET /users/me/export
+   *
+   * Generate an export of the authenticated user's profile, orders and tickets and email a download link.
+   */
+  exportAccount(params: ExportAccountParams = {}): Promise<ExportAccountResponse> {
+    return this.request<ExportAccountResponse>({
+      method: "GET",
+      path: `/users/me/export`,
+      query: { format: params.format },
+      as: "json",
+    });
+  }
+
+  /**
+   * List saved events.
+   *
+   * GET /users/me/favorites
+   *
+   * List the authenticated user's saved events, most recently saved first.
+   */
+  getMyFavorites(params: GetMyFavoritesParams = {}): Promise<GetMyFavoritesResponse> {
+    return this.request<GetMyFavoritesResponse>({
+      method: "GET",
+      path: `/users/me/favorites`,
+      query: { page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * Get notification preferences.
+   *
+   * GET /users/me/notification-preferences
+   *
+   * Get the channels and topics the authenticated user receives notifications for.
+   */
+  getNotificationPreferences(): Promise<GetNotificationPreferencesResponse> {
+    return this.request<GetNotificationPreferencesResponse>({
+      method: "GET",
+      path: `/users/me/notification-preferences`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Update notification preferences.
+   *
+   * PUT /users/me/notification-preferences
+   *
+   * Choose which channels (email, SMS, push) and topics (orders, reminders, marketing) the authenticated user receives. Omitted fields are unchanged; account and security emails are always sent.
+   */
+  updateNotificationPreferences(body: NotificationPreferencesRequest): Promise<UpdateNotificationPreferencesResponse> {
+    return this.request<UpdateNotificationPreferencesResponse>({
+      method: "PUT",
+      path: `/users/me/notification-preferences`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get user's notifications.
+   *
+   * GET /users/me/notifications
+   *
+   * Get notifications sent to the authenticated user, newest first.
+   */
+  getMyNotifications(params: GetMyNotificationsParams = {}): Promise<GetMyNotificationsResponse> {
+    return this.request<GetMyNotificationsResponse>({
+      method: "GET",
+      path: `/users/me/notifications`,
+      query: { unread: params.unread, page: params.page, limit: params.limit },
+      as: "json",
+    });
+  }
+
+  /**
+   * List my sessions.
+   *
+   * GET /users/me/sessions
+   *
+   * List the devices the authenticated user is signed in on, most recently used first.
+   */
+  getMySessions(): Promise<GetMySessionsResponse> {
+    return this.request<GetMySessionsResponse>({
+      method: "GET",
+      path: `/users/me/sessions`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Revoke a session.
+   *
+   * DELETE /users/me/sessions/{id}
+   *
+   * Sign the authenticated user out of one device. Its refresh token stops working and its access tokens are rejected.
+   */
+  revokeMySession(id: string): Promise<RevokeMySessionResponse> {
+    return this.request<RevokeMySessionResponse>({
+      method: "DELETE",
+      path: `/users/me/sessions/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Payment provider webhook.
+   *
+   * POST /webhooks/payments
+   *
+   * Receive signed Paystack (x-paystack-signature) or Stripe (Stripe-Signature) callbacks and settle the referenced payment.
+   */
+  paymentWebhook(): Promise<PaymentWebhookResponse> {
+    return this.request<PaymentWebhookResponse>({
+      method: "POST",
+      path: `/webhooks/payments`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Get an event for the widget.
+   *
+   * GET /widget/events/{id}
+   *
+   * Get a published event with its ticket tiers, live availability and the sites its checkout widget is embedded on.
+   */
+  getWidgetEvent(id: string): Promise<GetWidgetEventResponse> {
+    return this.request<GetWidgetEventResponse>({
+      method: "GET",
+      path: `/widget/events/${encodeURIComponent(id)}`,
+      as: "json",
+    });
+  }
+
+  /**
+   * Start a widget guest checkout.
+   *
+   * POST /widget/events/{id}/guest
+   *
+   * Get guest tokens for the widget's reservation, order and payment routes, as with POST /auth/guest.
+   */
+  widgetGuestCheckout(id: string, body: GuestCheckoutRequest): Promise<WidgetGuestCheckoutResponse> {
+    return this.request<WidgetGuestCheckoutResponse>({
+      method: "POST",
+      path: `/widget/events/${encodeURIComponent(id)}/guest`,
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Create an order from the widget.
+   *
+   * POST /widget/events/{id}/orders
+   *
+   * Create an order for tickets reserved through the widget, as with POST /orders.
+   */
+  widgetCreateOrder(id: string, body: CreateOrderRequest, params: WidgetCreateOrderParams = {}): Promise<WidgetCreateOrderResponse> {
+    return this.request<WidgetCreateOrderResponse>({
+      method: "POST",
+      path: `/widget/events/${encodeURIComponent(id)}/orders`,
+      headers: { "Idempotency-Key": params.idempotencyKey },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Pay for a widget order.
+   *
+   * POST /widget/events/{id}/payments/initialize
+   *
+   * Initialize the payment of an order for the event and return the checkout URL, as with POST /payments/initialize.
+   */
+  widgetInitializePayment(id: string, body: InitializePaymentRequest, params: WidgetInitializePaymentParams = {}): Promise<WidgetInitializePaymentResponse> {
+    return this.request<WidgetInitializePaymentResponse>({
+      method: "POST",
+      path: `/widget/events/${encodeURIComponent(id)}/payments/initialize`,
+      headers: { "Idempotency-Key": params.idempotencyKey },
+      body,
+      as: "json",
+    });
+  }
+
+  /**
+   * Reserve tickets from the widget.
+   *
+   * POST /widget/events/{id}/reserve
+   *
+   * Reserve tickets of one of the event's tiers (15-minute hold), as with POST /tickets/reserve.
+   */
+  widgetReserveTicket(id: string, body: ReserveTicketRequest): Promise<WidgetReserveTicketResponse> {
+    return this.request<WidgetReserveTicketResponse>({
+      method: "POST",
+      path: `/widget/events/${encodeURIComponent(id)}/reserve`,
+      body,
+      as: "json",
+    });
+  }
+}